@@ -87,6 +87,7 @@ import (
 	precisebank "github.com/cosmos/evm/x/precisebank"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	ibccallbackskeeper "github.com/cosmos/evm/x/ibc/callbacks/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -100,8 +101,10 @@ import (
 	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
 
 	gammkeeper "github.com/bitbadges/bitbadgeschain/x/gamm/keeper"
+	incentiveskeeper "github.com/bitbadges/bitbadgeschain/x/incentives/keeper"
 
 	ibchooks "github.com/bitbadges/bitbadgeschain/x/ibc-hooks"
+	customhookskeeper "github.com/bitbadges/bitbadgeschain/x/custom-hooks/keeper"
 	ibcratelimitkeeper "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/keeper"
 
 	// this line is used by starport scaffolding # stargate/app/moduleImport
@@ -162,6 +165,8 @@ type App struct {
 	// IBC Hooks
 	HooksICS4Wrapper    ibchooks.ICS4Middleware
 	TransferICS4Wrapper porttypes.ICS4Wrapper
+	CustomHooksKeeper   customhookskeeper.Keeper
+	IBCCallbacksKeeper  *IBCCallbacksContractKeeper
 
 	// IBC Rate Limit
 	IBCRateLimitKeeper ibcratelimitkeeper.Keeper
@@ -171,6 +176,7 @@ type App struct {
 
 	GammKeeper        gammkeeper.Keeper
 	PoolManagerKeeper poolmanager.Keeper
+	IncentivesKeeper  incentiveskeeper.Keeper
 	SendmanagerKeeper sendmanagermodulekeeper.Keeper
 	FeeMarketKeeper   feemarketkeeper.Keeper
 	ERC20Keeper       erc20keeper.Keeper
@@ -364,6 +370,10 @@ func New(
 	app.TokenizationKeeper.SetGammKeeper(&app.GammKeeper)
 	if app.EVMKeeper != nil {
 		app.TokenizationKeeper.SetEVMKeeper(app.EVMKeeper)
+		// Enables the evm_call post-swap action in IBC hooks
+		app.CustomHooksKeeper.SetEVMKeepers(app.EVMKeeper, app.ERC20Keeper, app.AccountKeeper)
+		// Enables EVM contract targets for IBC transfer callbacks
+		app.IBCCallbacksKeeper.SetEVMContractKeeper(ibccallbackskeeper.NewKeeper(app.AccountKeeper, app.EVMKeeper, app.ERC20Keeper))
 	}
 
 	// Register custom approval criteria checkers (optional)
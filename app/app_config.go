@@ -4,6 +4,7 @@ import (
 	"time"
 
 	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	incentivestypes "github.com/bitbadges/bitbadgeschain/x/incentives/types"
 	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
 	_ "github.com/bitbadges/bitbadgeschain/x/sendmanager/module"
 	sendmanagermoduletypes "github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
@@ -111,6 +112,7 @@ var (
 		packetforwardtypes.ModuleName,
 		gammtypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		incentivestypes.ModuleName,
 		sendmanagermoduletypes.ModuleName,
 		feemarkettypes.ModuleName, // FeeMarket must come before EVM
 		erc20types.ModuleName,     // ERC20 must come after EVM (depends on EVM keeper)
@@ -143,6 +145,7 @@ var (
 		packetforwardtypes.ModuleName,
 		gammtypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		incentivestypes.ModuleName,
 		sendmanagermoduletypes.ModuleName,
 		feemarkettypes.ModuleName, // FeeMarket must come before EVM
 		erc20types.ModuleName,     // ERC20 must come after EVM (depends on EVM keeper)
@@ -170,6 +173,7 @@ var (
 		packetforwardtypes.ModuleName,
 		gammtypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		incentivestypes.ModuleName,
 		sendmanagermoduletypes.ModuleName,
 		feemarkettypes.ModuleName, // FeeMarket must come before EVM
 		erc20types.ModuleName,     // ERC20 must come after EVM (depends on EVM keeper)
@@ -199,6 +203,7 @@ var (
 		{Account: packetforwardtypes.ModuleName},
 		{Account: gammtypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: poolmanagertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: incentivestypes.ModuleName},
 		{Account: precisebanktypes.ModuleName, Permissions: []string{}},                             // PreciseBank module account
 		{Account: feemarkettypes.ModuleName, Permissions: []string{}},                               // FeeMarket module account
 		{Account: erc20types.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}}, // ERC20 module account
@@ -6,12 +6,17 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	// this line is used by starport scaffolding # ibc/app/import
 
 	"github.com/bitbadges/bitbadgeschain/x/gamm"
 	gammkeeper "github.com/bitbadges/bitbadgeschain/x/gamm/keeper"
 	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	incentiveskeeper "github.com/bitbadges/bitbadgeschain/x/incentives/keeper"
+	incentivesmodule "github.com/bitbadges/bitbadgeschain/x/incentives/module"
+	incentivestypes "github.com/bitbadges/bitbadgeschain/x/incentives/types"
 	"github.com/bitbadges/bitbadgeschain/x/poolmanager"
 	poolmanagermodule "github.com/bitbadges/bitbadgeschain/x/poolmanager/module"
 	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
@@ -23,6 +28,7 @@ func (app *App) registerGammModules(appOpts servertypes.AppOptions) error {
 	if err := app.RegisterStores(
 		storetypes.NewKVStoreKey(gammtypes.StoreKey),
 		storetypes.NewKVStoreKey(poolmanagertypes.StoreKey),
+		storetypes.NewKVStoreKey(incentivestypes.StoreKey),
 	); err != nil {
 		return err
 	}
@@ -57,10 +63,19 @@ func (app *App) registerGammModules(appOpts servertypes.AppOptions) error {
 
 	app.GammKeeper.SetPoolManager(&app.PoolManagerKeeper)
 
+	app.IncentivesKeeper = incentiveskeeper.NewKeeper(
+		app.appCodec,
+		app.GetKey(incentivestypes.StoreKey),
+		&app.SendmanagerKeeper,
+		app.GammKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// register IBC modules
 	if err := app.RegisterModules(
 		gamm.NewAppModule(app.appCodec, app.GammKeeper, app.AccountKeeper, app.BankKeeper),
 		poolmanagermodule.NewAppModule(app.PoolManagerKeeper, app.GammKeeper),
+		incentivesmodule.NewAppModule(app.appCodec, app.IncentivesKeeper),
 	); err != nil {
 		return err
 	}
@@ -75,6 +90,7 @@ func RegisterGamm(registry cdctypes.InterfaceRegistry) map[string]appmodule.AppM
 	modules := map[string]appmodule.AppModule{
 		gammtypes.ModuleName:        gamm.AppModule{},
 		poolmanagertypes.ModuleName: poolmanagermodule.AppModule{},
+		incentivestypes.ModuleName:  incentivesmodule.AppModule{},
 	}
 
 	for name, m := range modules {
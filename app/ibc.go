@@ -249,11 +249,11 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	// i.e. packet-forward-middleware is higher on the stack and sits between callbacks and the ibc channel keeper
 	// Since this is the lowest level middleware of the transfer stack, it should be the first entrypoint for transfer keeper's
 	// WriteAcknowledgement.
-	// IBC callbacks: use no-op implementation for ContractKeeper
-	// If callbacks are needed in the future, we'll need to create a ContractKeeper adapter
-	// callbackGasLimit must be non-zero even though we use a no-op keeper (it's used for validation)
-	// Using a reasonable default: 1,000,000 gas units
-	cbStack := ibccallbacks.NewIBCMiddleware(transferStack, app.PacketForwardKeeper, NewNoopContractKeeper(), 1_000_000)
+	// IBC callbacks: source callbacks of outgoing transfers are routed to an EVM contract or a dynamic store
+	// (see IBCCallbacksContractKeeper). The EVM contract keeper is set once the EVM modules are created.
+	// Using a reasonable default callback gas limit: 1,000,000 gas units
+	app.IBCCallbacksKeeper = NewIBCCallbacksContractKeeper(app.TokenizationKeeper)
+	cbStack := ibccallbacks.NewIBCMiddleware(transferStack, app.PacketForwardKeeper, app.IBCCallbacksKeeper, 1_000_000)
 	transferStack = packetforward.NewIBCMiddleware(
 		cbStack,
 		app.PacketForwardKeeper,
@@ -267,7 +267,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	// Create tokenization msg server for transfer_tokens IBC hook
 	tokenizationMsgServer := tokenizationkeeper.NewMsgServerImpl(app.TokenizationKeeper)
 	// Pass pointer to GammKeeper to avoid copying the keeper (which contains storeKey)
-	customHooksKeeper := customhookskeeper.NewKeeper(
+	app.CustomHooksKeeper = customhookskeeper.NewKeeper(
 		app.Logger(),
 		&app.GammKeeper,
 		app.BankKeeper,
@@ -280,7 +280,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	)
 
 	// Setup Custom Hooks (standalone)
-	customHooks := customhooks.NewCustomHooks(customHooksKeeper, bech32Prefix)
+	customHooks := customhooks.NewCustomHooks(app.CustomHooksKeeper, bech32Prefix)
 
 	// Setup IBC Rate Limit Keeper
 	// Authority defaults to gov module account
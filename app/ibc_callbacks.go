@@ -0,0 +1,184 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibccallbackstypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+
+	tokenizationkeeper "github.com/bitbadges/bitbadgeschain/x/tokenization/keeper"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+)
+
+// DynamicStoreCallbackPrefix is the src_callback address prefix that targets a tokenization dynamic store.
+// Format: "dynamic_store:<storeId>" or "dynamic_store:<storeId>:<address>" (address defaults to the packet sender).
+const DynamicStoreCallbackPrefix = "dynamic_store:"
+
+// IBCCallbacksContractKeeper implements ibccallbackstypes.ContractKeeper for source callbacks of outgoing transfers.
+// A transfer memo names the callback target via {"src_callback": {"address": "..."}}:
+//   - An EVM contract (0x hex or bech32): its onPacketAcknowledgement / onPacketTimeout entrypoint is invoked
+//   - A dynamic store ("dynamic_store:<storeId>[:<address>]"): the address is flagged true on a successful
+//     acknowledgement and false on an error acknowledgement or timeout. Only the store creator can target a store.
+//
+// Destination (receive) callbacks are not supported and are a no-op.
+type IBCCallbacksContractKeeper struct {
+	tokenizationKeeper *tokenizationkeeper.Keeper
+
+	// evmContractKeeper is set via SetEVMContractKeeper once the EVM modules are created
+	evmContractKeeper ibccallbackstypes.ContractKeeper
+}
+
+// NewIBCCallbacksContractKeeper creates a new IBCCallbacksContractKeeper
+func NewIBCCallbacksContractKeeper(tokenizationKeeper *tokenizationkeeper.Keeper) *IBCCallbacksContractKeeper {
+	return &IBCCallbacksContractKeeper{
+		tokenizationKeeper: tokenizationKeeper,
+	}
+}
+
+// SetEVMContractKeeper sets the contract keeper used for EVM contract callback targets
+func (k *IBCCallbacksContractKeeper) SetEVMContractKeeper(evmContractKeeper ibccallbackstypes.ContractKeeper) {
+	k.evmContractKeeper = evmContractKeeper
+}
+
+// IBCSendPacketCallback implements ibccallbackstypes.ContractKeeper.
+// The callback target is validated at send time so that an invalid target fails the transfer
+// instead of silently never being invoked.
+func (k *IBCCallbacksContractKeeper) IBCSendPacketCallback(ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, packetData []byte, contractAddress string, packetSenderAddress string, version string) error {
+	if isDynamicStoreCallback(contractAddress) {
+		_, _, err := k.validateDynamicStoreCallback(ctx, contractAddress, packetSenderAddress)
+		return err
+	}
+
+	evmContractKeeper, evmContractAddress, err := k.evmCallbackTarget(contractAddress)
+	if err != nil {
+		return err
+	}
+	return evmContractKeeper.IBCSendPacketCallback(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, evmContractAddress, packetSenderAddress, version)
+}
+
+// IBCOnAcknowledgementPacketCallback implements ibccallbackstypes.ContractKeeper
+func (k *IBCCallbacksContractKeeper) IBCOnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress, contractAddress string, packetSenderAddress string, version string) error {
+	if isDynamicStoreCallback(contractAddress) {
+		var ack channeltypes.Acknowledgement
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+			return sdkerrors.Wrapf(ibccallbackstypes.ErrInvalidCallbackData, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+		}
+		return k.flagDynamicStore(ctx, packet, contractAddress, packetSenderAddress, ack.Success(), "acknowledgement")
+	}
+
+	evmContractKeeper, evmContractAddress, err := k.evmCallbackTarget(contractAddress)
+	if err != nil {
+		return err
+	}
+	return evmContractKeeper.IBCOnAcknowledgementPacketCallback(ctx, packet, acknowledgement, relayer, evmContractAddress, packetSenderAddress, version)
+}
+
+// IBCOnTimeoutPacketCallback implements ibccallbackstypes.ContractKeeper
+func (k *IBCCallbacksContractKeeper) IBCOnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, contractAddress string, packetSenderAddress string, version string) error {
+	if isDynamicStoreCallback(contractAddress) {
+		return k.flagDynamicStore(ctx, packet, contractAddress, packetSenderAddress, false, "timeout")
+	}
+
+	evmContractKeeper, evmContractAddress, err := k.evmCallbackTarget(contractAddress)
+	if err != nil {
+		return err
+	}
+	return evmContractKeeper.IBCOnTimeoutPacketCallback(ctx, packet, relayer, evmContractAddress, packetSenderAddress, version)
+}
+
+// IBCReceivePacketCallback implements ibccallbackstypes.ContractKeeper.
+// Destination callbacks are not supported (incoming transfers use custom-hooks memos instead).
+func (k *IBCCallbacksContractKeeper) IBCReceivePacketCallback(ctx sdk.Context, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement, contractAddress string, version string) error {
+	return nil
+}
+
+// Ensure IBCCallbacksContractKeeper implements ibccallbackstypes.ContractKeeper
+var _ ibccallbackstypes.ContractKeeper = (*IBCCallbacksContractKeeper)(nil)
+
+func isDynamicStoreCallback(contractAddress string) bool {
+	return strings.HasPrefix(contractAddress, DynamicStoreCallbackPrefix)
+}
+
+// evmCallbackTarget returns the EVM contract keeper and the 0x hex address of the callback contract
+func (k *IBCCallbacksContractKeeper) evmCallbackTarget(contractAddress string) (ibccallbackstypes.ContractKeeper, string, error) {
+	if k.evmContractKeeper == nil {
+		return nil, "", sdkerrors.Wrap(ibccallbackstypes.ErrInvalidCallbackData, "EVM contract callbacks are not available")
+	}
+
+	if common.IsHexAddress(contractAddress) {
+		return k.evmContractKeeper, common.HexToAddress(contractAddress).Hex(), nil
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil || len(accAddr) != common.AddressLength {
+		return nil, "", sdkerrors.Wrapf(ibccallbackstypes.ErrInvalidCallbackData, "invalid callback address: %s", contractAddress)
+	}
+	return k.evmContractKeeper, common.BytesToAddress(accAddr).Hex(), nil
+}
+
+// validateDynamicStoreCallback parses a dynamic store callback address and checks that the
+// packet sender is the creator of the store. Returns the store ID and the address to flag.
+func (k *IBCCallbacksContractKeeper) validateDynamicStoreCallback(ctx sdk.Context, contractAddress string, packetSenderAddress string) (sdkmath.Uint, string, error) {
+	parts := strings.Split(strings.TrimPrefix(contractAddress, DynamicStoreCallbackPrefix), ":")
+	if len(parts) > 2 {
+		return sdkmath.Uint{}, "", sdkerrors.Wrapf(ibccallbackstypes.ErrInvalidCallbackData, "invalid dynamic store callback address: %s", contractAddress)
+	}
+
+	storeId, err := sdkmath.ParseUint(parts[0])
+	if err != nil || storeId.IsZero() {
+		return sdkmath.Uint{}, "", sdkerrors.Wrapf(ibccallbackstypes.ErrInvalidCallbackData, "invalid dynamic store ID: %s", parts[0])
+	}
+
+	dynamicStore, found := k.tokenizationKeeper.GetDynamicStoreFromStore(ctx, storeId)
+	if !found {
+		return sdkmath.Uint{}, "", sdkerrors.Wrapf(tokenizationtypes.ErrInvalidDynamicStoreID, "dynamic store not found: %s", storeId)
+	}
+
+	// Same restriction as MsgSetDynamicStoreValue: only the creator can set values in the store
+	if packetSenderAddress == "" || dynamicStore.CreatedBy != packetSenderAddress {
+		return sdkmath.Uint{}, "", sdkerrors.Wrapf(tokenizationtypes.ErrInvalidDynamicStoreID, "only the creator of dynamic store %s can use it as a callback target", storeId)
+	}
+
+	flagAddress := packetSenderAddress
+	if len(parts) == 2 {
+		flagAddress = parts[1]
+	}
+	if err := tokenizationtypes.ValidateAddress(flagAddress, false); err != nil {
+		return sdkmath.Uint{}, "", sdkerrors.Wrapf(err, "invalid dynamic store callback address: %s", flagAddress)
+	}
+
+	return storeId, flagAddress, nil
+}
+
+// flagDynamicStore sets the dynamic store value for the callback target to the packet outcome
+func (k *IBCCallbacksContractKeeper) flagDynamicStore(ctx sdk.Context, packet channeltypes.Packet, contractAddress string, packetSenderAddress string, success bool, result string) error {
+	storeId, flagAddress, err := k.validateDynamicStoreCallback(ctx, contractAddress, packetSenderAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.tokenizationKeeper.SetDynamicStoreValueInStore(ctx, storeId, flagAddress, success); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"ibc_callback_dynamic_store",
+		sdk.NewAttribute("store_id", storeId.String()),
+		sdk.NewAttribute("address", flagAddress),
+		sdk.NewAttribute("value", fmt.Sprintf("%t", success)),
+		sdk.NewAttribute("result", result),
+		sdk.NewAttribute("source_port", packet.GetSourcePort()),
+		sdk.NewAttribute("source_channel", packet.GetSourceChannel()),
+		sdk.NewAttribute("sequence", fmt.Sprintf("%d", packet.GetSequence())),
+	))
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+)
+
+// recordingContractKeeper records the EVM contract addresses it is invoked with
+type recordingContractKeeper struct {
+	NoopContractKeeper
+	acks     []string
+	timeouts []string
+}
+
+func (k *recordingContractKeeper) IBCOnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress, contractAddress string, packetSenderAddress string, version string) error {
+	k.acks = append(k.acks, contractAddress)
+	return nil
+}
+
+func (k *recordingContractKeeper) IBCOnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, contractAddress string, packetSenderAddress string, version string) error {
+	k.timeouts = append(k.timeouts, contractAddress)
+	return nil
+}
+
+func (k *recordingContractKeeper) IBCReceivePacketCallback(ctx sdk.Context, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement, contractAddress string, version string) error {
+	return nil
+}
+
+func newCallbackTestPacket(sender string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("ubadge", "100", sender, "cosmos1receiver", "")
+	return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+}
+
+func TestIBCCallbacksContractKeeper_DynamicStore(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(false)
+
+	creator := sdk.AccAddress([]byte("callback_store_owner")).String()
+	other := sdk.AccAddress([]byte("callback_other______")).String()
+	storeId := sdkmath.NewUint(1)
+	require.NoError(t, app.TokenizationKeeper.SetDynamicStoreInStore(ctx, tokenizationtypes.DynamicStore{
+		StoreId:       storeId,
+		CreatedBy:     creator,
+		GlobalEnabled: true,
+	}))
+
+	k := app.IBCCallbacksKeeper
+	packet := newCallbackTestPacket(creator)
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	errorAck := channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled).Acknowledgement()
+
+	// Send: valid targets are accepted, non-creators and unknown stores are rejected
+	require.NoError(t, k.IBCSendPacketCallback(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packet.Data, "dynamic_store:1", creator, transfertypes.V1))
+	require.Error(t, k.IBCSendPacketCallback(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packet.Data, "dynamic_store:1", other, transfertypes.V1))
+	require.Error(t, k.IBCSendPacketCallback(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packet.Data, "dynamic_store:2", creator, transfertypes.V1))
+	require.Error(t, k.IBCSendPacketCallback(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packet.Data, "dynamic_store:abc", creator, transfertypes.V1))
+	require.Error(t, k.IBCSendPacketCallback(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packet.Data, "dynamic_store:1:invalid", creator, transfertypes.V1))
+
+	// Successful acknowledgement flags the packet sender true
+	require.NoError(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet, successAck, nil, "dynamic_store:1", creator, transfertypes.V1))
+	value, found := app.TokenizationKeeper.GetDynamicStoreValueFromStore(ctx, storeId, creator)
+	require.True(t, found)
+	require.True(t, value.Value)
+
+	// Error acknowledgement flags the explicit address false
+	require.NoError(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet, successAck, nil, "dynamic_store:1:"+other, creator, transfertypes.V1))
+	value, _ = app.TokenizationKeeper.GetDynamicStoreValueFromStore(ctx, storeId, other)
+	require.True(t, value.Value)
+	require.NoError(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet, errorAck, nil, "dynamic_store:1:"+other, creator, transfertypes.V1))
+	value, _ = app.TokenizationKeeper.GetDynamicStoreValueFromStore(ctx, storeId, other)
+	require.False(t, value.Value)
+
+	// Timeout flags false
+	require.NoError(t, k.IBCOnTimeoutPacketCallback(ctx, packet, nil, "dynamic_store:1", creator, transfertypes.V1))
+	value, _ = app.TokenizationKeeper.GetDynamicStoreValueFromStore(ctx, storeId, creator)
+	require.False(t, value.Value)
+
+	// Only the store creator can use the store as a callback target
+	err := k.IBCOnAcknowledgementPacketCallback(ctx, packet, successAck, nil, "dynamic_store:1:"+other, other, transfertypes.V1)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "only the creator"))
+	value, _ = app.TokenizationKeeper.GetDynamicStoreValueFromStore(ctx, storeId, other)
+	require.False(t, value.Value)
+}
+
+func TestIBCCallbacksContractKeeper_EVMContract(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(false)
+
+	recorder := &recordingContractKeeper{}
+	k := NewIBCCallbacksContractKeeper(app.TokenizationKeeper)
+
+	sender := sdk.AccAddress([]byte("callback_sender_____")).String()
+	packet := newCallbackTestPacket(sender)
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	contract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	// EVM targets are rejected until the EVM contract keeper is set
+	require.Error(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet, successAck, nil, contract.Hex(), sender, transfertypes.V1))
+
+	k.SetEVMContractKeeper(recorder)
+
+	// Hex and bech32 contract addresses are routed to the EVM contract keeper as hex
+	require.NoError(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet, successAck, nil, contract.Hex(), sender, transfertypes.V1))
+	require.NoError(t, k.IBCOnTimeoutPacketCallback(ctx, packet, nil, sdk.AccAddress(contract.Bytes()).String(), sender, transfertypes.V1))
+	require.Equal(t, []string{contract.Hex()}, recorder.acks)
+	require.Equal(t, []string{contract.Hex()}, recorder.timeouts)
+
+	// Unparseable addresses are rejected
+	require.Error(t, k.IBCSendPacketCallback(ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packet.Data, "not-an-address", sender, transfertypes.V1))
+}
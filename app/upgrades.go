@@ -39,7 +39,7 @@ func (app *App) RegisterUpgradeHandlers() {
 			Renamed: []storetypes.StoreRename{},
 			// v33: remove deprecated x/anchor and x/maps modules
 			Deleted: []string{"anchor", "maps"},
-			Added:   []string{},
+			Added:   []string{"incentives"},
 		}
 	}
 
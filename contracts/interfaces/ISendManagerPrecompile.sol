@@ -19,5 +19,13 @@ interface ISendManagerPrecompile {
     ///                Supports both standard denoms (e.g., "ubadge") and alias denoms (e.g., "badgeslp:...")
     /// @return success Whether the send succeeded
     function send(string memory msgJson) external returns (bool success);
+
+    /// @notice Send native Cosmos coins from the caller to multiple recipients
+    /// @param msgJson JSON string matching MsgMultiSendWithAliasRouting protobuf JSON format (outputs only)
+    ///                Example: {"outputs":[{"address":"bb1...","coins":[{"denom":"ubadge","amount":"100"}]},{"address":"0x...","coins":[{"denom":"badgeslp:...","amount":"5"}]}]}
+    ///                Note: inputs are automatically set to a single input from msg.sender for the sum of all outputs
+    ///                Supports both standard denoms (e.g., "ubadge") and alias denoms (e.g., "badgeslp:...")
+    /// @return success Whether all sends succeeded
+    function multiSend(string memory msgJson) external returns (bool success);
 }
 
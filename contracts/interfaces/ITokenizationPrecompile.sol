@@ -212,6 +212,27 @@ interface ITokenizationPrecompile {
         string calldata msgJson
     ) external returns (uint256 resultCollectionId);
 
+    /// @notice Nominate a new manager of a collection, who must accept before the deadline
+    /// @param msgJson JSON string matching MsgNominateManager protobuf format
+    /// @return resultCollectionId The collection ID (unchanged)
+    function nominateManager(
+        string calldata msgJson
+    ) external returns (uint256 resultCollectionId);
+
+    /// @notice Accept a pending manager nomination, becoming the manager of the collection
+    /// @param msgJson JSON string matching MsgAcceptManager protobuf format
+    /// @return resultCollectionId The collection ID (unchanged)
+    function acceptManager(
+        string calldata msgJson
+    ) external returns (uint256 resultCollectionId);
+
+    /// @notice Cancel a pending manager nomination
+    /// @param msgJson JSON string matching MsgCancelManagerNomination protobuf format
+    /// @return success True if the nomination was cancelled
+    function cancelManagerNomination(
+        string calldata msgJson
+    ) external returns (bool success);
+
     /// @notice Set collection metadata
     /// @param msgJson JSON string matching MsgSetCollectionMetadata protobuf format
     /// @return resultCollectionId The collection ID (unchanged)
@@ -1716,6 +1716,36 @@ library TokenizationJSONHelpers {
         ));
     }
 
+    /**
+     * @notice Construct JSON for nominateManager
+     * @param collectionId The collection ID
+     * @param nominee The nominated manager address
+     * @param expiresAt Time (UNIX milliseconds) after which the nomination can no longer be accepted
+     */
+    function nominateManagerJSON(
+        uint256 collectionId,
+        string memory nominee,
+        uint256 expiresAt
+    ) internal pure returns (string memory) {
+        return string(abi.encodePacked(
+            '{"collectionId":"', _uintToString(collectionId),
+            '","nominee":"', nominee,
+            '","expiresAt":"', _uintToString(expiresAt), '"}'
+        ));
+    }
+
+    /**
+     * @notice Construct JSON for acceptManager or cancelManagerNomination
+     * @param collectionId The collection ID
+     */
+    function managerNominationJSON(
+        uint256 collectionId
+    ) internal pure returns (string memory) {
+        return string(abi.encodePacked(
+            '{"collectionId":"', _uintToString(collectionId), '"}'
+        ));
+    }
+
     /**
      * @notice Construct JSON for setStandards
      * @param collectionId The collection ID
@@ -0,0 +1,408 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+)
+
+// ExecuteActions executes a multi-action memo.
+// The actions run in order in a single cached context on behalf of the IBC intermediate sender,
+// each receiving the output of the previous action (see types.HookAction). Any output left after the
+// last action is sent to recover_address. If any action fails, all actions are rolled back and the
+// received tokens are sent to recover_address.
+func (k Keeper) ExecuteActions(ctx sdk.Context, sender sdk.AccAddress, actions []types.HookAction, recoverAddress string, tokenIn sdk.Coin, originalSender string) ibcexported.Acknowledgement {
+	// 1. Early validation
+	if len(actions) == 0 {
+		return types.NewCustomErrorAcknowledgement("actions cannot be empty")
+	}
+	if len(actions) > types.MaxHookActions {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("too many actions: %d, max: %d", len(actions), types.MaxHookActions))
+	}
+
+	// A recover address is always required so a failed action never strands the received tokens
+	if recoverAddress == "" {
+		return types.NewCustomErrorAcknowledgement("recover_address is required for actions")
+	}
+	if _, err := sdk.AccAddressFromBech32(recoverAddress); err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid recover_address: %s", recoverAddress))
+	}
+
+	actionNames := make([]string, len(actions))
+	for i := range actions {
+		name, err := hookActionName(&actions[i])
+		if err != nil {
+			return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid action %d: %s", i, err.Error()))
+		}
+		actionNames[i] = name
+
+		isLast := i == len(actions)-1
+		if !isLast && isTerminalHookAction(&actions[i]) {
+			return types.NewCustomErrorAcknowledgement(fmt.Sprintf("action %d (%s) sends its output away and must be the last action", i, name))
+		}
+		if isLast && keepsOutputHookAction(&actions[i]) {
+			return types.NewCustomErrorAcknowledgement(fmt.Sprintf("last action (%s) would leave its output at the intermediate address; add a transfer action or set to_address", name))
+		}
+	}
+
+	// 2. Execute all actions in a single cached context for atomicity
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	current := sdk.NewCoin(tokenIn.Denom, tokenIn.Amount)
+	for i := range actions {
+		types.ClearDeterministicError(cacheCtx)
+
+		output, err := k.executeHookAction(cacheCtx, sender, &actions[i], current)
+		if err != nil {
+			k.Logger(ctx).Error("custom-hooks: actions failed", "error", err, "sender", sender.String(), "original_sender", originalSender, "action_index", i, "action", actionNames[i], "token_in", tokenIn.String())
+
+			actionsErrMsg := fmt.Sprintf("actions failed at action %d (%s): sender=%s (derived from %s), token_in=%s, action_input=%s", i, actionNames[i], sender.String(), originalSender, tokenIn.String(), current.String())
+
+			// Only use deterministic error from transient store — never use err.Error() directly
+			if detErrMsg, found := types.GetDeterministicError(cacheCtx); found {
+				actionsErrMsg = fmt.Sprintf("%s: %s", actionsErrMsg, detErrMsg)
+			}
+
+			extraAttrs := []sdk.Attribute{
+				sdk.NewAttribute("failed_action_index", strconv.Itoa(i)),
+				sdk.NewAttribute("failed_action", actionNames[i]),
+			}
+			return k.ExecuteFallbackToRecoverAddress(ctx, sender, recoverAddress, tokenIn, originalSender, actionsErrMsg, "actions_fallback", extraAttrs)
+		}
+
+		current = output
+	}
+
+	// 3. Pass-through actions (transfer_tokens, set_*, cast_vote) leave their input at the intermediate sender,
+	// so whatever is left after the last action is swept to recover_address instead of being stranded
+	if current.Amount.IsPositive() {
+		types.ClearDeterministicError(cacheCtx)
+		ack := k.ExecuteLocalTransfer(cacheCtx, sender, &types.TransferInfo{ToAddress: recoverAddress}, current)
+		if !ack.Success() {
+			k.Logger(ctx).Error("custom-hooks: sweeping remaining output failed", "sender", sender.String(), "original_sender", originalSender, "remaining", current.String(), "token_in", tokenIn.String())
+
+			actionsErrMsg := fmt.Sprintf("actions failed to sweep remaining %s to recover_address: sender=%s (derived from %s), token_in=%s", current.String(), sender.String(), originalSender, tokenIn.String())
+			if detErrMsg, found := types.GetDeterministicError(cacheCtx); found {
+				actionsErrMsg = fmt.Sprintf("%s: %s", actionsErrMsg, detErrMsg)
+			}
+			return k.ExecuteFallbackToRecoverAddress(ctx, sender, recoverAddress, tokenIn, originalSender, actionsErrMsg, "actions_fallback", nil)
+		}
+	}
+
+	// 4. All actions succeeded — commit cache
+	writeCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"actions_success",
+		sdk.NewAttribute("module", "custom-hooks"),
+		sdk.NewAttribute("sender", sender.String()),
+		sdk.NewAttribute("original_sender", originalSender),
+		sdk.NewAttribute("token_in", tokenIn.String()),
+		sdk.NewAttribute("num_actions", strconv.Itoa(len(actions))),
+		sdk.NewAttribute("actions", strings.Join(actionNames, ",")),
+		sdk.NewAttribute("swept_to_recover_address", current.String()),
+	))
+
+	return types.NewSuccessAcknowledgement()
+}
+
+// hookActionName returns the memo key of the action, erroring if not exactly one action is set
+func hookActionName(action *types.HookAction) (string, error) {
+	var names []string
+	if action.Swap != nil {
+		names = append(names, "swap")
+	}
+	if action.Transfer != nil {
+		names = append(names, "transfer")
+	}
+	if action.TransferTokens != nil {
+		names = append(names, "transfer_tokens")
+	}
+	if action.JoinPool != nil {
+		names = append(names, "join_pool")
+	}
+	if action.ExitPool != nil {
+		names = append(names, "exit_pool")
+	}
+	if action.SetDynamicStoreValue != nil {
+		names = append(names, "set_dynamic_store_value")
+	}
+	if action.SetIncomingApproval != nil {
+		names = append(names, "set_incoming_approval")
+	}
+	if action.CastVote != nil {
+		names = append(names, "cast_vote")
+	}
+
+	if len(names) != 1 {
+		return "", fmt.Errorf("must specify exactly one action, got %d", len(names))
+	}
+	return names[0], nil
+}
+
+// isTerminalHookAction returns true if the action sends its output away from the intermediate sender
+func isTerminalHookAction(action *types.HookAction) bool {
+	return action.Transfer != nil ||
+		(action.JoinPool != nil && action.JoinPool.ToAddress != "") ||
+		(action.ExitPool != nil && action.ExitPool.ToAddress != "")
+}
+
+// keepsOutputHookAction returns true if the action outputs new tokens at the intermediate sender
+func keepsOutputHookAction(action *types.HookAction) bool {
+	return action.Swap != nil ||
+		(action.JoinPool != nil && action.JoinPool.ToAddress == "") ||
+		(action.ExitPool != nil && action.ExitPool.ToAddress == "")
+}
+
+// executeHookAction executes a single action with the given input and returns its output
+func (k Keeper) executeHookAction(ctx sdk.Context, sender sdk.AccAddress, action *types.HookAction, input sdk.Coin) (sdk.Coin, error) {
+	switch {
+	case action.Swap != nil:
+		return k.executeSwapAction(ctx, sender, action.Swap, input)
+	case action.Transfer != nil:
+		ack := k.ExecuteLocalTransfer(ctx, sender, action.Transfer, input)
+		if !ack.Success() {
+			ackErrMsg, _ := types.GetAckError(ack)
+			return sdk.Coin{}, types.WrapErrSimple(&ctx, types.ErrSendCoinsFailed, fmt.Sprintf("failed to send %s to to_address: %s", input.String(), ackErrMsg))
+		}
+		return sdk.NewCoin(input.Denom, sdkmath.ZeroInt()), nil
+	case action.JoinPool != nil:
+		return k.executeJoinPoolAction(ctx, sender, action.JoinPool, input)
+	case action.ExitPool != nil:
+		return k.executeExitPoolAction(ctx, sender, action.ExitPool, input)
+	case action.TransferTokens != nil:
+		return k.executePassThroughAction(ctx, sender, input, func(ctx sdk.Context) error {
+			return k.executeTransferTokensAction(ctx, sender, action.TransferTokens)
+		})
+	case action.SetDynamicStoreValue != nil:
+		return k.executePassThroughAction(ctx, sender, input, func(ctx sdk.Context) error {
+			msg, err := newSetDynamicStoreValueMsg(sender, action.SetDynamicStoreValue)
+			if err != nil {
+				return types.WrapErrSimple(&ctx, types.ErrHookActionFailed, err.Error())
+			}
+			_, err = k.tokenizationMsgServer.SetDynamicStoreValue(ctx, msg)
+			return err
+		})
+	case action.SetIncomingApproval != nil:
+		return k.executePassThroughAction(ctx, sender, input, func(ctx sdk.Context) error {
+			msg, err := newSetIncomingApprovalMsg(sender, action.SetIncomingApproval)
+			if err != nil {
+				return types.WrapErrSimple(&ctx, types.ErrHookActionFailed, err.Error())
+			}
+			_, err = k.tokenizationMsgServer.SetIncomingApproval(ctx, msg)
+			return err
+		})
+	case action.CastVote != nil:
+		return k.executePassThroughAction(ctx, sender, input, func(ctx sdk.Context) error {
+			msg, err := newCastVoteMsg(sender, action.CastVote)
+			if err != nil {
+				return types.WrapErrSimple(&ctx, types.ErrHookActionFailed, err.Error())
+			}
+			_, err = k.tokenizationMsgServer.CastVote(ctx, msg)
+			return err
+		})
+	default:
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "no action specified")
+	}
+}
+
+// executePassThroughAction executes an action that does not produce new tokens.
+// Its output is whatever is left of the input after execution (e.g., if MsgTransferTokens
+// paid for a mint with part of the input, the output is the remainder).
+func (k Keeper) executePassThroughAction(ctx sdk.Context, sender sdk.AccAddress, input sdk.Coin, execute func(ctx sdk.Context) error) (sdk.Coin, error) {
+	balanceBefore, err := k.sendManagerKeeper.GetBalanceWithAliasRouting(ctx, sender, input.Denom)
+	if err != nil {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "failed to get balance of %s", input.Denom)
+	}
+
+	if err := execute(ctx); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	balanceAfter, err := k.sendManagerKeeper.GetBalanceWithAliasRouting(ctx, sender, input.Denom)
+	if err != nil {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "failed to get balance of %s", input.Denom)
+	}
+
+	remaining := input.Amount
+	if balanceAfter.Amount.LT(balanceBefore.Amount) {
+		remaining = remaining.Sub(balanceBefore.Amount.Sub(balanceAfter.Amount))
+	}
+	if remaining.IsNegative() {
+		remaining = sdkmath.ZeroInt()
+	}
+
+	return sdk.NewCoin(input.Denom, remaining), nil
+}
+
+// executeSwapAction swaps the input through the given operations and returns the swapped tokens
+func (k Keeper) executeSwapAction(ctx sdk.Context, sender sdk.AccAddress, swap *types.SwapAction, input sdk.Coin) (sdk.Coin, error) {
+	if swap.SwapExactAssetIn == nil || len(swap.SwapExactAssetIn.Operations) == 0 {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrNoOperations, "no operations provided for swap")
+	}
+	if swap.MinAsset == nil || swap.MinAsset.Native == nil {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrMinAssetRequired, "min_asset is required for swaps")
+	}
+
+	operations := swap.SwapExactAssetIn.Operations
+	routes := make([]poolmanagertypes.SwapAmountInRoute, len(operations))
+	for i, operation := range operations {
+		poolId, err := strconv.ParseUint(operation.Pool, 10, 64)
+		if err != nil {
+			return sdk.Coin{}, types.WrapErr(&ctx, types.ErrInvalidPoolID, "invalid pool ID in operation %d: %s", i, operation.Pool)
+		}
+		routes[i] = poolmanagertypes.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: operation.DenomOut,
+		}
+	}
+
+	if operations[0].DenomIn != input.Denom {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrDenomMismatch, "first operation denom_in %s does not match input %s", operations[0].DenomIn, input.Denom)
+	}
+	for i := 0; i < len(operations)-1; i++ {
+		if operations[i].DenomOut != operations[i+1].DenomIn {
+			return sdk.Coin{}, types.WrapErr(&ctx, types.ErrOperationsChainInvalid, "operation %d denom_out %s does not match operation %d denom_in %s", i, operations[i].DenomOut, i+1, operations[i+1].DenomIn)
+		}
+	}
+
+	lastOperation := operations[len(operations)-1]
+	if lastOperation.DenomOut != swap.MinAsset.Native.Denom {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrDenomMismatch, "last operation denom_out %s does not match min_asset denom %s", lastOperation.DenomOut, swap.MinAsset.Native.Denom)
+	}
+
+	tokenOutMinAmount, ok := osmomath.NewIntFromString(swap.MinAsset.Native.Amount)
+	if !ok {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrInvalidMinAssetAmount, "invalid min_asset amount: %s", swap.MinAsset.Native.Amount)
+	}
+
+	// Set up auto-approve for intermediate address for all wrapped denoms in the route
+	allDenoms := []string{input.Denom}
+	for _, operation := range operations {
+		allDenoms = append(allDenoms, operation.DenomOut)
+	}
+	for _, denom := range allDenoms {
+		if k.tokenizationKeeper.CheckIsAliasDenom(ctx, denom) {
+			ack := k.setAutoApproveForIntermediateAddress(ctx, sender.String(), denom)
+			if !ack.Success() {
+				return sdk.Coin{}, types.WrapErr(&ctx, types.ErrSetAutoApproveFailed, "denom: %s", denom)
+			}
+		}
+	}
+
+	tokenOut, err := k.gammKeeper.RouteExactAmountIn(ctx, sender, routes, input, tokenOutMinAmount, nil)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(lastOperation.DenomOut, tokenOut), nil
+}
+
+// executeJoinPoolAction single-asset joins the pool with the input and returns the pool shares.
+// If to_address is set, the shares are sent there and the output is empty.
+func (k Keeper) executeJoinPoolAction(ctx sdk.Context, sender sdk.AccAddress, action *types.JoinPoolAction, input sdk.Coin) (sdk.Coin, error) {
+	poolId, err := strconv.ParseUint(action.PoolId, 10, 64)
+	if err != nil {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "invalid join_pool pool_id: %s", action.PoolId)
+	}
+
+	shareOutMinAmount, ok := osmomath.NewIntFromString(action.ShareOutMinAmount)
+	if !ok || !shareOutMinAmount.IsPositive() {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "invalid join_pool share_out_min_amount: %s; must be a positive integer", action.ShareOutMinAmount)
+	}
+
+	if _, err := k.gammKeeper.GetCFMMPool(ctx, poolId); err != nil {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "join_pool pool not found: %d", poolId)
+	}
+
+	sharesOut, err := k.executeJoinPoolInContext(ctx, sender, poolId, input, shareOutMinAmount, action.ToAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	if action.ToAddress != "" {
+		return sdk.NewCoin(shareDenom, sdkmath.ZeroInt()), nil
+	}
+	return sdk.NewCoin(shareDenom, sharesOut), nil
+}
+
+// executeExitPoolAction exits the pool with the input shares into token_out_denom and returns the tokens out.
+// If to_address is set, the tokens are sent there and the output is empty.
+func (k Keeper) executeExitPoolAction(ctx sdk.Context, sender sdk.AccAddress, action *types.ExitPoolAction, input sdk.Coin) (sdk.Coin, error) {
+	poolId, err := strconv.ParseUint(action.PoolId, 10, 64)
+	if err != nil {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "invalid exit_pool pool_id: %s", action.PoolId)
+	}
+
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	if input.Denom != shareDenom {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "exit_pool requires %s shares, got: %s", shareDenom, input.Denom)
+	}
+
+	if action.TokenOutDenom == "" {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "exit_pool token_out_denom is required")
+	}
+
+	tokenOutMinAmount, ok := osmomath.NewIntFromString(action.TokenOutMinAmount)
+	if !ok || !tokenOutMinAmount.IsPositive() {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "invalid exit_pool token_out_min_amount: %s; must be a positive integer", action.TokenOutMinAmount)
+	}
+
+	pool, err := k.gammKeeper.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, types.WrapErr(&ctx, types.ErrHookActionFailed, "exit_pool pool not found: %d", poolId)
+	}
+
+	tokenOutAmount, err := k.executeExitPoolInContext(ctx, sender, poolId, pool.GetTotalPoolLiquidity(ctx).Denoms(), input, action.TokenOutDenom, tokenOutMinAmount, action.ToAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if action.ToAddress != "" {
+		return sdk.NewCoin(action.TokenOutDenom, sdkmath.ZeroInt()), nil
+	}
+	return sdk.NewCoin(action.TokenOutDenom, tokenOutAmount), nil
+}
+
+// executeTransferTokensAction executes a MsgTransferTokens as the intermediate sender
+func (k Keeper) executeTransferTokensAction(ctx sdk.Context, sender sdk.AccAddress, action *types.TransferTokensAction) error {
+	collectionId, err := strconv.ParseUint(action.CollectionId, 10, 64)
+	if err != nil {
+		return types.WrapErr(&ctx, types.ErrInvalidTransferTokensCollectionId, "invalid transfer_tokens collection_id: %s", action.CollectionId)
+	}
+
+	protoTransfers, err := types.UnmarshalTransfersFromJSON(action.Transfers)
+	if err != nil {
+		return types.WrapErrSimple(&ctx, types.ErrTransferTokensConversion, fmt.Sprintf("failed to parse transfer_tokens transfers: %s", err.Error()))
+	}
+	if len(protoTransfers) == 0 {
+		return types.WrapErr(&ctx, types.ErrTransferTokensEmpty, "transfer_tokens transfers cannot be empty")
+	}
+
+	collection, found := k.tokenizationKeeper.GetCollectionFromStore(ctx, sdkmath.NewUint(collectionId))
+	if !found {
+		return types.WrapErr(&ctx, types.ErrCollectionNotFound, "transfer_tokens collection not found: %s", action.CollectionId)
+	}
+
+	if err := k.tokenizationKeeper.SetAllAutoApprovalFlagsForIntermediateAddress(ctx, collection, sender.String()); err != nil {
+		return types.WrapErr(&ctx, types.ErrSetAutoApproveFailed, "failed to set auto-approve for intermediate address on collection %s", action.CollectionId)
+	}
+
+	_, err = k.tokenizationMsgServer.TransferTokens(ctx, &tokenizationtypes.MsgTransferTokens{
+		Creator:      sender.String(),
+		CollectionId: sdkmath.NewUint(collectionId),
+		Transfers:    protoTransfers,
+	})
+	return err
+}
@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	customhookstypes "github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+)
+
+// TestParseActionsMemo tests parsing of multi-action memos
+func TestParseActionsMemo(t *testing.T) {
+	t.Run("valid actions memo", func(t *testing.T) {
+		memo := `{"actions": [
+			{"swap": {"swap_exact_asset_in": {"operations": [{"pool": "1", "denom_in": "uatom", "denom_out": "ubadge"}]}, "min_asset": {"native": {"denom": "ubadge", "amount": "1"}}}},
+			{"transfer_tokens": {"collection_id": "1", "transfers": []}},
+			{"transfer": {"to_address": "bb1to"}}
+		], "recover_address": "bb1recover"}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.NoError(t, err)
+		require.NotNil(t, hookData)
+		require.Len(t, hookData.Actions, 3)
+		require.NotNil(t, hookData.Actions[0].Swap)
+		require.Equal(t, "ubadge", hookData.Actions[0].Swap.MinAsset.Native.Denom)
+		require.NotNil(t, hookData.Actions[1].TransferTokens)
+		require.Equal(t, "bb1to", hookData.Actions[2].Transfer.ToAddress)
+		require.Equal(t, "bb1recover", hookData.RecoverAddress)
+		require.Nil(t, hookData.SwapAndAction)
+		require.Nil(t, hookData.TransferTokens)
+	})
+
+	t.Run("empty actions", func(t *testing.T) {
+		hookData, err := customhookstypes.ParseHookDataFromMemo(`{"actions": [], "recover_address": "bb1recover"}`)
+		require.Error(t, err)
+		require.Nil(t, hookData)
+	})
+
+	t.Run("mutual exclusivity — actions with another hook", func(t *testing.T) {
+		memo := `{"transfer_tokens": {"collection_id": "1", "transfers": []}, "actions": [{"transfer": {"to_address": "bb1to"}}]}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.Error(t, err)
+		require.Nil(t, hookData)
+		require.Contains(t, err.Error(), "transfer_tokens and actions")
+	})
+}
+
+func swapHookAction(poolID uint64, denomIn string, denomOut string, minAmount string) customhookstypes.HookAction {
+	return customhookstypes.HookAction{
+		Swap: &customhookstypes.SwapAction{
+			SwapExactAssetIn: &customhookstypes.SwapExactAssetIn{
+				Operations: []customhookstypes.Operation{
+					{Pool: strconv.FormatUint(poolID, 10), DenomIn: denomIn, DenomOut: denomOut},
+				},
+			},
+			MinAsset: &customhookstypes.MinAsset{
+				Native: &customhookstypes.NativeAsset{Denom: denomOut, Amount: minAmount},
+			},
+		},
+	}
+}
+
+// TestExecuteActions_SwapThenJoinPool tests that the swap output is passed on to the next action
+func (s *KeeperTestSuite) TestExecuteActions_SwapThenJoinPool() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	uatomBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, "uatom")
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		Actions: []customhookstypes.HookAction{
+			swapHookAction(poolID, sdk.DefaultBondDenom, "uatom", "1"),
+			{JoinPool: &customhookstypes.JoinPoolAction{
+				PoolId:            strconv.FormatUint(poolID, 10),
+				ShareOutMinAmount: "1",
+				ToAddress:         toAddr.String(),
+			}},
+		},
+		RecoverAddress: recoverAddr.String(),
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	// The swapped uatom was fully used to join, and the shares went to to_address
+	s.Require().Equal(uatomBefore, s.App.BankKeeper.GetBalance(s.Ctx, sender, "uatom"))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, toAddr, shareDenom).Amount.IsPositive())
+	s.Require().True(s.hasEvent("actions_success"))
+}
+
+// TestExecuteActions_SwapThenTransfer tests a pass-through action between a swap and a transfer
+func (s *KeeperTestSuite) TestExecuteActions_SwapThenTransfer() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	s.createDynamicStore(1, sender)
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	toBefore := s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom")
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		Actions: []customhookstypes.HookAction{
+			swapHookAction(poolID, sdk.DefaultBondDenom, "uatom", "1"),
+			{SetDynamicStoreValue: &customhookstypes.SetDynamicStoreValueAction{
+				StoreId: "1",
+				Address: toAddr.String(),
+				Value:   true,
+			}},
+			{Transfer: &customhookstypes.TransferInfo{ToAddress: toAddr.String()}},
+		},
+		RecoverAddress: recoverAddr.String(),
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom").Amount.GT(toBefore.Amount))
+	value, found := s.App.TokenizationKeeper.GetDynamicStoreValueFromStore(s.Ctx, sdkmath.NewUint(1), toAddr.String())
+	s.Require().True(found)
+	s.Require().True(value.Value)
+}
+
+// TestExecuteActions_SwapThenPassThroughSweepsOutput tests that output left at the intermediate sender
+// after a trailing pass-through action is swept to recover_address
+func (s *KeeperTestSuite) TestExecuteActions_SwapThenPassThroughSweepsOutput() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	s.createDynamicStore(1, sender)
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	senderBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, "uatom")
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		Actions: []customhookstypes.HookAction{
+			swapHookAction(poolID, sdk.DefaultBondDenom, "uatom", "1"),
+			{SetDynamicStoreValue: &customhookstypes.SetDynamicStoreValueAction{
+				StoreId: "1",
+				Address: toAddr.String(),
+				Value:   true,
+			}},
+		},
+		RecoverAddress: recoverAddr.String(),
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+	s.Require().True(s.hasEvent("actions_success"))
+
+	// Nothing is left at the intermediate sender; the swapped uatom went to recover_address
+	s.Require().Equal(senderBefore, s.App.BankKeeper.GetBalance(s.Ctx, sender, "uatom"))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom").Amount.GT(recoverBefore.Amount))
+	value, found := s.App.TokenizationKeeper.GetDynamicStoreValueFromStore(s.Ctx, sdkmath.NewUint(1), toAddr.String())
+	s.Require().True(found)
+	s.Require().True(value.Value)
+}
+
+// TestExecuteActions_FailureRollsBackAll tests that a failing action rolls back earlier actions
+// and sends the received tokens to recover_address
+func (s *KeeperTestSuite) TestExecuteActions_FailureRollsBackAll() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	// The intermediate sender is not the creator of the store, so the second action fails
+	s.createDynamicStore(1, toAddr)
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	toBefore := s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom")
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, sdk.DefaultBondDenom)
+	poolBefore, err := s.App.GammKeeper.GetCFMMPool(s.Ctx, poolID)
+	s.Require().NoError(err)
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		Actions: []customhookstypes.HookAction{
+			swapHookAction(poolID, sdk.DefaultBondDenom, "uatom", "1"),
+			{SetDynamicStoreValue: &customhookstypes.SetDynamicStoreValueAction{
+				StoreId: "1",
+				Address: toAddr.String(),
+				Value:   true,
+			}},
+			{Transfer: &customhookstypes.TransferInfo{ToAddress: toAddr.String()}},
+		},
+		RecoverAddress: recoverAddr.String(),
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+	s.Require().True(s.hasEvent("actions_fallback"))
+
+	// The swap was rolled back and the original tokens went to recover_address
+	s.Require().Equal(toBefore, s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom"))
+	s.Require().Equal(recoverBefore.Add(tokenIn), s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, sdk.DefaultBondDenom))
+	poolAfter, err := s.App.GammKeeper.GetCFMMPool(s.Ctx, poolID)
+	s.Require().NoError(err)
+	s.Require().Equal(poolBefore.GetTotalPoolLiquidity(s.Ctx), poolAfter.GetTotalPoolLiquidity(s.Ctx))
+}
+
+// TestExecuteActions_Validation tests early validation of multi-action memos
+func (s *KeeperTestSuite) TestExecuteActions_Validation() {
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[2].String()
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000))
+	transfer := customhookstypes.HookAction{Transfer: &customhookstypes.TransferInfo{ToAddress: s.TestAccs[1].String()}}
+	swap := swapHookAction(1, sdk.DefaultBondDenom, "uatom", "1")
+
+	tests := []struct {
+		name           string
+		actions        []customhookstypes.HookAction
+		recoverAddress string
+		errContains    string
+	}{
+		{"missing recover_address", []customhookstypes.HookAction{transfer}, "", "recover_address is required"},
+		{"invalid recover_address", []customhookstypes.HookAction{transfer}, "invalid", "invalid recover_address"},
+		{"no action set", []customhookstypes.HookAction{{}}, recoverAddr, "exactly one action"},
+		{"two actions set", []customhookstypes.HookAction{{Swap: swap.Swap, Transfer: transfer.Transfer}}, recoverAddr, "exactly one action"},
+		{"transfer not last", []customhookstypes.HookAction{transfer, swap}, recoverAddr, "must be the last action"},
+		{"swap last", []customhookstypes.HookAction{swap}, recoverAddr, "would leave its output"},
+		{"too many actions", make([]customhookstypes.HookAction, customhookstypes.MaxHookActions+1), recoverAddr, "too many actions"},
+	}
+
+	for _, tc := range tests {
+		ack := s.keeper.ExecuteActions(s.Ctx, sender, tc.actions, tc.recoverAddress, tokenIn, sender.String())
+		s.Require().False(ack.Success(), tc.name)
+		s.Require().Contains(getAckError(ack), tc.errContains, tc.name)
+	}
+}
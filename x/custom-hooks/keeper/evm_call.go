@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/statedb"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+)
+
+// erc20ApproveSelector is the function selector for approve(address,uint256)
+var erc20ApproveSelector = []byte{0x09, 0x5e, 0xa7, 0xb3}
+
+// evmCallerPrefix is the derivation prefix for the EVM caller of an intermediate sender
+const evmCallerPrefix = "custom-hooks/evm_call"
+
+// DeriveEVMCaller derives the 20-byte EVM caller address used for evm_call on behalf of an intermediate sender.
+// Intermediate senders are 32-byte addresses which cannot act as EVM callers directly.
+func DeriveEVMCaller(sender sdk.AccAddress) common.Address {
+	hash := address.Hash(evmCallerPrefix, sender.Bytes())
+	return common.BytesToAddress(hash[:common.AddressLength])
+}
+
+// ValidateEVMCall performs sanity checks on an evm_call post-swap action before executing the swap
+func (k Keeper) ValidateEVMCall(ctx sdk.Context, evmCall *types.EVMCallInfo) ibcexported.Acknowledgement {
+	if evmCall == nil {
+		return types.NewCustomErrorAcknowledgement("evm_call cannot be nil")
+	}
+
+	if k.evmKeeper == nil || *k.evmKeeper == nil || k.accountKeeper == nil || *k.accountKeeper == nil {
+		return types.NewCustomErrorAcknowledgement("evm_call is not supported: EVM is not available")
+	}
+
+	if evmCall.ContractAddress == "" {
+		return types.NewCustomErrorAcknowledgement("evm_call.contract_address is required")
+	}
+	contractAddr, err := parseEVMAddress(evmCall.ContractAddress)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid evm_call.contract_address: %s", evmCall.ContractAddress))
+	}
+	if !(*k.evmKeeper).IsContract(ctx, contractAddr) {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("evm_call.contract_address is not a contract: %s", evmCall.ContractAddress))
+	}
+
+	if _, err := decodeCalldata(evmCall.Calldata); err != nil {
+		return types.NewCustomErrorAcknowledgement("invalid evm_call.calldata: must be hex-encoded")
+	}
+
+	mode := evmCall.GetMode()
+	if mode != types.EVMCallModeSend && mode != types.EVMCallModeApprove {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid evm_call.mode: %s; must be %s or %s", evmCall.Mode, types.EVMCallModeSend, types.EVMCallModeApprove))
+	}
+	if mode == types.EVMCallModeApprove && (k.erc20Keeper == nil || *k.erc20Keeper == nil) {
+		return types.NewCustomErrorAcknowledgement("evm_call approve mode is not supported: ERC20 module is not available")
+	}
+
+	if evmCall.GetGasLimit() > types.MaxEVMCallGasLimit {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("evm_call.gas_limit cannot exceed %d", types.MaxEVMCallGasLimit))
+	}
+
+	// A recover address is always required so a reverted call never strands the swapped output
+	if evmCall.RecoverAddress == "" {
+		return types.NewCustomErrorAcknowledgement("evm_call.recover_address is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(evmCall.RecoverAddress); err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid evm_call.recover_address: %s", evmCall.RecoverAddress))
+	}
+
+	return types.NewSuccessAcknowledgement()
+}
+
+// ExecuteEVMCall executes an evm_call post-swap action with the swapped output.
+// The call is executed in a cached context. If it fails (revert, out of gas, or any
+// transfer failure), all of its effects are discarded and the swapped output is sent
+// to the recover address via ExecuteFallbackToRecoverAddress.
+func (k Keeper) ExecuteEVMCall(ctx sdk.Context, sender sdk.AccAddress, evmCall *types.EVMCallInfo, token sdk.Coin, originalSender string) ibcexported.Acknowledgement {
+	if evmCall == nil {
+		return types.NewSuccessAcknowledgement()
+	}
+
+	ack := k.ValidateEVMCall(ctx, evmCall)
+	if !ack.Success() {
+		return ack
+	}
+
+	contractAddr, _ := parseEVMAddress(evmCall.ContractAddress)
+	calldata, _ := decodeCalldata(evmCall.Calldata)
+	mode := evmCall.GetMode()
+	evmCaller := DeriveEVMCaller(sender)
+
+	callCtx, writeCallCache := ctx.CacheContext()
+	types.ClearDeterministicError(callCtx)
+
+	err := k.executeEVMCallInContext(callCtx, ctx.GasMeter(), sender, evmCaller, contractAddr, calldata, mode, evmCall, token)
+	if err != nil {
+		k.Logger(ctx).Error("custom-hooks: evm_call failed", "error", err, "sender", sender.String(), "original_sender", originalSender, "contract", contractAddr.Hex())
+
+		errMsg := fmt.Sprintf("evm_call failed: contract=%s, mode=%s, token=%s: %s", contractAddr.Hex(), mode, token.String(), err.Error())
+		extraAttrs := []sdk.Attribute{
+			sdk.NewAttribute("contract_address", contractAddr.Hex()),
+			sdk.NewAttribute("mode", mode),
+		}
+		return k.ExecuteFallbackToRecoverAddress(ctx, sender, evmCall.RecoverAddress, token, originalSender, errMsg, "evm_call_fallback", extraAttrs)
+	}
+
+	writeCallCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"evm_call_success",
+		sdk.NewAttribute("module", "custom-hooks"),
+		sdk.NewAttribute("sender", sender.String()),
+		sdk.NewAttribute("original_sender", originalSender),
+		sdk.NewAttribute("evm_caller", evmCaller.Hex()),
+		sdk.NewAttribute("contract_address", contractAddr.Hex()),
+		sdk.NewAttribute("mode", mode),
+		sdk.NewAttribute("token", token.String()),
+	))
+
+	return types.NewSuccessAcknowledgement()
+}
+
+// executeEVMCallInContext moves the swapped output according to the mode and calls the contract.
+// All EVM execution is metered against a gas meter capped at the evm_call gas limit; the gas used
+// is charged to parentGasMeter regardless of the outcome.
+func (k Keeper) executeEVMCallInContext(
+	ctx sdk.Context,
+	parentGasMeter storetypes.GasMeter,
+	sender sdk.AccAddress,
+	evmCaller common.Address,
+	contractAddr common.Address,
+	calldata []byte,
+	mode string,
+	evmCall *types.EVMCallInfo,
+	token sdk.Coin,
+) error {
+	evmCallerAcc := sdk.AccAddress(evmCaller.Bytes())
+
+	// cosmos/evm requires the caller account to exist
+	accountKeeper := *k.accountKeeper
+	if accountKeeper.GetAccount(ctx, evmCallerAcc) == nil {
+		accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, evmCallerAcc))
+	}
+
+	var approveData []byte
+	var tokenContract common.Address
+	switch mode {
+	case types.EVMCallModeSend:
+		// Send the swapped output directly to the contract, then call it
+		ack := k.ExecuteLocalTransfer(ctx, sender, &types.TransferInfo{ToAddress: sdk.AccAddress(contractAddr.Bytes()).String()}, token)
+		if !ack.Success() {
+			ackErrMsg, _ := types.GetAckError(ack)
+			return types.WrapErr(&ctx, types.ErrEVMCallFailed, "failed to send %s to contract: %s", token.String(), ackErrMsg)
+		}
+	case types.EVMCallModeApprove:
+		// Move the swapped output to the EVM caller, which approves the contract to pull it
+		erc20Keeper := *k.erc20Keeper
+		pair, found := erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetTokenPairID(ctx, token.Denom))
+		if !found {
+			return types.WrapErr(&ctx, types.ErrEVMCallFailed, "no ERC20 token pair registered for denom %s", token.Denom)
+		}
+		tokenContract = pair.GetERC20Contract()
+
+		ack := k.ExecuteLocalTransfer(ctx, sender, &types.TransferInfo{ToAddress: evmCallerAcc.String()}, token)
+		if !ack.Success() {
+			ackErrMsg, _ := types.GetAckError(ack)
+			return types.WrapErr(&ctx, types.ErrEVMCallFailed, "failed to send %s to evm caller: %s", token.String(), ackErrMsg)
+		}
+
+		approveData = append(approveData, erc20ApproveSelector...)
+		approveData = append(approveData, common.LeftPadBytes(contractAddr.Bytes(), 32)...)
+		approveData = append(approveData, common.LeftPadBytes(token.Amount.BigInt().Bytes(), 32)...)
+	}
+
+	gasLimit := evmCall.GetGasLimit()
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	err := func() (err error) {
+		limitedCtx := ctx.WithGasMeter(gasMeter)
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+					panic(r)
+				}
+				err = types.WrapErr(&ctx, types.ErrEVMCallFailed, "out of gas: gas_limit=%d", gasLimit)
+			}
+		}()
+
+		// gas_limit covers both calls, so the contract call only gets what the approve left over
+		callGasLimit := gasLimit
+		if len(approveData) > 0 {
+			approveGasUsed, err := k.callEVM(limitedCtx, evmCaller, tokenContract, approveData, gasLimit)
+			if err != nil {
+				return types.WrapErr(&ctx, types.ErrEVMCallFailed, "approve reverted: %s", err.Error())
+			}
+			if approveGasUsed >= gasLimit {
+				return types.WrapErr(&ctx, types.ErrEVMCallFailed, "no gas left for contract call after approve: gas_limit=%d", gasLimit)
+			}
+			callGasLimit = gasLimit - approveGasUsed
+		}
+
+		if _, err := k.callEVM(limitedCtx, evmCaller, contractAddr, calldata, callGasLimit); err != nil {
+			return types.WrapErr(&ctx, types.ErrEVMCallFailed, "contract call reverted: %s", err.Error())
+		}
+		return nil
+	}()
+	parentGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), "custom-hooks evm_call")
+	if err != nil {
+		return err
+	}
+
+	// In approve mode, whatever the contract did not pull is returned to the recover address
+	if mode == types.EVMCallModeApprove {
+		leftover, err := k.sendManagerKeeper.GetBalanceWithAliasRouting(ctx, evmCallerAcc, token.Denom)
+		if err != nil {
+			return types.WrapErr(&ctx, types.ErrEVMCallFailed, "failed to get leftover balance of evm caller: %s", err.Error())
+		}
+		if leftover.IsPositive() {
+			recoverAddr, _ := sdk.AccAddressFromBech32(evmCall.RecoverAddress)
+			if err := k.sendManagerKeeper.SendCoinWithAliasRouting(ctx, evmCallerAcc, recoverAddr, &leftover); err != nil {
+				return types.WrapErr(&ctx, types.ErrEVMCallFailed, "failed to return leftover %s to recover address", leftover.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// callEVM executes a state-changing EVM call and returns the gas it used, or an error if it reverts
+func (k Keeper) callEVM(ctx sdk.Context, from common.Address, contract common.Address, data []byte, gasLimit uint64) (uint64, error) {
+	evmKeeper := *k.evmKeeper
+
+	// Type-assert to real EVM keeper for stateDB creation; nil stateDB for mock keepers in tests
+	var sdb *statedb.StateDB
+	if realKeeper, ok := evmKeeper.(*evmkeeper.Keeper); ok {
+		sdb = statedb.New(ctx, realKeeper, statedb.NewEmptyTxConfig())
+	}
+
+	res, err := evmKeeper.CallEVMWithData(ctx, sdb, from, &contract, data, true, false, new(big.Int).SetUint64(gasLimit))
+	if err != nil {
+		return 0, err
+	}
+	if res.VmError != "" {
+		return 0, fmt.Errorf("%s", res.VmError)
+	}
+	if res.GasUsed > gasLimit {
+		return 0, fmt.Errorf("gas used %d exceeds gas_limit %d", res.GasUsed, gasLimit)
+	}
+	return res.GasUsed, nil
+}
+
+// parseEVMAddress parses a 0x-prefixed hex or bech32 address into an EVM address
+func parseEVMAddress(addr string) (common.Address, error) {
+	addr = strings.TrimSpace(addr)
+	if len(addr) >= 2 && strings.ToLower(addr[:2]) == "0x" {
+		if !common.IsHexAddress(addr) {
+			return common.Address{}, fmt.Errorf("invalid hex address: %s", addr)
+		}
+		return common.HexToAddress(addr), nil
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(accAddr) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("address is not 20 bytes: %s", addr)
+	}
+	return common.BytesToAddress(accAddr.Bytes()), nil
+}
+
+// decodeCalldata decodes hex-encoded calldata (0x prefix optional)
+func decodeCalldata(calldata string) ([]byte, error) {
+	calldata = strings.TrimPrefix(strings.TrimPrefix(calldata, "0x"), "0X")
+	return hex.DecodeString(calldata)
+}
@@ -0,0 +1,431 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/custom-hooks/keeper"
+	customhookstypes "github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+)
+
+type mockEVMCall struct {
+	From     common.Address
+	Contract common.Address
+	Data     []byte
+	GasCap   uint64
+}
+
+// mockEVMKeeper records EVM calls and lets tests simulate contract behavior
+type mockEVMKeeper struct {
+	contracts map[common.Address]bool
+	calls     []mockEVMCall
+
+	// revert makes calls to the given contract revert
+	revert map[common.Address]bool
+	// gasUsed is consumed on the context gas meter for every call
+	gasUsed uint64
+	// onCall simulates contract side effects (e.g., pulling approved tokens)
+	onCall func(ctx sdk.Context, from common.Address, contract common.Address, data []byte) error
+}
+
+func newMockEVMKeeper(contracts ...common.Address) *mockEVMKeeper {
+	m := &mockEVMKeeper{
+		contracts: make(map[common.Address]bool),
+		revert:    make(map[common.Address]bool),
+	}
+	for _, c := range contracts {
+		m.contracts[c] = true
+	}
+	return m
+}
+
+func (m *mockEVMKeeper) IsContract(ctx sdk.Context, addr common.Address) bool {
+	return m.contracts[addr]
+}
+
+func (m *mockEVMKeeper) CallEVMWithData(ctx sdk.Context, stateDB *statedb.StateDB, from common.Address, contract *common.Address, data []byte, commit bool, callFromPrecompile bool, gasCap *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
+	m.calls = append(m.calls, mockEVMCall{From: from, Contract: *contract, Data: data, GasCap: gasCap.Uint64()})
+	ctx.GasMeter().ConsumeGas(m.gasUsed, "mock evm call")
+
+	if m.revert[*contract] {
+		return &evmtypes.MsgEthereumTxResponse{VmError: "execution reverted"}, errors.New("execution reverted")
+	}
+	if m.onCall != nil {
+		if err := m.onCall(ctx, from, *contract, data); err != nil {
+			return &evmtypes.MsgEthereumTxResponse{VmError: err.Error()}, err
+		}
+	}
+	return &evmtypes.MsgEthereumTxResponse{GasUsed: m.gasUsed}, nil
+}
+
+// mockERC20Keeper maps denoms to ERC20 contracts
+type mockERC20Keeper struct {
+	pairs map[string]erc20types.TokenPair
+}
+
+func (m *mockERC20Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
+	if _, ok := m.pairs[token]; !ok {
+		return nil
+	}
+	return []byte(token)
+}
+
+func (m *mockERC20Keeper) GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool) {
+	pair, ok := m.pairs[string(id)]
+	return pair, ok
+}
+
+var (
+	testEVMContract = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testERC20Token  = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testCalldata    = "0xdeadbeef"
+)
+
+func (s *KeeperTestSuite) setupEVMKeepers() (*mockEVMKeeper, *mockERC20Keeper) {
+	evmKeeper := newMockEVMKeeper(testEVMContract, testERC20Token)
+	erc20Keeper := &mockERC20Keeper{pairs: map[string]erc20types.TokenPair{
+		"uatom": {Erc20Address: testERC20Token.Hex(), Denom: "uatom", Enabled: true},
+	}}
+	s.keeper.SetEVMKeepers(evmKeeper, erc20Keeper, s.App.AccountKeeper)
+	return evmKeeper, erc20Keeper
+}
+
+func (s *KeeperTestSuite) evmCallSwapAndAction(poolID uint64, evmCall *customhookstypes.EVMCallInfo) *customhookstypes.SwapAndAction {
+	return &customhookstypes.SwapAndAction{
+		UserSwap: &customhookstypes.UserSwap{
+			SwapExactAssetIn: &customhookstypes.SwapExactAssetIn{
+				SwapVenueName: "bitbadges-poolmanager",
+				Operations: []customhookstypes.Operation{
+					{
+						Pool:     strconv.FormatUint(poolID, 10),
+						DenomIn:  sdk.DefaultBondDenom,
+						DenomOut: "uatom",
+					},
+				},
+			},
+		},
+		MinAsset: &customhookstypes.MinAsset{
+			Native: &customhookstypes.NativeAsset{
+				Denom:  "uatom",
+				Amount: "1000",
+			},
+		},
+		PostSwapAction: &customhookstypes.PostSwapAction{
+			EVMCall: evmCall,
+		},
+	}
+}
+
+// TestExecuteSwapAndAction_EVMCall_Send tests that send mode delivers the swap output to the contract and calls it
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_Send() {
+	evmKeeper, _ := s.setupEVMKeepers()
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[1]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		RecoverAddress:  recoverAddr.String(),
+	})
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	contractBalance := s.App.BankKeeper.GetBalance(s.Ctx, sdk.AccAddress(testEVMContract.Bytes()), "uatom")
+	s.Require().True(contractBalance.Amount.IsPositive(), "contract should have received the swap output")
+
+	s.Require().Len(evmKeeper.calls, 1)
+	s.Require().Equal(keeper.DeriveEVMCaller(sender), evmKeeper.calls[0].From)
+	s.Require().Equal(testEVMContract, evmKeeper.calls[0].Contract)
+	s.Require().Equal("deadbeef", hex.EncodeToString(evmKeeper.calls[0].Data))
+
+	// The EVM caller account is created so the call can be executed
+	s.Require().NotNil(s.App.AccountKeeper.GetAccount(s.Ctx, sdk.AccAddress(keeper.DeriveEVMCaller(sender).Bytes())))
+}
+
+// TestExecuteSwapAndAction_EVMCall_RevertFallsBack tests that a reverted call sends the swap output to the recover address
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_RevertFallsBack() {
+	evmKeeper, _ := s.setupEVMKeepers()
+	evmKeeper.revert[testEVMContract] = true
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[1]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		RecoverAddress:  recoverAddr.String(),
+	})
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	// The transfer to the contract is rolled back and the output goes to the recover address
+	contractBalance := s.App.BankKeeper.GetBalance(s.Ctx, sdk.AccAddress(testEVMContract.Bytes()), "uatom")
+	s.Require().True(contractBalance.Amount.IsZero())
+	recoverAfter := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	s.Require().True(recoverAfter.Amount.GT(recoverBefore.Amount), "recover address should have received the swap output")
+
+	s.Require().True(s.hasEvent("evm_call_fallback"))
+}
+
+// TestExecuteSwapAndAction_EVMCall_OutOfGasFallsBack tests that exceeding gas_limit falls back to the recover address
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_OutOfGasFallsBack() {
+	evmKeeper, _ := s.setupEVMKeepers()
+	evmKeeper.gasUsed = 200_000
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[1]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		GasLimit:        100_000,
+		RecoverAddress:  recoverAddr.String(),
+	})
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	contractBalance := s.App.BankKeeper.GetBalance(s.Ctx, sdk.AccAddress(testEVMContract.Bytes()), "uatom")
+	s.Require().True(contractBalance.Amount.IsZero())
+	recoverAfter := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	s.Require().True(recoverAfter.Amount.GT(recoverBefore.Amount))
+}
+
+// TestExecuteSwapAndAction_EVMCall_Approve tests that approve mode approves the contract and returns leftovers
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_Approve() {
+	evmKeeper, _ := s.setupEVMKeepers()
+	evmKeeper.gasUsed = 30_000
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[1]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+
+	// Simulate the contract pulling half of the approved amount via transferFrom
+	pulled := sdkmath.ZeroInt()
+	evmKeeper.onCall = func(ctx sdk.Context, from common.Address, contract common.Address, data []byte) error {
+		if contract != testEVMContract {
+			return nil
+		}
+		callerAcc := sdk.AccAddress(from.Bytes())
+		balance := s.App.BankKeeper.GetBalance(ctx, callerAcc, "uatom")
+		pulled = balance.Amount.QuoRaw(2)
+		return s.App.BankKeeper.SendCoins(ctx, callerAcc, sdk.AccAddress(contract.Bytes()), sdk.NewCoins(sdk.NewCoin("uatom", pulled)))
+	}
+
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		Mode:            customhookstypes.EVMCallModeApprove,
+		RecoverAddress:  recoverAddr.String(),
+	})
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	// approve(contract, amount) on the ERC20 token, then the contract call
+	s.Require().Len(evmKeeper.calls, 2)
+	approveCall := evmKeeper.calls[0]
+	s.Require().Equal(testERC20Token, approveCall.Contract)
+	s.Require().Equal("095ea7b3", hex.EncodeToString(approveCall.Data[:4]))
+	s.Require().Equal(testEVMContract, common.BytesToAddress(approveCall.Data[4:36]))
+	approvedAmount := new(big.Int).SetBytes(approveCall.Data[36:68])
+	s.Require().Equal(testEVMContract, evmKeeper.calls[1].Contract)
+
+	// Both calls share gas_limit, so the contract call only gets what the approve did not use
+	s.Require().Equal(approveCall.GasCap-evmKeeper.gasUsed, evmKeeper.calls[1].GasCap)
+
+	contractBalance := s.App.BankKeeper.GetBalance(s.Ctx, sdk.AccAddress(testEVMContract.Bytes()), "uatom")
+	s.Require().Equal(pulled, contractBalance.Amount)
+
+	// Leftover is swept to the recover address and nothing remains with the EVM caller
+	recoverAfter := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	s.Require().Equal(sdkmath.NewIntFromBigInt(approvedAmount).Sub(pulled), recoverAfter.Amount.Sub(recoverBefore.Amount))
+	callerBalance := s.App.BankKeeper.GetBalance(s.Ctx, sdk.AccAddress(keeper.DeriveEVMCaller(sender).Bytes()), "uatom")
+	s.Require().True(callerBalance.Amount.IsZero())
+}
+
+// TestExecuteSwapAndAction_EVMCall_ApproveUsesAllGasFallsBack tests that an approve using the whole gas limit
+// does not leave the contract call with its own full gas limit
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_ApproveUsesAllGasFallsBack() {
+	evmKeeper, _ := s.setupEVMKeepers()
+	evmKeeper.gasUsed = 100_000
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[1]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		Mode:            customhookstypes.EVMCallModeApprove,
+		GasLimit:        100_000,
+		RecoverAddress:  recoverAddr.String(),
+	})
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	// Only the approve ran, and the output went to the recover address
+	s.Require().Len(evmKeeper.calls, 1)
+	s.Require().Equal(testERC20Token, evmKeeper.calls[0].Contract)
+	recoverAfter := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	s.Require().True(recoverAfter.Amount.GT(recoverBefore.Amount))
+}
+
+// TestExecuteSwapAndAction_EVMCall_ApproveNoTokenPair tests that approve mode without an ERC20 pair falls back
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_ApproveNoTokenPair() {
+	evmKeeper, erc20Keeper := s.setupEVMKeepers()
+	delete(erc20Keeper.pairs, "uatom")
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[1]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		Mode:            customhookstypes.EVMCallModeApprove,
+		RecoverAddress:  recoverAddr.String(),
+	})
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	s.Require().Empty(evmKeeper.calls)
+	recoverAfter := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	s.Require().True(recoverAfter.Amount.GT(recoverBefore.Amount))
+}
+
+// TestExecuteSwapAndAction_EVMCall_Validation tests that invalid evm_call actions are rejected before the swap
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_Validation() {
+	s.setupEVMKeepers()
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[1]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+
+	tests := []struct {
+		name     string
+		evmCall  *customhookstypes.EVMCallInfo
+		expError string
+	}{
+		{
+			name:     "missing contract address",
+			evmCall:  &customhookstypes.EVMCallInfo{Calldata: testCalldata, RecoverAddress: recoverAddr.String()},
+			expError: "evm_call.contract_address is required",
+		},
+		{
+			name:     "not a contract",
+			evmCall:  &customhookstypes.EVMCallInfo{ContractAddress: "0x3333333333333333333333333333333333333333", Calldata: testCalldata, RecoverAddress: recoverAddr.String()},
+			expError: "is not a contract",
+		},
+		{
+			name:     "invalid calldata",
+			evmCall:  &customhookstypes.EVMCallInfo{ContractAddress: testEVMContract.Hex(), Calldata: "0xzz", RecoverAddress: recoverAddr.String()},
+			expError: "invalid evm_call.calldata",
+		},
+		{
+			name:     "invalid mode",
+			evmCall:  &customhookstypes.EVMCallInfo{ContractAddress: testEVMContract.Hex(), Calldata: testCalldata, Mode: "delegatecall", RecoverAddress: recoverAddr.String()},
+			expError: "invalid evm_call.mode",
+		},
+		{
+			name:     "gas limit too high",
+			evmCall:  &customhookstypes.EVMCallInfo{ContractAddress: testEVMContract.Hex(), Calldata: testCalldata, GasLimit: customhookstypes.MaxEVMCallGasLimit + 1, RecoverAddress: recoverAddr.String()},
+			expError: "evm_call.gas_limit cannot exceed",
+		},
+		{
+			name:     "missing recover address",
+			evmCall:  &customhookstypes.EVMCallInfo{ContractAddress: testEVMContract.Hex(), Calldata: testCalldata},
+			expError: "evm_call.recover_address is required",
+		},
+	}
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, sdk.DefaultBondDenom)
+
+			ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, s.evmCallSwapAndAction(poolID, tc.evmCall), tokenIn, sender.String())
+			s.Require().False(ack.Success())
+			s.Require().Contains(getAckError(ack), tc.expError)
+
+			// Validation happens before the swap
+			balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, sender, sdk.DefaultBondDenom)
+			s.Require().Equal(balanceBefore, balanceAfter)
+		})
+	}
+
+	// evm_call cannot be combined with another post-swap action
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		RecoverAddress:  recoverAddr.String(),
+	})
+	swapAndAction.PostSwapAction.Transfer = &customhookstypes.TransferInfo{ToAddress: recoverAddr.String()}
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().False(ack.Success())
+	s.Require().Contains(getAckError(ack), "must specify exactly one")
+}
+
+// TestExecuteSwapAndAction_EVMCall_NoEVMKeeper tests that evm_call is rejected when the EVM keepers are not set
+func (s *KeeperTestSuite) TestExecuteSwapAndAction_EVMCall_NoEVMKeeper() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	s.FundAcc(sender, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000))})
+
+	swapAndAction := s.evmCallSwapAndAction(poolID, &customhookstypes.EVMCallInfo{
+		ContractAddress: testEVMContract.Hex(),
+		Calldata:        testCalldata,
+		RecoverAddress:  s.TestAccs[1].String(),
+	})
+
+	tokenIn := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(100000))
+	ack := s.keeper.ExecuteSwapAndAction(s.Ctx, sender, swapAndAction, tokenIn, sender.String())
+	s.Require().False(ack.Success())
+	s.Require().Contains(getAckError(ack), "EVM is not available")
+}
+
+func (s *KeeperTestSuite) hasEvent(eventType string) bool {
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -14,6 +15,10 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
 	"github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
@@ -28,6 +33,8 @@ type (
 		GetCFMMPool(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error)
 		SwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, pool poolmanagertypes.PoolI, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount osmomath.Int, spreadFactor osmomath.Dec, affiliates []poolmanagertypes.Affiliate) (osmomath.Int, error)
 		RouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount osmomath.Int, affiliates []poolmanagertypes.Affiliate) (osmomath.Int, error)
+		JoinSwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutMinAmount osmomath.Int) (osmomath.Int, error)
+		ExitSwapShareAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenOutDenom string, shareInAmount osmomath.Int, tokenOutMinAmount osmomath.Int) (osmomath.Int, error)
 	}
 
 	// BankKeeper interface for bank module
@@ -51,6 +58,7 @@ type (
 		SendCoinWithAliasRouting(ctx sdk.Context, fromAddressAcc sdk.AccAddress, toAddressAcc sdk.AccAddress, coin *sdk.Coin) error
 		IsICS20Compatible(ctx sdk.Context, denom string) bool
 		StandardName(ctx sdk.Context, denom string) string
+		GetBalanceWithAliasRouting(ctx sdk.Context, address sdk.AccAddress, denom string) (sdk.Coin, error)
 	}
 
 	// ICS4Wrapper interface for sending IBC packets (IBC v10: capabilities removed)
@@ -73,6 +81,28 @@ type (
 	// TokenizationMsgServer interface for executing tokenization messages
 	TokenizationMsgServer interface {
 		TransferTokens(ctx context.Context, msg *tokenizationtypes.MsgTransferTokens) (*tokenizationtypes.MsgTransferTokensResponse, error)
+		SetDynamicStoreValue(ctx context.Context, msg *tokenizationtypes.MsgSetDynamicStoreValue) (*tokenizationtypes.MsgSetDynamicStoreValueResponse, error)
+		SetIncomingApproval(ctx context.Context, msg *tokenizationtypes.MsgSetIncomingApproval) (*tokenizationtypes.MsgSetIncomingApprovalResponse, error)
+		CastVote(ctx context.Context, msg *tokenizationtypes.MsgCastVote) (*tokenizationtypes.MsgCastVoteResponse, error)
+	}
+
+	// EVMKeeper interface for executing EVM contract calls (evm_call post-swap action)
+	EVMKeeper interface {
+		IsContract(ctx sdk.Context, addr common.Address) bool
+		CallEVMWithData(ctx sdk.Context, stateDB *statedb.StateDB, from common.Address, contract *common.Address, data []byte, commit bool, callFromPrecompile bool, gasCap *big.Int) (*evmtypes.MsgEthereumTxResponse, error)
+	}
+
+	// ERC20Keeper interface for looking up the ERC20 token pair of a denom (evm_call approve mode)
+	ERC20Keeper interface {
+		GetTokenPairID(ctx sdk.Context, token string) []byte
+		GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	}
+
+	// AccountKeeper interface for creating the EVM caller account (evm_call post-swap action)
+	AccountKeeper interface {
+		GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+		NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+		SetAccount(ctx context.Context, acc sdk.AccountI)
 	}
 
 	Keeper struct {
@@ -85,6 +115,12 @@ type (
 		ics4Wrapper            ICS4Wrapper
 		channelKeeper          ChannelKeeper
 		tokenizationMsgServer  TokenizationMsgServer
+
+		// evmKeeper, erc20Keeper and accountKeeper are optional and set via SetEVMKeepers once the EVM modules are created.
+		// Pointers so value-copies of the Keeper (e.g., inside CustomHooks) share the same references.
+		evmKeeper     *EVMKeeper
+		erc20Keeper   *ERC20Keeper
+		accountKeeper *AccountKeeper
 	}
 )
 
@@ -109,9 +145,20 @@ func NewKeeper(
 		ics4Wrapper:            ics4Wrapper,
 		channelKeeper:          channelKeeper,
 		tokenizationMsgServer:  tokenizationMsgServer,
+		evmKeeper:              new(EVMKeeper),
+		erc20Keeper:            new(ERC20Keeper),
+		accountKeeper:          new(AccountKeeper),
 	}
 }
 
+// SetEVMKeepers sets the EVM, ERC20 and account keepers used by the evm_call post-swap action.
+// Must be called after the EVM modules are created; evm_call is rejected until then.
+func (k *Keeper) SetEVMKeepers(evmKeeper EVMKeeper, erc20Keeper ERC20Keeper, accountKeeper AccountKeeper) {
+	*k.evmKeeper = evmKeeper
+	*k.erc20Keeper = erc20Keeper
+	*k.accountKeeper = accountKeeper
+}
+
 // Logger returns a logger for the x/custom-hooks module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -138,6 +185,36 @@ func (k Keeper) ExecuteHook(ctx sdk.Context, sender sdk.AccAddress, hookData *ty
 		return k.ExecuteTransferTokens(ctx, sender, hookData.TransferTokens, tokenIn, originalSender)
 	}
 
+	// Execute JoinPool
+	if hookData.JoinPool != nil {
+		return k.ExecuteJoinPool(ctx, sender, hookData.JoinPool, tokenIn, originalSender)
+	}
+
+	// Execute ExitPool
+	if hookData.ExitPool != nil {
+		return k.ExecuteExitPool(ctx, sender, hookData.ExitPool, tokenIn, originalSender)
+	}
+
+	// Execute SetDynamicStoreValue
+	if hookData.SetDynamicStoreValue != nil {
+		return k.ExecuteSetDynamicStoreValue(ctx, sender, hookData.SetDynamicStoreValue, tokenIn, originalSender)
+	}
+
+	// Execute SetIncomingApproval
+	if hookData.SetIncomingApproval != nil {
+		return k.ExecuteSetIncomingApproval(ctx, sender, hookData.SetIncomingApproval, tokenIn, originalSender)
+	}
+
+	// Execute CastVote
+	if hookData.CastVote != nil {
+		return k.ExecuteCastVote(ctx, sender, hookData.CastVote, tokenIn, originalSender)
+	}
+
+	// Execute Actions
+	if hookData.Actions != nil {
+		return k.ExecuteActions(ctx, sender, hookData.Actions, hookData.RecoverAddress, tokenIn, originalSender)
+	}
+
 	return types.NewSuccessAcknowledgement()
 }
 
@@ -147,19 +224,23 @@ func (k Keeper) ExecuteSwapAndAction(ctx sdk.Context, sender sdk.AccAddress, swa
 		return types.NewSuccessAcknowledgement()
 	}
 
-	// Validate: post_swap_action is required and must have exactly one of IBCTransfer or Transfer
+	// Validate: post_swap_action is required and must have exactly one of IBCTransfer, Transfer or EVMCall
 	if swapAndAction.PostSwapAction == nil {
-		return types.NewCustomErrorAcknowledgement("post_swap_action is required and must have either ibc_transfer or transfer")
+		return types.NewCustomErrorAcknowledgement("post_swap_action is required and must have either ibc_transfer or transfer, or evm_call")
 	}
 
-	// Validate that exactly one of IBCTransfer or Transfer is set
+	// Validate that exactly one of IBCTransfer, Transfer or EVMCall is set
 	hasIBCTransfer := swapAndAction.PostSwapAction.IBCTransfer != nil
 	hasTransfer := swapAndAction.PostSwapAction.Transfer != nil
+	hasEVMCall := swapAndAction.PostSwapAction.EVMCall != nil
 	if hasIBCTransfer && hasTransfer {
 		return types.NewCustomErrorAcknowledgement("post_swap_action cannot have both ibc_transfer and transfer; must specify exactly one")
 	}
-	if !hasIBCTransfer && !hasTransfer {
-		return types.NewCustomErrorAcknowledgement("post_swap_action must have either ibc_transfer or transfer")
+	if hasEVMCall && (hasIBCTransfer || hasTransfer) {
+		return types.NewCustomErrorAcknowledgement("post_swap_action cannot have evm_call with ibc_transfer or transfer; must specify exactly one")
+	}
+	if !hasIBCTransfer && !hasTransfer && !hasEVMCall {
+		return types.NewCustomErrorAcknowledgement("post_swap_action must have either ibc_transfer or transfer, or evm_call")
 	}
 
 	// Validate: if post_swap_action is defined, a swap must also be defined
@@ -406,8 +487,10 @@ func (k Keeper) ExecuteSwapAndAction(ctx sdk.Context, sender sdk.AccAddress, swa
 		tokenIn = sdk.NewCoin(lastOperation.DenomOut, tokenOut)
 	}
 
-	// Execute post-swap action (IBC transfer or local transfer)
-	if swapAndAction.PostSwapAction.IBCTransfer != nil {
+	// Execute post-swap action (IBC transfer, local transfer or EVM call)
+	if swapAndAction.PostSwapAction.EVMCall != nil {
+		return k.ExecuteEVMCall(ctx, sender, swapAndAction.PostSwapAction.EVMCall, tokenIn, originalSender)
+	} else if swapAndAction.PostSwapAction.IBCTransfer != nil {
 		// Check if attempting to IBC transfer a non-ICS20 compatible denomination
 		if !k.sendManagerKeeper.IsICS20Compatible(ctx, tokenIn.Denom) {
 			standardName := k.sendManagerKeeper.StandardName(ctx, tokenIn.Denom)
@@ -524,6 +607,14 @@ func (k Keeper) ValidatePostSwapAction(ctx sdk.Context, postSwapAction *types.Po
 		// IBC v10: Capabilities removed - channel validation is handled by IBC core
 	}
 
+	// Validate EVM call if present
+	if postSwapAction.EVMCall != nil {
+		ack := k.ValidateEVMCall(ctx, postSwapAction.EVMCall)
+		if !ack.Success() {
+			return ack
+		}
+	}
+
 	// Validate local transfer if present
 	if postSwapAction.Transfer != nil {
 		if postSwapAction.Transfer.ToAddress == "" {
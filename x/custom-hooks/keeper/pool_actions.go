@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+)
+
+// ExecuteJoinPool executes a JoinPoolAction hook.
+// It single-asset joins the pool with the received tokens on behalf of the IBC intermediate sender
+// and sends the pool shares to to_address. On failure, the received tokens go to recover_address.
+func (k Keeper) ExecuteJoinPool(ctx sdk.Context, sender sdk.AccAddress, action *types.JoinPoolAction, tokenIn sdk.Coin, originalSender string) ibcexported.Acknowledgement {
+	if action == nil {
+		return types.NewSuccessAcknowledgement()
+	}
+
+	// 1. Early validation
+	poolId, err := strconv.ParseUint(action.PoolId, 10, 64)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid join_pool pool_id: %s", action.PoolId))
+	}
+
+	shareOutMinAmount, ok := osmomath.NewIntFromString(action.ShareOutMinAmount)
+	if !ok || !shareOutMinAmount.IsPositive() {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid join_pool share_out_min_amount: %s; must be a positive integer", action.ShareOutMinAmount))
+	}
+
+	if ack := validatePoolActionAddresses("join_pool", action.ToAddress, action.RecoverAddress); !ack.Success() {
+		return ack
+	}
+
+	pool, err := k.gammKeeper.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("join_pool pool not found: %d", poolId))
+	}
+
+	// 2. Execute join in a cached context for atomicity
+	cacheCtx, writeCache := ctx.CacheContext()
+	types.ClearDeterministicError(cacheCtx)
+
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	sharesOut, err := k.executeJoinPoolInContext(cacheCtx, sender, pool.GetId(), tokenIn, shareOutMinAmount, action.ToAddress)
+	if err != nil {
+		k.Logger(ctx).Error("custom-hooks: join_pool failed", "error", err, "sender", sender.String(), "original_sender", originalSender, "pool_id", poolId, "token_in", tokenIn.String())
+
+		joinErrMsg := fmt.Sprintf("join_pool failed: pool_id=%d, sender=%s (derived from %s), token_in=%s", poolId, sender.String(), originalSender, tokenIn.String())
+
+		// Only use deterministic error from transient store — never use err.Error() directly
+		if detErrMsg, found := types.GetDeterministicError(cacheCtx); found {
+			joinErrMsg = fmt.Sprintf("%s: %s", joinErrMsg, detErrMsg)
+		}
+
+		extraAttrs := []sdk.Attribute{
+			sdk.NewAttribute("pool_id", action.PoolId),
+		}
+		return k.ExecuteFallbackToRecoverAddress(ctx, sender, action.RecoverAddress, tokenIn, originalSender, joinErrMsg, "join_pool_fallback", extraAttrs)
+	}
+
+	// 3. Join succeeded — commit cache
+	writeCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"join_pool_success",
+		sdk.NewAttribute("module", "custom-hooks"),
+		sdk.NewAttribute("sender", sender.String()),
+		sdk.NewAttribute("original_sender", originalSender),
+		sdk.NewAttribute("pool_id", action.PoolId),
+		sdk.NewAttribute("token_in", tokenIn.String()),
+		sdk.NewAttribute("shares_out", sdk.NewCoin(shareDenom, sharesOut).String()),
+		sdk.NewAttribute("to_address", action.ToAddress),
+	))
+
+	return types.NewSuccessAcknowledgement()
+}
+
+func (k Keeper) executeJoinPoolInContext(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, shareOutMinAmount osmomath.Int, toAddress string) (osmomath.Int, error) {
+	// Set up auto-approve for intermediate address if joining with a wrapped denom
+	if k.tokenizationKeeper.CheckIsAliasDenom(ctx, tokenIn.Denom) {
+		ack := k.setAutoApproveForIntermediateAddress(ctx, sender.String(), tokenIn.Denom)
+		if !ack.Success() {
+			return osmomath.Int{}, types.WrapErr(&ctx, types.ErrSetAutoApproveFailed, "denom: %s", tokenIn.Denom)
+		}
+	}
+
+	sharesOut, err := k.gammKeeper.JoinSwapExactAmountIn(ctx, sender, poolId, sdk.Coins{tokenIn}, shareOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// Within a multi-action memo, an empty to_address keeps the shares at the intermediate sender for the next action
+	if toAddress == "" {
+		return sharesOut, nil
+	}
+
+	shares := sdk.NewCoin(gammtypes.GetPoolShareDenom(poolId), sharesOut)
+	ack := k.ExecuteLocalTransfer(ctx, sender, &types.TransferInfo{ToAddress: toAddress}, shares)
+	if !ack.Success() {
+		ackErrMsg, _ := types.GetAckError(ack)
+		return osmomath.Int{}, types.WrapErr(&ctx, types.ErrSendCoinsFailed, "failed to send %s to to_address: %s", shares.String(), ackErrMsg)
+	}
+
+	return sharesOut, nil
+}
+
+// ExecuteExitPool executes an ExitPoolAction hook.
+// It exits the pool with the received pool shares into token_out_denom on behalf of the IBC
+// intermediate sender and sends the tokens out to to_address. On failure, the received shares
+// go to recover_address.
+func (k Keeper) ExecuteExitPool(ctx sdk.Context, sender sdk.AccAddress, action *types.ExitPoolAction, tokenIn sdk.Coin, originalSender string) ibcexported.Acknowledgement {
+	if action == nil {
+		return types.NewSuccessAcknowledgement()
+	}
+
+	// 1. Early validation
+	poolId, err := strconv.ParseUint(action.PoolId, 10, 64)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid exit_pool pool_id: %s", action.PoolId))
+	}
+
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	if tokenIn.Denom != shareDenom {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("exit_pool requires %s shares, got: %s", shareDenom, tokenIn.Denom))
+	}
+
+	if action.TokenOutDenom == "" {
+		return types.NewCustomErrorAcknowledgement("exit_pool token_out_denom is required")
+	}
+
+	tokenOutMinAmount, ok := osmomath.NewIntFromString(action.TokenOutMinAmount)
+	if !ok || !tokenOutMinAmount.IsPositive() {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid exit_pool token_out_min_amount: %s; must be a positive integer", action.TokenOutMinAmount))
+	}
+
+	if ack := validatePoolActionAddresses("exit_pool", action.ToAddress, action.RecoverAddress); !ack.Success() {
+		return ack
+	}
+
+	pool, err := k.gammKeeper.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("exit_pool pool not found: %d", poolId))
+	}
+
+	// 2. Execute exit in a cached context for atomicity
+	cacheCtx, writeCache := ctx.CacheContext()
+	types.ClearDeterministicError(cacheCtx)
+
+	tokenOutAmount, err := k.executeExitPoolInContext(cacheCtx, sender, pool.GetId(), pool.GetTotalPoolLiquidity(ctx).Denoms(), tokenIn, action.TokenOutDenom, tokenOutMinAmount, action.ToAddress)
+	if err != nil {
+		k.Logger(ctx).Error("custom-hooks: exit_pool failed", "error", err, "sender", sender.String(), "original_sender", originalSender, "pool_id", poolId, "token_in", tokenIn.String())
+
+		exitErrMsg := fmt.Sprintf("exit_pool failed: pool_id=%d, sender=%s (derived from %s), token_in=%s, token_out_denom=%s", poolId, sender.String(), originalSender, tokenIn.String(), action.TokenOutDenom)
+
+		// Only use deterministic error from transient store — never use err.Error() directly
+		if detErrMsg, found := types.GetDeterministicError(cacheCtx); found {
+			exitErrMsg = fmt.Sprintf("%s: %s", exitErrMsg, detErrMsg)
+		}
+
+		extraAttrs := []sdk.Attribute{
+			sdk.NewAttribute("pool_id", action.PoolId),
+			sdk.NewAttribute("token_out_denom", action.TokenOutDenom),
+		}
+		return k.ExecuteFallbackToRecoverAddress(ctx, sender, action.RecoverAddress, tokenIn, originalSender, exitErrMsg, "exit_pool_fallback", extraAttrs)
+	}
+
+	// 3. Exit succeeded — commit cache
+	writeCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"exit_pool_success",
+		sdk.NewAttribute("module", "custom-hooks"),
+		sdk.NewAttribute("sender", sender.String()),
+		sdk.NewAttribute("original_sender", originalSender),
+		sdk.NewAttribute("pool_id", action.PoolId),
+		sdk.NewAttribute("token_in", tokenIn.String()),
+		sdk.NewAttribute("token_out", sdk.NewCoin(action.TokenOutDenom, tokenOutAmount).String()),
+		sdk.NewAttribute("to_address", action.ToAddress),
+	))
+
+	return types.NewSuccessAcknowledgement()
+}
+
+func (k Keeper) executeExitPoolInContext(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, poolDenoms []string, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount osmomath.Int, toAddress string) (osmomath.Int, error) {
+	// Exiting pays out every pool asset to the intermediate address before swapping into
+	// token_out_denom, so auto-approve is needed for all wrapped denoms in the pool
+	for _, denom := range poolDenoms {
+		if k.tokenizationKeeper.CheckIsAliasDenom(ctx, denom) {
+			ack := k.setAutoApproveForIntermediateAddress(ctx, sender.String(), denom)
+			if !ack.Success() {
+				return osmomath.Int{}, types.WrapErr(&ctx, types.ErrSetAutoApproveFailed, "denom: %s", denom)
+			}
+		}
+	}
+
+	tokenOutAmount, err := k.gammKeeper.ExitSwapShareAmountIn(ctx, sender, poolId, tokenOutDenom, tokenIn.Amount, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// Within a multi-action memo, an empty to_address keeps the tokens at the intermediate sender for the next action
+	if toAddress == "" {
+		return tokenOutAmount, nil
+	}
+
+	tokenOut := sdk.NewCoin(tokenOutDenom, tokenOutAmount)
+	ack := k.ExecuteLocalTransfer(ctx, sender, &types.TransferInfo{ToAddress: toAddress}, tokenOut)
+	if !ack.Success() {
+		ackErrMsg, _ := types.GetAckError(ack)
+		return osmomath.Int{}, types.WrapErr(&ctx, types.ErrSendCoinsFailed, "failed to send %s to to_address: %s", tokenOut.String(), ackErrMsg)
+	}
+
+	return tokenOutAmount, nil
+}
+
+// validatePoolActionAddresses validates the to_address and recover_address of a pool hook action
+func validatePoolActionAddresses(actionName string, toAddress string, recoverAddress string) ibcexported.Acknowledgement {
+	if toAddress == "" {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("%s to_address is required", actionName))
+	}
+	if _, err := sdk.AccAddressFromBech32(toAddress); err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid %s to_address: %s", actionName, toAddress))
+	}
+
+	// A recover address is always required so a failed join/exit never strands the received tokens
+	if recoverAddress == "" {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("%s recover_address is required", actionName))
+	}
+	if _, err := sdk.AccAddressFromBech32(recoverAddress); err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid %s recover_address: %s", actionName, recoverAddress))
+	}
+
+	return types.NewSuccessAcknowledgement()
+}
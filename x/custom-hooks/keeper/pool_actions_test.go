@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	customhookstypes "github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+)
+
+// TestParsePoolActionMemo tests parsing of join_pool and exit_pool memos
+func TestParsePoolActionMemo(t *testing.T) {
+	t.Run("valid join_pool memo", func(t *testing.T) {
+		memo := `{"join_pool": {"pool_id": "1", "share_out_min_amount": "100", "to_address": "bb1to", "recover_address": "bb1recover"}}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.NoError(t, err)
+		require.NotNil(t, hookData)
+		require.NotNil(t, hookData.JoinPool)
+		require.Nil(t, hookData.ExitPool)
+		require.Equal(t, "1", hookData.JoinPool.PoolId)
+		require.Equal(t, "100", hookData.JoinPool.ShareOutMinAmount)
+		require.Equal(t, "bb1to", hookData.JoinPool.ToAddress)
+		require.Equal(t, "bb1recover", hookData.JoinPool.RecoverAddress)
+	})
+
+	t.Run("valid exit_pool memo", func(t *testing.T) {
+		memo := `{"exit_pool": {"pool_id": "2", "token_out_denom": "uatom", "token_out_min_amount": "5", "to_address": "bb1to", "recover_address": "bb1recover"}}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.NoError(t, err)
+		require.NotNil(t, hookData)
+		require.NotNil(t, hookData.ExitPool)
+		require.Nil(t, hookData.JoinPool)
+		require.Equal(t, "2", hookData.ExitPool.PoolId)
+		require.Equal(t, "uatom", hookData.ExitPool.TokenOutDenom)
+		require.Equal(t, "5", hookData.ExitPool.TokenOutMinAmount)
+	})
+
+	t.Run("mutual exclusivity — join_pool with another hook", func(t *testing.T) {
+		memo := `{"transfer_tokens": {"collection_id": "1", "transfers": []}, "join_pool": {"pool_id": "1"}}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.Error(t, err)
+		require.Nil(t, hookData)
+		require.Contains(t, err.Error(), "transfer_tokens and join_pool")
+	})
+}
+
+// TestExecuteJoinPool tests single-asset joining a pool and sending the shares to to_address
+func (s *KeeperTestSuite) TestExecuteJoinPool() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	tokenIn := sdk.NewCoin("uatom", osmomath.NewInt(100000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	sharesBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom)
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		JoinPool: &customhookstypes.JoinPoolAction{
+			PoolId:            strconv.FormatUint(poolID, 10),
+			ShareOutMinAmount: "1",
+			ToAddress:         toAddr.String(),
+			RecoverAddress:    recoverAddr.String(),
+		},
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	// Shares go to to_address; none are left with the intermediate sender
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, toAddr, shareDenom).Amount.IsPositive())
+	s.Require().Equal(sharesBefore, s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom))
+	s.Require().True(s.hasEvent("join_pool_success"))
+}
+
+// TestExecuteJoinPool_MinSharesFallsBack tests that an unmet share_out_min_amount sends the tokens to recover_address
+func (s *KeeperTestSuite) TestExecuteJoinPool_MinSharesFallsBack() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	tokenIn := sdk.NewCoin("uatom", osmomath.NewInt(100000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		JoinPool: &customhookstypes.JoinPoolAction{
+			PoolId:            strconv.FormatUint(poolID, 10),
+			ShareOutMinAmount: "1000000000000000000000000",
+			ToAddress:         toAddr.String(),
+			RecoverAddress:    recoverAddr.String(),
+		},
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, toAddr, shareDenom).Amount.IsZero())
+	recoverAfter := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	s.Require().Equal(tokenIn.Amount, recoverAfter.Amount.Sub(recoverBefore.Amount))
+	s.Require().True(s.hasEvent("join_pool_fallback"))
+}
+
+// TestExecuteJoinPool_Validation tests that invalid join_pool actions are rejected
+func (s *KeeperTestSuite) TestExecuteJoinPool_Validation() {
+	poolID := strconv.FormatUint(s.prepareTestPool(), 10)
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1].String()
+	recoverAddr := s.TestAccs[2].String()
+	tokenIn := sdk.NewCoin("uatom", osmomath.NewInt(100000))
+
+	tests := []struct {
+		name     string
+		action   *customhookstypes.JoinPoolAction
+		expError string
+	}{
+		{
+			name:     "invalid pool id",
+			action:   &customhookstypes.JoinPoolAction{PoolId: "abc", ShareOutMinAmount: "1", ToAddress: toAddr, RecoverAddress: recoverAddr},
+			expError: "invalid join_pool pool_id",
+		},
+		{
+			name:     "pool not found",
+			action:   &customhookstypes.JoinPoolAction{PoolId: "999", ShareOutMinAmount: "1", ToAddress: toAddr, RecoverAddress: recoverAddr},
+			expError: "join_pool pool not found",
+		},
+		{
+			name:     "zero min shares",
+			action:   &customhookstypes.JoinPoolAction{PoolId: poolID, ShareOutMinAmount: "0", ToAddress: toAddr, RecoverAddress: recoverAddr},
+			expError: "invalid join_pool share_out_min_amount",
+		},
+		{
+			name:     "missing to address",
+			action:   &customhookstypes.JoinPoolAction{PoolId: poolID, ShareOutMinAmount: "1", RecoverAddress: recoverAddr},
+			expError: "join_pool to_address is required",
+		},
+		{
+			name:     "missing recover address",
+			action:   &customhookstypes.JoinPoolAction{PoolId: poolID, ShareOutMinAmount: "1", ToAddress: toAddr},
+			expError: "join_pool recover_address is required",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ack := s.keeper.ExecuteJoinPool(s.Ctx, sender, tc.action, tokenIn, sender.String())
+			s.Require().False(ack.Success())
+			s.Require().Contains(getAckError(ack), tc.expError)
+		})
+	}
+}
+
+// TestExecuteExitPool tests exiting a pool with received shares into a single asset
+func (s *KeeperTestSuite) TestExecuteExitPool() {
+	poolID := s.prepareTestPool()
+
+	// The pool creator holds shares; use a portion of them as the received tokens
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	shares := s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom)
+	tokenIn := sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(10))
+
+	toBefore := s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom")
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		ExitPool: &customhookstypes.ExitPoolAction{
+			PoolId:            strconv.FormatUint(poolID, 10),
+			TokenOutDenom:     "uatom",
+			TokenOutMinAmount: "1",
+			ToAddress:         toAddr.String(),
+			RecoverAddress:    recoverAddr.String(),
+		},
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	toAfter := s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom")
+	s.Require().True(toAfter.Amount.GT(toBefore.Amount))
+	s.Require().Equal(shares.Amount.Sub(tokenIn.Amount), s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom).Amount)
+	s.Require().True(s.hasEvent("exit_pool_success"))
+}
+
+// TestExecuteExitPool_MinOutFallsBack tests that an unmet token_out_min_amount sends the shares to recover_address
+func (s *KeeperTestSuite) TestExecuteExitPool_MinOutFallsBack() {
+	poolID := s.prepareTestPool()
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	shares := s.App.BankKeeper.GetBalance(s.Ctx, sender, shareDenom)
+	tokenIn := sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(10))
+
+	toBefore := s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom")
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		ExitPool: &customhookstypes.ExitPoolAction{
+			PoolId:            strconv.FormatUint(poolID, 10),
+			TokenOutDenom:     "uatom",
+			TokenOutMinAmount: "1000000000",
+			ToAddress:         toAddr.String(),
+			RecoverAddress:    recoverAddr.String(),
+		},
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	s.Require().Equal(toBefore, s.App.BankKeeper.GetBalance(s.Ctx, toAddr, "uatom"))
+	s.Require().Equal(tokenIn, s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, shareDenom))
+	s.Require().True(s.hasEvent("exit_pool_fallback"))
+}
+
+// TestExecuteExitPool_Validation tests that invalid exit_pool actions are rejected
+func (s *KeeperTestSuite) TestExecuteExitPool_Validation() {
+	poolID := s.prepareTestPool()
+	poolIDStr := strconv.FormatUint(poolID, 10)
+
+	sender := s.TestAccs[0]
+	toAddr := s.TestAccs[1].String()
+	recoverAddr := s.TestAccs[2].String()
+	tokenIn := sdk.NewCoin(gammtypes.GetPoolShareDenom(poolID), osmomath.NewInt(1000))
+
+	tests := []struct {
+		name     string
+		action   *customhookstypes.ExitPoolAction
+		tokenIn  sdk.Coin
+		expError string
+	}{
+		{
+			name:     "not pool shares",
+			action:   &customhookstypes.ExitPoolAction{PoolId: poolIDStr, TokenOutDenom: "uatom", TokenOutMinAmount: "1", ToAddress: toAddr, RecoverAddress: recoverAddr},
+			tokenIn:  sdk.NewCoin("uatom", osmomath.NewInt(1000)),
+			expError: "exit_pool requires",
+		},
+		{
+			name:     "missing token out denom",
+			action:   &customhookstypes.ExitPoolAction{PoolId: poolIDStr, TokenOutMinAmount: "1", ToAddress: toAddr, RecoverAddress: recoverAddr},
+			tokenIn:  tokenIn,
+			expError: "exit_pool token_out_denom is required",
+		},
+		{
+			name:     "missing min out",
+			action:   &customhookstypes.ExitPoolAction{PoolId: poolIDStr, TokenOutDenom: "uatom", ToAddress: toAddr, RecoverAddress: recoverAddr},
+			tokenIn:  tokenIn,
+			expError: "invalid exit_pool token_out_min_amount",
+		},
+		{
+			name:     "invalid recover address",
+			action:   &customhookstypes.ExitPoolAction{PoolId: poolIDStr, TokenOutDenom: "uatom", TokenOutMinAmount: "1", ToAddress: toAddr, RecoverAddress: "invalid"},
+			tokenIn:  tokenIn,
+			expError: "invalid exit_pool recover_address",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ack := s.keeper.ExecuteExitPool(s.Ctx, sender, tc.action, tc.tokenIn, sender.String())
+			s.Require().False(ack.Success())
+			s.Require().Contains(getAckError(ack), tc.expError)
+		})
+	}
+}
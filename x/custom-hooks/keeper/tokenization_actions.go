@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+)
+
+// ExecuteSetDynamicStoreValue executes a SetDynamicStoreValueAction hook.
+// It sets a dynamic store value on behalf of the IBC intermediate sender, which must be the store creator.
+func (k Keeper) ExecuteSetDynamicStoreValue(ctx sdk.Context, sender sdk.AccAddress, action *types.SetDynamicStoreValueAction, tokenIn sdk.Coin, originalSender string) ibcexported.Acknowledgement {
+	if action == nil {
+		return types.NewSuccessAcknowledgement()
+	}
+
+	// 1. Early validation
+	msg, err := newSetDynamicStoreValueMsg(sender, action)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(err.Error())
+	}
+
+	if ack := validateFailOnError(action.FailOnError, action.RecoverAddress); !ack.Success() {
+		return ack
+	}
+
+	// 2. Execute
+	extraAttrs := []sdk.Attribute{
+		sdk.NewAttribute("store_id", action.StoreId),
+		sdk.NewAttribute("address", action.Address),
+		sdk.NewAttribute("value", strconv.FormatBool(action.Value)),
+	}
+	return k.executeTokenizationAction(ctx, sender, "set_dynamic_store_value", fmt.Sprintf("store=%s", action.StoreId), action.FailOnError, action.RecoverAddress, tokenIn, originalSender, extraAttrs, func(cacheCtx sdk.Context) error {
+		_, err := k.tokenizationMsgServer.SetDynamicStoreValue(cacheCtx, msg)
+		return err
+	})
+}
+
+// ExecuteSetIncomingApproval executes a SetIncomingApprovalAction hook.
+// It sets an incoming approval for the IBC intermediate sender on the given collection.
+func (k Keeper) ExecuteSetIncomingApproval(ctx sdk.Context, sender sdk.AccAddress, action *types.SetIncomingApprovalAction, tokenIn sdk.Coin, originalSender string) ibcexported.Acknowledgement {
+	if action == nil {
+		return types.NewSuccessAcknowledgement()
+	}
+
+	// 1. Early validation
+	msg, err := newSetIncomingApprovalMsg(sender, action)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(err.Error())
+	}
+
+	if ack := validateFailOnError(action.FailOnError, action.RecoverAddress); !ack.Success() {
+		return ack
+	}
+
+	// 2. Execute
+	extraAttrs := []sdk.Attribute{
+		sdk.NewAttribute("collection_id", action.CollectionId),
+		sdk.NewAttribute("approval_id", msg.Approval.ApprovalId),
+	}
+	return k.executeTokenizationAction(ctx, sender, "set_incoming_approval", fmt.Sprintf("collection=%s", action.CollectionId), action.FailOnError, action.RecoverAddress, tokenIn, originalSender, extraAttrs, func(cacheCtx sdk.Context) error {
+		_, err := k.tokenizationMsgServer.SetIncomingApproval(cacheCtx, msg)
+		return err
+	})
+}
+
+// ExecuteCastVote executes a CastVoteAction hook.
+// It casts a vote on a voting challenge with the IBC intermediate sender as the voter.
+func (k Keeper) ExecuteCastVote(ctx sdk.Context, sender sdk.AccAddress, action *types.CastVoteAction, tokenIn sdk.Coin, originalSender string) ibcexported.Acknowledgement {
+	if action == nil {
+		return types.NewSuccessAcknowledgement()
+	}
+
+	// 1. Early validation
+	msg, err := newCastVoteMsg(sender, action)
+	if err != nil {
+		return types.NewCustomErrorAcknowledgement(err.Error())
+	}
+
+	if ack := validateFailOnError(action.FailOnError, action.RecoverAddress); !ack.Success() {
+		return ack
+	}
+
+	// 2. Execute
+	extraAttrs := []sdk.Attribute{
+		sdk.NewAttribute("collection_id", action.CollectionId),
+		sdk.NewAttribute("approval_id", action.ApprovalId),
+		sdk.NewAttribute("proposal_id", action.ProposalId),
+	}
+	return k.executeTokenizationAction(ctx, sender, "cast_vote", fmt.Sprintf("collection=%s", action.CollectionId), action.FailOnError, action.RecoverAddress, tokenIn, originalSender, extraAttrs, func(cacheCtx sdk.Context) error {
+		_, err := k.tokenizationMsgServer.CastVote(cacheCtx, msg)
+		return err
+	})
+}
+
+// newSetDynamicStoreValueMsg validates a SetDynamicStoreValueAction and builds its message with the intermediate sender as creator
+func newSetDynamicStoreValueMsg(sender sdk.AccAddress, action *types.SetDynamicStoreValueAction) (*tokenizationtypes.MsgSetDynamicStoreValue, error) {
+	storeId, err := sdkmath.ParseUint(action.StoreId)
+	if err != nil || storeId.IsZero() {
+		return nil, fmt.Errorf("invalid set_dynamic_store_value store_id: %s", action.StoreId)
+	}
+
+	if action.Address == "" {
+		return nil, fmt.Errorf("set_dynamic_store_value address is required")
+	}
+
+	return &tokenizationtypes.MsgSetDynamicStoreValue{
+		Creator: sender.String(),
+		StoreId: storeId,
+		Address: action.Address,
+		Value:   action.Value,
+	}, nil
+}
+
+// newSetIncomingApprovalMsg validates a SetIncomingApprovalAction and builds its message with the intermediate sender as creator
+func newSetIncomingApprovalMsg(sender sdk.AccAddress, action *types.SetIncomingApprovalAction) (*tokenizationtypes.MsgSetIncomingApproval, error) {
+	collectionId, err := strconv.ParseUint(action.CollectionId, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid set_incoming_approval collection_id: %s", action.CollectionId)
+	}
+
+	if len(action.Approval) == 0 || string(action.Approval) == "null" {
+		return nil, fmt.Errorf("set_incoming_approval approval is required")
+	}
+
+	// Unmarshal snake_case JSON approval directly into the protobuf type
+	approval, err := types.UnmarshalIncomingApprovalFromJSON(action.Approval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse set_incoming_approval approval: %s", err.Error())
+	}
+
+	return &tokenizationtypes.MsgSetIncomingApproval{
+		Creator:      sender.String(),
+		CollectionId: sdkmath.NewUint(collectionId),
+		Approval:     approval,
+	}, nil
+}
+
+// newCastVoteMsg validates a CastVoteAction and builds its message with the intermediate sender as voter
+func newCastVoteMsg(sender sdk.AccAddress, action *types.CastVoteAction) (*tokenizationtypes.MsgCastVote, error) {
+	collectionId, err := strconv.ParseUint(action.CollectionId, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cast_vote collection_id: %s", action.CollectionId)
+	}
+
+	yesWeight, err := sdkmath.ParseUint(action.YesWeight)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cast_vote yes_weight: %s", action.YesWeight)
+	}
+
+	if action.ApprovalId == "" || action.ProposalId == "" {
+		return nil, fmt.Errorf("cast_vote approval_id and proposal_id are required")
+	}
+
+	return &tokenizationtypes.MsgCastVote{
+		Creator:         sender.String(),
+		CollectionId:    sdkmath.NewUint(collectionId),
+		ApprovalLevel:   action.ApprovalLevel,
+		ApproverAddress: action.ApproverAddress,
+		ApprovalId:      action.ApprovalId,
+		ProposalId:      action.ProposalId,
+		YesWeight:       yesWeight,
+	}, nil
+}
+
+// executeTokenizationAction runs a tokenization message in a cached context with the same
+// deterministic error handling as ExecuteTransferTokens: on failure, an error ack is returned if
+// fail_on_error is set, otherwise the IBC tokens are sent to recover_address.
+// On success, tokenIn is retained at the intermediate sender as payment for the hook operation.
+func (k Keeper) executeTokenizationAction(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	actionName string,
+	target string,
+	failOnError bool,
+	recoverAddress string,
+	tokenIn sdk.Coin,
+	originalSender string,
+	extraAttrs []sdk.Attribute,
+	execute func(cacheCtx sdk.Context) error,
+) ibcexported.Acknowledgement {
+	cacheCtx, writeCache := ctx.CacheContext()
+	types.ClearDeterministicError(cacheCtx)
+
+	if err := execute(cacheCtx); err != nil {
+		actionErrMsg := fmt.Sprintf("%s failed: %s, sender=%s (derived from %s)", actionName, target, sender.String(), originalSender)
+
+		// Only use deterministic error from transient store — never use err.Error() directly
+		// as it may contain stack traces or non-deterministic content that would halt consensus
+		if detErrMsg, found := types.GetDeterministicError(cacheCtx); found {
+			actionErrMsg = fmt.Sprintf("%s: %s", actionErrMsg, detErrMsg)
+		}
+
+		if failOnError {
+			// Return error ack — IBC will refund tokens to source chain
+			return types.NewCustomErrorAcknowledgement(actionErrMsg)
+		}
+
+		// fail_on_error is false — send IBC tokens to recover_address
+		return k.ExecuteFallbackToRecoverAddress(ctx, sender, recoverAddress, tokenIn, originalSender, actionErrMsg, actionName+"_fallback", extraAttrs)
+	}
+
+	writeCache()
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute("module", "custom-hooks"),
+		sdk.NewAttribute("sender", sender.String()),
+		sdk.NewAttribute("original_sender", originalSender),
+	}
+	attrs = append(attrs, extraAttrs...)
+	attrs = append(attrs,
+		sdk.NewAttribute("token_in", tokenIn.String()),
+		sdk.NewAttribute("token_in_denom", tokenIn.Denom),
+		sdk.NewAttribute("token_in_amount", tokenIn.Amount.String()),
+	)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(actionName+"_success", attrs...))
+
+	return types.NewSuccessAcknowledgement()
+}
+
+// validateFailOnError checks that recover_address is provided and valid when fail_on_error is false
+func validateFailOnError(failOnError bool, recoverAddress string) ibcexported.Acknowledgement {
+	if failOnError {
+		return types.NewSuccessAcknowledgement()
+	}
+	if recoverAddress == "" {
+		return types.NewCustomErrorAcknowledgement("recover_address is required when fail_on_error is false")
+	}
+	if _, err := sdk.AccAddressFromBech32(recoverAddress); err != nil {
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("invalid recover_address: %s", recoverAddress))
+	}
+	return types.NewSuccessAcknowledgement()
+}
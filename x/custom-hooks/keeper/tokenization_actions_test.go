@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	customhookstypes "github.com/bitbadges/bitbadgeschain/x/custom-hooks/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+)
+
+// TestParseTokenizationActionMemo tests parsing of set_dynamic_store_value, set_incoming_approval and cast_vote memos
+func TestParseTokenizationActionMemo(t *testing.T) {
+	t.Run("valid set_dynamic_store_value memo", func(t *testing.T) {
+		memo := `{"set_dynamic_store_value": {"store_id": "1", "address": "bb1user", "value": true, "fail_on_error": true}}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.NoError(t, err)
+		require.NotNil(t, hookData.SetDynamicStoreValue)
+		require.Equal(t, "1", hookData.SetDynamicStoreValue.StoreId)
+		require.Equal(t, "bb1user", hookData.SetDynamicStoreValue.Address)
+		require.True(t, hookData.SetDynamicStoreValue.Value)
+		require.True(t, hookData.SetDynamicStoreValue.FailOnError)
+	})
+
+	t.Run("valid set_incoming_approval memo", func(t *testing.T) {
+		memo := `{"set_incoming_approval": {"collection_id": "1", "approval": {"approval_id": "ibc", "from_list_id": "All"}, "recover_address": "bb1recover"}}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.NoError(t, err)
+		require.NotNil(t, hookData.SetIncomingApproval)
+		require.Equal(t, "bb1recover", hookData.SetIncomingApproval.RecoverAddress)
+
+		approval, err := customhookstypes.UnmarshalIncomingApprovalFromJSON(hookData.SetIncomingApproval.Approval)
+		require.NoError(t, err)
+		require.Equal(t, "ibc", approval.ApprovalId)
+		require.Equal(t, "All", approval.FromListId)
+	})
+
+	t.Run("valid cast_vote memo", func(t *testing.T) {
+		memo := `{"cast_vote": {"collection_id": "1", "approval_level": "collection", "approval_id": "a", "proposal_id": "p", "yes_weight": "70", "fail_on_error": true}}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.NoError(t, err)
+		require.NotNil(t, hookData.CastVote)
+		require.Equal(t, "collection", hookData.CastVote.ApprovalLevel)
+		require.Equal(t, "p", hookData.CastVote.ProposalId)
+		require.Equal(t, "70", hookData.CastVote.YesWeight)
+	})
+
+	t.Run("mutual exclusivity — cast_vote with another hook", func(t *testing.T) {
+		memo := `{"set_dynamic_store_value": {"store_id": "1"}, "cast_vote": {"collection_id": "1"}}`
+		hookData, err := customhookstypes.ParseHookDataFromMemo(memo)
+		require.Error(t, err)
+		require.Nil(t, hookData)
+		require.Contains(t, err.Error(), "set_dynamic_store_value and cast_vote")
+	})
+
+	t.Run("null approval", func(t *testing.T) {
+		_, err := customhookstypes.UnmarshalIncomingApprovalFromJSON(json.RawMessage(`null`))
+		require.Error(t, err)
+	})
+}
+
+// createDynamicStore creates a dynamic store owned by creator
+func (s *KeeperTestSuite) createDynamicStore(storeId uint64, creator sdk.AccAddress) {
+	s.Require().NoError(s.App.TokenizationKeeper.SetDynamicStoreInStore(s.Ctx, tokenizationtypes.DynamicStore{
+		StoreId:       sdkmath.NewUint(storeId),
+		CreatedBy:     creator.String(),
+		GlobalEnabled: true,
+	}))
+}
+
+// TestExecuteSetDynamicStoreValue tests setting a dynamic store value as the intermediate sender
+func (s *KeeperTestSuite) TestExecuteSetDynamicStoreValue() {
+	sender := s.TestAccs[0]
+	user := s.TestAccs[1]
+	s.createDynamicStore(1, sender)
+
+	tokenIn := sdk.NewCoin("uatom", osmomath.NewInt(1))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		SetDynamicStoreValue: &customhookstypes.SetDynamicStoreValueAction{
+			StoreId:     "1",
+			Address:     user.String(),
+			Value:       true,
+			FailOnError: true,
+		},
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+
+	value, found := s.App.TokenizationKeeper.GetDynamicStoreValueFromStore(s.Ctx, sdkmath.NewUint(1), user.String())
+	s.Require().True(found)
+	s.Require().True(value.Value)
+	s.Require().True(s.hasEvent("set_dynamic_store_value_success"))
+}
+
+// TestExecuteSetDynamicStoreValue_NotCreator tests that a store not created by the intermediate
+// sender returns an error ack with fail_on_error, and falls back to recover_address otherwise
+func (s *KeeperTestSuite) TestExecuteSetDynamicStoreValue_NotCreator() {
+	sender := s.TestAccs[0]
+	user := s.TestAccs[1]
+	recoverAddr := s.TestAccs[2]
+	s.createDynamicStore(1, user)
+
+	tokenIn := sdk.NewCoin("uatom", osmomath.NewInt(1000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	action := &customhookstypes.SetDynamicStoreValueAction{
+		StoreId:     "1",
+		Address:     user.String(),
+		Value:       true,
+		FailOnError: true,
+	}
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{SetDynamicStoreValue: action}, tokenIn, sender.String())
+	s.Require().False(ack.Success())
+	s.Require().Contains(getAckError(ack), "set_dynamic_store_value failed")
+
+	action.FailOnError = false
+	action.RecoverAddress = recoverAddr.String()
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	ack = s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{SetDynamicStoreValue: action}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+	s.Require().Equal(recoverBefore.Add(tokenIn), s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom"))
+	s.Require().True(s.hasEvent("set_dynamic_store_value_fallback"))
+
+	_, found := s.App.TokenizationKeeper.GetDynamicStoreValueFromStore(s.Ctx, sdkmath.NewUint(1), user.String())
+	s.Require().False(found)
+}
+
+// TestExecuteSetIncomingApproval tests setting an incoming approval for the intermediate sender
+func (s *KeeperTestSuite) TestExecuteSetIncomingApproval() {
+	sender := s.TestAccs[0]
+	s.Require().NoError(s.App.TokenizationKeeper.SetCollectionInStore(s.Ctx, &tokenizationtypes.TokenCollection{
+		CollectionId: sdkmath.NewUint(1),
+		ValidTokenIds: []*tokenizationtypes.UintRange{
+			{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(1)},
+		},
+	}, true))
+
+	tokenIn := sdk.NewCoin("uatom", osmomath.NewInt(1))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{
+		SetIncomingApproval: &customhookstypes.SetIncomingApprovalAction{
+			CollectionId: "1",
+			Approval: json.RawMessage(`{
+				"approval_id": "ibc-approval",
+				"from_list_id": "All",
+				"initiated_by_list_id": "All",
+				"transfer_times": [{"start": "1", "end": "18446744073709551615"}],
+				"token_ids": [{"start": "1", "end": "1"}],
+				"ownership_times": [{"start": "1", "end": "18446744073709551615"}]
+			}`),
+			FailOnError: true,
+		},
+	}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+	s.Require().True(s.hasEvent("set_incoming_approval_success"))
+
+	collection, found := s.App.TokenizationKeeper.GetCollectionFromStore(s.Ctx, sdkmath.NewUint(1))
+	s.Require().True(found)
+	balance, _, err := s.App.TokenizationKeeper.GetBalanceOrApplyDefault(s.Ctx, collection, sender.String())
+	s.Require().NoError(err)
+
+	approvalIds := []string{}
+	for _, approval := range balance.IncomingApprovals {
+		approvalIds = append(approvalIds, approval.ApprovalId)
+	}
+	s.Require().Contains(approvalIds, "ibc-approval")
+}
+
+// TestExecuteCastVote_Validation tests early validation and the fallback for a missing voting challenge
+func (s *KeeperTestSuite) TestExecuteCastVote_Validation() {
+	sender := s.TestAccs[0]
+	recoverAddr := s.TestAccs[2]
+	tokenIn := sdk.NewCoin("uatom", osmomath.NewInt(1000))
+	s.FundAcc(sender, sdk.Coins{tokenIn})
+
+	newAction := func() *customhookstypes.CastVoteAction {
+		return &customhookstypes.CastVoteAction{
+			CollectionId:   "1",
+			ApprovalLevel:  "collection",
+			ApprovalId:     "approval",
+			ProposalId:     "proposal",
+			YesWeight:      "100",
+			RecoverAddress: recoverAddr.String(),
+		}
+	}
+
+	invalid := map[string]func(a *customhookstypes.CastVoteAction){
+		"invalid collection_id": func(a *customhookstypes.CastVoteAction) { a.CollectionId = "abc" },
+		"invalid yes_weight":    func(a *customhookstypes.CastVoteAction) { a.YesWeight = "-1" },
+		"missing proposal_id":   func(a *customhookstypes.CastVoteAction) { a.ProposalId = "" },
+		"missing recover":       func(a *customhookstypes.CastVoteAction) { a.RecoverAddress = "" },
+		"invalid recover":       func(a *customhookstypes.CastVoteAction) { a.RecoverAddress = "invalid" },
+	}
+	for name, mutate := range invalid {
+		action := newAction()
+		mutate(action)
+		ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{CastVote: action}, tokenIn, sender.String())
+		s.Require().False(ack.Success(), name)
+	}
+
+	// The collection does not exist, so the vote fails and the tokens go to recover_address
+	recoverBefore := s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom")
+	ack := s.keeper.ExecuteHook(s.Ctx, sender, &customhookstypes.HookData{CastVote: newAction()}, tokenIn, sender.String())
+	s.Require().True(ack.Success(), getAckError(ack))
+	s.Require().Equal(recoverBefore.Add(tokenIn), s.App.BankKeeper.GetBalance(s.Ctx, recoverAddr, "uatom"))
+	s.Require().True(s.hasEvent("cast_vote_fallback"))
+}
@@ -51,10 +51,12 @@ var (
 	ErrSetAutoApproveFailed              = sdkerrors.Register(ModuleName, 41, "failed to set auto-approve for intermediate address")
 	ErrDeterministicError                = sdkerrors.Register(ModuleName, 42, "deterministic error")
 	ErrMemoSizeExceedsMaximum            = sdkerrors.Register(ModuleName, 43, "memo size exceeds maximum allowed size")
-	ErrMutuallyExclusiveHooks            = sdkerrors.Register(ModuleName, 44, "memo cannot contain both swap_and_action and transfer_tokens")
+	ErrMutuallyExclusiveHooks            = sdkerrors.Register(ModuleName, 44, "memo cannot contain more than one hook action")
 	ErrTransferTokensFailed              = sdkerrors.Register(ModuleName, 45, "transfer_tokens execution failed")
 	ErrRecoverAddressRequired            = sdkerrors.Register(ModuleName, 46, "recover_address is required when fail_on_error is false")
 	ErrInvalidTransferTokensCollectionId = sdkerrors.Register(ModuleName, 47, "invalid transfer_tokens collection_id")
 	ErrTransferTokensEmpty               = sdkerrors.Register(ModuleName, 48, "transfer_tokens transfers cannot be empty")
 	ErrTransferTokensConversion          = sdkerrors.Register(ModuleName, 49, "failed to convert transfer_tokens types")
+	ErrEVMCallFailed                     = sdkerrors.Register(ModuleName, 50, "evm_call execution failed")
+	ErrHookActionFailed                  = sdkerrors.Register(ModuleName, 51, "hook action execution failed")
 )
@@ -40,6 +40,30 @@ func UnmarshalTransfersFromJSON(transfersJSON json.RawMessage) ([]*tokenizationt
 	return transfers, nil
 }
 
+// UnmarshalIncomingApprovalFromJSON takes the raw JSON "approval" object from the memo (snake_case keys)
+// and unmarshals it directly into a protobuf UserIncomingApproval, same as UnmarshalTransfersFromJSON.
+func UnmarshalIncomingApprovalFromJSON(approvalJSON json.RawMessage) (*tokenizationtypes.UserIncomingApproval, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(approvalJSON, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse approval JSON: %w", err)
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("approval cannot be null")
+	}
+
+	camelJSON, err := json.Marshal(snakeToCamelKeys(raw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-marshal approval: %w", err)
+	}
+
+	var approval tokenizationtypes.UserIncomingApproval
+	if err := json.Unmarshal(camelJSON, &approval); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal into proto approval: %w", err)
+	}
+
+	return &approval, nil
+}
+
 // snakeToCamelKeys recursively converts all map keys from snake_case to camelCase.
 func snakeToCamelKeys(v interface{}) interface{} {
 	switch val := v.(type) {
@@ -150,6 +150,69 @@ func Err(ctx *sdk.Context, detMsg string, args ...interface{}) error {
 type HookData struct {
 	SwapAndAction  *SwapAndAction        `json:"swap_and_action,omitempty"`
 	TransferTokens *TransferTokensAction `json:"transfer_tokens,omitempty"`
+	JoinPool       *JoinPoolAction       `json:"join_pool,omitempty"`
+	ExitPool       *ExitPoolAction       `json:"exit_pool,omitempty"`
+
+	SetDynamicStoreValue *SetDynamicStoreValueAction `json:"set_dynamic_store_value,omitempty"`
+	SetIncomingApproval  *SetIncomingApprovalAction  `json:"set_incoming_approval,omitempty"`
+	CastVote             *CastVoteAction             `json:"cast_vote,omitempty"`
+
+	// Actions is an ordered list of actions executed atomically (see HookAction).
+	// RecoverAddress receives the received tokens if any action fails and is only used with Actions.
+	Actions        []HookAction `json:"actions,omitempty"`
+	RecoverAddress string       `json:"recover_address,omitempty"`
+}
+
+// HookAction is a single step of a multi-action memo. Exactly one field must be set.
+// Steps are executed in order in a single cached context, and each step receives the output of
+// the previous one (the first step receives the IBC tokens):
+//   - Swap swaps the input and outputs the swapped tokens
+//   - JoinPool / ExitPool output the pool shares / exited tokens, unless to_address is set
+//     in which case the output is sent there and the step must be last
+//   - Transfer sends the input to to_address and must be last
+//   - TransferTokens, SetDynamicStoreValue, SetIncomingApproval and CastVote output whatever is
+//     left of the input after the message executes
+//
+// If any step fails, all steps are rolled back and the IBC tokens are sent to the memo's
+// recover_address. The fail_on_error and recover_address fields of individual actions are ignored.
+type HookAction struct {
+	Swap                 *SwapAction                 `json:"swap,omitempty"`
+	Transfer             *TransferInfo               `json:"transfer,omitempty"`
+	TransferTokens       *TransferTokensAction       `json:"transfer_tokens,omitempty"`
+	JoinPool             *JoinPoolAction             `json:"join_pool,omitempty"`
+	ExitPool             *ExitPoolAction             `json:"exit_pool,omitempty"`
+	SetDynamicStoreValue *SetDynamicStoreValueAction `json:"set_dynamic_store_value,omitempty"`
+	SetIncomingApproval  *SetIncomingApprovalAction  `json:"set_incoming_approval,omitempty"`
+	CastVote             *CastVoteAction             `json:"cast_vote,omitempty"`
+}
+
+// SwapAction is a swap step of a multi-action memo. MinAsset is required.
+type SwapAction struct {
+	SwapExactAssetIn *SwapExactAssetIn `json:"swap_exact_asset_in,omitempty"`
+	MinAsset         *MinAsset         `json:"min_asset,omitempty"`
+}
+
+// JoinPoolAction represents an IBC hook that single-asset joins a gamm pool with the received tokens.
+// Use case: LPs on other chains add liquidity to a BitBadges pool in one cross-chain tx.
+// The received pool shares are sent to ToAddress. If the join fails (e.g., fewer than
+// ShareOutMinAmount shares), the received tokens are sent to RecoverAddress instead.
+type JoinPoolAction struct {
+	PoolId            string `json:"pool_id"`
+	ShareOutMinAmount string `json:"share_out_min_amount"`
+	ToAddress         string `json:"to_address"`
+	RecoverAddress    string `json:"recover_address"`
+}
+
+// ExitPoolAction represents an IBC hook that exits a gamm pool with the received pool shares
+// into a single asset (TokenOutDenom). The received shares must be the pool's share denom.
+// The exited tokens are sent to ToAddress. If the exit fails (e.g., fewer than
+// TokenOutMinAmount tokens out), the received shares are sent to RecoverAddress instead.
+type ExitPoolAction struct {
+	PoolId            string `json:"pool_id"`
+	TokenOutDenom     string `json:"token_out_denom"`
+	TokenOutMinAmount string `json:"token_out_min_amount"`
+	ToAddress         string `json:"to_address"`
+	RecoverAddress    string `json:"recover_address"`
 }
 
 // TransferTokensAction represents an IBC hook that triggers a MsgTransferTokens on receive.
@@ -163,6 +226,41 @@ type TransferTokensAction struct {
 	RecoverAddress string          `json:"recover_address,omitempty"`
 }
 
+// SetDynamicStoreValueAction represents an IBC hook that triggers a MsgSetDynamicStoreValue on receive.
+// Use case: a contract on another chain registers users in a dynamic store it created via its intermediate sender.
+// Same as MsgSetDynamicStoreValue, only the creator of the store (the intermediate sender) can set values.
+type SetDynamicStoreValueAction struct {
+	StoreId        string `json:"store_id"`
+	Address        string `json:"address"`
+	Value          bool   `json:"value"`
+	FailOnError    bool   `json:"fail_on_error"`
+	RecoverAddress string `json:"recover_address,omitempty"`
+}
+
+// SetIncomingApprovalAction represents an IBC hook that triggers a MsgSetIncomingApproval on receive,
+// setting an incoming approval for the intermediate sender.
+// Approval is kept as json.RawMessage (snake_case) and converted to the protobuf type via
+// UnmarshalIncomingApprovalFromJSON, same as TransferTokensAction.Transfers.
+type SetIncomingApprovalAction struct {
+	CollectionId   string          `json:"collection_id"`
+	Approval       json.RawMessage `json:"approval"`
+	FailOnError    bool            `json:"fail_on_error"`
+	RecoverAddress string          `json:"recover_address,omitempty"`
+}
+
+// CastVoteAction represents an IBC hook that triggers a MsgCastVote on receive,
+// casting a vote on a voting challenge with the intermediate sender as the voter.
+type CastVoteAction struct {
+	CollectionId    string `json:"collection_id"`
+	ApprovalLevel   string `json:"approval_level"`
+	ApproverAddress string `json:"approver_address,omitempty"`
+	ApprovalId      string `json:"approval_id"`
+	ProposalId      string `json:"proposal_id"`
+	YesWeight       string `json:"yes_weight"`
+	FailOnError     bool   `json:"fail_on_error"`
+	RecoverAddress  string `json:"recover_address,omitempty"`
+}
+
 // SwapAndAction represents the Skip-style format for swap and action
 type SwapAndAction struct {
 	UserSwap                  *UserSwap       `json:"user_swap,omitempty"`
@@ -206,6 +304,52 @@ type NativeAsset struct {
 type PostSwapAction struct {
 	IBCTransfer *IBCTransferInfo `json:"ibc_transfer,omitempty"`
 	Transfer    *TransferInfo    `json:"transfer,omitempty"`
+	EVMCall     *EVMCallInfo     `json:"evm_call,omitempty"`
+}
+
+const (
+	// EVMCallModeSend sends the swapped output to the contract before calling it
+	EVMCallModeSend = "send"
+	// EVMCallModeApprove approves the contract to spend the swapped output (via its ERC20 token pair) before calling it
+	EVMCallModeApprove = "approve"
+
+	// DefaultEVMCallGasLimit is the gas cap used for evm_call when gas_limit is not provided
+	DefaultEVMCallGasLimit = uint64(500_000)
+	// MaxEVMCallGasLimit is the maximum gas cap a memo can request for evm_call
+	MaxEVMCallGasLimit = uint64(5_000_000)
+)
+
+// EVMCallInfo contains the details of an EVM contract call executed with the swapped output.
+// The call is made from the intermediate sender address with the caller-supplied calldata.
+// If the call reverts (or runs out of gas), all of its effects are rolled back and the swapped
+// output is sent to recover_address instead.
+type EVMCallInfo struct {
+	// ContractAddress is the EVM contract to call (0x-prefixed hex or bech32)
+	ContractAddress string `json:"contract_address"`
+	// Calldata is the hex-encoded calldata (0x prefix optional)
+	Calldata string `json:"calldata"`
+	// Mode is either "send" (default) or "approve"
+	Mode string `json:"mode,omitempty"`
+	// GasLimit caps the gas used by the call, including the approve in approve mode (defaults to DefaultEVMCallGasLimit)
+	GasLimit uint64 `json:"gas_limit,omitempty"`
+	// RecoverAddress receives the swapped output if the call fails
+	RecoverAddress string `json:"recover_address"`
+}
+
+// GetMode returns the EVM call mode, defaulting to EVMCallModeSend
+func (e *EVMCallInfo) GetMode() string {
+	if e.Mode == "" {
+		return EVMCallModeSend
+	}
+	return e.Mode
+}
+
+// GetGasLimit returns the EVM call gas cap, defaulting to DefaultEVMCallGasLimit
+func (e *EVMCallInfo) GetGasLimit() uint64 {
+	if e.GasLimit == 0 {
+		return DefaultEVMCallGasLimit
+	}
+	return e.GasLimit
 }
 
 // TransferInfo contains local transfer information
@@ -237,6 +381,9 @@ const (
 	// Security: LOW-009 - Prevents DoS attacks via extremely large memo payloads
 	// 64KB is a reasonable limit that allows most legitimate memos while preventing abuse
 	MaxMemoSize = 64 * 1024 // 64KB
+
+	// MaxHookActions is the maximum number of actions in a multi-action memo
+	MaxHookActions = 10
 )
 
 // ParseHookDataFromMemo parses hook data from IBC memo
@@ -256,23 +403,21 @@ func ParseHookDataFromMemo(memo string) (*HookData, error) {
 		return nil, err
 	}
 
-	_, hasSwapAndAction := memoObj["swap_and_action"]
-	_, hasTransferTokens := memoObj["transfer_tokens"]
-
 	// Enforce mutual exclusivity
-	if hasSwapAndAction && hasTransferTokens {
-		return nil, errorsmod.Wrap(ErrMutuallyExclusiveHooks, "memo cannot contain both swap_and_action and transfer_tokens")
+	var hookKeys []string
+	for _, key := range []string{"swap_and_action", "transfer_tokens", "join_pool", "exit_pool", "set_dynamic_store_value", "set_incoming_approval", "cast_vote", "actions"} {
+		if _, ok := memoObj[key]; ok {
+			hookKeys = append(hookKeys, key)
+		}
+	}
+	if len(hookKeys) > 1 {
+		return nil, errorsmod.Wrapf(ErrMutuallyExclusiveHooks, "memo cannot contain both %s and %s", hookKeys[0], hookKeys[1])
 	}
 
 	// Check for swap_and_action key
-	if hasSwapAndAction {
-		swapAndActionBytes, err := json.Marshal(memoObj["swap_and_action"])
-		if err != nil {
-			return nil, err
-		}
-
+	if _, ok := memoObj["swap_and_action"]; ok {
 		var swapAndAction SwapAndAction
-		if err := json.Unmarshal(swapAndActionBytes, &swapAndAction); err != nil {
+		if err := unmarshalMemoKey(memoObj, "swap_and_action", &swapAndAction); err != nil {
 			return nil, err
 		}
 
@@ -282,25 +427,112 @@ func ParseHookDataFromMemo(memo string) (*HookData, error) {
 	}
 
 	// Check for transfer_tokens key
-	if hasTransferTokens {
-		transferTokensBytes, err := json.Marshal(memoObj["transfer_tokens"])
-		if err != nil {
+	if _, ok := memoObj["transfer_tokens"]; ok {
+		var transferTokens TransferTokensAction
+		if err := unmarshalMemoKey(memoObj, "transfer_tokens", &transferTokens); err != nil {
 			return nil, err
 		}
 
-		var transferTokens TransferTokensAction
-		if err := json.Unmarshal(transferTokensBytes, &transferTokens); err != nil {
+		return &HookData{
+			TransferTokens: &transferTokens,
+		}, nil
+	}
+
+	// Check for join_pool key
+	if _, ok := memoObj["join_pool"]; ok {
+		var joinPool JoinPoolAction
+		if err := unmarshalMemoKey(memoObj, "join_pool", &joinPool); err != nil {
 			return nil, err
 		}
 
 		return &HookData{
-			TransferTokens: &transferTokens,
+			JoinPool: &joinPool,
+		}, nil
+	}
+
+	// Check for exit_pool key
+	if _, ok := memoObj["exit_pool"]; ok {
+		var exitPool ExitPoolAction
+		if err := unmarshalMemoKey(memoObj, "exit_pool", &exitPool); err != nil {
+			return nil, err
+		}
+
+		return &HookData{
+			ExitPool: &exitPool,
+		}, nil
+	}
+
+	// Check for actions key
+	if _, ok := memoObj["actions"]; ok {
+		var actions []HookAction
+		if err := unmarshalMemoKey(memoObj, "actions", &actions); err != nil {
+			return nil, err
+		}
+		if len(actions) == 0 {
+			return nil, errorsmod.Wrap(ErrInvalidRequest, "actions cannot be empty")
+		}
+
+		var recoverAddress string
+		if _, ok := memoObj["recover_address"]; ok {
+			if err := unmarshalMemoKey(memoObj, "recover_address", &recoverAddress); err != nil {
+				return nil, err
+			}
+		}
+
+		return &HookData{
+			Actions:        actions,
+			RecoverAddress: recoverAddress,
+		}, nil
+	}
+
+	// Check for set_dynamic_store_value key
+	if _, ok := memoObj["set_dynamic_store_value"]; ok {
+		var setDynamicStoreValue SetDynamicStoreValueAction
+		if err := unmarshalMemoKey(memoObj, "set_dynamic_store_value", &setDynamicStoreValue); err != nil {
+			return nil, err
+		}
+
+		return &HookData{
+			SetDynamicStoreValue: &setDynamicStoreValue,
+		}, nil
+	}
+
+	// Check for set_incoming_approval key
+	if _, ok := memoObj["set_incoming_approval"]; ok {
+		var setIncomingApproval SetIncomingApprovalAction
+		if err := unmarshalMemoKey(memoObj, "set_incoming_approval", &setIncomingApproval); err != nil {
+			return nil, err
+		}
+
+		return &HookData{
+			SetIncomingApproval: &setIncomingApproval,
+		}, nil
+	}
+
+	// Check for cast_vote key
+	if _, ok := memoObj["cast_vote"]; ok {
+		var castVote CastVoteAction
+		if err := unmarshalMemoKey(memoObj, "cast_vote", &castVote); err != nil {
+			return nil, err
+		}
+
+		return &HookData{
+			CastVote: &castVote,
 		}, nil
 	}
 
 	return nil, nil
 }
 
+// unmarshalMemoKey re-marshals memoObj[key] and unmarshals it into out
+func unmarshalMemoKey(memoObj map[string]interface{}, key string, out interface{}) error {
+	bz, err := json.Marshal(memoObj[key])
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, out)
+}
+
 // NewCustomErrorAcknowledgement creates a custom error acknowledgement with a deterministic error string
 // IMPORTANT: The error string must be deterministic (no traces, logs, or non-deterministic values)
 // This is used instead of channeltypes.NewErrorAcknowledgement to provide more friendly error messages
@@ -16,9 +16,9 @@ const (
 	PoolFileExitFee        = "exit-fee"
 	PoolFileFutureGovernor = "future-governor"
 
-	PoolFileStartTime                = "start-time"
-	PoolFileDuration                 = "duration"
-	PoolFileTargetPoolWeights        = "target-pool-weights"
+	PoolFileStartTime         = "start-time"
+	PoolFileDuration          = "duration"
+	PoolFileTargetPoolWeights = "target-pool-weights"
 
 	FlagPoolId = "pool-id"
 	// Will be parsed to osmomath.Int.
@@ -46,12 +46,19 @@ const (
 	FlagPoolRecords = "pool-records"
 )
 
+type smoothWeightChangeParamsInputs struct {
+	StartTime         string `json:"start-time"`
+	Duration          string `json:"duration"`
+	TargetPoolWeights string `json:"target-pool-weights"`
+}
+
 type createBalancerPoolInputs struct {
 	Weights                  string                         `json:"weights"`
 	InitialDeposit           string                         `json:"initial-deposit"`
 	SwapFee                  string                         `json:"swap-fee"`
 	ExitFee                  string                         `json:"exit-fee"`
 	FutureGovernor           string                         `json:"future-governor"`
+	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
 }
 
 type createStableswapPoolInputs struct {
@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPools)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPoolWeights)
 	cmd.AddCommand(
 		osmocli.GetParams[*types.ParamsRequest](
 			types.ModuleName, types.NewQueryClient),
@@ -187,6 +188,17 @@ Example:
 	)
 }
 
+// GetCmdPoolWeights returns the current weights of a balancer pool and its weight schedule.
+func GetCmdPoolWeights() (*osmocli.QueryDescriptor, *types.QueryPoolWeightsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-weights",
+		Short: "Query the current weights of a balancer pool, and its target weights if they are changing",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-weights 1
+`,
+	}, &types.QueryPoolWeightsRequest{}
+}
+
 // Deprecated: use alternate in x/poolmanager.
 func GetCmdSpotPrice() (*osmocli.QueryDescriptor, *types.QuerySpotPriceRequest) {
 	return &osmocli.QueryDescriptor{
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -12,6 +13,7 @@ import (
 	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
 	"github.com/bitbadges/bitbadgeschain/third_party/osmoutils/osmocli"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/balancer"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/orderbook"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
 	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
 
@@ -30,6 +32,10 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInWithIBCTransferCmd)
+	osmocli.AddTxCmd(txCmd, NewCreateOrderbookPoolCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 	)
@@ -53,6 +59,20 @@ Sample pool JSON file contents for balancer:
 	"future-governor": "168h"
 }
 
+For a liquidity bootstrapping pool, whose weights move linearly from the initial weights to the
+target weights over the given duration (start-time is RFC3339 and defaults to the block time):
+{
+	"weights": "1uatom,99osmo",
+	"initial-deposit": "100uatom,5osmo",
+	"swap-fee": "0.01",
+	"future-governor": "168h",
+	"lbp-params": {
+		"start-time": "2026-01-01T00:00:00Z",
+		"duration": "72h",
+		"target-pool-weights": "50uatom,50osmo"
+	}
+}
+
 For stableswap (demonstrating need for a 1:1000 scaling factor, see doc)
 {
 	"initial-deposit": "1000000uusdc,1000miliusdc",
@@ -170,6 +190,39 @@ func NewSwapExactAmountInWithIBCTransferCmd() (*osmocli.TxCliDesc, *types.MsgSwa
 	}, &types.MsgSwapExactAmountInWithIBCTransfer{}
 }
 
+func NewCreateOrderbookPoolCmd() (*osmocli.TxCliDesc, *orderbook.MsgCreateOrderbookPool) {
+	return &osmocli.TxCliDesc{
+		Use:     "create-orderbook-pool",
+		Short:   "create a new orderbook pool for limit orders",
+		Long:    "Create an orderbook pool trading the base denom for the quote denom. The price of tick n is n * tick-size quote per base. Orders must be worth at least min-order-notional of the quote denom. The creator pays the orderbook pool creation fee to the community pool.",
+		Example: "create-orderbook-pool ubadge uusdc 0.001 1000000",
+	}, &orderbook.MsgCreateOrderbookPool{}
+}
+
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *orderbook.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:   "place-limit-order",
+		Short: "place a limit order in an orderbook pool",
+		Long: `Place a limit order at a tick of an orderbook pool. Direction is 0 for bids and 1 for asks.
+The quantity is in the quote denom for bids and in the base denom for asks.`,
+		Example: "place-limit-order 1 1 1500 1000000",
+	}, &orderbook.MsgPlaceLimitOrder{}
+}
+
+func NewCancelLimitOrderCmd() (*osmocli.TxCliDesc, *orderbook.MsgCancelLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:   "cancel-limit-order",
+		Short: "cancel a limit order, refunding its unfilled quantity and claiming its proceeds",
+	}, &orderbook.MsgCancelLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *orderbook.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:   "claim-limit-order",
+		Short: "claim the proceeds of the filled part of a limit order",
+	}, &orderbook.MsgClaimLimitOrder{}
+}
+
 func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	poolType, err := fs.GetString(FlagPoolType)
 	if err != nil {
@@ -229,6 +282,42 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (
 		ExitFee: osmomath.NewDec(0),
 	}
 
+	if (pool.SmoothWeightChangeParams != smoothWeightChangeParamsInputs{}) {
+		duration, err := time.ParseDuration(pool.SmoothWeightChangeParams.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse duration: %w", err)
+		}
+
+		targetPoolAssetCoins, err := sdk.ParseDecCoins(pool.SmoothWeightChangeParams.TargetPoolWeights)
+		if err != nil {
+			return nil, err
+		}
+
+		var targetPoolAssets []balancer.PoolAsset
+		for i := 0; i < len(targetPoolAssetCoins); i++ {
+			targetPoolAssets = append(targetPoolAssets, balancer.PoolAsset{
+				Weight: targetPoolAssetCoins[i].Amount.RoundInt(),
+				Token:  sdk.NewCoin(targetPoolAssetCoins[i].Denom, osmomath.ZeroInt()),
+			})
+		}
+
+		smoothWeightParams := balancer.SmoothWeightChangeParams{
+			Duration:          duration,
+			TargetPoolWeights: targetPoolAssets,
+		}
+
+		if pool.SmoothWeightChangeParams.StartTime != "" {
+			startTime, err := time.Parse(time.RFC3339, pool.SmoothWeightChangeParams.StartTime)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse time: %w", err)
+			}
+
+			smoothWeightParams.StartTime = startTime
+		}
+
+		poolParams.SmoothWeightChangeParams = &smoothWeightParams
+	}
+
 	msg := &balancer.MsgCreateBalancerPool{
 		Sender:     clientCtx.GetFromAddress().String(),
 		PoolParams: poolParams,
@@ -35,6 +35,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 
 	k.setTotalLiquidity(ctx, liquidity)
 
+	for _, order := range genState.LimitOrders {
+		k.setLimitOrder(ctx, order)
+		if err := k.addOwnerOrderbookTick(ctx, order); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -52,9 +58,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 		poolAnys = append(poolAnys, any)
 	}
+	limitOrders, err := k.GetAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
 		NextPoolNumber: k.GetNextPoolId(ctx),
 		Pools:          poolAnys,
 		Params:         k.GetParams(ctx),
+		LimitOrders:    limitOrders,
 	}
 }
@@ -329,6 +329,52 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 	}
 }
 
+// PoolWeights queries the weights of a balancer pool at the current block time,
+// and its weight schedule if it has one that has not completed yet.
+func (q Querier) PoolWeights(ctx context.Context, req *types.QueryPoolWeightsRequest) (*types.QueryPoolWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "pool %d is not a balancer pool", req.PoolId)
+	}
+
+	res := &types.QueryPoolWeightsResponse{
+		CurrentWeights: toPoolWeights(balancerPool.PoolAssets),
+	}
+	if schedule := balancerPool.PoolParams.SmoothWeightChangeParams; schedule != nil {
+		startTime := schedule.StartTime
+		endTime := schedule.StartTime.Add(schedule.Duration)
+		res.WeightsChanging = true
+		res.InitialWeights = toPoolWeights(schedule.InitialPoolWeights)
+		res.TargetWeights = toPoolWeights(schedule.TargetPoolWeights)
+		res.StartTime = &startTime
+		res.EndTime = &endTime
+	}
+	return res, nil
+}
+
+// toPoolWeights converts internal balancer weights to the units used at pool creation.
+func toPoolWeights(assets []balancer.PoolAsset) []types.PoolWeight {
+	weights := make([]types.PoolWeight, len(assets))
+	for i, asset := range assets {
+		weights[i] = types.PoolWeight{
+			Denom:  asset.Token.Denom,
+			Weight: osmomath.NewDecFromInt(asset.Weight).QuoInt64(balancer.GuaranteedWeightPrecision),
+		}
+	}
+	return weights
+}
+
 // TotalPoolLiquidity returns total liquidity in pool.
 // Deprecated: please use the alternative in x/poolmanager
 // nolint: staticcheck
@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"errors"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ import (
 
 	appparams "github.com/bitbadges/bitbadgeschain/app/params"
 	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/keeper"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/balancer"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/stableswap"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
@@ -608,6 +610,60 @@ func (s *KeeperTestSuite) TestQueryTotalShares() {
 	// s.Require().Equal(types.InitPoolSharesSupply.Add(types.OneShare.MulRaw(10)).String(), res.TotalShares.Amount.String())
 }
 
+func (s *KeeperTestSuite) TestQueryPoolWeights() {
+	querier := keeper.NewQuerier(s.App.GammKeeper)
+
+	// Pool not exist
+	_, err := querier.PoolWeights(s.Ctx, &types.QueryPoolWeightsRequest{PoolId: 1})
+	s.Require().Error(err)
+
+	// Move the weight of foo from 100 to 300 over an hour, starting at the current block time.
+	// The default start time has second precision.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Truncate(time.Second))
+	poolId := s.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, balancer.PoolParams{
+		SwapFee: defaultSpreadFactor,
+		ExitFee: defaultZeroExitFee,
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(300), Token: sdk.NewCoin("foo", osmomath.ZeroInt())},
+				{Weight: osmomath.NewInt(100), Token: sdk.NewCoin("bar", osmomath.ZeroInt())},
+			},
+		},
+	})
+	poolWeights := func(barWeight, fooWeight int64) []types.PoolWeight {
+		return []types.PoolWeight{
+			{Denom: "bar", Weight: osmomath.NewDec(barWeight)},
+			{Denom: "foo", Weight: osmomath.NewDec(fooWeight)},
+		}
+	}
+	startTime := s.Ctx.BlockTime()
+	endTime := startTime.Add(time.Hour)
+
+	// Halfway through, the current weights are interpolated
+	res, err := querier.PoolWeights(s.Ctx.WithBlockTime(startTime.Add(30*time.Minute)), &types.QueryPoolWeightsRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().True(startTime.Equal(*res.StartTime))
+	s.Require().True(endTime.Equal(*res.EndTime))
+	res.StartTime, res.EndTime = nil, nil
+	s.Require().Equal(&types.QueryPoolWeightsResponse{
+		CurrentWeights:  poolWeights(100, 200),
+		WeightsChanging: true,
+		InitialWeights:  poolWeights(100, 100),
+		TargetWeights:   poolWeights(100, 300),
+	}, res)
+
+	// After the end, the pool has the target weights and no schedule
+	res, err = querier.PoolWeights(s.Ctx.WithBlockTime(endTime), &types.QueryPoolWeightsRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPoolWeightsResponse{CurrentWeights: poolWeights(100, 300)}, res)
+
+	// Non balancer pools have no weights
+	stableswapPoolId := s.PrepareBasicStableswapPool()
+	_, err = querier.PoolWeights(s.Ctx, &types.QueryPoolWeightsRequest{PoolId: stableswapPoolId})
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestQueryBalancerPoolTotalLiquidity() {
 	queryClient := s.queryClient
 
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/balancer"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/orderbook"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
 	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
 )
@@ -27,9 +28,16 @@ func NewBalancerMsgServerImpl(keeper *Keeper) balancer.MsgServer {
 	}
 }
 
+func NewOrderbookMsgServerImpl(keeper *Keeper) orderbook.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
 var (
-	_ types.MsgServer    = msgServer{}
-	_ balancer.MsgServer = msgServer{}
+	_ types.MsgServer     = msgServer{}
+	_ balancer.MsgServer  = msgServer{}
+	_ orderbook.MsgServer = msgServer{}
 )
 
 // CreateBalancerPool is a create balancer pool message.
@@ -382,3 +390,109 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
+
+func (server msgServer) CreateOrderbookPool(goCtx context.Context, msg *orderbook.MsgCreateOrderbookPool) (*orderbook.MsgCreateOrderbookPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	poolId, err := server.CreatePool(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	msgStr, err := MarshalMessageForEvent(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	EmitMessageAndIndexerEvents(ctx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, "gamm"),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute("msg_type", "create_orderbook_pool"),
+		sdk.NewAttribute("msg", msgStr),
+	)
+
+	return &orderbook.MsgCreateOrderbookPoolResponse{PoolID: poolId}, nil
+}
+
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *orderbook.MsgPlaceLimitOrder) (*orderbook.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.PlaceLimitOrder(ctx, sender, msg.PoolId, msg.Direction, msg.TickId, msg.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	msgStr, err := MarshalMessageForEvent(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	EmitMessageAndIndexerEvents(ctx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, "gamm"),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute("msg_type", "place_limit_order"),
+		sdk.NewAttribute("msg", msgStr),
+	)
+
+	return &orderbook.MsgPlaceLimitOrderResponse{OrderId: orderId}, nil
+}
+
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *orderbook.MsgCancelLimitOrder) (*orderbook.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refunded, proceeds, err := server.keeper.CancelLimitOrder(ctx, sender, msg.PoolId, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	msgStr, err := MarshalMessageForEvent(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	EmitMessageAndIndexerEvents(ctx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, "gamm"),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute("msg_type", "cancel_limit_order"),
+		sdk.NewAttribute("msg", msgStr),
+	)
+
+	return &orderbook.MsgCancelLimitOrderResponse{Refunded: refunded, Proceeds: proceeds}, nil
+}
+
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *orderbook.MsgClaimLimitOrder) (*orderbook.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	proceeds, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.PoolId, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	msgStr, err := MarshalMessageForEvent(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	EmitMessageAndIndexerEvents(ctx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, "gamm"),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute("msg_type", "claim_limit_order"),
+		sdk.NewAttribute("msg", msgStr),
+	)
+
+	return &orderbook.MsgClaimLimitOrderResponse{Proceeds: proceeds}, nil
+}
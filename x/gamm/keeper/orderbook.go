@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/third_party/osmoutils"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/orderbook"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
+)
+
+// getOrderbookPool returns the orderbook pool with the given id.
+func (k Keeper) getOrderbookPool(ctx sdk.Context, poolId uint64) (*orderbook.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	orderbookPool, ok := pool.(*orderbook.Pool)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNotOrderbookPool, "pool %d is of type %s", poolId, pool.GetType())
+	}
+	return orderbookPool, nil
+}
+
+func (k Keeper) setLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.GetKeyLimitOrder(order.PoolId, order.OrderId), &order)
+}
+
+// deleteLimitOrder deletes an order, and removes it from its owner's tick index.
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyLimitOrder(order.PoolId, order.OrderId))
+	k.removeOwnerOrderbookTick(ctx, order)
+}
+
+// addOwnerOrderbookTick indexes an open order at its tick for its owner.
+// Returns an error if the owner would have open orders at more than orderbook.MaxTicksPerOwner ticks on the order's side.
+func (k Keeper) addOwnerOrderbookTick(ctx sdk.Context, order types.LimitOrder) error {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyOwnerOrderbookTick(order.PoolId, owner, order.Direction, order.TickId)
+	numOrders := uint64(0)
+	if bz := store.Get(key); bz != nil {
+		numOrders = sdk.BigEndianToUint64(bz)
+	} else if numTicks := k.countOwnerOrderbookTicks(ctx, order.PoolId, owner, order.Direction); numTicks >= orderbook.MaxTicksPerOwner {
+		return errorsmod.Wrapf(types.ErrTooManyOrderbookTicks, "%s has open orders at max (%d) ticks", order.Owner, orderbook.MaxTicksPerOwner)
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(numOrders+1))
+	return nil
+}
+
+// removeOwnerOrderbookTick removes a closed order from its owner's tick index.
+func (k Keeper) removeOwnerOrderbookTick(ctx sdk.Context, order types.LimitOrder) {
+	// The owner is validated when the order is placed
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyOwnerOrderbookTick(order.PoolId, owner, order.Direction, order.TickId)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+	if numOrders := sdk.BigEndianToUint64(bz); numOrders > 1 {
+		store.Set(key, sdk.Uint64ToBigEndian(numOrders-1))
+	} else {
+		store.Delete(key)
+	}
+}
+
+// countOwnerOrderbookTicks returns the number of ticks on a side of the book the owner has open orders at.
+func (k Keeper) countOwnerOrderbookTicks(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, direction types.OrderDirection) int {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetKeyPrefixOwnerOrderbookTicks(poolId, owner, direction))
+	defer iterator.Close()
+
+	numTicks := 0
+	for ; iterator.Valid(); iterator.Next() {
+		numTicks++
+	}
+	return numTicks
+}
+
+// GetLimitOrder returns the limit order with the given id in the given orderbook pool.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, poolId uint64, orderId uint64) (types.LimitOrder, error) {
+	order := types.LimitOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyLimitOrder(poolId, orderId), &order)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if !found {
+		return types.LimitOrder{}, errorsmod.Wrapf(types.ErrLimitOrderNotFound, "order %d in pool %d", orderId, poolId)
+	}
+	return order, nil
+}
+
+// GetAllLimitOrders returns the limit orders of all orderbook pools, ordered by pool and order id.
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) ([]types.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixLimitOrders, func(bz []byte) (types.LimitOrder, error) {
+		order := types.LimitOrder{}
+		err := k.cdc.Unmarshal(bz, &order)
+		return order, err
+	})
+}
+
+// PlaceLimitOrder places a limit order in an orderbook pool, depositing its quantity from the sender.
+// Returns the id of the order.
+func (k Keeper) PlaceLimitOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	direction types.OrderDirection,
+	tickId uint64,
+	quantity osmomath.Int,
+) (orderId uint64, err error) {
+	pool, err := k.getOrderbookPool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	order, deposit, err := pool.PlaceOrder(sender.String(), direction, tickId, quantity)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.addOwnerOrderbookTick(ctx, order); err != nil {
+		return 0, err
+	}
+
+	if err := k.SendCoinsToPoolWithAliasRouting(ctx, sender, pool.GetAddress(), sdk.NewCoins(deposit)); err != nil {
+		return 0, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return 0, err
+	}
+	k.setLimitOrder(ctx, order)
+	k.RecordTotalLiquidityIncrease(ctx, sdk.NewCoins(deposit))
+
+	return order.OrderId, nil
+}
+
+// ClaimLimitOrder pays out the unclaimed proceeds of the filled part of a limit order to its owner.
+// The order is deleted once it is fully filled and claimed.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64) (sdk.Coin, error) {
+	pool, order, err := k.getOwnedLimitOrder(ctx, sender, poolId, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	proceeds, err := pool.ClaimOrder(&order)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if order.ClaimedQuantity.Equal(order.Quantity) {
+		k.deleteLimitOrder(ctx, order)
+	} else {
+		k.setLimitOrder(ctx, order)
+	}
+
+	if err := k.payOutFromOrderbookPool(ctx, pool, sender, sdk.NewCoins(proceeds)); err != nil {
+		return sdk.Coin{}, err
+	}
+	return proceeds, nil
+}
+
+// CancelLimitOrder deletes a limit order, refunding its unfilled quantity and paying out its unclaimed proceeds.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64) (refund sdk.Coin, proceeds sdk.Coin, err error) {
+	pool, order, err := k.getOwnedLimitOrder(ctx, sender, poolId, orderId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	refund, proceeds, err = pool.CancelOrder(&order)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	k.deleteLimitOrder(ctx, order)
+
+	if err := k.payOutFromOrderbookPool(ctx, pool, sender, sdk.NewCoins(refund, proceeds)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	return refund, proceeds, nil
+}
+
+func (k Keeper) getOwnedLimitOrder(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, orderId uint64) (*orderbook.Pool, types.LimitOrder, error) {
+	pool, err := k.getOrderbookPool(ctx, poolId)
+	if err != nil {
+		return nil, types.LimitOrder{}, err
+	}
+
+	order, err := k.GetLimitOrder(ctx, poolId, orderId)
+	if err != nil {
+		return nil, types.LimitOrder{}, err
+	}
+	if order.Owner != sender.String() {
+		return nil, types.LimitOrder{}, errorsmod.Wrapf(types.ErrNotLimitOrderOwner, "order %d in pool %d is owned by %s", orderId, poolId, order.Owner)
+	}
+	return pool, order, nil
+}
+
+// payOutFromOrderbookPool saves the pool and sends coins from it to the recipient.
+func (k Keeper) payOutFromOrderbookPool(ctx sdk.Context, pool *orderbook.Pool, recipient sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	if coins.IsZero() {
+		return nil
+	}
+	if err := k.SendCoinsFromPoolWithAliasRouting(ctx, pool.GetAddress(), recipient, coins); err != nil {
+		return err
+	}
+	k.RecordTotalLiquidityDecrease(ctx, coins)
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/orderbook"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+// TestOrderbookLimitOrders tests placing limit orders, filling them with a swap routed through x/poolmanager,
+// and claiming and canceling them.
+func (s *KeeperTestSuite) TestOrderbookLimitOrders() {
+	s.SetupTest()
+	k := s.App.GammKeeper
+	maker, taker := s.TestAccs[0], s.TestAccs[1]
+	s.FundAcc(maker, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000)))
+	s.FundAcc(taker, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000)))
+
+	// Creating the pool costs the orderbook pool creation fee, which goes to the community pool
+	creationFee := k.GetParams(s.Ctx).OrderbookPoolCreationFee
+	s.Require().False(creationFee.IsZero())
+	_, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, orderbook.NewMsgCreateOrderbookPool(maker, "foo", "bar", osmomath.OneDec(), osmomath.NewInt(10)))
+	s.Require().Error(err)
+
+	s.FundAcc(maker, creationFee)
+	communityPool := s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	communityPoolBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, communityPool)
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, orderbook.NewMsgCreateOrderbookPool(maker, "foo", "bar", osmomath.OneDec(), osmomath.NewInt(10)))
+	s.Require().NoError(err)
+	s.Require().Equal(communityPoolBefore.Add(creationFee...), s.App.BankKeeper.GetAllBalances(s.Ctx, communityPool))
+	pool, err := k.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(poolmanagertypes.Orderbook, pool.GetType())
+
+	// Ask 1000 foo at 2 bar, and bid 500 bar at 1 bar per foo
+	askId, err := k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Ask, 2, osmomath.NewInt(1000))
+	s.Require().NoError(err)
+	bidId, err := k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, 1, osmomath.NewInt(500))
+	s.Require().NoError(err)
+
+	_, err = k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, 2, osmomath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrLimitOrderCrossesBook)
+
+	// Orders must be worth at least the min order notional
+	_, err = k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, 1, osmomath.NewInt(9))
+	s.Require().ErrorIs(err, types.ErrInvalidLimitOrder)
+
+	s.Require().Len(k.ExportGenesis(s.Ctx).LimitOrders, 2)
+
+	// Fill part of the ask by swapping bar for foo through x/poolmanager
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "foo"}}
+	tokenIn := sdk.NewInt64Coin("bar", 1000)
+	expectedOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+	s.Require().NoError(err)
+	tokenOut, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, taker, route, tokenIn, osmomath.OneInt(), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedOut, tokenOut)
+	s.Require().Equal(tokenOut, s.App.BankKeeper.GetBalance(s.Ctx, taker, "foo").Amount)
+
+	// Only the owner can claim the order
+	_, err = k.ClaimLimitOrder(s.Ctx, taker, poolId, askId)
+	s.Require().ErrorIs(err, types.ErrNotLimitOrderOwner)
+
+	makerBarBefore := s.App.BankKeeper.GetBalance(s.Ctx, maker, "bar")
+	proceeds, err := k.ClaimLimitOrder(s.Ctx, maker, poolId, askId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin("bar", tokenOut.MulRaw(2)), proceeds)
+	s.Require().Equal(makerBarBefore.Add(proceeds), s.App.BankKeeper.GetBalance(s.Ctx, maker, "bar"))
+
+	// Canceling refunds the unfilled part, and the order is deleted
+	refund, proceeds, err := k.CancelLimitOrder(s.Ctx, maker, poolId, askId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin("foo", osmomath.NewInt(1000).Sub(tokenOut)), refund)
+	s.Require().True(proceeds.IsZero())
+	_, err = k.GetLimitOrder(s.Ctx, poolId, askId)
+	s.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	refund, _, err = k.CancelLimitOrder(s.Ctx, maker, poolId, bidId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("bar", 500), refund)
+
+	// The pool holds exactly its recorded liquidity, which is empty once all orders are closed
+	pool, err = k.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	poolBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+	s.Require().Equal(pool.GetTotalPoolLiquidity(s.Ctx), poolBalances)
+	s.Require().True(poolBalances.IsZero())
+	s.Require().Empty(k.ExportGenesis(s.Ctx).LimitOrders)
+}
+
+// TestOrderbookMaxTicksPerOwner tests that a single owner cannot have open orders at more than MaxTicksPerOwner ticks
+// on a side of the book.
+func (s *KeeperTestSuite) TestOrderbookMaxTicksPerOwner() {
+	s.SetupTest()
+	k := s.App.GammKeeper
+	maker, other := s.TestAccs[0], s.TestAccs[1]
+	s.FundAcc(maker, k.GetParams(s.Ctx).OrderbookPoolCreationFee.Add(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000)))
+	s.FundAcc(other, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000)))
+
+	poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, orderbook.NewMsgCreateOrderbookPool(maker, "foo", "bar", osmomath.OneDec(), osmomath.NewInt(10)))
+	s.Require().NoError(err)
+
+	var firstOrderId uint64
+	for tickId := uint64(1); tickId <= orderbook.MaxTicksPerOwner; tickId++ {
+		orderId, err := k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, tickId, osmomath.NewInt(10))
+		s.Require().NoError(err)
+		if tickId == 1 {
+			firstOrderId = orderId
+		}
+	}
+
+	// New ticks are rejected, but the owner can still add to the ticks it occupies
+	nextTickId := uint64(orderbook.MaxTicksPerOwner + 1)
+	_, err = k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, nextTickId, osmomath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrTooManyOrderbookTicks)
+	_, err = k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, 1, osmomath.NewInt(10))
+	s.Require().NoError(err)
+
+	// The cap is per owner and per side
+	_, err = k.PlaceLimitOrder(s.Ctx, other, poolId, types.Bid, nextTickId, osmomath.NewInt(10))
+	s.Require().NoError(err)
+	_, err = k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Ask, nextTickId+1, osmomath.NewInt(10))
+	s.Require().NoError(err)
+
+	// A tick is freed once all of the owner's orders at it are closed
+	_, _, err = k.CancelLimitOrder(s.Ctx, maker, poolId, firstOrderId)
+	s.Require().NoError(err)
+	_, err = k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, nextTickId, osmomath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrTooManyOrderbookTicks)
+
+	_, _, err = k.CancelLimitOrder(s.Ctx, maker, poolId, firstOrderId+orderbook.MaxTicksPerOwner)
+	s.Require().NoError(err)
+	_, err = k.PlaceLimitOrder(s.Ctx, maker, poolId, types.Bid, nextTickId, osmomath.NewInt(10))
+	s.Require().NoError(err)
+}
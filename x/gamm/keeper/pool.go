@@ -13,6 +13,7 @@ import (
 	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
 	"github.com/bitbadges/bitbadgeschain/third_party/osmoutils"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/balancer"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/orderbook"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
 	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
 )
@@ -149,7 +150,7 @@ func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
 		return nil, err
 	}
 
-	return pool.GetTotalPoolLiquidity(ctx).Denoms(), err
+	return pool.GetPoolDenoms(ctx), err
 }
 
 // setNextPoolId sets next pool Id.
@@ -189,6 +190,8 @@ func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (poolmanagertypes.Po
 	switch pool := pool.(type) {
 	case *balancer.Pool:
 		return poolmanagertypes.Balancer, nil
+	case *orderbook.Pool:
+		return poolmanagertypes.Orderbook, nil
 
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s pool type: %T", types.ModuleName, pool)
@@ -83,8 +83,50 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 		return fmt.Errorf("can not create pool with non zero exit fee, got %d", exitFee)
 	}
 
+	if pool.GetType() == poolmanagertypes.Orderbook {
+		// Orderbook pools have no LP shares, and are only funded by limit orders.
+		// Pool creation must still be allowed for both of their denoms.
+		for _, denom := range pool.GetPoolDenoms(ctx) {
+			if err := k.ValidatePoolCreationAllowed(ctx, sdk.Coins{sdk.NewCoin(denom, osmomath.ZeroInt())}); err != nil {
+				return err
+			}
+		}
+
+		// As no liquidity is deposited, the creator pays a creation fee to the community pool instead.
+		if fee := k.GetParams(ctx).OrderbookPoolCreationFee; !fee.IsZero() {
+			if err := k.FundCommunityPoolWithAliasRouting(ctx, sender, fee); err != nil {
+				return err
+			}
+		}
+	} else if err := k.initializePoolShares(ctx, pool, cfmmPool, sender); err != nil {
+		return err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	// Auto-set the pool address as a reserved protocol address in the tokenization module
+	poolAddress := pool.GetAddress().String()
+	err = k.tokenizationKeeper.SetReservedProtocolAddressInStore(ctx, poolAddress, true)
+	if err != nil {
+		return fmt.Errorf("failed to set pool address as reserved protocol: %w", err)
+	}
+	k.tokenizationKeeper.SetPoolAddressInCache(ctx, poolAddress, pool.GetId())
+
+	// N.B.: these hooks propagate to x/twap to create
+	// twap records at pool creation time.
+	// Additionally, these hooks are used in x/pool-incentives to
+	// create gauges.
+	k.RecordTotalLiquidityIncrease(ctx, cfmmPool.GetTotalPoolLiquidity(ctx))
+	return nil
+}
+
+// initializePoolShares mints the initial pool shares to the pool creator
+// and sets the bank metadata of the share denom.
+func (k Keeper) initializePoolShares(ctx sdk.Context, pool poolmanagertypes.PoolI, cfmmPool types.CFMMPoolI, sender sdk.AccAddress) error {
 	// Mint the initial pool shares share token to the sender
-	err = k.MintPoolShareToAccount(ctx, pool, sender, cfmmPool.GetTotalShares())
+	err := k.MintPoolShareToAccount(ctx, pool, sender, cfmmPool.GetTotalShares())
 	if err != nil {
 		return err
 	}
@@ -112,23 +154,6 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 		Display: poolShareDisplayDenom,
 	})
 
-	if err := k.setPool(ctx, pool); err != nil {
-		return err
-	}
-
-	// Auto-set the pool address as a reserved protocol address in the tokenization module
-	poolAddress := pool.GetAddress().String()
-	err = k.tokenizationKeeper.SetReservedProtocolAddressInStore(ctx, poolAddress, true)
-	if err != nil {
-		return fmt.Errorf("failed to set pool address as reserved protocol: %w", err)
-	}
-	k.tokenizationKeeper.SetPoolAddressInCache(ctx, poolAddress, pool.GetId())
-
-	// N.B.: these hooks propagate to x/twap to create
-	// twap records at pool creation time.
-	// Additionally, these hooks are used in x/pool-incentives to
-	// create gauges.
-	k.RecordTotalLiquidityIncrease(ctx, cfmmPool.GetTotalPoolLiquidity(ctx))
 	return nil
 }
 
@@ -272,19 +272,19 @@ func (s *KeeperTestSuite) TestGetPoolAndPoke() {
 		isPokePool bool
 		poolId     uint64
 	}{
-		// "weighted pool - change weights": {
-		// 	isPokePool: true,
-		// 	poolId: s.prepareCustomBalancerPool(defaultAcctFunds, startPoolWeightAssets, balancer.PoolParams{
-		// 		SwapFee: defaultSpreadFactor,
-		// 		ExitFee: defaultZeroExitFee,
-		// 		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
-		// 			StartTime:          time.Unix(startTime, 0), // start time is before block time so the weights should change
-		// 			Duration:           time.Hour,
-		// 			InitialPoolWeights: startPoolWeightAssets,
-		// 			TargetPoolWeights:  defaultPoolAssetsCopy,
-		// 		},
-		// 	}),
-		// },
+		"weighted pool - change weights": {
+			isPokePool: true,
+			poolId: s.prepareCustomBalancerPool(defaultAcctFunds, startPoolWeightAssets, balancer.PoolParams{
+				SwapFee: defaultSpreadFactor,
+				ExitFee: defaultZeroExitFee,
+				SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+					StartTime:          time.Unix(startTime, 0), // start time is before block time so the weights should change
+					Duration:           time.Hour,
+					InitialPoolWeights: startPoolWeightAssets,
+					TargetPoolWeights:  defaultPoolAssetsCopy,
+				},
+			}),
+		},
 		"non weighted pool": {
 			poolId: s.prepareCustomStableswapPool(
 				defaultAcctFunds,
@@ -28,6 +28,7 @@ import (
 	"github.com/bitbadges/bitbadgeschain/x/gamm/client/cli"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/keeper"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/balancer"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/orderbook"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/stableswap"
 	simulation "github.com/bitbadges/bitbadgeschain/x/gamm/simulation"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
@@ -56,6 +57,7 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
 	balancer.RegisterLegacyAminoCodec(cdc)
 	stableswap.RegisterLegacyAminoCodec(cdc)
+	orderbook.RegisterLegacyAminoCodec(cdc)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the gamm
@@ -93,6 +95,7 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	types.RegisterInterfaces(registry)
 	balancer.RegisterInterfaces(registry)
 	stableswap.RegisterInterfaces(registry)
+	orderbook.RegisterInterfaces(registry)
 }
 
 type AppModule struct {
@@ -107,6 +110,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
 	balancer.RegisterMsgServer(cfg.MsgServer(), keeper.NewBalancerMsgServerImpl(&am.keeper))
 	// stableswap.RegisterMsgServer(cfg.MsgServer(), keeper.NewStableswapMsgServerImpl(&am.keeper))
+	orderbook.RegisterMsgServer(cfg.MsgServer(), keeper.NewOrderbookMsgServerImpl(&am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 	v2types.RegisterQueryServer(cfg.QueryServer(), keeper.NewV2Querier(am.keeper))
 }
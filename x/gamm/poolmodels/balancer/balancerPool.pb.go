@@ -16,17 +16,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +37,97 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Parameters for changing the weights in a balancer pool smoothly from
+// a start weight and end weight over a period of time.
+// Currently, the only smooth change supported is linear changing between
+// the two weights, but more types may be added in the future.
+// When these parameters are set, the weight w(t) for pool time `t` is the
+// following:
+//
+//	t <= start_time: w(t) = initial_pool_weights
+//	start_time < t <= start_time + duration:
+//	  w(t) = initial_pool_weights + (t - start_time) *
+//	    (target_pool_weights - initial_pool_weights) / (duration)
+//	t > start_time + duration: w(t) = target_pool_weights
+type SmoothWeightChangeParams struct {
+	// The start time for beginning the weight change.
+	// If a parameter change / pool instantiation leaves this blank,
+	// it should be generated by the state_machine as the current time.
+	// It can be at most one year after the current time.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Duration for the weights to change over
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The initial pool weights. These are copied from the pool's settings
+	// at the time of weight change instantiation.
+	// The amount PoolAsset.token.amount field is ignored if present.
+	InitialPoolWeights []PoolAsset `protobuf:"bytes,3,rep,name=initial_pool_weights,json=initialPoolWeights,proto3" json:"initial_pool_weights" yaml:"initial_pool_weights"`
+	// The target pool weights. The pool weights will change linearly with respect
+	// to time between start_time, and start_time + duration. The amount
+	// PoolAsset.token.amount field is ignored if present.
+	TargetPoolWeights []PoolAsset `protobuf:"bytes,4,rep,name=target_pool_weights,json=targetPoolWeights,proto3" json:"target_pool_weights" yaml:"target_pool_weights"`
+}
+
+func (m *SmoothWeightChangeParams) Reset()         { *m = SmoothWeightChangeParams{} }
+func (m *SmoothWeightChangeParams) String() string { return proto.CompactTextString(m) }
+func (*SmoothWeightChangeParams) ProtoMessage()    {}
+func (*SmoothWeightChangeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea9f2a4847d9c9de, []int{0}
+}
+func (m *SmoothWeightChangeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SmoothWeightChangeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SmoothWeightChangeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SmoothWeightChangeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SmoothWeightChangeParams.Merge(m, src)
+}
+func (m *SmoothWeightChangeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SmoothWeightChangeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SmoothWeightChangeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SmoothWeightChangeParams proto.InternalMessageInfo
+
+func (m *SmoothWeightChangeParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *SmoothWeightChangeParams) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *SmoothWeightChangeParams) GetInitialPoolWeights() []PoolAsset {
+	if m != nil {
+		return m.InitialPoolWeights
+	}
+	return nil
+}
+
+func (m *SmoothWeightChangeParams) GetTargetPoolWeights() []PoolAsset {
+	if m != nil {
+		return m.TargetPoolWeights
+	}
+	return nil
+}
+
 // PoolParams defined the parameters that will be managed by the pool
 // governance in the future. This params are not managed by the chain
 // governance. Instead they will be managed by the token holders of the pool.
@@ -43,13 +137,16 @@ type PoolParams struct {
 	// pools can maintain a non-zero fee. No new pool can be created with non-zero
 	// fee anymore
 	ExitFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"exit_fee" yaml:"exit_fee"`
+	// Optional weight schedule for liquidity bootstrapping pools. Cleared once
+	// the target weights are reached.
+	SmoothWeightChangeParams *SmoothWeightChangeParams `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
 func (m *PoolParams) String() string { return proto.CompactTextString(m) }
 func (*PoolParams) ProtoMessage()    {}
 func (*PoolParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea9f2a4847d9c9de, []int{0}
+	return fileDescriptor_ea9f2a4847d9c9de, []int{1}
 }
 func (m *PoolParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,6 +175,13 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return nil
+}
+
 // Pool asset is an internal struct that combines the amount of the
 // token in the pool, and its balancer weight.
 // This is an awkward packaging of data,
@@ -94,7 +198,7 @@ func (m *PoolAsset) Reset()         { *m = PoolAsset{} }
 func (m *PoolAsset) String() string { return proto.CompactTextString(m) }
 func (*PoolAsset) ProtoMessage()    {}
 func (*PoolAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea9f2a4847d9c9de, []int{1}
+	return fileDescriptor_ea9f2a4847d9c9de, []int{2}
 }
 func (m *PoolAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +250,7 @@ type Pool struct {
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea9f2a4847d9c9de, []int{2}
+	return fileDescriptor_ea9f2a4847d9c9de, []int{3}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,6 +280,7 @@ func (m *Pool) XXX_DiscardUnknown() {
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*SmoothWeightChangeParams)(nil), "gamm.poolmodels.balancer.SmoothWeightChangeParams")
 	proto.RegisterType((*PoolParams)(nil), "gamm.poolmodels.balancer.PoolParams")
 	proto.RegisterType((*PoolAsset)(nil), "gamm.poolmodels.balancer.PoolAsset")
 	proto.RegisterType((*Pool)(nil), "gamm.poolmodels.balancer.Pool")
@@ -186,47 +291,126 @@ func init() {
 }
 
 var fileDescriptor_ea9f2a4847d9c9de = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xd5, 0x4c,
-	0x14, 0x6e, 0xf9, 0x7c, 0x99, 0xcb, 0x8b, 0xa1, 0x62, 0xbc, 0x80, 0x69, 0x49, 0x31, 0x91, 0xf8,
-	0x31, 0x13, 0xd0, 0xb8, 0x60, 0x61, 0x62, 0x55, 0x12, 0x12, 0x17, 0x58, 0x17, 0xa8, 0x9b, 0xeb,
-	0xb4, 0x1d, 0x7a, 0x47, 0xda, 0x4e, 0xd3, 0x19, 0x04, 0xfe, 0x81, 0x71, 0xe5, 0xd2, 0xb8, 0xe2,
-	0x27, 0xb8, 0xf0, 0x47, 0x10, 0x57, 0x2c, 0x8d, 0x8b, 0xc6, 0xc0, 0xc2, 0xa5, 0xc9, 0xfd, 0x05,
-	0x66, 0x3e, 0xea, 0xbd, 0x4a, 0x90, 0xc4, 0xcd, 0xcd, 0x9c, 0x99, 0xf3, 0x3c, 0xe7, 0x3c, 0xe7,
-	0x39, 0xb7, 0xe0, 0x46, 0x8a, 0xf3, 0x1c, 0x95, 0x8c, 0x65, 0x39, 0x4b, 0x48, 0xc6, 0x51, 0x84,
-	0x33, 0x5c, 0xc4, 0xa4, 0xfa, 0x75, 0xd8, 0x60, 0x2c, 0x83, 0x65, 0xc5, 0x04, 0x73, 0xda, 0x32,
-	0x19, 0xf6, 0x93, 0x61, 0x93, 0x33, 0x37, 0x1b, 0x33, 0x9e, 0x33, 0xde, 0x51, 0x79, 0x48, 0x07,
-	0x1a, 0x34, 0x37, 0x93, 0xb2, 0x94, 0xe9, 0x7b, 0x79, 0x32, 0xb7, 0xd3, 0x38, 0xa7, 0x05, 0x43,
-	0xea, 0xd7, 0x5c, 0xb9, 0x29, 0x63, 0x69, 0x46, 0x90, 0x8a, 0xa2, 0x9d, 0x2d, 0x94, 0xec, 0x54,
-	0x58, 0x50, 0x56, 0x98, 0x77, 0xef, 0xcf, 0x77, 0x41, 0x73, 0xc2, 0x05, 0xce, 0xcb, 0x86, 0x40,
-	0xd7, 0x45, 0x11, 0xe6, 0x04, 0xbd, 0x5e, 0x8e, 0x88, 0xc0, 0xcb, 0x28, 0x66, 0xd4, 0x10, 0xf8,
-	0x47, 0x36, 0x00, 0x52, 0xcd, 0x06, 0xae, 0x70, 0xce, 0x9d, 0x27, 0xe0, 0x3f, 0xbe, 0x8b, 0xcb,
-	0xce, 0x16, 0x21, 0x6d, 0x7b, 0xc1, 0x5e, 0x9a, 0x08, 0xee, 0x1e, 0xd6, 0x9e, 0xf5, 0xb5, 0xf6,
-	0xe6, 0x35, 0x11, 0x4f, 0xb6, 0x21, 0x65, 0x28, 0xc7, 0xa2, 0x0b, 0x1f, 0x93, 0x14, 0xc7, 0xfb,
-	0x0f, 0x49, 0xdc, 0xab, 0xbd, 0x0b, 0xfb, 0x38, 0xcf, 0x56, 0xfd, 0x06, 0xec, 0x87, 0xe3, 0xf2,
-	0xb8, 0x46, 0x88, 0xa4, 0x24, 0x7b, 0x54, 0x28, 0xca, 0xa1, 0x7f, 0xa0, 0x6c, 0xc0, 0x7e, 0x38,
-	0x2e, 0x8f, 0x6b, 0x84, 0xac, 0x5e, 0x79, 0xfb, 0xfd, 0xe3, 0xf5, 0xcb, 0xca, 0xa5, 0x60, 0xc0,
-	0x11, 0xad, 0xc1, 0xff, 0x60, 0x83, 0x09, 0x19, 0xde, 0xe7, 0x9c, 0x08, 0xe7, 0x11, 0x18, 0x15,
-	0x6c, 0x9b, 0x14, 0x4a, 0x4e, 0x6b, 0x65, 0x16, 0x1a, 0x23, 0xe4, 0x40, 0xa0, 0x19, 0x08, 0x7c,
-	0xc0, 0x68, 0x11, 0xcc, 0xc8, 0xb6, 0x7a, 0xb5, 0x37, 0xa9, 0xeb, 0x2a, 0x94, 0x1f, 0x6a, 0xb4,
-	0xb3, 0x06, 0xc6, 0x76, 0x09, 0x4d, 0xbb, 0xc2, 0x68, 0x80, 0x46, 0xc3, 0xa5, 0xd3, 0x1a, 0xd6,
-	0x0b, 0xd1, 0xab, 0xbd, 0xff, 0x35, 0x8b, 0x06, 0xf9, 0xa1, 0x41, 0xfb, 0x3f, 0x86, 0xc1, 0x88,
-	0x6c, 0xce, 0xb9, 0x09, 0xc6, 0x71, 0x92, 0x54, 0x84, 0x73, 0x33, 0x68, 0xa7, 0x57, 0x7b, 0x53,
-	0x1a, 0x64, 0x1e, 0xfc, 0xb0, 0x49, 0x71, 0xa6, 0xc0, 0x10, 0x4d, 0x54, 0xe9, 0x91, 0x70, 0x88,
-	0x26, 0xce, 0x2b, 0xd0, 0x92, 0x2b, 0xd7, 0x29, 0x95, 0xe4, 0xf6, 0xb0, 0xd2, 0x76, 0x15, 0x9e,
-	0xb5, 0x8b, 0xb0, 0x3f, 0x9e, 0x60, 0xd1, 0xc8, 0x9c, 0xd7, 0xb5, 0x9a, 0x94, 0xce, 0x00, 0x9f,
-	0x1f, 0x82, 0xb2, 0xbf, 0x13, 0xcf, 0xc1, 0xa4, 0x60, 0x02, 0x67, 0x1d, 0xde, 0xc5, 0x15, 0xe1,
-	0xed, 0x91, 0xf3, 0x06, 0x39, 0x6f, 0x2a, 0x5c, 0x6c, 0x06, 0xd9, 0x07, 0xfb, 0x61, 0x4b, 0x85,
-	0x4f, 0x55, 0xe4, 0xbc, 0x34, 0x32, 0xb0, 0xb4, 0x8a, 0xb7, 0x47, 0x17, 0x86, 0x97, 0x5a, 0x2b,
-	0x8b, 0x7f, 0x97, 0xa1, 0x6c, 0x0d, 0xe6, 0x4c, 0x0d, 0x47, 0xd7, 0x18, 0x60, 0x31, 0xcd, 0xab,
-	0x34, 0xee, 0x6c, 0x36, 0xcd, 0x1b, 0xf7, 0xc6, 0xd4, 0xac, 0xef, 0x9c, 0xe7, 0xde, 0x6f, 0xad,
-	0x37, 0x1e, 0xea, 0xd6, 0x37, 0x55, 0xb4, 0x7a, 0xed, 0xcd, 0x81, 0x67, 0xbd, 0x3f, 0xf0, 0xac,
-	0xcf, 0x9f, 0x6e, 0x8d, 0xca, 0xbe, 0xd6, 0xe5, 0x52, 0x4e, 0x9f, 0x5a, 0xca, 0xe0, 0xd9, 0xe1,
-	0xb1, 0x6b, 0x1f, 0x1d, 0xbb, 0xf6, 0xb7, 0x63, 0xd7, 0x7e, 0x77, 0xe2, 0x5a, 0x47, 0x27, 0xae,
-	0xf5, 0xe5, 0xc4, 0xb5, 0x5e, 0xdc, 0x4b, 0xa9, 0xe8, 0xee, 0x44, 0x30, 0x66, 0x39, 0x8a, 0xa8,
-	0x88, 0x70, 0x92, 0x12, 0xde, 0x3f, 0xc5, 0x5d, 0x4c, 0x0b, 0xb4, 0x87, 0xce, 0xfa, 0x1a, 0x45,
-	0x63, 0xea, 0x2f, 0x7c, 0xfb, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xce, 0x3f, 0x86, 0xb0,
-	0x04, 0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0x1e, 0xcf, 0xe4, 0x67, 0xd3, 0xb3, 0x2c, 0x9a, 0xde, 0x20, 0x9c, 0x19, 0x18, 0xaf, 0x7a,
+	0x91, 0x58, 0x2d, 0x8b, 0xad, 0x04, 0xc4, 0x21, 0x07, 0x24, 0xbc, 0xcb, 0x4a, 0x2b, 0x71, 0x58,
+	0xbc, 0x48, 0x1b, 0xb8, 0x98, 0xb6, 0xdd, 0xb1, 0x9b, 0xd8, 0xee, 0x91, 0xbb, 0x43, 0x12, 0x9e,
+	0x00, 0x71, 0xca, 0x31, 0x42, 0x42, 0xca, 0x23, 0x70, 0xe0, 0x21, 0x22, 0x4e, 0x91, 0xb8, 0x20,
+	0x0e, 0x06, 0x25, 0x07, 0x24, 0x2e, 0xa0, 0x79, 0x02, 0xd4, 0x3f, 0xce, 0x4c, 0x7e, 0x86, 0x44,
+	0x5c, 0x46, 0xdd, 0xd5, 0x55, 0xdf, 0x57, 0x55, 0x5f, 0x95, 0x07, 0xbc, 0x93, 0xe2, 0xa2, 0xf0,
+	0x46, 0x8c, 0xe5, 0x05, 0x4b, 0x48, 0xce, 0xbd, 0x08, 0xe7, 0xb8, 0x8c, 0x49, 0x75, 0x76, 0x78,
+	0xce, 0x58, 0xee, 0x8e, 0x2a, 0x26, 0x18, 0xb4, 0xa5, 0xb3, 0x3b, 0x71, 0x76, 0x1b, 0x9f, 0xfe,
+	0x4a, 0xcc, 0x78, 0xc1, 0x78, 0xa8, 0xfc, 0x3c, 0x7d, 0xd1, 0x41, 0xfd, 0xe5, 0x94, 0xa5, 0x4c,
+	0xdb, 0xe5, 0xc9, 0x58, 0x7b, 0xb8, 0xa0, 0x25, 0xf3, 0xd4, 0xaf, 0x31, 0x0d, 0x53, 0xc6, 0xd2,
+	0x9c, 0x78, 0xea, 0x16, 0x6d, 0x6f, 0x7a, 0xc9, 0x76, 0x85, 0x05, 0x65, 0xa5, 0x79, 0x77, 0x2e,
+	0xbe, 0x0b, 0x5a, 0x10, 0x2e, 0x70, 0x31, 0x6a, 0x00, 0x34, 0xaf, 0x17, 0x61, 0x4e, 0xbc, 0xaf,
+	0x57, 0x23, 0x22, 0xf0, 0xaa, 0x17, 0x33, 0x6a, 0x00, 0xd0, 0x2f, 0x1d, 0x60, 0xbf, 0x28, 0x18,
+	0x13, 0xd9, 0x4b, 0x42, 0xd3, 0x4c, 0x3c, 0xce, 0x70, 0x99, 0x92, 0xe7, 0xb8, 0xc2, 0x05, 0x87,
+	0x1b, 0x00, 0x70, 0x81, 0x2b, 0x11, 0x4a, 0x54, 0xdb, 0xba, 0x67, 0x3d, 0xe8, 0xae, 0xf5, 0x5d,
+	0x4d, 0xe9, 0x36, 0x94, 0xee, 0x67, 0x0d, 0xa5, 0xff, 0xe6, 0x51, 0xed, 0xb4, 0xc6, 0xb5, 0xd3,
+	0xdb, 0xc3, 0x45, 0xbe, 0x8e, 0x26, 0xb1, 0x68, 0xff, 0x77, 0xc7, 0x0a, 0x96, 0x94, 0x41, 0xba,
+	0xc3, 0x0c, 0xdc, 0x6a, 0x2a, 0xb1, 0xdb, 0x0a, 0x77, 0xe5, 0x12, 0xee, 0x13, 0xe3, 0xe0, 0xaf,
+	0x4a, 0xd8, 0xbf, 0x6a, 0x07, 0x36, 0x21, 0x8f, 0x58, 0x41, 0x05, 0x29, 0x46, 0x62, 0x6f, 0x5c,
+	0x3b, 0xaf, 0x6a, 0xb2, 0xe6, 0x0d, 0x1d, 0x48, 0xaa, 0x33, 0x74, 0xf8, 0x0d, 0x58, 0xa6, 0x25,
+	0x15, 0x14, 0xe7, 0xa1, 0x14, 0x29, 0xdc, 0x51, 0x65, 0x72, 0xbb, 0x73, 0xaf, 0xf3, 0xa0, 0xbb,
+	0x76, 0xdf, 0x9d, 0x25, 0x9f, 0x2b, 0x35, 0xfe, 0x88, 0x73, 0x22, 0xfc, 0xfb, 0xa6, 0xac, 0x81,
+	0x66, 0xba, 0x0a, 0x0e, 0x05, 0xd0, 0x98, 0x65, 0x98, 0x6e, 0x25, 0x87, 0x3b, 0xe0, 0xae, 0xc0,
+	0x55, 0x4a, 0xc4, 0x79, 0xea, 0xb9, 0x9b, 0x53, 0x23, 0x43, 0xdd, 0xd7, 0xd4, 0x57, 0xa0, 0xa1,
+	0xa0, 0xa7, 0xad, 0x53, 0xc4, 0xe8, 0x9f, 0x36, 0x00, 0xf2, 0x6e, 0x74, 0xfc, 0x14, 0xdc, 0xe2,
+	0x3b, 0x78, 0x14, 0x6e, 0x12, 0xad, 0xe2, 0x92, 0xff, 0x81, 0xc4, 0xfd, 0xad, 0x76, 0x06, 0x7a,
+	0x3c, 0x78, 0xb2, 0xe5, 0x52, 0xe6, 0x15, 0x58, 0x64, 0xee, 0x27, 0x24, 0xc5, 0xf1, 0xde, 0x13,
+	0x12, 0x4f, 0x7a, 0xdb, 0x04, 0xa3, 0x60, 0x51, 0x1e, 0x9f, 0x12, 0x22, 0x21, 0xc9, 0x2e, 0x15,
+	0x0a, 0xb2, 0xfd, 0x3f, 0x20, 0x9b, 0x60, 0x14, 0x2c, 0xca, 0xa3, 0x84, 0xfc, 0xc1, 0x02, 0x03,
+	0xae, 0x46, 0xd1, 0xd4, 0x16, 0xc6, 0x6a, 0x18, 0xc3, 0x91, 0xaa, 0xc2, 0xee, 0xa8, 0x39, 0x59,
+	0x9b, 0xdd, 0xb6, 0x59, 0x73, 0xec, 0x3f, 0x3c, 0xaa, 0x1d, 0x6b, 0x5c, 0x3b, 0xc8, 0x94, 0x33,
+	0x9b, 0x04, 0x05, 0x36, 0x9f, 0x81, 0xb2, 0xfe, 0xc6, 0x77, 0x7f, 0xfe, 0xf8, 0xf0, 0x75, 0xf5,
+	0x6d, 0xf0, 0xa7, 0xbe, 0x03, 0xfa, 0x15, 0x7d, 0x6f, 0x81, 0xa5, 0x33, 0xdd, 0xe0, 0xc7, 0x60,
+	0x5e, 0xb0, 0x2d, 0x52, 0x9a, 0xa5, 0x59, 0x71, 0xcd, 0xfa, 0xcb, 0x35, 0x74, 0xcd, 0x1a, 0xba,
+	0x8f, 0x19, 0x2d, 0xfd, 0x65, 0xa3, 0xf0, 0x6d, 0xa3, 0xb0, 0x8c, 0x42, 0x81, 0x8e, 0x86, 0x4f,
+	0xc1, 0x82, 0xce, 0xd2, 0xf4, 0xd8, 0x35, 0x3d, 0x7e, 0xed, 0x72, 0x8f, 0x9f, 0x95, 0x62, 0x5c,
+	0x3b, 0xaf, 0x68, 0x14, 0x1d, 0x84, 0x02, 0x13, 0x8d, 0xfe, 0xee, 0x80, 0x39, 0x99, 0x1c, 0x7c,
+	0x04, 0x16, 0x71, 0x92, 0x54, 0x84, 0x73, 0x33, 0x08, 0x70, 0x5c, 0x3b, 0x77, 0x74, 0x90, 0x79,
+	0x40, 0x41, 0xe3, 0x02, 0xef, 0x80, 0x36, 0x4d, 0x14, 0xf5, 0x5c, 0xd0, 0xa6, 0x09, 0xfc, 0x0a,
+	0x74, 0xd5, 0xe8, 0x9d, 0x13, 0xe4, 0xad, 0xff, 0x9e, 0x63, 0x23, 0xc1, 0x85, 0x1d, 0x6a, 0x5c,
+	0xc2, 0x29, 0x3c, 0x14, 0x80, 0xd1, 0x64, 0x66, 0x3f, 0x07, 0xb7, 0x05, 0x13, 0x38, 0x0f, 0x79,
+	0x86, 0x2b, 0x22, 0x97, 0xe6, 0x9a, 0x46, 0x0e, 0x0c, 0xc3, 0xdd, 0xa6, 0x91, 0x93, 0x60, 0x14,
+	0x74, 0xd5, 0xf5, 0x85, 0xba, 0xc1, 0x2f, 0x4d, 0x19, 0x58, 0x4a, 0xc5, 0xed, 0xf9, 0x9b, 0xaf,
+	0x63, 0xdf, 0x70, 0x40, 0xcd, 0x31, 0x85, 0x62, 0x92, 0x57, 0x6e, 0x1c, 0xbe, 0x6c, 0x92, 0x37,
+	0xea, 0x2d, 0xa8, 0x5e, 0xbf, 0x7f, 0x9d, 0x7a, 0xe7, 0x52, 0x6f, 0x34, 0xd4, 0xa9, 0xeb, 0x79,
+	0x5c, 0x7f, 0xfb, 0xdb, 0x43, 0xa7, 0x75, 0x70, 0xe8, 0xb4, 0x7e, 0xfe, 0xe9, 0xdd, 0x79, 0x99,
+	0xd7, 0x33, 0x39, 0x94, 0xbd, 0x4b, 0x43, 0xe9, 0x6f, 0x1c, 0x9d, 0x0c, 0xad, 0xe3, 0x93, 0xa1,
+	0xf5, 0xc7, 0xc9, 0xd0, 0xda, 0x3f, 0x1d, 0xb6, 0x8e, 0x4f, 0x87, 0xad, 0x5f, 0x4f, 0x87, 0xad,
+	0x2f, 0x3e, 0x4c, 0xa9, 0xc8, 0xb6, 0x23, 0x37, 0x66, 0x85, 0x17, 0x51, 0x11, 0xe1, 0x24, 0x25,
+	0x7c, 0x72, 0x8a, 0x33, 0x4c, 0x4b, 0x6f, 0xd7, 0x9b, 0xf5, 0x1f, 0x18, 0x2d, 0xa8, 0x0f, 0xf4,
+	0x7b, 0xff, 0x0e, 0x00, 0x49, 0xc7, 0x90, 0x7c, 0x26, 0x07, 0x00, 0x00,
+}
+
+func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SmoothWeightChangeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SmoothWeightChangeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetPoolWeights) > 0 {
+		for iNdEx := len(m.TargetPoolWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetPoolWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InitialPoolWeights) > 0 {
+		for iNdEx := len(m.InitialPoolWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialPoolWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -249,6 +433,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SmoothWeightChangeParams != nil {
+		{
+			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -405,6 +601,31 @@ func encodeVarintBalancerPool(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *SmoothWeightChangeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	if len(m.InitialPoolWeights) > 0 {
+		for _, e := range m.InitialPoolWeights {
+			l = e.Size()
+			n += 1 + l + sovBalancerPool(uint64(l))
+		}
+	}
+	if len(m.TargetPoolWeights) > 0 {
+		for _, e := range m.TargetPoolWeights {
+			l = e.Size()
+			n += 1 + l + sovBalancerPool(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PoolParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -415,6 +636,10 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovBalancerPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.SmoothWeightChangeParams != nil {
+		l = m.SmoothWeightChangeParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -465,6 +690,190 @@ func sovBalancerPool(x uint64) (n int) {
 func sozBalancerPool(x uint64) (n int) {
 	return sovBalancerPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalancerPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmoothWeightChangeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmoothWeightChangeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialPoolWeights = append(m.InitialPoolWeights, PoolAsset{})
+			if err := m.InitialPoolWeights[len(m.InitialPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPoolWeights = append(m.TargetPoolWeights, PoolAsset{})
+			if err := m.TargetPoolWeights[len(m.TargetPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalancerPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -562,6 +971,42 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SmoothWeightChangeParams == nil {
+				m.SmoothWeightChangeParams = &SmoothWeightChangeParams{}
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"time"
+
 	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
 )
 
@@ -17,6 +19,8 @@ var (
 	//
 	// This is done so that smooth weight changes have enough precision to actually be smooth.
 	GuaranteedWeightPrecision int64 = 1 << 30
+	// MaxSmoothWeightChangeDuration bounds the duration of a pool's weight schedule.
+	MaxSmoothWeightChangeDuration = 365 * 24 * time.Hour
 
 	PoolTypeName string = "Balancer"
 	oneDec              = osmomath.OneDec()
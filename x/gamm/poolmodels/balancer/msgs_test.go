@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -177,27 +178,68 @@ func TestMsgCreateBalancerPool_ValidateBasic(t *testing.T) {
 			}),
 			expectPass: false,
 		},
-		// {
-		// 	name: "Create an LBP",
-		// 	msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
-		// 		msg.PoolParams.SmoothWeightChangeParams = &SmoothWeightChangeParams{
-		// 			StartTime: time.Now(),
-		// 			Duration:  time.Hour,
-		// 			TargetPoolWeights: []PoolAsset{
-		// 				{
-		// 					Weight: osmomath.NewInt(200),
-		// 					Token:  sdk.NewCoin("test", osmomath.NewInt(1)),
-		// 				},
-		// 				{
-		// 					Weight: osmomath.NewInt(50),
-		// 					Token:  sdk.NewCoin("test2", osmomath.NewInt(1)),
-		// 				},
-		// 			},
-		// 		}
-		// 		return msg
-		// 	}),
-		// 	expectPass: true,
-		// },
+		{
+			name: "Create an LBP",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.SmoothWeightChangeParams = &balancer.SmoothWeightChangeParams{
+					StartTime: time.Now(),
+					Duration:  time.Hour,
+					TargetPoolWeights: []balancer.PoolAsset{
+						{
+							Weight: osmomath.NewInt(200),
+							Token:  sdk.NewCoin("test", osmomath.NewInt(1)),
+						},
+						{
+							Weight: osmomath.NewInt(50),
+							Token:  sdk.NewCoin("test2", osmomath.NewInt(1)),
+						},
+					},
+				}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "LBP with target denoms not in the pool",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.SmoothWeightChangeParams = &balancer.SmoothWeightChangeParams{
+					StartTime: time.Now(),
+					Duration:  time.Hour,
+					TargetPoolWeights: []balancer.PoolAsset{
+						{
+							Weight: osmomath.NewInt(200),
+							Token:  sdk.NewCoin("test", osmomath.NewInt(1)),
+						},
+						{
+							Weight: osmomath.NewInt(50),
+							Token:  sdk.NewCoin("test3", osmomath.NewInt(1)),
+						},
+					},
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "LBP with zero duration",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.SmoothWeightChangeParams = &balancer.SmoothWeightChangeParams{
+					StartTime: time.Now(),
+					TargetPoolWeights: []balancer.PoolAsset{
+						{
+							Weight: osmomath.NewInt(200),
+							Token:  sdk.NewCoin("test", osmomath.NewInt(1)),
+						},
+						{
+							Weight: osmomath.NewInt(50),
+							Token:  sdk.NewCoin("test2", osmomath.NewInt(1)),
+						},
+					},
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
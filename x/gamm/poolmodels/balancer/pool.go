@@ -174,6 +174,48 @@ func (p *Pool) SetInitialPoolAssets(PoolAssets []PoolAsset) error {
 // setInitialPoolParams
 func (p *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset, curBlockTime time.Time) error {
 	p.PoolParams = params
+	if params.SmoothWeightChangeParams != nil {
+		// Copy the schedule, so that the caller's params are not mutated
+		schedule := *params.SmoothWeightChangeParams
+
+		// set initial assets
+		initialWeights := make([]PoolAsset, len(sortedAssets))
+		for i, v := range sortedAssets {
+			initialWeights[i] = PoolAsset{
+				Weight: v.Weight,
+				Token:  sdk.Coin{Denom: v.Token.Denom, Amount: osmomath.ZeroInt()},
+			}
+		}
+		schedule.InitialPoolWeights = initialWeights
+
+		// sort target weights by denom, and scale them by GuaranteedWeightPrecision
+		targetPoolWeights := sortPoolAssetsOutOfPlaceByDenom(schedule.TargetPoolWeights)
+		for i, v := range targetPoolWeights {
+			err := ValidateUserSpecifiedWeight(v.Weight)
+			if err != nil {
+				return err
+			}
+			targetPoolWeights[i] = PoolAsset{
+				Weight: v.Weight.MulRaw(GuaranteedWeightPrecision),
+				Token:  sdk.Coin{Denom: v.Token.Denom, Amount: osmomath.ZeroInt()},
+			}
+		}
+		schedule.TargetPoolWeights = targetPoolWeights
+
+		// Set start time if not present.
+		if schedule.StartTime.Unix() <= 0 {
+			// Per https://golang.org/pkg/time/#Time.Unix, should be timezone independent
+			schedule.StartTime = time.Unix(curBlockTime.Unix(), 0)
+		}
+		// Bound the start time, so that a schedule cannot be parked in the far future.
+		// This is checked here rather than in PoolParams.Validate, which has no block time.
+		if schedule.StartTime.After(curBlockTime.Add(MaxSmoothWeightChangeDuration)) {
+			return errorsmod.Wrapf(types.ErrInvalidPool, "smooth weight change start time must be at most %s after the block time, got %s", MaxSmoothWeightChangeDuration, schedule.StartTime)
+		}
+
+		p.PoolParams.SmoothWeightChangeParams = &schedule
+	}
+
 	return nil
 }
 
@@ -365,10 +407,60 @@ func (p *Pool) updateAllWeights(newWeights []PoolAsset) {
 // PokePool checks to see if the pool's token weights need to be updated, and
 // if so, does so. Currently doesn't do anything outside out LBPs.
 func (p *Pool) PokePool(blockTime time.Time) {
-	poolWeightsChanging := false
+	// check if pool weights didn't change
+	poolWeightsChanging := p.PoolParams.SmoothWeightChangeParams != nil
 	if !poolWeightsChanging {
 		return
 	}
+
+	params := *p.PoolParams.SmoothWeightChangeParams
+
+	// The weights w(t) for the pool at time `t` is defined in one of three
+	// possible ways:
+	//
+	// 1. t <= start_time: w(t) = initial_pool_weights
+	//
+	// 2. start_time < t <= start_time + duration:
+	//     w(t) = initial_pool_weights + (t - start_time) *
+	//       (target_pool_weights - initial_pool_weights) / (duration)
+	//
+	// 3. t > start_time + duration: w(t) = target_pool_weights
+	switch {
+	case !blockTime.After(params.StartTime):
+		// case 1: t <= start_time
+		return
+
+	case !blockTime.Before(params.StartTime.Add(params.Duration)):
+		// case 3: t >= start_time + duration
+
+		// Update weights to be the target weights.
+		p.updateAllWeights(params.TargetPoolWeights)
+
+		// we've finished updating the weights, so reset the following fields
+		p.PoolParams.SmoothWeightChangeParams = nil
+		return
+
+	default:
+		// case 2: start_time < t < start_time + duration
+		shiftedBlockTime := blockTime.Sub(params.StartTime).Milliseconds()
+		percentDurationElapsed := osmomath.NewDec(shiftedBlockTime).QuoInt64(params.Duration.Milliseconds())
+
+		// If the duration elapsed is equal to the total time, or a rounding error
+		// makes it seem like it is, just set to target weight.
+		if percentDurationElapsed.GTE(osmomath.OneDec()) {
+			p.updateAllWeights(params.TargetPoolWeights)
+			// the schedule is finished, as in case 3
+			p.PoolParams.SmoothWeightChangeParams = nil
+			return
+		}
+
+		// below will be auto-truncated according to internal weight precision routine
+		totalWeightsDiff := subPoolAssetWeights(params.TargetPoolWeights, params.InitialPoolWeights)
+		scaledDiff := poolAssetsMulDec(totalWeightsDiff, percentDurationElapsed)
+		updatedWeights := addPoolAssetWeights(params.InitialPoolWeights, scaledDiff)
+
+		p.updateAllWeights(updatedWeights)
+	}
 }
 
 func (p Pool) GetTokenWeight(denom string) (osmomath.Int, error) {
@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
 	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
 )
@@ -29,6 +31,37 @@ func (params PoolParams) Validate(poolWeights []PoolAsset) error {
 		return types.ErrTooMuchSpreadFactor
 	}
 
+	if params.SmoothWeightChangeParams != nil {
+		targetWeights := params.SmoothWeightChangeParams.TargetPoolWeights
+		// Ensure it has the right number of weights
+		if len(targetWeights) != len(poolWeights) {
+			return types.ErrPoolParamsInvalidNumDenoms
+		}
+		// Validate all user specified weights
+		for _, targetWeight := range targetWeights {
+			if err := ValidateUserSpecifiedWeight(targetWeight.Weight); err != nil {
+				return err
+			}
+		}
+		// Ensure that all the target weight denoms are same as pool asset weights
+		sortedTargetPoolWeights := sortPoolAssetsOutOfPlaceByDenom(targetWeights)
+		sortedPoolWeights := sortPoolAssetsOutOfPlaceByDenom(poolWeights)
+		for i, v := range sortedPoolWeights {
+			if sortedTargetPoolWeights[i].Token.Denom != v.Token.Denom {
+				return types.ErrPoolParamsInvalidDenom
+			}
+		}
+
+		// We do not need to validate InitialPoolWeights, as we set that ourselves
+		// in setInitialPoolParams. StartTime is bounded there too, as it depends on the block time.
+		if params.SmoothWeightChangeParams.Duration <= 0 {
+			return errorsmod.Wrapf(types.ErrInvalidPool, "smooth weight change duration must be positive, got %s", params.SmoothWeightChangeParams.Duration)
+		}
+		if params.SmoothWeightChangeParams.Duration > MaxSmoothWeightChangeDuration {
+			return errorsmod.Wrapf(types.ErrInvalidPool, "smooth weight change duration must be at most %s, got %s", MaxSmoothWeightChangeDuration, params.SmoothWeightChangeParams.Duration)
+		}
+	}
+
 	return nil
 }
 
@@ -949,223 +949,272 @@ func TestLBPParamsEmptyStartTime(t *testing.T) {
 		},
 	}
 
-	_, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
-		SwapFee: defaultSpreadFactor,
-		ExitFee: defaultZeroExitFee,
+	params := balancer.SmoothWeightChangeParams{
+		Duration: 100 * time.Second,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{
+				Weight: osmomath.NewInt(1),
+				Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
+			},
+			{
+				Weight: osmomath.NewInt(2),
+				Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
+			},
+		},
+	}
+
+	pool, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee:                  defaultSpreadFactor,
+		ExitFee:                  defaultZeroExitFee,
+		SmoothWeightChangeParams: &params,
+	}, initialPoolAssets, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	// An empty start time defaults to the block time at pool creation
+	require.Equal(t, defaultCurBlockTime, pool.PoolParams.SmoothWeightChangeParams.StartTime)
+
+	// Start times too far after the block time are rejected
+	params.StartTime = defaultCurBlockTime.Add(balancer.MaxSmoothWeightChangeDuration + time.Second)
+	_, err = balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee:                  defaultSpreadFactor,
+		ExitFee:                  defaultZeroExitFee,
+		SmoothWeightChangeParams: &params,
+	}, initialPoolAssets, defaultCurBlockTime)
+	require.ErrorIs(t, err, types.ErrInvalidPool)
+
+	params.StartTime = defaultCurBlockTime.Add(balancer.MaxSmoothWeightChangeDuration)
+	_, err = balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee:                  defaultSpreadFactor,
+		ExitFee:                  defaultZeroExitFee,
+		SmoothWeightChangeParams: &params,
+	}, initialPoolAssets, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	// A schedule whose elapsed fraction rounds up to the end is finished, like one past its end
+	params.StartTime = defaultCurBlockTime
+	params.Duration = 100*time.Second + 500*time.Microsecond
+	pool, err = balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee:                  defaultSpreadFactor,
+		ExitFee:                  defaultZeroExitFee,
+		SmoothWeightChangeParams: &params,
 	}, initialPoolAssets, defaultCurBlockTime)
 	require.NoError(t, err)
+
+	pool.PokePool(defaultCurBlockTime.Add(100*time.Second + 100*time.Microsecond))
+	require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+	weight, err := pool.GetTokenWeight("asset2")
+	require.NoError(t, err)
+	require.Equal(t, osmomath.NewInt(2*balancer.GuaranteedWeightPrecision), weight)
 }
 
-// func TestBalancerPoolPokeTokenWeights(t *testing.T) {
-// 	// Set default date
-// 	defaultStartTime := time.Unix(1618703511, 0)
-// 	defaultStartTimeUnix := defaultStartTime.Unix()
-// 	defaultDuration := 100 * time.Second
-// 	floatGuaranteedPrecision := float64(balancer.GuaranteedWeightPrecision)
-
-// 	// testCases don't need to be ordered by time. but the blockTime should be
-// 	// less than the end time of the SmoothWeightChange. Testing past the end time
-// 	// is already handled.
-// 	type testCase struct {
-// 		blockTime       time.Time
-// 		expectedWeights []osmomath.Int
-// 	}
-
-// 	// Tests how the pool weights get updated via PokeTokenWeights at different block times.
-// 	// The framework underneath will automatically add tests for times before the start time,
-// 	// at the start time, at the end time, and after the end time. It is up to the test writer to
-// 	// test the behavior at times in-between.
-// 	tests := []struct {
-// 		// We take the initial weights from here
-// 		params balancer.SmoothWeightChangeParams
-// 		cases  []testCase
-// 	}{
-// 		{
-// 			// 1:1 pool, between asset1 and asset2
-// 			// transitioning to a 1:2 pool
-// 			params: balancer.SmoothWeightChangeParams{
-// 				StartTime: defaultStartTime,
-// 				Duration:  defaultDuration,
-// 				InitialPoolWeights: []balancer.PoolAsset{
-// 					{
-// 						Weight: osmomath.NewInt(1),
-// 						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
-// 					},
-// 					{
-// 						Weight: osmomath.NewInt(1),
-// 						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
-// 					},
-// 				},
-// 				TargetPoolWeights: []balancer.PoolAsset{
-// 					{
-// 						Weight: osmomath.NewInt(1),
-// 						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
-// 					},
-// 					{
-// 						Weight: osmomath.NewInt(2),
-// 						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
-// 					},
-// 				},
-// 			},
-// 			cases: []testCase{
-// 				{
-// 					// Halfway through at 50 seconds elapsed
-// 					blockTime: time.Unix(defaultStartTimeUnix+50, 0),
-// 					expectedWeights: []osmomath.Int{
-// 						osmomath.NewInt(1 * balancer.GuaranteedWeightPrecision),
-// 						// Halfway between 1 & 2
-// 						osmomath.NewInt(3 * balancer.GuaranteedWeightPrecision / 2),
-// 					},
-// 				},
-// 				{
-// 					// Quarter way through at 25 seconds elapsed
-// 					blockTime: time.Unix(defaultStartTimeUnix+25, 0),
-// 					expectedWeights: []osmomath.Int{
-// 						osmomath.NewInt(1 * balancer.GuaranteedWeightPrecision),
-// 						// Quarter way between 1 & 2 = 1.25
-// 						osmomath.NewInt(int64(1.25 * floatGuaranteedPrecision)),
-// 					},
-// 				},
-// 			},
-// 		},
-// 		{
-// 			// 2:2 pool, between asset1 and asset2
-// 			// transitioning to a 4:1 pool
-// 			params: balancer.SmoothWeightChangeParams{
-// 				StartTime: defaultStartTime,
-// 				Duration:  defaultDuration,
-// 				InitialPoolWeights: []balancer.PoolAsset{
-// 					{
-// 						Weight: osmomath.NewInt(2),
-// 						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
-// 					},
-// 					{
-// 						Weight: osmomath.NewInt(2),
-// 						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
-// 					},
-// 				},
-// 				TargetPoolWeights: []balancer.PoolAsset{
-// 					{
-// 						Weight: osmomath.NewInt(4),
-// 						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
-// 					},
-// 					{
-// 						Weight: osmomath.NewInt(1),
-// 						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
-// 					},
-// 				},
-// 			},
-// 			cases: []testCase{
-// 				{
-// 					// Halfway through at 50 seconds elapsed
-// 					blockTime: time.Unix(defaultStartTimeUnix+50, 0),
-// 					expectedWeights: []osmomath.Int{
-// 						// Halfway between 2 & 4
-// 						osmomath.NewInt(6 * balancer.GuaranteedWeightPrecision / 2),
-// 						// Halfway between 1 & 2
-// 						osmomath.NewInt(3 * balancer.GuaranteedWeightPrecision / 2),
-// 					},
-// 				},
-// 				{
-// 					// Quarter way through at 25 seconds elapsed
-// 					blockTime: time.Unix(defaultStartTimeUnix+25, 0),
-// 					expectedWeights: []osmomath.Int{
-// 						// Quarter way between 2 & 4 = 2.5
-// 						osmomath.NewInt(int64(2.5 * floatGuaranteedPrecision)),
-// 						// Quarter way between 2 & 1 = 1.75
-// 						osmomath.NewInt(int64(1.75 * floatGuaranteedPrecision)),
-// 					},
-// 				},
-// 			},
-// 		},
-// 	}
-
-// 	// Add test cases at a time before the start, the start, the end, and a time after the end.
-// 	addDefaultCases := func(params balancer.SmoothWeightChangeParams, cases []testCase) []testCase {
-// 		// Set times one second before the start, and one second after the end
-// 		timeBeforeWeightChangeStart := time.Unix(params.StartTime.Unix()-1, 0)
-// 		timeAtWeightChangeEnd := params.StartTime.Add(params.Duration)
-// 		timeAfterWeightChangeEnd := time.Unix(timeAtWeightChangeEnd.Unix()+1, 0)
-// 		initialWeights := make([]osmomath.Int, len(params.InitialPoolWeights))
-// 		finalWeights := make([]osmomath.Int, len(params.TargetPoolWeights))
-// 		for i, v := range params.InitialPoolWeights {
-// 			initialWeights[i] = v.Weight.MulRaw(balancer.GuaranteedWeightPrecision)
-// 		}
-// 		for i, v := range params.TargetPoolWeights {
-// 			// Doesn't need to be scaled, due to this being done already in param initialization,
-// 			// and because params is only shallow copied
-// 			finalWeights[i] = v.Weight
-// 		}
-// 		// Set the test cases for times before the start, and the start
-// 		updatedCases := []testCase{
-// 			{
-// 				blockTime:       timeBeforeWeightChangeStart,
-// 				expectedWeights: initialWeights,
-// 			},
-// 			{
-// 				blockTime:       params.StartTime,
-// 				expectedWeights: initialWeights,
-// 			},
-// 		}
-// 		// Append the provided cases
-// 		updatedCases = append(updatedCases, cases...)
-// 		finalCases := []testCase{
-// 			{
-// 				blockTime:       timeAtWeightChangeEnd,
-// 				expectedWeights: finalWeights,
-// 			},
-// 			{
-// 				blockTime:       timeAfterWeightChangeEnd,
-// 				expectedWeights: finalWeights,
-// 			},
-// 		}
-// 		// Append the final cases
-// 		updatedCases = append(updatedCases, finalCases...)
-// 		return updatedCases
-// 	}
-
-// 	for poolId, tc := range tests {
-// 		paramsCopy := tc.params
-// 		// First we create the initial pool assets we will use
-// 		initialPoolAssets := make([]balancer.PoolAsset, len(paramsCopy.InitialPoolWeights))
-// 		for i, asset := range paramsCopy.InitialPoolWeights {
-// 			assetCopy := balancer.PoolAsset{
-// 				Weight: asset.Weight,
-// 				Token:  sdk.NewInt64Coin(asset.Token.Denom, 10000),
-// 			}
-// 			initialPoolAssets[i] = assetCopy
-// 		}
-// 		// Initialize the pool
-// 		pacc, err := balancer.NewBalancerPool(uint64(poolId), balancer.PoolParams{
-// 			SwapFee:                  defaultSpreadFactor,
-// 			ExitFee:                  defaultZeroExitFee,
-// 			SmoothWeightChangeParams: &tc.params,
-// 		}, initialPoolAssets, defaultCurBlockTime)
-// 		require.NoError(t, err, "poolId %v", poolId)
-
-// 		// Consistency check that SmoothWeightChangeParams params are set
-// 		require.NotNil(t, pacc.PoolParams.SmoothWeightChangeParams)
-
-// 		testCases := addDefaultCases(paramsCopy, tc.cases)
-// 		for caseNum, testCase := range testCases {
-// 			pacc.PokePool(testCase.blockTime)
-
-// 			totalWeight := osmomath.ZeroInt()
-
-// 			for assetNum, asset := range pacc.GetAllPoolAssets() {
-// 				require.Equal(t, testCase.expectedWeights[assetNum], asset.Weight,
-// 					"Didn't get the expected weights, poolId %v, caseNumber %v, assetNumber %v",
-// 					poolId, caseNum, assetNum)
-
-// 				totalWeight = totalWeight.Add(asset.Weight)
-// 			}
-
-// 			require.Equal(t, totalWeight, pacc.GetTotalWeight())
-// 		}
-// 		// Should have been deleted by the last test case of after PokeTokenWeights pokes past end time.
-// 		require.Nil(t, pacc.PoolParams.SmoothWeightChangeParams)
-// 	}
-// }
+func TestBalancerPoolPokeTokenWeights(t *testing.T) {
+	// Set default date
+	defaultStartTime := time.Unix(1618703511, 0)
+	defaultStartTimeUnix := defaultStartTime.Unix()
+	defaultDuration := 100 * time.Second
+	floatGuaranteedPrecision := float64(balancer.GuaranteedWeightPrecision)
+
+	// testCases don't need to be ordered by time. but the blockTime should be
+	// less than the end time of the SmoothWeightChange. Testing past the end time
+	// is already handled.
+	type testCase struct {
+		blockTime       time.Time
+		expectedWeights []osmomath.Int
+	}
+
+	// Tests how the pool weights get updated via PokeTokenWeights at different block times.
+	// The framework underneath will automatically add tests for times before the start time,
+	// at the start time, at the end time, and after the end time. It is up to the test writer to
+	// test the behavior at times in-between.
+	tests := []struct {
+		// We take the initial weights from here
+		params balancer.SmoothWeightChangeParams
+		cases  []testCase
+	}{
+		{
+			// 1:1 pool, between asset1 and asset2
+			// transitioning to a 1:2 pool
+			params: balancer.SmoothWeightChangeParams{
+				StartTime: defaultStartTime,
+				Duration:  defaultDuration,
+				InitialPoolWeights: []balancer.PoolAsset{
+					{
+						Weight: osmomath.NewInt(1),
+						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
+					},
+					{
+						Weight: osmomath.NewInt(1),
+						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
+					},
+				},
+				TargetPoolWeights: []balancer.PoolAsset{
+					{
+						Weight: osmomath.NewInt(1),
+						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
+					},
+					{
+						Weight: osmomath.NewInt(2),
+						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
+					},
+				},
+			},
+			cases: []testCase{
+				{
+					// Halfway through at 50 seconds elapsed
+					blockTime: time.Unix(defaultStartTimeUnix+50, 0),
+					expectedWeights: []osmomath.Int{
+						osmomath.NewInt(1 * balancer.GuaranteedWeightPrecision),
+						// Halfway between 1 & 2
+						osmomath.NewInt(3 * balancer.GuaranteedWeightPrecision / 2),
+					},
+				},
+				{
+					// Quarter way through at 25 seconds elapsed
+					blockTime: time.Unix(defaultStartTimeUnix+25, 0),
+					expectedWeights: []osmomath.Int{
+						osmomath.NewInt(1 * balancer.GuaranteedWeightPrecision),
+						// Quarter way between 1 & 2 = 1.25
+						osmomath.NewInt(int64(1.25 * floatGuaranteedPrecision)),
+					},
+				},
+			},
+		},
+		{
+			// 2:2 pool, between asset1 and asset2
+			// transitioning to a 4:1 pool
+			params: balancer.SmoothWeightChangeParams{
+				StartTime: defaultStartTime,
+				Duration:  defaultDuration,
+				InitialPoolWeights: []balancer.PoolAsset{
+					{
+						Weight: osmomath.NewInt(2),
+						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
+					},
+					{
+						Weight: osmomath.NewInt(2),
+						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
+					},
+				},
+				TargetPoolWeights: []balancer.PoolAsset{
+					{
+						Weight: osmomath.NewInt(4),
+						Token:  sdk.NewCoin("asset1", osmomath.NewInt(0)),
+					},
+					{
+						Weight: osmomath.NewInt(1),
+						Token:  sdk.NewCoin("asset2", osmomath.NewInt(0)),
+					},
+				},
+			},
+			cases: []testCase{
+				{
+					// Halfway through at 50 seconds elapsed
+					blockTime: time.Unix(defaultStartTimeUnix+50, 0),
+					expectedWeights: []osmomath.Int{
+						// Halfway between 2 & 4
+						osmomath.NewInt(6 * balancer.GuaranteedWeightPrecision / 2),
+						// Halfway between 1 & 2
+						osmomath.NewInt(3 * balancer.GuaranteedWeightPrecision / 2),
+					},
+				},
+				{
+					// Quarter way through at 25 seconds elapsed
+					blockTime: time.Unix(defaultStartTimeUnix+25, 0),
+					expectedWeights: []osmomath.Int{
+						// Quarter way between 2 & 4 = 2.5
+						osmomath.NewInt(int64(2.5 * floatGuaranteedPrecision)),
+						// Quarter way between 2 & 1 = 1.75
+						osmomath.NewInt(int64(1.75 * floatGuaranteedPrecision)),
+					},
+				},
+			},
+		},
+	}
+
+	// Add test cases at a time before the start, the start, the end, and a time after the end.
+	addDefaultCases := func(params balancer.SmoothWeightChangeParams, cases []testCase) []testCase {
+		// Set times one second before the start, and one second after the end
+		timeBeforeWeightChangeStart := time.Unix(params.StartTime.Unix()-1, 0)
+		timeAtWeightChangeEnd := params.StartTime.Add(params.Duration)
+		timeAfterWeightChangeEnd := time.Unix(timeAtWeightChangeEnd.Unix()+1, 0)
+		initialWeights := make([]osmomath.Int, len(params.InitialPoolWeights))
+		finalWeights := make([]osmomath.Int, len(params.TargetPoolWeights))
+		for i, v := range params.InitialPoolWeights {
+			initialWeights[i] = v.Weight.MulRaw(balancer.GuaranteedWeightPrecision)
+		}
+		for i, v := range params.TargetPoolWeights {
+			finalWeights[i] = v.Weight.MulRaw(balancer.GuaranteedWeightPrecision)
+		}
+		// Set the test cases for times before the start, and the start
+		updatedCases := []testCase{
+			{
+				blockTime:       timeBeforeWeightChangeStart,
+				expectedWeights: initialWeights,
+			},
+			{
+				blockTime:       params.StartTime,
+				expectedWeights: initialWeights,
+			},
+		}
+		// Append the provided cases
+		updatedCases = append(updatedCases, cases...)
+		finalCases := []testCase{
+			{
+				blockTime:       timeAtWeightChangeEnd,
+				expectedWeights: finalWeights,
+			},
+			{
+				blockTime:       timeAfterWeightChangeEnd,
+				expectedWeights: finalWeights,
+			},
+		}
+		// Append the final cases
+		updatedCases = append(updatedCases, finalCases...)
+		return updatedCases
+	}
+
+	for poolId, tc := range tests {
+		paramsCopy := tc.params
+		// First we create the initial pool assets we will use
+		initialPoolAssets := make([]balancer.PoolAsset, len(paramsCopy.InitialPoolWeights))
+		for i, asset := range paramsCopy.InitialPoolWeights {
+			assetCopy := balancer.PoolAsset{
+				Weight: asset.Weight,
+				Token:  sdk.NewInt64Coin(asset.Token.Denom, 10000),
+			}
+			initialPoolAssets[i] = assetCopy
+		}
+		// Initialize the pool
+		pacc, err := balancer.NewBalancerPool(uint64(poolId), balancer.PoolParams{
+			SwapFee:                  defaultSpreadFactor,
+			ExitFee:                  defaultZeroExitFee,
+			SmoothWeightChangeParams: &tc.params,
+		}, initialPoolAssets, defaultCurBlockTime)
+		require.NoError(t, err, "poolId %v", poolId)
+
+		// Consistency check that SmoothWeightChangeParams params are set
+		require.NotNil(t, pacc.PoolParams.SmoothWeightChangeParams)
+
+		testCases := addDefaultCases(paramsCopy, tc.cases)
+		for caseNum, testCase := range testCases {
+			pacc.PokePool(testCase.blockTime)
+
+			totalWeight := osmomath.ZeroInt()
+
+			for assetNum, asset := range pacc.GetAllPoolAssets() {
+				require.Equal(t, testCase.expectedWeights[assetNum], asset.Weight,
+					"Didn't get the expected weights, poolId %v, caseNumber %v, assetNumber %v",
+					poolId, caseNum, assetNum)
+
+				totalWeight = totalWeight.Add(asset.Weight)
+			}
+
+			require.Equal(t, totalWeight, pacc.GetTotalWeight())
+		}
+		// Should have been deleted by the last test case of after PokeTokenWeights pokes past end time.
+		require.Nil(t, pacc.PoolParams.SmoothWeightChangeParams)
+	}
+}
 
 // This test (currently trivially) checks to make sure that `IsActive` returns true for balancer pools.
 // This is mainly to make sure that if IsActive is ever used as an emergency switch, it is not accidentally left off for any (or all) pools.
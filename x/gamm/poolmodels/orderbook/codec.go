@@ -0,0 +1,45 @@
+package orderbook
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	types "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&Pool{}, "gamm/OrderbookPool", nil)
+	cdc.RegisterConcrete(&MsgCreateOrderbookPool{}, "gamm/CreateOrderbookPool", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "gamm/PlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "gamm/CancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgClaimLimitOrder{}, "gamm/ClaimLimitOrder", nil)
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"poolmanager.v1beta1.PoolI",
+		(*poolmanagertypes.PoolI)(nil),
+		&Pool{},
+	)
+	registry.RegisterInterface(
+		"gamm.v1beta1.PoolI",
+		(*types.CFMMPoolI)(nil),
+		&Pool{},
+	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateOrderbookPool{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgClaimLimitOrder{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+const PoolTypeName string = "Orderbook"
@@ -0,0 +1,189 @@
+package orderbook
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+const (
+	TypeMsgCreateOrderbookPool = "create_orderbook_pool"
+	TypeMsgPlaceLimitOrder     = "place_limit_order"
+	TypeMsgCancelLimitOrder    = "cancel_limit_order"
+	TypeMsgClaimLimitOrder     = "claim_limit_order"
+)
+
+var (
+	_ sdk.Msg                        = &MsgCreateOrderbookPool{}
+	_ poolmanagertypes.CreatePoolMsg = &MsgCreateOrderbookPool{}
+	_ sdk.Msg                        = &MsgPlaceLimitOrder{}
+	_ sdk.Msg                        = &MsgCancelLimitOrder{}
+	_ sdk.Msg                        = &MsgClaimLimitOrder{}
+)
+
+func NewMsgCreateOrderbookPool(
+	sender sdk.AccAddress,
+	baseDenom string,
+	quoteDenom string,
+	tickSize osmomath.Dec,
+	minOrderNotional osmomath.Int,
+) MsgCreateOrderbookPool {
+	return MsgCreateOrderbookPool{
+		Sender:           sender.String(),
+		BaseDenom:        baseDenom,
+		QuoteDenom:       quoteDenom,
+		TickSize:         tickSize,
+		MinOrderNotional: minOrderNotional,
+	}
+}
+
+func (msg MsgCreateOrderbookPool) Route() string { return types.RouterKey }
+func (msg MsgCreateOrderbookPool) Type() string  { return TypeMsgCreateOrderbookPool }
+func (msg MsgCreateOrderbookPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validatePoolParams(msg.BaseDenom, msg.QuoteDenom, msg.TickSize, msg.MinOrderNotional)
+}
+
+func (msg MsgCreateOrderbookPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateOrderbookPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateOrderbookPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+// InitialLiquidity is empty, as orderbook pools are only funded by limit orders.
+// The creator pays the orderbook pool creation fee instead, see the x/gamm params.
+func (msg MsgCreateOrderbookPool) InitialLiquidity() sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (msg MsgCreateOrderbookPool) CreatePool(ctx sdk.Context, poolID uint64) (poolmanagertypes.PoolI, error) {
+	pool, err := NewOrderbookPool(poolID, msg.BaseDenom, msg.QuoteDenom, msg.TickSize, msg.MinOrderNotional)
+	return &pool, err
+}
+
+func (msg MsgCreateOrderbookPool) GetPoolType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Orderbook
+}
+
+func NewMsgPlaceLimitOrder(
+	sender sdk.AccAddress,
+	poolId uint64,
+	direction types.OrderDirection,
+	tickId uint64,
+	quantity osmomath.Int,
+) MsgPlaceLimitOrder {
+	return MsgPlaceLimitOrder{
+		Sender:    sender.String(),
+		PoolId:    poolId,
+		Direction: direction,
+		TickId:    tickId,
+		Quantity:  quantity,
+	}
+}
+
+func (msg MsgPlaceLimitOrder) Route() string { return types.RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Direction != types.Bid && msg.Direction != types.Ask {
+		return errorsmod.Wrapf(types.ErrInvalidLimitOrder, "invalid direction (%d)", msg.Direction)
+	}
+
+	if msg.TickId == 0 || msg.TickId > MaxTickId {
+		return errorsmod.Wrapf(types.ErrInvalidLimitOrder, "tick id must be in [1, %d], got (%d)", MaxTickId, msg.TickId)
+	}
+
+	if msg.Quantity.IsNil() || !msg.Quantity.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidLimitOrder, "quantity must be positive, got (%s)", msg.Quantity)
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgCancelLimitOrder(sender sdk.AccAddress, poolId uint64, orderId uint64) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{
+		Sender:  sender.String(),
+		PoolId:  poolId,
+		OrderId: orderId,
+	}
+}
+
+func (msg MsgCancelLimitOrder) Route() string { return types.RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgClaimLimitOrder(sender sdk.AccAddress, poolId uint64, orderId uint64) MsgClaimLimitOrder {
+	return MsgClaimLimitOrder{
+		Sender:  sender.String(),
+		PoolId:  poolId,
+		OrderId: orderId,
+	}
+}
+
+func (msg MsgClaimLimitOrder) Route() string { return types.RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,1383 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gamm/poolmodels/orderbook/v1beta1/orderbook_pool.proto
+
+package orderbook
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CanceledRange is a range of a tick's order queue that was canceled before
+// being reached by fills. Fills skip over canceled ranges.
+type CanceledRange struct {
+	Start cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=start,proto3,customtype=cosmossdk.io/math.Int" json:"start"`
+	End   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=end,proto3,customtype=cosmossdk.io/math.Int" json:"end"`
+}
+
+func (m *CanceledRange) Reset()         { *m = CanceledRange{} }
+func (m *CanceledRange) String() string { return proto.CompactTextString(m) }
+func (*CanceledRange) ProtoMessage()    {}
+func (*CanceledRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41c35c0bbf2e6c12, []int{0}
+}
+func (m *CanceledRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanceledRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanceledRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanceledRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanceledRange.Merge(m, src)
+}
+func (m *CanceledRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanceledRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanceledRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanceledRange proto.InternalMessageInfo
+
+// TickState is the FIFO order queue of one side of the book at a tick.
+// Amounts are in the denom resting on that side (base for asks, quote for
+// bids).
+type TickState struct {
+	TickId uint64 `protobuf:"varint,1,opt,name=tick_id,json=tickId,proto3" json:"tick_id,omitempty" yaml:"tick_id"`
+	// resting_amount is the amount not yet filled nor canceled
+	RestingAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=resting_amount,json=restingAmount,proto3,customtype=cosmossdk.io/math.Int" json:"resting_amount" yaml:"resting_amount"`
+	// cumulative_placed is the total amount ever placed at the tick
+	CumulativePlaced cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=cumulative_placed,json=cumulativePlaced,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_placed" yaml:"cumulative_placed"`
+	// cumulative_filled is the position in the queue up to which orders are
+	// filled (including canceled ranges that were skipped)
+	CumulativeFilled cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=cumulative_filled,json=cumulativeFilled,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_filled" yaml:"cumulative_filled"`
+	// canceled_ranges are the canceled ranges after cumulative_filled, sorted
+	CanceledRanges []CanceledRange `protobuf:"bytes,5,rep,name=canceled_ranges,json=canceledRanges,proto3" json:"canceled_ranges" yaml:"canceled_ranges"`
+	// num_orders is the number of open orders at the tick
+	NumOrders uint64 `protobuf:"varint,6,opt,name=num_orders,json=numOrders,proto3" json:"num_orders,omitempty" yaml:"num_orders"`
+}
+
+func (m *TickState) Reset()         { *m = TickState{} }
+func (m *TickState) String() string { return proto.CompactTextString(m) }
+func (*TickState) ProtoMessage()    {}
+func (*TickState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41c35c0bbf2e6c12, []int{1}
+}
+func (m *TickState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickState.Merge(m, src)
+}
+func (m *TickState) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickState) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickState proto.InternalMessageInfo
+
+func (m *TickState) GetTickId() uint64 {
+	if m != nil {
+		return m.TickId
+	}
+	return 0
+}
+
+func (m *TickState) GetCanceledRanges() []CanceledRange {
+	if m != nil {
+		return m.CanceledRanges
+	}
+	return nil
+}
+
+func (m *TickState) GetNumOrders() uint64 {
+	if m != nil {
+		return m.NumOrders
+	}
+	return 0
+}
+
+// Pool is the orderbook Pool struct. Orders rest at ticks, where the price of
+// a tick is tick_id * tick_size quote denom per base denom.
+type Pool struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	BaseDenom  string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	QuoteDenom string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// tick_size is the price increment between two ticks
+	TickSize    cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=tick_size,json=tickSize,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tick_size" yaml:"tick_size"`
+	NextOrderId uint64                      `protobuf:"varint,6,opt,name=next_order_id,json=nextOrderId,proto3" json:"next_order_id,omitempty" yaml:"next_order_id"`
+	// bid_ticks and ask_ticks are the ticks with open orders, sorted by tick_id
+	BidTicks []TickState `protobuf:"bytes,7,rep,name=bid_ticks,json=bidTicks,proto3" json:"bid_ticks" yaml:"bid_ticks"`
+	AskTicks []TickState `protobuf:"bytes,8,rep,name=ask_ticks,json=askTicks,proto3" json:"ask_ticks" yaml:"ask_ticks"`
+	// proceeds are the tokens swapped into the pool that are not yet claimed by
+	// order owners
+	Proceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=proceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proceeds" yaml:"proceeds"`
+	// min_order_notional is the minimum value of an order in the quote denom,
+	// which bounds the cost of occupying the limited ticks of the book
+	MinOrderNotional cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=min_order_notional,json=minOrderNotional,proto3,customtype=cosmossdk.io/math.Int" json:"min_order_notional" yaml:"min_order_notional"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41c35c0bbf2e6c12, []int{2}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CanceledRange)(nil), "gamm.poolmodels.orderbook.CanceledRange")
+	proto.RegisterType((*TickState)(nil), "gamm.poolmodels.orderbook.TickState")
+	proto.RegisterType((*Pool)(nil), "gamm.poolmodels.orderbook.Pool")
+}
+
+func init() {
+	proto.RegisterFile("gamm/poolmodels/orderbook/v1beta1/orderbook_pool.proto", fileDescriptor_41c35c0bbf2e6c12)
+}
+
+var fileDescriptor_41c35c0bbf2e6c12 = []byte{
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x1c, 0x35,
+	0x14, 0xde, 0x4d, 0x36, 0xc9, 0xae, 0xa3, 0xa4, 0x89, 0xd5, 0x54, 0x93, 0x22, 0x66, 0x2a, 0x8b,
+	0xc3, 0x0a, 0xe8, 0x8c, 0x4a, 0x51, 0x2b, 0x22, 0x24, 0xc4, 0xa6, 0x42, 0x8a, 0x84, 0x68, 0xe5,
+	0xf6, 0x02, 0x08, 0x8d, 0x3c, 0x63, 0x77, 0x62, 0xed, 0x8c, 0xbd, 0x1d, 0x7b, 0xaa, 0x26, 0x7f,
+	0x01, 0xe2, 0x54, 0x6e, 0x1c, 0x7b, 0xe6, 0xc4, 0x81, 0x3f, 0xa2, 0xe2, 0xd4, 0x23, 0xe2, 0x30,
+	0xa0, 0xe4, 0xc0, 0x7d, 0xff, 0x02, 0xe4, 0x1f, 0xd9, 0xdd, 0x10, 0xf5, 0x87, 0x72, 0x49, 0xec,
+	0xf7, 0xde, 0xf7, 0x7d, 0xde, 0xf7, 0x3d, 0x7b, 0xc0, 0x9d, 0x82, 0x54, 0x55, 0x32, 0x91, 0xb2,
+	0xac, 0x24, 0x65, 0xa5, 0x4a, 0x64, 0x4d, 0x59, 0x9d, 0x49, 0x39, 0x4e, 0x9e, 0xde, 0xca, 0x98,
+	0x26, 0xb7, 0xe6, 0x91, 0xd4, 0x94, 0xc5, 0x93, 0x5a, 0x6a, 0x09, 0x77, 0x0d, 0x2e, 0x9e, 0xe3,
+	0xe2, 0x59, 0xd5, 0xf5, 0xdd, 0x5c, 0xaa, 0x4a, 0xaa, 0xd4, 0x16, 0x26, 0x6e, 0xe3, 0x50, 0xd7,
+	0xaf, 0x16, 0xb2, 0x90, 0x2e, 0x6e, 0x56, 0x3e, 0xba, 0x4d, 0x2a, 0x2e, 0x64, 0x62, 0xff, 0xfa,
+	0x50, 0xe8, 0x60, 0x49, 0x46, 0x14, 0x9b, 0x1d, 0x24, 0x97, 0x5c, 0xb8, 0x3c, 0x6a, 0xc0, 0xc6,
+	0x3e, 0x11, 0x39, 0x2b, 0x19, 0xc5, 0x44, 0x14, 0x0c, 0xde, 0x06, 0x2b, 0x4a, 0x93, 0x5a, 0x07,
+	0xdd, 0x1b, 0xdd, 0xe1, 0x60, 0xf4, 0xfe, 0xcb, 0x36, 0xea, 0xfc, 0xd5, 0x46, 0x3b, 0x8e, 0x47,
+	0xd1, 0x71, 0xcc, 0x65, 0x52, 0x11, 0x7d, 0x18, 0x1f, 0x08, 0x8d, 0x5d, 0x2d, 0x4c, 0xc0, 0x32,
+	0x13, 0x34, 0x58, 0x7a, 0x17, 0x88, 0xa9, 0x44, 0xcf, 0x7b, 0x60, 0xf0, 0x88, 0xe7, 0xe3, 0x87,
+	0x9a, 0x68, 0x06, 0x3f, 0x02, 0x6b, 0x9a, 0xe7, 0xe3, 0x94, 0x53, 0xab, 0xda, 0x1b, 0xc1, 0x69,
+	0x1b, 0x6d, 0x1e, 0x91, 0xaa, 0xdc, 0x43, 0x3e, 0x81, 0xf0, 0xaa, 0x59, 0x1d, 0x50, 0xf8, 0x03,
+	0xd8, 0xac, 0x99, 0xd2, 0x5c, 0x14, 0x29, 0xa9, 0x64, 0x23, 0xb4, 0x97, 0xbd, 0xf3, 0x46, 0xd9,
+	0x69, 0x1b, 0xed, 0x38, 0xc2, 0xf3, 0x60, 0x84, 0x37, 0x7c, 0xe0, 0x4b, 0xbb, 0x87, 0x8f, 0xc1,
+	0x76, 0xde, 0x54, 0x4d, 0x49, 0x34, 0x7f, 0xca, 0xd2, 0x49, 0x49, 0x72, 0x46, 0x83, 0x65, 0xab,
+	0xf0, 0xd9, 0xdb, 0x14, 0x02, 0xa7, 0x70, 0x01, 0x8f, 0xf0, 0xd6, 0x3c, 0xf6, 0xc0, 0x86, 0xfe,
+	0xa7, 0xf3, 0x98, 0x97, 0x25, 0xa3, 0x41, 0xef, 0xb2, 0x3a, 0x0e, 0x7f, 0x4e, 0xe7, 0x2b, 0x1b,
+	0x82, 0x4f, 0xc0, 0x95, 0xdc, 0x1b, 0x9c, 0xd6, 0xc6, 0x61, 0x15, 0xac, 0xdc, 0x58, 0x1e, 0xae,
+	0x7f, 0x32, 0x8c, 0x5f, 0x3b, 0x79, 0xf1, 0xb9, 0x91, 0x18, 0x85, 0xe6, 0x3c, 0xd3, 0x36, 0xba,
+	0xe6, 0x65, 0xcf, 0xd3, 0x21, 0xbc, 0x99, 0x2f, 0x96, 0x2b, 0xf8, 0x29, 0x00, 0xa2, 0xa9, 0x52,
+	0x4b, 0xa7, 0x82, 0x55, 0xeb, 0xe8, 0xce, 0xb4, 0x8d, 0xb6, 0x1d, 0x7e, 0x9e, 0x43, 0x78, 0x20,
+	0x9a, 0xea, 0xbe, 0x5b, 0xff, 0xbc, 0x0a, 0x7a, 0x0f, 0xa4, 0x2c, 0xe1, 0xc7, 0x60, 0x8d, 0x50,
+	0x5a, 0x33, 0xa5, 0xfc, 0x0c, 0x2e, 0x4c, 0x83, 0x4f, 0x20, 0x7c, 0x56, 0x02, 0x37, 0xc1, 0x12,
+	0x77, 0x93, 0xd7, 0xc3, 0x4b, 0x9c, 0x1a, 0x71, 0x33, 0xeb, 0x29, 0x65, 0x42, 0x56, 0xde, 0xb8,
+	0x05, 0xf1, 0x79, 0x0e, 0xe1, 0x81, 0xd9, 0xdc, 0x33, 0x6b, 0x78, 0x17, 0xac, 0x3f, 0x69, 0xa4,
+	0x3e, 0x83, 0x39, 0x1f, 0xae, 0x4d, 0xdb, 0x08, 0x3a, 0xd8, 0x42, 0x12, 0x61, 0x60, 0x77, 0x0e,
+	0xf8, 0x08, 0x0c, 0xec, 0x84, 0x2a, 0x7e, 0xcc, 0x82, 0x15, 0x0b, 0xbb, 0xeb, 0xed, 0x7b, 0xef,
+	0xa2, 0x7d, 0x5f, 0xb3, 0x82, 0xe4, 0x47, 0xf7, 0x58, 0x3e, 0x6d, 0xa3, 0xad, 0x85, 0xf9, 0x36,
+	0x68, 0x84, 0xfb, 0x66, 0xfd, 0x90, 0x1f, 0x33, 0xf8, 0x39, 0xd8, 0x10, 0xec, 0x99, 0x76, 0x6d,
+	0x32, 0xd7, 0xc2, 0x35, 0x31, 0x98, 0xb6, 0xd1, 0x55, 0xdf, 0xc4, 0xc5, 0x34, 0xc2, 0xeb, 0x66,
+	0x6f, 0x1b, 0x79, 0x40, 0xe1, 0xf7, 0x60, 0x90, 0x71, 0x9a, 0x1a, 0x36, 0x15, 0xac, 0x59, 0xb3,
+	0x3f, 0x78, 0x83, 0xd9, 0xb3, 0x7b, 0x38, 0x0a, 0xbc, 0xd1, 0xfe, 0x68, 0x33, 0x12, 0x84, 0xfb,
+	0x19, 0xa7, 0xa6, 0x4e, 0x19, 0x72, 0xa2, 0xc6, 0x9e, 0xbc, 0x7f, 0x79, 0xf2, 0x19, 0x09, 0xc2,
+	0x7d, 0xa2, 0xc6, 0x8e, 0xfc, 0x18, 0xf4, 0x27, 0xb5, 0xcc, 0x19, 0xa3, 0x2a, 0x18, 0x58, 0xee,
+	0xdd, 0xd8, 0xbf, 0x7b, 0xc6, 0xab, 0xd8, 0x3f, 0x60, 0xf1, 0xbe, 0xe4, 0x62, 0xb4, 0xef, 0x09,
+	0xaf, 0x38, 0xc2, 0x33, 0x20, 0xfa, 0xf5, 0xef, 0x68, 0x58, 0x70, 0x7d, 0xd8, 0x64, 0x71, 0x2e,
+	0x2b, 0xff, 0x6e, 0xfa, 0x7f, 0x37, 0x15, 0x1d, 0x27, 0xfa, 0x68, 0xc2, 0x94, 0xe5, 0x50, 0x78,
+	0xa6, 0x07, 0x0f, 0x01, 0xac, 0xb8, 0xf0, 0x3d, 0x15, 0x52, 0x73, 0x29, 0x48, 0x19, 0x00, 0x6b,
+	0xe9, 0xde, 0xdb, 0x6e, 0xe4, 0xae, 0x3b, 0xc3, 0x45, 0x02, 0x84, 0xb7, 0x2a, 0x2e, 0xac, 0x33,
+	0xdf, 0xf8, 0xd0, 0xde, 0xf0, 0xc7, 0x17, 0x51, 0xe7, 0x97, 0x17, 0x51, 0xe7, 0x8f, 0xdf, 0x6f,
+	0xae, 0x98, 0x91, 0x3f, 0xf8, 0xe9, 0xdf, 0xdf, 0x3e, 0x84, 0xf6, 0x03, 0x72, 0xff, 0xac, 0x73,
+	0x26, 0x3e, 0xfa, 0xf6, 0xe5, 0x49, 0xd8, 0x7d, 0x75, 0x12, 0x76, 0xff, 0x39, 0x09, 0xbb, 0xcf,
+	0x4f, 0xc3, 0xce, 0xab, 0xd3, 0xb0, 0xf3, 0xe7, 0x69, 0xd8, 0xf9, 0xee, 0x8b, 0x85, 0x5f, 0x98,
+	0x71, 0x9d, 0x11, 0x5a, 0x30, 0x35, 0x5f, 0xe5, 0x87, 0x84, 0x8b, 0xe4, 0x59, 0xf2, 0xda, 0x8f,
+	0x52, 0xb6, 0x6a, 0xdf, 0xff, 0xdb, 0xff, 0x0d, 0x00, 0x4f, 0x86, 0xd4, 0xf7, 0xb8, 0x06, 0x00,
+	0x00,
+}
+
+func (m *CanceledRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanceledRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanceledRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.End.Size()
+		i -= size
+		if _, err := m.End.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Start.Size()
+		i -= size
+		if _, err := m.Start.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TickState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOrders != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.NumOrders))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CanceledRanges) > 0 {
+		for iNdEx := len(m.CanceledRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanceledRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.CumulativeFilled.Size()
+		i -= size
+		if _, err := m.CumulativeFilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CumulativePlaced.Size()
+		i -= size
+		if _, err := m.CumulativePlaced.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RestingAmount.Size()
+		i -= size
+		if _, err := m.RestingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TickId != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.TickId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinOrderNotional.Size()
+		i -= size
+		if _, err := m.MinOrderNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Proceeds) > 0 {
+		for iNdEx := len(m.Proceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AskTicks) > 0 {
+		for iNdEx := len(m.AskTicks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AskTicks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BidTicks) > 0 {
+		for iNdEx := len(m.BidTicks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidTicks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextOrderId != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.NextOrderId))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOrderbookPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrderbookPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrderbookPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CanceledRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Start.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	return n
+}
+
+func (m *TickState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickId != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.TickId))
+	}
+	l = m.RestingAmount.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.CumulativePlaced.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	l = m.CumulativeFilled.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	if len(m.CanceledRanges) > 0 {
+		for _, e := range m.CanceledRanges {
+			l = e.Size()
+			n += 1 + l + sovOrderbookPool(uint64(l))
+		}
+	}
+	if m.NumOrders != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.NumOrders))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.Id))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOrderbookPool(uint64(l))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	if m.NextOrderId != 0 {
+		n += 1 + sovOrderbookPool(uint64(m.NextOrderId))
+	}
+	if len(m.BidTicks) > 0 {
+		for _, e := range m.BidTicks {
+			l = e.Size()
+			n += 1 + l + sovOrderbookPool(uint64(l))
+		}
+	}
+	if len(m.AskTicks) > 0 {
+		for _, e := range m.AskTicks {
+			l = e.Size()
+			n += 1 + l + sovOrderbookPool(uint64(l))
+		}
+	}
+	if len(m.Proceeds) > 0 {
+		for _, e := range m.Proceeds {
+			l = e.Size()
+			n += 1 + l + sovOrderbookPool(uint64(l))
+		}
+	}
+	l = m.MinOrderNotional.Size()
+	n += 1 + l + sovOrderbookPool(uint64(l))
+	return n
+}
+
+func sovOrderbookPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrderbookPool(x uint64) (n int) {
+	return sovOrderbookPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CanceledRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanceledRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanceledRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbookPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickId", wireType)
+			}
+			m.TickId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePlaced", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePlaced.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanceledRanges = append(m.CanceledRanges, CanceledRange{})
+			if err := m.CanceledRanges[len(m.CanceledRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOrders", wireType)
+			}
+			m.NumOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbookPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderId", wireType)
+			}
+			m.NextOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTicks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidTicks = append(m.BidTicks, TickState{})
+			if err := m.BidTicks[len(m.BidTicks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskTicks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskTicks = append(m.AskTicks, TickState{})
+			if err := m.AskTicks[len(m.AskTicks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proceeds = append(m.Proceeds, types.Coin{})
+			if err := m.Proceeds[len(m.Proceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbookPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbookPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrderbookPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrderbookPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbookPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrderbookPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrderbookPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrderbookPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrderbookPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrderbookPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrderbookPool = fmt.Errorf("proto: unexpected end of group")
+)
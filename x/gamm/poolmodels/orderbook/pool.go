@@ -0,0 +1,540 @@
+package orderbook
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	poolmanagertypes "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+var (
+	_ poolmanagertypes.PoolI = &Pool{}
+	_ types.CFMMPoolI        = &Pool{}
+)
+
+const (
+	// MaxTickId bounds tick ids, and hence tick prices.
+	MaxTickId = uint64(1_000_000_000_000)
+	// MaxTicksPerSide bounds the number of ticks with open orders on each side of the book,
+	// as all ticks are part of the pool state.
+	MaxTicksPerSide = 200
+	// MaxTicksPerOwner bounds the number of ticks on each side of the book a single owner can have open orders at,
+	// so that no owner can occupy all of a side's ticks.
+	MaxTicksPerOwner = 20
+)
+
+var (
+	errNoLPShares    = errorsmod.Wrap(types.ErrNotImplemented, "orderbook pools have no LP shares, place limit orders instead")
+	errExactOutSwaps = errorsmod.Wrap(types.ErrNotImplemented, "orderbook pools only support exact amount in swaps")
+)
+
+// NewOrderbookPool returns an orderbook pool without any orders
+// Invariants that are assumed to be satisfied and not checked:
+// * poolID doesn't already exist
+func NewOrderbookPool(poolId uint64, baseDenom string, quoteDenom string, tickSize osmomath.Dec, minOrderNotional osmomath.Int) (Pool, error) {
+	if err := validatePoolParams(baseDenom, quoteDenom, tickSize, minOrderNotional); err != nil {
+		return Pool{}, err
+	}
+
+	return Pool{
+		Address:     poolmanagertypes.NewPoolAddress(poolId).String(),
+		Id:          poolId,
+		BaseDenom:   baseDenom,
+		QuoteDenom:  quoteDenom,
+		TickSize:    tickSize,
+		NextOrderId: 1,
+		BidTicks:    []TickState{},
+		AskTicks:    []TickState{},
+		Proceeds:    sdk.Coins{},
+
+		MinOrderNotional: minOrderNotional,
+	}, nil
+}
+
+func validatePoolParams(baseDenom string, quoteDenom string, tickSize osmomath.Dec, minOrderNotional osmomath.Int) error {
+	if err := sdk.ValidateDenom(baseDenom); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPool, "invalid base denom: %s", err)
+	}
+	if err := sdk.ValidateDenom(quoteDenom); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPool, "invalid quote denom: %s", err)
+	}
+	if baseDenom == quoteDenom {
+		return errorsmod.Wrapf(types.ErrInvalidPool, "base and quote denoms must differ, got (%s)", baseDenom)
+	}
+	if tickSize.IsNil() || !tickSize.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidPool, "tick size must be positive, got (%s)", tickSize)
+	}
+	if minOrderNotional.IsNil() || !minOrderNotional.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidPool, "min order notional must be positive, got (%s)", minOrderNotional)
+	}
+	return nil
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+// GetSpreadFactor returns zero, as orders are filled at their tick price.
+func (p Pool) GetSpreadFactor(ctx sdk.Context) osmomath.Dec {
+	return osmomath.ZeroDec()
+}
+
+func (p Pool) GetExitFee(ctx sdk.Context) osmomath.Dec {
+	return osmomath.ZeroDec()
+}
+
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+func (p Pool) GetType() poolmanagertypes.PoolType {
+	return poolmanagertypes.Orderbook
+}
+
+func (p *Pool) AsSerializablePool() poolmanagertypes.PoolI {
+	return p
+}
+
+// GetPoolDenoms returns the base and quote denoms, even if there are no open orders.
+func (p *Pool) GetPoolDenoms(ctx sdk.Context) []string {
+	return []string{p.BaseDenom, p.QuoteDenom}
+}
+
+// GetTotalPoolLiquidity returns the resting amounts of all orders and the unclaimed proceeds,
+// which is everything the pool account holds.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	return sdk.NewCoins(
+		sdk.NewCoin(p.BaseDenom, restingAmount(p.AskTicks)),
+		sdk.NewCoin(p.QuoteDenom, restingAmount(p.BidTicks)),
+	).Add(p.Proceeds...)
+}
+
+func (p Pool) GetTotalShares() osmomath.Int {
+	return osmomath.ZeroInt()
+}
+
+// TickPrice returns the price of the base denom in quote denom at the given tick.
+func (p Pool) TickPrice(tickId uint64) osmomath.Dec {
+	return p.TickSize.MulInt(osmomath.NewIntFromUint64(tickId))
+}
+
+// OrderDenom returns the denom that orders of the given direction rest.
+func (p Pool) OrderDenom(direction types.OrderDirection) string {
+	if direction == types.Ask {
+		return p.BaseDenom
+	}
+	return p.QuoteDenom
+}
+
+// ProceedsDenom returns the denom that orders of the given direction are filled into.
+func (p Pool) ProceedsDenom(direction types.OrderDirection) string {
+	if direction == types.Ask {
+		return p.QuoteDenom
+	}
+	return p.BaseDenom
+}
+
+func (p *Pool) ticksFor(direction types.OrderDirection) *[]TickState {
+	if direction == types.Ask {
+		return &p.AskTicks
+	}
+	return &p.BidTicks
+}
+
+// findTick returns the index of the tick in ticks, or the index to insert it at if not found.
+func findTick(ticks []TickState, tickId uint64) (int, bool) {
+	index := sort.Search(len(ticks), func(i int) bool { return ticks[i].TickId >= tickId })
+	return index, index < len(ticks) && ticks[index].TickId == tickId
+}
+
+func restingAmount(ticks []TickState) osmomath.Int {
+	total := osmomath.ZeroInt()
+	for _, tick := range ticks {
+		total = total.Add(tick.RestingAmount)
+	}
+	return total
+}
+
+// BestBid returns the highest tick with resting bids.
+func (p Pool) BestBid() (uint64, bool) {
+	for i := len(p.BidTicks) - 1; i >= 0; i-- {
+		if p.BidTicks[i].RestingAmount.IsPositive() {
+			return p.BidTicks[i].TickId, true
+		}
+	}
+	return 0, false
+}
+
+// BestAsk returns the lowest tick with resting asks.
+func (p Pool) BestAsk() (uint64, bool) {
+	for _, tick := range p.AskTicks {
+		if tick.RestingAmount.IsPositive() {
+			return tick.TickId, true
+		}
+	}
+	return 0, false
+}
+
+// PlaceOrder places an order of quantity at the end of the tick's queue.
+// Orders may not cross the book, i.e. bids must be below the best ask and asks above the best bid,
+// and must be worth at least the pool's min order notional.
+// Returns the order and the coin the owner must deposit to the pool.
+func (p *Pool) PlaceOrder(owner string, direction types.OrderDirection, tickId uint64, quantity osmomath.Int) (types.LimitOrder, sdk.Coin, error) {
+	if direction != types.Bid && direction != types.Ask {
+		return types.LimitOrder{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidLimitOrder, "invalid direction (%d)", direction)
+	}
+	if tickId == 0 || tickId > MaxTickId {
+		return types.LimitOrder{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidLimitOrder, "tick id must be in [1, %d], got (%d)", MaxTickId, tickId)
+	}
+	if quantity.IsNil() || !quantity.IsPositive() {
+		return types.LimitOrder{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidLimitOrder, "quantity must be positive, got (%s)", quantity)
+	}
+	if notional := p.orderNotional(direction, tickId, quantity); notional.LT(p.MinOrderNotional) {
+		return types.LimitOrder{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidLimitOrder, "order notional (%s) is below the min order notional (%s)", notional, p.MinOrderNotional)
+	}
+
+	if direction == types.Bid {
+		if bestAsk, ok := p.BestAsk(); ok && tickId >= bestAsk {
+			return types.LimitOrder{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrLimitOrderCrossesBook, "bid tick (%d) must be below the best ask tick (%d)", tickId, bestAsk)
+		}
+	} else {
+		if bestBid, ok := p.BestBid(); ok && tickId <= bestBid {
+			return types.LimitOrder{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrLimitOrderCrossesBook, "ask tick (%d) must be above the best bid tick (%d)", tickId, bestBid)
+		}
+	}
+
+	ticks := p.ticksFor(direction)
+	index, found := findTick(*ticks, tickId)
+	if !found {
+		if len(*ticks) >= MaxTicksPerSide {
+			return types.LimitOrder{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrTooManyOrderbookTicks, "max (%d)", MaxTicksPerSide)
+		}
+		*ticks = append(*ticks, TickState{})
+		copy((*ticks)[index+1:], (*ticks)[index:])
+		(*ticks)[index] = newTickState(tickId)
+	}
+
+	queuePosition := (*ticks)[index].place(quantity)
+	order := types.LimitOrder{
+		PoolId:          p.Id,
+		OrderId:         p.NextOrderId,
+		Owner:           owner,
+		Direction:       direction,
+		TickId:          tickId,
+		Quantity:        quantity,
+		QueuePosition:   queuePosition,
+		ClaimedQuantity: osmomath.ZeroInt(),
+	}
+	p.NextOrderId++
+
+	return order, sdk.NewCoin(p.OrderDenom(direction), quantity), nil
+}
+
+// orderNotional returns the value of an order in the quote denom, rounded down.
+func (p Pool) orderNotional(direction types.OrderDirection, tickId uint64, quantity osmomath.Int) osmomath.Int {
+	if direction == types.Ask {
+		return p.TickPrice(tickId).MulInt(quantity).TruncateInt()
+	}
+	return quantity
+}
+
+// FilledQuantity returns the filled part of the order's quantity.
+func (p Pool) FilledQuantity(order types.LimitOrder) (osmomath.Int, error) {
+	ticks := p.ticksFor(order.Direction)
+	index, err := p.orderTickIndex(*ticks, order)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	return (*ticks)[index].filledAmount(order.QueuePosition, order.Quantity), nil
+}
+
+// ClaimOrder pays out the proceeds of the order's filled quantity that were not claimed yet.
+// Once the order is fully filled and claimed, it is closed and should be deleted.
+// Closing the last order of a side also pays out the side's rounding dust (see sweepDust).
+func (p *Pool) ClaimOrder(order *types.LimitOrder) (sdk.Coin, error) {
+	ticks := p.ticksFor(order.Direction)
+	index, err := p.orderTickIndex(*ticks, *order)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	proceeds, err := p.claim(order, &(*ticks)[index])
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if order.ClaimedQuantity.Equal(order.Quantity) {
+		p.closeOrder(ticks, index)
+		proceeds = proceeds.Add(p.sweepDust(order.Direction))
+	}
+	return proceeds, nil
+}
+
+// CancelOrder closes the order, refunding its unfilled quantity and paying out its unclaimed proceeds.
+// Closing the last order of a side also pays out the side's rounding dust (see sweepDust).
+func (p *Pool) CancelOrder(order *types.LimitOrder) (refund sdk.Coin, proceeds sdk.Coin, err error) {
+	ticks := p.ticksFor(order.Direction)
+	index, err := p.orderTickIndex(*ticks, *order)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	tick := &(*ticks)[index]
+	proceeds, err = p.claim(order, tick)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	unfilled := tick.cancel(order.QueuePosition, order.Quantity)
+	p.closeOrder(ticks, index)
+	proceeds = proceeds.Add(p.sweepDust(order.Direction))
+
+	return sdk.NewCoin(p.OrderDenom(order.Direction), unfilled), proceeds, nil
+}
+
+func (p Pool) orderTickIndex(ticks []TickState, order types.LimitOrder) (int, error) {
+	if order.PoolId != p.Id {
+		return 0, errorsmod.Wrapf(types.ErrLimitOrderNotFound, "order %d is not in pool %d", order.OrderId, p.Id)
+	}
+	index, found := findTick(ticks, order.TickId)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrLimitOrderNotFound, "tick %d of order %d not found in pool %d", order.TickId, order.OrderId, p.Id)
+	}
+	return index, nil
+}
+
+// claim marks the filled quantity of the order as claimed and returns its proceeds.
+// Proceeds are computed on the total filled quantity so that rounding does not accumulate over claims.
+func (p *Pool) claim(order *types.LimitOrder, tick *TickState) (sdk.Coin, error) {
+	filled := tick.filledAmount(order.QueuePosition, order.Quantity)
+	price := p.TickPrice(order.TickId)
+	amount := proceedsAmount(order.Direction, filled, price).Sub(proceedsAmount(order.Direction, order.ClaimedQuantity, price))
+	proceeds := sdk.NewCoin(p.ProceedsDenom(order.Direction), amount)
+
+	remaining, hasNeg := p.Proceeds.SafeSub(proceeds)
+	if hasNeg {
+		return sdk.Coin{}, fmt.Errorf("orderbook pool %d proceeds (%s) are less than the claimed proceeds (%s)", p.Id, p.Proceeds, proceeds)
+	}
+	p.Proceeds = remaining
+	order.ClaimedQuantity = filled
+	return proceeds, nil
+}
+
+// closeOrder removes an order from its tick, deleting the tick once it has no open orders.
+func (p *Pool) closeOrder(ticks *[]TickState, index int) {
+	(*ticks)[index].NumOrders--
+	if (*ticks)[index].NumOrders == 0 {
+		*ticks = append((*ticks)[:index], (*ticks)[index+1:]...)
+	}
+}
+
+// sweepDust removes and returns the proceeds of the given side once it has no open orders.
+// Proceeds are rounded down for each order and swaps may leave a remainder worth less than one unit of
+// token out, so the proceeds denom of a side accumulates dust that no order can claim.
+func (p *Pool) sweepDust(direction types.OrderDirection) sdk.Coin {
+	denom := p.ProceedsDenom(direction)
+	if len(*p.ticksFor(direction)) > 0 {
+		return sdk.NewCoin(denom, osmomath.ZeroInt())
+	}
+	dust := sdk.NewCoin(denom, p.Proceeds.AmountOf(denom))
+	p.Proceeds = p.Proceeds.Sub(dust)
+	return dust
+}
+
+// proceedsAmount returns the proceeds of filling amount of an order at price, rounded down.
+func proceedsAmount(direction types.OrderDirection, amount osmomath.Int, price osmomath.Dec) osmomath.Int {
+	if direction == types.Ask {
+		return price.MulInt(amount).TruncateInt()
+	}
+	return amount.ToLegacyDec().QuoTruncate(price).TruncateInt()
+}
+
+// tickFill is the amount filled at a tick of the side of the book a swap consumes.
+type tickFill struct {
+	index  int
+	amount osmomath.Int
+}
+
+// calcFills returns the direction of the orders that tokenIn fills, the fill at each tick and the amount out.
+// Asks are filled from the lowest tick up and bids from the highest tick down. tokenIn must be fully used,
+// up to a remainder worth less than one unit of token out, which is kept by the pool.
+func (p Pool) calcFills(tokensIn sdk.Coins, tokenOutDenom string, spreadFactor osmomath.Dec) (types.OrderDirection, []tickFill, sdk.Coin, error) {
+	if tokensIn.Len() != 1 {
+		return 0, nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrTooManyTokensOut, "expected exactly one token in, got (%s)", tokensIn)
+	}
+	tokenIn := tokensIn[0]
+
+	var direction types.OrderDirection
+	switch {
+	case tokenIn.Denom == p.QuoteDenom && tokenOutDenom == p.BaseDenom:
+		direction = types.Ask
+	case tokenIn.Denom == p.BaseDenom && tokenOutDenom == p.QuoteDenom:
+		direction = types.Bid
+	default:
+		return 0, nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "pool %d trades (%s, %s), got (%s, %s)", p.Id, p.BaseDenom, p.QuoteDenom, tokenIn.Denom, tokenOutDenom)
+	}
+
+	remaining := tokenIn.Amount.ToLegacyDec().Mul(osmomath.OneDec().Sub(spreadFactor))
+	amountOut := osmomath.ZeroInt()
+	fills := []tickFill{}
+	stopped := false
+
+	ticks := *p.ticksFor(direction)
+	for i := range ticks {
+		index := i
+		if direction == types.Bid {
+			index = len(ticks) - 1 - i
+		}
+		tick := ticks[index]
+		if !tick.RestingAmount.IsPositive() {
+			continue
+		}
+		price := p.TickPrice(tick.TickId)
+
+		// The amount of the tick's resting denom that remaining can fill
+		var amount osmomath.Int
+		if direction == types.Ask {
+			amount = remaining.QuoTruncate(price).TruncateInt()
+		} else {
+			amount = remaining.Mul(price).TruncateInt()
+		}
+		if amount.GTE(tick.RestingAmount) {
+			amount = tick.RestingAmount
+		} else {
+			stopped = true
+		}
+
+		if amount.IsPositive() {
+			fills = append(fills, tickFill{index: index, amount: amount})
+			amountOut = amountOut.Add(amount)
+			if direction == types.Ask {
+				remaining = remaining.Sub(price.MulInt(amount))
+			} else {
+				// Round up the used amount in so that swaps never receive more than they pay for
+				remaining = remaining.Sub(amount.ToLegacyDec().QuoRoundUp(price))
+				if remaining.IsNegative() {
+					remaining = osmomath.ZeroDec()
+				}
+			}
+		}
+
+		if stopped {
+			break
+		}
+	}
+
+	if !stopped && remaining.TruncateInt().IsPositive() {
+		return 0, nil, sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientOrderbookLiquidity, "(%s) of (%s) could not be filled", remaining.TruncateInt(), tokenIn)
+	}
+
+	return direction, fills, sdk.NewCoin(tokenOutDenom, amountOut), nil
+}
+
+// CalcOutAmtGivenIn returns the amount out of filling resting orders with tokenIn.
+// This does not mutate the pool.
+func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, spreadFactor osmomath.Dec) (sdk.Coin, error) {
+	_, _, tokenOut, err := p.calcFills(tokenIn, tokenOutDenom, spreadFactor)
+	return tokenOut, err
+}
+
+// SwapOutAmtGivenIn fills resting orders with tokenIn. tokenIn is held by the pool as proceeds of the filled orders.
+func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, spreadFactor osmomath.Dec) (sdk.Coin, error) {
+	direction, fills, tokenOut, err := p.calcFills(tokenIn, tokenOutDenom, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ticks := *p.ticksFor(direction)
+	for _, fill := range fills {
+		ticks[fill.index].fill(fill.amount)
+	}
+	p.Proceeds = p.Proceeds.Add(tokenIn...)
+
+	return tokenOut, nil
+}
+
+func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, spreadFactor osmomath.Dec) (sdk.Coin, error) {
+	return sdk.Coin{}, errExactOutSwaps
+}
+
+func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, spreadFactor osmomath.Dec) (sdk.Coin, error) {
+	return sdk.Coin{}, errExactOutSwaps
+}
+
+// SpotPrice returns the mid price of the best bid and best ask, or the best resting price
+// if only one side of the book has orders.
+func (p Pool) SpotPrice(ctx sdk.Context, quoteAssetDenom string, baseAssetDenom string) (osmomath.BigDec, error) {
+	inverted := false
+	switch {
+	case baseAssetDenom == p.BaseDenom && quoteAssetDenom == p.QuoteDenom:
+	case baseAssetDenom == p.QuoteDenom && quoteAssetDenom == p.BaseDenom:
+		inverted = true
+	default:
+		return osmomath.BigDec{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "pool %d trades (%s, %s), got (%s, %s)", p.Id, p.BaseDenom, p.QuoteDenom, baseAssetDenom, quoteAssetDenom)
+	}
+
+	bestBid, hasBid := p.BestBid()
+	bestAsk, hasAsk := p.BestAsk()
+	var price osmomath.Dec
+	switch {
+	case hasBid && hasAsk:
+		price = p.TickPrice(bestBid).Add(p.TickPrice(bestAsk)).QuoInt64(2)
+	case hasBid:
+		price = p.TickPrice(bestBid)
+	case hasAsk:
+		price = p.TickPrice(bestAsk)
+	default:
+		return osmomath.BigDec{}, errorsmod.Wrapf(types.ErrInsufficientOrderbookLiquidity, "pool %d has no resting orders", p.Id)
+	}
+
+	if inverted {
+		return osmomath.OneBigDec().Quo(osmomath.BigDecFromDec(price)), nil
+	}
+	return osmomath.BigDecFromDec(price), nil
+}
+
+func (p *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, spreadFactor osmomath.Dec) (osmomath.Int, error) {
+	return osmomath.Int{}, errNoLPShares
+}
+
+func (p *Pool) JoinPoolNoSwap(ctx sdk.Context, tokensIn sdk.Coins, spreadFactor osmomath.Dec) (osmomath.Int, error) {
+	return osmomath.Int{}, errNoLPShares
+}
+
+func (p *Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, spreadFactor osmomath.Dec) (osmomath.Int, sdk.Coins, error) {
+	return osmomath.Int{}, nil, errNoLPShares
+}
+
+func (p Pool) CalcJoinPoolNoSwapShares(ctx sdk.Context, tokensIn sdk.Coins, spreadFactor osmomath.Dec) (osmomath.Int, sdk.Coins, error) {
+	return osmomath.Int{}, nil, errNoLPShares
+}
+
+func (p *Pool) ExitPool(ctx sdk.Context, numShares osmomath.Int, exitFee osmomath.Dec) (sdk.Coins, error) {
+	return nil, errNoLPShares
+}
+
+func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, numShares osmomath.Int, exitFee osmomath.Dec) (sdk.Coins, error) {
+	return nil, errNoLPShares
+}
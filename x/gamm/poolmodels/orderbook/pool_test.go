@@ -0,0 +1,305 @@
+package orderbook
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/types"
+)
+
+const (
+	base  = "ubase"
+	quote = "uquote"
+	owner = "owner"
+)
+
+var (
+	defaultPoolId   = uint64(1)
+	defaultTickSize = osmomath.MustNewDecFromStr("0.1")
+	defaultMinOrder = osmomath.NewInt(10)
+	zeroSpread      = osmomath.ZeroDec()
+)
+
+type testOrder struct {
+	direction types.OrderDirection
+	tickId    uint64
+	quantity  int64
+}
+
+func newTestPool(t *testing.T, orders ...testOrder) (Pool, []types.LimitOrder) {
+	pool, err := NewOrderbookPool(defaultPoolId, base, quote, defaultTickSize, defaultMinOrder)
+	require.NoError(t, err)
+
+	placed := make([]types.LimitOrder, 0, len(orders))
+	for _, o := range orders {
+		order, _, err := pool.PlaceOrder(owner, o.direction, o.tickId, osmomath.NewInt(o.quantity))
+		require.NoError(t, err)
+		placed = append(placed, order)
+	}
+	return pool, placed
+}
+
+func TestNewOrderbookPool(t *testing.T) {
+	tests := map[string]struct {
+		baseDenom        string
+		quoteDenom       string
+		tickSize         osmomath.Dec
+		minOrderNotional osmomath.Int
+		expectErr        bool
+	}{
+		"valid":                   {baseDenom: base, quoteDenom: quote, tickSize: defaultTickSize, minOrderNotional: defaultMinOrder},
+		"same denoms":             {baseDenom: base, quoteDenom: base, tickSize: defaultTickSize, minOrderNotional: defaultMinOrder, expectErr: true},
+		"invalid base denom":      {baseDenom: "1", quoteDenom: quote, tickSize: defaultTickSize, minOrderNotional: defaultMinOrder, expectErr: true},
+		"zero tick size":          {baseDenom: base, quoteDenom: quote, tickSize: osmomath.ZeroDec(), minOrderNotional: defaultMinOrder, expectErr: true},
+		"negative tick size":      {baseDenom: base, quoteDenom: quote, tickSize: osmomath.NewDec(-1), minOrderNotional: defaultMinOrder, expectErr: true},
+		"zero min order notional": {baseDenom: base, quoteDenom: quote, tickSize: defaultTickSize, minOrderNotional: osmomath.ZeroInt(), expectErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewOrderbookPool(defaultPoolId, tc.baseDenom, tc.quoteDenom, tc.tickSize, tc.minOrderNotional)
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidPool)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []string{base, quote}, pool.GetPoolDenoms(sdk.Context{}))
+			require.True(t, pool.GetTotalPoolLiquidity(sdk.Context{}).IsZero())
+		})
+	}
+}
+
+func TestPlaceOrder(t *testing.T) {
+	tests := map[string]struct {
+		existing    []testOrder
+		order       testOrder
+		expectedErr error
+	}{
+		"bid below best ask": {
+			existing: []testOrder{{types.Ask, 20, 100}},
+			order:    testOrder{types.Bid, 19, 100},
+		},
+		"ask above best bid": {
+			existing: []testOrder{{types.Bid, 20, 100}},
+			order:    testOrder{types.Ask, 21, 100},
+		},
+		"error: bid crosses best ask": {
+			existing:    []testOrder{{types.Ask, 20, 100}},
+			order:       testOrder{types.Bid, 20, 100},
+			expectedErr: types.ErrLimitOrderCrossesBook,
+		},
+		"error: ask crosses best bid": {
+			existing:    []testOrder{{types.Bid, 20, 100}},
+			order:       testOrder{types.Ask, 15, 100},
+			expectedErr: types.ErrLimitOrderCrossesBook,
+		},
+		"error: zero tick": {
+			order:       testOrder{types.Bid, 0, 100},
+			expectedErr: types.ErrInvalidLimitOrder,
+		},
+		"error: tick above max": {
+			order:       testOrder{types.Ask, MaxTickId + 1, 100},
+			expectedErr: types.ErrInvalidLimitOrder,
+		},
+		"error: zero quantity": {
+			order:       testOrder{types.Ask, 10, 0},
+			expectedErr: types.ErrInvalidLimitOrder,
+		},
+		"error: ask below min order notional": {
+			// 99 base at price 0.1 is worth 9.9 quote
+			order:       testOrder{types.Ask, 1, 99},
+			expectedErr: types.ErrInvalidLimitOrder,
+		},
+		"error: bid below min order notional": {
+			order:       testOrder{types.Bid, 10, 9},
+			expectedErr: types.ErrInvalidLimitOrder,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, _ := newTestPool(t, tc.existing...)
+			nextOrderId := pool.NextOrderId
+
+			order, deposit, err := pool.PlaceOrder(owner, tc.order.direction, tc.order.tickId, osmomath.NewInt(tc.order.quantity))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, nextOrderId, order.OrderId)
+			require.Equal(t, nextOrderId+1, pool.NextOrderId)
+			require.Equal(t, sdk.NewInt64Coin(pool.OrderDenom(tc.order.direction), tc.order.quantity), deposit)
+		})
+	}
+}
+
+func TestSwapFillsAsksInPriceTimePriority(t *testing.T) {
+	// Asks of 100 base each: two at price 1 and one at price 2
+	pool, orders := newTestPool(t,
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 20, 100},
+	)
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin(quote, 250))
+
+	calcOut, err := pool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, base, zeroSpread)
+	require.NoError(t, err)
+
+	tokenOut, err := pool.SwapOutAmtGivenIn(sdk.Context{}, tokenIn, base, zeroSpread)
+	require.NoError(t, err)
+	require.Equal(t, calcOut, tokenOut)
+	// 200 quote buys 200 base at price 1, 50 quote buys 25 base at price 2
+	require.Equal(t, sdk.NewInt64Coin(base, 225), tokenOut)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(base, 75), sdk.NewInt64Coin(quote, 250)), pool.GetTotalPoolLiquidity(sdk.Context{}))
+
+	expectedProceeds := []int64{100, 100, 50}
+	for i := range orders {
+		proceeds, err := pool.ClaimOrder(&orders[i])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin(quote, expectedProceeds[i]), proceeds)
+	}
+	require.True(t, pool.Proceeds.IsZero())
+	// The fully filled tick is removed once all of its orders are claimed
+	require.Len(t, pool.AskTicks, 1)
+
+	refund, proceeds, err := pool.CancelOrder(&orders[2])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(base, 75), refund)
+	require.True(t, proceeds.IsZero())
+	require.Empty(t, pool.AskTicks)
+	require.True(t, pool.GetTotalPoolLiquidity(sdk.Context{}).IsZero())
+}
+
+func TestSwapFillsBidsFromHighestTick(t *testing.T) {
+	// Bids of 100 quote each at prices 0.5 and 1
+	pool, orders := newTestPool(t,
+		testOrder{types.Bid, 5, 100},
+		testOrder{types.Bid, 10, 100},
+	)
+
+	tokenOut, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin(base, 150)), quote, zeroSpread)
+	require.NoError(t, err)
+	// 100 base sells for 100 quote at price 1, 50 base sells for 25 quote at price 0.5
+	require.Equal(t, sdk.NewInt64Coin(quote, 125), tokenOut)
+
+	proceeds, err := pool.ClaimOrder(&orders[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(base, 100), proceeds)
+
+	refund, proceeds, err := pool.CancelOrder(&orders[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(quote, 75), refund)
+	require.Equal(t, sdk.NewInt64Coin(base, 50), proceeds)
+	require.Empty(t, pool.BidTicks)
+}
+
+func TestSwapSkipsCanceledOrders(t *testing.T) {
+	pool, orders := newTestPool(t,
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 10, 100},
+	)
+
+	// Cancel the order in the middle of the queue
+	refund, _, err := pool.CancelOrder(&orders[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(base, 100), refund)
+
+	_, err = pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin(quote, 150)), base, zeroSpread)
+	require.NoError(t, err)
+
+	filled, err := pool.FilledQuantity(orders[0])
+	require.NoError(t, err)
+	require.Equal(t, osmomath.NewInt(100), filled)
+
+	filled, err = pool.FilledQuantity(orders[2])
+	require.NoError(t, err)
+	require.Equal(t, osmomath.NewInt(50), filled)
+}
+
+func TestCancelMergesCanceledRanges(t *testing.T) {
+	pool, orders := newTestPool(t,
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 10, 100},
+		testOrder{types.Ask, 10, 100},
+	)
+
+	for _, i := range []int{1, 3, 2} {
+		_, _, err := pool.CancelOrder(&orders[i])
+		require.NoError(t, err)
+	}
+	// The three adjacent canceled orders are a single range
+	require.Equal(t, []CanceledRange{{Start: osmomath.NewInt(100), End: osmomath.NewInt(400)}}, pool.AskTicks[0].CanceledRanges)
+
+	// Filling past the first order skips the range and drops it
+	_, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin(quote, 150)), base, zeroSpread)
+	require.NoError(t, err)
+	require.Empty(t, pool.AskTicks[0].CanceledRanges)
+
+	filled, err := pool.FilledQuantity(orders[4])
+	require.NoError(t, err)
+	require.Equal(t, osmomath.NewInt(50), filled)
+}
+
+func TestLastOrderSweepsDust(t *testing.T) {
+	// Asks of 50 base each at price 0.3
+	pool, orders := newTestPool(t,
+		testOrder{types.Ask, 3, 50},
+		testOrder{types.Ask, 3, 50},
+	)
+
+	// 25 quote buys 83 base for 24.9 quote, the remainder is kept by the pool
+	tokenOut, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin(quote, 25)), base, zeroSpread)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(base, 83), tokenOut)
+
+	proceeds, err := pool.ClaimOrder(&orders[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(quote, 15), proceeds)
+
+	// The second order filled 33 base, worth 9.9 quote, and also receives the dust as the last ask
+	refund, proceeds, err := pool.CancelOrder(&orders[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(base, 17), refund)
+	require.Equal(t, sdk.NewInt64Coin(quote, 10), proceeds)
+	require.True(t, pool.Proceeds.IsZero())
+	require.True(t, pool.GetTotalPoolLiquidity(sdk.Context{}).IsZero())
+}
+
+func TestSwapErrors(t *testing.T) {
+	pool, _ := newTestPool(t, testOrder{types.Ask, 10, 100})
+
+	// Not enough resting asks
+	_, err := pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin(quote, 101)), base, zeroSpread)
+	require.ErrorIs(t, err, types.ErrInsufficientOrderbookLiquidity)
+
+	// Not a denom of the pool
+	_, err = pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("uother", 10)), base, zeroSpread)
+	require.ErrorIs(t, err, types.ErrDenomNotFoundInPool)
+
+	// Exact amount out is not supported
+	_, err = pool.CalcInAmtGivenOut(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin(base, 10)), quote, zeroSpread)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+}
+
+func TestSpotPrice(t *testing.T) {
+	pool, _ := newTestPool(t)
+	_, err := pool.SpotPrice(sdk.Context{}, quote, base)
+	require.ErrorIs(t, err, types.ErrInsufficientOrderbookLiquidity)
+
+	pool, _ = newTestPool(t, testOrder{types.Bid, 10, 100}, testOrder{types.Ask, 30, 100})
+
+	spotPrice, err := pool.SpotPrice(sdk.Context{}, quote, base)
+	require.NoError(t, err)
+	require.Equal(t, osmomath.NewBigDec(2), spotPrice)
+
+	spotPrice, err = pool.SpotPrice(sdk.Context{}, base, quote)
+	require.NoError(t, err)
+	require.Equal(t, osmomath.MustNewBigDecFromStr("0.5"), spotPrice)
+}
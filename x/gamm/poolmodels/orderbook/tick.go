@@ -0,0 +1,118 @@
+package orderbook
+
+import (
+	"sort"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+)
+
+// Each tick side is a FIFO queue. An order occupies the range
+// [queue_position, queue_position + quantity) of the queue, and fills advance
+// CumulativeFilled through the queue, skipping canceled ranges.
+// Hence, the filled amount of an order is derived from CumulativeFilled alone.
+
+func newTickState(tickId uint64) TickState {
+	return TickState{
+		TickId:           tickId,
+		RestingAmount:    osmomath.ZeroInt(),
+		CumulativePlaced: osmomath.ZeroInt(),
+		CumulativeFilled: osmomath.ZeroInt(),
+	}
+}
+
+// place appends quantity to the end of the queue and returns its queue position.
+func (t *TickState) place(quantity osmomath.Int) osmomath.Int {
+	queuePosition := t.CumulativePlaced
+	t.CumulativePlaced = t.CumulativePlaced.Add(quantity)
+	t.RestingAmount = t.RestingAmount.Add(quantity)
+	t.NumOrders++
+	return queuePosition
+}
+
+// fill fills amount from the front of the queue.
+// CONTRACT: amount <= t.RestingAmount
+func (t *TickState) fill(amount osmomath.Int) {
+	t.RestingAmount = t.RestingAmount.Sub(amount)
+
+	remaining := amount
+	for remaining.IsPositive() {
+		if len(t.CanceledRanges) == 0 {
+			t.CumulativeFilled = t.CumulativeFilled.Add(remaining)
+			break
+		}
+
+		next := t.CanceledRanges[0]
+		gap := next.Start.Sub(t.CumulativeFilled)
+		if remaining.LT(gap) {
+			t.CumulativeFilled = t.CumulativeFilled.Add(remaining)
+			break
+		}
+
+		// Fill up to the canceled range and skip over it
+		remaining = remaining.Sub(gap)
+		t.CumulativeFilled = next.End
+		t.CanceledRanges = t.CanceledRanges[1:]
+	}
+	t.skipCanceledRanges()
+}
+
+// filledAmount returns the filled part of the order at queuePosition.
+func (t TickState) filledAmount(queuePosition osmomath.Int, quantity osmomath.Int) osmomath.Int {
+	filled := t.CumulativeFilled.Sub(queuePosition)
+	if !filled.IsPositive() {
+		return osmomath.ZeroInt()
+	}
+	return osmomath.MinInt(filled, quantity)
+}
+
+// cancel removes the unfilled part of the order at queuePosition from the queue and returns it.
+func (t *TickState) cancel(queuePosition osmomath.Int, quantity osmomath.Int) osmomath.Int {
+	filled := t.filledAmount(queuePosition, quantity)
+	unfilled := quantity.Sub(filled)
+	if unfilled.IsZero() {
+		return unfilled
+	}
+	t.RestingAmount = t.RestingAmount.Sub(unfilled)
+
+	canceled := CanceledRange{Start: queuePosition.Add(filled), End: queuePosition.Add(quantity)}
+	if canceled.Start.Equal(t.CumulativeFilled) {
+		// The order is at the front of the queue, so the next fill starts after it
+		t.CumulativeFilled = canceled.End
+		t.skipCanceledRanges()
+		return unfilled
+	}
+
+	t.insertCanceledRange(canceled)
+	return unfilled
+}
+
+// insertCanceledRange inserts canceled into the sorted ranges, merging it with adjacent ranges.
+// As ranges are merged, there is at most one range between two open orders.
+func (t *TickState) insertCanceledRange(canceled CanceledRange) {
+	index := sort.Search(len(t.CanceledRanges), func(i int) bool { return canceled.Start.LT(t.CanceledRanges[i].Start) })
+
+	mergesPrev := index > 0 && t.CanceledRanges[index-1].End.Equal(canceled.Start)
+	mergesNext := index < len(t.CanceledRanges) && canceled.End.Equal(t.CanceledRanges[index].Start)
+	switch {
+	case mergesPrev && mergesNext:
+		t.CanceledRanges[index-1].End = t.CanceledRanges[index].End
+		t.CanceledRanges = append(t.CanceledRanges[:index], t.CanceledRanges[index+1:]...)
+	case mergesPrev:
+		t.CanceledRanges[index-1].End = canceled.End
+	case mergesNext:
+		t.CanceledRanges[index].Start = canceled.Start
+	default:
+		t.CanceledRanges = append(t.CanceledRanges, CanceledRange{})
+		copy(t.CanceledRanges[index+1:], t.CanceledRanges[index:])
+		t.CanceledRanges[index] = canceled
+	}
+}
+
+// skipCanceledRanges advances CumulativeFilled over canceled ranges at the front of the queue,
+// dropping them as they are no longer ahead of the fill cursor.
+func (t *TickState) skipCanceledRanges() {
+	for len(t.CanceledRanges) > 0 && t.CanceledRanges[0].Start.LTE(t.CumulativeFilled) {
+		t.CumulativeFilled = osmomath.MaxInt(t.CumulativeFilled, t.CanceledRanges[0].End)
+		t.CanceledRanges = t.CanceledRanges[1:]
+	}
+}
@@ -109,4 +109,12 @@ var (
 	ErrNoGaugeToRedirect          = errorsmod.Register(ModuleName, 67, "could not find gauge to redirect")
 	ErrMustHaveTwoDenoms          = errorsmod.Register(ModuleName, 68, "can only have 2 denoms in CL pool")
 	ErrWeightOverflow             = errorsmod.Register(ModuleName, 69, "weight calculation overflow - asset weights too large")
+
+	ErrNotOrderbookPool               = errorsmod.Register(ModuleName, 70, "not orderbook pool")
+	ErrInvalidLimitOrder              = errorsmod.Register(ModuleName, 71, "invalid limit order")
+	ErrLimitOrderCrossesBook          = errorsmod.Register(ModuleName, 72, "limit order crosses the book")
+	ErrLimitOrderNotFound             = errorsmod.Register(ModuleName, 73, "limit order not found")
+	ErrNotLimitOrderOwner             = errorsmod.Register(ModuleName, 74, "sender is not the limit order owner")
+	ErrInsufficientOrderbookLiquidity = errorsmod.Register(ModuleName, 75, "not enough resting orders to fill the swap")
+	ErrTooManyOrderbookTicks          = errorsmod.Register(ModuleName, 76, "too many ticks with open orders")
 )
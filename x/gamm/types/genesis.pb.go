@@ -32,6 +32,8 @@ type GenesisState struct {
 	// will be renamed to next_pool_id in an upcoming version
 	NextPoolNumber uint64 `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
 	Params         Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// resting limit orders of orderbook pools
+	LimitOrders []LimitOrder `protobuf:"bytes,4,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +90,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetLimitOrders() []LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gamm.v1beta1.GenesisState")
 }
@@ -95,27 +104,29 @@ func init() {
 func init() { proto.RegisterFile("gamm/v1beta1/genesis.proto", fileDescriptor_e7345488fa03bd8f) }
 
 var fileDescriptor_e7345488fa03bd8f = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0xaf, 0x7f, 0xa4, 0x2f, 0x54, 0x08, 0x45, 0x1d, 0x4a, 0x07, 0x53, 0x31, 0x75,
-	0xc1, 0xa6, 0x45, 0x3c, 0x00, 0x5d, 0x10, 0x0c, 0xa8, 0x2a, 0x1b, 0x4b, 0x65, 0x07, 0xe3, 0x46,
-	0xaa, 0x7d, 0xa3, 0xda, 0x41, 0xcd, 0x5b, 0xf0, 0x0e, 0xbc, 0x02, 0x0f, 0x51, 0x31, 0x75, 0x64,
-	0x42, 0x28, 0x79, 0x11, 0x14, 0x3b, 0x2d, 0xdd, 0xee, 0xb9, 0xbf, 0x73, 0x7d, 0x8f, 0x6f, 0xd8,
-	0x97, 0x4c, 0x29, 0xfa, 0x3a, 0xe2, 0xc2, 0xb2, 0x11, 0x95, 0x42, 0x0b, 0x93, 0x18, 0x92, 0xae,
-	0xc0, 0x42, 0xd4, 0xa9, 0x18, 0xa9, 0x59, 0xbf, 0x2b, 0x41, 0x82, 0x03, 0xb4, 0xaa, 0xbc, 0xa7,
-	0x7f, 0x2a, 0x01, 0xe4, 0x52, 0x50, 0xa7, 0x78, 0xf6, 0x42, 0x99, 0xce, 0x77, 0x28, 0x06, 0xa3,
-	0xc0, 0xcc, 0xfd, 0x8c, 0x17, 0x35, 0xc2, 0x5e, 0x51, 0xce, 0x8c, 0xd8, 0x2f, 0x8f, 0x21, 0xd1,
-	0xfb, 0x57, 0x0f, 0x53, 0xa5, 0x6c, 0xc5, 0x54, 0x3d, 0x7a, 0xfe, 0x8e, 0xc2, 0xce, 0xad, 0x8f,
-	0xf9, 0x68, 0x99, 0x15, 0xd1, 0x75, 0xd8, 0x4a, 0x01, 0x96, 0xa6, 0x87, 0x06, 0x8d, 0xe1, 0xd1,
-	0xb8, 0x4b, 0x7c, 0x22, 0xb2, 0x4b, 0x44, 0x6e, 0x74, 0x3e, 0xf9, 0xff, 0xf9, 0x71, 0xd1, 0x9a,
-	0x02, 0x2c, 0xef, 0x66, 0xde, 0x1d, 0x0d, 0xc3, 0x13, 0x2d, 0xd6, 0x76, 0x5e, 0xa9, 0xb9, 0xce,
-	0x14, 0x17, 0xab, 0xde, 0xbf, 0x01, 0x1a, 0x36, 0x67, 0xc7, 0x55, 0xbf, 0xf2, 0x3e, 0xb8, 0x6e,
-	0x34, 0x0e, 0xdb, 0x3e, 0x41, 0xaf, 0x31, 0x40, 0x7e, 0xc3, 0xc1, 0x5d, 0xc8, 0xd4, 0xb1, 0x49,
-	0x73, 0xf3, 0x7d, 0x16, 0xcc, 0x6a, 0xe7, 0xe4, 0x7e, 0x53, 0x60, 0xb4, 0x2d, 0x30, 0xfa, 0x29,
-	0x30, 0x7a, 0x2b, 0x71, 0xb0, 0x2d, 0x71, 0xf0, 0x55, 0xe2, 0xe0, 0xe9, 0x52, 0x26, 0x76, 0x91,
-	0x71, 0x12, 0x83, 0xa2, 0x3c, 0xb1, 0x9c, 0x3d, 0x4b, 0x61, 0xfe, 0xaa, 0x78, 0xc1, 0x12, 0x4d,
-	0xd7, 0xd4, 0x1d, 0xc0, 0xe6, 0xa9, 0x30, 0xbc, 0xed, 0x7e, 0x72, 0xf5, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x64, 0xde, 0x90, 0xab, 0x01, 0x00, 0x00,
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0xdb, 0xcb, 0x9f, 0xe4, 0x16, 0x72, 0x73, 0xd3, 0xb0, 0xa8, 0xc4, 0x54, 0xe2, 0x8a,
+	0x8d, 0x1d, 0xc1, 0xf8, 0x00, 0xb0, 0x31, 0x1a, 0xa3, 0x04, 0x77, 0x6e, 0x9a, 0x99, 0x32, 0x0e,
+	0x8d, 0x9d, 0x39, 0x4d, 0x67, 0x30, 0xf0, 0x16, 0x3e, 0x8c, 0x0f, 0x41, 0x5c, 0xb1, 0x74, 0x65,
+	0x0c, 0x24, 0x3e, 0x87, 0x99, 0x99, 0x82, 0xb0, 0x9b, 0xef, 0xfc, 0xbe, 0xaf, 0xe7, 0xeb, 0xf1,
+	0xda, 0x0c, 0x73, 0x8e, 0x5e, 0x7a, 0x84, 0x2a, 0xdc, 0x43, 0x8c, 0x0a, 0x2a, 0x53, 0x19, 0xe5,
+	0x05, 0x28, 0xf0, 0x9b, 0x9a, 0x45, 0x25, 0x6b, 0xb7, 0x18, 0x30, 0x30, 0x00, 0xe9, 0x97, 0xf5,
+	0xb4, 0x8f, 0x18, 0x00, 0xcb, 0x28, 0x32, 0x8a, 0xcc, 0x9e, 0x10, 0x16, 0x8b, 0x2d, 0x4a, 0x40,
+	0x72, 0x90, 0xb1, 0xcd, 0x58, 0x51, 0xa2, 0xd0, 0x2a, 0x44, 0xb0, 0xa4, 0xbb, 0xe5, 0x09, 0xa4,
+	0x62, 0xf7, 0xd5, 0xfd, 0x56, 0x39, 0x2e, 0x30, 0xdf, 0x46, 0x8f, 0x0f, 0x10, 0x14, 0x13, 0x5a,
+	0x10, 0x80, 0x67, 0x4b, 0x4f, 0xbf, 0x5d, 0xaf, 0x79, 0x65, 0x7f, 0xe2, 0x41, 0x61, 0x45, 0xfd,
+	0x4b, 0xaf, 0x96, 0x03, 0x64, 0x32, 0x70, 0x3b, 0x95, 0x6e, 0xa3, 0xdf, 0x8a, 0x6c, 0xdf, 0x68,
+	0xdb, 0x37, 0x1a, 0x88, 0xc5, 0xf0, 0xef, 0xfb, 0xdb, 0x59, 0x6d, 0x04, 0x90, 0x5d, 0x8f, 0xad,
+	0xdb, 0xef, 0x7a, 0xff, 0x05, 0x9d, 0xab, 0x58, 0xab, 0x58, 0xcc, 0x38, 0xa1, 0x45, 0xf0, 0xa7,
+	0xe3, 0x76, 0xab, 0xe3, 0x7f, 0x7a, 0xae, 0xbd, 0x77, 0x66, 0xea, 0xf7, 0xbd, 0xba, 0xed, 0x17,
+	0x54, 0x3a, 0xae, 0xdd, 0xb0, 0x77, 0xb5, 0x68, 0x64, 0xd8, 0xb0, 0xba, 0xfc, 0x3c, 0x71, 0xc6,
+	0xa5, 0xd3, 0x1f, 0x78, 0xcd, 0x2c, 0xe5, 0xa9, 0x8a, 0x4d, 0x7d, 0x19, 0x54, 0x4d, 0xb7, 0xe0,
+	0x30, 0x79, 0xab, 0x1d, 0xf7, 0xda, 0x50, 0xa6, 0x1b, 0xd9, 0x6e, 0x22, 0x87, 0x37, 0xcb, 0x75,
+	0xe8, 0xae, 0xd6, 0xa1, 0xfb, 0xb5, 0x0e, 0xdd, 0xd7, 0x4d, 0xe8, 0xac, 0x36, 0xa1, 0xf3, 0xb1,
+	0x09, 0x9d, 0xc7, 0x73, 0x96, 0xaa, 0xe9, 0x8c, 0x44, 0x09, 0x70, 0x44, 0x52, 0x45, 0xf0, 0x84,
+	0x51, 0xf9, 0xfb, 0x4a, 0xa6, 0x38, 0x15, 0x68, 0x8e, 0xcc, 0x19, 0xd5, 0x22, 0xa7, 0x92, 0xd4,
+	0xcd, 0x31, 0x2e, 0x7e, 0x06, 0x00, 0x68, 0x1c, 0x8a, 0x9e, 0x0c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +149,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -198,6 +223,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -322,6 +353,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
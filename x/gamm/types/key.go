@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -30,6 +31,13 @@ var (
 
 	KeyPrefixMigrationInfoBalancerPool = []byte{0x04}
 	KeyPrefixMigrationInfoCLPool       = []byte{0x05}
+
+	// KeyPrefixLimitOrders defines prefix to store the limit orders of orderbook pools.
+	KeyPrefixLimitOrders = []byte{0x06}
+
+	// KeyPrefixOwnerOrderbookTicks defines prefix to index the ticks of each side of an orderbook pool
+	// that an owner has open orders at, storing the number of open orders.
+	KeyPrefixOwnerOrderbookTicks = []byte{0x07}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -68,3 +76,22 @@ func GetKeyPrefixMigrationInfoBalancerPool(balancerPoolId uint64) []byte {
 func GetKeyPrefixMigrationInfoPoolCLPool(concentratedPoolId uint64) []byte {
 	return append(KeyPrefixMigrationInfoCLPool, sdk.Uint64ToBigEndian(concentratedPoolId)...)
 }
+
+func GetKeyPrefixLimitOrders(poolId uint64) []byte {
+	return append(KeyPrefixLimitOrders, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyLimitOrder(poolId uint64, orderId uint64) []byte {
+	return append(GetKeyPrefixLimitOrders(poolId), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+func GetKeyPrefixOwnerOrderbookTicks(poolId uint64, owner sdk.AccAddress, direction OrderDirection) []byte {
+	key := append([]byte{}, KeyPrefixOwnerOrderbookTicks...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, address.MustLengthPrefix(owner)...)
+	return append(key, byte(direction))
+}
+
+func GetKeyOwnerOrderbookTick(poolId uint64, owner sdk.AccAddress, direction OrderDirection, tickId uint64) []byte {
+	return append(GetKeyPrefixOwnerOrderbookTicks(poolId, owner, direction), sdk.Uint64ToBigEndian(tickId)...)
+}
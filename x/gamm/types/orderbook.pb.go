@@ -0,0 +1,653 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gamm/v1beta1/orderbook.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderDirection is the side of the orderbook a limit order rests on.
+type OrderDirection int32
+
+const (
+	// Bid orders rest quote denom and buy the base denom at the tick price.
+	Bid OrderDirection = 0
+	// Ask orders rest base denom and sell it for the quote denom at the tick
+	// price.
+	Ask OrderDirection = 1
+)
+
+var OrderDirection_name = map[int32]string{
+	0: "Bid",
+	1: "Ask",
+}
+
+var OrderDirection_value = map[string]int32{
+	"Bid": 0,
+	"Ask": 1,
+}
+
+func (x OrderDirection) String() string {
+	return proto.EnumName(OrderDirection_name, int32(x))
+}
+
+func (OrderDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_230da80ad9c13519, []int{0}
+}
+
+// LimitOrder is a resting order placed in an orderbook pool.
+type LimitOrder struct {
+	PoolId    uint64         `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	OrderId   uint64         `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	Owner     string         `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Direction OrderDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=gamm.v1beta1.OrderDirection" json:"direction,omitempty" yaml:"direction"`
+	TickId    uint64         `protobuf:"varint,5,opt,name=tick_id,json=tickId,proto3" json:"tick_id,omitempty" yaml:"tick_id"`
+	// quantity is the amount placed, in the base denom for asks and in the
+	// quote denom for bids.
+	Quantity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity" yaml:"quantity"`
+	// queue_position is the tick's cumulative placed amount when the order was
+	// placed. The order is filled once the tick's filled amount passes it.
+	QueuePosition cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=queue_position,json=queuePosition,proto3,customtype=cosmossdk.io/math.Int" json:"queue_position" yaml:"queue_position"`
+	// claimed_quantity is the filled part of quantity whose proceeds were
+	// already claimed.
+	ClaimedQuantity cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=claimed_quantity,json=claimedQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"claimed_quantity" yaml:"claimed_quantity"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_230da80ad9c13519, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetDirection() OrderDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return Bid
+}
+
+func (m *LimitOrder) GetTickId() uint64 {
+	if m != nil {
+		return m.TickId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gamm.v1beta1.OrderDirection", OrderDirection_name, OrderDirection_value)
+	proto.RegisterType((*LimitOrder)(nil), "gamm.v1beta1.LimitOrder")
+}
+
+func init() { proto.RegisterFile("gamm/v1beta1/orderbook.proto", fileDescriptor_230da80ad9c13519) }
+
+var fileDescriptor_230da80ad9c13519 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0xb6, 0x69, 0x9a, 0xa4, 0xa3, 0x92, 0x5a, 0x43, 0x2b, 0xa2, 0xaa, 0xb2, 0xa3, 0x59, 0xa0,
+	0x08, 0x24, 0xbb, 0x05, 0x09, 0x21, 0x76, 0x58, 0x6c, 0x82, 0x2a, 0x51, 0xbc, 0x44, 0x42, 0xd1,
+	0xd8, 0x33, 0x72, 0x46, 0xc9, 0xf8, 0xa5, 0xf6, 0x04, 0xc8, 0x0d, 0x58, 0x72, 0x06, 0xb8, 0x4c,
+	0x97, 0x5d, 0x22, 0x16, 0x16, 0x4a, 0x6e, 0x90, 0x13, 0xa0, 0x19, 0x3b, 0x49, 0xdb, 0x4d, 0x76,
+	0x9f, 0xdf, 0xf7, 0xf3, 0xde, 0x67, 0x0d, 0x3a, 0x4b, 0xa9, 0x94, 0xc1, 0xd7, 0x8b, 0x98, 0x2b,
+	0x7a, 0x11, 0x40, 0xce, 0x78, 0x1e, 0x03, 0x8c, 0xfd, 0x69, 0x0e, 0x0a, 0xf0, 0xa1, 0x66, 0xfd,
+	0x9a, 0x3d, 0x3d, 0x4e, 0x21, 0x05, 0x43, 0x04, 0x1a, 0x55, 0x1a, 0xf2, 0xab, 0x81, 0xd0, 0xa5,
+	0x90, 0x42, 0x7d, 0xd4, 0x66, 0xfc, 0x02, 0xb5, 0xa6, 0x00, 0x93, 0xa1, 0x60, 0x5d, 0xbb, 0x67,
+	0xf7, 0x1b, 0x21, 0x5e, 0x95, 0x5e, 0x67, 0x4e, 0xe5, 0xe4, 0x2d, 0xa9, 0x09, 0x12, 0x35, 0x35,
+	0x1a, 0x30, 0xec, 0xa3, 0xb6, 0x59, 0xa9, 0xd5, 0x8f, 0x8c, 0xfa, 0xc9, 0xaa, 0xf4, 0x8e, 0x2a,
+	0xf5, 0x9a, 0x21, 0x51, 0xcb, 0xc0, 0x01, 0xc3, 0xcf, 0xd0, 0x3e, 0x7c, 0xcb, 0x78, 0xde, 0xdd,
+	0xeb, 0xd9, 0xfd, 0x83, 0xd0, 0x59, 0x95, 0xde, 0x61, 0x2d, 0xd6, 0x63, 0x12, 0x55, 0x34, 0xbe,
+	0x42, 0x07, 0x4c, 0xe4, 0x3c, 0x51, 0x02, 0xb2, 0x6e, 0xa3, 0x67, 0xf7, 0x3b, 0x2f, 0xcf, 0xfc,
+	0xbb, 0x5d, 0x7c, 0x73, 0xec, 0xfb, 0xb5, 0x26, 0x3c, 0x5e, 0x95, 0x9e, 0x53, 0x25, 0x6d, 0x8c,
+	0x24, 0xda, 0x86, 0xe8, 0x5a, 0x4a, 0x24, 0x63, 0x7d, 0xe8, 0xfe, 0xc3, 0x5a, 0x35, 0x41, 0xa2,
+	0xa6, 0x46, 0x03, 0x86, 0x2f, 0x51, 0xfb, 0x7a, 0x46, 0x33, 0x25, 0xd4, 0xbc, 0xdb, 0x34, 0x97,
+	0x9e, 0xdf, 0x94, 0x9e, 0xf5, 0xb7, 0xf4, 0x4e, 0x12, 0x28, 0x24, 0x14, 0x05, 0x1b, 0xfb, 0x02,
+	0x02, 0x49, 0xd5, 0xc8, 0x1f, 0x64, 0x6a, 0xdb, 0x79, 0x6d, 0x23, 0xd1, 0x26, 0x01, 0x7f, 0x41,
+	0x9d, 0xeb, 0x19, 0x9f, 0xf1, 0xe1, 0x14, 0x0a, 0x61, 0x1a, 0xb5, 0x4c, 0xe6, 0xeb, 0x5d, 0x99,
+	0x27, 0xeb, 0xcc, 0xbb, 0x66, 0x12, 0x3d, 0x36, 0x83, 0xab, 0xfa, 0x1b, 0x27, 0xc8, 0x49, 0x26,
+	0x54, 0x48, 0xce, 0x86, 0x9b, 0xa3, 0xdb, 0x66, 0xc1, 0x9b, 0x5d, 0x0b, 0x9e, 0x56, 0x0b, 0x1e,
+	0xda, 0x49, 0x74, 0x54, 0x8f, 0x3e, 0xd5, 0x93, 0xe7, 0x7d, 0xd4, 0xb9, 0xff, 0xc7, 0x71, 0x0b,
+	0xed, 0x85, 0x82, 0x39, 0x96, 0x06, 0xef, 0x8a, 0xb1, 0x63, 0x9f, 0x36, 0x7e, 0xfc, 0x76, 0xad,
+	0xf0, 0xc3, 0xcd, 0xc2, 0xb5, 0x6f, 0x17, 0xae, 0xfd, 0x6f, 0xe1, 0xda, 0x3f, 0x97, 0xae, 0x75,
+	0xbb, 0x74, 0xad, 0x3f, 0x4b, 0xd7, 0xfa, 0x7c, 0x9e, 0x0a, 0x35, 0x9a, 0xc5, 0x7e, 0x02, 0x32,
+	0x88, 0x85, 0x8a, 0x29, 0x4b, 0x79, 0xb1, 0x45, 0xc9, 0x88, 0x8a, 0x2c, 0xf8, 0x1e, 0x98, 0x07,
+	0xad, 0xe6, 0x53, 0x5e, 0xc4, 0x4d, 0xf3, 0x42, 0x5f, 0xfd, 0x1f, 0x00, 0x54, 0xcf, 0x26, 0xad,
+	0xe5, 0x02, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimedQuantity.Size()
+		i -= size
+		if _, err := m.ClaimedQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.QueuePosition.Size()
+		i -= size
+		if _, err := m.QueuePosition.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TickId != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.TickId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Direction != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrderbook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrderbook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovOrderbook(uint64(m.PoolId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovOrderbook(uint64(m.OrderId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovOrderbook(uint64(m.Direction))
+	}
+	if m.TickId != 0 {
+		n += 1 + sovOrderbook(uint64(m.TickId))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.QueuePosition.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.ClaimedQuantity.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	return n
+}
+
+func sovOrderbook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrderbook(x uint64) (n int) {
+	return sovOrderbook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= OrderDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickId", wireType)
+			}
+			m.TickId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuePosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrderbook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrderbook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrderbook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrderbook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrderbook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrderbook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrderbook = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,9 +9,13 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee          = []byte("PoolCreationFee")
+	KeyOrderbookPoolCreationFee = []byte("OrderbookPoolCreationFee")
 )
 
+// DefaultOrderbookPoolCreationFee is the default fee for creating an orderbook pool (1 BADGE).
+var DefaultOrderbookPoolCreationFee = sdk.NewCoins(sdk.NewInt64Coin("ubadge", 1_000_000_000))
+
 // ParamTable for gamm module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -23,18 +27,24 @@ func NewParams() Params {
 
 // default gamm module parameters.
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		OrderbookPoolCreationFee: DefaultOrderbookPoolCreationFee,
+	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-
+	if err := validatePoolCreationFee(p.OrderbookPoolCreationFee); err != nil {
+		return err
+	}
 	return nil
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyOrderbookPoolCreationFee, &p.OrderbookPoolCreationFee, validatePoolCreationFee),
+	}
 }
 
 func validatePoolCreationFee(i interface{}) error {
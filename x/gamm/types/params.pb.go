@@ -6,7 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params holds parameters for the incentives module
 type Params struct {
+	// orderbook_pool_creation_fee is paid to the community pool by the creator of an orderbook
+	// pool, as orderbook pools are created without any liquidity.
+	OrderbookPoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=orderbook_pool_creation_fee,json=orderbookPoolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"orderbook_pool_creation_fee" yaml:"orderbook_pool_creation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -62,6 +66,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetOrderbookPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OrderbookPoolCreationFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gamm.v1beta1.Params")
 }
@@ -69,19 +80,25 @@ func init() {
 func init() { proto.RegisterFile("gamm/v1beta1/params.proto", fileDescriptor_29b133023b87da47) }
 
 var fileDescriptor_29b133023b87da47 = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
+	// 284 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x4f, 0xcc, 0xcd,
 	0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
 	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0x49, 0xe9, 0x41, 0xa5, 0xa4, 0x44, 0xd2, 0xf3,
 	0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e,
 	0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x4a, 0xc9, 0x41, 0x78, 0xfa, 0x49, 0x89, 0xc5, 0xa9, 0x70,
-	0x0b, 0x92, 0xf3, 0x33, 0xf3, 0x20, 0xf2, 0x4a, 0x1c, 0x5c, 0x6c, 0x01, 0x60, 0xeb, 0x9c, 0xbc,
-	0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x29, 0xb3, 0x24, 0x29, 0x31, 0x25, 0x3d, 0xb5, 0x18,
-	0xc1, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xaf, 0xd0, 0x07, 0xfb, 0xa1, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0x6c, 0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x13, 0xeb, 0x9d, 0xba, 0xd8,
-	0x00, 0x00, 0x00,
+	0x0b, 0x92, 0xf3, 0x33, 0xf3, 0x20, 0xf2, 0x4a, 0xbb, 0x19, 0xb9, 0xd8, 0x02, 0xc0, 0xf6, 0x09,
+	0x6d, 0x60, 0xe4, 0x92, 0xce, 0x2f, 0x4a, 0x49, 0x2d, 0x4a, 0xca, 0xcf, 0xcf, 0x8e, 0x2f, 0xc8,
+	0xcf, 0xcf, 0x89, 0x4f, 0x2e, 0x4a, 0x4d, 0x2c, 0xc9, 0xcc, 0xcf, 0x8b, 0x4f, 0x4b, 0x4d, 0x95,
+	0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd4, 0x83, 0x9a, 0x0f, 0x32, 0x11, 0xe6, 0x2e, 0x3d,
+	0xe7, 0xfc, 0xcc, 0x3c, 0xa7, 0xb0, 0x13, 0xf7, 0xe4, 0x19, 0x3e, 0xdd, 0x93, 0x57, 0xaa, 0x4c,
+	0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0x63, 0x96, 0xd2, 0xaa, 0xfb, 0xf2, 0x1a, 0xe9, 0x99, 0x25, 0x19,
+	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x50, 0x27, 0x43, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92,
+	0xca, 0x82, 0xd4, 0x62, 0xb0, 0xb1, 0xc5, 0x41, 0x12, 0x70, 0x93, 0x02, 0xf2, 0xf3, 0x73, 0x9c,
+	0xa1, 0xe6, 0xb8, 0xa5, 0xa6, 0x3a, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
+	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
+	0x43, 0x94, 0x01, 0x92, 0xe9, 0x49, 0x99, 0x25, 0x49, 0x89, 0x29, 0xe9, 0xa9, 0xc5, 0x08, 0x56,
+	0x72, 0x46, 0x62, 0x66, 0x9e, 0x7e, 0x85, 0x3e, 0x38, 0xdc, 0xc1, 0x76, 0x25, 0xb1, 0x81, 0x03,
+	0xc4, 0x18, 0x30, 0x00, 0x87, 0x80, 0xbd, 0x41, 0x8c, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -104,6 +121,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderbookPoolCreationFee) > 0 {
+		for iNdEx := len(m.OrderbookPoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderbookPoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -124,6 +155,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.OrderbookPoolCreationFee) > 0 {
+		for _, e := range m.OrderbookPoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -162,6 +199,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderbookPoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderbookPoolCreationFee = append(m.OrderbookPoolCreationFee, types.Coin{})
+			if err := m.OrderbookPoolCreationFee[len(m.OrderbookPoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
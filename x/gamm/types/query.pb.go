@@ -16,19 +16,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -765,6 +769,185 @@ func (m *QueryPoolParamsResponse) GetParams() *types.Any {
 	return nil
 }
 
+// =============================== PoolWeights
+type QueryPoolWeightsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolWeightsRequest) Reset()         { *m = QueryPoolWeightsRequest{} }
+func (m *QueryPoolWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolWeightsRequest) ProtoMessage()    {}
+func (*QueryPoolWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96cac0202528dce1, []int{16}
+}
+func (m *QueryPoolWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolWeightsRequest.Merge(m, src)
+}
+func (m *QueryPoolWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolWeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolWeightsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolWeightsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// PoolWeight is the weight of a denom in a balancer pool, in the units
+// used at pool creation.
+type PoolWeight struct {
+	Denom  string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight" yaml:"weight"`
+}
+
+func (m *PoolWeight) Reset()         { *m = PoolWeight{} }
+func (m *PoolWeight) String() string { return proto.CompactTextString(m) }
+func (*PoolWeight) ProtoMessage()    {}
+func (*PoolWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96cac0202528dce1, []int{17}
+}
+func (m *PoolWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolWeight.Merge(m, src)
+}
+func (m *PoolWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolWeight proto.InternalMessageInfo
+
+func (m *PoolWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryPoolWeightsResponse struct {
+	// weights interpolated at the current block time
+	CurrentWeights []PoolWeight `protobuf:"bytes,1,rep,name=current_weights,json=currentWeights,proto3" json:"current_weights" yaml:"current_weights"`
+	// true if the pool has a weight schedule that has not completed yet.
+	// The fields below are only set if this is true.
+	WeightsChanging bool         `protobuf:"varint,2,opt,name=weights_changing,json=weightsChanging,proto3" json:"weights_changing,omitempty" yaml:"weights_changing"`
+	InitialWeights  []PoolWeight `protobuf:"bytes,3,rep,name=initial_weights,json=initialWeights,proto3" json:"initial_weights" yaml:"initial_weights"`
+	TargetWeights   []PoolWeight `protobuf:"bytes,4,rep,name=target_weights,json=targetWeights,proto3" json:"target_weights" yaml:"target_weights"`
+	StartTime       *time.Time   `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	EndTime         *time.Time   `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+}
+
+func (m *QueryPoolWeightsResponse) Reset()         { *m = QueryPoolWeightsResponse{} }
+func (m *QueryPoolWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolWeightsResponse) ProtoMessage()    {}
+func (*QueryPoolWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96cac0202528dce1, []int{18}
+}
+func (m *QueryPoolWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolWeightsResponse.Merge(m, src)
+}
+func (m *QueryPoolWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolWeightsResponse) GetCurrentWeights() []PoolWeight {
+	if m != nil {
+		return m.CurrentWeights
+	}
+	return nil
+}
+
+func (m *QueryPoolWeightsResponse) GetWeightsChanging() bool {
+	if m != nil {
+		return m.WeightsChanging
+	}
+	return false
+}
+
+func (m *QueryPoolWeightsResponse) GetInitialWeights() []PoolWeight {
+	if m != nil {
+		return m.InitialWeights
+	}
+	return nil
+}
+
+func (m *QueryPoolWeightsResponse) GetTargetWeights() []PoolWeight {
+	if m != nil {
+		return m.TargetWeights
+	}
+	return nil
+}
+
+func (m *QueryPoolWeightsResponse) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *QueryPoolWeightsResponse) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
 // =============================== PoolLiquidity
 // Deprecated: please use the alternative in x/poolmanager
 //
@@ -777,7 +960,7 @@ func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLi
 func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{16}
+	return fileDescriptor_96cac0202528dce1, []int{19}
 }
 func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,7 +1007,7 @@ func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolL
 func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{17}
+	return fileDescriptor_96cac0202528dce1, []int{20}
 }
 func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +1052,7 @@ func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest
 func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesRequest) ProtoMessage()    {}
 func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{18}
+	return fileDescriptor_96cac0202528dce1, []int{21}
 }
 func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1096,7 @@ func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesRespon
 func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesResponse) ProtoMessage()    {}
 func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{19}
+	return fileDescriptor_96cac0202528dce1, []int{22}
 }
 func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1142,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJ
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
 func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{20}
+	return fileDescriptor_96cac0202528dce1, []int{23}
 }
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1011,7 +1194,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalc
 func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
 func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{21}
+	return fileDescriptor_96cac0202528dce1, []int{24}
 }
 func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1246,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{22}
+	return fileDescriptor_96cac0202528dce1, []int{25}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1316,7 @@ func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilte
 func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
 func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{23}
+	return fileDescriptor_96cac0202528dce1, []int{26}
 }
 func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1193,7 +1376,7 @@ func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilt
 func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
 func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{24}
+	return fileDescriptor_96cac0202528dce1, []int{27}
 }
 func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,7 +1432,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{25}
+	return fileDescriptor_96cac0202528dce1, []int{28}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1482,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{26}
+	return fileDescriptor_96cac0202528dce1, []int{29}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,7 +1548,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{27}
+	return fileDescriptor_96cac0202528dce1, []int{30}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,7 +1591,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{28}
+	return fileDescriptor_96cac0202528dce1, []int{31}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1474,7 +1657,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{29}
+	return fileDescriptor_96cac0202528dce1, []int{32}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1510,7 +1693,7 @@ func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityR
 func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{30}
+	return fileDescriptor_96cac0202528dce1, []int{33}
 }
 func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,7 +1730,7 @@ func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidity
 func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{31}
+	return fileDescriptor_96cac0202528dce1, []int{34}
 }
 func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1784,7 @@ func (m *GammCustomQueryType) Reset()         { *m = GammCustomQueryType{} }
 func (m *GammCustomQueryType) String() string { return proto.CompactTextString(m) }
 func (*GammCustomQueryType) ProtoMessage()    {}
 func (*GammCustomQueryType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96cac0202528dce1, []int{32}
+	return fileDescriptor_96cac0202528dce1, []int{35}
 }
 func (m *GammCustomQueryType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1717,6 +1900,9 @@ func init() {
 	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
 	proto.RegisterType((*QueryPoolParamsRequest)(nil), "gamm.v1beta1.QueryPoolParamsRequest")
 	proto.RegisterType((*QueryPoolParamsResponse)(nil), "gamm.v1beta1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryPoolWeightsRequest)(nil), "gamm.v1beta1.QueryPoolWeightsRequest")
+	proto.RegisterType((*PoolWeight)(nil), "gamm.v1beta1.PoolWeight")
+	proto.RegisterType((*QueryPoolWeightsResponse)(nil), "gamm.v1beta1.QueryPoolWeightsResponse")
 	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "gamm.v1beta1.QueryTotalPoolLiquidityRequest")
 	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "gamm.v1beta1.QueryTotalPoolLiquidityResponse")
 	proto.RegisterType((*QueryTotalSharesRequest)(nil), "gamm.v1beta1.QueryTotalSharesRequest")
@@ -1739,139 +1925,156 @@ func init() {
 func init() { proto.RegisterFile("gamm/v1beta1/query.proto", fileDescriptor_96cac0202528dce1) }
 
 var fileDescriptor_96cac0202528dce1 = []byte{
-	// 2101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x77, 0x4f, 0x6c, 0xc7, 0xf3, 0x1c, 0x7f, 0xa4, 0x6c, 0x6f, 0x3a, 0x63, 0x67, 0x26, 0x54,
-	0x1c, 0xc7, 0x49, 0x9c, 0x99, 0xc4, 0xc9, 0xb2, 0x2b, 0x2b, 0x4b, 0x36, 0x76, 0xec, 0xc4, 0x61,
-	0x65, 0x27, 0x9d, 0x05, 0xc4, 0x22, 0x34, 0x6a, 0x7b, 0x3a, 0xe3, 0xde, 0xb8, 0xbb, 0x66, 0xa6,
-	0xab, 0xb1, 0x2d, 0xb4, 0x5a, 0x69, 0x25, 0x96, 0xe5, 0x04, 0x12, 0xab, 0x15, 0x07, 0xc4, 0x89,
-	0x0b, 0x1c, 0x01, 0x09, 0x24, 0x84, 0x04, 0x12, 0x87, 0x15, 0xa7, 0x95, 0x10, 0x12, 0xe2, 0x30,
-	0xa0, 0x04, 0x71, 0xc7, 0x7f, 0x01, 0xaa, 0x8f, 0xfe, 0x9c, 0x6e, 0x4f, 0x7b, 0x50, 0xa4, 0xdd,
-	0x93, 0xa7, 0xeb, 0xbd, 0xf7, 0xab, 0xdf, 0x7b, 0xaf, 0xea, 0xd5, 0xab, 0x32, 0xa8, 0x75, 0xdd,
-	0xb2, 0x2a, 0xdf, 0xb9, 0xb1, 0x65, 0x50, 0xfd, 0x46, 0xa5, 0xe9, 0x1a, 0xad, 0x83, 0x72, 0xa3,
-	0x45, 0x28, 0x41, 0xa7, 0x98, 0xa4, 0x2c, 0x25, 0x85, 0xc9, 0x3a, 0xa9, 0x13, 0x2e, 0xa8, 0xb0,
-	0x5f, 0x42, 0xa7, 0x30, 0x15, 0xb1, 0xa6, 0xfb, 0x72, 0x78, 0xb6, 0x41, 0xc8, 0xae, 0xa5, 0xdb,
-	0x7a, 0xdd, 0x68, 0xf9, 0x52, 0x67, 0x4f, 0x6f, 0x54, 0x5b, 0xc4, 0xa5, 0x86, 0xd4, 0x2a, 0x6e,
-	0x13, 0xc7, 0x22, 0x4e, 0x65, 0x4b, 0x77, 0x0c, 0x5f, 0x6b, 0x9b, 0x98, 0xb6, 0x94, 0x5f, 0x09,
-	0xcb, 0x39, 0x33, 0x5f, 0xab, 0xa1, 0xd7, 0x4d, 0x5b, 0xa7, 0x26, 0xf1, 0x74, 0x67, 0xea, 0x84,
-	0xd4, 0x77, 0x8d, 0x8a, 0xde, 0x30, 0x2b, 0xba, 0x6d, 0x13, 0xca, 0x85, 0x8e, 0x94, 0x9e, 0x95,
-	0x52, 0xfe, 0xb5, 0xe5, 0x3e, 0xad, 0xe8, 0xf6, 0x81, 0x27, 0x12, 0x93, 0x54, 0x85, 0x6b, 0xe2,
-	0xc3, 0xb7, 0x0a, 0x3b, 0xd7, 0xd0, 0x5b, 0xba, 0x25, 0x45, 0x78, 0x0c, 0x46, 0x1e, 0xf1, 0x6f,
-	0xcd, 0x68, 0xba, 0x86, 0x43, 0xf1, 0x3d, 0x18, 0xf5, 0x06, 0x9c, 0x06, 0xb1, 0x1d, 0x03, 0x2d,
-	0xc2, 0xa0, 0x30, 0x51, 0x95, 0xf3, 0xca, 0xfc, 0xf0, 0xe2, 0x64, 0x39, 0x1c, 0xcf, 0xb2, 0xd0,
-	0x5e, 0xee, 0xff, 0xb4, 0x5d, 0xea, 0xd3, 0xa4, 0x26, 0x5e, 0x81, 0xf1, 0xc7, 0xcc, 0xcf, 0x47,
-	0x84, 0xec, 0x4a, 0x64, 0x74, 0x15, 0x4e, 0xb2, 0x68, 0x56, 0xcd, 0x1a, 0x07, 0xea, 0x5f, 0x46,
-	0x87, 0xed, 0xd2, 0xe8, 0x81, 0x6e, 0xed, 0x2e, 0x61, 0x29, 0xc0, 0xda, 0x20, 0xfb, 0xb5, 0x5e,
-	0x5b, 0xca, 0xa9, 0x0a, 0x7e, 0x0b, 0x4e, 0x87, 0x40, 0x24, 0x9b, 0x9b, 0xd0, 0xcf, 0x54, 0x02,
-	0x2e, 0x3c, 0x20, 0x65, 0x2f, 0x20, 0xe5, 0xbb, 0xf6, 0xc1, 0x72, 0xfe, 0x2f, 0xbf, 0xb9, 0x36,
-	0xc0, 0xac, 0xd6, 0x35, 0xae, 0xcc, 0xd1, 0xbe, 0x15, 0x42, 0xf3, 0xbc, 0x45, 0x6b, 0x00, 0x41,
-	0x06, 0xd4, 0x1c, 0xc7, 0x9c, 0x2b, 0xcb, 0xe0, 0xb1, 0x74, 0x95, 0xc5, 0x42, 0x0a, 0x9c, 0xad,
-	0x1b, 0xd2, 0x56, 0x0b, 0x59, 0xe2, 0x8f, 0x15, 0x40, 0x61, 0x74, 0x49, 0xf6, 0x55, 0x18, 0x60,
-	0xf3, 0xb3, 0xc8, 0x9d, 0xc8, 0xc2, 0x56, 0x68, 0xa3, 0xfb, 0x09, 0xac, 0x2e, 0x75, 0x65, 0x25,
-	0xe6, 0x8c, 0xd0, 0x2a, 0xc0, 0x24, 0x67, 0xb5, 0xe1, 0x5a, 0x61, 0xb7, 0x79, 0x3c, 0x36, 0x60,
-	0x2a, 0x26, 0x93, 0xa4, 0x6f, 0x40, 0xde, 0x76, 0xad, 0xaa, 0x47, 0x9c, 0x65, 0x6a, 0xf2, 0xb0,
-	0x5d, 0x1a, 0x17, 0x99, 0xf2, 0x45, 0x58, 0x1b, 0xb2, 0xa5, 0x29, 0xc7, 0x5b, 0x91, 0x73, 0xb1,
-	0x91, 0xb7, 0x0f, 0x1a, 0x46, 0x2f, 0x69, 0xc7, 0x0f, 0x25, 0xa9, 0x00, 0x24, 0x20, 0xc5, 0x95,
-	0xe9, 0x41, 0xc3, 0xe0, 0x38, 0xf9, 0x30, 0x29, 0x5f, 0x84, 0xb5, 0xa1, 0x86, 0x34, 0xc5, 0xbf,
-	0x55, 0xa0, 0xc8, 0xc1, 0x56, 0xf4, 0xdd, 0xed, 0x87, 0xc4, 0xb4, 0x19, 0xe8, 0x93, 0x1d, 0xbd,
-	0x65, 0x38, 0xbd, 0x70, 0x43, 0x3b, 0x90, 0xa7, 0xe4, 0x99, 0x61, 0x3b, 0x55, 0x93, 0x25, 0x85,
-	0x25, 0xf4, 0x6c, 0x24, 0x29, 0x5e, 0x3a, 0x56, 0x88, 0x69, 0x2f, 0x5f, 0x67, 0xfb, 0xe1, 0x97,
-	0xff, 0x2c, 0xcd, 0xd7, 0x4d, 0xba, 0xe3, 0x6e, 0x95, 0xb7, 0x89, 0x25, 0x37, 0xa5, 0xfc, 0x73,
-	0xcd, 0xa9, 0x3d, 0xab, 0x30, 0xce, 0x0e, 0x37, 0x70, 0xb4, 0x21, 0x81, 0xbe, 0x6e, 0xe3, 0xff,
-	0x2a, 0x50, 0x4a, 0x65, 0x2e, 0x03, 0xb2, 0x05, 0xe3, 0x0e, 0x1b, 0xa9, 0x12, 0x97, 0x56, 0x75,
-	0x8b, 0xb8, 0x36, 0x95, 0x71, 0x79, 0x9d, 0xcd, 0xfc, 0x8f, 0x76, 0x69, 0x4a, 0xcc, 0xe3, 0xd4,
-	0x9e, 0x95, 0x4d, 0x52, 0xb1, 0x74, 0xba, 0x53, 0x5e, 0xb7, 0xe9, 0x61, 0xbb, 0x74, 0x46, 0x38,
-	0x18, 0x37, 0xc7, 0xda, 0x28, 0x1f, 0xda, 0x74, 0xe9, 0x5d, 0x3e, 0x80, 0xde, 0x05, 0x90, 0x1e,
-	0x13, 0x97, 0xbe, 0x0c, 0x97, 0x65, 0x40, 0x37, 0x5d, 0x8a, 0x7f, 0xa0, 0xc0, 0x25, 0xdf, 0xe7,
-	0xd5, 0x7d, 0x93, 0x32, 0x9f, 0xb9, 0xd6, 0x5a, 0x8b, 0x58, 0xd1, 0xb4, 0x9d, 0x89, 0xa5, 0xcd,
-	0x4f, 0xd1, 0x2a, 0x8c, 0x09, 0xaf, 0x4c, 0xdb, 0x8b, 0x49, 0x8e, 0xc7, 0xe4, 0xdc, 0x91, 0x31,
-	0xd1, 0x46, 0xb8, 0xd5, 0xba, 0x2d, 0xfc, 0xc6, 0x9f, 0x28, 0x30, 0xdf, 0x9d, 0x8b, 0x4c, 0x44,
-	0x34, 0x48, 0xca, 0x4b, 0x0d, 0xd2, 0x2a, 0xbc, 0xe2, 0x6f, 0x8f, 0x48, 0xd9, 0x3e, 0xde, 0x2e,
-	0xbb, 0x0f, 0x67, 0x3a, 0x60, 0xa4, 0x37, 0x0b, 0x9d, 0xc5, 0xbe, 0xb3, 0x64, 0xf9, 0x65, 0xfe,
-	0xb1, 0xdc, 0x61, 0x6f, 0x13, 0xaa, 0xef, 0x32, 0xb4, 0xb7, 0xcc, 0xa6, 0x6b, 0xd6, 0x4c, 0x7a,
-	0xd0, 0x73, 0xd1, 0xff, 0xb9, 0xb7, 0xf6, 0x93, 0x30, 0x25, 0xc9, 0xf7, 0x20, 0xbf, 0xeb, 0x0d,
-	0x76, 0x8f, 0xf8, 0x3d, 0x16, 0xf1, 0xa0, 0x56, 0xf8, 0x96, 0xf8, 0x78, 0x59, 0xf0, 0xed, 0x38,
-	0xcd, 0x35, 0x19, 0x42, 0xce, 0xb2, 0xf7, 0xa2, 0x82, 0x5d, 0x50, 0x3b, 0x71, 0xa4, 0x9b, 0xdf,
-	0x84, 0x53, 0x94, 0x0d, 0x57, 0xf9, 0xea, 0xf4, 0x32, 0x72, 0x84, 0xa7, 0xd3, 0xd2, 0xd3, 0x09,
-	0x31, 0x59, 0xd8, 0x18, 0x6b, 0xc3, 0x34, 0x98, 0x02, 0xff, 0x41, 0x81, 0xd9, 0x8e, 0x0a, 0xb3,
-	0x41, 0x9e, 0xec, 0xe9, 0x8d, 0x2f, 0x44, 0x85, 0xfc, 0x8f, 0x02, 0x17, 0xbb, 0xf0, 0x97, 0x41,
-	0x7c, 0xff, 0x78, 0xdb, 0x73, 0x55, 0x86, 0xf0, 0xb4, 0x17, 0x42, 0xcf, 0x14, 0xf7, 0xb8, 0x67,
-	0xd1, 0x6d, 0x00, 0x91, 0x02, 0x59, 0x44, 0x33, 0x94, 0xa3, 0xbc, 0x30, 0x60, 0x3b, 0xfe, 0x17,
-	0x39, 0x79, 0x22, 0x3e, 0x69, 0x10, 0xfa, 0xa8, 0x65, 0x6e, 0xf7, 0x74, 0xae, 0xa2, 0x55, 0x18,
-	0x67, 0xbe, 0x56, 0x75, 0xc7, 0x31, 0x68, 0xb5, 0x66, 0xd8, 0xc4, 0x92, 0x54, 0xa6, 0x83, 0x03,
-	0x21, 0xae, 0x81, 0xb5, 0x51, 0x36, 0x74, 0x97, 0x8d, 0xdc, 0x63, 0x03, 0xe8, 0x01, 0x9c, 0x6e,
-	0xba, 0x84, 0x46, 0x71, 0x4e, 0x70, 0x9c, 0x99, 0xc3, 0x76, 0x49, 0x15, 0x38, 0x1d, 0x2a, 0x58,
-	0x1b, 0xe3, 0x63, 0x21, 0xa4, 0x0d, 0x18, 0xde, 0x33, 0xe9, 0x0e, 0x4b, 0xd8, 0x9a, 0x61, 0xa8,
-	0xfd, 0xe7, 0x95, 0xf9, 0xa1, 0xe5, 0x85, 0xc3, 0x76, 0x69, 0x4e, 0x60, 0x30, 0x61, 0x95, 0x37,
-	0xda, 0x4f, 0x0d, 0x03, 0x2f, 0xd4, 0x8c, 0x46, 0xcb, 0xd8, 0xd6, 0xa9, 0x51, 0x5b, 0xc2, 0xb4,
-	0xe5, 0x1a, 0x58, 0x55, 0xb4, 0x30, 0x00, 0xdf, 0x93, 0x7f, 0x52, 0x60, 0x3a, 0x68, 0xc2, 0xbe,
-	0x61, 0xd2, 0x9d, 0x35, 0x73, 0x97, 0x1a, 0x2d, 0x2f, 0x62, 0x6f, 0xc0, 0x88, 0x65, 0xda, 0xd5,
-	0x70, 0xe9, 0x60, 0xcc, 0xd5, 0xc3, 0x76, 0x69, 0x52, 0xcc, 0x1a, 0x11, 0x63, 0xed, 0x94, 0x65,
-	0xda, 0x7e, 0xf5, 0x41, 0xd3, 0xe1, 0x16, 0x84, 0x07, 0x2f, 0x68, 0x36, 0x62, 0x8d, 0xe4, 0x89,
-	0x9e, 0x1b, 0xc9, 0x9f, 0x29, 0x30, 0x93, 0xec, 0xc3, 0xe7, 0xa4, 0xa5, 0xd4, 0xe4, 0x11, 0x14,
-	0x5a, 0x8f, 0x92, 0xd9, 0x2d, 0x00, 0xa7, 0x41, 0x68, 0xb5, 0xc1, 0x46, 0x65, 0x6c, 0xa7, 0x82,
-	0xad, 0x14, 0xc8, 0xb0, 0x96, 0x77, 0x3c, 0x6b, 0x9e, 0xb8, 0x0f, 0x72, 0x70, 0x4e, 0x80, 0xee,
-	0xe9, 0x8d, 0xd5, 0x7d, 0x7d, 0x5b, 0x36, 0x20, 0xeb, 0xb6, 0x97, 0xba, 0xcb, 0x30, 0xe8, 0x18,
-	0x76, 0xcd, 0x68, 0x49, 0xdc, 0xd3, 0x87, 0xed, 0xd2, 0x88, 0xc4, 0xe5, 0xe3, 0x58, 0x93, 0x0a,
-	0xe1, 0x7d, 0x91, 0xeb, 0xba, 0x2f, 0xca, 0x20, 0x6a, 0x0a, 0x2b, 0x58, 0x62, 0x1d, 0x4f, 0x1c,
-	0xb6, 0x4b, 0x63, 0xa1, 0xcd, 0x5f, 0x35, 0x6d, 0xac, 0x9d, 0xe4, 0x3f, 0xd7, 0x6d, 0xf4, 0x35,
-	0x18, 0xe4, 0x17, 0x3f, 0x47, 0xed, 0xe7, 0xe1, 0x9f, 0x2b, 0x87, 0x2e, 0x88, 0x7e, 0xf0, 0x98,
-	0x1b, 0xbe, 0x07, 0x4c, 0x7d, 0x79, 0x4a, 0x96, 0x15, 0xc9, 0x59, 0x60, 0x60, 0x4d, 0x82, 0xf1,
-	0x20, 0x7c, 0xe4, 0xb5, 0xab, 0x09, 0x41, 0x08, 0x7a, 0x3e, 0xc1, 0xa9, 0xe7, 0x9e, 0x2f, 0x6e,
-	0x8e, 0xb5, 0x51, 0x3e, 0xe4, 0xf7, 0x7c, 0x9c, 0xca, 0xf7, 0x73, 0xc9, 0x54, 0x36, 0x5d, 0xfa,
-	0xb2, 0x13, 0xf2, 0x75, 0x3f, 0xc0, 0x27, 0x78, 0x80, 0x2f, 0x75, 0x09, 0x30, 0xa3, 0x94, 0x21,
-	0xc2, 0xec, 0xfe, 0xe0, 0xfb, 0xce, 0xab, 0x4d, 0xe4, 0xfe, 0xe0, 0x8b, 0xb0, 0x3c, 0x63, 0x36,
-	0x5d, 0x11, 0x89, 0x0f, 0xbd, 0x6e, 0x24, 0x29, 0x12, 0x32, 0x2b, 0x55, 0x18, 0xf3, 0x56, 0x4a,
-	0x34, 0x29, 0xaf, 0x75, 0x4b, 0xca, 0x2b, 0xd1, 0x75, 0xe6, 0xe7, 0x64, 0x44, 0x2e, 0xb7, 0x50,
-	0x4a, 0x66, 0xa0, 0x10, 0xf4, 0x09, 0xf1, 0x2e, 0x0b, 0xff, 0xd4, 0xab, 0x7c, 0x71, 0xf1, 0xe7,
-	0xa2, 0x61, 0xc2, 0xbf, 0x1f, 0x84, 0x89, 0xfb, 0xba, 0x65, 0xad, 0xb8, 0x0e, 0x25, 0x96, 0x20,
-	0xca, 0x8a, 0xe6, 0x6d, 0xc8, 0x37, 0xbd, 0x5a, 0x27, 0xbb, 0x9b, 0x62, 0xf4, 0x71, 0x21, 0xfe,
-	0x88, 0xa0, 0x05, 0x06, 0xe8, 0x0e, 0x80, 0xff, 0xe1, 0xc8, 0x92, 0x56, 0x4a, 0x31, 0x77, 0xfc,
-	0x5a, 0x1b, 0x98, 0xa0, 0x07, 0x30, 0xd2, 0x0c, 0x5f, 0x36, 0x65, 0xd9, 0xc6, 0x29, 0x18, 0xa1,
-	0x4b, 0xad, 0x16, 0x35, 0x44, 0xdf, 0x86, 0xc9, 0x66, 0x42, 0xd1, 0xe6, 0x0b, 0x6d, 0x78, 0xf1,
-	0x72, 0x1a, 0xa9, 0x8e, 0x23, 0x4a, 0x4b, 0x84, 0xf1, 0x89, 0x7a, 0x57, 0x75, 0x75, 0x20, 0x95,
-	0x68, 0xec, 0xa6, 0xaf, 0x45, 0x0d, 0xd1, 0x3b, 0x30, 0xd1, 0xec, 0x5c, 0x27, 0xea, 0x20, 0xc7,
-	0x9b, 0x4f, 0xc0, 0x4b, 0x5c, 0x6f, 0x5a, 0x12, 0x08, 0x7a, 0x0a, 0x67, 0x9a, 0xc9, 0x8d, 0xbb,
-	0x7a, 0x92, 0xe3, 0x2f, 0xa4, 0xe1, 0x27, 0xdd, 0x1c, 0xb4, 0x34, 0x30, 0xf4, 0x55, 0x18, 0x6d,
-	0x46, 0x4e, 0x20, 0x75, 0x88, 0xc3, 0x5f, 0x48, 0x80, 0x8f, 0x77, 0x4d, 0x5a, 0xcc, 0x14, 0x6d,
-	0xc0, 0x58, 0x33, 0x7a, 0x15, 0x52, 0xf3, 0x1c, 0x6d, 0x36, 0x25, 0x69, 0x91, 0x6b, 0x97, 0x16,
-	0x37, 0x46, 0x8f, 0x61, 0xbc, 0x19, 0xeb, 0xe7, 0x55, 0xe0, 0x80, 0x17, 0xd3, 0xbc, 0x8f, 0x34,
-	0xdc, 0x5a, 0x87, 0xf9, 0xe2, 0xaf, 0x26, 0x60, 0x80, 0x6b, 0x23, 0x02, 0x03, 0x22, 0x8d, 0xdd,
-	0x96, 0x79, 0xe1, 0x7c, 0xba, 0x82, 0x28, 0x09, 0xf8, 0xc2, 0x07, 0x7f, 0xfd, 0xf7, 0x8f, 0x73,
-	0xe7, 0xd0, 0x74, 0x85, 0x6f, 0x5f, 0xd3, 0xa9, 0x44, 0x1f, 0x09, 0xf9, 0x3c, 0xef, 0xc3, 0x90,
-	0xbf, 0x74, 0x32, 0xac, 0xb6, 0xc2, 0x85, 0x23, 0x75, 0xe4, 0xcc, 0x57, 0xf8, 0xcc, 0x5f, 0x42,
-	0xa5, 0xe4, 0x99, 0xfd, 0x07, 0xa6, 0x8f, 0x72, 0x0a, 0xfa, 0x58, 0x81, 0xd1, 0xd8, 0x32, 0xcb,
-	0xbc, 0x4a, 0x0b, 0x97, 0x33, 0x68, 0x4a, 0x4e, 0xd7, 0x38, 0xa7, 0x4b, 0xe8, 0x62, 0x32, 0x27,
-	0x71, 0x93, 0xf2, 0x0b, 0x1a, 0xfa, 0x44, 0x81, 0xb1, 0xf8, 0x26, 0xcd, 0xbe, 0xcb, 0x0b, 0x57,
-	0xb2, 0xa8, 0x4a, 0x66, 0x0b, 0x9c, 0xd9, 0x1c, 0x9a, 0x4d, 0x66, 0xf6, 0x94, 0x6b, 0x1b, 0x35,
-	0x11, 0x32, 0x74, 0x00, 0xfd, 0xbc, 0x36, 0x76, 0x29, 0xa3, 0x85, 0x52, 0xaa, 0x5c, 0x4e, 0x7b,
-	0xfd, 0xe8, 0x80, 0xf0, 0xd9, 0x2a, 0xdf, 0x95, 0xc7, 0xf7, 0x7b, 0x2c, 0x55, 0x1f, 0x2a, 0x30,
-	0xe4, 0x17, 0xc4, 0x0c, 0x35, 0x34, 0x71, 0xb1, 0xc4, 0xdf, 0xfd, 0xf0, 0x0d, 0xce, 0xe3, 0x2a,
-	0xba, 0x9c, 0xce, 0x83, 0x37, 0xe4, 0x01, 0x17, 0xf4, 0x3d, 0x05, 0xd4, 0xb4, 0x6b, 0x21, 0x5a,
-	0x4c, 0x98, 0xb4, 0xcb, 0x1d, 0xb8, 0x70, 0xf3, 0x58, 0x36, 0x92, 0x78, 0x1f, 0xfa, 0xb5, 0x02,
-	0xa8, 0xf3, 0x01, 0x0f, 0x2d, 0x74, 0x41, 0x8b, 0xce, 0x7d, 0x2d, 0xa3, 0xb6, 0x9c, 0xf5, 0x4d,
-	0x1e, 0xae, 0x25, 0xf4, 0x7a, 0xa6, 0xb4, 0x55, 0xde, 0x25, 0xa6, 0x2d, 0x6e, 0x59, 0x06, 0xeb,
-	0x6d, 0xaa, 0xa6, 0x8d, 0xfe, 0xa6, 0xc0, 0xf4, 0x11, 0xcf, 0x5e, 0xe8, 0xd5, 0x14, 0x42, 0x47,
-	0x3f, 0xd9, 0x15, 0xbe, 0x7c, 0x5c, 0x33, 0xe9, 0xd0, 0x7d, 0xee, 0xd0, 0x5d, 0x74, 0x27, 0x9b,
-	0x43, 0xc6, 0xbe, 0x49, 0x85, 0x43, 0xe2, 0x1d, 0x50, 0x74, 0x54, 0xcc, 0xaf, 0x1f, 0x2a, 0x00,
-	0xa1, 0x3a, 0x9d, 0xa9, 0xbc, 0x17, 0x2e, 0x76, 0xd1, 0x92, 0x24, 0x6f, 0x71, 0x92, 0x65, 0xb4,
-	0x90, 0x8d, 0xa4, 0x78, 0x3c, 0x43, 0xbf, 0x53, 0x00, 0x25, 0x1c, 0x6f, 0xc7, 0x3a, 0x25, 0x13,
-	0xd7, 0x47, 0xfa, 0xcb, 0x19, 0x5e, 0xe5, 0x4c, 0x6f, 0xa3, 0xa5, 0x6c, 0x4c, 0x45, 0xdd, 0xe3,
-	0x9f, 0x7e, 0xf1, 0x63, 0x7b, 0xfd, 0x27, 0x0a, 0x0c, 0x87, 0x8e, 0x28, 0x94, 0xed, 0x6c, 0x2b,
-	0xcc, 0x75, 0x53, 0x93, 0x2c, 0x97, 0x38, 0xcb, 0x5b, 0x68, 0xf1, 0x38, 0x2c, 0xc5, 0x9b, 0x09,
-	0xcb, 0x73, 0x3e, 0x38, 0xde, 0xb3, 0xf4, 0x04, 0x85, 0xd9, 0xa3, 0x95, 0x24, 0xa9, 0xd7, 0x8e,
-	0x99, 0x64, 0x66, 0xcc, 0xcf, 0xb0, 0x3f, 0x2a, 0x70, 0x76, 0xd5, 0xa1, 0xa6, 0xa5, 0x53, 0xa3,
-	0xe3, 0x6e, 0x87, 0xae, 0x26, 0x4d, 0x9e, 0x72, 0x0d, 0x2e, 0x2c, 0x64, 0x53, 0x96, 0x8c, 0x1f,
-	0x70, 0xc6, 0x77, 0xd0, 0x1b, 0xc9, 0x8c, 0x43, 0xbb, 0x46, 0xb2, 0xab, 0x84, 0x4a, 0x81, 0xbf,
-	0x73, 0x98, 0x0b, 0x7f, 0x56, 0xa0, 0x90, 0xe2, 0xc2, 0xa6, 0x4b, 0x51, 0x06, 0x5a, 0xc1, 0xd5,
-	0x31, 0x71, 0xc9, 0xa6, 0x5f, 0xaf, 0xf0, 0x3a, 0xf7, 0xe2, 0x4d, 0xf4, 0x95, 0xff, 0xc3, 0x0b,
-	0xe2, 0x52, 0xe6, 0x86, 0x09, 0x83, 0x72, 0xfb, 0x4f, 0x27, 0xfd, 0x0f, 0xd3, 0x23, 0x38, 0x93,
-	0x2c, 0x94, 0x7c, 0x66, 0x39, 0x9f, 0x22, 0x9a, 0x49, 0x59, 0x07, 0xe2, 0xdf, 0xa1, 0x0f, 0x3f,
-	0x7d, 0x5e, 0x54, 0x3e, 0x7b, 0x5e, 0x54, 0xfe, 0xf5, 0xbc, 0xa8, 0xfc, 0xe8, 0x45, 0xb1, 0xef,
-	0xb3, 0x17, 0xc5, 0xbe, 0xbf, 0xbf, 0x28, 0xf6, 0xbd, 0x73, 0x3d, 0x74, 0x83, 0xda, 0x32, 0xe9,
-	0x96, 0x5e, 0xab, 0x1b, 0x4e, 0xf0, 0x6b, 0x7b, 0x47, 0x37, 0xed, 0xca, 0xbe, 0x00, 0xe5, 0xf7,
-	0xa9, 0xad, 0x41, 0xfe, 0xb6, 0x73, 0xf3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x61, 0x92,
-	0xca, 0xe2, 0x1e, 0x00, 0x00,
+	// 2377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x3b, 0xb6, 0xe3, 0x79, 0x8e, 0x3f, 0x52, 0xb1, 0x93, 0x49, 0xdb, 0xf1, 0x2c, 0x15,
+	0xc7, 0x49, 0x36, 0xf6, 0x4c, 0xe2, 0x24, 0xec, 0xca, 0xca, 0x92, 0x8d, 0x1d, 0x3b, 0x71, 0x88,
+	0x9c, 0xa4, 0x13, 0x58, 0xb1, 0x08, 0x46, 0xed, 0x99, 0xce, 0xb8, 0x37, 0xee, 0xee, 0x99, 0xe9,
+	0x6a, 0x12, 0x0b, 0xad, 0x56, 0x8a, 0xc4, 0xb2, 0x9c, 0x58, 0x69, 0x57, 0x2b, 0x0e, 0x88, 0x13,
+	0x17, 0x38, 0x70, 0x01, 0x09, 0x24, 0x84, 0x04, 0x12, 0x87, 0x88, 0xd3, 0x4a, 0x08, 0x09, 0x71,
+	0x98, 0x45, 0x09, 0xe2, 0xce, 0xfc, 0x05, 0xa8, 0xaa, 0x5e, 0x7f, 0x4e, 0x8f, 0xa7, 0x6d, 0x14,
+	0x69, 0x39, 0x79, 0xba, 0xde, 0x7b, 0xbf, 0xfa, 0xbd, 0xf7, 0xaa, 0x5e, 0xbd, 0x2a, 0x19, 0xf2,
+	0x35, 0xdd, 0xb2, 0x4a, 0xdf, 0xbb, 0xb8, 0x69, 0x30, 0xfd, 0x62, 0xa9, 0xe1, 0x19, 0xcd, 0x9d,
+	0x62, 0xbd, 0xe9, 0x30, 0x87, 0x1c, 0xe6, 0x92, 0x22, 0x4a, 0xd4, 0x89, 0x9a, 0x53, 0x73, 0x84,
+	0xa0, 0xc4, 0x7f, 0x49, 0x1d, 0x75, 0x32, 0x66, 0xcd, 0x9e, 0xe2, 0xf0, 0x6c, 0xdd, 0x71, 0xb6,
+	0x2d, 0xdd, 0xd6, 0x6b, 0x46, 0x33, 0x90, 0xba, 0x4f, 0xf4, 0x7a, 0xb9, 0xe9, 0x78, 0xcc, 0x40,
+	0xad, 0x99, 0x8a, 0xe3, 0x5a, 0x8e, 0x5b, 0xda, 0xd4, 0x5d, 0x23, 0xd0, 0xaa, 0x38, 0xa6, 0x8d,
+	0xf2, 0xd7, 0xa3, 0x72, 0xc1, 0x2c, 0xd0, 0xaa, 0xeb, 0x35, 0xd3, 0xd6, 0x99, 0xe9, 0xf8, 0xba,
+	0xd3, 0x35, 0xc7, 0xa9, 0x6d, 0x1b, 0x25, 0xbd, 0x6e, 0x96, 0x74, 0xdb, 0x76, 0x98, 0x10, 0xba,
+	0x28, 0x3d, 0x81, 0x52, 0xf1, 0xb5, 0xe9, 0x3d, 0x2a, 0xe9, 0x36, 0x7a, 0xa9, 0x16, 0x92, 0x22,
+	0x66, 0x5a, 0x86, 0xcb, 0x74, 0xab, 0xee, 0xdb, 0x4a, 0x16, 0x65, 0xe9, 0xbb, 0xfc, 0x08, 0x60,
+	0xa3, 0xde, 0xd7, 0xf5, 0xa6, 0x6e, 0xa1, 0x88, 0x8e, 0xc1, 0xc8, 0x3d, 0xf1, 0xad, 0x19, 0x0d,
+	0xcf, 0x70, 0x19, 0xbd, 0x01, 0xa3, 0xfe, 0x80, 0x5b, 0x77, 0x6c, 0xd7, 0x20, 0x8b, 0x30, 0x28,
+	0x4d, 0xf2, 0xca, 0x6b, 0xca, 0xd9, 0xe1, 0xc5, 0x89, 0x62, 0x34, 0xe0, 0x45, 0xa9, 0xbd, 0xdc,
+	0xff, 0xbc, 0x55, 0x38, 0xa0, 0xa1, 0x26, 0x5d, 0x81, 0xf1, 0xfb, 0x3c, 0x10, 0xf7, 0x1c, 0x67,
+	0x1b, 0x91, 0xc9, 0x79, 0x38, 0xc4, 0xc3, 0x5d, 0x36, 0xab, 0x02, 0xa8, 0x7f, 0x99, 0xb4, 0x5b,
+	0x85, 0xd1, 0x1d, 0xdd, 0xda, 0x5e, 0xa2, 0x28, 0xa0, 0xda, 0x20, 0xff, 0xb5, 0x5e, 0x5d, 0xea,
+	0xcb, 0x2b, 0xf4, 0x0e, 0x1c, 0x89, 0x80, 0x20, 0x9b, 0x4b, 0xd0, 0xcf, 0x55, 0x42, 0x2e, 0x22,
+	0x2c, 0x45, 0x3f, 0x2c, 0xc5, 0xeb, 0xf6, 0xce, 0x72, 0xee, 0x2f, 0xbf, 0x59, 0x18, 0xe0, 0x56,
+	0xeb, 0x9a, 0x50, 0x16, 0x68, 0xdf, 0x8e, 0xa0, 0xf9, 0xde, 0x92, 0x35, 0x80, 0x30, 0x45, 0xf9,
+	0x3e, 0x81, 0x39, 0x57, 0xc4, 0xe0, 0xf1, 0x7c, 0x16, 0xe5, 0x4a, 0x0b, 0x9d, 0xad, 0x19, 0x68,
+	0xab, 0x45, 0x2c, 0xe9, 0xa7, 0x0a, 0x90, 0x28, 0x3a, 0x92, 0xbd, 0x02, 0x03, 0x7c, 0x7e, 0x1e,
+	0xb9, 0x83, 0x59, 0xd8, 0x4a, 0x6d, 0x72, 0x33, 0x85, 0xd5, 0x99, 0x9e, 0xac, 0xe4, 0x9c, 0x31,
+	0x5a, 0x2a, 0x4c, 0x08, 0x56, 0x1b, 0x9e, 0x15, 0x75, 0x5b, 0xc4, 0x63, 0x03, 0x26, 0x13, 0x32,
+	0x24, 0x7d, 0x11, 0x72, 0xb6, 0x67, 0x95, 0x7d, 0xe2, 0x3c, 0x53, 0x13, 0xed, 0x56, 0x61, 0x5c,
+	0x66, 0x2a, 0x10, 0x51, 0x6d, 0xc8, 0x46, 0x53, 0x81, 0xb7, 0x82, 0x73, 0xf1, 0x91, 0x87, 0x3b,
+	0x75, 0x63, 0x3f, 0x69, 0xa7, 0xb7, 0x61, 0x32, 0x01, 0x12, 0x92, 0x12, 0xca, 0x6c, 0xa7, 0x6e,
+	0x08, 0x9c, 0x5c, 0x94, 0x54, 0x20, 0xa2, 0xda, 0x50, 0x1d, 0x4d, 0xe9, 0x6f, 0x15, 0x98, 0x11,
+	0x60, 0x2b, 0xfa, 0x76, 0xe5, 0xb6, 0x63, 0xda, 0x1c, 0xf4, 0xc1, 0x96, 0xde, 0x34, 0xdc, 0xfd,
+	0x70, 0x23, 0x5b, 0x90, 0x63, 0xce, 0x63, 0xc3, 0x76, 0xcb, 0x26, 0x4f, 0x0a, 0x4f, 0xe8, 0x89,
+	0x58, 0x52, 0xfc, 0x74, 0xac, 0x38, 0xa6, 0xbd, 0x7c, 0x81, 0xef, 0x87, 0x5f, 0x7e, 0x51, 0x38,
+	0x5b, 0x33, 0xd9, 0x96, 0xb7, 0x59, 0xac, 0x38, 0x16, 0x6e, 0x4a, 0xfc, 0xb3, 0xe0, 0x56, 0x1f,
+	0x97, 0x38, 0x67, 0x57, 0x18, 0xb8, 0xda, 0x90, 0x44, 0x5f, 0xb7, 0xe9, 0x7f, 0x14, 0x28, 0x74,
+	0x65, 0x8e, 0x01, 0xd9, 0x84, 0x71, 0x97, 0x8f, 0x94, 0x1d, 0x8f, 0x95, 0x75, 0xcb, 0xf1, 0x6c,
+	0x86, 0x71, 0x79, 0x93, 0xcf, 0xfc, 0x8f, 0x56, 0x61, 0x52, 0xce, 0xe3, 0x56, 0x1f, 0x17, 0x4d,
+	0xa7, 0x64, 0xe9, 0x6c, 0xab, 0xb8, 0x6e, 0xb3, 0x76, 0xab, 0x70, 0x5c, 0x3a, 0x98, 0x34, 0xa7,
+	0xda, 0xa8, 0x18, 0xba, 0xeb, 0xb1, 0xeb, 0x62, 0x80, 0xbc, 0x07, 0x80, 0x1e, 0x3b, 0x1e, 0x7b,
+	0x15, 0x2e, 0x63, 0x40, 0xef, 0x7a, 0x8c, 0xfe, 0x48, 0x81, 0x33, 0x81, 0xcf, 0xab, 0x4f, 0x4d,
+	0xc6, 0x7d, 0x16, 0x5a, 0x6b, 0x4d, 0xc7, 0x8a, 0xa7, 0xed, 0x78, 0x22, 0x6d, 0x41, 0x8a, 0x56,
+	0x61, 0x4c, 0x7a, 0x65, 0xda, 0x7e, 0x4c, 0xfa, 0x44, 0x4c, 0x4e, 0xee, 0x1a, 0x13, 0x6d, 0x44,
+	0x58, 0xad, 0xdb, 0xd2, 0x6f, 0xfa, 0x99, 0x02, 0x67, 0x7b, 0x73, 0xc1, 0x44, 0xc4, 0x83, 0xa4,
+	0xbc, 0xd2, 0x20, 0xad, 0xc2, 0xb1, 0x60, 0x7b, 0xc4, 0xca, 0xf6, 0xde, 0x76, 0xd9, 0x4d, 0x38,
+	0xde, 0x01, 0x83, 0xde, 0xcc, 0x77, 0x16, 0xfb, 0xce, 0x92, 0x15, 0x94, 0xf9, 0xb5, 0x08, 0xd0,
+	0x3b, 0x86, 0x59, 0xdb, 0x62, 0xfb, 0x23, 0xf4, 0x4c, 0x01, 0x08, 0x31, 0xc8, 0x1c, 0x0c, 0x54,
+	0x0d, 0xdb, 0xb1, 0x70, 0x41, 0x8f, 0xb7, 0x5b, 0x85, 0xc3, 0xd2, 0x52, 0x0c, 0x53, 0x4d, 0x8a,
+	0xc9, 0x1d, 0x18, 0x7c, 0x22, 0x2c, 0x30, 0xcb, 0x97, 0x31, 0xcb, 0x53, 0x9d, 0x59, 0xbe, 0x63,
+	0xd4, 0xf4, 0xca, 0xce, 0x0d, 0xa3, 0xd2, 0x6e, 0x15, 0x46, 0x24, 0x96, 0x34, 0xa5, 0x1a, 0x62,
+	0xd0, 0x5f, 0xf5, 0x43, 0xbe, 0xd3, 0x1b, 0x8c, 0x8b, 0x0e, 0x63, 0x15, 0xaf, 0xd9, 0x34, 0x6c,
+	0x56, 0x96, 0xea, 0x7e, 0x4d, 0xcf, 0x27, 0x4e, 0xc3, 0xc0, 0x76, 0x79, 0x86, 0xb3, 0x69, 0xb7,
+	0x0a, 0xc7, 0xe4, 0x74, 0x09, 0x73, 0xaa, 0x8d, 0xe2, 0x08, 0x4e, 0x45, 0xd6, 0x60, 0x1c, 0x65,
+	0xe5, 0xca, 0x96, 0x6e, 0xd7, 0x4c, 0xbb, 0x26, 0xfc, 0x1a, 0x5a, 0x9e, 0x0a, 0x37, 0x6d, 0x52,
+	0x83, 0x6a, 0x63, 0x38, 0xb4, 0x82, 0x23, 0x9c, 0xaa, 0x69, 0x9b, 0xcc, 0xd4, 0xb7, 0x03, 0xaa,
+	0x07, 0xf7, 0x46, 0x35, 0x61, 0x4e, 0xb5, 0x51, 0x1c, 0xf1, 0xa9, 0x7e, 0x17, 0x46, 0x99, 0xde,
+	0xac, 0x19, 0x61, 0x30, 0xfa, 0x7b, 0xcc, 0x70, 0x12, 0x67, 0x98, 0x94, 0x33, 0xc4, 0xad, 0xa9,
+	0x36, 0x22, 0x07, 0x7c, 0xfc, 0x87, 0x00, 0x2e, 0xd3, 0x9b, 0xac, 0xcc, 0x9b, 0x9c, 0xfc, 0x80,
+	0x58, 0x89, 0x6a, 0xc7, 0x4a, 0x7c, 0xe8, 0x77, 0x40, 0xcb, 0x27, 0xda, 0xad, 0xc2, 0x11, 0x89,
+	0x1c, 0xda, 0xd1, 0x8f, 0xbf, 0x28, 0x28, 0x5a, 0x4e, 0x0c, 0x70, 0x55, 0xb2, 0x01, 0x43, 0x86,
+	0x5d, 0x95, 0x98, 0x83, 0x3d, 0x31, 0x8f, 0xb7, 0x5b, 0x85, 0x31, 0x89, 0xe9, 0x5b, 0x49, 0xc4,
+	0x43, 0x86, 0x5d, 0xe5, 0x6a, 0xf4, 0x3e, 0x9e, 0x2f, 0x0f, 0x1d, 0xa6, 0x6f, 0x73, 0x5f, 0xef,
+	0x98, 0x0d, 0xcf, 0xac, 0x9a, 0x6c, 0x67, 0xdf, 0x2d, 0xcf, 0xcf, 0xfd, 0xca, 0x9f, 0x86, 0x89,
+	0x4b, 0xf1, 0x7d, 0xc8, 0x6d, 0xfb, 0x83, 0xbd, 0xeb, 0xcd, 0x0d, 0x0c, 0x3c, 0x9e, 0x94, 0x81,
+	0x25, 0xdd, 0x5b, 0x0d, 0x0a, 0xec, 0x04, 0x4d, 0x7f, 0xdf, 0x0b, 0x96, 0xfb, 0x3f, 0x52, 0xa9,
+	0x07, 0xf9, 0x4e, 0x1c, 0x74, 0xf3, 0x5b, 0x70, 0x98, 0xf1, 0xe1, 0xb2, 0xa8, 0xcd, 0x7e, 0x3d,
+	0xda, 0xc5, 0xd3, 0x29, 0xf4, 0xf4, 0x28, 0x2e, 0xb1, 0x88, 0x31, 0xd5, 0x86, 0x59, 0x38, 0x05,
+	0xfd, 0x83, 0x02, 0xb3, 0x1d, 0xe7, 0xeb, 0x86, 0xf3, 0xe0, 0x89, 0x5e, 0xff, 0xbf, 0xe8, 0x0f,
+	0xfe, 0xad, 0xc0, 0xe9, 0x1e, 0xfc, 0x31, 0x88, 0x1f, 0xec, 0xed, 0x70, 0x5a, 0xc5, 0x10, 0x1e,
+	0xf1, 0x43, 0xe8, 0x9b, 0xd2, 0x7d, 0x9e, 0x58, 0xe4, 0x2a, 0x80, 0x4c, 0x01, 0xb6, 0x10, 0x19,
+	0x0e, 0xe3, 0x9c, 0x34, 0xe0, 0xe7, 0xdd, 0x2f, 0xfa, 0xb0, 0x1f, 0x7c, 0x50, 0x77, 0xd8, 0xbd,
+	0xa6, 0x59, 0xd9, 0x57, 0x57, 0x49, 0x56, 0x61, 0x9c, 0xfb, 0x5a, 0xd6, 0x5d, 0xd7, 0x60, 0x65,
+	0x79, 0xb4, 0x48, 0x2a, 0x91, 0xca, 0x9a, 0xd4, 0xa0, 0xda, 0x28, 0x1f, 0xba, 0xce, 0x47, 0x6e,
+	0xf0, 0x01, 0x72, 0x0b, 0x8e, 0x34, 0x3c, 0x87, 0xc5, 0x71, 0x0e, 0x0a, 0x9c, 0xe9, 0x76, 0xab,
+	0x90, 0x97, 0x38, 0x1d, 0x2a, 0x54, 0x1b, 0x13, 0x63, 0x11, 0xa4, 0x0d, 0x18, 0x7e, 0x62, 0xb2,
+	0x2d, 0x9e, 0xb0, 0x35, 0xc3, 0xc8, 0xf7, 0x8b, 0x2a, 0x3f, 0xdf, 0x6e, 0x15, 0xe6, 0xb0, 0xca,
+	0x9b, 0x6c, 0xab, 0x2c, 0xee, 0xa1, 0x8f, 0x0c, 0x83, 0xce, 0x57, 0x8d, 0x7a, 0xd3, 0xa8, 0xe8,
+	0xcc, 0xa8, 0x2e, 0x51, 0xd6, 0xf4, 0x0c, 0x9a, 0x57, 0xb4, 0x28, 0x80, 0xd8, 0x93, 0x7f, 0x52,
+	0x60, 0x2a, 0xbc, 0x82, 0xbc, 0x63, 0xb2, 0xad, 0x35, 0x73, 0x9b, 0x19, 0x4d, 0x3f, 0x62, 0x6f,
+	0xc1, 0x88, 0x65, 0xda, 0xe5, 0x68, 0xe9, 0xe0, 0xcc, 0xf3, 0xed, 0x56, 0x61, 0x42, 0xce, 0x1a,
+	0x13, 0x53, 0xed, 0xb0, 0x65, 0xda, 0x41, 0xf5, 0x21, 0x53, 0xd1, 0x06, 0x5c, 0x04, 0x2f, 0x6c,
+	0xb5, 0x13, 0xd7, 0xa8, 0x83, 0xfb, 0xbe, 0x46, 0xfd, 0x4c, 0x81, 0xe9, 0x74, 0x1f, 0xbe, 0x24,
+	0x17, 0x2a, 0x0d, 0x8e, 0x25, 0xd7, 0x23, 0x32, 0xbb, 0x0c, 0xe0, 0xd6, 0x1d, 0x56, 0xae, 0xf3,
+	0x51, 0x8c, 0xed, 0x64, 0xe4, 0x58, 0x0a, 0x64, 0x54, 0xcb, 0xb9, 0xbe, 0xb5, 0x48, 0xdc, 0xb3,
+	0x3e, 0x38, 0x29, 0x41, 0x9f, 0xe8, 0xf5, 0xd5, 0xa7, 0x7a, 0x05, 0xdb, 0xef, 0x75, 0xdb, 0x4f,
+	0xdd, 0x39, 0x18, 0x74, 0x0d, 0xbb, 0x6a, 0x34, 0x11, 0xf7, 0x48, 0xd8, 0xc4, 0xc8, 0x71, 0xaa,
+	0xa1, 0x42, 0x74, 0x5f, 0xf4, 0xf5, 0xdc, 0x17, 0x45, 0x90, 0x35, 0xa5, 0x6c, 0xca, 0xa4, 0xe5,
+	0x96, 0x8f, 0x86, 0x87, 0x9e, 0x2f, 0xa1, 0xda, 0x21, 0xf1, 0x73, 0xdd, 0x26, 0xdf, 0x80, 0x41,
+	0xf1, 0x2e, 0xe2, 0x1f, 0xf7, 0x73, 0xc5, 0xc8, 0xfb, 0x49, 0x10, 0x3c, 0xee, 0x46, 0xe0, 0x01,
+	0x57, 0x5f, 0x9e, 0xc4, 0xb2, 0x82, 0x9c, 0x25, 0x06, 0xd5, 0x10, 0x4c, 0x04, 0xe1, 0x23, 0xff,
+	0xb2, 0x96, 0x12, 0x84, 0xf0, 0xc6, 0x23, 0x39, 0xed, 0xfb, 0xc6, 0x93, 0x34, 0xa7, 0xda, 0xa8,
+	0x18, 0x0a, 0x6e, 0x3c, 0x82, 0xca, 0x0f, 0xfb, 0xd2, 0xa9, 0xdc, 0xf5, 0xd8, 0xab, 0x4e, 0xc8,
+	0x37, 0x83, 0x00, 0xcb, 0x8e, 0xed, 0x4c, 0x8f, 0x00, 0x73, 0x4a, 0x19, 0x22, 0xcc, 0x6f, 0xcf,
+	0x81, 0xef, 0xf9, 0xfe, 0xe4, 0xed, 0x39, 0x10, 0x51, 0x3c, 0x63, 0xee, 0x7a, 0x32, 0x12, 0x1f,
+	0xfa, 0xdd, 0x48, 0x5a, 0x24, 0x30, 0x2b, 0x65, 0x18, 0xf3, 0x57, 0x4a, 0x3c, 0x29, 0x6f, 0xf4,
+	0x4a, 0xca, 0xb1, 0xf8, 0x3a, 0x0b, 0x72, 0x32, 0x82, 0xcb, 0x2d, 0x92, 0x92, 0x69, 0x50, 0xc3,
+	0x3e, 0x21, 0xd9, 0x65, 0xd1, 0x9f, 0xfa, 0x95, 0x2f, 0x29, 0xfe, 0x52, 0x34, 0x4c, 0xf4, 0xf7,
+	0x83, 0x70, 0xf4, 0xa6, 0x6e, 0x59, 0x2b, 0x9e, 0xcb, 0x1c, 0x4b, 0x12, 0xe5, 0x45, 0xf3, 0x2a,
+	0xe4, 0x1a, 0x7e, 0xad, 0xc3, 0xee, 0x66, 0x26, 0xde, 0x3f, 0x27, 0x9f, 0xd0, 0xb4, 0xd0, 0x80,
+	0x5c, 0x03, 0x08, 0x3e, 0x5c, 0x2c, 0x69, 0x85, 0x2e, 0xe6, 0x6e, 0x50, 0x6b, 0x43, 0x13, 0x72,
+	0x0b, 0x46, 0x1a, 0xd1, 0xa7, 0x16, 0x2c, 0xdb, 0xb4, 0x0b, 0x46, 0xe4, 0x49, 0x47, 0x8b, 0x1b,
+	0x92, 0xef, 0xc0, 0x44, 0x23, 0xa5, 0x68, 0x8b, 0x85, 0x36, 0xbc, 0x78, 0xae, 0x1b, 0xa9, 0x8e,
+	0x23, 0x4a, 0x4b, 0x85, 0x09, 0x88, 0xfa, 0x0f, 0x55, 0xf9, 0x81, 0xae, 0x44, 0x13, 0xef, 0x5c,
+	0x5a, 0xdc, 0x90, 0xbc, 0x0b, 0x47, 0x1b, 0x9d, 0xeb, 0x04, 0xef, 0x02, 0x67, 0x53, 0xf0, 0x52,
+	0xd7, 0x9b, 0x96, 0x06, 0x42, 0x1e, 0xc1, 0xf1, 0x46, 0x7a, 0xe3, 0x9e, 0x3f, 0x24, 0xf0, 0xe7,
+	0xbb, 0xe1, 0xa7, 0xdd, 0x1c, 0xb4, 0x6e, 0x60, 0xe4, 0xeb, 0x30, 0xda, 0x88, 0x9d, 0x40, 0xf9,
+	0x21, 0x01, 0x7f, 0x2a, 0x05, 0x3e, 0xd9, 0x35, 0x69, 0x09, 0x53, 0xb2, 0x01, 0x63, 0x8d, 0xf8,
+	0x43, 0x40, 0x3e, 0x27, 0xd0, 0x66, 0xbb, 0x24, 0x2d, 0xf6, 0xe8, 0xa0, 0x25, 0x8d, 0xc9, 0x7d,
+	0x18, 0x6f, 0x24, 0xfa, 0xf9, 0x3c, 0x08, 0xc0, 0xd3, 0xdd, 0xbc, 0x8f, 0x35, 0xdc, 0x5a, 0x87,
+	0xf9, 0xe2, 0xf3, 0x09, 0x18, 0x10, 0xda, 0xc4, 0x81, 0x01, 0x99, 0xc6, 0x5e, 0xcb, 0x5c, 0x7d,
+	0xad, 0xbb, 0x82, 0x2c, 0x09, 0xf4, 0xd4, 0xb3, 0xbf, 0xfe, 0xeb, 0x93, 0xbe, 0x93, 0x64, 0xaa,
+	0x24, 0xb6, 0xaf, 0xe9, 0x96, 0xe2, 0x4f, 0xe4, 0x62, 0x9e, 0x0f, 0x60, 0x28, 0x58, 0x3a, 0x19,
+	0x56, 0x9b, 0x7a, 0x6a, 0x57, 0x1d, 0x9c, 0xf9, 0x75, 0x31, 0xf3, 0x57, 0x48, 0x21, 0x7d, 0xe6,
+	0xe0, 0x79, 0xf5, 0xa3, 0x3e, 0x85, 0x7c, 0xaa, 0xc0, 0x68, 0x62, 0x99, 0x65, 0x5e, 0xa5, 0xea,
+	0xb9, 0x0c, 0x9a, 0xc8, 0x69, 0x41, 0x70, 0x3a, 0x43, 0x4e, 0xa7, 0x73, 0x92, 0x37, 0xa9, 0xa0,
+	0xa0, 0x91, 0xcf, 0x14, 0x18, 0x4b, 0x6e, 0xd2, 0xec, 0xbb, 0x5c, 0x7d, 0x3d, 0x8b, 0x2a, 0x32,
+	0x9b, 0x17, 0xcc, 0xe6, 0xc8, 0x6c, 0x3a, 0xb3, 0x47, 0x42, 0xdb, 0xa8, 0xca, 0x90, 0x91, 0x1d,
+	0xe8, 0x17, 0xb5, 0xb1, 0x47, 0x19, 0x55, 0x0b, 0x5d, 0xe5, 0x38, 0xed, 0x85, 0xdd, 0x03, 0x22,
+	0x66, 0x2b, 0x7d, 0x1f, 0x8f, 0xef, 0xf7, 0x79, 0xaa, 0x3e, 0x54, 0x60, 0x28, 0x28, 0x88, 0x19,
+	0x6a, 0xa8, 0x7a, 0x6a, 0x57, 0x1d, 0xe4, 0x71, 0x51, 0xf0, 0x38, 0x4f, 0xce, 0x75, 0xe7, 0x21,
+	0x1a, 0xf2, 0x90, 0x0b, 0xf9, 0x81, 0x02, 0xf9, 0x6e, 0xd7, 0x42, 0xb2, 0x98, 0x32, 0x69, 0x8f,
+	0x3b, 0xb0, 0x7a, 0x69, 0x4f, 0x36, 0x48, 0xfc, 0x00, 0xf9, 0xb5, 0x02, 0xa4, 0xf3, 0xf9, 0x9a,
+	0xcc, 0xf7, 0x40, 0x8b, 0xcf, 0xbd, 0x90, 0x51, 0x1b, 0x67, 0x7d, 0x5b, 0x84, 0x6b, 0x89, 0xbc,
+	0x99, 0x29, 0x6d, 0xa5, 0xf7, 0x1c, 0xd3, 0x96, 0xb7, 0x2c, 0x83, 0xf7, 0x36, 0x65, 0xd3, 0x26,
+	0x7f, 0x53, 0x60, 0x6a, 0x97, 0x47, 0x5f, 0x72, 0xa5, 0x0b, 0xa1, 0xdd, 0x1f, 0xac, 0xd5, 0xaf,
+	0xee, 0xd5, 0x0c, 0x1d, 0xba, 0x29, 0x1c, 0xba, 0x4e, 0xae, 0x65, 0x73, 0xc8, 0x78, 0x6a, 0x32,
+	0xe9, 0x90, 0x7c, 0x05, 0x97, 0x1d, 0x15, 0xf7, 0xeb, 0xc7, 0xf8, 0xc0, 0x8a, 0x75, 0x3a, 0x53,
+	0x79, 0x57, 0x4f, 0xf7, 0xd0, 0x42, 0x92, 0x97, 0x05, 0xc9, 0x22, 0x99, 0xcf, 0x46, 0x52, 0x3e,
+	0x1d, 0x93, 0x4f, 0x14, 0x18, 0x8e, 0x3c, 0xb4, 0x92, 0x6e, 0x93, 0xc5, 0x9f, 0x95, 0xd5, 0xb9,
+	0x5e, 0x6a, 0x48, 0xea, 0x8a, 0x20, 0x55, 0x22, 0x0b, 0xd9, 0x48, 0xe1, 0x43, 0x24, 0xf9, 0x9d,
+	0x02, 0x24, 0xe5, 0xd0, 0xdd, 0xd3, 0xd9, 0xad, 0x2e, 0x64, 0xd4, 0x46, 0xaa, 0xab, 0x82, 0xea,
+	0x55, 0xb2, 0x94, 0x8d, 0xaa, 0xac, 0xc6, 0xe2, 0x33, 0x28, 0xc9, 0xbc, 0x02, 0xfd, 0x44, 0x81,
+	0xe1, 0xc8, 0xc1, 0x49, 0xb2, 0x9d, 0xb8, 0xea, 0x5c, 0x2f, 0x35, 0x64, 0xb9, 0x24, 0x58, 0x5e,
+	0x26, 0x8b, 0x7b, 0x61, 0x29, 0x5f, 0x72, 0xf8, 0xea, 0xcb, 0x85, 0x4d, 0x47, 0x96, 0x4e, 0x45,
+	0x9d, 0xdd, 0x5d, 0x09, 0x49, 0xbd, 0xb1, 0xc7, 0xa5, 0xc7, 0x8d, 0xc5, 0xc9, 0xfa, 0x47, 0x05,
+	0x4e, 0xac, 0xba, 0xcc, 0xb4, 0x74, 0x66, 0x74, 0xdc, 0x38, 0xc9, 0xf9, 0xb4, 0xc9, 0xbb, 0x5c,
+	0xce, 0xd5, 0xf9, 0x6c, 0xca, 0xc8, 0xf8, 0x96, 0x60, 0x7c, 0x8d, 0xbc, 0x95, 0xce, 0x38, 0xb2,
+	0x97, 0x91, 0x5d, 0x29, 0x52, 0xa0, 0x82, 0xfd, 0xcc, 0x5d, 0xf8, 0xb3, 0x02, 0x6a, 0x17, 0x17,
+	0xf8, 0xc3, 0x5b, 0x06, 0x5a, 0xe1, 0x85, 0x56, 0x5d, 0xc8, 0xa8, 0x8d, 0x5e, 0xac, 0x0b, 0x2f,
+	0xde, 0x26, 0x5f, 0xfb, 0x1f, 0xbc, 0x70, 0x3c, 0xc6, 0xdd, 0x30, 0x61, 0x10, 0x8b, 0xd2, 0x54,
+	0xda, 0xff, 0x15, 0xf8, 0x04, 0xa7, 0xd3, 0x85, 0xc8, 0x67, 0x56, 0xf0, 0x99, 0x21, 0xd3, 0x5d,
+	0xd6, 0x81, 0xd0, 0x5e, 0xbe, 0xfd, 0xfc, 0xc5, 0x8c, 0xf2, 0xf9, 0x8b, 0x19, 0xe5, 0x9f, 0x2f,
+	0x66, 0x94, 0x8f, 0x5f, 0xce, 0x1c, 0xf8, 0xfc, 0xe5, 0xcc, 0x81, 0xbf, 0xbf, 0x9c, 0x39, 0xf0,
+	0xee, 0x85, 0xc8, 0xbd, 0x6e, 0xd3, 0x64, 0x9b, 0x7a, 0xb5, 0x66, 0xb8, 0xe1, 0xaf, 0xca, 0x96,
+	0x6e, 0xda, 0xa5, 0xa7, 0x12, 0x54, 0xdc, 0xf2, 0x36, 0x07, 0xc5, 0x8b, 0xd3, 0xa5, 0xff, 0x0e,
+	0x00, 0xc9, 0x02, 0x6b, 0xdd, 0x97, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1905,6 +2108,9 @@ type QueryClient interface {
 	CalcJoinPoolShares(ctx context.Context, in *QueryCalcJoinPoolSharesRequest, opts ...grpc.CallOption) (*QueryCalcJoinPoolSharesResponse, error)
 	CalcExitPoolCoinsFromShares(ctx context.Context, in *QueryCalcExitPoolCoinsFromSharesRequest, opts ...grpc.CallOption) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
 	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
+	// PoolWeights returns the weights of a balancer pool at the current block
+	// time, and the target weights of its weight schedule if it has one.
+	PoolWeights(ctx context.Context, in *QueryPoolWeightsRequest, opts ...grpc.CallOption) (*QueryPoolWeightsResponse, error)
 	// Deprecated: please use the alternative in x/poolmanager
 	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
@@ -2019,6 +2225,15 @@ func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest
 	return out, nil
 }
 
+func (c *queryClient) PoolWeights(ctx context.Context, in *QueryPoolWeightsRequest, opts ...grpc.CallOption) (*QueryPoolWeightsResponse, error) {
+	out := new(QueryPoolWeightsResponse)
+	err := c.cc.Invoke(ctx, "/gamm.v1beta1.Query/PoolWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error) {
 	out := new(QueryTotalPoolLiquidityResponse)
@@ -2098,6 +2313,9 @@ type QueryServer interface {
 	CalcJoinPoolShares(context.Context, *QueryCalcJoinPoolSharesRequest) (*QueryCalcJoinPoolSharesResponse, error)
 	CalcExitPoolCoinsFromShares(context.Context, *QueryCalcExitPoolCoinsFromSharesRequest) (*QueryCalcExitPoolCoinsFromSharesResponse, error)
 	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
+	// PoolWeights returns the weights of a balancer pool at the current block
+	// time, and the target weights of its weight schedule if it has one.
+	PoolWeights(context.Context, *QueryPoolWeightsRequest) (*QueryPoolWeightsResponse, error)
 	// Deprecated: please use the alternative in x/poolmanager
 	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
@@ -2146,6 +2364,9 @@ func (*UnimplementedQueryServer) CalcExitPoolCoinsFromShares(ctx context.Context
 func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
 }
+func (*UnimplementedQueryServer) PoolWeights(ctx context.Context, req *QueryPoolWeightsRequest) (*QueryPoolWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolWeights not implemented")
+}
 func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
 }
@@ -2349,6 +2570,24 @@ func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gamm.v1beta1.Query/PoolWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolWeights(ctx, req.(*QueryPoolWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalPoolLiquidityRequest)
 	if err := dec(in); err != nil {
@@ -2502,6 +2741,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PoolParams",
 			Handler:    _Query_PoolParams_Handler,
 		},
+		{
+			MethodName: "PoolWeights",
+			Handler:    _Query_PoolWeights_Handler,
+		},
 		{
 			MethodName: "TotalPoolLiquidity",
 			Handler:    _Query_TotalPoolLiquidity_Handler,
@@ -3070,7 +3313,7 @@ func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3080,12 +3323,12 @@ func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3098,7 +3341,7 @@ func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolWeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3108,20 +3351,118 @@ func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolWeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetWeights) > 0 {
+		for iNdEx := len(m.TargetWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TargetWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InitialWeights) > 0 {
+		for iNdEx := len(m.InitialWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WeightsChanging {
+		i--
+		if m.WeightsChanging {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrentWeights) > 0 {
+		for iNdEx := len(m.CurrentWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3135,7 +3476,7 @@ func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3145,12 +3486,12 @@ func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3163,7 +3504,7 @@ func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3173,21 +3514,86 @@ func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
@@ -4069,6 +4475,71 @@ func (m *QueryPoolParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPoolWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentWeights) > 0 {
+		for _, e := range m.CurrentWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.WeightsChanging {
+		n += 2
+	}
+	if len(m.InitialWeights) > 0 {
+		for _, e := range m.InitialWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TargetWeights) > 0 {
+		for _, e := range m.TargetWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5710,6 +6181,435 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPoolWeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolWeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWeights = append(m.CurrentWeights, PoolWeight{})
+			if err := m.CurrentWeights[len(m.CurrentWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightsChanging", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WeightsChanging = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialWeights = append(m.InitialWeights, PoolWeight{})
+			if err := m.InitialWeights[len(m.InitialWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetWeights = append(m.TargetWeights, PoolWeight{})
+			if err := m.TargetWeights[len(m.TargetWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
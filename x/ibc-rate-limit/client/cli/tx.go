@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+const (
+	FlagInbound  = "inbound"
+	FlagOutbound = "outbound"
+)
+
+// GetTxCmd returns the transaction commands for this module
+// Params and rate limit updates are governance proposals; only the guardian operations are exposed here
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdPauseChannel())
+	cmd.AddCommand(CmdUnpauseChannel())
+
+	return cmd
+}
+
+func CmdPauseChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-channel [channel-id]",
+		Short: "Pause inbound and/or outbound transfers on a channel (guardian or governance only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			inbound, err := cmd.Flags().GetBool(FlagInbound)
+			if err != nil {
+				return err
+			}
+			outbound, err := cmd.Flags().GetBool(FlagOutbound)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPauseChannel{
+				Signer:    clientCtx.GetFromAddress().String(),
+				ChannelId: args[0],
+				Inbound:   inbound,
+				Outbound:  outbound,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagInbound, false, "Pause received transfers")
+	cmd.Flags().Bool(FlagOutbound, false, "Pause sent transfers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnpauseChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-channel [channel-id]",
+		Short: "Lift a channel pause before it expires (guardian or governance only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnpauseChannel{
+				Signer:    clientCtx.GetFromAddress().String(),
+				ChannelId: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
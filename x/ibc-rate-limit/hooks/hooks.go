@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -13,6 +14,7 @@ import (
 
 	ibchooks "github.com/bitbadges/bitbadgeschain/x/ibc-hooks"
 	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/keeper"
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
 )
 
 var (
@@ -130,6 +132,16 @@ func (h *RateLimitOverrideHooks) OnRecvPacketOverride(im ibchooks.IBCMiddleware,
 	// Extract sender address (from packet data)
 	senderAddr := data.Sender
 
+	// Reject transfers while the channel is paused by the guardian or governance
+	if h.keeper.IsChannelPaused(ctx, channelID, true) {
+		h.keeper.Logger(ctx).Error("channel inbound transfers paused, rejecting packet",
+			"channel", channelID,
+			"denom", denom,
+			"sender", senderAddr,
+		)
+		return types.NewCustomErrorAcknowledgement(fmt.Sprintf("channel is paused: channel=%s, direction=inbound", channelID))
+	}
+
 	// Check rate limit for inflow
 	rateLimitAck := h.keeper.CheckRateLimit(ctx, channelID, denom, amount, true, senderAddr)
 	if !rateLimitAck.Success() {
@@ -177,6 +189,11 @@ func (h *RateLimitOverrideHooks) SendPacketOverride(i ibchooks.ICS4Middleware, c
 	// Extract sender address (from packet data)
 	senderAddr := packetData.Sender
 
+	// Reject transfers while the channel is paused by the guardian or governance
+	if h.keeper.IsChannelPaused(ctx, sourceChannel, false) {
+		return 0, errorsmod.Wrapf(types.ErrChannelPaused, "channel=%s, direction=outbound", sourceChannel)
+	}
+
 	// Check rate limit for outflow
 	ack := h.keeper.CheckRateLimit(ctx, sourceChannel, denom, amount, false, senderAddr)
 	if !ack.Success() {
@@ -260,7 +277,11 @@ func (h *RateLimitOverrideHooks) refundSendTracking(ctx sdk.Context, packet chan
 
 	// Reverse supply shift tracking. Outflow added amount.Neg(); undo by adding amount.
 	for _, limit := range config.SupplyShiftLimits {
-		if limit.MaxAmount.IsZero() {
+		if !limit.IsEnabled() {
+			continue
+		}
+		if config.IsSlidingWindow() {
+			h.keeper.AddSlidingChannelFlow(ctx, sourceChannel, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, amount)
 			continue
 		}
 		flow, _ := h.keeper.GetChannelFlowWithTimeframe(ctx, sourceChannel, denom, limit.TimeframeType, limit.TimeframeDuration)
@@ -269,8 +290,13 @@ func (h *RateLimitOverrideHooks) refundSendTracking(ctx sdk.Context, packet chan
 	}
 
 	// Reverse per-address tracking. TotalAmount is tracked as Abs(), so subtract Abs().
-	if senderAddr != "" {
+	// Exempt addresses are never tracked, so there is nothing to reverse.
+	if senderAddr != "" && !params.IsExemptAddress(senderAddr) {
 		for _, limit := range config.AddressLimits {
+			if config.IsSlidingWindow() {
+				h.keeper.RefundSlidingAddressTransfer(ctx, senderAddr, sourceChannel, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, amount.Abs())
+				continue
+			}
 			data, _ := h.keeper.GetAddressTransferData(ctx, senderAddr, sourceChannel, denom, limit.TimeframeType, limit.TimeframeDuration)
 			if data.TransferCount > 0 {
 				data.TransferCount--
@@ -300,7 +326,11 @@ func (h *RateLimitOverrideHooks) updateTrackingAfterTransfer(ctx sdk.Context, ch
 
 	// Update multiple timeframe supply shift limits
 	for _, limit := range config.SupplyShiftLimits {
-		if limit.MaxAmount.IsZero() {
+		if !limit.IsEnabled() {
+			continue
+		}
+		if config.IsSlidingWindow() {
+			h.keeper.AddSlidingChannelFlow(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, amount)
 			continue
 		}
 		h.keeper.ResetChannelFlowWindowWithTimeframe(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
@@ -309,8 +339,11 @@ func (h *RateLimitOverrideHooks) updateTrackingAfterTransfer(ctx sdk.Context, ch
 		h.keeper.SetChannelFlowWithTimeframe(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, flow)
 	}
 
+	// Exempt addresses are not subject to unique sender or per-address limits, so they are not tracked
+	exempt := params.IsExemptAddress(senderAddr)
+
 	// Update unique sender tracking (only for inflows)
-	if isInflow && senderAddr != "" {
+	if isInflow && senderAddr != "" && !exempt {
 		for _, limit := range config.UniqueSenderLimits {
 			if limit.MaxUniqueSenders == 0 {
 				continue
@@ -321,8 +354,12 @@ func (h *RateLimitOverrideHooks) updateTrackingAfterTransfer(ctx sdk.Context, ch
 	}
 
 	// Update per-address tracking
-	if senderAddr != "" {
+	if senderAddr != "" && !exempt {
 		for _, limit := range config.AddressLimits {
+			if config.IsSlidingWindow() {
+				h.keeper.AddSlidingAddressTransfer(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, amount.Abs())
+				continue
+			}
 			h.keeper.ResetAddressTransferWindow(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
 			data, _ := h.keeper.GetAddressTransferData(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
 			data.TransferCount++
@@ -44,6 +44,9 @@ func (mockBank) GetSupply(ctx context.Context, denom string) sdk.Coin {
 func (mockBank) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
 	return sdk.Coins{}
 }
+func (mockBank) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.Coin{Denom: denom, Amount: sdkmath.ZeroInt()}
+}
 func (mockBank) MintCoins(ctx sdk.Context, moduleName string, coins sdk.Coins) error {
 	return nil
 }
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+// GetChannelPause gets the stored pause for a channel, regardless of whether it has expired
+func (k Keeper) GetChannelPause(ctx sdk.Context, channelID string) (types.ChannelPause, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelPauseKey(channelID))
+	if bz == nil {
+		return types.ChannelPause{}, false
+	}
+
+	var pause types.ChannelPause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause, true
+}
+
+// SetChannelPause sets the pause for a channel
+func (k Keeper) SetChannelPause(ctx sdk.Context, pause types.ChannelPause) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pause)
+	store.Set(types.ChannelPauseKey(pause.ChannelId), bz)
+}
+
+// DeleteChannelPause removes the pause for a channel
+func (k Keeper) DeleteChannelPause(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelPauseKey(channelID))
+}
+
+// GetActiveChannelPause gets the pause for a channel if it has not yet expired
+// Expired pauses are left in the store and simply ignored; they are overwritten by the next pause
+func (k Keeper) GetActiveChannelPause(ctx sdk.Context, channelID string) (types.ChannelPause, bool) {
+	pause, found := k.GetChannelPause(ctx, channelID)
+	if !found || ctx.BlockHeight() >= pause.ExpiresAt {
+		return types.ChannelPause{}, false
+	}
+	return pause, true
+}
+
+// IsChannelPaused returns true if transfers in the given direction are currently paused on the channel
+func (k Keeper) IsChannelPaused(ctx sdk.Context, channelID string, isInflow bool) bool {
+	pause, active := k.GetActiveChannelPause(ctx, channelID)
+	if !active {
+		return false
+	}
+	if isInflow {
+		return pause.Inbound
+	}
+	return pause.Outbound
+}
+
+// isGuardianOrAuthority returns true if the address can pause and unpause channels
+func (k Keeper) isGuardianOrAuthority(ctx sdk.Context, address string) bool {
+	if address == k.GetAuthority() {
+		return true
+	}
+	guardian := k.GetParams(ctx).Guardian
+	return guardian != "" && address == guardian
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/keeper"
+	ratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+const testGuardian = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+func (suite *KeeperTestSuite) TestPauseChannel_Authorization() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Guardian = testGuardian
+	params.PauseDuration = 100
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	_, err := msgServer.PauseChannel(suite.ctx, &ratelimittypes.MsgPauseChannel{
+		Signer:    "cosmos1nobody",
+		ChannelId: "channel-0",
+		Inbound:   true,
+	})
+	suite.Require().ErrorIs(err, ratelimittypes.ErrNotGuardian)
+
+	res, err := msgServer.PauseChannel(suite.ctx, &ratelimittypes.MsgPauseChannel{
+		Signer:    testGuardian,
+		ChannelId: "channel-0",
+		Inbound:   true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockHeight()+100, res.ExpiresAt)
+
+	suite.Require().True(suite.keeper.IsChannelPaused(suite.ctx, "channel-0", true))
+	suite.Require().False(suite.keeper.IsChannelPaused(suite.ctx, "channel-0", false), "only the inbound direction was paused")
+	suite.Require().False(suite.keeper.IsChannelPaused(suite.ctx, "channel-1", true))
+
+	// Governance can lift the pause early
+	_, err = msgServer.UnpauseChannel(suite.ctx, &ratelimittypes.MsgUnpauseChannel{
+		Signer:    suite.keeper.GetAuthority(),
+		ChannelId: "channel-0",
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsChannelPaused(suite.ctx, "channel-0", true))
+}
+
+func (suite *KeeperTestSuite) TestPauseChannel_Expires() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PauseDuration = 100
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	res, err := msgServer.PauseChannel(suite.ctx, &ratelimittypes.MsgPauseChannel{
+		Signer:    suite.keeper.GetAuthority(),
+		ChannelId: "channel-0",
+		Inbound:   true,
+		Outbound:  true,
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(res.ExpiresAt - 1)
+	suite.Require().True(suite.keeper.IsChannelPaused(suite.ctx, "channel-0", false))
+
+	suite.ctx = suite.ctx.WithBlockHeight(res.ExpiresAt)
+	suite.Require().False(suite.keeper.IsChannelPaused(suite.ctx, "channel-0", false))
+	suite.Require().False(suite.keeper.IsChannelPaused(suite.ctx, "channel-0", true))
+}
+
+func (suite *KeeperTestSuite) TestCheckRateLimit_ExemptAddress() {
+	channelID := "channel-0"
+	denom := "uatom"
+	exempt := "cosmos1exempt"
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ExemptAddresses = []string{exempt}
+	params.RateLimits[0].AddressLimits = []ratelimittypes.AddressLimit{
+		{
+			MaxTransfers:      1,
+			MaxAmount:         sdkmath.ZeroInt(),
+			TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK,
+			TimeframeDuration: 1000,
+		},
+	}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	limit := params.RateLimits[0].AddressLimits[0]
+	for _, addr := range []string{exempt, "cosmos1other"} {
+		suite.keeper.ResetAddressTransferWindow(suite.ctx, addr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+		suite.keeper.SetAddressTransferData(suite.ctx, addr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, ratelimittypes.AddressTransferData{
+			TransferCount: 1,
+			TotalAmount:   sdkmath.NewInt(1),
+		})
+	}
+
+	ack := suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(1), true, exempt)
+	suite.Require().True(ack.Success(), "exempt addresses skip per-address limits")
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(1), true, "cosmos1other")
+	suite.Require().False(ack.Success())
+
+	// Channel-wide supply shift limits still apply
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(300001), true, exempt)
+	suite.Require().False(ack.Success())
+}
+
+func (suite *KeeperTestSuite) TestValidateParams_GuardianAndExemptions() {
+	params := ratelimittypes.DefaultParams()
+	params.Guardian = "not-an-address"
+	suite.Require().Error(params.Validate())
+
+	params.Guardian = testGuardian
+	params.ExemptAddresses = []string{"cosmos1exempt", "cosmos1exempt"}
+	suite.Require().Error(params.Validate(), "duplicate exempt addresses")
+
+	params.ExemptAddresses = []string{"cosmos1exempt"}
+	suite.Require().NoError(params.Validate())
+
+	params.PauseDuration = -1
+	suite.Require().Error(params.Validate())
+}
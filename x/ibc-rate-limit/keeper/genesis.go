@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+// ImportTrackingState writes the flows, windows, unique senders, address data, buckets, snapshots and pauses from a genesis state
+// Params are not written here; see InitGenesis
+func (k Keeper) ImportTrackingState(ctx sdk.Context, genState types.GenesisState) {
+	for _, r := range genState.ChannelFlows {
+		k.SetChannelFlowWithTimeframe(ctx, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration, r.Flow)
+	}
+	for _, r := range genState.ChannelFlowWindows {
+		k.SetChannelFlowWindowWithTimeframe(ctx, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration, r.Window)
+	}
+	// Windows are written first since senders are keyed by the start of their window
+	for _, r := range genState.UniqueSendersWindows {
+		k.SetUniqueSendersWindow(ctx, r.ChannelId, r.TimeframeType, r.TimeframeDuration, r.Window)
+	}
+	for _, r := range genState.UniqueSenders {
+		for _, sender := range r.Senders {
+			k.AddUniqueSender(ctx, r.ChannelId, sender, r.TimeframeType, r.TimeframeDuration)
+		}
+	}
+	for _, r := range genState.AddressTransfers {
+		k.SetAddressTransferData(ctx, r.Address, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration, r.Data)
+	}
+	for _, r := range genState.AddressTransferWindows {
+		k.SetAddressTransferWindow(ctx, r.Address, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration, r.Window)
+	}
+	for _, r := range genState.ChannelFlowBuckets {
+		k.SetChannelFlowBuckets(ctx, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration, r.Buckets)
+	}
+	for _, r := range genState.AddressTransferBuckets {
+		k.SetAddressTransferBuckets(ctx, r.Address, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration, r.Buckets)
+	}
+	for _, r := range genState.SupplySnapshots {
+		k.SetSupplySnapshot(ctx, r.ChannelId, r.Denom, r.SupplyBasis, r.TimeframeType, r.TimeframeDuration, r.Snapshot)
+	}
+	for _, pause := range genState.ChannelPauses {
+		k.SetChannelPause(ctx, pause)
+	}
+}
+
+// ExportTrackingState reads all tracking state into the corresponding fields of a genesis state
+// Entries stored under the legacy (channel, denom) keys are not read by rate limit checks and are not exported
+func (k Keeper) ExportTrackingState(ctx sdk.Context, genState *types.GenesisState) {
+	k.iterateTrackingKeys(ctx, types.KeyPrefixChannelFlow, 1, 2, func(head []string, denom string, tail []int64, bz []byte) {
+		record := types.ChannelFlowRecord{ChannelId: head[0], Denom: denom, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1]}
+		k.cdc.MustUnmarshal(bz, &record.Flow)
+		genState.ChannelFlows = append(genState.ChannelFlows, record)
+	})
+	k.iterateTrackingKeys(ctx, types.KeyPrefixChannelFlowWindow, 1, 2, func(head []string, denom string, tail []int64, bz []byte) {
+		record := types.ChannelFlowWindowRecord{ChannelId: head[0], Denom: denom, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1]}
+		k.cdc.MustUnmarshal(bz, &record.Window)
+		genState.ChannelFlowWindows = append(genState.ChannelFlowWindows, record)
+	})
+	// Senders are read per channel and timeframe from the counts, since sender addresses may contain the key separator
+	// Only the current window is exported; senders of rolled over windows are stale and would be pruned anyway
+	k.iterateTrackingKeys(ctx, types.KeyPrefixUniqueSenderCount, 0, 3, func(_ []string, channelID string, tail []int64, _ []byte) {
+		timeframeType, timeframeDuration := types.TimeframeType(tail[0]), tail[1]
+		if tail[2] != k.currentUniqueSendersWindowStart(ctx, channelID, timeframeType, timeframeDuration) {
+			return
+		}
+		genState.UniqueSenders = append(genState.UniqueSenders, types.UniqueSendersRecord{
+			ChannelId: channelID, TimeframeType: timeframeType, TimeframeDuration: timeframeDuration,
+			Senders: k.GetUniqueSenders(ctx, channelID, timeframeType, timeframeDuration),
+		})
+	})
+	k.iterateTrackingKeys(ctx, types.KeyPrefixUniqueSendersWindow, 0, 2, func(_ []string, channelID string, tail []int64, bz []byte) {
+		record := types.UniqueSendersWindowRecord{ChannelId: channelID, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1]}
+		k.cdc.MustUnmarshal(bz, &record.Window)
+		genState.UniqueSendersWindows = append(genState.UniqueSendersWindows, record)
+	})
+	k.iterateTrackingKeys(ctx, types.KeyPrefixAddressTransferData, 2, 2, func(head []string, denom string, tail []int64, bz []byte) {
+		record := types.AddressTransferRecord{Address: head[0], ChannelId: head[1], Denom: denom, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1]}
+		k.cdc.MustUnmarshal(bz, &record.Data)
+		genState.AddressTransfers = append(genState.AddressTransfers, record)
+	})
+	k.iterateTrackingKeys(ctx, types.KeyPrefixAddressTransferWindow, 2, 2, func(head []string, denom string, tail []int64, bz []byte) {
+		record := types.AddressTransferWindowRecord{Address: head[0], ChannelId: head[1], Denom: denom, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1]}
+		k.cdc.MustUnmarshal(bz, &record.Window)
+		genState.AddressTransferWindows = append(genState.AddressTransferWindows, record)
+	})
+	k.iterateTrackingKeys(ctx, types.KeyPrefixChannelFlowBuckets, 1, 2, func(head []string, denom string, tail []int64, bz []byte) {
+		record := types.ChannelFlowBucketsRecord{ChannelId: head[0], Denom: denom, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1]}
+		k.cdc.MustUnmarshal(bz, &record.Buckets)
+		genState.ChannelFlowBuckets = append(genState.ChannelFlowBuckets, record)
+	})
+	k.iterateTrackingKeys(ctx, types.KeyPrefixAddressTransferBuckets, 2, 2, func(head []string, denom string, tail []int64, bz []byte) {
+		record := types.AddressTransferBucketsRecord{Address: head[0], ChannelId: head[1], Denom: denom, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1]}
+		k.cdc.MustUnmarshal(bz, &record.Buckets)
+		genState.AddressTransferBuckets = append(genState.AddressTransferBuckets, record)
+	})
+	k.iterateTrackingKeys(ctx, types.KeyPrefixSupplySnapshot, 1, 3, func(head []string, denom string, tail []int64, bz []byte) {
+		record := types.SupplySnapshotRecord{
+			ChannelId: head[0], Denom: denom, SupplyBasis: types.SupplyBasis(tail[0]), TimeframeType: types.TimeframeType(tail[1]), TimeframeDuration: tail[2],
+		}
+		k.cdc.MustUnmarshal(bz, &record.Snapshot)
+		genState.SupplySnapshots = append(genState.SupplySnapshots, record)
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixChannelPause)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pause types.ChannelPause
+		k.cdc.MustUnmarshal(iterator.Value(), &pause)
+		genState.ChannelPauses = append(genState.ChannelPauses, pause)
+	}
+}
+
+// iterateTrackingKeys iterates over all entries under a prefix whose keys are "|"-separated identifiers followed by integer fields
+// The key is split into numHead leading identifiers, the middle identifier (the denom, or the channel for unique sender keys)
+// and numTail trailing integers. The middle identifier takes any remaining separators so denoms are never truncated
+// Keys that do not have this shape are skipped
+func (k Keeper) iterateTrackingKeys(ctx sdk.Context, prefix []byte, numHead, numTail int, cb func(head []string, middle string, tail []int64, value []byte)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		parts := strings.Split(string(iterator.Key()[len(prefix):]), "|")
+		if len(parts) < numHead+1+numTail {
+			continue
+		}
+
+		tailParts := parts[len(parts)-numTail:]
+		tail := make([]int64, numTail)
+		valid := true
+		for i, part := range tailParts {
+			n, err := strconv.ParseInt(part, 10, 64)
+			if err != nil {
+				valid = false
+				break
+			}
+			tail[i] = n
+		}
+		if !valid {
+			continue
+		}
+
+		middle := strings.Join(parts[numHead:len(parts)-numTail], "|")
+		cb(parts[:numHead], middle, tail, iterator.Value())
+	}
+}
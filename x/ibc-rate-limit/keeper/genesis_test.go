@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	ratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+func testTrackingGenesis() ratelimittypes.GenesisState {
+	blockType := ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK
+	window := ratelimittypes.ChannelFlowWindow{WindowStart: 10, WindowDuration: 1000}
+	buckets := ratelimittypes.WindowBuckets{Buckets: []ratelimittypes.WindowBucket{
+		{BucketIndex: 1, Amount: sdkmath.NewInt(5), Count: 1},
+		{BucketIndex: 3, Amount: sdkmath.NewInt(7), Count: 2},
+	}}
+
+	return ratelimittypes.GenesisState{
+		Params: ratelimittypes.DefaultParams(),
+		ChannelFlows: []ratelimittypes.ChannelFlowRecord{
+			{ChannelId: "channel-0", Denom: "uatom", TimeframeType: blockType, TimeframeDuration: 1000, Flow: ratelimittypes.ChannelFlow{NetFlow: sdkmath.NewInt(-250)}},
+			{ChannelId: "channel-1", Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", TimeframeType: ratelimittypes.TimeframeType_TIMEFRAME_TYPE_DAY, TimeframeDuration: 1, Flow: ratelimittypes.ChannelFlow{NetFlow: sdkmath.NewInt(42)}},
+		},
+		ChannelFlowWindows: []ratelimittypes.ChannelFlowWindowRecord{
+			{ChannelId: "channel-0", Denom: "uatom", TimeframeType: blockType, TimeframeDuration: 1000, Window: window},
+		},
+		UniqueSenders: []ratelimittypes.UniqueSendersRecord{
+			{ChannelId: "channel-0", TimeframeType: blockType, TimeframeDuration: 1000, Senders: []string{"cosmos1a", "cosmos1b"}},
+		},
+		UniqueSendersWindows: []ratelimittypes.UniqueSendersWindowRecord{
+			{ChannelId: "channel-0", TimeframeType: blockType, TimeframeDuration: 1000, Window: window},
+		},
+		AddressTransfers: []ratelimittypes.AddressTransferRecord{
+			{Address: "cosmos1a", ChannelId: "channel-0", Denom: "uatom", TimeframeType: blockType, TimeframeDuration: 1000, Data: ratelimittypes.AddressTransferData{TransferCount: 3, TotalAmount: sdkmath.NewInt(900)}},
+		},
+		AddressTransferWindows: []ratelimittypes.AddressTransferWindowRecord{
+			{Address: "cosmos1a", ChannelId: "channel-0", Denom: "uatom", TimeframeType: blockType, TimeframeDuration: 1000, Window: window},
+		},
+		ChannelFlowBuckets: []ratelimittypes.ChannelFlowBucketsRecord{
+			{ChannelId: "channel-0", Denom: "uatom", TimeframeType: blockType, TimeframeDuration: 1000, Buckets: buckets},
+		},
+		AddressTransferBuckets: []ratelimittypes.AddressTransferBucketsRecord{
+			{Address: "cosmos1a", ChannelId: "channel-0", Denom: "uatom", TimeframeType: blockType, TimeframeDuration: 1000, Buckets: buckets},
+		},
+		SupplySnapshots: []ratelimittypes.SupplySnapshotRecord{
+			{ChannelId: "channel-0", Denom: "uatom", SupplyBasis: ratelimittypes.SupplyBasis_SUPPLY_BASIS_CHANNEL_ESCROW, TimeframeType: blockType, TimeframeDuration: 1000, Snapshot: ratelimittypes.SupplySnapshot{Amount: sdkmath.NewInt(1_000_000), SnapshotHeight: 10}},
+		},
+		ChannelPauses: []ratelimittypes.ChannelPause{
+			{ChannelId: "channel-0", Inbound: true, ExpiresAt: 500, PausedBy: "cosmos1guardian"},
+		},
+	}
+}
+
+func (suite *KeeperTestSuite) TestGenesis_TrackingStateRoundTrip() {
+	genState := testTrackingGenesis()
+	suite.Require().NoError(genState.Validate())
+
+	suite.keeper.ImportTrackingState(suite.ctx, genState)
+
+	// Legacy keys share the channel flow prefix but are not exported
+	suite.keeper.SetChannelFlow(suite.ctx, "channel-9", "uatom", ratelimittypes.ChannelFlow{NetFlow: sdkmath.NewInt(1)})
+
+	exported := ratelimittypes.GenesisState{Params: genState.Params}
+	suite.keeper.ExportTrackingState(suite.ctx, &exported)
+	suite.Require().Equal(genState, exported)
+
+	// Imported state is what rate limit checks see
+	flow, found := suite.keeper.GetChannelFlowWithTimeframe(suite.ctx, "channel-0", "uatom", ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK, 1000)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(-250), flow.NetFlow)
+	suite.Require().True(suite.keeper.IsChannelPaused(suite.ctx, "channel-0", true))
+}
+
+func (suite *KeeperTestSuite) TestGenesis_ValidateTrackingState() {
+	genState := testTrackingGenesis()
+	genState.ChannelFlows = append(genState.ChannelFlows, genState.ChannelFlows[0])
+	suite.Require().Error(genState.Validate(), "duplicate channel flow")
+
+	genState = testTrackingGenesis()
+	genState.UniqueSenders[0].Senders = []string{"cosmos1a", "cosmos1a"}
+	suite.Require().Error(genState.Validate(), "duplicate sender")
+
+	genState = testTrackingGenesis()
+	genState.AddressTransfers[0].Data.TotalAmount = sdkmath.NewInt(-1)
+	suite.Require().Error(genState.Validate())
+
+	genState = testTrackingGenesis()
+	genState.AddressTransferWindows[0].Window.WindowDuration = 0
+	suite.Require().Error(genState.Validate())
+
+	genState = testTrackingGenesis()
+	genState.ChannelFlowBuckets[0].Buckets.Buckets[1].BucketIndex = 1
+	suite.Require().Error(genState.Validate(), "unsorted buckets")
+
+	genState = testTrackingGenesis()
+	genState.SupplySnapshots[0].TimeframeType = ratelimittypes.TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+	suite.Require().Error(genState.Validate())
+
+	genState = testTrackingGenesis()
+	genState.ChannelPauses[0].Inbound = false
+	suite.Require().Error(genState.Validate())
+}
@@ -167,15 +167,25 @@ func (k Keeper) CheckRateLimit(ctx sdk.Context, channelID string, denom string,
 
 	// Check multiple timeframe supply shift limits
 	for _, limit := range config.SupplyShiftLimits {
-		if limit.MaxAmount.IsZero() {
+		if !limit.IsEnabled() {
 			continue // Limit disabled
 		}
 
-		// Reset window if needed
-		k.ResetChannelFlowWindowWithTimeframe(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
-
 		// Get current flow
-		flow, _ := k.GetChannelFlowWithTimeframe(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+		var flow types.ChannelFlow
+		if config.IsSlidingWindow() {
+			flow = k.GetSlidingChannelFlow(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+		} else {
+			// Reset window if needed
+			k.ResetChannelFlowWindowWithTimeframe(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+			flow, _ = k.GetChannelFlowWithTimeframe(ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+		}
+
+		// Percentage-based limits are resolved against the supply snapshot for this window
+		maxAmount, enforced := k.GetEffectiveMaxAmount(ctx, channelID, denom, limit)
+		if !enforced {
+			continue // Supply basis is zero and there is no fallback
+		}
 
 		// Calculate new flow after this transfer
 		newFlow := flow.NetFlow
@@ -187,15 +197,18 @@ func (k Keeper) CheckRateLimit(ctx sdk.Context, channelID string, denom string,
 
 		// Check if the absolute value of net flow exceeds the limit
 		absNewFlow := newFlow.Abs()
-		if absNewFlow.GT(limit.MaxAmount) {
+		if absNewFlow.GT(maxAmount) {
 			return types.NewCustomErrorAcknowledgement(
-				fmt.Sprintf("rate limit exceeded: supply shift limit exceeded: channel=%s, denom=%s, amount=%s, limit=%s, new_flow=%s", channelID, denom, amount.String(), limit.MaxAmount.String(), absNewFlow.String()),
+				fmt.Sprintf("rate limit exceeded: supply shift limit exceeded: channel=%s, denom=%s, amount=%s, limit=%s, new_flow=%s", channelID, denom, amount.String(), maxAmount.String(), absNewFlow.String()),
 			)
 		}
 	}
 
+	// Exempt addresses (e.g. protocol treasuries) skip unique sender and per-address limits
+	exempt := params.IsExemptAddress(senderAddr)
+
 	// Check unique sender limits (only for inflows with sender address)
-	if isInflow && senderAddr != "" {
+	if isInflow && senderAddr != "" && !exempt {
 		for _, limit := range config.UniqueSenderLimits {
 			if limit.MaxUniqueSenders == 0 {
 				continue // Limit disabled
@@ -204,23 +217,12 @@ func (k Keeper) CheckRateLimit(ctx sdk.Context, channelID string, denom string,
 			// Reset window if needed
 			k.ResetUniqueSendersWindow(ctx, channelID, limit.TimeframeType, limit.TimeframeDuration)
 
-			// Get current unique senders
-			senders, _ := k.GetUniqueSenders(ctx, channelID, limit.TimeframeType, limit.TimeframeDuration)
-
-			// Check if sender is already in the list
-			senderExists := false
-			for _, addr := range senders.Senders {
-				if addr == senderAddr {
-					senderExists = true
-					break
-				}
-			}
-
-			// If sender doesn't exist, check if adding them would exceed limit
-			if !senderExists {
-				if int64(len(senders.Senders)) >= limit.MaxUniqueSenders {
+			// If sender hasn't been counted yet, check if adding them would exceed limit
+			if !k.HasUniqueSender(ctx, channelID, senderAddr, limit.TimeframeType, limit.TimeframeDuration) {
+				uniqueSenders := k.GetUniqueSenderCount(ctx, channelID, limit.TimeframeType, limit.TimeframeDuration)
+				if uniqueSenders >= limit.MaxUniqueSenders {
 					return types.NewCustomErrorAcknowledgement(
-						fmt.Sprintf("rate limit exceeded: unique sender limit exceeded: channel=%s, denom=%s, sender=%s, current_unique_senders=%d, max_unique_senders=%d", channelID, denom, senderAddr, len(senders.Senders), limit.MaxUniqueSenders),
+						fmt.Sprintf("rate limit exceeded: unique sender limit exceeded: channel=%s, denom=%s, sender=%s, current_unique_senders=%d, max_unique_senders=%d", channelID, denom, senderAddr, uniqueSenders, limit.MaxUniqueSenders),
 					)
 				}
 			}
@@ -228,13 +230,17 @@ func (k Keeper) CheckRateLimit(ctx sdk.Context, channelID string, denom string,
 	}
 
 	// Check per-address limits (only if sender address is provided)
-	if senderAddr != "" {
+	if senderAddr != "" && !exempt {
 		for _, limit := range config.AddressLimits {
-			// Reset window if needed
-			k.ResetAddressTransferWindow(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
-
 			// Get current transfer data
-			data, _ := k.GetAddressTransferData(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+			var data types.AddressTransferData
+			if config.IsSlidingWindow() {
+				data = k.GetSlidingAddressTransferData(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+			} else {
+				// Reset window if needed
+				k.ResetAddressTransferWindow(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+				data, _ = k.GetAddressTransferData(ctx, senderAddr, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+			}
 
 			// Check transfer count limit
 			if limit.MaxTransfers > 0 {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
@@ -10,6 +11,9 @@ import (
 const (
 	// DefaultBlockTimeSeconds is the default block time in seconds (3 seconds for BitBadges chain)
 	DefaultBlockTimeSeconds int64 = 3
+
+	// MaxUniqueSenderPrunesPerCall is the maximum number of stale unique sender keys deleted per unique senders window check
+	MaxUniqueSenderPrunesPerCall = 100
 )
 
 // GetChannelFlowWithTimeframe gets the current flow state for a channel, denom, and timeframe
@@ -82,42 +86,100 @@ func (k Keeper) ResetChannelFlowWindowWithTimeframe(ctx sdk.Context, channelID,
 	}
 }
 
-// GetUniqueSenders gets the unique senders for a channel and timeframe
-func (k Keeper) GetUniqueSenders(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64) (types.UniqueSenders, bool) {
+// currentUniqueSendersWindowStart returns the start of the unique senders window that senders are currently counted under
+// Senders and counts are keyed by window start, so a window rollover does not need to touch the senders of the previous window
+func (k Keeper) currentUniqueSendersWindowStart(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64) int64 {
+	window, found := k.GetUniqueSendersWindow(ctx, channelID, timeframeType, timeframeDuration)
+	if !found {
+		return 0
+	}
+	return window.WindowStart
+}
+
+// HasUniqueSender returns true if the sender has already been counted in the current window for a channel and timeframe
+func (k Keeper) HasUniqueSender(ctx sdk.Context, channelID, senderAddr string, timeframeType types.TimeframeType, timeframeDuration int64) bool {
 	store := ctx.KVStore(k.storeKey)
-	key := types.UniqueSendersKey(channelID, int32(timeframeType), timeframeDuration)
-	bz := store.Get(key)
+	windowStart := k.currentUniqueSendersWindowStart(ctx, channelID, timeframeType, timeframeDuration)
+	return store.Has(types.UniqueSenderKey(channelID, int32(timeframeType), timeframeDuration, windowStart, senderAddr))
+}
+
+// GetUniqueSenderCount gets the number of unique senders in the current window for a channel and timeframe
+func (k Keeper) GetUniqueSenderCount(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64) int64 {
+	windowStart := k.currentUniqueSendersWindowStart(ctx, channelID, timeframeType, timeframeDuration)
+	return k.getUniqueSenderCount(ctx, channelID, timeframeType, timeframeDuration, windowStart)
+}
+
+// getUniqueSenderCount gets the number of unique senders for a channel, timeframe and window
+func (k Keeper) getUniqueSenderCount(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64, windowStart int64) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UniqueSenderCountKey(channelID, int32(timeframeType), timeframeDuration, windowStart))
 	if bz == nil {
-		return types.UniqueSenders{Senders: []string{}}, false
+		return 0
 	}
-
-	var senders types.UniqueSenders
-	k.cdc.MustUnmarshal(bz, &senders)
-	return senders, true
+	return int64(sdk.BigEndianToUint64(bz))
 }
 
-// SetUniqueSenders sets the unique senders for a channel and timeframe
-func (k Keeper) SetUniqueSenders(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64, senders types.UniqueSenders) {
+// setUniqueSenderCount sets the number of unique senders for a channel, timeframe and window
+func (k Keeper) setUniqueSenderCount(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64, windowStart int64, count int64) {
 	store := ctx.KVStore(k.storeKey)
-	key := types.UniqueSendersKey(channelID, int32(timeframeType), timeframeDuration)
-	bz := k.cdc.MustMarshal(&senders)
-	store.Set(key, bz)
+	store.Set(types.UniqueSenderCountKey(channelID, int32(timeframeType), timeframeDuration, windowStart), sdk.Uint64ToBigEndian(uint64(count)))
 }
 
-// AddUniqueSender adds a sender to the unique senders list if not already present
+// AddUniqueSender adds a sender to the unique senders of the current window for a channel and timeframe if not already present
+// Each sender has its own key, so this is a constant number of reads and writes regardless of how many senders are tracked
 func (k Keeper) AddUniqueSender(ctx sdk.Context, channelID, senderAddr string, timeframeType types.TimeframeType, timeframeDuration int64) {
-	senders, _ := k.GetUniqueSenders(ctx, channelID, timeframeType, timeframeDuration)
+	windowStart := k.currentUniqueSendersWindowStart(ctx, channelID, timeframeType, timeframeDuration)
+	key := types.UniqueSenderKey(channelID, int32(timeframeType), timeframeDuration, windowStart, senderAddr)
 
-	// Check if sender already exists
-	for _, addr := range senders.Senders {
-		if addr == senderAddr {
-			return // Already exists
-		}
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(key) {
+		return // Already exists
 	}
 
-	// Add new sender
-	senders.Senders = append(senders.Senders, senderAddr)
-	k.SetUniqueSenders(ctx, channelID, timeframeType, timeframeDuration, senders)
+	store.Set(key, []byte{1})
+	count := k.getUniqueSenderCount(ctx, channelID, timeframeType, timeframeDuration, windowStart)
+	k.setUniqueSenderCount(ctx, channelID, timeframeType, timeframeDuration, windowStart, count+1)
+}
+
+// GetUniqueSenders gets all unique senders in the current window for a channel and timeframe, ordered by address
+// This iterates over every tracked sender and is only meant for genesis export and queries, not the packet path
+func (k Keeper) GetUniqueSenders(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64) []string {
+	windowStart := k.currentUniqueSendersWindowStart(ctx, channelID, timeframeType, timeframeDuration)
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.UniqueSenderWindowPrefix(channelID, int32(timeframeType), timeframeDuration, windowStart)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	senders := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		senders = append(senders, string(iterator.Key()[len(prefix):]))
+	}
+	return senders
+}
+
+// PruneStaleUniqueSenders deletes up to limit sender keys of windows older than the current one for a channel and timeframe
+// Stale windows sort before the current window, so this only visits keys that are deleted and costs at most limit deletes
+// Returns the number of keys deleted
+func (k Keeper) PruneStaleUniqueSenders(ctx sdk.Context, channelID string, timeframeType types.TimeframeType, timeframeDuration int64, limit int) int {
+	windowStart := k.currentUniqueSendersWindowStart(ctx, channelID, timeframeType, timeframeDuration)
+
+	store := ctx.KVStore(k.storeKey)
+	start := types.UniqueSenderPrefix(channelID, int32(timeframeType), timeframeDuration)
+	end := types.UniqueSenderWindowPrefix(channelID, int32(timeframeType), timeframeDuration, windowStart)
+	iterator := store.Iterator(start, end)
+
+	// Collect keys first since the store cannot be written to while iterating
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
 }
 
 // GetUniqueSendersWindow gets the time window for unique sender tracking
@@ -157,10 +219,16 @@ func (k Keeper) ResetUniqueSendersWindow(ctx sdk.Context, channelID string, time
 			WindowStart:    currentHeight, // Always set to current block height (never future)
 			WindowDuration: windowDurationBlocks,
 		}
+		// Senders of the old window stay under the old window start and are no longer read, so the rollover itself is O(1)
+		if found && window.WindowStart != currentHeight {
+			store := ctx.KVStore(k.storeKey)
+			store.Delete(types.UniqueSenderCountKey(channelID, int32(timeframeType), timeframeDuration, window.WindowStart))
+		}
 		k.SetUniqueSendersWindow(ctx, channelID, timeframeType, timeframeDuration, newWindow)
-		// Reset unique senders when window expires
-		k.SetUniqueSenders(ctx, channelID, timeframeType, timeframeDuration, types.UniqueSenders{Senders: []string{}})
 	}
+
+	// Stale senders are deleted a bounded batch at a time on every call until none are left
+	k.PruneStaleUniqueSenders(ctx, channelID, timeframeType, timeframeDuration, MaxUniqueSenderPrunesPerCall)
 }
 
 // GetAddressTransferData gets the transfer data for an address, channel, denom, and timeframe
@@ -31,6 +31,7 @@ type KeeperTestSuite struct {
 
 	keeper     keeper.Keeper
 	bankKeeper *MockBankKeeper
+	storeKey   storetypes.StoreKey
 	ctx        sdk.Context
 }
 
@@ -97,6 +98,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	}
 	suite.keeper.SetParams(ctx, defaultParams)
 	suite.bankKeeper = bankKeeper
+	suite.storeKey = storeKey
 	suite.ctx = ctx
 }
 
@@ -128,6 +130,10 @@ func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress
 	return sdk.Coins{}
 }
 
+func (m *MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.Coin{Denom: denom, Amount: sdkmath.ZeroInt()}
+}
+
 func (m *MockBankKeeper) MintCoins(ctx sdk.Context, moduleName string, coins sdk.Coins) error {
 	for _, coin := range coins {
 		if existing, ok := m.supplies[coin.Denom]; ok {
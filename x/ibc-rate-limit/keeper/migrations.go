@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+// MigrateUniqueSenders moves unique sender lists stored under the deprecated KeyPrefixUniqueSenders
+// to one key per sender plus a count under the current unique senders window, and deletes the lists (v1 -> v2)
+func (k Keeper) MigrateUniqueSenders(ctx sdk.Context) error {
+	records := []types.UniqueSendersRecord{}
+	k.iterateTrackingKeys(ctx, types.KeyPrefixUniqueSenders, 0, 2, func(_ []string, channelID string, tail []int64, bz []byte) {
+		var senders types.UniqueSenders
+		k.cdc.MustUnmarshal(bz, &senders)
+		records = append(records, types.UniqueSendersRecord{
+			ChannelId: channelID, TimeframeType: types.TimeframeType(tail[0]), TimeframeDuration: tail[1], Senders: senders.Senders,
+		})
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixUniqueSenders)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, r := range records {
+		for _, sender := range r.Senders {
+			k.AddUniqueSender(ctx, r.ChannelId, sender, r.TimeframeType, r.TimeframeDuration)
+		}
+	}
+
+	return nil
+}
@@ -49,7 +49,9 @@ func (k msgServer) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRa
 	// Find if a rate limit with the same channel_id and denom exists
 	foundIndex := -1
 	for i, config := range params.RateLimits {
-		if config.ChannelId == req.RateLimit.ChannelId && config.Denom == req.RateLimit.Denom {
+		// Empty and "*" channel IDs both target all channels
+		sameChannel := config.ChannelId == req.RateLimit.ChannelId || (config.IsWildcardChannel() && req.RateLimit.IsWildcardChannel())
+		if sameChannel && config.Denom == req.RateLimit.Denom {
 			foundIndex = i
 			break
 		}
@@ -72,3 +74,34 @@ func (k msgServer) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRa
 	return &types.MsgUpdateRateLimitResponse{}, nil
 }
 
+// PauseChannel implements the MsgServer interface
+func (k msgServer) PauseChannel(goCtx context.Context, req *types.MsgPauseChannel) (*types.MsgPauseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.isGuardianOrAuthority(ctx, req.Signer) {
+		return nil, errorsmod.Wrapf(types.ErrNotGuardian, "invalid signer; got %s", req.Signer)
+	}
+
+	// A new pause replaces any existing pause on the channel
+	expiresAt := ctx.BlockHeight() + k.GetParams(ctx).EffectivePauseDuration()
+	k.SetChannelPause(ctx, types.ChannelPause{
+		ChannelId: req.ChannelId,
+		Inbound:   req.Inbound,
+		Outbound:  req.Outbound,
+		ExpiresAt: expiresAt,
+		PausedBy:  req.Signer,
+	})
+
+	return &types.MsgPauseChannelResponse{ExpiresAt: expiresAt}, nil
+}
+
+// UnpauseChannel implements the MsgServer interface
+func (k msgServer) UnpauseChannel(goCtx context.Context, req *types.MsgUnpauseChannel) (*types.MsgUnpauseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.isGuardianOrAuthority(ctx, req.Signer) {
+		return nil, errorsmod.Wrapf(types.ErrNotGuardian, "invalid signer; got %s", req.Signer)
+	}
+
+	k.DeleteChannelPause(ctx, req.ChannelId)
+
+	return &types.MsgUnpauseChannelResponse{}, nil
+}
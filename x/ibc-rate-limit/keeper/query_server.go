@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// Params implements the QueryServer interface
+func (k queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// RateLimits implements the QueryServer interface
+func (k queryServer) RateLimits(goCtx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	rateLimits := []types.RateLimitConfig{}
+
+	// With both filters set, only the config CheckRateLimit would apply is returned
+	if req.ChannelId != "" && req.Denom != "" {
+		if config := params.FindMatchingConfig(req.ChannelId, req.Denom); config != nil {
+			rateLimits = append(rateLimits, *config)
+		}
+		return &types.QueryRateLimitsResponse{RateLimits: rateLimits}, nil
+	}
+
+	// Otherwise return every config that can apply to the filtered channel or denom, wildcards included
+	for _, config := range params.RateLimits {
+		if req.ChannelId != "" && !config.IsWildcardChannel() && config.ChannelId != req.ChannelId {
+			continue
+		}
+		if req.Denom != "" && config.Denom != types.Wildcard && config.Denom != req.Denom {
+			continue
+		}
+		rateLimits = append(rateLimits, config)
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
+// RateLimitStatus implements the QueryServer interface
+func (k queryServer) RateLimitStatus(goCtx context.Context, req *types.QueryRateLimitStatusRequest) (*types.QueryRateLimitStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must be specified")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	config := params.FindMatchingConfig(req.ChannelId, req.Denom)
+	if config == nil {
+		return nil, status.Errorf(codes.NotFound, "no rate limit configured for channel %s and denom %s", req.ChannelId, req.Denom)
+	}
+
+	res := &types.QueryRateLimitStatusResponse{
+		RateLimit:          *config,
+		SupplyShiftLimits:  []types.SupplyShiftLimitStatus{},
+		UniqueSenderLimits: []types.UniqueSenderLimitStatus{},
+		ExemptAddresses:    params.ExemptAddresses,
+	}
+
+	// Disabled limits are skipped here just like in CheckRateLimit
+	for _, limit := range config.SupplyShiftLimits {
+		if !limit.IsEnabled() {
+			continue
+		}
+
+		var netFlow sdkmath.Int
+		var windowStart, windowEnd int64
+		if config.IsSlidingWindow() {
+			netFlow = k.GetSlidingChannelFlow(ctx, req.ChannelId, req.Denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets).NetFlow
+			windowStart, windowEnd = SlidingWindowBounds(ctx, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+		} else {
+			window, windowFound := k.GetChannelFlowWindowWithTimeframe(ctx, req.ChannelId, req.Denom, limit.TimeframeType, limit.TimeframeDuration)
+			var active bool
+			windowStart, windowEnd, active = currentWindow(ctx, window, windowFound, limit.TimeframeType, limit.TimeframeDuration)
+
+			netFlow = sdkmath.ZeroInt()
+			if active {
+				flow, _ := k.GetChannelFlowWithTimeframe(ctx, req.ChannelId, req.Denom, limit.TimeframeType, limit.TimeframeDuration)
+				netFlow = flow.NetFlow
+			}
+		}
+
+		// A transfer is rejected when |net_flow +/- amount| > max_amount
+		maxAmount, enforced := k.effectiveMaxAmount(ctx, req.ChannelId, req.Denom, limit, false)
+		res.SupplyShiftLimits = append(res.SupplyShiftLimits, types.SupplyShiftLimitStatus{
+			Limit:              limit,
+			NetFlow:            netFlow,
+			WindowStart:        windowStart,
+			WindowEnd:          windowEnd,
+			RemainingInflow:    sdkmath.MaxInt(maxAmount.Sub(netFlow), sdkmath.ZeroInt()),
+			RemainingOutflow:   sdkmath.MaxInt(maxAmount.Add(netFlow), sdkmath.ZeroInt()),
+			EffectiveMaxAmount: maxAmount,
+			Enforced:           enforced,
+		})
+	}
+
+	for _, limit := range config.UniqueSenderLimits {
+		if limit.MaxUniqueSenders == 0 {
+			continue
+		}
+
+		window, windowFound := k.GetUniqueSendersWindow(ctx, req.ChannelId, limit.TimeframeType, limit.TimeframeDuration)
+		windowStart, windowEnd, active := currentWindow(ctx, window, windowFound, limit.TimeframeType, limit.TimeframeDuration)
+
+		uniqueSenders := int64(0)
+		if active {
+			uniqueSenders = k.GetUniqueSenderCount(ctx, req.ChannelId, limit.TimeframeType, limit.TimeframeDuration)
+		}
+
+		res.UniqueSenderLimits = append(res.UniqueSenderLimits, types.UniqueSenderLimitStatus{
+			Limit:            limit,
+			UniqueSenders:    uniqueSenders,
+			WindowStart:      windowStart,
+			WindowEnd:        windowEnd,
+			RemainingSenders: max(limit.MaxUniqueSenders-uniqueSenders, 0),
+		})
+	}
+
+	return res, nil
+}
+
+// AddressUsage implements the QueryServer interface
+func (k queryServer) AddressUsage(goCtx context.Context, req *types.QueryAddressUsageRequest) (*types.QueryAddressUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address must be specified")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must be specified")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	config := params.FindMatchingConfig(req.ChannelId, req.Denom)
+	if config == nil {
+		return nil, status.Errorf(codes.NotFound, "no rate limit configured for channel %s and denom %s", req.ChannelId, req.Denom)
+	}
+
+	res := &types.QueryAddressUsageResponse{
+		RateLimit:     *config,
+		AddressLimits: []types.AddressLimitStatus{},
+		Exempt:        params.IsExemptAddress(req.Address),
+	}
+
+	// Exempt addresses are not subject to address limits, so there is no usage to report
+	if res.Exempt {
+		return res, nil
+	}
+
+	// Limits are only enforced for the dimensions that are set, like in CheckRateLimit
+	for _, limit := range config.AddressLimits {
+		transfersEnforced := limit.MaxTransfers > 0
+		amountEnforced := !limit.MaxAmount.IsZero()
+		if !transfersEnforced && !amountEnforced {
+			continue
+		}
+
+		var data types.AddressTransferData
+		var windowStart, windowEnd int64
+		if config.IsSlidingWindow() {
+			data = k.GetSlidingAddressTransferData(ctx, req.Address, req.ChannelId, req.Denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+			windowStart, windowEnd = SlidingWindowBounds(ctx, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+		} else {
+			window, windowFound := k.GetAddressTransferWindow(ctx, req.Address, req.ChannelId, req.Denom, limit.TimeframeType, limit.TimeframeDuration)
+			var active bool
+			windowStart, windowEnd, active = currentWindow(ctx, window, windowFound, limit.TimeframeType, limit.TimeframeDuration)
+
+			data = types.AddressTransferData{TotalAmount: sdkmath.ZeroInt()}
+			if active {
+				data, _ = k.GetAddressTransferData(ctx, req.Address, req.ChannelId, req.Denom, limit.TimeframeType, limit.TimeframeDuration)
+			}
+		}
+
+		res.AddressLimits = append(res.AddressLimits, types.AddressLimitStatus{
+			Limit:              limit,
+			TransferCount:      data.TransferCount,
+			TotalAmount:        data.TotalAmount,
+			WindowStart:        windowStart,
+			WindowEnd:          windowEnd,
+			RemainingTransfers: max(limit.MaxTransfers-data.TransferCount, 0),
+			RemainingAmount:    sdkmath.MaxInt(limit.MaxAmount.Sub(data.TotalAmount), sdkmath.ZeroInt()),
+			TransfersEnforced:  transfersEnforced,
+			AmountEnforced:     amountEnforced,
+		})
+	}
+
+	return res, nil
+}
+
+// currentWindow returns the bounds of the window that the next transfer will observe without writing to state.
+// If the stored window is missing or has expired, a fresh window starting at the current height is returned
+// and active is false, meaning any stored usage belongs to a previous window and should be treated as zero.
+func currentWindow(ctx sdk.Context, window types.ChannelFlowWindow, found bool, timeframeType types.TimeframeType, timeframeDuration int64) (start int64, end int64, active bool) {
+	windowDurationBlocks := types.TimeframeDurationInBlocks(timeframeType, timeframeDuration, DefaultBlockTimeSeconds)
+	currentHeight := ctx.BlockHeight()
+
+	// Same fixed window expiration check as ResetChannelFlowWindowWithTimeframe
+	if !found || currentHeight >= window.WindowStart+windowDurationBlocks {
+		return currentHeight, currentHeight + windowDurationBlocks, false
+	}
+
+	return window.WindowStart, window.WindowStart + windowDurationBlocks, true
+}
+
+// ChannelPause implements the QueryServer interface
+func (k queryServer) ChannelPause(goCtx context.Context, req *types.QueryChannelPauseRequest) (*types.QueryChannelPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pause, active := k.GetActiveChannelPause(ctx, req.ChannelId)
+
+	return &types.QueryChannelPauseResponse{Paused: active, Pause: pause}, nil
+}
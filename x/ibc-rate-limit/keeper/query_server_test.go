@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/keeper"
+	ratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	res, err := queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+
+	res, err = queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{ChannelId: "channel-0", Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+
+	res, err = queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{Denom: "uosmo"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RateLimits)
+
+	// Filters also match wildcard configs, like transfers do
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimits = append(params.RateLimits, wildcardTestConfig("*", "uosmo", 1000), wildcardTestConfig("channel-0", "*", 2000))
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	res, err = queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{Denom: "uosmo"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 2)
+
+	res, err = queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{ChannelId: "channel-5"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+	suite.Require().Equal("uosmo", res.RateLimits[0].Denom)
+
+	// With both filters, only the config that applies is returned
+	res, err = queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{ChannelId: "channel-0", Denom: "uosmo"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+	suite.Require().Equal("uosmo", res.RateLimits[0].Denom)
+
+	res, err = queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{ChannelId: "channel-0", Denom: "ujuno"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+	suite.Require().Equal(ratelimittypes.Wildcard, res.RateLimits[0].Denom)
+
+	res, err = queryServer.RateLimits(suite.ctx, &ratelimittypes.QueryRateLimitsRequest{ChannelId: "channel-5", Denom: "ujuno"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RateLimits)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimitStatus() {
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+	channelID := "channel-0"
+	denom := "uatom"
+	limit := suite.keeper.GetParams(suite.ctx).RateLimits[0].SupplyShiftLimits[0]
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+	suite.keeper.ResetChannelFlowWindowWithTimeframe(suite.ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+	suite.keeper.SetChannelFlowWithTimeframe(suite.ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, ratelimittypes.ChannelFlow{NetFlow: sdkmath.NewInt(100000)})
+
+	res, err := queryServer.RateLimitStatus(suite.ctx, &ratelimittypes.QueryRateLimitStatusRequest{ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.SupplyShiftLimits, 1)
+
+	status := res.SupplyShiftLimits[0]
+	suite.Require().Equal(sdkmath.NewInt(100000), status.NetFlow)
+	suite.Require().Equal(int64(100), status.WindowStart)
+	suite.Require().Equal(int64(1100), status.WindowEnd)
+	suite.Require().Equal(sdkmath.NewInt(200000), status.RemainingInflow)
+	suite.Require().Equal(sdkmath.NewInt(400000), status.RemainingOutflow)
+
+	// The remaining inflow matches what CheckRateLimit allows
+	ack := suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, status.RemainingInflow, true, "")
+	suite.Require().True(ack.Success())
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, status.RemainingInflow.AddRaw(1), true, "")
+	suite.Require().False(ack.Success())
+
+	// Once the window expires, the status reports a fresh window even before any transfer resets it
+	suite.ctx = suite.ctx.WithBlockHeight(1100)
+	res, err = queryServer.RateLimitStatus(suite.ctx, &ratelimittypes.QueryRateLimitStatusRequest{ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().True(res.SupplyShiftLimits[0].NetFlow.IsZero())
+	suite.Require().Equal(int64(1100), res.SupplyShiftLimits[0].WindowStart)
+	suite.Require().Equal(sdkmath.NewInt(300000), res.SupplyShiftLimits[0].RemainingInflow)
+
+	// Unconfigured channel/denom pairs are not found
+	_, err = queryServer.RateLimitStatus(suite.ctx, &ratelimittypes.QueryRateLimitStatusRequest{ChannelId: channelID, Denom: "uosmo"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryAddressUsage() {
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+	channelID := "channel-0"
+	denom := "uatom"
+	sender := "cosmos1sender"
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimits[0].AddressLimits = []ratelimittypes.AddressLimit{
+		{
+			MaxTransfers:      5,
+			MaxAmount:         sdkmath.NewInt(1000),
+			TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK,
+			TimeframeDuration: 100,
+		},
+	}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	limit := params.RateLimits[0].AddressLimits[0]
+	suite.keeper.ResetAddressTransferWindow(suite.ctx, sender, channelID, denom, limit.TimeframeType, limit.TimeframeDuration)
+	suite.keeper.SetAddressTransferData(suite.ctx, sender, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, ratelimittypes.AddressTransferData{
+		TransferCount: 2,
+		TotalAmount:   sdkmath.NewInt(400),
+	})
+
+	res, err := queryServer.AddressUsage(suite.ctx, &ratelimittypes.QueryAddressUsageRequest{Address: sender, ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.AddressLimits, 1)
+	suite.Require().Equal(int64(2), res.AddressLimits[0].TransferCount)
+	suite.Require().Equal(int64(3), res.AddressLimits[0].RemainingTransfers)
+	suite.Require().Equal(sdkmath.NewInt(600), res.AddressLimits[0].RemainingAmount)
+
+	// Other addresses have the full allowance
+	res, err = queryServer.AddressUsage(suite.ctx, &ratelimittypes.QueryAddressUsageRequest{Address: "cosmos1other", ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(5), res.AddressLimits[0].RemainingTransfers)
+	suite.Require().Equal(sdkmath.NewInt(1000), res.AddressLimits[0].RemainingAmount)
+	suite.Require().True(res.AddressLimits[0].TransfersEnforced)
+	suite.Require().True(res.AddressLimits[0].AmountEnforced)
+
+	// Disabled dimensions are flagged as not enforced
+	params.RateLimits[0].AddressLimits[0].MaxAmount = sdkmath.ZeroInt()
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	res, err = queryServer.AddressUsage(suite.ctx, &ratelimittypes.QueryAddressUsageRequest{Address: sender, ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.AddressLimits, 1)
+	suite.Require().True(res.AddressLimits[0].TransfersEnforced)
+	suite.Require().False(res.AddressLimits[0].AmountEnforced)
+	suite.Require().Equal(int64(3), res.AddressLimits[0].RemainingTransfers)
+	suite.Require().False(res.Exempt)
+
+	// Exempt addresses have no address limit usage to report
+	params.ExemptAddresses = []string{sender}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	res, err = queryServer.AddressUsage(suite.ctx, &ratelimittypes.QueryAddressUsageRequest{Address: sender, ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Exempt)
+	suite.Require().Empty(res.AddressLimits)
+
+	statusRes, err := queryServer.RateLimitStatus(suite.ctx, &ratelimittypes.QueryRateLimitStatusRequest{ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{sender}, statusRes.ExemptAddresses)
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+// Sliding windows split each timeframe into numBuckets sub-buckets of bucketSize blocks.
+// A bucket is identified by its index (blockHeight / bucketSize), and the window at any height
+// covers the current bucket plus the numBuckets-1 buckets before it. Buckets that slide out of
+// the window are pruned on write and ignored on read, so usage decays one bucket at a time
+// instead of resetting wholesale at the end of the timeframe.
+
+// GetChannelFlowBuckets gets the sliding window buckets for a channel, denom, and timeframe
+func (k Keeper) GetChannelFlowBuckets(ctx sdk.Context, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64) (types.WindowBuckets, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ChannelFlowBucketsKey(channelID, denom, int32(timeframeType), timeframeDuration)
+	bz := store.Get(key)
+	if bz == nil {
+		return types.WindowBuckets{Buckets: []types.WindowBucket{}}, false
+	}
+
+	var buckets types.WindowBuckets
+	k.cdc.MustUnmarshal(bz, &buckets)
+	return buckets, true
+}
+
+// SetChannelFlowBuckets sets the sliding window buckets for a channel, denom, and timeframe
+func (k Keeper) SetChannelFlowBuckets(ctx sdk.Context, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64, buckets types.WindowBuckets) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ChannelFlowBucketsKey(channelID, denom, int32(timeframeType), timeframeDuration)
+	bz := k.cdc.MustMarshal(&buckets)
+	store.Set(key, bz)
+}
+
+// GetAddressTransferBuckets gets the sliding window buckets for an address, channel, denom, and timeframe
+func (k Keeper) GetAddressTransferBuckets(ctx sdk.Context, address, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64) (types.WindowBuckets, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AddressTransferBucketsKey(address, channelID, denom, int32(timeframeType), timeframeDuration)
+	bz := store.Get(key)
+	if bz == nil {
+		return types.WindowBuckets{Buckets: []types.WindowBucket{}}, false
+	}
+
+	var buckets types.WindowBuckets
+	k.cdc.MustUnmarshal(bz, &buckets)
+	return buckets, true
+}
+
+// SetAddressTransferBuckets sets the sliding window buckets for an address, channel, denom, and timeframe
+func (k Keeper) SetAddressTransferBuckets(ctx sdk.Context, address, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64, buckets types.WindowBuckets) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AddressTransferBucketsKey(address, channelID, denom, int32(timeframeType), timeframeDuration)
+	bz := k.cdc.MustMarshal(&buckets)
+	store.Set(key, bz)
+}
+
+// GetSlidingChannelFlow returns the net flow summed over the buckets still inside the sliding window
+func (k Keeper) GetSlidingChannelFlow(ctx sdk.Context, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64, numBuckets int64) types.ChannelFlow {
+	buckets, _ := k.GetChannelFlowBuckets(ctx, channelID, denom, timeframeType, timeframeDuration)
+	currentIndex, _ := slidingWindowBucket(ctx, timeframeType, timeframeDuration, numBuckets)
+
+	amount, _ := sumWindowBuckets(pruneWindowBuckets(buckets.Buckets, currentIndex, numBuckets))
+	return types.ChannelFlow{NetFlow: amount}
+}
+
+// AddSlidingChannelFlow adds a signed amount to the current bucket of the sliding window
+// positive amount = inflow, negative amount = outflow
+func (k Keeper) AddSlidingChannelFlow(ctx sdk.Context, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64, numBuckets int64, amount sdkmath.Int) {
+	buckets, _ := k.GetChannelFlowBuckets(ctx, channelID, denom, timeframeType, timeframeDuration)
+	currentIndex, _ := slidingWindowBucket(ctx, timeframeType, timeframeDuration, numBuckets)
+
+	buckets.Buckets = addToCurrentBucket(pruneWindowBuckets(buckets.Buckets, currentIndex, numBuckets), currentIndex, 0, amount)
+	k.SetChannelFlowBuckets(ctx, channelID, denom, timeframeType, timeframeDuration, buckets)
+}
+
+// GetSlidingAddressTransferData returns the transfer data summed over the buckets still inside the sliding window
+func (k Keeper) GetSlidingAddressTransferData(ctx sdk.Context, address, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64, numBuckets int64) types.AddressTransferData {
+	buckets, _ := k.GetAddressTransferBuckets(ctx, address, channelID, denom, timeframeType, timeframeDuration)
+	currentIndex, _ := slidingWindowBucket(ctx, timeframeType, timeframeDuration, numBuckets)
+
+	amount, count := sumWindowBuckets(pruneWindowBuckets(buckets.Buckets, currentIndex, numBuckets))
+	return types.AddressTransferData{
+		TransferCount: count,
+		TotalAmount:   amount,
+	}
+}
+
+// AddSlidingAddressTransfer records a transfer of the given (absolute) amount in the current bucket of the sliding window
+func (k Keeper) AddSlidingAddressTransfer(ctx sdk.Context, address, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64, numBuckets int64, amount sdkmath.Int) {
+	buckets, _ := k.GetAddressTransferBuckets(ctx, address, channelID, denom, timeframeType, timeframeDuration)
+	currentIndex, _ := slidingWindowBucket(ctx, timeframeType, timeframeDuration, numBuckets)
+
+	buckets.Buckets = addToCurrentBucket(pruneWindowBuckets(buckets.Buckets, currentIndex, numBuckets), currentIndex, 1, amount)
+	k.SetAddressTransferBuckets(ctx, address, channelID, denom, timeframeType, timeframeDuration, buckets)
+}
+
+// RefundSlidingAddressTransfer removes a transfer of the given (absolute) amount from the sliding window
+// The refund is taken from the newest buckets first and never drives a bucket below zero, mirroring the
+// clamping done for fixed windows in the refund path
+func (k Keeper) RefundSlidingAddressTransfer(ctx sdk.Context, address, channelID, denom string, timeframeType types.TimeframeType, timeframeDuration int64, numBuckets int64, amount sdkmath.Int) {
+	buckets, found := k.GetAddressTransferBuckets(ctx, address, channelID, denom, timeframeType, timeframeDuration)
+	if !found {
+		return
+	}
+	currentIndex, _ := slidingWindowBucket(ctx, timeframeType, timeframeDuration, numBuckets)
+	buckets.Buckets = pruneWindowBuckets(buckets.Buckets, currentIndex, numBuckets)
+
+	countRemaining := int64(1)
+	amountRemaining := amount
+	for i := len(buckets.Buckets) - 1; i >= 0; i-- {
+		bucket := &buckets.Buckets[i]
+		if countRemaining > 0 && bucket.Count > 0 {
+			bucket.Count--
+			countRemaining--
+		}
+		if amountRemaining.IsPositive() && bucket.Amount.IsPositive() {
+			taken := sdkmath.MinInt(bucket.Amount, amountRemaining)
+			bucket.Amount = bucket.Amount.Sub(taken)
+			amountRemaining = amountRemaining.Sub(taken)
+		}
+		if countRemaining == 0 && !amountRemaining.IsPositive() {
+			break
+		}
+	}
+
+	k.SetAddressTransferBuckets(ctx, address, channelID, denom, timeframeType, timeframeDuration, buckets)
+}
+
+// SlidingWindowBounds returns the block heights spanned by the sliding window at the current height
+// end is the height at which the oldest bucket slides out of the window
+func SlidingWindowBounds(ctx sdk.Context, timeframeType types.TimeframeType, timeframeDuration int64, numBuckets int64) (start int64, end int64) {
+	currentIndex, bucketSize := slidingWindowBucket(ctx, timeframeType, timeframeDuration, numBuckets)
+
+	start = (currentIndex - numBuckets + 1) * bucketSize
+	if start < 0 {
+		start = 0
+	}
+	return start, (currentIndex + 1) * bucketSize
+}
+
+// slidingWindowBucket returns the index of the bucket containing the current block and the bucket size in blocks
+func slidingWindowBucket(ctx sdk.Context, timeframeType types.TimeframeType, timeframeDuration int64, numBuckets int64) (currentIndex int64, bucketSize int64) {
+	windowDurationBlocks := types.TimeframeDurationInBlocks(timeframeType, timeframeDuration, DefaultBlockTimeSeconds)
+	bucketSize = types.WindowBucketSize(windowDurationBlocks, numBuckets)
+	return ctx.BlockHeight() / bucketSize, bucketSize
+}
+
+// pruneWindowBuckets drops buckets that have slid out of the window ending at currentIndex
+func pruneWindowBuckets(buckets []types.WindowBucket, currentIndex int64, numBuckets int64) []types.WindowBucket {
+	oldestIndex := currentIndex - numBuckets + 1
+
+	kept := make([]types.WindowBucket, 0, len(buckets)+1)
+	for _, bucket := range buckets {
+		if bucket.BucketIndex >= oldestIndex {
+			kept = append(kept, bucket)
+		}
+	}
+	return kept
+}
+
+// addToCurrentBucket adds count and amount to the bucket at currentIndex, creating it if needed
+// Buckets are kept ordered by index, so the current bucket is always the last one
+func addToCurrentBucket(buckets []types.WindowBucket, currentIndex int64, count int64, amount sdkmath.Int) []types.WindowBucket {
+	if n := len(buckets); n > 0 && buckets[n-1].BucketIndex == currentIndex {
+		buckets[n-1].Count += count
+		buckets[n-1].Amount = buckets[n-1].Amount.Add(amount)
+		return buckets
+	}
+
+	return append(buckets, types.WindowBucket{
+		BucketIndex: currentIndex,
+		Amount:      amount,
+		Count:       count,
+	})
+}
+
+// sumWindowBuckets returns the total amount and count across buckets
+func sumWindowBuckets(buckets []types.WindowBucket) (sdkmath.Int, int64) {
+	amount := sdkmath.ZeroInt()
+	count := int64(0)
+	for _, bucket := range buckets {
+		amount = amount.Add(bucket.Amount)
+		count += bucket.Count
+	}
+	return amount, count
+}
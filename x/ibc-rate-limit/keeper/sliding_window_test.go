@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	ratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+// setSlidingWindowParams switches the default test config (300000 per 1000 blocks) to a sliding window with 10 buckets of 100 blocks
+func (suite *KeeperTestSuite) setSlidingWindowParams(addressLimits []ratelimittypes.AddressLimit) ratelimittypes.RateLimitConfig {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimits[0].WindowMode = ratelimittypes.WindowMode_WINDOW_MODE_SLIDING
+	params.RateLimits[0].WindowBuckets = 10
+	params.RateLimits[0].AddressLimits = addressLimits
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	return params.RateLimits[0]
+}
+
+func (suite *KeeperTestSuite) TestCheckRateLimit_SlidingWindowBoundary() {
+	channelID := "channel-0"
+	denom := "uatom"
+	config := suite.setSlidingWindowParams(nil)
+	limit := config.SupplyShiftLimits[0]
+
+	// Use up the full limit right before where a fixed window would reset
+	suite.ctx = suite.ctx.WithBlockHeight(950)
+	ack := suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(300000), true, "")
+	suite.Require().True(ack.Success())
+	suite.keeper.AddSlidingChannelFlow(suite.ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, sdkmath.NewInt(300000))
+
+	// Crossing the boundary does not free up capacity while the bucket is still in the window
+	suite.ctx = suite.ctx.WithBlockHeight(1000)
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(1), true, "")
+	suite.Require().False(ack.Success(), "sliding window should not allow 2x the limit across a boundary")
+
+	// Outflows net against the inflow
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(600000), false, "")
+	suite.Require().True(ack.Success())
+
+	// Once the bucket (blocks 900-999) slides out of the window the capacity is available again
+	suite.ctx = suite.ctx.WithBlockHeight(1899)
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(1), true, "")
+	suite.Require().False(ack.Success())
+
+	suite.ctx = suite.ctx.WithBlockHeight(1900)
+	flow := suite.keeper.GetSlidingChannelFlow(suite.ctx, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+	suite.Require().True(flow.NetFlow.IsZero())
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(300000), true, "")
+	suite.Require().True(ack.Success())
+}
+
+func (suite *KeeperTestSuite) TestCheckRateLimit_SlidingWindowAddressLimits() {
+	channelID := "channel-0"
+	denom := "uatom"
+	sender := "cosmos1sender"
+	config := suite.setSlidingWindowParams([]ratelimittypes.AddressLimit{
+		{
+			MaxTransfers:      2,
+			MaxAmount:         sdkmath.NewInt(1000),
+			TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK,
+			TimeframeDuration: 1000,
+		},
+	})
+	limit := config.AddressLimits[0]
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+	suite.keeper.AddSlidingAddressTransfer(suite.ctx, sender, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, sdkmath.NewInt(400))
+	suite.ctx = suite.ctx.WithBlockHeight(500)
+	suite.keeper.AddSlidingAddressTransfer(suite.ctx, sender, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, sdkmath.NewInt(400))
+
+	data := suite.keeper.GetSlidingAddressTransferData(suite.ctx, sender, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+	suite.Require().Equal(int64(2), data.TransferCount)
+	suite.Require().Equal(sdkmath.NewInt(800), data.TotalAmount)
+
+	ack := suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(1), true, sender)
+	suite.Require().False(ack.Success(), "transfer count limit should be enforced")
+
+	// Refunding a transfer frees up one transfer and its amount
+	suite.keeper.RefundSlidingAddressTransfer(suite.ctx, sender, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets, sdkmath.NewInt(400))
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(600), true, sender)
+	suite.Require().True(ack.Success())
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(601), true, sender)
+	suite.Require().False(ack.Success(), "amount limit should be enforced")
+
+	// The first transfer slides out of the window after 1000 blocks
+	suite.ctx = suite.ctx.WithBlockHeight(1100)
+	data = suite.keeper.GetSlidingAddressTransferData(suite.ctx, sender, channelID, denom, limit.TimeframeType, limit.TimeframeDuration, config.WindowBuckets)
+	suite.Require().Equal(int64(0), data.TransferCount)
+	suite.Require().True(data.TotalAmount.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestValidateParams_WindowMode() {
+	config := ratelimittypes.RateLimitConfig{
+		ChannelId:     "channel-0",
+		Denom:         "uatom",
+		WindowBuckets: 10,
+	}
+	suite.Require().Error(config.Validate(), "window_buckets requires sliding mode")
+
+	config.WindowMode = ratelimittypes.WindowMode_WINDOW_MODE_SLIDING
+	suite.Require().NoError(config.Validate())
+
+	config.WindowBuckets = 1
+	suite.Require().Error(config.Validate())
+
+	config.WindowBuckets = ratelimittypes.MaxWindowBuckets + 1
+	suite.Require().Error(config.Validate())
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+// GetSupplySnapshot gets the supply snapshot for a channel, denom, supply basis, and timeframe
+func (k Keeper) GetSupplySnapshot(ctx sdk.Context, channelID, denom string, supplyBasis types.SupplyBasis, timeframeType types.TimeframeType, timeframeDuration int64) (types.SupplySnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SupplySnapshotKey(channelID, denom, int32(supplyBasis), int32(timeframeType), timeframeDuration)
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SupplySnapshot{Amount: sdkmath.ZeroInt()}, false
+	}
+
+	var snapshot types.SupplySnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetSupplySnapshot sets the supply snapshot for a channel, denom, supply basis, and timeframe
+func (k Keeper) SetSupplySnapshot(ctx sdk.Context, channelID, denom string, supplyBasis types.SupplyBasis, timeframeType types.TimeframeType, timeframeDuration int64, snapshot types.SupplySnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SupplySnapshotKey(channelID, denom, int32(supplyBasis), int32(timeframeType), timeframeDuration)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(key, bz)
+}
+
+// GetSupplyBasisAmount returns the current amount that a percentage-based limit is measured against
+// For CHANNEL_ESCROW this is the balance of the transfer escrow account for the channel
+func (k Keeper) GetSupplyBasisAmount(ctx sdk.Context, channelID, denom string, supplyBasis types.SupplyBasis) sdkmath.Int {
+	switch supplyBasis {
+	case types.SupplyBasis_SUPPLY_BASIS_TOTAL_SUPPLY:
+		return k.bankKeeper.GetSupply(ctx, denom).Amount
+	case types.SupplyBasis_SUPPLY_BASIS_CHANNEL_ESCROW:
+		escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, channelID)
+		return k.bankKeeper.GetBalance(ctx, escrowAddress, denom).Amount
+	default:
+		return sdkmath.ZeroInt()
+	}
+}
+
+// GetEffectiveMaxAmount returns the maximum absolute supply shift allowed by a limit in the current window,
+// and whether the limit is enforced at all
+// For percentage-based limits, the supply basis is snapshotted the first time it is needed in each window
+// (same fixed window cadence as ResetChannelFlowWindowWithTimeframe) so that the limit cannot be moved by
+// transfers within the window itself
+// A supply basis of 0 (e.g. a new voucher before its first receive) is never snapshotted, so that a denom can
+// bootstrap: until the basis becomes non-zero, max_amount is used instead, or the limit is not enforced if it is 0
+func (k Keeper) GetEffectiveMaxAmount(ctx sdk.Context, channelID, denom string, limit types.TimeframeLimit) (sdkmath.Int, bool) {
+	return k.effectiveMaxAmount(ctx, channelID, denom, limit, true)
+}
+
+// effectiveMaxAmount computes the effective max amount of a limit
+// If persist is false, an expired snapshot is recomputed without being written to state (used by queries)
+func (k Keeper) effectiveMaxAmount(ctx sdk.Context, channelID, denom string, limit types.TimeframeLimit, persist bool) (sdkmath.Int, bool) {
+	if !limit.IsPercentage() {
+		return limit.MaxAmount, true
+	}
+
+	windowDurationBlocks := types.TimeframeDurationInBlocks(limit.TimeframeType, limit.TimeframeDuration, DefaultBlockTimeSeconds)
+	currentHeight := ctx.BlockHeight()
+
+	snapshot, found := k.GetSupplySnapshot(ctx, channelID, denom, limit.SupplyBasis, limit.TimeframeType, limit.TimeframeDuration)
+	if !found || currentHeight >= snapshot.SnapshotHeight+windowDurationBlocks {
+		snapshot = types.SupplySnapshot{
+			Amount:         k.GetSupplyBasisAmount(ctx, channelID, denom, limit.SupplyBasis),
+			SnapshotHeight: currentHeight,
+		}
+		if snapshot.Amount.IsZero() {
+			return limit.MaxAmount, !limit.MaxAmount.IsZero()
+		}
+		if persist {
+			k.SetSupplySnapshot(ctx, channelID, denom, limit.SupplyBasis, limit.TimeframeType, limit.TimeframeDuration, snapshot)
+		}
+	}
+
+	return snapshot.Amount.Mul(sdkmath.NewIntFromUint64(limit.MaxPercentBps)).QuoRaw(int64(types.MaxPercentBps)), true
+}
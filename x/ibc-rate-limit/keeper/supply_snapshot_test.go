@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+func (suite *KeeperTestSuite) TestCheckRateLimit_PercentOfSupply() {
+	channelID := "channel-0"
+	denom := "uatom"
+
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, "mint", sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1_000_000)))))
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimits[0].SupplyShiftLimits = []ratelimittypes.TimeframeLimit{
+		{
+			MaxAmount:         sdkmath.ZeroInt(),
+			MaxPercentBps:     1000, // 10%
+			SupplyBasis:       ratelimittypes.SupplyBasis_SUPPLY_BASIS_TOTAL_SUPPLY,
+			TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK,
+			TimeframeDuration: 100,
+		},
+	}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	ack := suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(100_000), true, "")
+	suite.Require().True(ack.Success())
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(100_001), true, "")
+	suite.Require().False(ack.Success())
+
+	// Supply changes within the window do not move the limit
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, "mint", sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1_000_000)))))
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(100_001), true, "")
+	suite.Require().False(ack.Success(), "limit should be based on the snapshot taken at window start")
+
+	// The next window snapshots the new supply
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100)
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(200_000), true, "")
+	suite.Require().True(ack.Success())
+
+	snapshot, found := suite.keeper.GetSupplySnapshot(suite.ctx, channelID, denom, ratelimittypes.SupplyBasis_SUPPLY_BASIS_TOTAL_SUPPLY, ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK, 100)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(2_000_000), snapshot.Amount)
+	suite.Require().Equal(suite.ctx.BlockHeight(), snapshot.SnapshotHeight)
+}
+
+func (suite *KeeperTestSuite) TestCheckRateLimit_PercentOfEmptyEscrow() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimits[0].SupplyShiftLimits = []ratelimittypes.TimeframeLimit{
+		{
+			MaxAmount:         sdkmath.ZeroInt(),
+			MaxPercentBps:     5000,
+			SupplyBasis:       ratelimittypes.SupplyBasis_SUPPLY_BASIS_CHANNEL_ESCROW,
+			TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK,
+			TimeframeDuration: 100,
+		},
+	}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// Nothing is escrowed and there is no max_amount fallback, so the limit is not enforced
+	ack := suite.keeper.CheckRateLimit(suite.ctx, "channel-0", "uatom", sdkmath.NewInt(1_000_000), false, "")
+	suite.Require().True(ack.Success())
+
+	// The zero basis is not snapshotted, so the window picks up the first non-zero basis
+	_, found := suite.keeper.GetSupplySnapshot(suite.ctx, "channel-0", "uatom", ratelimittypes.SupplyBasis_SUPPLY_BASIS_CHANNEL_ESCROW, ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK, 100)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestCheckRateLimit_PercentOfZeroSupplyFallsBackToMaxAmount() {
+	channelID := "channel-0"
+	denom := "ibc/voucher"
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimits[0].Denom = denom
+	params.RateLimits[0].SupplyShiftLimits = []ratelimittypes.TimeframeLimit{
+		{
+			MaxAmount:         sdkmath.NewInt(1_000),
+			MaxPercentBps:     1000, // 10%
+			SupplyBasis:       ratelimittypes.SupplyBasis_SUPPLY_BASIS_TOTAL_SUPPLY,
+			TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK,
+			TimeframeDuration: 100,
+		},
+	}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// The voucher has no supply before its first receive, so max_amount applies
+	ack := suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(1_001), true, "")
+	suite.Require().False(ack.Success())
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(1_000), true, "")
+	suite.Require().True(ack.Success())
+
+	// Once the voucher has supply, the percentage applies for the rest of the window
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, "mint", sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100_000)))))
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(10_000), true, "")
+	suite.Require().True(ack.Success())
+	ack = suite.keeper.CheckRateLimit(suite.ctx, channelID, denom, sdkmath.NewInt(10_001), true, "")
+	suite.Require().False(ack.Success())
+
+	snapshot, found := suite.keeper.GetSupplySnapshot(suite.ctx, channelID, denom, ratelimittypes.SupplyBasis_SUPPLY_BASIS_TOTAL_SUPPLY, ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK, 100)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100_000), snapshot.Amount)
+}
+
+func (suite *KeeperTestSuite) TestValidateParams_PercentLimits() {
+	limit := ratelimittypes.TimeframeLimit{
+		MaxAmount:         sdkmath.ZeroInt(),
+		MaxPercentBps:     1000,
+		TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_DAY,
+		TimeframeDuration: 1,
+	}
+	suite.Require().Error(limit.Validate(), "supply_basis is required")
+
+	limit.SupplyBasis = ratelimittypes.SupplyBasis_SUPPLY_BASIS_TOTAL_SUPPLY
+	suite.Require().NoError(limit.Validate())
+
+	limit.MaxAmount = sdkmath.NewInt(1)
+	suite.Require().NoError(limit.Validate(), "max_amount is the fallback for a zero supply basis")
+
+	limit.MaxAmount = sdkmath.ZeroInt()
+	limit.MaxPercentBps = ratelimittypes.MaxPercentBps + 1
+	suite.Require().Error(limit.Validate())
+
+	limit.MaxPercentBps = 0
+	suite.Require().Error(limit.Validate(), "supply_basis requires max_percent_bps")
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/keeper"
+	ratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+func (suite *KeeperTestSuite) TestUniqueSenders_CountAndReset() {
+	channelID := "channel-0"
+	blockType := ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK
+
+	suite.keeper.AddUniqueSender(suite.ctx, channelID, "cosmos1a", blockType, 100)
+	suite.keeper.AddUniqueSender(suite.ctx, channelID, "cosmos1b", blockType, 100)
+	suite.keeper.AddUniqueSender(suite.ctx, channelID, "cosmos1a", blockType, 100)
+	suite.keeper.AddUniqueSender(suite.ctx, channelID, "cosmos1a", blockType, 1000)
+
+	suite.Require().Equal(int64(2), suite.keeper.GetUniqueSenderCount(suite.ctx, channelID, blockType, 100))
+	suite.Require().Equal([]string{"cosmos1a", "cosmos1b"}, suite.keeper.GetUniqueSenders(suite.ctx, channelID, blockType, 100))
+
+	// Rolling over one timeframe does not touch a timeframe whose duration shares a prefix
+	suite.keeper.SetUniqueSendersWindow(suite.ctx, channelID, blockType, 1000, ratelimittypes.ChannelFlowWindow{WindowStart: 0, WindowDuration: 100_000})
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100)
+	suite.keeper.ResetUniqueSendersWindow(ctx, channelID, blockType, 100)
+	suite.Require().Equal(int64(0), suite.keeper.GetUniqueSenderCount(ctx, channelID, blockType, 100))
+	suite.Require().False(suite.keeper.HasUniqueSender(ctx, channelID, "cosmos1a", blockType, 100))
+	suite.Require().Empty(suite.keeper.GetUniqueSenders(ctx, channelID, blockType, 100))
+	suite.Require().Equal(int64(1), suite.keeper.GetUniqueSenderCount(ctx, channelID, blockType, 1000))
+	suite.Require().True(suite.keeper.HasUniqueSender(ctx, channelID, "cosmos1a", blockType, 1000))
+}
+
+func (suite *KeeperTestSuite) TestUniqueSenders_RolloverPrunesInBatches() {
+	channelID := "channel-0"
+	blockType := ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK
+	numSenders := 2*keeper.MaxUniqueSenderPrunesPerCall + 50
+
+	suite.keeper.ResetUniqueSendersWindow(suite.ctx, channelID, blockType, 10)
+	for i := 0; i < numSenders; i++ {
+		suite.keeper.AddUniqueSender(suite.ctx, channelID, fmt.Sprintf("cosmos1sender%04d", i), blockType, 10)
+	}
+	suite.Require().Equal(int64(numSenders), suite.keeper.GetUniqueSenderCount(suite.ctx, channelID, blockType, 10))
+
+	countStaleKeys := func(ctx sdk.Context) int {
+		store := ctx.KVStore(suite.storeKey)
+		iterator := storetypes.KVStorePrefixIterator(store, ratelimittypes.UniqueSenderPrefix(channelID, int32(blockType), 10))
+		defer iterator.Close()
+		n := 0
+		for ; iterator.Valid(); iterator.Next() {
+			n++
+		}
+		return n
+	}
+
+	// The rollover starts a fresh window and deletes at most one batch of the old senders
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10)
+	suite.keeper.ResetUniqueSendersWindow(ctx, channelID, blockType, 10)
+	suite.Require().Equal(int64(0), suite.keeper.GetUniqueSenderCount(ctx, channelID, blockType, 10))
+	suite.Require().False(suite.keeper.HasUniqueSender(ctx, channelID, "cosmos1sender0000", blockType, 10))
+	suite.Require().Equal(numSenders-keeper.MaxUniqueSenderPrunesPerCall, countStaleKeys(ctx))
+
+	// Senders of the new window are never pruned
+	suite.keeper.AddUniqueSender(ctx, channelID, "cosmos1sender0000", blockType, 10)
+	for i := 0; i < 5; i++ {
+		suite.keeper.ResetUniqueSendersWindow(ctx, channelID, blockType, 10)
+	}
+	suite.Require().Equal(1, countStaleKeys(ctx))
+	suite.Require().Equal(int64(1), suite.keeper.GetUniqueSenderCount(ctx, channelID, blockType, 10))
+	suite.Require().True(suite.keeper.HasUniqueSender(ctx, channelID, "cosmos1sender0000", blockType, 10))
+}
+
+func (suite *KeeperTestSuite) TestUniqueSenders_ConstantGas() {
+	channelID := "channel-0"
+	blockType := ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK
+
+	gasForAdd := func(sender string) storetypes.Gas {
+		ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		suite.keeper.AddUniqueSender(ctx, channelID, sender, blockType, 1000)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// The first sender creates the count, so measure from the second
+	suite.keeper.AddUniqueSender(suite.ctx, channelID, "cosmos1sender0000", blockType, 1000)
+	first := gasForAdd("cosmos1sender0001")
+	for i := 2; i < 500; i++ {
+		suite.keeper.AddUniqueSender(suite.ctx, channelID, fmt.Sprintf("cosmos1sender%04d", i), blockType, 1000)
+	}
+	last := gasForAdd("cosmos1sender0500")
+
+	suite.Require().Equal(first, last, "adding a sender should not get more expensive as more senders are tracked")
+	suite.Require().Equal(int64(501), suite.keeper.GetUniqueSenderCount(suite.ctx, channelID, blockType, 1000))
+}
+
+func (suite *KeeperTestSuite) TestMigrateUniqueSenders() {
+	blockType := ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK
+
+	// Write a list in the v1 layout
+	store := suite.ctx.KVStore(suite.storeKey)
+	legacy := ratelimittypes.UniqueSenders{Senders: []string{"cosmos1b", "cosmos1a"}}
+	bz, err := legacy.Marshal()
+	suite.Require().NoError(err)
+	store.Set(ratelimittypes.UniqueSendersKey("channel-0", int32(blockType), 1000), bz)
+
+	suite.Require().NoError(suite.keeper.MigrateUniqueSenders(suite.ctx))
+
+	suite.Require().Nil(store.Get(ratelimittypes.UniqueSendersKey("channel-0", int32(blockType), 1000)))
+	suite.Require().Equal(int64(2), suite.keeper.GetUniqueSenderCount(suite.ctx, "channel-0", blockType, 1000))
+	suite.Require().Equal([]string{"cosmos1a", "cosmos1b"}, suite.keeper.GetUniqueSenders(suite.ctx, "channel-0", blockType, 1000))
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	ratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
+)
+
+func wildcardTestConfig(channelID, denom string, maxAmount int64) ratelimittypes.RateLimitConfig {
+	return ratelimittypes.RateLimitConfig{
+		ChannelId: channelID,
+		Denom:     denom,
+		SupplyShiftLimits: []ratelimittypes.TimeframeLimit{
+			{
+				MaxAmount:         sdkmath.NewInt(maxAmount),
+				TimeframeType:     ratelimittypes.TimeframeType_TIMEFRAME_TYPE_BLOCK,
+				TimeframeDuration: 1000,
+			},
+		},
+	}
+}
+
+func (suite *KeeperTestSuite) TestFindMatchingConfig_MostSpecificWins() {
+	params := ratelimittypes.Params{
+		RateLimits: []ratelimittypes.RateLimitConfig{
+			wildcardTestConfig("*", "*", 1),
+			wildcardTestConfig("channel-0", "*", 2),
+			wildcardTestConfig("*", "uatom", 3),
+			wildcardTestConfig("channel-0", "uatom", 4),
+		},
+	}
+	suite.Require().NoError(params.Validate())
+
+	suite.Require().Equal("4", params.FindMatchingConfig("channel-0", "uatom").SupplyShiftLimits[0].MaxAmount.String())
+	suite.Require().Equal("3", params.FindMatchingConfig("channel-5", "uatom").SupplyShiftLimits[0].MaxAmount.String())
+	suite.Require().Equal("2", params.FindMatchingConfig("channel-0", "uosmo").SupplyShiftLimits[0].MaxAmount.String())
+	suite.Require().Equal("1", params.FindMatchingConfig("channel-5", "uosmo").SupplyShiftLimits[0].MaxAmount.String())
+
+	// Without the catch-all, unmatched pairs have no limit
+	params.RateLimits = params.RateLimits[1:]
+	suite.Require().Nil(params.FindMatchingConfig("channel-5", "uosmo"))
+}
+
+func (suite *KeeperTestSuite) TestValidateParams_DuplicateConfigs() {
+	params := ratelimittypes.Params{
+		RateLimits: []ratelimittypes.RateLimitConfig{
+			wildcardTestConfig("", "uatom", 1),
+			wildcardTestConfig("*", "uatom", 2),
+		},
+	}
+	suite.Require().Error(params.Validate(), "empty and wildcard channel IDs are equivalent")
+
+	params.RateLimits[1].ChannelId = "channel-0"
+	suite.Require().NoError(params.Validate())
+}
+
+func (suite *KeeperTestSuite) TestCheckRateLimit_WildcardProtectsNewChannels() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimits = append(params.RateLimits, wildcardTestConfig("*", "*", 1000))
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// A channel with no specific config falls back to the wildcard default
+	ack := suite.keeper.CheckRateLimit(suite.ctx, "channel-42", "ubadge", sdkmath.NewInt(1001), true, "")
+	suite.Require().False(ack.Success())
+	ack = suite.keeper.CheckRateLimit(suite.ctx, "channel-42", "ubadge", sdkmath.NewInt(1000), true, "")
+	suite.Require().True(ack.Success())
+
+	// The specific config still overrides the default
+	ack = suite.keeper.CheckRateLimit(suite.ctx, "channel-0", "uatom", sdkmath.NewInt(300000), true, "")
+	suite.Require().True(ack.Success())
+}
@@ -13,11 +13,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *ibcratelimittypes.G
 		panic(err)
 	}
 	k.SetParams(ctx, genState.Params)
+	k.ImportTrackingState(ctx, *genState)
 }
 
 // ExportGenesis exports the module's state to a genesis state.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) ibcratelimittypes.GenesisState {
-	return ibcratelimittypes.GenesisState{
+	genState := ibcratelimittypes.GenesisState{
 		Params: k.GetParams(ctx),
 	}
+	k.ExportTrackingState(ctx, &genState)
+	return genState
 }
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,6 +14,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
+	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/client/cli"
 	"github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/keeper"
 	ibcratelimittypes "github.com/bitbadges/bitbadgeschain/x/ibc-rate-limit/types"
 )
@@ -70,16 +72,18 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// No gRPC queries for now
+	if err := ibcratelimittypes.RegisterQueryHandlerClient(context.Background(), mux, ibcratelimittypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the root Tx command for the module.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil // No CLI commands for now
+	return cli.GetTxCmd()
 }
 
 func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil // No CLI queries for now
+	return cli.GetQueryCmd()
 }
 
 // ----------------------------------------------------------------------------
@@ -106,7 +110,11 @@ func NewAppModule(
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	ibcratelimittypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
-	// No query server for now
+	ibcratelimittypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	if err := cfg.RegisterMigration(ibcratelimittypes.ModuleName, 1, am.keeper.MigrateUniqueSenders); err != nil {
+		panic(fmt.Errorf("failed to register migration of %s: %w", ibcratelimittypes.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module.
@@ -126,7 +134,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the module.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 func (am AppModule) BeginBlock(_ context.Context) error {
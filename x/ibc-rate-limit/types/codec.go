@@ -10,12 +10,16 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "ibcratelimit/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, "ibcratelimit/MsgUpdateRateLimit", nil)
+	cdc.RegisterConcrete(&MsgPauseChannel{}, "ibcratelimit/MsgPauseChannel", nil)
+	cdc.RegisterConcrete(&MsgUnpauseChannel{}, "ibcratelimit/MsgUnpauseChannel", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgUpdateRateLimit{},
+		&MsgPauseChannel{},
+		&MsgUnpauseChannel{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -7,4 +7,6 @@ import (
 var (
 	ErrRateLimitExceeded = errorsmod.Register(ModuleName, 1, "rate limit exceeded: transfer would exceed maximum supply change percentage")
 	ErrInvalidSigner     = errorsmod.Register(ModuleName, 2, "expected gov account as only signer for proposal message")
+	ErrNotGuardian       = errorsmod.Register(ModuleName, 3, "expected guardian or gov account as signer")
+	ErrChannelPaused     = errorsmod.Register(ModuleName, 4, "channel is paused")
 )
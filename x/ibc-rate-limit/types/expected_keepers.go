@@ -10,5 +10,6 @@ import (
 type BankKeeper interface {
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
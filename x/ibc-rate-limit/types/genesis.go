@@ -0,0 +1,234 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// validateTrackingState validates the tracking state entries of a genesis state
+// Entries are keyed the same way as in the store, so duplicates would silently overwrite each other on import
+func (gs GenesisState) validateTrackingState() error {
+	seen := make(map[string]bool)
+	checkDuplicate := func(field string, id string) error {
+		key := field + "/" + id
+		if seen[key] {
+			return fmt.Errorf("duplicate %s entry: %s", field, id)
+		}
+		seen[key] = true
+		return nil
+	}
+
+	for i, r := range gs.ChannelFlows {
+		id, err := trackingID("", r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid channel_flows[%d]: %w", i, err)
+		}
+		if r.Flow.NetFlow.IsNil() {
+			return fmt.Errorf("invalid channel_flows[%d]: net_flow must be set", i)
+		}
+		if err := checkDuplicate("channel_flows", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.ChannelFlowWindows {
+		id, err := trackingID("", r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid channel_flow_windows[%d]: %w", i, err)
+		}
+		if err := validateWindow(r.Window); err != nil {
+			return fmt.Errorf("invalid channel_flow_windows[%d]: %w", i, err)
+		}
+		if err := checkDuplicate("channel_flow_windows", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.UniqueSenders {
+		// Unique senders are tracked per channel across all denoms
+		id, err := trackingID("", r.ChannelId, Wildcard, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid unique_senders[%d]: %w", i, err)
+		}
+		senders := make(map[string]bool, len(r.Senders))
+		for _, sender := range r.Senders {
+			if sender == "" {
+				return fmt.Errorf("invalid unique_senders[%d]: sender cannot be empty", i)
+			}
+			if senders[sender] {
+				return fmt.Errorf("invalid unique_senders[%d]: duplicate sender %s", i, sender)
+			}
+			senders[sender] = true
+		}
+		if err := checkDuplicate("unique_senders", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.UniqueSendersWindows {
+		id, err := trackingID("", r.ChannelId, Wildcard, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid unique_senders_windows[%d]: %w", i, err)
+		}
+		if err := validateWindow(r.Window); err != nil {
+			return fmt.Errorf("invalid unique_senders_windows[%d]: %w", i, err)
+		}
+		if err := checkDuplicate("unique_senders_windows", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.AddressTransfers {
+		id, err := trackingID(r.Address, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid address_transfers[%d]: %w", i, err)
+		}
+		if r.Data.TransferCount < 0 {
+			return fmt.Errorf("invalid address_transfers[%d]: transfer_count cannot be negative: %d", i, r.Data.TransferCount)
+		}
+		if err := validateNonNegative("total_amount", r.Data.TotalAmount); err != nil {
+			return fmt.Errorf("invalid address_transfers[%d]: %w", i, err)
+		}
+		if err := checkDuplicate("address_transfers", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.AddressTransferWindows {
+		id, err := trackingID(r.Address, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid address_transfer_windows[%d]: %w", i, err)
+		}
+		if err := validateWindow(r.Window); err != nil {
+			return fmt.Errorf("invalid address_transfer_windows[%d]: %w", i, err)
+		}
+		if err := checkDuplicate("address_transfer_windows", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.ChannelFlowBuckets {
+		id, err := trackingID("", r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid channel_flow_buckets[%d]: %w", i, err)
+		}
+		// Channel flow buckets hold the signed net flow, so amounts may be negative
+		if err := validateBuckets(r.Buckets, false); err != nil {
+			return fmt.Errorf("invalid channel_flow_buckets[%d]: %w", i, err)
+		}
+		if err := checkDuplicate("channel_flow_buckets", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.AddressTransferBuckets {
+		id, err := trackingID(r.Address, r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid address_transfer_buckets[%d]: %w", i, err)
+		}
+		if err := validateBuckets(r.Buckets, true); err != nil {
+			return fmt.Errorf("invalid address_transfer_buckets[%d]: %w", i, err)
+		}
+		if err := checkDuplicate("address_transfer_buckets", id); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range gs.SupplySnapshots {
+		id, err := trackingID("", r.ChannelId, r.Denom, r.TimeframeType, r.TimeframeDuration)
+		if err != nil {
+			return fmt.Errorf("invalid supply_snapshots[%d]: %w", i, err)
+		}
+		if _, ok := SupplyBasis_name[int32(r.SupplyBasis)]; !ok || r.SupplyBasis == SupplyBasis_SUPPLY_BASIS_UNSPECIFIED {
+			return fmt.Errorf("invalid supply_snapshots[%d]: invalid supply_basis: %d", i, r.SupplyBasis)
+		}
+		if err := validateNonNegative("amount", r.Snapshot.Amount); err != nil {
+			return fmt.Errorf("invalid supply_snapshots[%d]: %w", i, err)
+		}
+		if r.Snapshot.SnapshotHeight < 0 {
+			return fmt.Errorf("invalid supply_snapshots[%d]: snapshot_height cannot be negative: %d", i, r.Snapshot.SnapshotHeight)
+		}
+		if err := checkDuplicate("supply_snapshots", fmt.Sprintf("%s|%d", id, r.SupplyBasis)); err != nil {
+			return err
+		}
+	}
+
+	for i, pause := range gs.ChannelPauses {
+		if pause.ChannelId == "" {
+			return fmt.Errorf("invalid channel_pauses[%d]: channel_id cannot be empty", i)
+		}
+		if !pause.Inbound && !pause.Outbound {
+			return fmt.Errorf("invalid channel_pauses[%d]: at least one of inbound or outbound must be paused", i)
+		}
+		if pause.ExpiresAt <= 0 {
+			return fmt.Errorf("invalid channel_pauses[%d]: expires_at must be positive: %d", i, pause.ExpiresAt)
+		}
+		if err := checkDuplicate("channel_pauses", pause.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trackingID validates the identifiers of a tracking state entry and returns a string identifying it
+// address is empty for entries that are not tracked per address
+func trackingID(address, channelID, denom string, timeframeType TimeframeType, timeframeDuration int64) (string, error) {
+	if channelID == "" {
+		return "", fmt.Errorf("channel_id cannot be empty")
+	}
+	if denom == "" {
+		return "", fmt.Errorf("denom cannot be empty")
+	}
+	// The address and channel are "|"-separated in store keys, so they cannot contain the separator
+	if strings.Contains(address, "|") || strings.Contains(channelID, "|") {
+		return "", fmt.Errorf("address and channel_id cannot contain '|'")
+	}
+	if _, ok := TimeframeType_name[int32(timeframeType)]; !ok || timeframeType == TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED {
+		return "", fmt.Errorf("invalid timeframe_type: %d", timeframeType)
+	}
+	if timeframeDuration <= 0 {
+		return "", fmt.Errorf("timeframe_duration must be positive: %d", timeframeDuration)
+	}
+	return fmt.Sprintf("%s|%s|%s|%d|%d", address, channelID, denom, timeframeType, timeframeDuration), nil
+}
+
+func validateWindow(window ChannelFlowWindow) error {
+	if window.WindowStart < 0 {
+		return fmt.Errorf("window_start cannot be negative: %d", window.WindowStart)
+	}
+	if window.WindowDuration <= 0 {
+		return fmt.Errorf("window_duration must be positive: %d", window.WindowDuration)
+	}
+	return nil
+}
+
+func validateBuckets(buckets WindowBuckets, nonNegative bool) error {
+	for i, bucket := range buckets.Buckets {
+		if i > 0 && bucket.BucketIndex <= buckets.Buckets[i-1].BucketIndex {
+			return fmt.Errorf("buckets must be sorted by bucket_index without duplicates")
+		}
+		if bucket.Amount.IsNil() {
+			return fmt.Errorf("bucket amount must be set")
+		}
+		if nonNegative && bucket.Amount.IsNegative() {
+			return fmt.Errorf("bucket amount cannot be negative: %s", bucket.Amount)
+		}
+		if bucket.Count < 0 {
+			return fmt.Errorf("bucket count cannot be negative: %d", bucket.Count)
+		}
+	}
+	return nil
+}
+
+func validateNonNegative(field string, amount sdkmath.Int) error {
+	if amount.IsNil() {
+		return fmt.Errorf("%s must be set", field)
+	}
+	if amount.IsNegative() {
+		return fmt.Errorf("%s cannot be negative: %s", field, amount)
+	}
+	return nil
+}
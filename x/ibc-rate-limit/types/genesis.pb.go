@@ -27,6 +27,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// channel_flows is the net flow tracked for each channel, denom and timeframe
+	ChannelFlows []ChannelFlowRecord `protobuf:"bytes,2,rep,name=channel_flows,json=channelFlows,proto3" json:"channel_flows"`
+	// channel_flow_windows is the fixed window for each tracked channel flow
+	ChannelFlowWindows []ChannelFlowWindowRecord `protobuf:"bytes,3,rep,name=channel_flow_windows,json=channelFlowWindows,proto3" json:"channel_flow_windows"`
+	// unique_senders is the set of unique senders tracked for each channel and timeframe
+	UniqueSenders []UniqueSendersRecord `protobuf:"bytes,4,rep,name=unique_senders,json=uniqueSenders,proto3" json:"unique_senders"`
+	// unique_senders_windows is the fixed window for each tracked unique sender set
+	UniqueSendersWindows []UniqueSendersWindowRecord `protobuf:"bytes,5,rep,name=unique_senders_windows,json=uniqueSendersWindows,proto3" json:"unique_senders_windows"`
+	// address_transfers is the transfer data tracked for each address, channel, denom and timeframe
+	AddressTransfers []AddressTransferRecord `protobuf:"bytes,6,rep,name=address_transfers,json=addressTransfers,proto3" json:"address_transfers"`
+	// address_transfer_windows is the fixed window for each tracked address
+	AddressTransferWindows []AddressTransferWindowRecord `protobuf:"bytes,7,rep,name=address_transfer_windows,json=addressTransferWindows,proto3" json:"address_transfer_windows"`
+	// channel_flow_buckets is the sliding window buckets for each channel, denom and timeframe
+	ChannelFlowBuckets []ChannelFlowBucketsRecord `protobuf:"bytes,8,rep,name=channel_flow_buckets,json=channelFlowBuckets,proto3" json:"channel_flow_buckets"`
+	// address_transfer_buckets is the sliding window buckets for each address, channel, denom and timeframe
+	AddressTransferBuckets []AddressTransferBucketsRecord `protobuf:"bytes,9,rep,name=address_transfer_buckets,json=addressTransferBuckets,proto3" json:"address_transfer_buckets"`
+	// supply_snapshots is the supply basis snapshot for each percentage-based limit
+	SupplySnapshots []SupplySnapshotRecord `protobuf:"bytes,10,rep,name=supply_snapshots,json=supplySnapshots,proto3" json:"supply_snapshots"`
+	// channel_pauses is the emergency pauses that have not yet been lifted
+	ChannelPauses []ChannelPause `protobuf:"bytes,11,rep,name=channel_pauses,json=channelPauses,proto3" json:"channel_pauses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,91 +89,3814 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "ibcratelimit.GenesisState")
+func (m *GenesisState) GetChannelFlows() []ChannelFlowRecord {
+	if m != nil {
+		return m.ChannelFlows
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("ibcratelimit/genesis.proto", fileDescriptor_86c4bb17c7c40ee8) }
+func (m *GenesisState) GetChannelFlowWindows() []ChannelFlowWindowRecord {
+	if m != nil {
+		return m.ChannelFlowWindows
+	}
+	return nil
+}
 
-var fileDescriptor_86c4bb17c7c40ee8 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x4c, 0x4a, 0x2e,
-	0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x41, 0x96, 0x93, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x4b, 0xe8, 0x83, 0x58, 0x10, 0x35, 0x52, 0x92, 0x28, 0xfa, 0x0b, 0x12, 0x8b, 0x12, 0x73,
-	0xa1, 0xda, 0x95, 0x9c, 0xb8, 0x78, 0xdc, 0x21, 0xe6, 0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x19,
-	0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x44, 0xf4, 0x90, 0xf5, 0xea,
-	0x05, 0x80, 0xe5, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x74, 0x0a, 0x39, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xab, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xa4, 0xcc, 0x92, 0xa4, 0xc4, 0x94, 0xf4, 0xd4, 0x62, 0x04, 0x2b,
-	0x39, 0x23, 0x31, 0x33, 0x4f, 0xbf, 0x42, 0x3f, 0x33, 0x29, 0x59, 0x17, 0x64, 0x87, 0x2e, 0xc4,
-	0x81, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x07, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x20, 0xb4, 0xbf, 0x41, 0xfd, 0x00, 0x00, 0x00,
+func (m *GenesisState) GetUniqueSenders() []UniqueSendersRecord {
+	if m != nil {
+		return m.UniqueSenders
+	}
+	return nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetUniqueSendersWindows() []UniqueSendersWindowRecord {
+	if m != nil {
+		return m.UniqueSendersWindows
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetAddressTransfers() []AddressTransferRecord {
+	if m != nil {
+		return m.AddressTransfers
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *GenesisState) GetAddressTransferWindows() []AddressTransferWindowRecord {
+	if m != nil {
+		return m.AddressTransferWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelFlowBuckets() []ChannelFlowBucketsRecord {
+	if m != nil {
+		return m.ChannelFlowBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressTransferBuckets() []AddressTransferBucketsRecord {
+	if m != nil {
+		return m.AddressTransferBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplySnapshots() []SupplySnapshotRecord {
+	if m != nil {
+		return m.SupplySnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelPauses() []ChannelPause {
+	if m != nil {
+		return m.ChannelPauses
+	}
+	return nil
+}
+
+// ChannelFlowRecord is a ChannelFlow along with the channel, denom and timeframe it is tracked for
+type ChannelFlowRecord struct {
+	ChannelId         string        `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom             string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	TimeframeType     TimeframeType `protobuf:"varint,3,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64         `protobuf:"varint,4,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Flow              ChannelFlow   `protobuf:"bytes,5,opt,name=flow,proto3" json:"flow"`
+}
+
+func (m *ChannelFlowRecord) Reset()         { *m = ChannelFlowRecord{} }
+func (m *ChannelFlowRecord) String() string { return proto.CompactTextString(m) }
+func (*ChannelFlowRecord) ProtoMessage()    {}
+func (*ChannelFlowRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{1}
+}
+func (m *ChannelFlowRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFlowRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFlowRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFlowRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFlowRecord.Merge(m, src)
+}
+func (m *ChannelFlowRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFlowRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFlowRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFlowRecord proto.InternalMessageInfo
+
+func (m *ChannelFlowRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFlowRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ChannelFlowRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *ChannelFlowRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *ChannelFlowRecord) GetFlow() ChannelFlow {
+	if m != nil {
+		return m.Flow
+	}
+	return ChannelFlow{}
+}
+
+// ChannelFlowWindowRecord is a ChannelFlowWindow along with the channel, denom and timeframe it is tracked for
+type ChannelFlowWindowRecord struct {
+	ChannelId         string            `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom             string            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	TimeframeType     TimeframeType     `protobuf:"varint,3,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64             `protobuf:"varint,4,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Window            ChannelFlowWindow `protobuf:"bytes,5,opt,name=window,proto3" json:"window"`
+}
+
+func (m *ChannelFlowWindowRecord) Reset()         { *m = ChannelFlowWindowRecord{} }
+func (m *ChannelFlowWindowRecord) String() string { return proto.CompactTextString(m) }
+func (*ChannelFlowWindowRecord) ProtoMessage()    {}
+func (*ChannelFlowWindowRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{2}
+}
+func (m *ChannelFlowWindowRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFlowWindowRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFlowWindowRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFlowWindowRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFlowWindowRecord.Merge(m, src)
+}
+func (m *ChannelFlowWindowRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFlowWindowRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFlowWindowRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFlowWindowRecord proto.InternalMessageInfo
+
+func (m *ChannelFlowWindowRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFlowWindowRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ChannelFlowWindowRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *ChannelFlowWindowRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *ChannelFlowWindowRecord) GetWindow() ChannelFlowWindow {
+	if m != nil {
+		return m.Window
+	}
+	return ChannelFlowWindow{}
+}
+
+// UniqueSendersRecord is the set of unique senders for a channel and timeframe
+type UniqueSendersRecord struct {
+	ChannelId         string        `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TimeframeType     TimeframeType `protobuf:"varint,2,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64         `protobuf:"varint,3,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Senders           []string      `protobuf:"bytes,4,rep,name=senders,proto3" json:"senders,omitempty"`
+}
+
+func (m *UniqueSendersRecord) Reset()         { *m = UniqueSendersRecord{} }
+func (m *UniqueSendersRecord) String() string { return proto.CompactTextString(m) }
+func (*UniqueSendersRecord) ProtoMessage()    {}
+func (*UniqueSendersRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{3}
+}
+func (m *UniqueSendersRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UniqueSendersRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UniqueSendersRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UniqueSendersRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniqueSendersRecord.Merge(m, src)
+}
+func (m *UniqueSendersRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UniqueSendersRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniqueSendersRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UniqueSendersRecord proto.InternalMessageInfo
+
+func (m *UniqueSendersRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *UniqueSendersRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *UniqueSendersRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *UniqueSendersRecord) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+// UniqueSendersWindowRecord is a unique sender window along with the channel and timeframe it is tracked for
+type UniqueSendersWindowRecord struct {
+	ChannelId         string            `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TimeframeType     TimeframeType     `protobuf:"varint,2,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64             `protobuf:"varint,3,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Window            ChannelFlowWindow `protobuf:"bytes,4,opt,name=window,proto3" json:"window"`
+}
+
+func (m *UniqueSendersWindowRecord) Reset()         { *m = UniqueSendersWindowRecord{} }
+func (m *UniqueSendersWindowRecord) String() string { return proto.CompactTextString(m) }
+func (*UniqueSendersWindowRecord) ProtoMessage()    {}
+func (*UniqueSendersWindowRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{4}
+}
+func (m *UniqueSendersWindowRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UniqueSendersWindowRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UniqueSendersWindowRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UniqueSendersWindowRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniqueSendersWindowRecord.Merge(m, src)
+}
+func (m *UniqueSendersWindowRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UniqueSendersWindowRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniqueSendersWindowRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UniqueSendersWindowRecord proto.InternalMessageInfo
+
+func (m *UniqueSendersWindowRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *UniqueSendersWindowRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *UniqueSendersWindowRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *UniqueSendersWindowRecord) GetWindow() ChannelFlowWindow {
+	if m != nil {
+		return m.Window
+	}
+	return ChannelFlowWindow{}
+}
+
+// AddressTransferRecord is an AddressTransferData along with the address, channel, denom and timeframe it is tracked for
+type AddressTransferRecord struct {
+	Address           string              `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChannelId         string              `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom             string              `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	TimeframeType     TimeframeType       `protobuf:"varint,4,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64               `protobuf:"varint,5,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Data              AddressTransferData `protobuf:"bytes,6,opt,name=data,proto3" json:"data"`
+}
+
+func (m *AddressTransferRecord) Reset()         { *m = AddressTransferRecord{} }
+func (m *AddressTransferRecord) String() string { return proto.CompactTextString(m) }
+func (*AddressTransferRecord) ProtoMessage()    {}
+func (*AddressTransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{5}
+}
+func (m *AddressTransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressTransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressTransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransferRecord.Merge(m, src)
+}
+func (m *AddressTransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransferRecord proto.InternalMessageInfo
+
+func (m *AddressTransferRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTransferRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AddressTransferRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AddressTransferRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *AddressTransferRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *AddressTransferRecord) GetData() AddressTransferData {
+	if m != nil {
+		return m.Data
+	}
+	return AddressTransferData{}
+}
+
+// AddressTransferWindowRecord is an address transfer window along with the address, channel, denom and timeframe it is tracked for
+type AddressTransferWindowRecord struct {
+	Address           string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChannelId         string            `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom             string            `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	TimeframeType     TimeframeType     `protobuf:"varint,4,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64             `protobuf:"varint,5,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Window            ChannelFlowWindow `protobuf:"bytes,6,opt,name=window,proto3" json:"window"`
+}
+
+func (m *AddressTransferWindowRecord) Reset()         { *m = AddressTransferWindowRecord{} }
+func (m *AddressTransferWindowRecord) String() string { return proto.CompactTextString(m) }
+func (*AddressTransferWindowRecord) ProtoMessage()    {}
+func (*AddressTransferWindowRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{6}
+}
+func (m *AddressTransferWindowRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransferWindowRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressTransferWindowRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressTransferWindowRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransferWindowRecord.Merge(m, src)
+}
+func (m *AddressTransferWindowRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransferWindowRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransferWindowRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransferWindowRecord proto.InternalMessageInfo
+
+func (m *AddressTransferWindowRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTransferWindowRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AddressTransferWindowRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AddressTransferWindowRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *AddressTransferWindowRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *AddressTransferWindowRecord) GetWindow() ChannelFlowWindow {
+	if m != nil {
+		return m.Window
+	}
+	return ChannelFlowWindow{}
+}
+
+// ChannelFlowBucketsRecord is the sliding window buckets along with the channel, denom and timeframe they are tracked for
+type ChannelFlowBucketsRecord struct {
+	ChannelId         string        `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom             string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	TimeframeType     TimeframeType `protobuf:"varint,3,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64         `protobuf:"varint,4,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Buckets           WindowBuckets `protobuf:"bytes,5,opt,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *ChannelFlowBucketsRecord) Reset()         { *m = ChannelFlowBucketsRecord{} }
+func (m *ChannelFlowBucketsRecord) String() string { return proto.CompactTextString(m) }
+func (*ChannelFlowBucketsRecord) ProtoMessage()    {}
+func (*ChannelFlowBucketsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{7}
+}
+func (m *ChannelFlowBucketsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFlowBucketsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFlowBucketsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFlowBucketsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFlowBucketsRecord.Merge(m, src)
+}
+func (m *ChannelFlowBucketsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFlowBucketsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFlowBucketsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFlowBucketsRecord proto.InternalMessageInfo
+
+func (m *ChannelFlowBucketsRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFlowBucketsRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ChannelFlowBucketsRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *ChannelFlowBucketsRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *ChannelFlowBucketsRecord) GetBuckets() WindowBuckets {
+	if m != nil {
+		return m.Buckets
+	}
+	return WindowBuckets{}
+}
+
+// AddressTransferBucketsRecord is the sliding window buckets along with the address, channel, denom and timeframe they are tracked for
+type AddressTransferBucketsRecord struct {
+	Address           string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChannelId         string        `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom             string        `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	TimeframeType     TimeframeType `protobuf:"varint,4,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64         `protobuf:"varint,5,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Buckets           WindowBuckets `protobuf:"bytes,6,opt,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *AddressTransferBucketsRecord) Reset()         { *m = AddressTransferBucketsRecord{} }
+func (m *AddressTransferBucketsRecord) String() string { return proto.CompactTextString(m) }
+func (*AddressTransferBucketsRecord) ProtoMessage()    {}
+func (*AddressTransferBucketsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{8}
+}
+func (m *AddressTransferBucketsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransferBucketsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressTransferBucketsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressTransferBucketsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransferBucketsRecord.Merge(m, src)
+}
+func (m *AddressTransferBucketsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransferBucketsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransferBucketsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransferBucketsRecord proto.InternalMessageInfo
+
+func (m *AddressTransferBucketsRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTransferBucketsRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AddressTransferBucketsRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AddressTransferBucketsRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *AddressTransferBucketsRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *AddressTransferBucketsRecord) GetBuckets() WindowBuckets {
+	if m != nil {
+		return m.Buckets
+	}
+	return WindowBuckets{}
+}
+
+// SupplySnapshotRecord is a SupplySnapshot along with the channel, denom, supply basis and timeframe it is taken for
+type SupplySnapshotRecord struct {
+	ChannelId         string         `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom             string         `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	SupplyBasis       SupplyBasis    `protobuf:"varint,3,opt,name=supply_basis,json=supplyBasis,proto3,enum=ibcratelimit.SupplyBasis" json:"supply_basis,omitempty"`
+	TimeframeType     TimeframeType  `protobuf:"varint,4,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
+	TimeframeDuration int64          `protobuf:"varint,5,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	Snapshot          SupplySnapshot `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *SupplySnapshotRecord) Reset()         { *m = SupplySnapshotRecord{} }
+func (m *SupplySnapshotRecord) String() string { return proto.CompactTextString(m) }
+func (*SupplySnapshotRecord) ProtoMessage()    {}
+func (*SupplySnapshotRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86c4bb17c7c40ee8, []int{9}
+}
+func (m *SupplySnapshotRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplySnapshotRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplySnapshotRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplySnapshotRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplySnapshotRecord.Merge(m, src)
+}
+func (m *SupplySnapshotRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplySnapshotRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplySnapshotRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplySnapshotRecord proto.InternalMessageInfo
+
+func (m *SupplySnapshotRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *SupplySnapshotRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SupplySnapshotRecord) GetSupplyBasis() SupplyBasis {
+	if m != nil {
+		return m.SupplyBasis
+	}
+	return SupplyBasis_SUPPLY_BASIS_UNSPECIFIED
+}
+
+func (m *SupplySnapshotRecord) GetTimeframeType() TimeframeType {
+	if m != nil {
+		return m.TimeframeType
+	}
+	return TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED
+}
+
+func (m *SupplySnapshotRecord) GetTimeframeDuration() int64 {
+	if m != nil {
+		return m.TimeframeDuration
+	}
+	return 0
+}
+
+func (m *SupplySnapshotRecord) GetSnapshot() SupplySnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return SupplySnapshot{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "ibcratelimit.GenesisState")
+	proto.RegisterType((*ChannelFlowRecord)(nil), "ibcratelimit.ChannelFlowRecord")
+	proto.RegisterType((*ChannelFlowWindowRecord)(nil), "ibcratelimit.ChannelFlowWindowRecord")
+	proto.RegisterType((*UniqueSendersRecord)(nil), "ibcratelimit.UniqueSendersRecord")
+	proto.RegisterType((*UniqueSendersWindowRecord)(nil), "ibcratelimit.UniqueSendersWindowRecord")
+	proto.RegisterType((*AddressTransferRecord)(nil), "ibcratelimit.AddressTransferRecord")
+	proto.RegisterType((*AddressTransferWindowRecord)(nil), "ibcratelimit.AddressTransferWindowRecord")
+	proto.RegisterType((*ChannelFlowBucketsRecord)(nil), "ibcratelimit.ChannelFlowBucketsRecord")
+	proto.RegisterType((*AddressTransferBucketsRecord)(nil), "ibcratelimit.AddressTransferBucketsRecord")
+	proto.RegisterType((*SupplySnapshotRecord)(nil), "ibcratelimit.SupplySnapshotRecord")
+}
+
+func init() { proto.RegisterFile("ibcratelimit/genesis.proto", fileDescriptor_86c4bb17c7c40ee8) }
+
+var fileDescriptor_86c4bb17c7c40ee8 = []byte{
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6e, 0xfb, 0x44,
+	0x10, 0x8e, 0x9d, 0x7f, 0xbf, 0x4c, 0xd2, 0xd0, 0x2e, 0xa1, 0x38, 0x69, 0x49, 0x83, 0x11, 0x10,
+	0x90, 0x9a, 0x48, 0xe9, 0x8d, 0x02, 0x12, 0xa1, 0xa2, 0x82, 0x03, 0xaa, 0x92, 0x00, 0x12, 0x12,
+	0x44, 0x6b, 0x7b, 0x9b, 0x18, 0x12, 0xdb, 0x78, 0xd7, 0x2a, 0xbd, 0xf1, 0x08, 0x9c, 0xb8, 0xc1,
+	0x93, 0x70, 0xe1, 0xd6, 0x1b, 0x3d, 0x72, 0x42, 0xa8, 0xbd, 0x72, 0xe0, 0x0d, 0x40, 0x5e, 0xaf,
+	0x13, 0x3b, 0xb1, 0x53, 0x51, 0x55, 0x82, 0xf6, 0xe6, 0xf5, 0x7c, 0xf3, 0xcd, 0x7e, 0xb3, 0xb3,
+	0xb3, 0xbb, 0xd0, 0x30, 0x35, 0xdd, 0xc5, 0x8c, 0xcc, 0xcc, 0xb9, 0xc9, 0xba, 0x13, 0x62, 0x11,
+	0x6a, 0xd2, 0x8e, 0xe3, 0xda, 0xcc, 0x46, 0x95, 0xa8, 0xad, 0x51, 0x9b, 0xd8, 0x13, 0x9b, 0x1b,
+	0xba, 0xfe, 0x57, 0x80, 0x69, 0xd4, 0x63, 0xfe, 0x0e, 0x76, 0xf1, 0x5c, 0xb8, 0x37, 0x94, 0x98,
+	0x89, 0x5d, 0x3a, 0x44, 0x58, 0xd4, 0x5f, 0x8b, 0x50, 0x39, 0x0d, 0x42, 0x0d, 0x19, 0x66, 0x04,
+	0xf5, 0xa0, 0x10, 0xb8, 0x2a, 0x52, 0x4b, 0x6a, 0x97, 0x7b, 0xb5, 0x4e, 0xd4, 0xb7, 0x73, 0xc6,
+	0x6d, 0xfd, 0xdc, 0xd5, 0xef, 0x07, 0x99, 0x81, 0x40, 0xa2, 0x8f, 0x60, 0x4b, 0x9f, 0x62, 0xcb,
+	0x22, 0xb3, 0xf1, 0xf9, 0xcc, 0xbe, 0xa0, 0x8a, 0xdc, 0xca, 0xb6, 0xcb, 0xbd, 0x83, 0xb8, 0xeb,
+	0xfb, 0x01, 0xe4, 0x83, 0x99, 0x7d, 0x31, 0x20, 0xba, 0xed, 0x1a, 0x82, 0xa5, 0xa2, 0x2f, 0x0d,
+	0x14, 0x7d, 0x01, 0xb5, 0x28, 0xd7, 0xf8, 0xc2, 0xb4, 0x0c, 0x9f, 0x32, 0xcb, 0x29, 0x5f, 0x4d,
+	0xa5, 0xfc, 0x8c, 0xe3, 0x62, 0xc4, 0x48, 0x5f, 0x35, 0x53, 0xf4, 0x31, 0x54, 0x3d, 0xcb, 0xfc,
+	0xc6, 0x23, 0x63, 0x4a, 0x2c, 0x83, 0xb8, 0x54, 0xc9, 0x71, 0xe2, 0x97, 0xe3, 0xc4, 0x9f, 0x70,
+	0xcc, 0x30, 0x80, 0xc4, 0x48, 0xb7, 0xbc, 0xa8, 0x09, 0xe9, 0xb0, 0x1b, 0xe7, 0x5b, 0x4c, 0x38,
+	0xcf, 0x79, 0x5f, 0xdf, 0xc0, 0x9b, 0x30, 0xe5, 0x9a, 0xb7, 0x0e, 0xa0, 0xe8, 0x53, 0xd8, 0xc1,
+	0x86, 0xe1, 0x12, 0x4a, 0xc7, 0xcc, 0xc5, 0x16, 0x3d, 0xf7, 0xe7, 0x5d, 0xe0, 0xfc, 0xaf, 0xc4,
+	0xf9, 0xdf, 0x0b, 0x60, 0x23, 0x81, 0x8a, 0x71, 0x6f, 0xe3, 0xb8, 0x91, 0x22, 0x13, 0x94, 0x55,
+	0xde, 0xc5, 0xf4, 0x8b, 0x9c, 0xfe, 0x8d, 0x8d, 0xf4, 0x09, 0x02, 0x76, 0x71, 0x12, 0x84, 0xa2,
+	0x2f, 0x57, 0x96, 0x55, 0xf3, 0xf4, 0xaf, 0x09, 0xa3, 0xca, 0x33, 0x1e, 0xe6, 0xb5, 0xd4, 0x65,
+	0xed, 0x07, 0xb8, 0xd4, 0x75, 0x15, 0x76, 0xf4, 0x55, 0x82, 0x94, 0x30, 0x46, 0x89, 0xc7, 0x78,
+	0x73, 0xa3, 0x94, 0xa4, 0x38, 0xbb, 0x38, 0x11, 0x83, 0x86, 0xb0, 0x4d, 0x3d, 0xc7, 0x99, 0x5d,
+	0x8e, 0xa9, 0x85, 0x1d, 0x3a, 0xb5, 0x19, 0x55, 0x80, 0xc7, 0x50, 0xe3, 0x31, 0x86, 0x1c, 0x35,
+	0x14, 0xa0, 0x18, 0xf7, 0x73, 0x34, 0x66, 0xa3, 0xe8, 0x14, 0xaa, 0x61, 0x82, 0x1c, 0xec, 0x51,
+	0x42, 0x95, 0x32, 0xa7, 0x6c, 0x24, 0xa6, 0xe6, 0xcc, 0x87, 0x84, 0x15, 0xa9, 0x47, 0xfe, 0x51,
+	0xf5, 0x2f, 0x09, 0x76, 0xd6, 0xb6, 0x1a, 0x7a, 0x09, 0x20, 0xa4, 0x37, 0x0d, 0xbe, 0xb5, 0x4b,
+	0x83, 0x92, 0xf8, 0xf3, 0xa1, 0x81, 0x6a, 0x90, 0x37, 0x88, 0x65, 0xcf, 0x15, 0x99, 0x5b, 0x82,
+	0x01, 0xea, 0x43, 0x95, 0x99, 0x73, 0x72, 0xee, 0xe2, 0x39, 0x19, 0xfb, 0x5d, 0x43, 0xc9, 0xb6,
+	0xa4, 0x76, 0xb5, 0xb7, 0x17, 0x9f, 0xd3, 0x28, 0xc4, 0x8c, 0x2e, 0x1d, 0x32, 0xd8, 0x62, 0xd1,
+	0x21, 0x3a, 0x04, 0xb4, 0xe4, 0x30, 0x3c, 0x17, 0x33, 0xd3, 0xb6, 0x94, 0x5c, 0x4b, 0x6a, 0x67,
+	0x07, 0x3b, 0x0b, 0xcb, 0x89, 0x30, 0xa0, 0x23, 0xc8, 0xf9, 0xf5, 0xa1, 0xe4, 0x79, 0xf3, 0xa9,
+	0xa7, 0xd7, 0x45, 0xa0, 0x9d, 0x83, 0xd5, 0xef, 0x64, 0x78, 0x31, 0xa5, 0x15, 0x3c, 0x1a, 0xe1,
+	0xef, 0x40, 0x21, 0xd8, 0x7a, 0x42, 0xfa, 0xc1, 0x1d, 0x9d, 0x2e, 0x6c, 0xc1, 0x81, 0x93, 0xfa,
+	0x8b, 0x04, 0xcf, 0x27, 0x34, 0xad, 0xbb, 0xe4, 0xaf, 0x0b, 0x95, 0x1f, 0x48, 0x68, 0x36, 0x4d,
+	0xa8, 0x02, 0xc5, 0x68, 0xeb, 0x2d, 0x0d, 0xc2, 0xa1, 0xfa, 0xa7, 0x04, 0xf5, 0xd4, 0x06, 0xf9,
+	0x3f, 0x54, 0xb2, 0x5c, 0xb2, 0xdc, 0x7d, 0x96, 0xec, 0x07, 0x19, 0x5e, 0x48, 0xec, 0xd7, 0x7e,
+	0x8a, 0x44, 0xeb, 0x11, 0x3a, 0xc3, 0xe1, 0x4a, 0x12, 0xe4, 0xd4, 0x6a, 0xce, 0x6e, 0xae, 0xe6,
+	0xdc, 0x03, 0xa5, 0x26, 0x9f, 0x96, 0x9a, 0x63, 0xc8, 0x19, 0x98, 0x61, 0xa5, 0xd0, 0x92, 0xd6,
+	0x0f, 0xd7, 0x15, 0xd1, 0x27, 0x98, 0xe1, 0x70, 0x3b, 0xfb, 0x4e, 0xea, 0x4f, 0x32, 0xec, 0x6d,
+	0x38, 0x69, 0x9e, 0x40, 0x7a, 0x96, 0x95, 0x53, 0xb8, 0x4f, 0xe5, 0xfc, 0x2d, 0x81, 0x92, 0x76,
+	0x46, 0x3e, 0x9a, 0x86, 0x77, 0x0c, 0xc5, 0xf0, 0x80, 0x0e, 0x3a, 0xde, 0x4a, 0x2c, 0xa1, 0x3c,
+	0x80, 0x88, 0x04, 0x84, 0x1e, 0xea, 0x8f, 0x32, 0xec, 0x6f, 0x3a, 0xc1, 0x9f, 0xc4, 0x16, 0x5a,
+	0xe4, 0xa7, 0xf0, 0xaf, 0xf3, 0xf3, 0xb3, 0x0c, 0xb5, 0xa4, 0xdb, 0xc7, 0xfd, 0xaa, 0xe3, 0x6d,
+	0xa8, 0x88, 0x0b, 0x8f, 0x86, 0xa9, 0x49, 0x45, 0x6d, 0xd4, 0x93, 0x2e, 0x3b, 0x7d, 0x1f, 0x30,
+	0x28, 0xd3, 0xe5, 0xe0, 0xbf, 0xc8, 0xdd, 0xbb, 0xf0, 0x2c, 0xbc, 0x9a, 0x89, 0xe4, 0xed, 0x6f,
+	0xba, 0x99, 0x89, 0xec, 0x2d, 0x7c, 0xfa, 0xa3, 0xab, 0x9b, 0xa6, 0x74, 0x7d, 0xd3, 0x94, 0xfe,
+	0xb8, 0x69, 0x4a, 0xdf, 0xdf, 0x36, 0x33, 0xd7, 0xb7, 0xcd, 0xcc, 0x6f, 0xb7, 0xcd, 0xcc, 0xe7,
+	0x6f, 0x4d, 0x4c, 0x36, 0xf5, 0xb4, 0x8e, 0x6e, 0xcf, 0xbb, 0x9a, 0xc9, 0x34, 0x6c, 0x4c, 0x08,
+	0x5d, 0x7e, 0xe9, 0x53, 0x6c, 0x5a, 0xdd, 0x6f, 0xbb, 0xa6, 0xa6, 0x1f, 0xfa, 0xd1, 0x0e, 0x23,
+	0x2f, 0x2e, 0xad, 0xc0, 0x9f, 0x5c, 0x47, 0xff, 0x0c, 0x00, 0xf6, 0x57, 0x2c, 0xee, 0xe9, 0x0d,
+	0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelPauses) > 0 {
+		for iNdEx := len(m.ChannelPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SupplySnapshots) > 0 {
+		for iNdEx := len(m.SupplySnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplySnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AddressTransferBuckets) > 0 {
+		for iNdEx := len(m.AddressTransferBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressTransferBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChannelFlowBuckets) > 0 {
+		for iNdEx := len(m.ChannelFlowBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFlowBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AddressTransferWindows) > 0 {
+		for iNdEx := len(m.AddressTransferWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressTransferWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddressTransfers) > 0 {
+		for iNdEx := len(m.AddressTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UniqueSendersWindows) > 0 {
+		for iNdEx := len(m.UniqueSendersWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UniqueSendersWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UniqueSenders) > 0 {
+		for iNdEx := len(m.UniqueSenders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UniqueSenders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelFlowWindows) > 0 {
+		for iNdEx := len(m.ChannelFlowWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFlowWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelFlows) > 0 {
+		for iNdEx := len(m.ChannelFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFlowRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFlowRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFlowRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFlowWindowRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFlowWindowRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFlowWindowRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UniqueSendersRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UniqueSendersRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UniqueSendersRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UniqueSendersWindowRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UniqueSendersWindowRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UniqueSendersWindowRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTransferWindowRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransferWindowRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransferWindowRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFlowBucketsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFlowBucketsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFlowBucketsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Buckets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTransferBucketsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransferBucketsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransferBucketsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Buckets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplySnapshotRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplySnapshotRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplySnapshotRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TimeframeDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeframeType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeframeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SupplyBasis != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SupplyBasis))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChannelFlows) > 0 {
+		for _, e := range m.ChannelFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelFlowWindows) > 0 {
+		for _, e := range m.ChannelFlowWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UniqueSenders) > 0 {
+		for _, e := range m.UniqueSenders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UniqueSendersWindows) > 0 {
+		for _, e := range m.UniqueSendersWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressTransfers) > 0 {
+		for _, e := range m.AddressTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressTransferWindows) > 0 {
+		for _, e := range m.AddressTransferWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelFlowBuckets) > 0 {
+		for _, e := range m.ChannelFlowBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressTransferBuckets) > 0 {
+		for _, e := range m.AddressTransferBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplySnapshots) > 0 {
+		for _, e := range m.SupplySnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelPauses) > 0 {
+		for _, e := range m.ChannelPauses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelFlowRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Flow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ChannelFlowWindowRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *UniqueSendersRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UniqueSendersWindowRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AddressTransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AddressTransferWindowRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ChannelFlowBucketsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Buckets.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AddressTransferBucketsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Buckets.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SupplySnapshotRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SupplyBasis != 0 {
+		n += 1 + sovGenesis(uint64(m.SupplyBasis))
+	}
+	if m.TimeframeType != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeType))
+	}
+	if m.TimeframeDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeframeDuration))
+	}
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelFlows = append(m.ChannelFlows, ChannelFlowRecord{})
+			if err := m.ChannelFlows[len(m.ChannelFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFlowWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelFlowWindows = append(m.ChannelFlowWindows, ChannelFlowWindowRecord{})
+			if err := m.ChannelFlowWindows[len(m.ChannelFlowWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueSenders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniqueSenders = append(m.UniqueSenders, UniqueSendersRecord{})
+			if err := m.UniqueSenders[len(m.UniqueSenders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueSendersWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniqueSendersWindows = append(m.UniqueSendersWindows, UniqueSendersWindowRecord{})
+			if err := m.UniqueSendersWindows[len(m.UniqueSendersWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressTransfers = append(m.AddressTransfers, AddressTransferRecord{})
+			if err := m.AddressTransfers[len(m.AddressTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressTransferWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressTransferWindows = append(m.AddressTransferWindows, AddressTransferWindowRecord{})
+			if err := m.AddressTransferWindows[len(m.AddressTransferWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFlowBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelFlowBuckets = append(m.ChannelFlowBuckets, ChannelFlowBucketsRecord{})
+			if err := m.ChannelFlowBuckets[len(m.ChannelFlowBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressTransferBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressTransferBuckets = append(m.AddressTransferBuckets, AddressTransferBucketsRecord{})
+			if err := m.AddressTransferBuckets[len(m.AddressTransferBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplySnapshots = append(m.SupplySnapshots, SupplySnapshotRecord{})
+			if err := m.SupplySnapshots[len(m.SupplySnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelPauses = append(m.ChannelPauses, ChannelPause{})
+			if err := m.ChannelPauses[len(m.ChannelPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFlowRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFlowRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFlowRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFlowWindowRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFlowWindowRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFlowWindowRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UniqueSendersRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UniqueSendersRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UniqueSendersRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UniqueSendersWindowRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UniqueSendersWindowRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UniqueSendersWindowRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTransferWindowRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransferWindowRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransferWindowRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFlowBucketsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFlowBucketsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFlowBucketsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Buckets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTransferBucketsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransferBucketsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransferBucketsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Buckets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *SupplySnapshotRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -176,15 +3919,136 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplySnapshotRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplySnapshotRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyBasis", wireType)
+			}
+			m.SupplyBasis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyBasis |= SupplyBasis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeType", wireType)
+			}
+			m.TimeframeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeType |= TimeframeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeframeDuration", wireType)
+			}
+			m.TimeframeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeframeDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -211,7 +4075,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -22,7 +24,8 @@ var (
 	// Key: channelFlowWindowKey(channelID, denom, timeframeType, timeframeDuration) -> ChannelFlowWindow
 	KeyPrefixChannelFlowWindow = []byte{0x02}
 
-	// KeyPrefixUniqueSenders stores unique sender addresses for each channel+timeframe combination
+	// KeyPrefixUniqueSenders stored unique sender addresses for each channel+timeframe combination as a single list
+	// Deprecated: only read by the v1 -> v2 migration, which moves the lists to KeyPrefixUniqueSender and KeyPrefixUniqueSenderCount
 	// Key: uniqueSendersKey(channelID, timeframeType, timeframeDuration) -> UniqueSenders
 	KeyPrefixUniqueSenders = []byte{0x03}
 
@@ -37,6 +40,31 @@ var (
 	// KeyPrefixAddressTransferWindow stores the time window for address transfer tracking
 	// Key: addressTransferWindowKey(address, channelID, denom, timeframeType, timeframeDuration) -> ChannelFlowWindow
 	KeyPrefixAddressTransferWindow = []byte{0x06}
+
+	// KeyPrefixChannelFlowBuckets stores the sliding window buckets for each channel+denom+timeframe combination
+	// Key: channelFlowBucketsKey(channelID, denom, timeframeType, timeframeDuration) -> WindowBuckets
+	KeyPrefixChannelFlowBuckets = []byte{0x07}
+
+	// KeyPrefixAddressTransferBuckets stores the sliding window buckets for each address+channel+denom+timeframe combination
+	// Key: addressTransferBucketsKey(address, channelID, denom, timeframeType, timeframeDuration) -> WindowBuckets
+	KeyPrefixAddressTransferBuckets = []byte{0x08}
+
+	// KeyPrefixSupplySnapshot stores the supply snapshot used by percentage-based limits for each channel+denom+basis+timeframe combination
+	// Key: supplySnapshotKey(channelID, denom, supplyBasis, timeframeType, timeframeDuration) -> SupplySnapshot
+	KeyPrefixSupplySnapshot = []byte{0x09}
+
+	// KeyPrefixChannelPause stores emergency channel pauses
+	// Key: channelPauseKey(channelID) -> ChannelPause
+	KeyPrefixChannelPause = []byte{0x0A}
+
+	// KeyPrefixUniqueSender marks a sender as seen for each channel+timeframe+window combination
+	// Keys of windows that have rolled over are stale and are pruned in bounded batches
+	// Key: uniqueSenderKey(channelID, timeframeType, timeframeDuration, windowStart, sender) -> []byte{1}
+	KeyPrefixUniqueSender = []byte{0x0B}
+
+	// KeyPrefixUniqueSenderCount stores the number of unique senders for each channel+timeframe+window combination
+	// Key: uniqueSenderCountKey(channelID, timeframeType, timeframeDuration, windowStart) -> uint64 (big endian)
+	KeyPrefixUniqueSenderCount = []byte{0x0C}
 )
 
 // ChannelFlowKeyLegacy returns the key for storing channel flow state (backward compatibility)
@@ -114,3 +142,68 @@ func AddressTransferWindowKey(address, channelID, denom string, timeframeType in
 	key = append(key, []byte(fmt.Sprintf("%d|%d", timeframeType, timeframeDuration))...)
 	return key
 }
+
+// channelFlowBucketsKey returns the key for storing sliding window buckets for a specific channel, denom, and timeframe
+func ChannelFlowBucketsKey(channelID, denom string, timeframeType int32, timeframeDuration int64) []byte {
+	key := append(KeyPrefixChannelFlowBuckets, []byte(channelID)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(denom)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(fmt.Sprintf("%d|%d", timeframeType, timeframeDuration))...)
+	return key
+}
+
+// addressTransferBucketsKey returns the key for storing sliding window buckets for a specific address, channel, denom, and timeframe
+func AddressTransferBucketsKey(address, channelID, denom string, timeframeType int32, timeframeDuration int64) []byte {
+	key := append(KeyPrefixAddressTransferBuckets, []byte(address)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(channelID)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(denom)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(fmt.Sprintf("%d|%d", timeframeType, timeframeDuration))...)
+	return key
+}
+
+// supplySnapshotKey returns the key for storing the supply snapshot for a specific channel, denom, supply basis, and timeframe
+func SupplySnapshotKey(channelID, denom string, supplyBasis int32, timeframeType int32, timeframeDuration int64) []byte {
+	key := append(KeyPrefixSupplySnapshot, []byte(channelID)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(denom)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(fmt.Sprintf("%d|%d|%d", supplyBasis, timeframeType, timeframeDuration))...)
+	return key
+}
+
+// channelPauseKey returns the key for storing the emergency pause for a specific channel
+func ChannelPauseKey(channelID string) []byte {
+	return append(KeyPrefixChannelPause, []byte(channelID)...)
+}
+
+// uniqueSenderPrefix returns the prefix of all unique sender keys for a specific channel and timeframe, across all windows
+// The trailing separator ensures that the prefix for one timeframe duration does not match another (e.g. 100 and 1000)
+func UniqueSenderPrefix(channelID string, timeframeType int32, timeframeDuration int64) []byte {
+	key := append(KeyPrefixUniqueSender, []byte(channelID)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(fmt.Sprintf("%d|%d|", timeframeType, timeframeDuration))...)
+	return key
+}
+
+// uniqueSenderWindowPrefix returns the prefix of the unique sender keys for a specific channel, timeframe and window
+// The window start is big endian encoded so that older windows sort first and can be pruned with a range iterator
+func UniqueSenderWindowPrefix(channelID string, timeframeType int32, timeframeDuration int64, windowStart int64) []byte {
+	return append(UniqueSenderPrefix(channelID, timeframeType, timeframeDuration), sdk.Uint64ToBigEndian(uint64(windowStart))...)
+}
+
+// uniqueSenderKey returns the key for marking a sender as seen for a specific channel, timeframe and window
+func UniqueSenderKey(channelID string, timeframeType int32, timeframeDuration int64, windowStart int64, sender string) []byte {
+	return append(UniqueSenderWindowPrefix(channelID, timeframeType, timeframeDuration, windowStart), []byte(sender)...)
+}
+
+// uniqueSenderCountKey returns the key for storing the unique sender count for a specific channel, timeframe and window
+func UniqueSenderCountKey(channelID string, timeframeType int32, timeframeDuration int64, windowStart int64) []byte {
+	key := append(KeyPrefixUniqueSenderCount, []byte(channelID)...)
+	key = append(key, []byte("|")...)
+	key = append(key, []byte(fmt.Sprintf("%d|%d|%d", timeframeType, timeframeDuration, windowStart))...)
+	return key
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgPauseChannel{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgPauseChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if m.ChannelId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "channel_id must be specified")
+	}
+
+	if !m.Inbound && !m.Outbound {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one of inbound or outbound must be paused")
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUnpauseChannel{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUnpauseChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if m.ChannelId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "channel_id must be specified")
+	}
+
+	return nil
+}
@@ -57,11 +57,67 @@ func (TimeframeType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e8c0c72281699922, []int{0}
 }
 
+// WindowMode defines how usage is tracked across a timeframe
+type WindowMode int32
+
+const (
+	WindowMode_WINDOW_MODE_FIXED   WindowMode = 0
+	WindowMode_WINDOW_MODE_SLIDING WindowMode = 1
+)
+
+var WindowMode_name = map[int32]string{
+	0: "WINDOW_MODE_FIXED",
+	1: "WINDOW_MODE_SLIDING",
+}
+
+var WindowMode_value = map[string]int32{
+	"WINDOW_MODE_FIXED":   0,
+	"WINDOW_MODE_SLIDING": 1,
+}
+
+func (x WindowMode) String() string {
+	return proto.EnumName(WindowMode_name, int32(x))
+}
+
+func (WindowMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e8c0c72281699922, []int{1}
+}
+
+// SupplyBasis defines what a percentage-based supply shift limit is measured against
+type SupplyBasis int32
+
+const (
+	SupplyBasis_SUPPLY_BASIS_UNSPECIFIED    SupplyBasis = 0
+	SupplyBasis_SUPPLY_BASIS_TOTAL_SUPPLY   SupplyBasis = 1
+	SupplyBasis_SUPPLY_BASIS_CHANNEL_ESCROW SupplyBasis = 2
+)
+
+var SupplyBasis_name = map[int32]string{
+	0: "SUPPLY_BASIS_UNSPECIFIED",
+	1: "SUPPLY_BASIS_TOTAL_SUPPLY",
+	2: "SUPPLY_BASIS_CHANNEL_ESCROW",
+}
+
+var SupplyBasis_value = map[string]int32{
+	"SUPPLY_BASIS_UNSPECIFIED":    0,
+	"SUPPLY_BASIS_TOTAL_SUPPLY":   1,
+	"SUPPLY_BASIS_CHANNEL_ESCROW": 2,
+}
+
+func (x SupplyBasis) String() string {
+	return proto.EnumName(SupplyBasis_name, int32(x))
+}
+
+func (SupplyBasis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e8c0c72281699922, []int{2}
+}
+
 // TimeframeLimit defines a limit for a specific timeframe
 type TimeframeLimit struct {
 	// max_amount is the maximum absolute amount of supply change allowed in this timeframe
 	// Value is represented as an integer (e.g., "1000000" = 1,000,000 tokens)
-	// If set to 0, this limit is disabled
+	// If both max_amount and max_percent_bps are 0, this limit is disabled
+	// If max_percent_bps is set, max_amount is optional and only applies while the supply basis is 0
 	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
 	// timeframe_type defines the type of timeframe (block, hour, day)
 	TimeframeType TimeframeType `protobuf:"varint,2,opt,name=timeframe_type,json=timeframeType,proto3,enum=ibcratelimit.TimeframeType" json:"timeframe_type,omitempty"`
@@ -70,6 +126,14 @@ type TimeframeLimit struct {
 	// For HOUR: number of hours (will be converted to blocks using block time)
 	// For DAY: number of days (will be converted to blocks using block time)
 	TimeframeDuration int64 `protobuf:"varint,3,opt,name=timeframe_duration,json=timeframeDuration,proto3" json:"timeframe_duration,omitempty"`
+	// max_percent_bps is the maximum absolute supply change allowed in this timeframe, expressed in basis points
+	// (1/100th of a percent, so 10000 = 100%) of the supply_basis amount snapshotted at the start of the window
+	// If the supply basis is 0 (e.g. a new voucher before its first receive), max_amount is used instead,
+	// or the limit is not enforced if max_amount is 0, until the supply basis becomes non-zero
+	MaxPercentBps uint64 `protobuf:"varint,4,opt,name=max_percent_bps,json=maxPercentBps,proto3" json:"max_percent_bps,omitempty"`
+	// supply_basis defines what max_percent_bps is measured against
+	// Required when max_percent_bps is set
+	SupplyBasis SupplyBasis `protobuf:"varint,5,opt,name=supply_basis,json=supplyBasis,proto3,enum=ibcratelimit.SupplyBasis" json:"supply_basis,omitempty"`
 }
 
 func (m *TimeframeLimit) Reset()         { *m = TimeframeLimit{} }
@@ -119,6 +183,20 @@ func (m *TimeframeLimit) GetTimeframeDuration() int64 {
 	return 0
 }
 
+func (m *TimeframeLimit) GetMaxPercentBps() uint64 {
+	if m != nil {
+		return m.MaxPercentBps
+	}
+	return 0
+}
+
+func (m *TimeframeLimit) GetSupplyBasis() SupplyBasis {
+	if m != nil {
+		return m.SupplyBasis
+	}
+	return SupplyBasis_SUPPLY_BASIS_UNSPECIFIED
+}
+
 // UniqueSenderLimit defines limits on unique senders per channel
 type UniqueSenderLimit struct {
 	// max_unique_senders is the maximum number of unique senders allowed in the timeframe
@@ -256,9 +334,10 @@ func (m *AddressLimit) GetTimeframeDuration() int64 {
 // RateLimitConfig defines a rate limit configuration for a specific channel and denom
 type RateLimitConfig struct {
 	// channel_id is the IBC channel ID this rate limit applies to
-	// If empty, applies to all channels
+	// If "*" (or empty), applies to all channels
 	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// denom is the denomination this rate limit applies to
+	// If "*", applies to all denoms
 	// Must be specified (empty denoms are not allowed)
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	// supply_shift_limits defines multiple timeframe limits for supply shift
@@ -270,6 +349,13 @@ type RateLimitConfig struct {
 	// address_limits defines per-address transfer limits
 	// All limits are checked, and the transfer is rejected if any limit would be exceeded
 	AddressLimits []AddressLimit `protobuf:"bytes,7,rep,name=address_limits,json=addressLimits,proto3" json:"address_limits"`
+	// window_mode defines how supply_shift_limits and address_limits track usage over their timeframes
+	// FIXED windows reset wholesale, which allows up to 2x the limit across a window boundary
+	// SLIDING windows split each timeframe into window_buckets sub-buckets and sum the buckets still in the window
+	WindowMode WindowMode `protobuf:"varint,8,opt,name=window_mode,json=windowMode,proto3,enum=ibcratelimit.WindowMode" json:"window_mode,omitempty"`
+	// window_buckets is the number of sub-buckets each timeframe is divided into
+	// Required when window_mode is SLIDING and must be unset otherwise
+	WindowBuckets int64 `protobuf:"varint,9,opt,name=window_buckets,json=windowBuckets,proto3" json:"window_buckets,omitempty"`
 }
 
 func (m *RateLimitConfig) Reset()         { *m = RateLimitConfig{} }
@@ -340,13 +426,38 @@ func (m *RateLimitConfig) GetAddressLimits() []AddressLimit {
 	return nil
 }
 
+func (m *RateLimitConfig) GetWindowMode() WindowMode {
+	if m != nil {
+		return m.WindowMode
+	}
+	return WindowMode_WINDOW_MODE_FIXED
+}
+
+func (m *RateLimitConfig) GetWindowBuckets() int64 {
+	if m != nil {
+		return m.WindowBuckets
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// rate_limits is an array of rate limit configurations
 	// Each configuration can specify channel_id, denom, and various limit types (supply_shift_limits, unique_sender_limits, address_limits)
-	// Configurations are checked in order, and the first matching config is used
+	// The most specific matching config is used: exact channel and denom, then wildcard channel with exact denom,
+	// then exact channel with wildcard denom, then wildcard channel and denom
+	// Wildcard configs track usage separately for each channel and denom they match
 	// If no config matches, the transfer is allowed (no rate limit)
 	RateLimits []RateLimitConfig `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// exempt_addresses are sender addresses (e.g. protocol treasuries) that are not subject to
+	// address_limits or unique_sender_limits. Supply shift limits still apply to their transfers
+	ExemptAddresses []string `protobuf:"bytes,2,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+	// guardian is an address, separate from the governance authority, that can instantly pause and unpause
+	// inbound and/or outbound transfers on a channel. If empty, only the governance authority can pause channels
+	Guardian string `protobuf:"bytes,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// pause_duration is the number of blocks a channel pause lasts before it automatically expires
+	// If 0, DefaultPauseDuration is used
+	PauseDuration int64 `protobuf:"varint,4,opt,name=pause_duration,json=pauseDuration,proto3" json:"pause_duration,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -389,8 +500,31 @@ func (m *Params) GetRateLimits() []RateLimitConfig {
 	return nil
 }
 
+func (m *Params) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetPauseDuration() int64 {
+	if m != nil {
+		return m.PauseDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibcratelimit.TimeframeType", TimeframeType_name, TimeframeType_value)
+	proto.RegisterEnum("ibcratelimit.WindowMode", WindowMode_name, WindowMode_value)
+	proto.RegisterEnum("ibcratelimit.SupplyBasis", SupplyBasis_name, SupplyBasis_value)
 	proto.RegisterType((*TimeframeLimit)(nil), "ibcratelimit.TimeframeLimit")
 	proto.RegisterType((*UniqueSenderLimit)(nil), "ibcratelimit.UniqueSenderLimit")
 	proto.RegisterType((*AddressLimit)(nil), "ibcratelimit.AddressLimit")
@@ -401,49 +535,65 @@ func init() {
 func init() { proto.RegisterFile("ibcratelimit/params.proto", fileDescriptor_e8c0c72281699922) }
 
 var fileDescriptor_e8c0c72281699922 = []byte{
-	// 672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6e, 0xd3, 0x4c,
-	0x18, 0xcd, 0x24, 0x6d, 0x7f, 0x75, 0xda, 0xe4, 0x4f, 0xa6, 0x01, 0x42, 0xda, 0x3a, 0x51, 0x00,
-	0x11, 0x55, 0x34, 0x96, 0xca, 0x2e, 0xb0, 0x49, 0x9a, 0x14, 0x22, 0x5a, 0x5a, 0xb9, 0xa9, 0x50,
-	0xd9, 0x58, 0x93, 0xd8, 0x49, 0x46, 0x74, 0x3c, 0xc6, 0x33, 0x96, 0x52, 0x4e, 0x80, 0x58, 0xb1,
-	0xe0, 0x00, 0x1c, 0x81, 0x63, 0x74, 0xd9, 0x25, 0x42, 0xa2, 0x42, 0xcd, 0xa2, 0x1c, 0x03, 0x79,
-	0x1c, 0xb7, 0xb6, 0xab, 0x6e, 0x00, 0x89, 0x8d, 0x35, 0xfe, 0xde, 0x7c, 0xef, 0xcd, 0x7b, 0xdf,
-	0xd8, 0xf0, 0x2e, 0xe9, 0xf5, 0x1d, 0x2c, 0xcc, 0x23, 0x42, 0x89, 0x50, 0x6d, 0xec, 0x60, 0xca,
-	0x6b, 0xb6, 0xc3, 0x04, 0x43, 0x8b, 0x61, 0xa8, 0x98, 0xc3, 0x94, 0x58, 0x4c, 0x95, 0x4f, 0x7f,
-	0x43, 0x31, 0x3f, 0x64, 0x43, 0x26, 0x97, 0xaa, 0xb7, 0xf2, 0xab, 0x95, 0x0b, 0x00, 0x33, 0x5d,
-	0x42, 0xcd, 0x81, 0x83, 0xa9, 0xb9, 0xed, 0xf5, 0xa2, 0xa7, 0x10, 0x52, 0x3c, 0xd6, 0x31, 0x65,
-	0xae, 0x25, 0x0a, 0xa0, 0x0c, 0xaa, 0xf3, 0xcd, 0xd5, 0x93, 0xb3, 0x52, 0xe2, 0xdb, 0x59, 0xe9,
-	0x56, 0x9f, 0x71, 0xca, 0x38, 0x37, 0xde, 0xd4, 0x08, 0x53, 0x29, 0x16, 0xa3, 0x5a, 0xc7, 0x12,
-	0xda, 0x3c, 0xc5, 0xe3, 0x86, 0xdc, 0x8f, 0x9a, 0x30, 0x23, 0x02, 0x3e, 0x5d, 0x1c, 0xdb, 0x66,
-	0x21, 0x59, 0x06, 0xd5, 0xcc, 0xc6, 0x72, 0x2d, 0x7c, 0xc0, 0xda, 0xa5, 0x66, 0xf7, 0xd8, 0x36,
-	0xb5, 0xb4, 0x08, 0xbf, 0xa2, 0x75, 0x88, 0xae, 0x38, 0x0c, 0xd7, 0xc1, 0x82, 0x30, 0xab, 0x90,
-	0x2a, 0x83, 0x6a, 0x4a, 0xcb, 0x5d, 0x22, 0xad, 0x29, 0x50, 0xbf, 0xff, 0xf3, 0x73, 0x09, 0x7c,
-	0xb8, 0xf8, 0xb2, 0xb6, 0x1c, 0x89, 0x27, 0x6a, 0xab, 0xf2, 0x1d, 0xc0, 0xdc, 0x81, 0x45, 0xde,
-	0xba, 0xe6, 0xbe, 0x69, 0x19, 0xa6, 0xe3, 0x9b, 0x7d, 0x04, 0x91, 0x67, 0xd6, 0x95, 0x80, 0xce,
-	0x25, 0xc2, 0xa5, 0xe9, 0x94, 0x96, 0xa5, 0x78, 0x1c, 0xee, 0xe0, 0xff, 0xc2, 0xdc, 0xc3, 0xc0,
-	0x9c, 0x12, 0x31, 0x77, 0xcd, 0x49, 0xe5, 0x7d, 0x12, 0x2e, 0x36, 0x0c, 0xc3, 0x31, 0x39, 0xf7,
-	0xad, 0xdd, 0x83, 0x69, 0xcf, 0x9a, 0x70, 0xb0, 0xc5, 0x07, 0x57, 0xae, 0x16, 0x29, 0x1e, 0x77,
-	0x83, 0x5a, 0x6c, 0xd8, 0xc9, 0x3f, 0x1e, 0x76, 0xea, 0x2f, 0xe5, 0x31, 0x73, 0x53, 0x1e, 0x95,
-	0x20, 0x8f, 0xe8, 0xb7, 0x10, 0x76, 0x5e, 0x99, 0x24, 0xe1, 0xff, 0x1a, 0x16, 0xfe, 0xe0, 0x37,
-	0x99, 0x35, 0x20, 0x43, 0xb4, 0x0a, 0x61, 0x7f, 0x84, 0x2d, 0xcb, 0x3c, 0xd2, 0x89, 0xe1, 0xdf,
-	0x6a, 0x6d, 0x7e, 0x5a, 0xe9, 0x18, 0x28, 0x0f, 0x67, 0x0d, 0xd3, 0x62, 0xd4, 0x8f, 0x40, 0xf3,
-	0x5f, 0x90, 0x06, 0x97, 0xb8, 0x6b, 0xdb, 0x47, 0xc7, 0x3a, 0x1f, 0x91, 0x81, 0xd0, 0xa5, 0x16,
-	0x2f, 0xcc, 0x96, 0x53, 0xd5, 0x85, 0x8d, 0x95, 0x1b, 0x4c, 0x4a, 0xd5, 0xe6, 0x8c, 0x17, 0xa2,
-	0x96, 0xf3, 0xdb, 0xf7, 0xbd, 0x6e, 0x59, 0xe7, 0xe8, 0x15, 0xcc, 0x47, 0x6e, 0x5b, 0x40, 0x3a,
-	0x27, 0x49, 0x4b, 0x51, 0xd2, 0x6b, 0x63, 0x9e, 0xf2, 0x22, 0x37, 0x0e, 0x70, 0xf4, 0x0c, 0x66,
-	0xb0, 0x9f, 0x42, 0x40, 0xf9, 0x9f, 0xa4, 0x2c, 0x46, 0x29, 0xc3, 0x49, 0x4d, 0xd9, 0xd2, 0x38,
-	0x54, 0xe3, 0xf5, 0x07, 0x41, 0xc4, 0x2b, 0x91, 0x88, 0x63, 0x89, 0x56, 0x3e, 0x01, 0x38, 0xb7,
-	0x27, 0x7f, 0x41, 0xa8, 0x05, 0x17, 0xbc, 0x7d, 0x81, 0x2e, 0x90, 0xba, 0xab, 0x51, 0xdd, 0x58,
-	0xfb, 0x54, 0x1a, 0x3a, 0x41, 0x99, 0xd7, 0x5b, 0x81, 0xee, 0x93, 0x21, 0x11, 0x23, 0xb7, 0x57,
-	0xeb, 0x33, 0xaa, 0xf6, 0x88, 0xe8, 0x61, 0x63, 0x68, 0xf2, 0xab, 0x55, 0x7f, 0x84, 0x89, 0xa5,
-	0x8e, 0x55, 0xd2, 0xeb, 0xaf, 0x7b, 0xed, 0xeb, 0xfe, 0xf9, 0xfc, 0xb3, 0xac, 0xbd, 0x83, 0xe9,
-	0xc8, 0x7d, 0x43, 0x0a, 0x2c, 0x76, 0x3b, 0x3b, 0xed, 0x2d, 0xad, 0xb1, 0xd3, 0xd6, 0xbb, 0x87,
-	0x7b, 0x6d, 0xfd, 0xe0, 0xe5, 0xfe, 0x5e, 0x7b, 0xb3, 0xb3, 0xd5, 0x69, 0xb7, 0xb2, 0x09, 0x54,
-	0x80, 0xf9, 0x18, 0xde, 0xdc, 0xde, 0xdd, 0x7c, 0x91, 0x05, 0xe8, 0x0e, 0x5c, 0x8a, 0x21, 0xcf,
-	0x77, 0x0f, 0xb4, 0x6c, 0x12, 0xdd, 0x86, 0x28, 0x06, 0xb4, 0x1a, 0x87, 0xd9, 0x54, 0xb3, 0x7b,
-	0x72, 0xae, 0x80, 0xd3, 0x73, 0x05, 0xfc, 0x38, 0x57, 0xc0, 0xc7, 0x89, 0x92, 0x38, 0x9d, 0x28,
-	0x89, 0xaf, 0x13, 0x25, 0xf1, 0xba, 0xfe, 0x5b, 0x96, 0xbc, 0x4f, 0x8a, 0xf7, 0xe6, 0xe4, 0xaf,
-	0xfa, 0xf1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x93, 0x3c, 0xa3, 0xfe, 0x05, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x6e, 0x22, 0x47,
+	0x10, 0xf6, 0x80, 0xd7, 0x59, 0x0a, 0x83, 0xa1, 0xed, 0xcd, 0x8e, 0xb1, 0x0d, 0x88, 0x64, 0x13,
+	0x62, 0xc5, 0x20, 0x6d, 0x4e, 0x71, 0x7c, 0xe1, 0xcf, 0xbb, 0xa3, 0x60, 0x40, 0x03, 0x16, 0x71,
+	0x2e, 0xa3, 0x86, 0x69, 0xc3, 0xc8, 0x9e, 0x9f, 0x4c, 0xf7, 0xc8, 0x38, 0xc7, 0x9c, 0xa2, 0x9c,
+	0xf2, 0x08, 0x79, 0x84, 0xbc, 0x44, 0xa4, 0xbd, 0x44, 0xda, 0x63, 0x14, 0x29, 0xab, 0xc8, 0x3e,
+	0x24, 0x8f, 0x11, 0x4d, 0x37, 0x03, 0x33, 0x58, 0x7b, 0x49, 0x22, 0xed, 0x05, 0x75, 0x7f, 0xd5,
+	0xf5, 0x75, 0x7d, 0x5f, 0x55, 0x0f, 0xb0, 0x6b, 0x8c, 0xc6, 0x2e, 0x66, 0xe4, 0xda, 0x30, 0x0d,
+	0x56, 0x75, 0xb0, 0x8b, 0x4d, 0x5a, 0x71, 0x5c, 0x9b, 0xd9, 0x68, 0x33, 0x1c, 0xca, 0x65, 0xb1,
+	0x69, 0x58, 0x76, 0x95, 0xff, 0x8a, 0x03, 0xb9, 0x9d, 0x89, 0x3d, 0xb1, 0xf9, 0xb2, 0xea, 0xaf,
+	0x04, 0x5a, 0xfa, 0x35, 0x06, 0xe9, 0x81, 0x61, 0x92, 0x4b, 0x17, 0x9b, 0xa4, 0xed, 0xe7, 0xa2,
+	0x13, 0x00, 0x13, 0xcf, 0x34, 0x6c, 0xda, 0x9e, 0xc5, 0x64, 0xa9, 0x28, 0x95, 0x13, 0xf5, 0x83,
+	0x57, 0x6f, 0x0a, 0x6b, 0xbf, 0xbf, 0x29, 0x3c, 0x19, 0xdb, 0xd4, 0xb4, 0x29, 0xd5, 0xaf, 0x2a,
+	0x86, 0x5d, 0x35, 0x31, 0x9b, 0x56, 0x14, 0x8b, 0xa9, 0x09, 0x13, 0xcf, 0x6a, 0xfc, 0x3c, 0xaa,
+	0x43, 0x9a, 0x05, 0x7c, 0x1a, 0xbb, 0x75, 0x88, 0x1c, 0x2b, 0x4a, 0xe5, 0xf4, 0xf3, 0xbd, 0x4a,
+	0xb8, 0xc0, 0xca, 0xe2, 0xce, 0xc1, 0xad, 0x43, 0xd4, 0x14, 0x0b, 0x6f, 0xd1, 0x11, 0xa0, 0x25,
+	0x87, 0xee, 0xb9, 0x98, 0x19, 0xb6, 0x25, 0xc7, 0x8b, 0x52, 0x39, 0xae, 0x66, 0x17, 0x91, 0xe6,
+	0x3c, 0x80, 0x3e, 0x82, 0x2d, 0xbf, 0x60, 0x87, 0xb8, 0x63, 0x62, 0x31, 0x6d, 0xe4, 0x50, 0x79,
+	0xbd, 0x28, 0x95, 0xd7, 0xd5, 0x94, 0x89, 0x67, 0x3d, 0x81, 0xd6, 0x1d, 0x8a, 0x4e, 0x60, 0x93,
+	0x7a, 0x8e, 0x73, 0x7d, 0xab, 0x8d, 0x30, 0x35, 0xa8, 0xfc, 0x88, 0x17, 0xb6, 0x1b, 0x2d, 0xac,
+	0xcf, 0x4f, 0xd4, 0xfd, 0x03, 0x6a, 0x92, 0x2e, 0x37, 0xc7, 0x1f, 0xfe, 0xfd, 0x53, 0x41, 0xfa,
+	0xe1, 0xaf, 0x9f, 0x0f, 0xf7, 0x22, 0x4d, 0x88, 0x9a, 0x57, 0xfa, 0x43, 0x82, 0xec, 0xb9, 0x65,
+	0x7c, 0xe3, 0x91, 0x3e, 0xb1, 0x74, 0xe2, 0x0a, 0x4b, 0x3f, 0x05, 0xe4, 0x57, 0xe8, 0xf1, 0x80,
+	0x46, 0x79, 0x84, 0x72, 0x6b, 0xe3, 0x6a, 0xc6, 0xc4, 0xb3, 0x70, 0x06, 0x7d, 0x07, 0x16, 0x1e,
+	0x7f, 0x1c, 0x88, 0xcb, 0x47, 0xc4, 0x3d, 0x50, 0x52, 0xfa, 0x3e, 0x06, 0x9b, 0x35, 0x5d, 0x77,
+	0x09, 0xa5, 0x42, 0xda, 0x07, 0xe0, 0xbb, 0xac, 0x31, 0x17, 0x5b, 0xf4, 0x72, 0xa9, 0x6a, 0xd3,
+	0xc4, 0xb3, 0x41, 0x80, 0xad, 0x8c, 0x54, 0xec, 0x3f, 0x8f, 0x54, 0xfc, 0x7f, 0xf2, 0x63, 0xfd,
+	0x6d, 0x7e, 0x94, 0x02, 0x3f, 0xa2, 0x2f, 0x2e, 0xac, 0xbc, 0xf4, 0x4b, 0x1c, 0xb6, 0x54, 0xcc,
+	0x44, 0xe3, 0x1b, 0xb6, 0x75, 0x69, 0x4c, 0xd0, 0x01, 0xc0, 0x78, 0x8a, 0x2d, 0x8b, 0x5c, 0x6b,
+	0x86, 0x2e, 0xde, 0x8e, 0x9a, 0x98, 0x23, 0x8a, 0x8e, 0x76, 0xe0, 0x91, 0x4e, 0x2c, 0xdb, 0x14,
+	0x16, 0xa8, 0x62, 0x83, 0x54, 0xd8, 0x9e, 0xcf, 0x25, 0x9d, 0x1a, 0x97, 0x4c, 0xe3, 0x77, 0xf9,
+	0xe3, 0x19, 0x2f, 0x27, 0x9f, 0xef, 0xbf, 0x45, 0x24, 0xbf, 0xb5, 0xbe, 0xee, 0x9b, 0xa8, 0x66,
+	0x45, 0x7a, 0xdf, 0xcf, 0xe6, 0x38, 0x45, 0x43, 0xd8, 0x89, 0x4c, 0x5b, 0x40, 0xba, 0xc1, 0x49,
+	0x0b, 0x51, 0xd2, 0x07, 0x6d, 0x9e, 0xf3, 0x22, 0x6f, 0x35, 0x40, 0xd1, 0x0b, 0x48, 0x63, 0xe1,
+	0x42, 0x40, 0xf9, 0x1e, 0xa7, 0xcc, 0x45, 0x29, 0xc3, 0x4e, 0xcd, 0xd9, 0x52, 0x38, 0x84, 0x51,
+	0xf4, 0x39, 0x24, 0x6f, 0x0c, 0x4b, 0xb7, 0x6f, 0x34, 0xd3, 0xd6, 0x89, 0xfc, 0x98, 0xb7, 0x54,
+	0x8e, 0xb2, 0x0c, 0xf9, 0x81, 0x33, 0x5b, 0x27, 0x2a, 0xdc, 0x2c, 0xd6, 0xe8, 0x19, 0xa4, 0xe7,
+	0xa9, 0x23, 0x6f, 0x7c, 0x45, 0x18, 0x95, 0x13, 0xbc, 0x91, 0x29, 0x81, 0xd6, 0x05, 0x78, 0xfc,
+	0x2c, 0x68, 0xe2, 0x7e, 0xa4, 0x89, 0x2b, 0x3d, 0x2b, 0x7d, 0x17, 0x83, 0x8d, 0x1e, 0xff, 0x94,
+	0xa2, 0x26, 0x24, 0xfd, 0x73, 0x81, 0x32, 0x89, 0x2b, 0x3b, 0x88, 0xd6, 0xb4, 0x92, 0x3e, 0x17,
+	0x07, 0x6e, 0x00, 0x53, 0xf4, 0x09, 0x64, 0xc8, 0x8c, 0x98, 0x0e, 0xd3, 0xe6, 0x8a, 0x09, 0x95,
+	0x63, 0xc5, 0x78, 0x39, 0xa1, 0x6e, 0x09, 0xbc, 0x16, 0xc0, 0x28, 0x07, 0x8f, 0x27, 0x1e, 0x76,
+	0x75, 0x03, 0x8b, 0xc7, 0x99, 0x50, 0x17, 0x7b, 0x5f, 0xa5, 0x83, 0x3d, 0xfa, 0x60, 0x5c, 0x53,
+	0x1c, 0x5d, 0x8c, 0x6a, 0x33, 0x50, 0xf9, 0xc5, 0xc4, 0x60, 0x53, 0x6f, 0x54, 0x19, 0xdb, 0x66,
+	0x75, 0x64, 0xb0, 0x11, 0xd6, 0x27, 0x84, 0x2e, 0x57, 0xe3, 0x29, 0x36, 0xac, 0xea, 0xac, 0x6a,
+	0x8c, 0xc6, 0x47, 0x7e, 0xb1, 0x47, 0xc2, 0x0d, 0xa1, 0xfc, 0xf0, 0x5b, 0x48, 0x45, 0xde, 0x0f,
+	0xca, 0x43, 0x6e, 0xa0, 0x9c, 0xb5, 0x4e, 0xd5, 0xda, 0x59, 0x4b, 0x1b, 0x5c, 0xf4, 0x5a, 0xda,
+	0x79, 0xa7, 0xdf, 0x6b, 0x35, 0x94, 0x53, 0xa5, 0xd5, 0xcc, 0xac, 0x21, 0x19, 0x76, 0x56, 0xe2,
+	0xf5, 0x76, 0xb7, 0xf1, 0x65, 0x46, 0x42, 0x4f, 0x61, 0x7b, 0x25, 0xf2, 0xb2, 0x7b, 0xae, 0x66,
+	0x62, 0xe8, 0x7d, 0x40, 0x2b, 0x81, 0x66, 0xed, 0x22, 0x13, 0x3f, 0x3c, 0x01, 0x58, 0x36, 0x1a,
+	0x3d, 0x81, 0xec, 0x50, 0xe9, 0x34, 0xbb, 0x43, 0xed, 0xac, 0xdb, 0x6c, 0x69, 0xa7, 0xca, 0x57,
+	0xfc, 0xbe, 0xa7, 0xb0, 0x1d, 0x86, 0xfb, 0x6d, 0xa5, 0xa9, 0x74, 0x5e, 0x64, 0xa4, 0xc3, 0x2b,
+	0x48, 0x86, 0xbe, 0xd9, 0x68, 0x1f, 0xe4, 0xfe, 0x79, 0xaf, 0xd7, 0xbe, 0xd0, 0xea, 0xb5, 0xbe,
+	0xd2, 0x5f, 0xa9, 0xfa, 0x00, 0x76, 0x23, 0xd1, 0x41, 0x77, 0x50, 0x6b, 0x6b, 0x02, 0xca, 0x48,
+	0xa8, 0x00, 0x7b, 0x91, 0x70, 0xe3, 0x65, 0xad, 0xd3, 0x69, 0xb5, 0xb5, 0x56, 0xbf, 0xa1, 0x76,
+	0x87, 0x99, 0x58, 0x7d, 0xf0, 0xea, 0x2e, 0x2f, 0xbd, 0xbe, 0xcb, 0x4b, 0x7f, 0xde, 0xe5, 0xa5,
+	0x1f, 0xef, 0xf3, 0x6b, 0xaf, 0xef, 0xf3, 0x6b, 0xbf, 0xdd, 0xe7, 0xd7, 0xbe, 0x3e, 0xfe, 0x57,
+	0xee, 0xfb, 0x5f, 0x33, 0x3a, 0xda, 0xe0, 0xff, 0xc5, 0x9f, 0xfd, 0x33, 0x00, 0x3b, 0x3c, 0x94,
+	0x20, 0xdf, 0x07, 0x00, 0x00,
 }
 
 func (this *TimeframeLimit) Equal(that interface{}) bool {
@@ -474,6 +624,12 @@ func (this *TimeframeLimit) Equal(that interface{}) bool {
 	if this.TimeframeDuration != that1.TimeframeDuration {
 		return false
 	}
+	if this.MaxPercentBps != that1.MaxPercentBps {
+		return false
+	}
+	if this.SupplyBasis != that1.SupplyBasis {
+		return false
+	}
 	return true
 }
 func (this *UniqueSenderLimit) Equal(that interface{}) bool {
@@ -588,6 +744,12 @@ func (this *RateLimitConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.WindowMode != that1.WindowMode {
+		return false
+	}
+	if this.WindowBuckets != that1.WindowBuckets {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -617,6 +779,20 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ExemptAddresses) != len(that1.ExemptAddresses) {
+		return false
+	}
+	for i := range this.ExemptAddresses {
+		if this.ExemptAddresses[i] != that1.ExemptAddresses[i] {
+			return false
+		}
+	}
+	if this.Guardian != that1.Guardian {
+		return false
+	}
+	if this.PauseDuration != that1.PauseDuration {
+		return false
+	}
 	return true
 }
 func (m *TimeframeLimit) Marshal() (dAtA []byte, err error) {
@@ -639,6 +815,16 @@ func (m *TimeframeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SupplyBasis != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SupplyBasis))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPercentBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPercentBps))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeframeDuration != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TimeframeDuration))
 		i--
@@ -768,6 +954,16 @@ func (m *RateLimitConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WindowBuckets != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowBuckets))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.WindowMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowMode))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.AddressLimits) > 0 {
 		for iNdEx := len(m.AddressLimits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -847,6 +1043,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PauseDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PauseDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.RateLimits) > 0 {
 		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -889,6 +1106,12 @@ func (m *TimeframeLimit) Size() (n int) {
 	if m.TimeframeDuration != 0 {
 		n += 1 + sovParams(uint64(m.TimeframeDuration))
 	}
+	if m.MaxPercentBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxPercentBps))
+	}
+	if m.SupplyBasis != 0 {
+		n += 1 + sovParams(uint64(m.SupplyBasis))
+	}
 	return n
 }
 
@@ -962,6 +1185,12 @@ func (m *RateLimitConfig) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.WindowMode != 0 {
+		n += 1 + sovParams(uint64(m.WindowMode))
+	}
+	if m.WindowBuckets != 0 {
+		n += 1 + sovParams(uint64(m.WindowBuckets))
+	}
 	return n
 }
 
@@ -977,6 +1206,19 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PauseDuration != 0 {
+		n += 1 + sovParams(uint64(m.PauseDuration))
+	}
 	return n
 }
 
@@ -1087,6 +1329,44 @@ func (m *TimeframeLimit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentBps", wireType)
+			}
+			m.MaxPercentBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPercentBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyBasis", wireType)
+			}
+			m.SupplyBasis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyBasis |= SupplyBasis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1551,6 +1831,44 @@ func (m *RateLimitConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMode", wireType)
+			}
+			m.WindowMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowMode |= WindowMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBuckets", wireType)
+			}
+			m.WindowBuckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBuckets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1635,6 +1953,89 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseDuration", wireType)
+			}
+			m.PauseDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,3970 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibcratelimit/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryRateLimitsRequest is request type for the Query/RateLimits RPC method.
+// Filters follow the same wildcard matching as transfers: a filter also matches wildcard configs,
+// and if both filters are set only the config that applies to that channel and denom is returned.
+type QueryRateLimitsRequest struct {
+	// channel_id filters configs by the channel they apply to. If empty, configs for all channels are returned.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom filters configs by the denom they apply to. If empty, configs for all denoms are returned.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{2}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryRateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryRateLimitsResponse is response type for the Query/RateLimits RPC method.
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimitConfig `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{3}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimitConfig {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+// QueryRateLimitStatusRequest is request type for the Query/RateLimitStatus RPC method.
+type QueryRateLimitStatusRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRateLimitStatusRequest) Reset()         { *m = QueryRateLimitStatusRequest{} }
+func (m *QueryRateLimitStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitStatusRequest) ProtoMessage()    {}
+func (*QueryRateLimitStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{4}
+}
+func (m *QueryRateLimitStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitStatusRequest.Merge(m, src)
+}
+func (m *QueryRateLimitStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitStatusRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitStatusRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryRateLimitStatusRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// SupplyShiftLimitStatus reports the current state of a single supply shift limit.
+// Windows that have expired (but have not yet been reset by a transfer) are reported as fresh windows
+// starting at the current block height, matching what the next transfer will observe.
+// For sliding windows, window_start is the start of the oldest bucket still in the window and window_end
+// is the height at which that bucket slides out.
+type SupplyShiftLimitStatus struct {
+	Limit TimeframeLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// net_flow is the net amount transferred (inflow - outflow) in the current window
+	NetFlow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=net_flow,json=netFlow,proto3,customtype=cosmossdk.io/math.Int" json:"net_flow"`
+	// window_start is the block height when the current window started
+	WindowStart int64 `protobuf:"varint,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// window_end is the block height at which the current window expires
+	WindowEnd int64 `protobuf:"varint,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// remaining_inflow is the maximum amount that can currently be received without exceeding the limit
+	RemainingInflow cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_inflow,json=remainingInflow,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_inflow"`
+	// remaining_outflow is the maximum amount that can currently be sent without exceeding the limit
+	RemainingOutflow cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=remaining_outflow,json=remainingOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_outflow"`
+	// effective_max_amount is the limit in absolute terms
+	// Equal to limit.max_amount, or for percentage-based limits, max_percent_bps of the window's supply snapshot
+	EffectiveMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=effective_max_amount,json=effectiveMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"effective_max_amount"`
+	// enforced is false if the limit is percentage-based, its supply basis is 0 and it has no max_amount fallback,
+	// in which case transfers are not limited by it until the supply basis becomes non-zero
+	Enforced bool `protobuf:"varint,8,opt,name=enforced,proto3" json:"enforced,omitempty"`
+}
+
+func (m *SupplyShiftLimitStatus) Reset()         { *m = SupplyShiftLimitStatus{} }
+func (m *SupplyShiftLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*SupplyShiftLimitStatus) ProtoMessage()    {}
+func (*SupplyShiftLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{5}
+}
+func (m *SupplyShiftLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyShiftLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyShiftLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyShiftLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyShiftLimitStatus.Merge(m, src)
+}
+func (m *SupplyShiftLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyShiftLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyShiftLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyShiftLimitStatus proto.InternalMessageInfo
+
+func (m *SupplyShiftLimitStatus) GetLimit() TimeframeLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return TimeframeLimit{}
+}
+
+func (m *SupplyShiftLimitStatus) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *SupplyShiftLimitStatus) GetWindowEnd() int64 {
+	if m != nil {
+		return m.WindowEnd
+	}
+	return 0
+}
+
+func (m *SupplyShiftLimitStatus) GetEnforced() bool {
+	if m != nil {
+		return m.Enforced
+	}
+	return false
+}
+
+// UniqueSenderLimitStatus reports the current state of a single unique sender limit.
+type UniqueSenderLimitStatus struct {
+	Limit UniqueSenderLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// unique_senders is the number of unique senders seen in the current window
+	UniqueSenders int64 `protobuf:"varint,2,opt,name=unique_senders,json=uniqueSenders,proto3" json:"unique_senders,omitempty"`
+	// window_start is the block height when the current window started
+	WindowStart int64 `protobuf:"varint,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// window_end is the block height at which the current window expires
+	WindowEnd int64 `protobuf:"varint,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// remaining_senders is the number of new unique senders still allowed in the current window
+	RemainingSenders int64 `protobuf:"varint,5,opt,name=remaining_senders,json=remainingSenders,proto3" json:"remaining_senders,omitempty"`
+}
+
+func (m *UniqueSenderLimitStatus) Reset()         { *m = UniqueSenderLimitStatus{} }
+func (m *UniqueSenderLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*UniqueSenderLimitStatus) ProtoMessage()    {}
+func (*UniqueSenderLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{6}
+}
+func (m *UniqueSenderLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UniqueSenderLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UniqueSenderLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UniqueSenderLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniqueSenderLimitStatus.Merge(m, src)
+}
+func (m *UniqueSenderLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *UniqueSenderLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniqueSenderLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UniqueSenderLimitStatus proto.InternalMessageInfo
+
+func (m *UniqueSenderLimitStatus) GetLimit() UniqueSenderLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return UniqueSenderLimit{}
+}
+
+func (m *UniqueSenderLimitStatus) GetUniqueSenders() int64 {
+	if m != nil {
+		return m.UniqueSenders
+	}
+	return 0
+}
+
+func (m *UniqueSenderLimitStatus) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *UniqueSenderLimitStatus) GetWindowEnd() int64 {
+	if m != nil {
+		return m.WindowEnd
+	}
+	return 0
+}
+
+func (m *UniqueSenderLimitStatus) GetRemainingSenders() int64 {
+	if m != nil {
+		return m.RemainingSenders
+	}
+	return 0
+}
+
+// QueryRateLimitStatusResponse is response type for the Query/RateLimitStatus RPC method.
+type QueryRateLimitStatusResponse struct {
+	// rate_limit is the config that applies to the channel and denom
+	RateLimit RateLimitConfig `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// supply_shift_limits has one entry per enabled supply shift limit in the config
+	SupplyShiftLimits []SupplyShiftLimitStatus `protobuf:"bytes,2,rep,name=supply_shift_limits,json=supplyShiftLimits,proto3" json:"supply_shift_limits"`
+	// unique_sender_limits has one entry per enabled unique sender limit in the config
+	UniqueSenderLimits []UniqueSenderLimitStatus `protobuf:"bytes,3,rep,name=unique_sender_limits,json=uniqueSenderLimits,proto3" json:"unique_sender_limits"`
+	// exempt_addresses are the senders that are neither counted towards nor limited by unique_sender_limits
+	ExemptAddresses []string `protobuf:"bytes,4,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+}
+
+func (m *QueryRateLimitStatusResponse) Reset()         { *m = QueryRateLimitStatusResponse{} }
+func (m *QueryRateLimitStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitStatusResponse) ProtoMessage()    {}
+func (*QueryRateLimitStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{7}
+}
+func (m *QueryRateLimitStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitStatusResponse.Merge(m, src)
+}
+func (m *QueryRateLimitStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitStatusResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitStatusResponse) GetRateLimit() RateLimitConfig {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimitConfig{}
+}
+
+func (m *QueryRateLimitStatusResponse) GetSupplyShiftLimits() []SupplyShiftLimitStatus {
+	if m != nil {
+		return m.SupplyShiftLimits
+	}
+	return nil
+}
+
+func (m *QueryRateLimitStatusResponse) GetUniqueSenderLimits() []UniqueSenderLimitStatus {
+	if m != nil {
+		return m.UniqueSenderLimits
+	}
+	return nil
+}
+
+func (m *QueryRateLimitStatusResponse) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
+// QueryAddressUsageRequest is request type for the Query/AddressUsage RPC method.
+type QueryAddressUsageRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAddressUsageRequest) Reset()         { *m = QueryAddressUsageRequest{} }
+func (m *QueryAddressUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressUsageRequest) ProtoMessage()    {}
+func (*QueryAddressUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{8}
+}
+func (m *QueryAddressUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressUsageRequest.Merge(m, src)
+}
+func (m *QueryAddressUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressUsageRequest proto.InternalMessageInfo
+
+func (m *QueryAddressUsageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAddressUsageRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryAddressUsageRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AddressLimitStatus reports an address's usage of a single address limit.
+// Remaining values are only meaningful for the enforced parts of the limit (max_transfers / max_amount > 0).
+type AddressLimitStatus struct {
+	Limit AddressLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// transfer_count is the number of transfers made by the address in the current window
+	TransferCount int64 `protobuf:"varint,2,opt,name=transfer_count,json=transferCount,proto3" json:"transfer_count,omitempty"`
+	// total_amount is the total amount transferred by the address in the current window
+	TotalAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_amount"`
+	// window_start is the block height when the current window started
+	WindowStart int64 `protobuf:"varint,4,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// window_end is the block height at which the current window expires
+	WindowEnd int64 `protobuf:"varint,5,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// remaining_transfers is the number of transfers still allowed in the current window
+	RemainingTransfers int64 `protobuf:"varint,6,opt,name=remaining_transfers,json=remainingTransfers,proto3" json:"remaining_transfers,omitempty"`
+	// remaining_amount is the amount still allowed in the current window
+	RemainingAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_amount"`
+	// transfers_enforced is false if max_transfers is 0, in which case the transfer count is not limited
+	TransfersEnforced bool `protobuf:"varint,8,opt,name=transfers_enforced,json=transfersEnforced,proto3" json:"transfers_enforced,omitempty"`
+	// amount_enforced is false if max_amount is 0, in which case the transferred amount is not limited
+	AmountEnforced bool `protobuf:"varint,9,opt,name=amount_enforced,json=amountEnforced,proto3" json:"amount_enforced,omitempty"`
+}
+
+func (m *AddressLimitStatus) Reset()         { *m = AddressLimitStatus{} }
+func (m *AddressLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*AddressLimitStatus) ProtoMessage()    {}
+func (*AddressLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{9}
+}
+func (m *AddressLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressLimitStatus.Merge(m, src)
+}
+func (m *AddressLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressLimitStatus proto.InternalMessageInfo
+
+func (m *AddressLimitStatus) GetLimit() AddressLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return AddressLimit{}
+}
+
+func (m *AddressLimitStatus) GetTransferCount() int64 {
+	if m != nil {
+		return m.TransferCount
+	}
+	return 0
+}
+
+func (m *AddressLimitStatus) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *AddressLimitStatus) GetWindowEnd() int64 {
+	if m != nil {
+		return m.WindowEnd
+	}
+	return 0
+}
+
+func (m *AddressLimitStatus) GetRemainingTransfers() int64 {
+	if m != nil {
+		return m.RemainingTransfers
+	}
+	return 0
+}
+
+func (m *AddressLimitStatus) GetTransfersEnforced() bool {
+	if m != nil {
+		return m.TransfersEnforced
+	}
+	return false
+}
+
+func (m *AddressLimitStatus) GetAmountEnforced() bool {
+	if m != nil {
+		return m.AmountEnforced
+	}
+	return false
+}
+
+// QueryAddressUsageResponse is response type for the Query/AddressUsage RPC method.
+type QueryAddressUsageResponse struct {
+	// rate_limit is the config that applies to the channel and denom
+	RateLimit RateLimitConfig `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// address_limits has one entry per enabled address limit in the config, and is empty if the address is exempt
+	AddressLimits []AddressLimitStatus `protobuf:"bytes,2,rep,name=address_limits,json=addressLimits,proto3" json:"address_limits"`
+	// exempt is true if the address is exempt from address and unique sender limits
+	Exempt bool `protobuf:"varint,3,opt,name=exempt,proto3" json:"exempt,omitempty"`
+}
+
+func (m *QueryAddressUsageResponse) Reset()         { *m = QueryAddressUsageResponse{} }
+func (m *QueryAddressUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressUsageResponse) ProtoMessage()    {}
+func (*QueryAddressUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{10}
+}
+func (m *QueryAddressUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressUsageResponse.Merge(m, src)
+}
+func (m *QueryAddressUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressUsageResponse proto.InternalMessageInfo
+
+func (m *QueryAddressUsageResponse) GetRateLimit() RateLimitConfig {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimitConfig{}
+}
+
+func (m *QueryAddressUsageResponse) GetAddressLimits() []AddressLimitStatus {
+	if m != nil {
+		return m.AddressLimits
+	}
+	return nil
+}
+
+func (m *QueryAddressUsageResponse) GetExempt() bool {
+	if m != nil {
+		return m.Exempt
+	}
+	return false
+}
+
+// QueryChannelPauseRequest is request type for the Query/ChannelPause RPC method.
+type QueryChannelPauseRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelPauseRequest) Reset()         { *m = QueryChannelPauseRequest{} }
+func (m *QueryChannelPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPauseRequest) ProtoMessage()    {}
+func (*QueryChannelPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{11}
+}
+func (m *QueryChannelPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPauseRequest.Merge(m, src)
+}
+func (m *QueryChannelPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelPauseRequest proto.InternalMessageInfo
+
+func (m *QueryChannelPauseRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryChannelPauseResponse is response type for the Query/ChannelPause RPC method.
+type QueryChannelPauseResponse struct {
+	// paused is false if the channel has no pause or the pause has expired
+	Paused bool         `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Pause  ChannelPause `protobuf:"bytes,2,opt,name=pause,proto3" json:"pause"`
+}
+
+func (m *QueryChannelPauseResponse) Reset()         { *m = QueryChannelPauseResponse{} }
+func (m *QueryChannelPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPauseResponse) ProtoMessage()    {}
+func (*QueryChannelPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d709ba7db9feb0, []int{12}
+}
+func (m *QueryChannelPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPauseResponse.Merge(m, src)
+}
+func (m *QueryChannelPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelPauseResponse proto.InternalMessageInfo
+
+func (m *QueryChannelPauseResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryChannelPauseResponse) GetPause() ChannelPause {
+	if m != nil {
+		return m.Pause
+	}
+	return ChannelPause{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibcratelimit.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibcratelimit.QueryParamsResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "ibcratelimit.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "ibcratelimit.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryRateLimitStatusRequest)(nil), "ibcratelimit.QueryRateLimitStatusRequest")
+	proto.RegisterType((*SupplyShiftLimitStatus)(nil), "ibcratelimit.SupplyShiftLimitStatus")
+	proto.RegisterType((*UniqueSenderLimitStatus)(nil), "ibcratelimit.UniqueSenderLimitStatus")
+	proto.RegisterType((*QueryRateLimitStatusResponse)(nil), "ibcratelimit.QueryRateLimitStatusResponse")
+	proto.RegisterType((*QueryAddressUsageRequest)(nil), "ibcratelimit.QueryAddressUsageRequest")
+	proto.RegisterType((*AddressLimitStatus)(nil), "ibcratelimit.AddressLimitStatus")
+	proto.RegisterType((*QueryAddressUsageResponse)(nil), "ibcratelimit.QueryAddressUsageResponse")
+	proto.RegisterType((*QueryChannelPauseRequest)(nil), "ibcratelimit.QueryChannelPauseRequest")
+	proto.RegisterType((*QueryChannelPauseResponse)(nil), "ibcratelimit.QueryChannelPauseResponse")
+}
+
+func init() { proto.RegisterFile("ibcratelimit/query.proto", fileDescriptor_35d709ba7db9feb0) }
+
+var fileDescriptor_35d709ba7db9feb0 = []byte{
+	// 1127 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5d, 0x4f, 0xdc, 0x46,
+	0x17, 0xc6, 0x6b, 0x76, 0xb3, 0x7b, 0x20, 0x10, 0x86, 0x7d, 0x89, 0xd9, 0x17, 0x16, 0x62, 0x85,
+	0x42, 0x5a, 0xb1, 0x96, 0xe8, 0x87, 0xf2, 0x21, 0x55, 0x01, 0x9a, 0xa8, 0x54, 0x45, 0x49, 0x0d,
+	0xb9, 0x89, 0x54, 0x59, 0xc3, 0x7a, 0x76, 0xb1, 0xb2, 0x9e, 0x59, 0x3c, 0xe3, 0x02, 0x8a, 0x72,
+	0x53, 0xf5, 0x07, 0x54, 0xaa, 0xaa, 0x5e, 0x56, 0xea, 0x4d, 0xff, 0x49, 0x85, 0x7a, 0x95, 0xaa,
+	0x37, 0x55, 0x2f, 0xa2, 0x0a, 0x7a, 0xd9, 0x1f, 0x51, 0x79, 0x3c, 0xde, 0xb5, 0x17, 0x13, 0xb6,
+	0x6d, 0xee, 0x3c, 0xe7, 0xe3, 0x39, 0x8f, 0x67, 0xce, 0x73, 0x3c, 0x06, 0xc3, 0xdb, 0x6b, 0x06,
+	0x58, 0x90, 0x8e, 0xe7, 0x7b, 0xc2, 0x3a, 0x08, 0x49, 0x70, 0xdc, 0xe8, 0x06, 0x4c, 0x30, 0x34,
+	0x9e, 0xf6, 0xd4, 0xaa, 0x6d, 0xd6, 0x66, 0xd2, 0x61, 0x45, 0x4f, 0x71, 0x4c, 0x6d, 0xae, 0xcd,
+	0x58, 0xbb, 0x43, 0x2c, 0xdc, 0xf5, 0x2c, 0x4c, 0x29, 0x13, 0x58, 0x78, 0x8c, 0x72, 0xe5, 0x9d,
+	0xcd, 0x60, 0x77, 0x71, 0x80, 0xfd, 0xc4, 0x95, 0x2d, 0x2b, 0x8e, 0xbb, 0x44, 0x79, 0xcc, 0x2a,
+	0xa0, 0xcf, 0x22, 0x16, 0x8f, 0x65, 0xb8, 0x4d, 0x0e, 0x42, 0xc2, 0x85, 0xb9, 0x05, 0xd3, 0x19,
+	0x2b, 0xef, 0x32, 0xca, 0x09, 0x5a, 0x83, 0x52, 0x0c, 0x6b, 0x68, 0x8b, 0xda, 0xca, 0xd8, 0x5a,
+	0xb5, 0x91, 0xc6, 0x6d, 0xc4, 0xd1, 0x1b, 0xa3, 0x27, 0xaf, 0x16, 0x46, 0x6c, 0x15, 0x69, 0x6e,
+	0xc3, 0x8c, 0x84, 0xb2, 0xb1, 0x20, 0x9f, 0x46, 0x61, 0x49, 0x11, 0x34, 0x0f, 0xd0, 0xdc, 0xc7,
+	0x94, 0x92, 0x8e, 0xe3, 0xb9, 0x12, 0xb1, 0x62, 0x57, 0x94, 0x65, 0xcb, 0x45, 0x55, 0x28, 0xba,
+	0x84, 0x32, 0xdf, 0x28, 0x48, 0x4f, 0xbc, 0x30, 0x1d, 0xb8, 0x7e, 0x0e, 0x4e, 0xb1, 0xfb, 0x08,
+	0xc6, 0x22, 0x2e, 0x8e, 0x24, 0x13, 0x51, 0xd4, 0x57, 0xc6, 0xd6, 0xe6, 0xb3, 0x14, 0x7b, 0x69,
+	0x9b, 0x8c, 0xb6, 0xbc, 0xb6, 0xe2, 0x0a, 0x41, 0x0f, 0xcd, 0xb4, 0xe1, 0xff, 0xd9, 0x02, 0x3b,
+	0x02, 0x8b, 0xf0, 0xbf, 0x91, 0xfe, 0x45, 0x87, 0x99, 0x9d, 0xb0, 0xdb, 0xed, 0x1c, 0xef, 0xec,
+	0x7b, 0x2d, 0x91, 0x82, 0x45, 0xb7, 0xa1, 0x28, 0x99, 0xa9, 0x1d, 0x9d, 0xcb, 0xd2, 0xdd, 0xf5,
+	0x7c, 0xd2, 0x0a, 0xb0, 0x1f, 0x33, 0x51, 0x6c, 0xe3, 0x04, 0x74, 0x1b, 0xca, 0x94, 0x08, 0xa7,
+	0xd5, 0x61, 0x87, 0x71, 0xb5, 0x8d, 0xf9, 0xc8, 0xfd, 0xfb, 0xab, 0x85, 0xff, 0x35, 0x19, 0xf7,
+	0x19, 0xe7, 0xee, 0xb3, 0x86, 0xc7, 0x2c, 0x1f, 0x8b, 0xfd, 0xc6, 0x16, 0x15, 0xf6, 0x15, 0x4a,
+	0xc4, 0xc3, 0x0e, 0x3b, 0x44, 0x37, 0x60, 0xfc, 0xd0, 0xa3, 0x2e, 0x3b, 0x74, 0xb8, 0xc0, 0x81,
+	0x30, 0xf4, 0x45, 0x6d, 0x45, 0xb7, 0xc7, 0x62, 0xdb, 0x4e, 0x64, 0x8a, 0x5e, 0x53, 0x85, 0x10,
+	0xea, 0x1a, 0xa3, 0x32, 0xa0, 0x12, 0x5b, 0x1e, 0x50, 0x17, 0x7d, 0x0c, 0xd7, 0x02, 0xe2, 0x63,
+	0x8f, 0x7a, 0xb4, 0xed, 0x78, 0x54, 0x72, 0x28, 0x0e, 0xc3, 0x61, 0xb2, 0x97, 0xb6, 0x25, 0xb3,
+	0xd0, 0x27, 0x30, 0xd5, 0x47, 0x62, 0xa1, 0x90, 0x50, 0xa5, 0x61, 0xa0, 0xfa, 0x0c, 0x1e, 0xc5,
+	0x69, 0xe8, 0x11, 0x54, 0x49, 0xab, 0x45, 0x9a, 0xc2, 0xfb, 0x82, 0x38, 0x3e, 0x3e, 0x72, 0xb0,
+	0xcf, 0x42, 0x2a, 0x8c, 0x2b, 0xc3, 0xc0, 0xa1, 0x5e, 0xea, 0x36, 0x3e, 0x5a, 0x97, 0x89, 0xa8,
+	0x06, 0x65, 0x42, 0x5b, 0x2c, 0x68, 0x12, 0xd7, 0x28, 0x2f, 0x6a, 0x2b, 0x65, 0xbb, 0xb7, 0x36,
+	0xff, 0xd2, 0xe0, 0xfa, 0x13, 0xea, 0x1d, 0x84, 0x64, 0x87, 0x50, 0x97, 0x04, 0xe9, 0x43, 0xbd,
+	0x97, 0x3d, 0xd4, 0x85, 0xec, 0xa1, 0x9e, 0xcb, 0xca, 0x9e, 0xeb, 0x12, 0x4c, 0x84, 0x32, 0xc2,
+	0xe1, 0x32, 0x84, 0xcb, 0xd3, 0xd5, 0xed, 0xab, 0x61, 0x2a, 0x8f, 0xbf, 0x81, 0x43, 0x7c, 0x27,
+	0xbd, 0xf5, 0x49, 0xad, 0xa2, 0x8c, 0xea, 0xef, 0xad, 0x2a, 0x67, 0x9e, 0x14, 0x60, 0x2e, 0x5f,
+	0x17, 0x4a, 0x7d, 0x1b, 0x00, 0x7d, 0xf5, 0xa9, 0x17, 0x1f, 0x4a, 0x7c, 0x95, 0x9e, 0xf8, 0xd0,
+	0x53, 0x98, 0xe6, 0x52, 0x26, 0x0e, 0x8f, 0x74, 0x92, 0x28, 0xb9, 0x20, 0x95, 0x7c, 0x33, 0x0b,
+	0x96, 0xaf, 0x27, 0x85, 0x39, 0xc5, 0x07, 0xbc, 0x1c, 0x7d, 0x0e, 0xd5, 0xcc, 0xb6, 0x26, 0xe0,
+	0xba, 0x04, 0x5f, 0xba, 0xe4, 0x88, 0x32, 0xe8, 0x28, 0x1c, 0x74, 0x73, 0x74, 0x0b, 0xae, 0x91,
+	0x23, 0xe2, 0x77, 0x85, 0x83, 0x5d, 0x37, 0x20, 0x9c, 0x13, 0x6e, 0x8c, 0x2e, 0xea, 0x2b, 0x15,
+	0x7b, 0x32, 0xb6, 0xaf, 0x27, 0x66, 0xd3, 0x03, 0x43, 0xee, 0xa4, 0xb2, 0x3c, 0xe1, 0xb8, 0x4d,
+	0x92, 0xf1, 0x62, 0xc0, 0x15, 0x95, 0xaf, 0x66, 0x4b, 0xb2, 0x1c, 0x18, 0x3c, 0x85, 0x0b, 0x07,
+	0x8f, 0x9e, 0x1e, 0x3c, 0x3f, 0xeb, 0x80, 0x54, 0x99, 0x74, 0x7f, 0x7e, 0x90, 0xed, 0xcf, 0x5a,
+	0xf6, 0xe5, 0xd3, 0x09, 0xe7, 0x5a, 0x53, 0x04, 0x98, 0xf2, 0x16, 0x09, 0x9c, 0xa6, 0x94, 0x96,
+	0x6a, 0xcd, 0xc4, 0xba, 0x29, 0x65, 0x73, 0x1f, 0xc6, 0x05, 0x13, 0xb8, 0x93, 0xe8, 0x4f, 0x1f,
+	0x46, 0x7f, 0x63, 0x32, 0x45, 0x09, 0x6f, 0xb0, 0xb9, 0x47, 0x2f, 0x6b, 0xee, 0xe2, 0x60, 0x73,
+	0x5b, 0x30, 0xdd, 0x6f, 0xee, 0x84, 0x1e, 0x97, 0x93, 0x45, 0xb7, 0x51, 0xcf, 0xb5, 0x9b, 0x78,
+	0xb2, 0x23, 0xed, 0x9f, 0x0c, 0x8e, 0xfe, 0x48, 0x53, 0xe4, 0x57, 0x01, 0xf5, 0x0a, 0x3a, 0x03,
+	0xf3, 0x63, 0xaa, 0xe7, 0x79, 0xa0, 0x1c, 0x68, 0x19, 0x26, 0xe3, 0x72, 0xfd, 0xd8, 0x8a, 0x8c,
+	0x9d, 0x88, 0xcd, 0x49, 0xa0, 0xf9, 0x93, 0x06, 0xb3, 0x39, 0x8d, 0xf3, 0x06, 0xf5, 0xb7, 0x0d,
+	0x13, 0xaa, 0xdd, 0xb2, 0xd2, 0x5b, 0xbc, 0xb8, 0x41, 0x32, 0xc2, 0xb8, 0x8a, 0x53, 0x1e, 0x8e,
+	0x66, 0xa0, 0x14, 0xf7, 0xbe, 0xec, 0x80, 0xb2, 0xad, 0x56, 0xe6, 0x1d, 0x25, 0x80, 0xcd, 0xb8,
+	0x7b, 0x1f, 0xe3, 0x90, 0x93, 0xe1, 0xbe, 0xaf, 0xe6, 0x33, 0x98, 0xcd, 0x49, 0x55, 0x5b, 0x30,
+	0x13, 0x5d, 0x4f, 0x42, 0x4e, 0xe2, 0xbc, 0xb2, 0xad, 0x56, 0x51, 0xbb, 0xcb, 0x27, 0xa3, 0x90,
+	0xd7, 0xee, 0x69, 0xa8, 0xa4, 0xdd, 0x65, 0xf8, 0xda, 0x77, 0x25, 0x28, 0xca, 0x6a, 0xe8, 0x2b,
+	0x0d, 0x4a, 0xf1, 0xed, 0x06, 0x0d, 0xec, 0xc5, 0xf9, 0xcb, 0x53, 0xed, 0xc6, 0x6b, 0x22, 0x62,
+	0xa6, 0xe6, 0xfb, 0x5f, 0xfe, 0xfa, 0xe7, 0x37, 0x05, 0x0b, 0xad, 0x5a, 0x7b, 0x9e, 0xd8, 0xc3,
+	0x6e, 0x9b, 0xf0, 0xfe, 0x53, 0x73, 0x1f, 0x7b, 0xd4, 0xca, 0xb9, 0xcc, 0xa1, 0x6f, 0x35, 0x80,
+	0xfe, 0xc5, 0x07, 0xdd, 0xcc, 0x29, 0x74, 0xee, 0x9a, 0x55, 0x5b, 0xba, 0x24, 0x4a, 0x51, 0xba,
+	0x2b, 0x29, 0xbd, 0x87, 0xd6, 0x86, 0xa4, 0x94, 0xba, 0x6a, 0xa1, 0xef, 0x35, 0x98, 0x1c, 0xf8,
+	0x2e, 0xa0, 0x5b, 0xaf, 0x2b, 0x9b, 0xb9, 0x53, 0xd5, 0xde, 0x1e, 0x26, 0xf4, 0x5f, 0xee, 0x1c,
+	0x8f, 0xd9, 0xfc, 0xa8, 0xc1, 0x78, 0x5a, 0x36, 0xe8, 0xad, 0x9c, 0x9a, 0x39, 0x03, 0xb9, 0xb6,
+	0x7c, 0x69, 0x9c, 0x22, 0xf6, 0x50, 0x12, 0xbb, 0x8f, 0x3e, 0x1c, 0x92, 0x58, 0x22, 0xb4, 0x30,
+	0x42, 0xb1, 0x9e, 0xab, 0xe5, 0x0b, 0xf4, 0x83, 0x06, 0xe3, 0xe9, 0x96, 0xcc, 0x65, 0x9a, 0xa3,
+	0x9c, 0xda, 0xf2, 0xa5, 0x71, 0x8a, 0xe9, 0xba, 0x64, 0x7a, 0x0f, 0xdd, 0x19, 0xba, 0xf9, 0x42,
+	0x4e, 0xac, 0xe7, 0x7d, 0x59, 0xbe, 0xd8, 0xd8, 0x3d, 0x39, 0xad, 0x6b, 0x2f, 0x4f, 0xeb, 0xda,
+	0x1f, 0xa7, 0x75, 0xed, 0xeb, 0xb3, 0xfa, 0xc8, 0xcb, 0xb3, 0xfa, 0xc8, 0x6f, 0x67, 0xf5, 0x91,
+	0xa7, 0x77, 0xdb, 0x9e, 0xd8, 0x0f, 0xf7, 0x1a, 0x4d, 0xe6, 0x5f, 0x0c, 0x7f, 0x14, 0x15, 0x58,
+	0x8d, 0x2a, 0xac, 0xa6, 0xfe, 0x48, 0xf6, 0x4a, 0xf2, 0x97, 0xe4, 0xdd, 0xbf, 0x07, 0x00, 0x22,
+	0xeb, 0x11, 0x22, 0x25, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RateLimits queries the configured rate limits, optionally filtered by channel_id and/or denom.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// RateLimitStatus queries the config that applies to a channel and denom along with the
+	// current usage and remaining capacity of each supply shift and unique sender limit.
+	RateLimitStatus(ctx context.Context, in *QueryRateLimitStatusRequest, opts ...grpc.CallOption) (*QueryRateLimitStatusResponse, error)
+	// AddressUsage queries the current usage and remaining capacity of each address limit
+	// that applies to an address on a channel and denom.
+	AddressUsage(ctx context.Context, in *QueryAddressUsageRequest, opts ...grpc.CallOption) (*QueryAddressUsageResponse, error)
+	// ChannelPause queries the active emergency pause on a channel, if any.
+	ChannelPause(ctx context.Context, in *QueryChannelPauseRequest, opts ...grpc.CallOption) (*QueryChannelPauseResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibcratelimit.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ibcratelimit.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitStatus(ctx context.Context, in *QueryRateLimitStatusRequest, opts ...grpc.CallOption) (*QueryRateLimitStatusResponse, error) {
+	out := new(QueryRateLimitStatusResponse)
+	err := c.cc.Invoke(ctx, "/ibcratelimit.Query/RateLimitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressUsage(ctx context.Context, in *QueryAddressUsageRequest, opts ...grpc.CallOption) (*QueryAddressUsageResponse, error) {
+	out := new(QueryAddressUsageResponse)
+	err := c.cc.Invoke(ctx, "/ibcratelimit.Query/AddressUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelPause(ctx context.Context, in *QueryChannelPauseRequest, opts ...grpc.CallOption) (*QueryChannelPauseResponse, error) {
+	out := new(QueryChannelPauseResponse)
+	err := c.cc.Invoke(ctx, "/ibcratelimit.Query/ChannelPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RateLimits queries the configured rate limits, optionally filtered by channel_id and/or denom.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// RateLimitStatus queries the config that applies to a channel and denom along with the
+	// current usage and remaining capacity of each supply shift and unique sender limit.
+	RateLimitStatus(context.Context, *QueryRateLimitStatusRequest) (*QueryRateLimitStatusResponse, error)
+	// AddressUsage queries the current usage and remaining capacity of each address limit
+	// that applies to an address on a channel and denom.
+	AddressUsage(context.Context, *QueryAddressUsageRequest) (*QueryAddressUsageResponse, error)
+	// ChannelPause queries the active emergency pause on a channel, if any.
+	ChannelPause(context.Context, *QueryChannelPauseRequest) (*QueryChannelPauseResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitStatus(ctx context.Context, req *QueryRateLimitStatusRequest) (*QueryRateLimitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitStatus not implemented")
+}
+func (*UnimplementedQueryServer) AddressUsage(ctx context.Context, req *QueryAddressUsageRequest) (*QueryAddressUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressUsage not implemented")
+}
+func (*UnimplementedQueryServer) ChannelPause(ctx context.Context, req *QueryChannelPauseRequest) (*QueryChannelPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPause not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibcratelimit.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibcratelimit.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibcratelimit.Query/RateLimitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitStatus(ctx, req.(*QueryRateLimitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibcratelimit.Query/AddressUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressUsage(ctx, req.(*QueryAddressUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibcratelimit.Query/ChannelPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelPause(ctx, req.(*QueryChannelPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibcratelimit.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitStatus",
+			Handler:    _Query_RateLimitStatus_Handler,
+		},
+		{
+			MethodName: "AddressUsage",
+			Handler:    _Query_AddressUsage_Handler,
+		},
+		{
+			MethodName: "ChannelPause",
+			Handler:    _Query_ChannelPause_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibcratelimit/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyShiftLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyShiftLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyShiftLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enforced {
+		i--
+		if m.Enforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.EffectiveMaxAmount.Size()
+		i -= size
+		if _, err := m.EffectiveMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RemainingOutflow.Size()
+		i -= size
+		if _, err := m.RemainingOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RemainingInflow.Size()
+		i -= size
+		if _, err := m.RemainingInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.WindowEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.NetFlow.Size()
+		i -= size
+		if _, err := m.NetFlow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UniqueSenderLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UniqueSenderLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UniqueSenderLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingSenders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingSenders))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UniqueSenders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UniqueSenders))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UniqueSenderLimits) > 0 {
+		for iNdEx := len(m.UniqueSenderLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UniqueSenderLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SupplyShiftLimits) > 0 {
+		for iNdEx := len(m.SupplyShiftLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyShiftLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmountEnforced {
+		i--
+		if m.AmountEnforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TransfersEnforced {
+		i--
+		if m.TransfersEnforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.RemainingTransfers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingTransfers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WindowEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEnd))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TransferCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TransferCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AddressLimits) > 0 {
+		for iNdEx := len(m.AddressLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimitStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyShiftLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetFlow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowStart != 0 {
+		n += 1 + sovQuery(uint64(m.WindowStart))
+	}
+	if m.WindowEnd != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEnd))
+	}
+	l = m.RemainingInflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingOutflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveMaxAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Enforced {
+		n += 2
+	}
+	return n
+}
+
+func (m *UniqueSenderLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UniqueSenders != 0 {
+		n += 1 + sovQuery(uint64(m.UniqueSenders))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovQuery(uint64(m.WindowStart))
+	}
+	if m.WindowEnd != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEnd))
+	}
+	if m.RemainingSenders != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingSenders))
+	}
+	return n
+}
+
+func (m *QueryRateLimitStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SupplyShiftLimits) > 0 {
+		for _, e := range m.SupplyShiftLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UniqueSenderLimits) > 0 {
+		for _, e := range m.UniqueSenderLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddressUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TransferCount != 0 {
+		n += 1 + sovQuery(uint64(m.TransferCount))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowStart != 0 {
+		n += 1 + sovQuery(uint64(m.WindowStart))
+	}
+	if m.WindowEnd != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEnd))
+	}
+	if m.RemainingTransfers != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingTransfers))
+	}
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TransfersEnforced {
+		n += 2
+	}
+	if m.AmountEnforced {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAddressUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AddressLimits) > 0 {
+		for _, e := range m.AddressLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryChannelPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimitConfig{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyShiftLimitStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyShiftLimitStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyShiftLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetFlow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetFlow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			m.WindowEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enforced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UniqueSenderLimitStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UniqueSenderLimitStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UniqueSenderLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueSenders", wireType)
+			}
+			m.UniqueSenders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueSenders |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			m.WindowEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSenders", wireType)
+			}
+			m.RemainingSenders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingSenders |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyShiftLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyShiftLimits = append(m.SupplyShiftLimits, SupplyShiftLimitStatus{})
+			if err := m.SupplyShiftLimits[len(m.SupplyShiftLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueSenderLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniqueSenderLimits = append(m.UniqueSenderLimits, UniqueSenderLimitStatus{})
+			if err := m.UniqueSenderLimits[len(m.UniqueSenderLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressLimitStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressLimitStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferCount", wireType)
+			}
+			m.TransferCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			m.WindowEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTransfers", wireType)
+			}
+			m.RemainingTransfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingTransfers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransfersEnforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransfersEnforced = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountEnforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AmountEnforced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressLimits = append(m.AddressLimits, AddressLimitStatus{})
+			if err := m.AddressLimits[len(m.AddressLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelPauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelPauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -221,44 +221,272 @@ func (m *MsgUpdateRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateRateLimitResponse proto.InternalMessageInfo
 
+// MsgPauseChannel is the Msg/PauseChannel request type.
+type MsgPauseChannel struct {
+	// signer is the guardian or the governance authority.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// channel_id is the IBC channel ID to pause.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// inbound pauses received transfers on the channel.
+	Inbound bool `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// outbound pauses sent transfers on the channel.
+	Outbound bool `protobuf:"varint,4,opt,name=outbound,proto3" json:"outbound,omitempty"`
+}
+
+func (m *MsgPauseChannel) Reset()         { *m = MsgPauseChannel{} }
+func (m *MsgPauseChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseChannel) ProtoMessage()    {}
+func (*MsgPauseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d1bc25d615edf4a, []int{4}
+}
+func (m *MsgPauseChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseChannel.Merge(m, src)
+}
+func (m *MsgPauseChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseChannel proto.InternalMessageInfo
+
+func (m *MsgPauseChannel) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgPauseChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgPauseChannel) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+func (m *MsgPauseChannel) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
+	}
+	return false
+}
+
+// MsgPauseChannelResponse defines the response structure for executing a
+// MsgPauseChannel message.
+type MsgPauseChannelResponse struct {
+	// expires_at is the block height at which the pause automatically expires.
+	ExpiresAt int64 `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *MsgPauseChannelResponse) Reset()         { *m = MsgPauseChannelResponse{} }
+func (m *MsgPauseChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseChannelResponse) ProtoMessage()    {}
+func (*MsgPauseChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d1bc25d615edf4a, []int{5}
+}
+func (m *MsgPauseChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseChannelResponse.Merge(m, src)
+}
+func (m *MsgPauseChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseChannelResponse proto.InternalMessageInfo
+
+func (m *MsgPauseChannelResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// MsgUnpauseChannel is the Msg/UnpauseChannel request type.
+type MsgUnpauseChannel struct {
+	// signer is the guardian or the governance authority.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// channel_id is the IBC channel ID to unpause.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgUnpauseChannel) Reset()         { *m = MsgUnpauseChannel{} }
+func (m *MsgUnpauseChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseChannel) ProtoMessage()    {}
+func (*MsgUnpauseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d1bc25d615edf4a, []int{6}
+}
+func (m *MsgUnpauseChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseChannel.Merge(m, src)
+}
+func (m *MsgUnpauseChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseChannel proto.InternalMessageInfo
+
+func (m *MsgUnpauseChannel) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUnpauseChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgUnpauseChannelResponse defines the response structure for executing a
+// MsgUnpauseChannel message.
+type MsgUnpauseChannelResponse struct {
+}
+
+func (m *MsgUnpauseChannelResponse) Reset()         { *m = MsgUnpauseChannelResponse{} }
+func (m *MsgUnpauseChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseChannelResponse) ProtoMessage()    {}
+func (*MsgUnpauseChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d1bc25d615edf4a, []int{7}
+}
+func (m *MsgUnpauseChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseChannelResponse.Merge(m, src)
+}
+func (m *MsgUnpauseChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseChannelResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibcratelimit.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibcratelimit.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateRateLimit)(nil), "ibcratelimit.MsgUpdateRateLimit")
 	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "ibcratelimit.MsgUpdateRateLimitResponse")
+	proto.RegisterType((*MsgPauseChannel)(nil), "ibcratelimit.MsgPauseChannel")
+	proto.RegisterType((*MsgPauseChannelResponse)(nil), "ibcratelimit.MsgPauseChannelResponse")
+	proto.RegisterType((*MsgUnpauseChannel)(nil), "ibcratelimit.MsgUnpauseChannel")
+	proto.RegisterType((*MsgUnpauseChannelResponse)(nil), "ibcratelimit.MsgUnpauseChannelResponse")
 }
 
 func init() { proto.RegisterFile("ibcratelimit/tx.proto", fileDescriptor_1d1bc25d615edf4a) }
 
 var fileDescriptor_1d1bc25d615edf4a = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcd, 0x4c, 0x4a, 0x2e,
-	0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x41, 0x16, 0x96, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x05, 0x52, 0xe2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0xb9, 0xc5, 0xe9, 0xfa, 0x65, 0x86,
-	0x20, 0x0a, 0x2a, 0x21, 0x09, 0x91, 0x88, 0x07, 0xf3, 0xf4, 0x21, 0x1c, 0xa8, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x44, 0x1c, 0xc4, 0x82, 0x69, 0x40, 0x71, 0x41, 0x41, 0x62, 0x51, 0x62, 0x2e,
-	0x54, 0x83, 0xd2, 0x66, 0x46, 0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4,
-	0x00, 0xb0, 0x8c, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x9b, 0x1c, 0x53,
-	0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xcc,
-	0xb9, 0xd8, 0x20, 0x66, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0xdb, 0xab,
-	0x07, 0x31, 0xdd, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41,
-	0x95, 0x5b, 0xe9, 0x37, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xa8, 0xeb, 0xf9, 0x06, 0x2d, 0x19, 0x14,
-	0x27, 0xa3, 0xb9, 0x50, 0x49, 0x92, 0x4b, 0x1c, 0x4d, 0x28, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf,
-	0x38, 0x55, 0xe9, 0x24, 0x23, 0x97, 0x10, 0x5c, 0x2e, 0x28, 0xb1, 0x24, 0xd5, 0x07, 0x64, 0x02,
-	0xd9, 0x7e, 0x72, 0xe7, 0xe2, 0x02, 0x39, 0x23, 0x1e, 0xec, 0x0e, 0xa8, 0xbf, 0x64, 0x51, 0xfd,
-	0x05, 0xb7, 0xc4, 0x39, 0x3f, 0x2f, 0x2d, 0x33, 0x1d, 0xd9, 0x83, 0x9c, 0x45, 0x30, 0x39, 0x2b,
-	0x23, 0x4c, 0x3f, 0xca, 0x63, 0xf7, 0x23, 0xdc, 0x3c, 0x25, 0x19, 0x2e, 0x29, 0x4c, 0x51, 0x98,
-	0x4f, 0x8d, 0x0e, 0x32, 0x72, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0x85, 0x70, 0xf1, 0xa0, 0x44, 0x1f,
-	0x9a, 0xf3, 0xd0, 0x02, 0x4a, 0x4a, 0x15, 0xaf, 0x34, 0xcc, 0x74, 0xa1, 0x58, 0x2e, 0x7e, 0xf4,
-	0x30, 0x54, 0xc0, 0xa1, 0x13, 0xae, 0x42, 0x4a, 0x83, 0x90, 0x0a, 0x98, 0xf1, 0x52, 0xac, 0x0d,
-	0xa0, 0x00, 0x72, 0x0a, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xab,
-	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xa4, 0xcc, 0x92, 0xa4, 0xc4,
-	0x94, 0xf4, 0xd4, 0x62, 0x04, 0x2b, 0x39, 0x23, 0x31, 0x33, 0x4f, 0xbf, 0x42, 0x3f, 0x33, 0x29,
-	0x59, 0x17, 0x64, 0xa1, 0x2e, 0x34, 0x77, 0x55, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xd3, 0xb6,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x58, 0x20, 0xbb, 0x64, 0x7a, 0x03, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x8b, 0xd3, 0x40,
+	0x18, 0x6d, 0x76, 0xb5, 0x6e, 0xc6, 0xe2, 0xb2, 0xa1, 0xd2, 0x36, 0xee, 0xa6, 0x25, 0xb0, 0x58,
+	0x8a, 0x6d, 0xb4, 0x82, 0x4a, 0x6f, 0xdb, 0x3d, 0x88, 0xe0, 0xc2, 0x12, 0xeb, 0x65, 0x41, 0xca,
+	0x24, 0x19, 0xd3, 0x81, 0x4d, 0x26, 0x64, 0xa6, 0xd2, 0xbd, 0x89, 0x47, 0x4f, 0x1e, 0xfc, 0x11,
+	0x1e, 0x0b, 0xfa, 0x1b, 0x64, 0xbd, 0x2d, 0x9e, 0x3c, 0x89, 0xb4, 0x87, 0xfe, 0x00, 0xff, 0x80,
+	0x24, 0x99, 0xa4, 0x4d, 0xb2, 0x6e, 0x41, 0xf0, 0xd2, 0x66, 0xbe, 0xf7, 0xe6, 0x7d, 0xef, 0xcd,
+	0x7c, 0x09, 0xb8, 0x8d, 0x0d, 0xd3, 0x87, 0x0c, 0x9d, 0x62, 0x07, 0x33, 0x8d, 0x4d, 0x3a, 0x9e,
+	0x4f, 0x18, 0x91, 0x4a, 0xab, 0x65, 0x79, 0x07, 0x3a, 0xd8, 0x25, 0x5a, 0xf8, 0x1b, 0x11, 0xe4,
+	0x8a, 0x49, 0xa8, 0x43, 0xa8, 0xe6, 0x50, 0x5b, 0x7b, 0xf3, 0x20, 0xf8, 0xe3, 0x40, 0x2d, 0x02,
+	0x86, 0xe1, 0x4a, 0x8b, 0x16, 0x1c, 0x2a, 0xdb, 0xc4, 0x26, 0x51, 0x3d, 0x78, 0x8a, 0x37, 0xa4,
+	0x1c, 0x78, 0xd0, 0x87, 0x0e, 0xdf, 0xa0, 0x7e, 0x16, 0xc0, 0xf6, 0x11, 0xb5, 0x5f, 0x7a, 0x16,
+	0x64, 0xe8, 0x38, 0x44, 0xa4, 0x47, 0x40, 0x84, 0x63, 0x36, 0x22, 0x3e, 0x66, 0x67, 0x55, 0xa1,
+	0x21, 0x34, 0xc5, 0x7e, 0xf5, 0xfb, 0x97, 0x76, 0x99, 0x77, 0x3a, 0xb0, 0x2c, 0x1f, 0x51, 0xfa,
+	0x82, 0xf9, 0xd8, 0xb5, 0xf5, 0x25, 0x55, 0x7a, 0x0c, 0x8a, 0x91, 0x76, 0x75, 0xa3, 0x21, 0x34,
+	0x6f, 0x76, 0xcb, 0x9d, 0xd5, 0xbe, 0x9d, 0x48, 0xbd, 0x2f, 0x9e, 0xff, 0xac, 0x17, 0x3e, 0x2d,
+	0xa6, 0x2d, 0x41, 0xe7, 0xf4, 0x9e, 0xf6, 0x6e, 0x31, 0x6d, 0x2d, 0x85, 0xde, 0x2f, 0xa6, 0xad,
+	0xdd, 0x94, 0xe5, 0x8c, 0x43, 0xb5, 0x06, 0x2a, 0x99, 0x92, 0x8e, 0xa8, 0x47, 0x5c, 0x8a, 0xd4,
+	0x6f, 0x02, 0x90, 0x12, 0x4c, 0x87, 0x0c, 0x3d, 0x0f, 0x14, 0xfe, 0x39, 0xd3, 0x53, 0x00, 0x02,
+	0x1b, 0xc3, 0xd0, 0x07, 0xcf, 0xb5, 0x97, 0xce, 0x95, 0x34, 0x39, 0x24, 0xee, 0x6b, 0x6c, 0xaf,
+	0x06, 0x14, 0xfd, 0x18, 0xeb, 0x75, 0xf3, 0x19, 0xeb, 0x97, 0x67, 0x4c, 0xf4, 0xd4, 0x5d, 0x20,
+	0xe7, 0xab, 0x49, 0xd2, 0xaf, 0xd1, 0xd5, 0x1d, 0xc3, 0x31, 0x45, 0x87, 0x23, 0xe8, 0xba, 0xe8,
+	0x54, 0xba, 0x0f, 0x8a, 0x14, 0xdb, 0x2e, 0xf2, 0xd7, 0x66, 0xe4, 0x3c, 0x69, 0x0f, 0x00, 0x33,
+	0xda, 0x3c, 0xc4, 0x56, 0x18, 0x50, 0xd4, 0x45, 0x5e, 0x79, 0x66, 0x49, 0x55, 0x70, 0x03, 0xbb,
+	0x06, 0x19, 0xbb, 0x56, 0x75, 0xb3, 0x21, 0x34, 0xb7, 0xf4, 0x78, 0x29, 0xc9, 0x60, 0x8b, 0x8c,
+	0x59, 0x04, 0x5d, 0x0b, 0xa1, 0x64, 0xdd, 0xbb, 0x17, 0x84, 0xe5, 0x1d, 0x2e, 0xbd, 0xcd, 0x55,
+	0xd3, 0xea, 0x13, 0x50, 0xc9, 0x94, 0xe2, 0x8c, 0x81, 0x3b, 0x34, 0xf1, 0xb0, 0x8f, 0xe8, 0x10,
+	0xb2, 0x30, 0xd3, 0xa6, 0x2e, 0xf2, 0xca, 0x01, 0x53, 0x3f, 0x0a, 0x60, 0x27, 0x38, 0x21, 0xd7,
+	0xfb, 0x9f, 0x87, 0xd0, 0xeb, 0x64, 0xe2, 0x28, 0xb9, 0x8b, 0x4b, 0x19, 0x50, 0xef, 0x80, 0x5a,
+	0xae, 0x18, 0x47, 0xea, 0xfe, 0xde, 0x00, 0x9b, 0x47, 0xd4, 0x96, 0x06, 0xa0, 0x94, 0x7a, 0xeb,
+	0x32, 0x53, 0x95, 0x99, 0x6f, 0x79, 0xff, 0x4a, 0x38, 0x39, 0xb0, 0x57, 0x60, 0x3b, 0x3b, 0xfa,
+	0x8d, 0xbf, 0xec, 0x4c, 0x18, 0x72, 0x73, 0x1d, 0x23, 0x91, 0x1f, 0x80, 0x52, 0x6a, 0xde, 0xf2,
+	0xa6, 0x57, 0x61, 0x79, 0xff, 0x4a, 0x38, 0x51, 0x3d, 0x01, 0xb7, 0x32, 0x57, 0x58, 0xcf, 0x3b,
+	0x4a, 0x11, 0xe4, 0xbb, 0x6b, 0x08, 0xb1, 0xb6, 0x7c, 0xfd, 0x6d, 0xf0, 0x26, 0xf6, 0x07, 0xe7,
+	0x33, 0x45, 0xb8, 0x98, 0x29, 0xc2, 0xaf, 0x99, 0x22, 0x7c, 0x98, 0x2b, 0x85, 0x8b, 0xb9, 0x52,
+	0xf8, 0x31, 0x57, 0x0a, 0x27, 0x3d, 0x1b, 0xb3, 0xd1, 0xd8, 0xe8, 0x98, 0xc4, 0xd1, 0x0c, 0xcc,
+	0x0c, 0x68, 0xd9, 0x88, 0x2e, 0x9f, 0xcc, 0x11, 0xc4, 0xae, 0x36, 0xd1, 0xb0, 0x61, 0xb6, 0x83,
+	0x7e, 0x6d, 0xfe, 0x19, 0x3f, 0xf3, 0x10, 0x35, 0x8a, 0xe1, 0x47, 0xf4, 0xe1, 0x9f, 0x01, 0x00,
+	0xf4, 0x60, 0x4b, 0x12, 0xe3, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -280,6 +508,13 @@ type MsgClient interface {
 	// If a rate limit with the same channel_id and denom exists, it will be updated.
 	// Otherwise, it will be appended to the list. The authority defaults to the x/gov module account.
 	UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
+	// PauseChannel defines an emergency operation for pausing inbound and/or outbound transfers on a channel.
+	// Can be signed by the guardian or the governance authority. The pause expires automatically after
+	// the configured pause_duration.
+	PauseChannel(ctx context.Context, in *MsgPauseChannel, opts ...grpc.CallOption) (*MsgPauseChannelResponse, error)
+	// UnpauseChannel lifts a channel pause before it expires.
+	// Can be signed by the guardian or the governance authority.
+	UnpauseChannel(ctx context.Context, in *MsgUnpauseChannel, opts ...grpc.CallOption) (*MsgUnpauseChannelResponse, error)
 }
 
 type msgClient struct {
@@ -308,6 +543,24 @@ func (c *msgClient) UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit,
 	return out, nil
 }
 
+func (c *msgClient) PauseChannel(ctx context.Context, in *MsgPauseChannel, opts ...grpc.CallOption) (*MsgPauseChannelResponse, error) {
+	out := new(MsgPauseChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibcratelimit.Msg/PauseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseChannel(ctx context.Context, in *MsgUnpauseChannel, opts ...grpc.CallOption) (*MsgUnpauseChannelResponse, error) {
+	out := new(MsgUnpauseChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibcratelimit.Msg/UnpauseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -317,6 +570,13 @@ type MsgServer interface {
 	// If a rate limit with the same channel_id and denom exists, it will be updated.
 	// Otherwise, it will be appended to the list. The authority defaults to the x/gov module account.
 	UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
+	// PauseChannel defines an emergency operation for pausing inbound and/or outbound transfers on a channel.
+	// Can be signed by the guardian or the governance authority. The pause expires automatically after
+	// the configured pause_duration.
+	PauseChannel(context.Context, *MsgPauseChannel) (*MsgPauseChannelResponse, error)
+	// UnpauseChannel lifts a channel pause before it expires.
+	// Can be signed by the guardian or the governance authority.
+	UnpauseChannel(context.Context, *MsgUnpauseChannel) (*MsgUnpauseChannelResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -329,6 +589,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) UpdateRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimit not implemented")
 }
+func (*UnimplementedMsgServer) PauseChannel(ctx context.Context, req *MsgPauseChannel) (*MsgPauseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseChannel not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseChannel(ctx context.Context, req *MsgUnpauseChannel) (*MsgUnpauseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseChannel not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -370,6 +636,42 @@ func _Msg_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibcratelimit.Msg/PauseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseChannel(ctx, req.(*MsgPauseChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibcratelimit.Msg/UnpauseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseChannel(ctx, req.(*MsgUnpauseChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibcratelimit.Msg",
@@ -383,6 +685,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRateLimit",
 			Handler:    _Msg_UpdateRateLimit_Handler,
 		},
+		{
+			MethodName: "PauseChannel",
+			Handler:    _Msg_PauseChannel_Handler,
+		},
+		{
+			MethodName: "UnpauseChannel",
+			Handler:    _Msg_UnpauseChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibcratelimit/tx.proto",
@@ -514,6 +824,151 @@ func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPauseChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outbound {
+		i--
+		if m.Outbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Inbound {
+		i--
+		if m.Inbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -559,27 +1014,253 @@ func (m *MsgUpdateRateLimit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.RateLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Inbound {
+		n += 2
+	}
+	if m.Outbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPauseChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgUnpauseChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -602,10 +1283,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -642,7 +1323,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -669,7 +1350,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -694,7 +1375,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -717,10 +1398,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -744,7 +1425,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -767,15 +1448,15 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -803,13 +1484,13 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -819,24 +1500,246 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inbound = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outbound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -859,7 +1762,7 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -882,10 +1785,10 @@ func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
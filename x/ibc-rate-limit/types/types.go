@@ -2,11 +2,31 @@ package types
 
 import (
 	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
+const (
+	// Wildcard matches any channel_id or denom in a RateLimitConfig
+	Wildcard = "*"
+
+	// MinWindowBuckets is the minimum number of sub-buckets for a sliding window
+	MinWindowBuckets int64 = 2
+	// MaxWindowBuckets is the maximum number of sub-buckets for a sliding window
+	// Bounds the size of each stored WindowBuckets entry (and therefore gas per packet)
+	MaxWindowBuckets int64 = 100
+
+	// MaxPercentBps is the maximum value of max_percent_bps (100%)
+	MaxPercentBps uint64 = 10000
+
+	// DefaultPauseDuration is the default number of blocks a channel pause lasts (1 day at 3 second blocks)
+	DefaultPauseDuration int64 = 28800
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -16,7 +36,10 @@ func DefaultGenesis() *GenesisState {
 
 // Validate performs basic genesis state validation returning an error upon any failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	return gs.validateTrackingState()
 }
 
 // DefaultParams returns default parameters
@@ -28,14 +51,63 @@ func DefaultParams() Params {
 
 // Validate validates params
 func (p Params) Validate() error {
+	seen := make(map[string]int, len(p.RateLimits))
 	for i, config := range p.RateLimits {
 		if err := config.Validate(); err != nil {
 			return fmt.Errorf("invalid rate limit config at index %d: %w", i, err)
 		}
+
+		// Two configs for the same channel and denom would make matching ambiguous
+		// Empty and "*" channel IDs are equivalent
+		channelID := config.ChannelId
+		if config.IsWildcardChannel() {
+			channelID = Wildcard
+		}
+		key := channelID + "|" + config.Denom
+		if j, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate rate limit config at index %d: channel_id=%s, denom=%s already configured at index %d", i, config.ChannelId, config.Denom, j)
+		}
+		seen[key] = i
+	}
+
+	seenExempt := make(map[string]bool, len(p.ExemptAddresses))
+	for i, address := range p.ExemptAddresses {
+		// Inbound senders are counterparty chain addresses, so these are not required to be local bech32 addresses
+		if address == "" {
+			return fmt.Errorf("exempt_addresses[%d] cannot be empty", i)
+		}
+		if seenExempt[address] {
+			return fmt.Errorf("duplicate exempt address: %s", address)
+		}
+		seenExempt[address] = true
+	}
+
+	if p.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+			return fmt.Errorf("invalid guardian address: %w", err)
+		}
 	}
+
+	if p.PauseDuration < 0 {
+		return fmt.Errorf("pause_duration cannot be negative: %d", p.PauseDuration)
+	}
+
 	return nil
 }
 
+// IsExemptAddress returns true if the address is exempt from address and unique sender limits
+func (p Params) IsExemptAddress(address string) bool {
+	return slices.Contains(p.ExemptAddresses, address)
+}
+
+// EffectivePauseDuration returns the number of blocks a channel pause lasts
+func (p Params) EffectivePauseDuration() int64 {
+	if p.PauseDuration == 0 {
+		return DefaultPauseDuration
+	}
+	return p.PauseDuration
+}
+
 // Validate validates a rate limit config
 func (c RateLimitConfig) Validate() error {
 	// Denom must always be specified (no empty denoms allowed)
@@ -64,14 +136,45 @@ func (c RateLimitConfig) Validate() error {
 		}
 	}
 
+	// Validate window mode
+	switch c.WindowMode {
+	case WindowMode_WINDOW_MODE_FIXED:
+		if c.WindowBuckets != 0 {
+			return fmt.Errorf("window_buckets can only be set when window_mode is sliding")
+		}
+	case WindowMode_WINDOW_MODE_SLIDING:
+		if c.WindowBuckets < MinWindowBuckets || c.WindowBuckets > MaxWindowBuckets {
+			return fmt.Errorf("window_buckets must be between %d and %d: %d", MinWindowBuckets, MaxWindowBuckets, c.WindowBuckets)
+		}
+	default:
+		return fmt.Errorf("unknown window_mode: %d", c.WindowMode)
+	}
+
 	return nil
 }
 
+// IsSlidingWindow returns true if the config tracks supply shift and address limits using sliding windows
+func (c RateLimitConfig) IsSlidingWindow() bool {
+	return c.WindowMode == WindowMode_WINDOW_MODE_SLIDING
+}
+
 // Validate validates a timeframe limit
 func (t TimeframeLimit) Validate() error {
 	if t.MaxAmount.IsNegative() {
 		return fmt.Errorf("max_amount cannot be negative: %s", t.MaxAmount)
 	}
+	if t.MaxPercentBps > MaxPercentBps {
+		return fmt.Errorf("max_percent_bps cannot exceed %d: %d", MaxPercentBps, t.MaxPercentBps)
+	}
+	if t.MaxPercentBps > 0 && t.SupplyBasis == SupplyBasis_SUPPLY_BASIS_UNSPECIFIED {
+		return fmt.Errorf("supply_basis must be specified when max_percent_bps is set")
+	}
+	if t.MaxPercentBps == 0 && t.SupplyBasis != SupplyBasis_SUPPLY_BASIS_UNSPECIFIED {
+		return fmt.Errorf("supply_basis can only be set when max_percent_bps is set")
+	}
+	if _, ok := SupplyBasis_name[int32(t.SupplyBasis)]; !ok {
+		return fmt.Errorf("unknown supply_basis: %d", t.SupplyBasis)
+	}
 	if t.TimeframeType == TimeframeType_TIMEFRAME_TYPE_UNSPECIFIED {
 		return fmt.Errorf("timeframe_type must be specified")
 	}
@@ -81,6 +184,16 @@ func (t TimeframeLimit) Validate() error {
 	return nil
 }
 
+// IsEnabled returns true if the limit has either an absolute or a percentage-based maximum
+func (t TimeframeLimit) IsEnabled() bool {
+	return !t.MaxAmount.IsZero() || t.MaxPercentBps > 0
+}
+
+// IsPercentage returns true if the limit is expressed as a percentage of a supply basis
+func (t TimeframeLimit) IsPercentage() bool {
+	return t.MaxPercentBps > 0
+}
+
 // Validate validates a unique sender limit
 func (u UniqueSenderLimit) Validate() error {
 	if u.MaxUniqueSenders < 0 {
@@ -131,27 +244,69 @@ func TimeframeDurationInBlocks(timeframeType TimeframeType, timeframeDuration in
 	}
 }
 
-// FindMatchingConfig finds the first rate limit config that matches the given channel and denom
+// WindowBucketSize returns the number of blocks covered by each sub-bucket of a sliding window
+// The bucket size is rounded up so that numBuckets buckets always cover the full window
+func WindowBucketSize(windowDurationBlocks int64, numBuckets int64) int64 {
+	if numBuckets <= 0 {
+		numBuckets = 1
+	}
+	size := (windowDurationBlocks + numBuckets - 1) / numBuckets
+	if size < 1 {
+		return 1
+	}
+	return size
+}
+
+// FindMatchingConfig finds the most specific rate limit config that matches the given channel and denom
 // Returns nil if no config matches
 // Matching rules:
-// - Empty channel_id matches all channels
-// - Denom must exactly match (empty denoms are not allowed in configs)
-// - Both must match (channel can be empty to match all, but denom must match exactly)
+// - channel_id "*" (or empty, for backward compatibility) matches all channels
+// - denom "*" matches all denoms (empty denoms are not allowed in configs)
+// - When several configs match, the most specific one wins, in this order:
+//  1. exact channel, exact denom
+//  2. wildcard channel, exact denom
+//  3. exact channel, wildcard denom
+//  4. wildcard channel, wildcard denom
+//
+// A denom match is preferred over a channel match because limit amounts are denominated in the denom.
+// Ties (which Params.Validate rejects) resolve to the first config in the list.
 func (p Params) FindMatchingConfig(channelID, denom string) *RateLimitConfig {
+	var bestMatch *RateLimitConfig
+	bestSpecificity := -1
 	for i := range p.RateLimits {
 		config := &p.RateLimits[i]
 
-		// Check channel match (empty channel_id matches all channels)
-		channelMatch := config.ChannelId == "" || config.ChannelId == channelID
+		// Check channel match (wildcard channel_id matches all channels)
+		channelWildcard := config.IsWildcardChannel()
+		channelMatch := channelWildcard || config.ChannelId == channelID
+
+		// Check denom match (wildcard denom matches all denoms)
+		denomWildcard := config.Denom == Wildcard
+		denomMatch := denomWildcard || config.Denom == denom
 
-		// Check denom match (must match exactly, empty denoms are not allowed)
-		denomMatch := config.Denom == denom
+		if !channelMatch || !denomMatch {
+			continue
+		}
 
-		if channelMatch && denomMatch {
-			return config
+		specificity := 0
+		if !denomWildcard {
+			specificity += 2
+		}
+		if !channelWildcard {
+			specificity += 1
+		}
+
+		if specificity > bestSpecificity {
+			bestMatch = config
+			bestSpecificity = specificity
 		}
 	}
-	return nil
+	return bestMatch
+}
+
+// IsWildcardChannel returns true if the config applies to all channels
+func (c RateLimitConfig) IsWildcardChannel() bool {
+	return c.ChannelId == "" || c.ChannelId == Wildcard
 }
 
 // NewCustomErrorAcknowledgement creates a custom error acknowledgement with a deterministic error string
@@ -123,6 +123,7 @@ func (m *ChannelFlowWindow) GetWindowDuration() int64 {
 }
 
 // UniqueSenders tracks unique sender addresses for a channel
+// Deprecated: unique senders are now stored with one key per sender; this is only decoded by the v1 -> v2 migration
 type UniqueSenders struct {
 	// senders is a list of unique sender addresses
 	Senders []string `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
@@ -216,45 +217,297 @@ func (m *AddressTransferData) GetTransferCount() int64 {
 	return 0
 }
 
+// WindowBucket tracks usage within a single sub-bucket of a sliding window
+type WindowBucket struct {
+	// bucket_index is the block height divided by the bucket size in blocks
+	BucketIndex int64 `protobuf:"varint,1,opt,name=bucket_index,json=bucketIndex,proto3" json:"bucket_index,omitempty"`
+	// amount is the amount transferred in this bucket
+	// For supply shift tracking this is the signed net flow (inflow - outflow)
+	// For address tracking this is the total absolute amount
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// count is the number of transfers in this bucket (only used for address tracking)
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *WindowBucket) Reset()         { *m = WindowBucket{} }
+func (m *WindowBucket) String() string { return proto.CompactTextString(m) }
+func (*WindowBucket) ProtoMessage()    {}
+func (*WindowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa1de8e1157e3dd, []int{4}
+}
+func (m *WindowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowBucket.Merge(m, src)
+}
+func (m *WindowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowBucket proto.InternalMessageInfo
+
+func (m *WindowBucket) GetBucketIndex() int64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *WindowBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// WindowBuckets tracks the sub-buckets of a sliding window, ordered by bucket_index
+type WindowBuckets struct {
+	Buckets []WindowBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *WindowBuckets) Reset()         { *m = WindowBuckets{} }
+func (m *WindowBuckets) String() string { return proto.CompactTextString(m) }
+func (*WindowBuckets) ProtoMessage()    {}
+func (*WindowBuckets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa1de8e1157e3dd, []int{5}
+}
+func (m *WindowBuckets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowBuckets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowBuckets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindowBuckets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowBuckets.Merge(m, src)
+}
+func (m *WindowBuckets) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowBuckets) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowBuckets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowBuckets proto.InternalMessageInfo
+
+func (m *WindowBuckets) GetBuckets() []WindowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// SupplySnapshot records the basis amount that percentage-based limits are measured against for the current window
+type SupplySnapshot struct {
+	// amount is the total supply or channel escrow amount at the time of the snapshot
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// snapshot_height is the block height when the snapshot was taken
+	SnapshotHeight int64 `protobuf:"varint,2,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+}
+
+func (m *SupplySnapshot) Reset()         { *m = SupplySnapshot{} }
+func (m *SupplySnapshot) String() string { return proto.CompactTextString(m) }
+func (*SupplySnapshot) ProtoMessage()    {}
+func (*SupplySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa1de8e1157e3dd, []int{6}
+}
+func (m *SupplySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplySnapshot.Merge(m, src)
+}
+func (m *SupplySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplySnapshot proto.InternalMessageInfo
+
+func (m *SupplySnapshot) GetSnapshotHeight() int64 {
+	if m != nil {
+		return m.SnapshotHeight
+	}
+	return 0
+}
+
+// ChannelPause records an emergency pause of transfers on a channel
+type ChannelPause struct {
+	// channel_id is the IBC channel ID that is paused
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// inbound pauses received transfers on the channel
+	Inbound bool `protobuf:"varint,2,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// outbound pauses sent transfers on the channel
+	Outbound bool `protobuf:"varint,3,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	// expires_at is the block height at which the pause automatically expires
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// paused_by is the address (guardian or governance authority) that paused the channel
+	PausedBy string `protobuf:"bytes,5,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+}
+
+func (m *ChannelPause) Reset()         { *m = ChannelPause{} }
+func (m *ChannelPause) String() string { return proto.CompactTextString(m) }
+func (*ChannelPause) ProtoMessage()    {}
+func (*ChannelPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa1de8e1157e3dd, []int{7}
+}
+func (m *ChannelPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPause.Merge(m, src)
+}
+func (m *ChannelPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPause proto.InternalMessageInfo
+
+func (m *ChannelPause) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelPause) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+func (m *ChannelPause) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
+	}
+	return false
+}
+
+func (m *ChannelPause) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *ChannelPause) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ChannelFlow)(nil), "ibcratelimit.ChannelFlow")
 	proto.RegisterType((*ChannelFlowWindow)(nil), "ibcratelimit.ChannelFlowWindow")
 	proto.RegisterType((*UniqueSenders)(nil), "ibcratelimit.UniqueSenders")
 	proto.RegisterType((*AddressTransferData)(nil), "ibcratelimit.AddressTransferData")
+	proto.RegisterType((*WindowBucket)(nil), "ibcratelimit.WindowBucket")
+	proto.RegisterType((*WindowBuckets)(nil), "ibcratelimit.WindowBuckets")
+	proto.RegisterType((*SupplySnapshot)(nil), "ibcratelimit.SupplySnapshot")
+	proto.RegisterType((*ChannelPause)(nil), "ibcratelimit.ChannelPause")
 }
 
 func init() { proto.RegisterFile("ibcratelimit/types.proto", fileDescriptor_baa1de8e1157e3dd) }
 
 var fileDescriptor_baa1de8e1157e3dd = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0xb4, 0x60, 0xed, 0x24, 0xad, 0x74, 0x55, 0x58, 0x02, 0x4e, 0xd2, 0x88, 0xb4, 0x0a,
-	0xcd, 0x50, 0xbc, 0xc8, 0x9e, 0xec, 0x1f, 0x04, 0x2f, 0x1e, 0xd2, 0x8a, 0xe0, 0x25, 0xcc, 0xec,
-	0x4e, 0x93, 0xd1, 0xdd, 0xf9, 0xd5, 0x99, 0x5f, 0x8c, 0x7e, 0x03, 0xf1, 0xe4, 0x47, 0xf0, 0xea,
-	0xcd, 0x8f, 0xd1, 0x63, 0x8f, 0xe2, 0xa1, 0x48, 0x72, 0xd0, 0x8f, 0x21, 0x3b, 0xbb, 0x8b, 0xbb,
-	0xa0, 0xe0, 0x65, 0x79, 0xbf, 0x37, 0xef, 0xbd, 0xdf, 0x9b, 0x65, 0x68, 0xa8, 0x65, 0x6c, 0x05,
-	0xaa, 0x54, 0x67, 0x1a, 0x39, 0xbe, 0x3f, 0x57, 0x6e, 0x78, 0x6e, 0x01, 0x21, 0xe8, 0xd4, 0x4f,
-	0xba, 0x5b, 0x22, 0xd3, 0x06, 0xb8, 0xff, 0x16, 0x82, 0xee, 0xad, 0x09, 0x4c, 0xc0, 0x43, 0x9e,
-	0xa3, 0x92, 0x65, 0x31, 0xb8, 0x0c, 0x1c, 0x97, 0xc2, 0x29, 0xfe, 0x76, 0x5f, 0x2a, 0x14, 0xfb,
-	0x3c, 0x06, 0x6d, 0x8a, 0xf3, 0xc1, 0x2b, 0xda, 0x3e, 0x9a, 0x0a, 0x63, 0x54, 0xfa, 0x24, 0x85,
-	0x79, 0xf0, 0x88, 0x5e, 0x37, 0x0a, 0xc7, 0x67, 0x29, 0xcc, 0x43, 0xd2, 0x27, 0xbb, 0xeb, 0x87,
-	0x77, 0x2e, 0xae, 0x7a, 0xad, 0xef, 0x57, 0xbd, 0xdb, 0x45, 0x90, 0x4b, 0x5e, 0x0f, 0x35, 0xf0,
-	0x4c, 0xe0, 0x74, 0xf8, 0xd4, 0xe0, 0x68, 0xcd, 0x28, 0xcc, 0x9d, 0xd1, 0xf6, 0xaf, 0xcf, 0x3d,
-	0xf2, 0xf1, 0xe7, 0xd7, 0x07, 0xcd, 0x2b, 0xd4, 0xc2, 0x07, 0x1f, 0x08, 0xdd, 0xaa, 0xcd, 0x2f,
-	0xb4, 0x49, 0x60, 0x1e, 0x6c, 0xd3, 0xce, 0xdc, 0xa3, 0xb1, 0x43, 0x61, 0xd1, 0xaf, 0x5d, 0x1d,
-	0xb5, 0x0b, 0xee, 0x24, 0xa7, 0x82, 0x1d, 0x7a, 0xa3, 0x94, 0x24, 0x33, 0x2b, 0x50, 0x83, 0x09,
-	0x57, 0xbc, 0x6a, 0xb3, 0xa0, 0x8f, 0x4b, 0x36, 0xda, 0xa9, 0x4a, 0xb0, 0x7f, 0x95, 0x28, 0x96,
-	0x0e, 0x9e, 0xd1, 0x8d, 0xe7, 0x46, 0xbf, 0x99, 0xa9, 0x13, 0x65, 0x12, 0x65, 0x5d, 0x10, 0xd2,
-	0x35, 0x57, 0xc0, 0x90, 0xf4, 0x57, 0x77, 0xd7, 0x47, 0xd5, 0x18, 0xdd, 0xad, 0x32, 0xbb, 0x8d,
-	0xcc, 0x86, 0x7d, 0xf0, 0x85, 0xd0, 0x9b, 0x07, 0x49, 0x62, 0x95, 0x73, 0xa7, 0x56, 0x18, 0x77,
-	0xa6, 0xec, 0xb1, 0x40, 0x11, 0xdc, 0xa3, 0x9b, 0x58, 0xce, 0xe3, 0x18, 0x66, 0xa6, 0xba, 0xde,
-	0x46, 0xc5, 0x1e, 0xe5, 0x64, 0xf0, 0x98, 0x76, 0x10, 0x50, 0xa4, 0x63, 0x91, 0x79, 0xd1, 0xca,
-	0xff, 0xfc, 0xfa, 0xb6, 0xb7, 0x1c, 0x78, 0x47, 0x74, 0xbf, 0x6a, 0xd9, 0x6f, 0xb4, 0xfc, 0x4b,
-	0xa7, 0xc3, 0xd3, 0x8b, 0x05, 0x23, 0x97, 0x0b, 0x46, 0x7e, 0x2c, 0x18, 0xf9, 0xb4, 0x64, 0xad,
-	0xcb, 0x25, 0x6b, 0x7d, 0x5b, 0xb2, 0xd6, 0xcb, 0x68, 0xa2, 0x71, 0x3a, 0x93, 0xc3, 0x18, 0x32,
-	0x2e, 0x35, 0x4a, 0x91, 0x4c, 0x94, 0xfb, 0x83, 0xe2, 0xa9, 0xd0, 0x86, 0xbf, 0xe3, 0x5a, 0xc6,
-	0x7b, 0xf9, 0x8a, 0xbd, 0xda, 0x2b, 0x95, 0xd7, 0xfc, 0x7b, 0x7a, 0xf8, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0x49, 0x2b, 0x13, 0x73, 0xc2, 0x02, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xdd, 0xfe, 0xd8, 0x9d, 0xdd, 0xb6, 0x74, 0xac, 0x10, 0xb7, 0x34, 0x6d, 0xa3,
+	0xd2, 0x2a, 0x74, 0x43, 0x15, 0x41, 0x72, 0xb2, 0xdb, 0x22, 0xf6, 0x22, 0xb2, 0x5b, 0x11, 0xbc,
+	0x84, 0x49, 0x32, 0xdd, 0x8c, 0xdd, 0x9d, 0x89, 0x99, 0x89, 0xdb, 0xfd, 0x0f, 0xc4, 0x93, 0x27,
+	0xcf, 0xe2, 0xcd, 0x9b, 0xff, 0x83, 0x97, 0x1e, 0x7b, 0x14, 0x0f, 0x45, 0xda, 0x83, 0xfe, 0x19,
+	0x92, 0x99, 0x8c, 0x26, 0xe0, 0x82, 0xbd, 0x84, 0xf7, 0xbe, 0xf3, 0x7e, 0x7c, 0x5e, 0xde, 0x30,
+	0xc0, 0x24, 0x7e, 0x90, 0x20, 0x81, 0x07, 0x64, 0x48, 0x84, 0x23, 0xc6, 0x31, 0xe6, 0xed, 0x38,
+	0x61, 0x82, 0xc1, 0x66, 0xf1, 0xa4, 0xb5, 0x84, 0x86, 0x84, 0x32, 0x47, 0x7e, 0x55, 0x40, 0x6b,
+	0xb9, 0xcf, 0xfa, 0x4c, 0x9a, 0x4e, 0x66, 0xe5, 0xaa, 0x15, 0x30, 0x3e, 0x64, 0xdc, 0xf1, 0x11,
+	0xc7, 0xce, 0x9b, 0x1d, 0x1f, 0x0b, 0xb4, 0xe3, 0x04, 0x8c, 0x50, 0x75, 0x6e, 0xbf, 0x02, 0x8d,
+	0xbd, 0x08, 0x51, 0x8a, 0x07, 0x8f, 0x07, 0x6c, 0x04, 0x1f, 0x82, 0x1a, 0xc5, 0xc2, 0x3b, 0x1a,
+	0xb0, 0x91, 0x69, 0xac, 0x1b, 0x5b, 0xf5, 0xce, 0xea, 0xe9, 0xf9, 0x5a, 0xe5, 0xfb, 0xf9, 0xda,
+	0x75, 0x55, 0x88, 0x87, 0xc7, 0x6d, 0xc2, 0x9c, 0x21, 0x12, 0x51, 0xfb, 0x80, 0x8a, 0xee, 0x1c,
+	0xc5, 0x22, 0xcb, 0x74, 0x37, 0x7e, 0x7d, 0x5c, 0x33, 0xde, 0xfd, 0xfc, 0x72, 0xb7, 0x3c, 0x42,
+	0xa1, 0xb8, 0xfd, 0xd6, 0x00, 0x4b, 0x05, 0xff, 0x05, 0xa1, 0x21, 0x1b, 0xc1, 0x0d, 0xd0, 0x1c,
+	0x49, 0xcb, 0xe3, 0x02, 0x25, 0x42, 0xb6, 0xad, 0x76, 0x1b, 0x4a, 0xeb, 0x65, 0x12, 0xdc, 0x04,
+	0x8b, 0x79, 0x48, 0x98, 0x26, 0x48, 0x10, 0x46, 0xcd, 0x29, 0x19, 0xb5, 0xa0, 0xe4, 0xfd, 0x5c,
+	0x75, 0x37, 0x35, 0x84, 0x35, 0x09, 0x42, 0x35, 0xb5, 0x9f, 0x82, 0xf9, 0xe7, 0x94, 0xbc, 0x4e,
+	0x71, 0x0f, 0xd3, 0x10, 0x27, 0x1c, 0x9a, 0x60, 0x8e, 0x2b, 0xd3, 0x34, 0xd6, 0xab, 0x5b, 0xf5,
+	0xae, 0x76, 0xdd, 0x9b, 0xba, 0x66, 0xab, 0x54, 0xb3, 0x94, 0x6e, 0x7f, 0x36, 0xc0, 0xb5, 0xdd,
+	0x30, 0x4c, 0x30, 0xe7, 0x87, 0x09, 0xa2, 0xfc, 0x08, 0x27, 0xfb, 0x48, 0x20, 0x78, 0x1b, 0x2c,
+	0x88, 0xdc, 0xf7, 0x02, 0x96, 0x52, 0x3d, 0xde, 0xbc, 0x56, 0xf7, 0x32, 0x11, 0x3e, 0x02, 0x4d,
+	0xc1, 0x04, 0x1a, 0x78, 0x68, 0x28, 0x83, 0xa6, 0xfe, 0xe7, 0xd7, 0x37, 0x64, 0xca, 0xae, 0xcc,
+	0x70, 0xef, 0x68, 0xca, 0xf5, 0x12, 0xe5, 0x3f, 0x98, 0xec, 0x4f, 0x06, 0x68, 0xaa, 0xdf, 0xd0,
+	0x49, 0x83, 0x63, 0x2c, 0xb2, 0x0d, 0xf8, 0xd2, 0xf2, 0x08, 0x0d, 0xf1, 0x89, 0xde, 0x80, 0xd2,
+	0x0e, 0x32, 0x09, 0x3e, 0x00, 0xb3, 0x57, 0x41, 0xcb, 0x83, 0xe1, 0x32, 0x98, 0x51, 0x53, 0x57,
+	0x65, 0x49, 0xe5, 0xb8, 0xb6, 0x66, 0xbd, 0x51, 0x62, 0x2d, 0x32, 0xd9, 0x31, 0x98, 0x2f, 0xfa,
+	0x1c, 0xba, 0x60, 0x4e, 0x01, 0xa9, 0x05, 0x35, 0xee, 0xb5, 0xda, 0xc5, 0xec, 0x76, 0x31, 0xba,
+	0x33, 0x9d, 0xe1, 0x75, 0x75, 0xc2, 0xa4, 0x15, 0x96, 0x1a, 0xd8, 0x1f, 0x0c, 0xb0, 0xd0, 0x4b,
+	0xe3, 0x78, 0x30, 0xee, 0x51, 0x14, 0xf3, 0x88, 0x89, 0xc2, 0xd4, 0xc6, 0x55, 0xa6, 0xde, 0x04,
+	0x8b, 0x3c, 0x2f, 0xe1, 0x45, 0x98, 0xf4, 0x23, 0xa1, 0xaf, 0xab, 0x96, 0x9f, 0x48, 0xd5, 0xbd,
+	0xa5, 0xb9, 0x56, 0x4a, 0x5c, 0x65, 0x0a, 0xfb, 0xab, 0x01, 0x9a, 0xf9, 0x0d, 0x7e, 0x86, 0x52,
+	0x8e, 0xe1, 0x2a, 0x00, 0x81, 0xf2, 0x3d, 0x12, 0x2a, 0xb4, 0x6e, 0x3d, 0x57, 0x0e, 0xc2, 0xec,
+	0x2a, 0x13, 0xea, 0xb3, 0x94, 0x86, 0xb2, 0x6d, 0xad, 0xab, 0x5d, 0xd8, 0x02, 0x35, 0x96, 0x0a,
+	0x75, 0x54, 0x95, 0x47, 0x7f, 0xfc, 0xac, 0x28, 0x3e, 0x89, 0x49, 0x82, 0xb9, 0x87, 0x84, 0x39,
+	0x2d, 0x79, 0xeb, 0xb9, 0xb2, 0x2b, 0xe0, 0x0a, 0xa8, 0xc7, 0x59, 0xf3, 0xd0, 0xf3, 0xc7, 0xe6,
+	0x8c, 0x6c, 0x59, 0x53, 0x42, 0x67, 0x3c, 0x69, 0xa1, 0x45, 0xe8, 0xce, 0xe1, 0xe9, 0x85, 0x65,
+	0x9c, 0x5d, 0x58, 0xc6, 0x8f, 0x0b, 0xcb, 0x78, 0x7f, 0x69, 0x55, 0xce, 0x2e, 0xad, 0xca, 0xb7,
+	0x4b, 0xab, 0xf2, 0xd2, 0xed, 0x13, 0x11, 0xa5, 0x7e, 0x3b, 0x60, 0x43, 0xc7, 0x27, 0xc2, 0x47,
+	0x61, 0x1f, 0xf3, 0xbf, 0x56, 0x10, 0x21, 0x42, 0x9d, 0x13, 0x87, 0xf8, 0xc1, 0x76, 0x56, 0x7b,
+	0xbb, 0xf0, 0x36, 0xfa, 0xb3, 0xf2, 0x15, 0xbb, 0xff, 0x7b, 0x00, 0xac, 0x9e, 0x6f, 0x42, 0x38,
+	0x05, 0x00, 0x00,
 }
 
 func (this *ChannelFlow) Equal(that interface{}) bool {
@@ -364,6 +617,128 @@ func (this *AddressTransferData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WindowBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WindowBucket)
+	if !ok {
+		that2, ok := that.(WindowBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BucketIndex != that1.BucketIndex {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *WindowBuckets) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WindowBuckets)
+	if !ok {
+		that2, ok := that.(WindowBuckets)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Buckets) != len(that1.Buckets) {
+		return false
+	}
+	for i := range this.Buckets {
+		if !this.Buckets[i].Equal(&that1.Buckets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SupplySnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplySnapshot)
+	if !ok {
+		that2, ok := that.(SupplySnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.SnapshotHeight != that1.SnapshotHeight {
+		return false
+	}
+	return true
+}
+func (this *ChannelPause) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChannelPause)
+	if !ok {
+		that2, ok := that.(ChannelPause)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if this.Inbound != that1.Inbound {
+		return false
+	}
+	if this.Outbound != that1.Outbound {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if this.PausedBy != that1.PausedBy {
+		return false
+	}
+	return true
+}
 func (m *ChannelFlow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -500,35 +875,215 @@ func (m *AddressTransferData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WindowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ChannelFlow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NetFlow.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+
+func (m *WindowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChannelFlowWindow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WindowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WindowStart != 0 {
+	if m.Count != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BucketIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BucketIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowBuckets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowBuckets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowBuckets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SnapshotHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Outbound {
+		i--
+		if m.Outbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Inbound {
+		i--
+		if m.Inbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetFlow.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ChannelFlowWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
 		n += 1 + sovTypes(uint64(m.WindowStart))
 	}
 	if m.WindowDuration != 0 {
@@ -561,18 +1116,344 @@ func (m *AddressTransferData) Size() (n int) {
 	if m.TransferCount != 0 {
 		n += 1 + sovTypes(uint64(m.TransferCount))
 	}
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *WindowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketIndex != 0 {
+		n += 1 + sovTypes(uint64(m.BucketIndex))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovTypes(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *WindowBuckets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SnapshotHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SnapshotHeight))
+	}
+	return n
+}
+
+func (m *ChannelPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Inbound {
+		n += 2
+	}
+	if m.Outbound {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetFlow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetFlow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFlowWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFlowWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFlowWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
+			}
+			m.WindowDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UniqueSenders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UniqueSenders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UniqueSenders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ChannelFlow) Unmarshal(dAtA []byte) error {
+func (m *AddressTransferData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -595,15 +1476,34 @@ func (m *ChannelFlow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelFlow: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressTransferData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressTransferData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferCount", wireType)
+			}
+			m.TransferCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetFlow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -631,7 +1531,7 @@ func (m *ChannelFlow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetFlow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -656,7 +1556,7 @@ func (m *ChannelFlow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelFlowWindow) Unmarshal(dAtA []byte) error {
+func (m *WindowBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -679,17 +1579,17 @@ func (m *ChannelFlowWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelFlowWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelFlowWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
 			}
-			m.WindowStart = 0
+			m.BucketIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -699,16 +1599,50 @@ func (m *ChannelFlowWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowStart |= int64(b&0x7F) << shift
+				m.BucketIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.WindowDuration = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -718,7 +1652,7 @@ func (m *ChannelFlowWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowDuration |= int64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -744,7 +1678,7 @@ func (m *ChannelFlowWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UniqueSenders) Unmarshal(dAtA []byte) error {
+func (m *WindowBuckets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -767,15 +1701,99 @@ func (m *UniqueSenders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UniqueSenders: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowBuckets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UniqueSenders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowBuckets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, WindowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -803,8 +1821,29 @@ func (m *UniqueSenders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
+			}
+			m.SnapshotHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -826,7 +1865,7 @@ func (m *UniqueSenders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressTransferData) Unmarshal(dAtA []byte) error {
+func (m *ChannelPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -849,17 +1888,17 @@ func (m *AddressTransferData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressTransferData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressTransferData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.TransferCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -869,14 +1908,86 @@ func (m *AddressTransferData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TransferCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inbound = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outbound = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -904,9 +2015,7 @@ func (m *AddressTransferData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
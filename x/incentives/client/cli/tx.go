@@ -0,0 +1,272 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+const (
+	FlagMinLockDuration = "min-lock-duration"
+	FlagStartTime       = "start-time"
+)
+
+// GetTxCmd returns the transaction commands for this module
+// Params updates are governance proposals and are not exposed here
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdLockTokens())
+	cmd.AddCommand(CmdBeginUnlocking())
+	cmd.AddCommand(CmdCreateGauge())
+	cmd.AddCommand(CmdAddToGauge())
+	cmd.AddCommand(CmdClaimRewards())
+	cmd.AddCommand(CmdReclaimGauge())
+
+	return cmd
+}
+
+func CmdLockTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-tokens [share-coin] [duration]",
+		Short: "Lock pool shares for one of the lockable durations to earn gauge rewards",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgLockTokens{
+				Owner:    clientCtx.GetFromAddress().String(),
+				Duration: duration,
+				Coin:     coin,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBeginUnlocking() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-unlocking [lock-id]",
+		Short: "Start unbonding a lock; the shares are returned once its duration has passed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBeginUnlocking{
+				Owner:  clientCtx.GetFromAddress().String(),
+				LockId: lockId,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCreateGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-gauge [pool-id] [coins] [num-epochs]",
+		Short: "Create a gauge distributing rewards to locked shares of a pool over a number of epochs, paying the gauge creation fee",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			numEpochs, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			minLockDuration, err := cmd.Flags().GetDuration(FlagMinLockDuration)
+			if err != nil {
+				return err
+			}
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			var startTime time.Time
+			if startTimeStr != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgCreateGauge{
+				Owner:             clientCtx.GetFromAddress().String(),
+				PoolId:            poolId,
+				MinLockDuration:   minLockDuration,
+				Coins:             coins,
+				StartTime:         startTime,
+				NumEpochsPaidOver: numEpochs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagMinLockDuration, 0, "Shortest lock duration that earns the gauge rewards")
+	cmd.Flags().String(FlagStartTime, "", "RFC3339 time after which the gauge starts distributing (defaults to the block time)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAddToGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-gauge [gauge-id] [coins]",
+		Short: "Add rewards to an unfinished gauge you own",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			rewards, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddToGauge{
+				Owner:   clientCtx.GetFromAddress().String(),
+				GaugeId: gaugeId,
+				Rewards: rewards,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClaimRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Short: "Claim all distributed gauge rewards of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimRewards{
+				Owner: clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReclaimGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reclaim-gauge [gauge-id]",
+		Short: "Reclaim the undistributed coins of an owned gauge, once its scheduled epochs have passed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReclaimGauge{
+				Owner:   clientCtx.GetFromAddress().String(),
+				GaugeId: gaugeId,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
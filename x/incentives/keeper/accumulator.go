@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+// GetAccumulator gets the reward accumulator of a pool and lock duration, or an empty one if there are no bonded shares
+func (k Keeper) GetAccumulator(ctx sdk.Context, poolId uint64, duration time.Duration) (types.DurationAccumulator, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccumulatorKey(poolId, duration))
+	if bz == nil {
+		return types.NewDurationAccumulator(poolId, duration), nil
+	}
+
+	var acc types.DurationAccumulator
+	if err := k.cdc.Unmarshal(bz, &acc); err != nil {
+		return types.DurationAccumulator{}, err
+	}
+	return acc, nil
+}
+
+// setAccumulator stores an accumulator, deleting it once it has no bonded shares
+// No lock refers to the rewards per share of a deleted accumulator, so it can restart from zero
+func (k Keeper) setAccumulator(ctx sdk.Context, acc types.DurationAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	if !acc.TotalShares.IsPositive() {
+		store.Delete(types.AccumulatorKey(acc.PoolId, acc.Duration))
+		return
+	}
+	store.Set(types.AccumulatorKey(acc.PoolId, acc.Duration), k.cdc.MustMarshal(&acc))
+}
+
+// getPoolAccumulators gets the accumulators of a pool with a lock duration of at least minDuration, ordered by duration
+func (k Keeper) getPoolAccumulators(ctx sdk.Context, poolId uint64, minDuration time.Duration) ([]types.DurationAccumulator, error) {
+	if minDuration < 0 {
+		minDuration = 0
+	}
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.AccumulatorKey(poolId, minDuration), storetypes.PrefixEndBytes(types.PoolAccumulatorPrefix(poolId)))
+	defer iterator.Close()
+
+	accumulators := []types.DurationAccumulator{}
+	for ; iterator.Valid(); iterator.Next() {
+		var acc types.DurationAccumulator
+		if err := k.cdc.Unmarshal(iterator.Value(), &acc); err != nil {
+			return nil, err
+		}
+		accumulators = append(accumulators, acc)
+	}
+	return accumulators, nil
+}
+
+// GetAllAccumulators gets the accumulators of all pools, ordered by pool and lock duration
+func (k Keeper) GetAllAccumulators(ctx sdk.Context) []types.DurationAccumulator {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAccumulator)
+	defer iterator.Close()
+
+	accumulators := []types.DurationAccumulator{}
+	for ; iterator.Valid(); iterator.Next() {
+		var acc types.DurationAccumulator
+		k.cdc.MustUnmarshal(iterator.Value(), &acc)
+		accumulators = append(accumulators, acc)
+	}
+	return accumulators
+}
+
+// settleLock adds the rewards a bonded lock earned since it was last settled to the owner's unclaimed rewards
+// The caller must store the lock
+func (k Keeper) settleLock(ctx sdk.Context, lock *types.PeriodLock, acc types.DurationAccumulator) {
+	rewards := acc.RewardsSince(lock.SettledRewardsPerShare, lock.Coin.Amount)
+	lock.SettledRewardsPerShare = copyRewardsPerShare(acc.RewardsPerShare)
+	if !rewards.IsZero() {
+		k.addRewards(ctx, lock.Owner, rewards)
+	}
+}
+
+// copyRewardsPerShare copies rewards per share so that a lock does not alias its accumulator, keeping empty ones unset
+func copyRewardsPerShare(rewardsPerShare []types.RewardPerShare) []types.RewardPerShare {
+	if len(rewardsPerShare) == 0 {
+		return nil
+	}
+	return append([]types.RewardPerShare{}, rewardsPerShare...)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+// BeginBlocker ends the current epoch once it has lasted the epoch duration, distributing the active gauges,
+// and returns the shares of the locks that have finished unbonding to their owners
+// Each gauge and unlock is applied in its own cache context, and failures are logged and skipped
+// so that a single bad gauge or lock cannot halt the chain
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	epochInfo := k.GetEpochInfo(ctx)
+	switch {
+	case epochInfo.CurrentEpoch == 0:
+		// The first epoch starts at the first block
+		k.SetEpochInfo(ctx, types.EpochInfo{
+			CurrentEpoch:          1,
+			CurrentEpochStartTime: ctx.BlockTime(),
+		})
+
+	case !ctx.BlockTime().Before(epochInfo.CurrentEpochStartTime.Add(k.GetParams(ctx).EpochDuration)):
+		k.distributeGauges(ctx)
+
+		// Epochs start on a fixed schedule, so that they do not drift with block times
+		k.SetEpochInfo(ctx, types.EpochInfo{
+			CurrentEpoch:          epochInfo.CurrentEpoch + 1,
+			CurrentEpochStartTime: epochInfo.CurrentEpochStartTime.Add(k.GetParams(ctx).EpochDuration),
+		})
+	}
+
+	k.completeUnlocks(ctx)
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+// GetGauge gets a gauge by id
+func (k Keeper) GetGauge(ctx sdk.Context, gaugeId uint64) (types.Gauge, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GaugeKey(gaugeId))
+	if bz == nil {
+		return types.Gauge{}, errorsmod.Wrapf(types.ErrGaugeNotFound, "gauge %d", gaugeId)
+	}
+
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(bz, &gauge)
+	return gauge, nil
+}
+
+// setGauge stores a gauge, and indexes it as active until it has finished distributing
+func (k Keeper) setGauge(ctx sdk.Context, gauge types.Gauge) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GaugeKey(gauge.Id), k.cdc.MustMarshal(&gauge))
+	if gauge.IsFinished() {
+		store.Delete(types.ActiveGaugeKey(gauge.Id))
+	} else {
+		store.Set(types.ActiveGaugeKey(gauge.Id), []byte{1})
+	}
+}
+
+// GetAllGauges gets all gauges, including finished ones, ordered by id
+func (k Keeper) GetAllGauges(ctx sdk.Context) []types.Gauge {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixGauge)
+	defer iterator.Close()
+
+	gauges := []types.Gauge{}
+	for ; iterator.Valid(); iterator.Next() {
+		var gauge types.Gauge
+		k.cdc.MustUnmarshal(iterator.Value(), &gauge)
+		gauges = append(gauges, gauge)
+	}
+	return gauges
+}
+
+// GetActiveGauges gets the gauges that have not finished distributing, ordered by id
+func (k Keeper) GetActiveGauges(ctx sdk.Context) ([]types.Gauge, error) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixActiveGauge)
+	defer iterator.Close()
+
+	gauges := []types.Gauge{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		gauge, err := k.GetGauge(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if err != nil {
+			return nil, err
+		}
+		gauges = append(gauges, gauge)
+	}
+	return gauges, nil
+}
+
+// countActiveGauges returns the number of gauges that have not finished distributing
+func (k Keeper) countActiveGauges(ctx sdk.Context) uint64 {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixActiveGauge)
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// CreateGauge creates a gauge funded by the owner, which distributes its coins over numEpochsPaidOver epochs
+// to the shares of the pool locked for at least minLockDuration, which cannot exceed the longest lockable duration
+// The owner also pays the gauge creation fee to the community pool, and the number of active gauges is capped
+// A zero start time starts the gauge at the current block time
+func (k Keeper) CreateGauge(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	poolId uint64,
+	minLockDuration time.Duration,
+	coins sdk.Coins,
+	startTime time.Time,
+	numEpochsPaidOver uint64,
+) (uint64, error) {
+	totalShares, err := k.gammKeeper.GetTotalPoolShares(ctx, poolId)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidGauge, err.Error())
+	}
+	if !totalShares.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidGauge, "pool %d has no shares to lock", poolId)
+	}
+
+	if startTime.Unix() <= 0 {
+		startTime = ctx.BlockTime()
+	}
+
+	gauge := types.Gauge{
+		Id:                k.getNextId(ctx, types.KeyNextGaugeId),
+		Owner:             owner.String(),
+		PoolId:            poolId,
+		MinLockDuration:   minLockDuration,
+		Coins:             coins,
+		DistributedCoins:  sdk.Coins{},
+		StartTime:         startTime,
+		NumEpochsPaidOver: numEpochsPaidOver,
+	}
+	if err := gauge.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidGauge, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	if minLockDuration > params.MaxLockableDuration() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidGauge, "min lock duration %s exceeds the longest lockable duration %s", minLockDuration, params.MaxLockableDuration())
+	}
+	if k.countActiveGauges(ctx) >= params.MaxActiveGauges {
+		return 0, errorsmod.Wrapf(types.ErrTooManyGauges, "max %d", params.MaxActiveGauges)
+	}
+
+	if !params.GaugeCreationFee.IsZero() {
+		if err := k.sendManagerKeeper.FundCommunityPoolWithAliasRouting(ctx, owner, params.GaugeCreationFee); err != nil {
+			return 0, err
+		}
+	}
+	if err := k.sendManagerKeeper.SendCoinsFromAccountToModuleWithAliasRouting(ctx, owner, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+
+	k.setNextId(ctx, types.KeyNextGaugeId, gauge.Id+1)
+	k.setGauge(ctx, gauge)
+
+	return gauge.Id, nil
+}
+
+// AddToGauge adds rewards from the owner to a gauge that has not finished distributing
+// Only the owner can fund a gauge, since the owner can reclaim the coins the gauge did not distribute
+func (k Keeper) AddToGauge(ctx sdk.Context, owner sdk.AccAddress, gaugeId uint64, rewards sdk.Coins) error {
+	gauge, err := k.GetGauge(ctx, gaugeId)
+	if err != nil {
+		return err
+	}
+	if gauge.Owner != owner.String() {
+		return errorsmod.Wrapf(types.ErrNotGaugeOwner, "gauge %d is owned by %s", gaugeId, gauge.Owner)
+	}
+	if gauge.IsFinished() {
+		return errorsmod.Wrapf(types.ErrGaugeFinished, "gauge %d", gaugeId)
+	}
+
+	if err := k.sendManagerKeeper.SendCoinsFromAccountToModuleWithAliasRouting(ctx, owner, types.ModuleName, rewards); err != nil {
+		return err
+	}
+
+	gauge.Coins = gauge.Coins.Add(rewards...)
+	k.setGauge(ctx, gauge)
+
+	return nil
+}
+
+// ReclaimGauge returns the coins a gauge did not distribute to its owner, and finishes the gauge
+// Rewards of epochs without qualifying locks carry over, so they are left over if the last epochs have none
+// It can only be reclaimed once the gauge has finished or the epochs it was scheduled to distribute over have
+// passed since its start time
+func (k Keeper) ReclaimGauge(ctx sdk.Context, owner sdk.AccAddress, gaugeId uint64) (sdk.Coins, error) {
+	gauge, err := k.GetGauge(ctx, gaugeId)
+	if err != nil {
+		return nil, err
+	}
+	if gauge.Owner != owner.String() {
+		return nil, errorsmod.Wrapf(types.ErrNotGaugeOwner, "gauge %d is owned by %s", gaugeId, gauge.Owner)
+	}
+	reclaimableTime := gauge.StartTime.Add(time.Duration(gauge.NumEpochsPaidOver) * k.GetParams(ctx).EpochDuration)
+	if !gauge.IsFinished() && ctx.BlockTime().Before(reclaimableTime) {
+		return nil, errorsmod.Wrapf(types.ErrGaugeNotReclaimable, "gauge %d can be reclaimed from %s", gaugeId, reclaimableTime)
+	}
+	remaining := gauge.RemainingCoins()
+	if remaining.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrGaugeNotReclaimable, "gauge %d has no coins left", gaugeId)
+	}
+
+	gauge.Coins = gauge.DistributedCoins
+	gauge.FilledEpochs = gauge.NumEpochsPaidOver
+	k.setGauge(ctx, gauge)
+
+	if err := k.sendManagerKeeper.SendCoinsFromModuleToAccountWithAliasRouting(ctx, types.ModuleName, owner, remaining); err != nil {
+		return nil, err
+	}
+	return remaining, nil
+}
+
+// distributeGauges distributes an epoch's share of the remaining rewards of every active gauge to the
+// qualifying locks, pro-rata to their locked shares
+// Rewards are added to the rewards per share of the pool's accumulators, so distributing a gauge does not
+// depend on the number of locks. Each lock is settled when it is claimed or begins unlocking.
+// Gauges that fail to distribute are logged and skipped
+func (k Keeper) distributeGauges(ctx sdk.Context) {
+	gauges, err := k.GetActiveGauges(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("incentives: failed to get active gauges", "error", err)
+		return
+	}
+
+	for _, gauge := range gauges {
+		if !gauge.IsActive(ctx.BlockTime()) {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.distributeGauge(cacheCtx, &gauge); err != nil {
+			k.Logger(ctx).Error("incentives: failed to distribute gauge", "gauge_id", gauge.Id, "error", err)
+			continue
+		}
+		k.setGauge(cacheCtx, gauge)
+		writeCache()
+	}
+}
+
+// distributeGauge adds an epoch's share of the remaining rewards of a gauge to the rewards per share of
+// the pool's accumulators with at least the gauge's minimum lock duration
+// The epoch counts towards the gauge's epochs even if there are no such bonded shares, so every gauge finishes on
+// schedule. Its rewards then carry over to the following epochs, and are left for the owner to reclaim after the last.
+func (k Keeper) distributeGauge(ctx sdk.Context, gauge *types.Gauge) error {
+	accumulators, err := k.getPoolAccumulators(ctx, gauge.PoolId, gauge.MinLockDuration)
+	if err != nil {
+		return err
+	}
+
+	totalShares := osmomath.ZeroInt()
+	for _, acc := range accumulators {
+		totalShares = totalShares.Add(acc.TotalShares)
+	}
+	if !totalShares.IsPositive() {
+		gauge.FilledEpochs++
+		return nil
+	}
+
+	// Each epoch distributes remaining / remaining epochs, rounded down, so the last epoch distributes
+	// everything that remains and the gauge ends fully distributed
+	remainingEpochs := osmomath.NewIntFromUint64(gauge.NumEpochsPaidOver - gauge.FilledEpochs)
+	epochRewards := sdk.Coins{}
+	for _, coin := range gauge.RemainingCoins() {
+		amount := coin.Amount.Quo(remainingEpochs)
+		if amount.IsPositive() {
+			epochRewards = epochRewards.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	if !epochRewards.IsZero() {
+		rewardsPerShare := types.NewRewardsPerShare(epochRewards, totalShares)
+		for _, acc := range accumulators {
+			acc.AddRewardsPerShare(rewardsPerShare)
+			k.setAccumulator(ctx, acc)
+		}
+	}
+
+	gauge.DistributedCoins = gauge.DistributedCoins.Add(epochRewards...)
+	gauge.FilledEpochs++
+	return nil
+}
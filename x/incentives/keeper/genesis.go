@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+// ImportState imports the epoch, locks, gauges, rewards and accumulators of a genesis state
+func (k Keeper) ImportState(ctx sdk.Context, genState types.GenesisState) {
+	k.SetEpochInfo(ctx, genState.EpochInfo)
+	// Unset ids default to 1
+	if genState.NextLockId != 0 {
+		k.setNextId(ctx, types.KeyNextLockId, genState.NextLockId)
+	}
+	if genState.NextGaugeId != 0 {
+		k.setNextId(ctx, types.KeyNextGaugeId, genState.NextGaugeId)
+	}
+
+	for _, lock := range genState.Locks {
+		k.setLock(ctx, lock)
+	}
+	for _, gauge := range genState.Gauges {
+		k.setGauge(ctx, gauge)
+	}
+	for _, rewards := range genState.Rewards {
+		k.setAccountRewards(ctx, rewards)
+	}
+	for _, acc := range genState.Accumulators {
+		k.setAccumulator(ctx, acc)
+	}
+}
+
+// ExportState exports the epoch, locks, gauges, rewards and accumulators into a genesis state
+func (k Keeper) ExportState(ctx sdk.Context, genState *types.GenesisState) {
+	genState.EpochInfo = k.GetEpochInfo(ctx)
+	genState.Locks = k.GetAllLocks(ctx)
+	genState.Gauges = k.GetAllGauges(ctx)
+	genState.Rewards = k.GetAllAccountRewards(ctx)
+	genState.NextLockId = k.getNextId(ctx, types.KeyNextLockId)
+	genState.NextGaugeId = k.getNextId(ctx, types.KeyNextGaugeId)
+	genState.Accumulators = k.GetAllAccumulators(ctx)
+}
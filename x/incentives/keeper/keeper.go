@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	sendManagerKeeper types.SendManagerKeeper
+	gammKeeper        types.GammKeeper
+	authority         string
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	sendManagerKeeper types.SendManagerKeeper,
+	gammKeeper types.GammKeeper,
+	authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return Keeper{
+		cdc:               cdc,
+		storeKey:          storeKey,
+		sendManagerKeeper: sendManagerKeeper,
+		gammKeeper:        gammKeeper,
+		authority:         authority,
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetEpochInfo gets the current epoch
+func (k Keeper) GetEpochInfo(ctx sdk.Context) types.EpochInfo {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyEpochInfo)
+	if bz == nil {
+		return types.EpochInfo{}
+	}
+
+	var epochInfo types.EpochInfo
+	k.cdc.MustUnmarshal(bz, &epochInfo)
+	return epochInfo
+}
+
+// SetEpochInfo sets the current epoch
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epochInfo types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyEpochInfo, k.cdc.MustMarshal(&epochInfo))
+}
+
+// getNextId returns the id stored under key, or 1 if none is stored
+func (k Keeper) getNextId(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextId(ctx sdk.Context, key []byte, id uint64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(id))
+}
+
+// consumeNextId returns the id stored under key and increments it
+func (k Keeper) consumeNextId(ctx sdk.Context, key []byte) uint64 {
+	id := k.getNextId(ctx, key)
+	k.setNextId(ctx, key, id+1)
+	return id
+}
@@ -0,0 +1,498 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/apptesting"
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+	"github.com/bitbadges/bitbadgeschain/x/incentives/keeper"
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+const rewardDenom = "reward"
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	poolId     uint64
+	shareDenom string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Reset()
+
+	s.poolId = s.PrepareBalancerPool()
+	s.shareDenom = gammtypes.GetPoolShareDenom(s.poolId)
+
+	// Give the second account a quarter of the pool shares
+	err := s.App.BankKeeper.SendCoins(s.Ctx, s.TestAccs[0], s.TestAccs[1], sdk.NewCoins(s.shares(25)))
+	s.Require().NoError(err)
+
+	s.App.IncentivesKeeper.SetEpochInfo(s.Ctx, types.EpochInfo{
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: s.Ctx.BlockTime(),
+	})
+}
+
+func (s *KeeperTestSuite) shares(amount int64) sdk.Coin {
+	return sdk.NewCoin(s.shareDenom, gammtypes.OneShare.MulRaw(amount))
+}
+
+// endEpoch moves the block time to the end of the current epoch and runs the begin blocker
+func (s *KeeperTestSuite) endEpoch() {
+	epochInfo := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx)
+	epochDuration := s.App.IncentivesKeeper.GetParams(s.Ctx).EpochDuration
+	s.Ctx = s.Ctx.WithBlockTime(epochInfo.CurrentEpochStartTime.Add(epochDuration))
+	s.App.IncentivesKeeper.BeginBlocker(s.Ctx)
+	s.Require().Equal(epochInfo.CurrentEpoch+1, s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).CurrentEpoch)
+}
+
+func (s *KeeperTestSuite) createGauge(minLockDuration time.Duration, amount int64, numEpochs uint64) uint64 {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, amount))
+	s.FundAcc(s.TestAccs[2], coins.Add(s.App.IncentivesKeeper.GetParams(s.Ctx).GaugeCreationFee...))
+
+	gaugeId, err := s.App.IncentivesKeeper.CreateGauge(s.Ctx, s.TestAccs[2], s.poolId, minLockDuration, coins, time.Time{}, numEpochs)
+	s.Require().NoError(err)
+	return gaugeId
+}
+
+func (s *KeeperTestSuite) claimableRewards(owner sdk.AccAddress) sdk.Coins {
+	rewards, err := s.App.IncentivesKeeper.GetClaimableRewards(s.Ctx, owner)
+	s.Require().NoError(err)
+	return rewards
+}
+
+func (s *KeeperTestSuite) TestLockTokens() {
+	k := s.App.IncentivesKeeper
+
+	tests := []struct {
+		name        string
+		coin        sdk.Coin
+		duration    time.Duration
+		expectedErr error
+	}{
+		{
+			name:     "valid lock",
+			coin:     s.shares(10),
+			duration: 24 * time.Hour,
+		},
+		{
+			name:        "not a pool share",
+			coin:        sdk.NewInt64Coin(apptesting.FOO, 10),
+			duration:    24 * time.Hour,
+			expectedErr: types.ErrInvalidLock,
+		},
+		{
+			name:        "below min lock amount",
+			coin:        sdk.NewCoin(s.shareDenom, types.DefaultMinLockAmount.SubRaw(1)),
+			duration:    24 * time.Hour,
+			expectedErr: types.ErrInvalidLock,
+		},
+		{
+			name:        "duration not lockable",
+			coin:        s.shares(10),
+			duration:    2 * 24 * time.Hour,
+			expectedErr: types.ErrInvalidLock,
+		},
+		{
+			name:        "zero duration",
+			coin:        s.shares(10),
+			duration:    0,
+			expectedErr: types.ErrInvalidLock,
+		},
+		{
+			name:        "duration above max",
+			coin:        s.shares(10),
+			duration:    types.DefaultMaxLockDuration + time.Second,
+			expectedErr: types.ErrInvalidLock,
+		},
+		{
+			name:        "insufficient shares",
+			coin:        s.shares(1000),
+			duration:    24 * time.Hour,
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			lock, err := k.LockTokens(s.Ctx, s.TestAccs[0], tc.coin, tc.duration)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			stored, err := k.GetLock(s.Ctx, lock.Id)
+			s.Require().NoError(err)
+			s.Require().Equal(lock, stored)
+			s.Require().False(stored.IsUnbonding())
+
+			bonded, err := k.GetPoolBondedLocks(s.Ctx, s.poolId)
+			s.Require().NoError(err)
+			s.Require().Contains(bonded, lock)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDistributeGauge() {
+	k := s.App.IncentivesKeeper
+
+	// Locked 3:1 for different durations, so rewards are split 3:1
+	_, err := k.LockTokens(s.Ctx, s.TestAccs[0], s.shares(75), 24*time.Hour)
+	s.Require().NoError(err)
+	_, err = k.LockTokens(s.Ctx, s.TestAccs[1], s.shares(25), 7*24*time.Hour)
+	s.Require().NoError(err)
+
+	gaugeId := s.createGauge(0, 1000, 2)
+
+	s.endEpoch()
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 375)), s.claimableRewards(s.TestAccs[0]))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 125)), s.claimableRewards(s.TestAccs[1]))
+
+	s.endEpoch()
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 750)), s.claimableRewards(s.TestAccs[0]))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 250)), s.claimableRewards(s.TestAccs[1]))
+
+	gauge, err := k.GetGauge(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().True(gauge.IsFinished())
+	s.Require().Equal(gauge.Coins, gauge.DistributedCoins)
+
+	active, err := k.GetActiveGauges(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(active)
+
+	// Finished gauges cannot be topped up
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 10)))
+	err = k.AddToGauge(s.Ctx, s.TestAccs[2], gaugeId, sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrGaugeFinished)
+
+	claimed, err := k.ClaimRewards(s.Ctx, s.TestAccs[1])
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 250)), claimed)
+	s.Require().Equal(osmomath.NewInt(250), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], rewardDenom).Amount)
+	s.Require().True(s.claimableRewards(s.TestAccs[1]).IsZero())
+
+	_, err = k.ClaimRewards(s.Ctx, s.TestAccs[1])
+	s.Require().ErrorIs(err, types.ErrNoRewardsToClaim)
+}
+
+func (s *KeeperTestSuite) TestDistributeGaugeMinLockDuration() {
+	k := s.App.IncentivesKeeper
+
+	gaugeId := s.createGauge(7*24*time.Hour, 1000, 2)
+
+	// No qualifying locks, so the epoch's rewards carry over to the next epoch
+	_, err := k.LockTokens(s.Ctx, s.TestAccs[0], s.shares(75), 24*time.Hour)
+	s.Require().NoError(err)
+	s.endEpoch()
+
+	gauge, err := k.GetGauge(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), gauge.FilledEpochs)
+	s.Require().True(gauge.DistributedCoins.IsZero())
+	s.Require().True(s.claimableRewards(s.TestAccs[0]).IsZero())
+
+	// Only the long lock earns the rewards
+	_, err = k.LockTokens(s.Ctx, s.TestAccs[1], s.shares(25), 14*24*time.Hour)
+	s.Require().NoError(err)
+	s.endEpoch()
+
+	s.Require().True(s.claimableRewards(s.TestAccs[0]).IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 1000)), s.claimableRewards(s.TestAccs[1]))
+
+	// A gauge cannot require a longer lock than any lockable duration
+	coins := sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 1000))
+	s.FundAcc(s.TestAccs[2], coins.Add(k.GetParams(s.Ctx).GaugeCreationFee...))
+	_, err = k.CreateGauge(s.Ctx, s.TestAccs[2], s.poolId, 15*24*time.Hour, coins, time.Time{}, 2)
+	s.Require().ErrorIs(err, types.ErrInvalidGauge)
+}
+
+func (s *KeeperTestSuite) TestRewardsSettledOnUnlockAndClaim() {
+	k := s.App.IncentivesKeeper
+
+	_, err := k.LockTokens(s.Ctx, s.TestAccs[0], s.shares(25), 24*time.Hour)
+	s.Require().NoError(err)
+	lock, err := k.LockTokens(s.Ctx, s.TestAccs[1], s.shares(25), 24*time.Hour)
+	s.Require().NoError(err)
+	s.createGauge(0, 1000, 2)
+
+	// Distributing only updates the accumulator, the locks are settled lazily
+	s.endEpoch()
+	s.Require().Empty(k.GetAllAccountRewards(s.Ctx))
+	acc, err := k.GetAccumulator(s.Ctx, s.poolId, 24*time.Hour)
+	s.Require().NoError(err)
+	s.Require().Equal(s.shares(50).Amount, acc.TotalShares)
+
+	// Unlocking settles the lock's rewards, and it earns nothing afterwards
+	_, err = k.BeginUnlocking(s.Ctx, s.TestAccs[1], lock.Id)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.AccountRewards{{Address: s.TestAccs[1].String(), Rewards: sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 250))}}, k.GetAllAccountRewards(s.Ctx))
+
+	// The last epoch goes to the remaining lock alone
+	s.endEpoch()
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 250)), s.claimableRewards(s.TestAccs[1]))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 750)), s.claimableRewards(s.TestAccs[0]))
+
+	claimed, err := k.ClaimRewards(s.Ctx, s.TestAccs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 750)), claimed)
+	s.Require().True(s.claimableRewards(s.TestAccs[0]).IsZero())
+}
+
+func (s *KeeperTestSuite) TestCreateGaugeFeeAndCap() {
+	k := s.App.IncentivesKeeper
+	params := k.GetParams(s.Ctx)
+	params.MaxActiveGauges = 1
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+
+	communityPool := s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	feeBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, communityPool)
+	s.createGauge(0, 1000, 2)
+	s.Require().Equal(feeBefore.Add(params.GaugeCreationFee...), s.App.BankKeeper.GetAllBalances(s.Ctx, communityPool))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 1000))
+	s.FundAcc(s.TestAccs[2], coins.Add(params.GaugeCreationFee...))
+	_, err := k.CreateGauge(s.Ctx, s.TestAccs[2], s.poolId, 0, coins, time.Time{}, 2)
+	s.Require().ErrorIs(err, types.ErrTooManyGauges)
+}
+
+func (s *KeeperTestSuite) TestReclaimGauge() {
+	k := s.App.IncentivesKeeper
+
+	// Without qualifying locks, the gauge distributes nothing but still finishes on schedule
+	gaugeId := s.createGauge(7*24*time.Hour, 1000, 2)
+	_, err := k.LockTokens(s.Ctx, s.TestAccs[0], s.shares(75), 24*time.Hour)
+	s.Require().NoError(err)
+
+	_, err = k.ReclaimGauge(s.Ctx, s.TestAccs[0], gaugeId)
+	s.Require().ErrorIs(err, types.ErrNotGaugeOwner)
+
+	// Only the owner can fund the gauge, so reclaiming it cannot take anyone else's coins
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 500)))
+	err = k.AddToGauge(s.Ctx, s.TestAccs[1], gaugeId, sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 500)))
+	s.Require().ErrorIs(err, types.ErrNotGaugeOwner)
+
+	// Not before the epochs it was scheduled over have passed
+	s.endEpoch()
+	_, err = k.ReclaimGauge(s.Ctx, s.TestAccs[2], gaugeId)
+	s.Require().ErrorIs(err, types.ErrGaugeNotReclaimable)
+
+	s.endEpoch()
+	active, err := k.GetActiveGauges(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(active)
+
+	coins, err := k.ReclaimGauge(s.Ctx, s.TestAccs[2], gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 1000)), coins)
+	s.Require().Equal(osmomath.NewInt(1000), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[2], rewardDenom).Amount)
+	s.Require().Equal(osmomath.NewInt(500), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], rewardDenom).Amount)
+	gauge, err := k.GetGauge(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().True(gauge.Coins.IsZero())
+
+	_, err = k.ReclaimGauge(s.Ctx, s.TestAccs[2], gaugeId)
+	s.Require().ErrorIs(err, types.ErrGaugeNotReclaimable)
+
+	// The rewards of the epochs after the qualifying locks left are reclaimed as well
+	gaugeId = s.createGauge(7*24*time.Hour, 1000, 2)
+	lock, err := k.LockTokens(s.Ctx, s.TestAccs[1], s.shares(25), 14*24*time.Hour)
+	s.Require().NoError(err)
+	s.endEpoch()
+	_, err = k.BeginUnlocking(s.Ctx, s.TestAccs[1], lock.Id)
+	s.Require().NoError(err)
+	s.endEpoch()
+
+	coins, err = k.ReclaimGauge(s.Ctx, s.TestAccs[2], gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 500)), coins)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 500)), s.claimableRewards(s.TestAccs[1]))
+	gauge, err = k.GetGauge(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(gauge.DistributedCoins, gauge.Coins)
+}
+
+func (s *KeeperTestSuite) TestBeginUnlocking() {
+	k := s.App.IncentivesKeeper
+
+	lock, err := k.LockTokens(s.Ctx, s.TestAccs[1], s.shares(25), 24*time.Hour)
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], s.shareDenom).IsZero())
+
+	_, err = k.BeginUnlocking(s.Ctx, s.TestAccs[0], lock.Id)
+	s.Require().ErrorIs(err, types.ErrNotLockOwner)
+
+	unlockTime, err := k.BeginUnlocking(s.Ctx, s.TestAccs[1], lock.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime().Add(24*time.Hour), unlockTime)
+
+	_, err = k.BeginUnlocking(s.Ctx, s.TestAccs[1], lock.Id)
+	s.Require().ErrorIs(err, types.ErrLockUnbonding)
+
+	// Unbonding locks no longer earn rewards
+	bonded, err := k.GetPoolBondedLocks(s.Ctx, s.poolId)
+	s.Require().NoError(err)
+	s.Require().Empty(bonded)
+
+	// Shares are only returned once the lock duration has passed
+	s.Ctx = s.Ctx.WithBlockTime(unlockTime.Add(-time.Second))
+	k.BeginBlocker(s.Ctx)
+	_, err = k.GetLock(s.Ctx, lock.Id)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(unlockTime)
+	k.BeginBlocker(s.Ctx)
+	_, err = k.GetLock(s.Ctx, lock.Id)
+	s.Require().ErrorIs(err, types.ErrLockNotFound)
+	s.Require().Equal(s.shares(25), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], s.shareDenom))
+
+	locks, err := k.GetAccountLocks(s.Ctx, s.TestAccs[1])
+	s.Require().NoError(err)
+	s.Require().Empty(locks)
+}
+
+func (s *KeeperTestSuite) TestPaginatedQueries() {
+	k := s.App.IncentivesKeeper
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	var lockIds []uint64
+	for i := 0; i < 3; i++ {
+		lock, err := k.LockTokens(s.Ctx, s.TestAccs[0], s.shares(10), 24*time.Hour)
+		s.Require().NoError(err)
+		lockIds = append(lockIds, lock.Id)
+	}
+	_, err := k.BeginUnlocking(s.Ctx, s.TestAccs[0], lockIds[0])
+	s.Require().NoError(err)
+	_, err = k.BeginUnlocking(s.Ctx, s.TestAccs[0], lockIds[2])
+	s.Require().NoError(err)
+
+	locksRes, err := queryServer.AccountLocks(s.Ctx, &types.QueryAccountLocksRequest{
+		Owner:      s.TestAccs[0].String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(locksRes.Locks, 2)
+	s.Require().NotNil(locksRes.Pagination.NextKey)
+
+	locksRes, err = queryServer.AccountLocks(s.Ctx, &types.QueryAccountLocksRequest{
+		Owner:      s.TestAccs[0].String(),
+		Pagination: &query.PageRequest{Key: locksRes.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(locksRes.Locks, 1)
+	s.Require().Equal(lockIds[2], locksRes.Locks[0].Id)
+
+	// Bonded locks are skipped without counting towards the limit
+	unbondingRes, err := queryServer.AccountUnbondingLocks(s.Ctx, &types.QueryAccountUnbondingLocksRequest{
+		Owner:      s.TestAccs[0].String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(unbondingRes.Locks, 2)
+	s.Require().Equal(uint64(2), unbondingRes.Pagination.Total)
+	s.Require().Equal(sdk.NewCoins(s.shares(20)), unbondingRes.UnbondingCoins)
+
+	otherPoolId := s.PrepareBalancerPool()
+	s.createGauge(0, 1000, 1)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 1000))
+	s.FundAcc(s.TestAccs[2], coins.Add(k.GetParams(s.Ctx).GaugeCreationFee...))
+	otherGaugeId, err := k.CreateGauge(s.Ctx, s.TestAccs[2], otherPoolId, 0, coins, time.Time{}, 1)
+	s.Require().NoError(err)
+	s.createGauge(0, 1000, 2)
+
+	gaugesRes, err := queryServer.Gauges(s.Ctx, &types.QueryGaugesRequest{
+		PoolId:     s.poolId,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(gaugesRes.Gauges, 1)
+	s.Require().Equal(uint64(2), gaugesRes.Pagination.Total)
+
+	// The first two gauges finish after an epoch
+	s.endEpoch()
+	gaugesRes, err = queryServer.Gauges(s.Ctx, &types.QueryGaugesRequest{ActiveOnly: true})
+	s.Require().NoError(err)
+	s.Require().Len(gaugesRes.Gauges, 1)
+	s.Require().NotEqual(otherGaugeId, gaugesRes.Gauges[0].Id)
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerSkipsFailedUnlocks() {
+	k := s.App.IncentivesKeeper
+
+	lock, err := k.LockTokens(s.Ctx, s.TestAccs[1], s.shares(25), 24*time.Hour)
+	s.Require().NoError(err)
+	unlockTime, err := k.BeginUnlocking(s.Ctx, s.TestAccs[1], lock.Id)
+	s.Require().NoError(err)
+
+	// A lock whose shares the module does not hold cannot be returned
+	unbacked := types.PeriodLock{
+		Id:       lock.Id + 1,
+		Owner:    s.TestAccs[0].String(),
+		Duration: time.Hour,
+		EndTime:  unlockTime,
+		Coin:     s.shares(1000),
+	}
+	k.ImportState(s.Ctx, types.GenesisState{Locks: []types.PeriodLock{unbacked}, NextLockId: unbacked.Id + 1})
+
+	s.Ctx = s.Ctx.WithBlockTime(unlockTime)
+	k.BeginBlocker(s.Ctx)
+
+	// The failed unlock is skipped without reverting the other one
+	_, err = k.GetLock(s.Ctx, lock.Id)
+	s.Require().ErrorIs(err, types.ErrLockNotFound)
+	s.Require().Equal(s.shares(25), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], s.shareDenom))
+	_, err = k.GetLock(s.Ctx, unbacked.Id)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestExportImportState() {
+	k := s.App.IncentivesKeeper
+
+	_, err := k.LockTokens(s.Ctx, s.TestAccs[0], s.shares(75), 24*time.Hour)
+	s.Require().NoError(err)
+	lock, err := k.LockTokens(s.Ctx, s.TestAccs[1], s.shares(25), 7*24*time.Hour)
+	s.Require().NoError(err)
+	_, err = k.BeginUnlocking(s.Ctx, s.TestAccs[1], lock.Id)
+	s.Require().NoError(err)
+	s.createGauge(0, 1000, 2)
+	s.endEpoch()
+
+	exported := types.GenesisState{Params: k.GetParams(s.Ctx)}
+	k.ExportState(s.Ctx, &exported)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.Locks, 2)
+	s.Require().Len(exported.Gauges, 1)
+	// The bonded lock's rewards are not settled yet, and the unbonding lock has no bonded shares left
+	s.Require().Empty(exported.Rewards)
+	s.Require().Len(exported.Accumulators, 1)
+	s.Require().Equal(uint64(3), exported.NextLockId)
+	s.Require().Equal(uint64(2), exported.NextGaugeId)
+
+	s.SetupTest()
+	k = s.App.IncentivesKeeper
+	k.ImportState(s.Ctx, exported)
+
+	reexported := types.GenesisState{Params: k.GetParams(s.Ctx)}
+	k.ExportState(s.Ctx, &reexported)
+	s.Require().Equal(exported, reexported)
+
+	bonded, err := k.GetPoolBondedLocks(s.Ctx, s.poolId)
+	s.Require().NoError(err)
+	s.Require().Len(bonded, 1)
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+// GetLock gets a lock by id
+func (k Keeper) GetLock(ctx sdk.Context, lockId uint64) (types.PeriodLock, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LockKey(lockId))
+	if bz == nil {
+		return types.PeriodLock{}, errorsmod.Wrapf(types.ErrLockNotFound, "lock %d", lockId)
+	}
+
+	var lock types.PeriodLock
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, nil
+}
+
+// setLock stores a lock and indexes it by owner, and by pool while it is bonded or by end time while it is unbonding
+func (k Keeper) setLock(ctx sdk.Context, lock types.PeriodLock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LockKey(lock.Id), k.cdc.MustMarshal(&lock))
+	store.Set(types.AccountLockKey(sdk.MustAccAddressFromBech32(lock.Owner), lock.Id), []byte{1})
+	if lock.IsUnbonding() {
+		store.Delete(types.PoolLockKey(lock.PoolId(), lock.Id))
+		store.Set(types.UnlockQueueKey(lock.EndTime, lock.Id), []byte{1})
+	} else {
+		store.Set(types.PoolLockKey(lock.PoolId(), lock.Id), []byte{1})
+	}
+}
+
+// deleteLock deletes a lock and its indexes
+func (k Keeper) deleteLock(ctx sdk.Context, lock types.PeriodLock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LockKey(lock.Id))
+	store.Delete(types.AccountLockKey(sdk.MustAccAddressFromBech32(lock.Owner), lock.Id))
+	store.Delete(types.PoolLockKey(lock.PoolId(), lock.Id))
+	if lock.IsUnbonding() {
+		store.Delete(types.UnlockQueueKey(lock.EndTime, lock.Id))
+	}
+}
+
+// getLocksByIndex returns the locks whose ids are the last 8 bytes of the keys under an index prefix
+func (k Keeper) getLocksByIndex(ctx sdk.Context, prefix []byte) ([]types.PeriodLock, error) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	locks := []types.PeriodLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		lock, err := k.GetLock(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, lock)
+	}
+	return locks, nil
+}
+
+// GetAccountLocks gets the locks of an account, both bonded and unbonding, ordered by id
+func (k Keeper) GetAccountLocks(ctx sdk.Context, owner sdk.AccAddress) ([]types.PeriodLock, error) {
+	return k.getLocksByIndex(ctx, types.AccountLockPrefix(owner))
+}
+
+// GetPoolBondedLocks gets the bonded locks of the shares of a pool, ordered by id
+func (k Keeper) GetPoolBondedLocks(ctx sdk.Context, poolId uint64) ([]types.PeriodLock, error) {
+	return k.getLocksByIndex(ctx, types.PoolLockPrefix(poolId))
+}
+
+// GetAllLocks gets all locks, ordered by id
+func (k Keeper) GetAllLocks(ctx sdk.Context) []types.PeriodLock {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixLock)
+	defer iterator.Close()
+
+	locks := []types.PeriodLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		var lock types.PeriodLock
+		k.cdc.MustUnmarshal(iterator.Value(), &lock)
+		locks = append(locks, lock)
+	}
+	return locks
+}
+
+// LockTokens moves pool shares from the owner into a new lock with the given duration
+func (k Keeper) LockTokens(ctx sdk.Context, owner sdk.AccAddress, coin sdk.Coin, duration time.Duration) (types.PeriodLock, error) {
+	poolId, err := types.ValidateLockCoin(coin)
+	if err != nil {
+		return types.PeriodLock{}, errorsmod.Wrap(types.ErrInvalidLock, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	if coin.Amount.LT(params.MinLockAmount) {
+		return types.PeriodLock{}, errorsmod.Wrapf(types.ErrInvalidLock, "amount must be at least %s, got %s", params.MinLockAmount, coin.Amount)
+	}
+	if !params.IsLockableDuration(duration) {
+		return types.PeriodLock{}, errorsmod.Wrapf(types.ErrInvalidLock, "duration must be one of %v, got %s", params.LockableDurations, duration)
+	}
+
+	if err := k.sendManagerKeeper.SendCoinsFromAccountToModuleWithAliasRouting(ctx, owner, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	acc, err := k.GetAccumulator(ctx, poolId, duration)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	acc.TotalShares = acc.TotalShares.Add(coin.Amount)
+	k.setAccumulator(ctx, acc)
+
+	// The lock only earns the rewards distributed from now on
+	lock := types.PeriodLock{
+		Id:                     k.consumeNextId(ctx, types.KeyNextLockId),
+		Owner:                  owner.String(),
+		Duration:               duration,
+		Coin:                   coin,
+		SettledRewardsPerShare: copyRewardsPerShare(acc.RewardsPerShare),
+	}
+	k.setLock(ctx, lock)
+
+	return lock, nil
+}
+
+// BeginUnlocking starts unbonding a lock, which settles its rewards and stops it from earning more
+// Returns the time its shares are returned to the owner
+func (k Keeper) BeginUnlocking(ctx sdk.Context, owner sdk.AccAddress, lockId uint64) (time.Time, error) {
+	lock, err := k.GetLock(ctx, lockId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if lock.Owner != owner.String() {
+		return time.Time{}, errorsmod.Wrapf(types.ErrNotLockOwner, "lock %d is owned by %s", lockId, lock.Owner)
+	}
+	if lock.IsUnbonding() {
+		return time.Time{}, errorsmod.Wrapf(types.ErrLockUnbonding, "lock %d unbonds at %s", lockId, lock.EndTime)
+	}
+
+	acc, err := k.GetAccumulator(ctx, lock.PoolId(), lock.Duration)
+	if err != nil {
+		return time.Time{}, err
+	}
+	k.settleLock(ctx, &lock, acc)
+	acc.TotalShares = acc.TotalShares.Sub(lock.Coin.Amount)
+	k.setAccumulator(ctx, acc)
+
+	lock.EndTime = ctx.BlockTime().Add(lock.Duration)
+	k.setLock(ctx, lock)
+
+	return lock.EndTime, nil
+}
+
+// completeUnlocks returns the shares of the locks that have finished unbonding to their owners
+// Unlocks that fail are logged and retried in the next block
+func (k Keeper) completeUnlocks(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixUnlockQueue, storetypes.PrefixEndBytes(types.UnlockQueuePrefix(ctx.BlockTime())))
+	lockIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		lockIds = append(lockIds, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	iterator.Close()
+
+	for _, lockId := range lockIds {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.completeUnlock(cacheCtx, lockId); err != nil {
+			k.Logger(ctx).Error("incentives: failed to complete unlock", "lock_id", lockId, "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// completeUnlock deletes an unbonded lock and returns its shares to the owner
+func (k Keeper) completeUnlock(ctx sdk.Context, lockId uint64) error {
+	lock, err := k.GetLock(ctx, lockId)
+	if err != nil {
+		return err
+	}
+
+	k.deleteLock(ctx, lock)
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return err
+	}
+	return k.sendManagerKeeper.SendCoinsFromModuleToAccountWithAliasRouting(ctx, types.ModuleName, owner, sdk.NewCoins(lock.Coin))
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams implements the MsgServer interface
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// LockTokens implements the MsgServer interface
+func (k msgServer) LockTokens(goCtx context.Context, req *types.MsgLockTokens) (*types.MsgLockTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := k.Keeper.LockTokens(ctx, owner, req.Coin, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockTokensResponse{LockId: lock.Id}, nil
+}
+
+// BeginUnlocking implements the MsgServer interface
+func (k msgServer) BeginUnlocking(goCtx context.Context, req *types.MsgBeginUnlocking) (*types.MsgBeginUnlockingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockTime, err := k.Keeper.BeginUnlocking(ctx, owner, req.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBeginUnlockingResponse{UnlockTime: unlockTime}, nil
+}
+
+// CreateGauge implements the MsgServer interface
+func (k msgServer) CreateGauge(goCtx context.Context, req *types.MsgCreateGauge) (*types.MsgCreateGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	gaugeId, err := k.Keeper.CreateGauge(ctx, owner, req.PoolId, req.MinLockDuration, req.Coins, req.StartTime, req.NumEpochsPaidOver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGaugeResponse{GaugeId: gaugeId}, nil
+}
+
+// AddToGauge implements the MsgServer interface
+func (k msgServer) AddToGauge(goCtx context.Context, req *types.MsgAddToGauge) (*types.MsgAddToGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AddToGauge(ctx, owner, req.GaugeId, req.Rewards); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToGaugeResponse{}, nil
+}
+
+// ClaimRewards implements the MsgServer interface
+func (k msgServer) ClaimRewards(goCtx context.Context, req *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.Keeper.ClaimRewards(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
+
+// ReclaimGauge implements the MsgServer interface
+func (k msgServer) ReclaimGauge(goCtx context.Context, req *types.MsgReclaimGauge) (*types.MsgReclaimGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, err := k.Keeper.ReclaimGauge(ctx, owner, req.GaugeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReclaimGaugeResponse{Coins: coins}, nil
+}
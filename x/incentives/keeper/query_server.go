@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// Params implements the QueryServer interface
+func (k queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// EpochInfo implements the QueryServer interface
+func (k queryServer) EpochInfo(goCtx context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryEpochInfoResponse{EpochInfo: k.GetEpochInfo(ctx)}, nil
+}
+
+// Lock implements the QueryServer interface
+func (k queryServer) Lock(goCtx context.Context, req *types.QueryLockRequest) (*types.QueryLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lock, err := k.GetLock(ctx, req.LockId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryLockResponse{Lock: lock}, nil
+}
+
+// AccountLocks implements the QueryServer interface
+func (k queryServer) AccountLocks(goCtx context.Context, req *types.QueryAccountLocksRequest) (*types.QueryAccountLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountLockPrefix(owner))
+
+	locks := []types.PeriodLock{}
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		lock, err := k.GetLock(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		locks = append(locks, lock)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// AccountUnbondingLocks implements the QueryServer interface
+func (k queryServer) AccountUnbondingLocks(goCtx context.Context, req *types.QueryAccountUnbondingLocksRequest) (*types.QueryAccountUnbondingLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountLockPrefix(owner))
+
+	res := &types.QueryAccountUnbondingLocksResponse{
+		Locks:          []types.PeriodLock{},
+		UnbondingCoins: sdk.Coins{},
+	}
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		lock, err := k.GetLock(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return false, err
+		}
+		if !lock.IsUnbonding() {
+			return false, nil
+		}
+		if accumulate {
+			res.Locks = append(res.Locks, lock)
+			res.UnbondingCoins = res.UnbondingCoins.Add(lock.Coin)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+// Gauge implements the QueryServer interface
+func (k queryServer) Gauge(goCtx context.Context, req *types.QueryGaugeRequest) (*types.QueryGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gauge, err := k.GetGauge(ctx, req.GaugeId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGaugeResponse{Gauge: gauge}, nil
+}
+
+// Gauges implements the QueryServer interface
+func (k queryServer) Gauges(goCtx context.Context, req *types.QueryGaugesRequest) (*types.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Both the gauges and the active gauge index are keyed by gauge id
+	storePrefix := types.KeyPrefixGauge
+	if req.ActiveOnly {
+		storePrefix = types.KeyPrefixActiveGauge
+	}
+	gaugeStore := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+
+	res := &types.QueryGaugesResponse{Gauges: []types.Gauge{}}
+	pageRes, err := query.FilteredPaginate(gaugeStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		gauge, err := k.GetGauge(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return false, err
+		}
+		if req.PoolId != 0 && gauge.PoolId != req.PoolId {
+			return false, nil
+		}
+		if accumulate {
+			res.Gauges = append(res.Gauges, gauge)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+// ClaimableRewards implements the QueryServer interface
+func (k queryServer) ClaimableRewards(goCtx context.Context, req *types.QueryClaimableRewardsRequest) (*types.QueryClaimableRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rewards, err := k.GetClaimableRewards(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimableRewardsResponse{Rewards: rewards}, nil
+}
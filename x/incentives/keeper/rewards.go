@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+// getAccountRewards gets the settled rewards an account has not claimed yet
+func (k Keeper) getAccountRewards(ctx sdk.Context, owner sdk.AccAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.RewardsKey(owner))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	var rewards types.AccountRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards.Rewards
+}
+
+// GetClaimableRewards gets the rewards an account has not claimed yet, including the rewards its bonded locks
+// earned since they were last settled
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	locks, err := k.GetAccountLocks(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := k.getAccountRewards(ctx, owner)
+	for _, lock := range locks {
+		if lock.IsUnbonding() {
+			continue
+		}
+		acc, err := k.GetAccumulator(ctx, lock.PoolId(), lock.Duration)
+		if err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(acc.RewardsSince(lock.SettledRewardsPerShare, lock.Coin.Amount)...)
+	}
+	return rewards, nil
+}
+
+func (k Keeper) setAccountRewards(ctx sdk.Context, rewards types.AccountRewards) {
+	ctx.KVStore(k.storeKey).Set(types.RewardsKey(sdk.MustAccAddressFromBech32(rewards.Address)), k.cdc.MustMarshal(&rewards))
+}
+
+// addRewards adds to the unclaimed rewards of an account
+func (k Keeper) addRewards(ctx sdk.Context, owner string, rewards sdk.Coins) {
+	k.setAccountRewards(ctx, types.AccountRewards{
+		Address: owner,
+		Rewards: k.getAccountRewards(ctx, sdk.MustAccAddressFromBech32(owner)).Add(rewards...),
+	})
+}
+
+// GetAllAccountRewards gets the settled unclaimed rewards of all accounts
+func (k Keeper) GetAllAccountRewards(ctx sdk.Context) []types.AccountRewards {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRewards)
+	defer iterator.Close()
+
+	allRewards := []types.AccountRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.AccountRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		allRewards = append(allRewards, rewards)
+	}
+	return allRewards
+}
+
+// ClaimRewards settles the bonded locks of an account and pays out all of its unclaimed rewards
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	locks, err := k.GetAccountLocks(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, lock := range locks {
+		if lock.IsUnbonding() {
+			continue
+		}
+		acc, err := k.GetAccumulator(ctx, lock.PoolId(), lock.Duration)
+		if err != nil {
+			return nil, err
+		}
+		k.settleLock(ctx, &lock, acc)
+		k.setLock(ctx, lock)
+	}
+
+	rewards := k.getAccountRewards(ctx, owner)
+	if rewards.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNoRewardsToClaim, "account %s", owner)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.RewardsKey(owner))
+	if err := k.sendManagerKeeper.SendCoinsFromModuleToAccountWithAliasRouting(ctx, types.ModuleName, owner, rewards); err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
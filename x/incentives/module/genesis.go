@@ -0,0 +1,28 @@
+package module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/incentives/keeper"
+	incentivestypes "github.com/bitbadges/bitbadgeschain/x/incentives/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *incentivestypes.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	k.ImportState(ctx, *genState)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) incentivestypes.GenesisState {
+	genState := incentivestypes.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+	k.ExportState(ctx, &genState)
+	return genState
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+)
+
+// NewDurationAccumulator returns an accumulator without shares or rewards.
+func NewDurationAccumulator(poolId uint64, duration time.Duration) DurationAccumulator {
+	return DurationAccumulator{
+		PoolId:          poolId,
+		Duration:        duration,
+		TotalShares:     osmomath.ZeroInt(),
+		RewardsPerShare: []RewardPerShare{},
+	}
+}
+
+// NewRewardsPerShare returns the rewards per share of distributing coins to totalShares, rounded down
+// so that the rewards paid out to the shares never exceed coins.
+// CONTRACT: totalShares is positive
+func NewRewardsPerShare(coins sdk.Coins, totalShares osmomath.Int) []RewardPerShare {
+	rewardsPerShare := make([]RewardPerShare, 0, len(coins))
+	for _, coin := range coins {
+		rewardsPerShare = append(rewardsPerShare, RewardPerShare{
+			Denom:  coin.Denom,
+			Amount: osmomath.BigDecFromSDKInt(coin.Amount).QuoTruncate(osmomath.BigDecFromSDKInt(totalShares)),
+		})
+	}
+	return rewardsPerShare
+}
+
+// AddRewardsPerShare adds rewards per share to the accumulator, keeping the denoms sorted.
+func (acc *DurationAccumulator) AddRewardsPerShare(rewardsPerShare []RewardPerShare) {
+	for _, reward := range rewardsPerShare {
+		index := sort.Search(len(acc.RewardsPerShare), func(i int) bool { return acc.RewardsPerShare[i].Denom >= reward.Denom })
+		if index < len(acc.RewardsPerShare) && acc.RewardsPerShare[index].Denom == reward.Denom {
+			acc.RewardsPerShare[index].Amount = acc.RewardsPerShare[index].Amount.Add(reward.Amount)
+			continue
+		}
+		acc.RewardsPerShare = append(acc.RewardsPerShare, RewardPerShare{})
+		copy(acc.RewardsPerShare[index+1:], acc.RewardsPerShare[index:])
+		acc.RewardsPerShare[index] = RewardPerShare{Denom: reward.Denom, Amount: reward.Amount}
+	}
+}
+
+// RewardsSince returns the rewards earned by shares since the rewards per share were settled, rounded down.
+func (acc DurationAccumulator) RewardsSince(settled []RewardPerShare, shares osmomath.Int) sdk.Coins {
+	settledByDenom := make(map[string]osmomath.BigDec, len(settled))
+	for _, reward := range settled {
+		settledByDenom[reward.Denom] = reward.Amount
+	}
+
+	rewards := sdk.Coins{}
+	for _, reward := range acc.RewardsPerShare {
+		growth := reward.Amount
+		if settledAmount, ok := settledByDenom[reward.Denom]; ok {
+			growth = growth.Sub(settledAmount)
+		}
+		amount := osmomath.NewIntFromBigInt(growth.Mul(osmomath.BigDecFromSDKInt(shares)).TruncateInt().BigInt())
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.NewCoin(reward.Denom, amount))
+		}
+	}
+	return rewards
+}
+
+// Validate performs a stateless validation of an accumulator.
+func (acc DurationAccumulator) Validate() error {
+	if acc.PoolId == 0 {
+		return fmt.Errorf("pool_id must be specified")
+	}
+	if acc.Duration <= 0 {
+		return fmt.Errorf("duration must be positive, got %s", acc.Duration)
+	}
+	if acc.TotalShares.IsNil() || !acc.TotalShares.IsPositive() {
+		return fmt.Errorf("total_shares must be positive, got %s", acc.TotalShares)
+	}
+	for i, reward := range acc.RewardsPerShare {
+		if err := sdk.ValidateDenom(reward.Denom); err != nil {
+			return fmt.Errorf("invalid rewards_per_share[%d]: %w", i, err)
+		}
+		if reward.Amount.IsNil() || reward.Amount.IsNegative() {
+			return fmt.Errorf("invalid rewards_per_share[%d]: amount cannot be negative", i)
+		}
+		if i > 0 && acc.RewardsPerShare[i-1].Denom >= reward.Denom {
+			return fmt.Errorf("rewards_per_share must be sorted by denom without duplicates")
+		}
+	}
+	return nil
+}
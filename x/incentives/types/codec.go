@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "incentives/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgLockTokens{}, "incentives/MsgLockTokens", nil)
+	cdc.RegisterConcrete(&MsgBeginUnlocking{}, "incentives/MsgBeginUnlocking", nil)
+	cdc.RegisterConcrete(&MsgCreateGauge{}, "incentives/MsgCreateGauge", nil)
+	cdc.RegisterConcrete(&MsgAddToGauge{}, "incentives/MsgAddToGauge", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "incentives/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgReclaimGauge{}, "incentives/MsgReclaimGauge", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgLockTokens{},
+		&MsgBeginUnlocking{},
+		&MsgCreateGauge{},
+		&MsgAddToGauge{},
+		&MsgClaimRewards{},
+		&MsgReclaimGauge{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidSigner       = errorsmod.Register(ModuleName, 1, "expected gov account as only signer for proposal message")
+	ErrInvalidLock         = errorsmod.Register(ModuleName, 2, "invalid lock")
+	ErrLockNotFound        = errorsmod.Register(ModuleName, 3, "lock not found")
+	ErrNotLockOwner        = errorsmod.Register(ModuleName, 4, "sender is not the owner of the lock")
+	ErrLockUnbonding       = errorsmod.Register(ModuleName, 5, "lock is already unbonding")
+	ErrInvalidGauge        = errorsmod.Register(ModuleName, 6, "invalid gauge")
+	ErrGaugeNotFound       = errorsmod.Register(ModuleName, 7, "gauge not found")
+	ErrGaugeFinished       = errorsmod.Register(ModuleName, 8, "gauge has finished distributing")
+	ErrNoRewardsToClaim    = errorsmod.Register(ModuleName, 9, "no rewards to claim")
+	ErrTooManyGauges       = errorsmod.Register(ModuleName, 10, "too many active gauges")
+	ErrNotGaugeOwner       = errorsmod.Register(ModuleName, 11, "sender is not the owner of the gauge")
+	ErrGaugeNotReclaimable = errorsmod.Register(ModuleName, 12, "gauge cannot be reclaimed")
+)
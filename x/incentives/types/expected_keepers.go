@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+)
+
+// SendManagerKeeper defines the expected send manager keeper interface
+// Rewards may be alias denoms, so all transfers to and from the module are alias routed
+type SendManagerKeeper interface {
+	SendCoinsFromAccountToModuleWithAliasRouting(ctx sdk.Context, fromAddressAcc sdk.AccAddress, moduleName string, coins sdk.Coins) error
+	SendCoinsFromModuleToAccountWithAliasRouting(ctx sdk.Context, moduleName string, toAddressAcc sdk.AccAddress, coins sdk.Coins) error
+	FundCommunityPoolWithAliasRouting(ctx sdk.Context, from sdk.AccAddress, coins sdk.Coins) error
+}
+
+// GammKeeper defines the expected gamm keeper interface
+type GammKeeper interface {
+	GetTotalPoolShares(ctx sdk.Context, poolId uint64) (osmomath.Int, error)
+}
@@ -0,0 +1,711 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: incentives/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the incentives module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// epoch_info is the current epoch. If current_epoch is 0, the first epoch starts at the first block.
+	EpochInfo EpochInfo `protobuf:"bytes,2,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info"`
+	// locks are all share locks, including unbonding ones.
+	Locks []PeriodLock `protobuf:"bytes,3,rep,name=locks,proto3" json:"locks"`
+	// gauges are all gauges, including finished ones.
+	Gauges []Gauge `protobuf:"bytes,4,rep,name=gauges,proto3" json:"gauges"`
+	// rewards are the unclaimed rewards of each account.
+	Rewards []AccountRewards `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards"`
+	// next_lock_id is the id of the next lock.
+	NextLockId uint64 `protobuf:"varint,6,opt,name=next_lock_id,json=nextLockId,proto3" json:"next_lock_id,omitempty"`
+	// next_gauge_id is the id of the next gauge.
+	NextGaugeId uint64 `protobuf:"varint,7,opt,name=next_gauge_id,json=nextGaugeId,proto3" json:"next_gauge_id,omitempty"`
+	// accumulators are the reward accumulators of the bonded shares of each pool and lock duration.
+	Accumulators []DurationAccumulator `protobuf:"bytes,8,rep,name=accumulators,proto3" json:"accumulators"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad9fd4792f7015c7, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetEpochInfo() EpochInfo {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return EpochInfo{}
+}
+
+func (m *GenesisState) GetLocks() []PeriodLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewards() []AccountRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLockId() uint64 {
+	if m != nil {
+		return m.NextLockId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextGaugeId() uint64 {
+	if m != nil {
+		return m.NextGaugeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAccumulators() []DurationAccumulator {
+	if m != nil {
+		return m.Accumulators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "incentives.GenesisState")
+}
+
+func init() { proto.RegisterFile("incentives/genesis.proto", fileDescriptor_ad9fd4792f7015c7) }
+
+var fileDescriptor_ad9fd4792f7015c7 = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x3f, 0x8f, 0xda, 0x30,
+	0x18, 0xc6, 0x93, 0x02, 0xa1, 0x35, 0x74, 0xa8, 0xd5, 0x3f, 0x16, 0x95, 0x42, 0xc4, 0xc4, 0x44,
+	0x2a, 0x2a, 0x75, 0x60, 0x03, 0xb5, 0x42, 0x91, 0x3a, 0x20, 0xba, 0x75, 0x41, 0x8e, 0x63, 0x82,
+	0x05, 0xd8, 0x51, 0xec, 0xdc, 0x71, 0xdf, 0xe2, 0x3e, 0xc9, 0x7d, 0x0e, 0x46, 0xc6, 0x9b, 0x4e,
+	0x27, 0xf8, 0x22, 0x27, 0x3b, 0x41, 0x31, 0xdb, 0xab, 0xf7, 0xf9, 0xfd, 0xfc, 0x3e, 0x83, 0x01,
+	0x62, 0x9c, 0x50, 0xae, 0xd8, 0x1d, 0x95, 0x61, 0x4a, 0x39, 0x95, 0x4c, 0x8e, 0xb2, 0x5c, 0x28,
+	0x01, 0x41, 0x9d, 0xf4, 0x3e, 0xa7, 0x22, 0x15, 0x66, 0x1d, 0xea, 0xa9, 0x24, 0x7a, 0xdf, 0x2d,
+	0xb7, 0x1e, 0xab, 0xf0, 0x9b, 0x15, 0x66, 0x38, 0xc7, 0xfb, 0x2a, 0x18, 0x3c, 0x35, 0x40, 0x77,
+	0x5e, 0x5e, 0xfa, 0xa7, 0xb0, 0xa2, 0xf0, 0x07, 0xf0, 0x4a, 0x00, 0xb9, 0x81, 0x3b, 0xec, 0x8c,
+	0xe1, 0xc8, 0x7a, 0x6c, 0x61, 0x92, 0x59, 0xf3, 0xf8, 0xd2, 0x77, 0x96, 0x15, 0x07, 0x27, 0x00,
+	0xd0, 0x4c, 0x90, 0xcd, 0x8a, 0xf1, 0xb5, 0x40, 0xef, 0x8c, 0xf5, 0xc5, 0xb6, 0xfe, 0xe8, 0x34,
+	0xe2, 0x6b, 0x51, 0x89, 0x1f, 0xe8, 0x75, 0x01, 0xc7, 0xa0, 0xb5, 0x13, 0x64, 0x2b, 0x51, 0x23,
+	0x68, 0x0c, 0x3b, 0xe3, 0xaf, 0x37, 0xc7, 0x68, 0xce, 0x44, 0xf2, 0x57, 0x90, 0x6d, 0xe5, 0x95,
+	0x28, 0x0c, 0x81, 0x97, 0xe2, 0x22, 0xa5, 0x12, 0x35, 0x8d, 0xf4, 0xc9, 0x96, 0xe6, 0x3a, 0xb9,
+	0x16, 0x2c, 0x31, 0x38, 0x01, 0xed, 0x9c, 0xde, 0xe3, 0x3c, 0x91, 0xa8, 0x65, 0x8c, 0x9e, 0x6d,
+	0x4c, 0x09, 0x11, 0x05, 0x57, 0xcb, 0x92, 0xa8, 0xd4, 0xab, 0x00, 0x03, 0xd0, 0xe5, 0xf4, 0xa0,
+	0x56, 0xfa, 0xf4, 0x8a, 0x25, 0xc8, 0x0b, 0xdc, 0x61, 0x73, 0x09, 0xf4, 0x4e, 0x37, 0x8b, 0x12,
+	0x38, 0x00, 0x1f, 0x0d, 0x61, 0x8e, 0x69, 0xa4, 0x6d, 0x90, 0x8e, 0x5e, 0x9a, 0x36, 0x51, 0x02,
+	0x23, 0xd0, 0xc5, 0x84, 0x14, 0xfb, 0x62, 0x87, 0x95, 0xc8, 0x25, 0x7a, 0x6f, 0x6a, 0xf4, 0xed,
+	0x1a, 0xbf, 0x8b, 0x1c, 0x2b, 0x26, 0xf8, 0xb4, 0xe6, 0xaa, 0x2e, 0x37, 0xea, 0x6c, 0x71, 0x3c,
+	0xfb, 0xee, 0xe9, 0xec, 0xbb, 0xaf, 0x67, 0xdf, 0x7d, 0xbc, 0xf8, 0xce, 0xe9, 0xe2, 0x3b, 0xcf,
+	0x17, 0xdf, 0xf9, 0xff, 0x2b, 0x65, 0x6a, 0x53, 0xc4, 0x23, 0x22, 0xf6, 0x61, 0xcc, 0x54, 0x8c,
+	0x93, 0x94, 0xca, 0x7a, 0x22, 0x1b, 0xcc, 0x78, 0x78, 0xb0, 0xfe, 0x46, 0xa8, 0x1e, 0x32, 0x2a,
+	0x63, 0xcf, 0xfc, 0x84, 0x9f, 0x6f, 0x03, 0x00, 0x31, 0xb3, 0x48, 0x10, 0x7d, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accumulators) > 0 {
+		for iNdEx := len(m.Accumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextGaugeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextGaugeId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextLockId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLockId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.EpochInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLockId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLockId))
+	}
+	if m.NextGaugeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextGaugeId))
+	}
+	if len(m.Accumulators) > 0 {
+		for _, e := range m.Accumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, PeriodLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, AccountRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockId", wireType)
+			}
+			m.NextLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGaugeId", wireType)
+			}
+			m.NextGaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextGaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accumulators = append(m.Accumulators, DurationAccumulator{})
+			if err := m.Accumulators[len(m.Accumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "incentives"
+	RouterKey  = ModuleName
+	StoreKey   = ModuleName
+)
+
+var (
+	// ParamsKey stores the module parameters
+	ParamsKey = []byte("p_incentives")
+
+	// KeyEpochInfo stores the current epoch
+	KeyEpochInfo = []byte{0x01}
+
+	// KeyNextLockId stores the id of the next lock
+	KeyNextLockId = []byte{0x02}
+
+	// KeyNextGaugeId stores the id of the next gauge
+	KeyNextGaugeId = []byte{0x03}
+
+	// KeyPrefixLock stores the locks
+	// Key: LockKey(lockId) -> PeriodLock
+	KeyPrefixLock = []byte{0x04}
+
+	// KeyPrefixAccountLock indexes the locks of each account
+	// Key: AccountLockKey(owner, lockId) -> []byte{1}
+	KeyPrefixAccountLock = []byte{0x05}
+
+	// KeyPrefixPoolLock indexes the bonded locks of each pool, which are the locks that earn gauge rewards
+	// Key: PoolLockKey(poolId, lockId) -> []byte{1}
+	KeyPrefixPoolLock = []byte{0x06}
+
+	// KeyPrefixUnlockQueue indexes the unbonding locks by the time they finish unbonding
+	// Key: UnlockQueueKey(endTime, lockId) -> []byte{1}
+	KeyPrefixUnlockQueue = []byte{0x07}
+
+	// KeyPrefixGauge stores the gauges
+	// Key: GaugeKey(gaugeId) -> Gauge
+	KeyPrefixGauge = []byte{0x08}
+
+	// KeyPrefixRewards stores the unclaimed rewards of each account
+	// Key: RewardsKey(owner) -> AccountRewards
+	KeyPrefixRewards = []byte{0x09}
+
+	// KeyPrefixActiveGauge indexes the gauges that have not finished distributing
+	// Key: ActiveGaugeKey(gaugeId) -> []byte{1}
+	KeyPrefixActiveGauge = []byte{0x0A}
+
+	// KeyPrefixAccumulator stores the reward accumulators of the bonded shares of each pool and lock duration
+	// Key: AccumulatorKey(poolId, duration) -> DurationAccumulator
+	KeyPrefixAccumulator = []byte{0x0B}
+)
+
+// LockKey returns the key for storing a lock
+func LockKey(lockId uint64) []byte {
+	return append(KeyPrefixLock, sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// AccountLockPrefix returns the prefix of the lock index of an account
+func AccountLockPrefix(owner sdk.AccAddress) []byte {
+	key := append([]byte{}, KeyPrefixAccountLock...)
+	return append(key, address.MustLengthPrefix(owner)...)
+}
+
+// AccountLockKey returns the key for indexing a lock of an account
+func AccountLockKey(owner sdk.AccAddress, lockId uint64) []byte {
+	return append(AccountLockPrefix(owner), sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// PoolLockPrefix returns the prefix of the bonded lock index of a pool
+func PoolLockPrefix(poolId uint64) []byte {
+	key := append([]byte{}, KeyPrefixPoolLock...)
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// PoolLockKey returns the key for indexing a bonded lock of a pool
+func PoolLockKey(poolId uint64, lockId uint64) []byte {
+	return append(PoolLockPrefix(poolId), sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// UnlockQueuePrefix returns the prefix of the unlock queue entries ending at the given time
+func UnlockQueuePrefix(endTime time.Time) []byte {
+	key := append([]byte{}, KeyPrefixUnlockQueue...)
+	return append(key, sdk.FormatTimeBytes(endTime)...)
+}
+
+// UnlockQueueKey returns the key for queueing an unbonding lock
+func UnlockQueueKey(endTime time.Time, lockId uint64) []byte {
+	return append(UnlockQueuePrefix(endTime), sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// GaugeKey returns the key for storing a gauge
+func GaugeKey(gaugeId uint64) []byte {
+	return append(KeyPrefixGauge, sdk.Uint64ToBigEndian(gaugeId)...)
+}
+
+// ActiveGaugeKey returns the key for indexing a gauge that has not finished distributing
+func ActiveGaugeKey(gaugeId uint64) []byte {
+	return append(KeyPrefixActiveGauge, sdk.Uint64ToBigEndian(gaugeId)...)
+}
+
+// RewardsKey returns the key for storing the unclaimed rewards of an account
+func RewardsKey(owner sdk.AccAddress) []byte {
+	return append(KeyPrefixRewards, address.MustLengthPrefix(owner)...)
+}
+
+// PoolAccumulatorPrefix returns the prefix of the reward accumulators of a pool
+func PoolAccumulatorPrefix(poolId uint64) []byte {
+	key := append([]byte{}, KeyPrefixAccumulator...)
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// AccumulatorKey returns the key for storing the reward accumulator of a pool and lock duration
+// Durations are big endian encoded, so the accumulators of a pool are ordered by duration
+func AccumulatorKey(poolId uint64, duration time.Duration) []byte {
+	return append(PoolAccumulatorPrefix(poolId), sdk.Uint64ToBigEndian(uint64(duration))...)
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgAddToGauge{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddToGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	if err := m.Rewards.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidGauge, err.Error())
+	}
+	if m.Rewards.IsZero() {
+		return errorsmod.Wrap(ErrInvalidGauge, "rewards cannot be empty")
+	}
+
+	return nil
+}
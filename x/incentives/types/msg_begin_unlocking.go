@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgBeginUnlocking{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBeginUnlocking) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateGauge{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	if err := validateGaugeParams(m.PoolId, m.MinLockDuration, m.NumEpochsPaidOver); err != nil {
+		return errorsmod.Wrap(ErrInvalidGauge, err.Error())
+	}
+
+	if err := m.Coins.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidGauge, err.Error())
+	}
+	if m.Coins.IsZero() {
+		return errorsmod.Wrap(ErrInvalidGauge, "gauge must be funded")
+	}
+
+	return nil
+}
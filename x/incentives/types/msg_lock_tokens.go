@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgLockTokens{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgLockTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	if m.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLock, "duration must be positive, got %s", m.Duration)
+	}
+
+	if _, err := ValidateLockCoin(m.Coin); err != nil {
+		return errorsmod.Wrap(ErrInvalidLock, err.Error())
+	}
+
+	return nil
+}
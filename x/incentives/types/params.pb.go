@@ -0,0 +1,654 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: incentives/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the incentives module.
+type Params struct {
+	// epoch_duration is the length of an epoch. Active gauges distribute rewards at the end of every epoch.
+	EpochDuration time.Duration `protobuf:"bytes,1,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+	// max_lock_duration is the longest duration shares can be locked for.
+	MaxLockDuration time.Duration `protobuf:"bytes,2,opt,name=max_lock_duration,json=maxLockDuration,proto3,stdduration" json:"max_lock_duration"`
+	// lockable_durations are the durations shares can be locked for. Gauge rewards are tracked per pool
+	// and lockable duration, so the number of lockable durations bounds the cost of distributing a gauge.
+	LockableDurations []time.Duration `protobuf:"bytes,3,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations"`
+	// min_lock_amount is the smallest amount of shares that can be locked.
+	MinLockAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_lock_amount,json=minLockAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_lock_amount"`
+	// gauge_creation_fee is paid to the community pool when creating a gauge.
+	GaugeCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_creation_fee"`
+	// max_active_gauges is the maximum number of gauges that have not finished distributing.
+	MaxActiveGauges uint64 `protobuf:"varint,6,opt,name=max_active_gauges,json=maxActiveGauges,proto3" json:"max_active_gauges,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9b9320dd5a543d, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLockDuration() time.Duration {
+	if m != nil {
+		return m.MaxLockDuration
+	}
+	return 0
+}
+
+func (m *Params) GetLockableDurations() []time.Duration {
+	if m != nil {
+		return m.LockableDurations
+	}
+	return nil
+}
+
+func (m *Params) GetGaugeCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxActiveGauges() uint64 {
+	if m != nil {
+		return m.MaxActiveGauges
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "incentives.Params")
+}
+
+func init() { proto.RegisterFile("incentives/params.proto", fileDescriptor_4c9b9320dd5a543d) }
+
+var fileDescriptor_4c9b9320dd5a543d = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0x63, 0x1a, 0x22, 0x38, 0x54, 0x4a, 0x4e, 0x20, 0x8e, 0x48, 0xdc, 0x45, 0x4c, 0x51,
+	0x25, 0x6c, 0x0a, 0x12, 0x03, 0x5b, 0x53, 0x7e, 0x08, 0x84, 0x44, 0x95, 0x91, 0xe5, 0x64, 0x3b,
+	0xae, 0x63, 0x25, 0xf6, 0x8b, 0xce, 0xbe, 0x2a, 0x9d, 0xd9, 0x98, 0x18, 0x19, 0x99, 0x99, 0xf8,
+	0x33, 0x3a, 0x76, 0x44, 0x0c, 0x2d, 0x4a, 0x06, 0xf8, 0x33, 0xaa, 0xb3, 0xef, 0x9a, 0x8c, 0x5d,
+	0x92, 0x77, 0xfe, 0xfa, 0xfb, 0x79, 0x5f, 0xbd, 0xe7, 0xe8, 0xa1, 0x32, 0x5c, 0x18, 0xa7, 0x8e,
+	0x85, 0x25, 0x73, 0x5a, 0x50, 0x6d, 0xf1, 0xbc, 0x00, 0x07, 0x71, 0xb4, 0x16, 0x7a, 0x5d, 0xaa,
+	0x95, 0x01, 0xe2, 0x7f, 0x83, 0xdc, 0x4b, 0x39, 0x58, 0x0d, 0x96, 0x30, 0x6a, 0x05, 0x39, 0xde,
+	0x63, 0xc2, 0xd1, 0x3d, 0xc2, 0x41, 0x99, 0x5a, 0xbf, 0x2f, 0x41, 0x82, 0x2f, 0x49, 0x55, 0x35,
+	0x2e, 0x09, 0x20, 0x67, 0x82, 0xf8, 0x2f, 0x56, 0x1e, 0x91, 0x71, 0x59, 0x50, 0xa7, 0xa0, 0x76,
+	0x3d, 0xf9, 0xd2, 0x8e, 0x3a, 0x87, 0x3e, 0x45, 0xfc, 0x21, 0xba, 0x2b, 0xe6, 0xc0, 0x27, 0x79,
+	0x73, 0x25, 0x41, 0x7d, 0x34, 0xb8, 0xf3, 0xfc, 0x11, 0x0e, 0x0c, 0xdc, 0x30, 0xf0, 0xeb, 0xfa,
+	0xc2, 0xf0, 0xd6, 0xe9, 0x79, 0xd6, 0xfa, 0x7e, 0x91, 0xa1, 0xd1, 0xb6, 0xb7, 0x36, 0x42, 0xfc,
+	0x29, 0xea, 0x6a, 0xba, 0xc8, 0x67, 0xc0, 0xa7, 0x6b, 0xdc, 0x8d, 0xeb, 0xe3, 0x76, 0x34, 0x5d,
+	0x7c, 0x04, 0x3e, 0xbd, 0x02, 0x8e, 0xa2, 0xb8, 0x82, 0x51, 0x36, 0x13, 0x57, 0x40, 0x9b, 0x6c,
+	0xf5, 0xb7, 0xae, 0x4b, 0xec, 0x36, 0xf6, 0x46, 0xb3, 0xf1, 0x9b, 0x68, 0x47, 0x2b, 0x13, 0x42,
+	0x52, 0x0d, 0xa5, 0x71, 0x49, 0xbb, 0x8f, 0x06, 0xb7, 0x87, 0x8f, 0x2b, 0xd7, 0x9f, 0xf3, 0xec,
+	0x41, 0x18, 0xb9, 0x1d, 0x4f, 0xb1, 0x02, 0xa2, 0xa9, 0x9b, 0xe0, 0xf7, 0xc6, 0x8d, 0xb6, 0xb5,
+	0x32, 0x55, 0xb8, 0x7d, 0xef, 0x89, 0x4f, 0xa2, 0x58, 0xd2, 0x52, 0x8a, 0x9c, 0x17, 0xc2, 0x93,
+	0xf3, 0x23, 0x21, 0x92, 0x9b, 0x75, 0xb4, 0x80, 0xc0, 0xd5, 0xd6, 0x70, 0xbd, 0x35, 0x7c, 0x00,
+	0xca, 0x0c, 0x9f, 0x55, 0x4d, 0x7e, 0x5e, 0x64, 0x03, 0xa9, 0xdc, 0xa4, 0x64, 0x98, 0x83, 0x26,
+	0xf5, 0x8a, 0xc3, 0xdf, 0x53, 0x3b, 0x9e, 0x12, 0x77, 0x32, 0x17, 0xd6, 0x1b, 0xec, 0xe8, 0x9e,
+	0x6f, 0x73, 0x50, 0x77, 0x79, 0x2b, 0x44, 0xbc, 0x1b, 0xc6, 0x4c, 0x79, 0xf5, 0x6a, 0x72, 0x2f,
+	0xdb, 0xa4, 0xd3, 0x47, 0x83, 0xb6, 0x9f, 0xe0, 0xbe, 0x3f, 0x7f, 0xe7, 0x8f, 0x5f, 0xf5, 0xfe,
+	0xff, 0xc8, 0xd0, 0xd7, 0x7f, 0xbf, 0x76, 0xbb, 0x1b, 0x0f, 0x30, 0xac, 0x7e, 0x78, 0x78, 0xba,
+	0x4c, 0xd1, 0xd9, 0x32, 0x45, 0x7f, 0x97, 0x29, 0xfa, 0xb6, 0x4a, 0x5b, 0x67, 0xab, 0xb4, 0xf5,
+	0x7b, 0x95, 0xb6, 0x3e, 0xbf, 0xdc, 0x48, 0xc7, 0x94, 0x63, 0x74, 0x2c, 0x85, 0x5d, 0x57, 0x7c,
+	0x42, 0x95, 0x21, 0x0b, 0xb2, 0x81, 0xf4, 0x89, 0x59, 0xc7, 0xef, 0xe2, 0xc5, 0xe5, 0x00, 0x1f,
+	0x36, 0xdd, 0xa0, 0xee, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochDuration != that1.EpochDuration {
+		return false
+	}
+	if this.MaxLockDuration != that1.MaxLockDuration {
+		return false
+	}
+	if len(this.LockableDurations) != len(that1.LockableDurations) {
+		return false
+	}
+	for i := range this.LockableDurations {
+		if this.LockableDurations[i] != that1.LockableDurations[i] {
+			return false
+		}
+	}
+	if !this.MinLockAmount.Equal(that1.MinLockAmount) {
+		return false
+	}
+	if len(this.GaugeCreationFee) != len(that1.GaugeCreationFee) {
+		return false
+	}
+	for i := range this.GaugeCreationFee {
+		if !this.GaugeCreationFee[i].Equal(&that1.GaugeCreationFee[i]) {
+			return false
+		}
+	}
+	if this.MaxActiveGauges != that1.MaxActiveGauges {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxActiveGauges != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveGauges))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for iNdEx := len(m.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MinLockAmount.Size()
+		i -= size
+		if _, err := m.MinLockAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LockableDurations) > 0 {
+		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintParams(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxLockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLockDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLockDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.LockableDurations) > 0 {
+		for _, e := range m.LockableDurations {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinLockAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.GaugeCreationFee) > 0 {
+		for _, e := range m.GaugeCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxActiveGauges != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveGauges))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxLockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLockAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeCreationFee = append(m.GaugeCreationFee, types.Coin{})
+			if err := m.GaugeCreationFee[len(m.GaugeCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveGauges", wireType)
+			}
+			m.MaxActiveGauges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveGauges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
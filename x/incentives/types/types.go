@@ -0,0 +1,304 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/bitbadges/bitbadgeschain/x/gamm/types"
+)
+
+const (
+	// DefaultEpochDuration is the default length of an epoch
+	DefaultEpochDuration = 24 * time.Hour
+
+	// DefaultMaxLockDuration is the default longest duration shares can be locked for
+	DefaultMaxLockDuration = 365 * 24 * time.Hour
+
+	// MaxNumEpochsPaidOver bounds the number of epochs a gauge distributes over (10 years of daily epochs)
+	MaxNumEpochsPaidOver uint64 = 3650
+
+	// MaxLockableDurations bounds the number of lockable durations, and hence the accumulators a gauge distributes to
+	MaxLockableDurations = 10
+
+	// DefaultMaxActiveGauges is the default maximum number of gauges that have not finished distributing
+	DefaultMaxActiveGauges uint64 = 100
+)
+
+var (
+	// DefaultLockableDurations are the default durations shares can be locked for
+	DefaultLockableDurations = []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 14 * 24 * time.Hour}
+
+	// DefaultMinLockAmount is the default smallest amount of shares that can be locked (a thousandth of a share)
+	DefaultMinLockAmount = gammtypes.OneShare.QuoRaw(1000)
+
+	// DefaultGaugeCreationFee is the default fee paid to the community pool when creating a gauge (1 BADGE)
+	DefaultGaugeCreationFee = sdk.NewCoins(sdk.NewInt64Coin("ubadge", 1_000_000_000))
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:       DefaultParams(),
+		Locks:        []PeriodLock{},
+		Gauges:       []Gauge{},
+		Rewards:      []AccountRewards{},
+		NextLockId:   1,
+		NextGaugeId:  1,
+		Accumulators: []DurationAccumulator{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	lockIds := make(map[uint64]bool, len(gs.Locks))
+	for i, lock := range gs.Locks {
+		if err := lock.Validate(); err != nil {
+			return fmt.Errorf("invalid locks[%d]: %w", i, err)
+		}
+		if lock.Id >= gs.NextLockId {
+			return fmt.Errorf("invalid locks[%d]: id %d must be less than next_lock_id %d", i, lock.Id, gs.NextLockId)
+		}
+		if lockIds[lock.Id] {
+			return fmt.Errorf("duplicate lock id: %d", lock.Id)
+		}
+		lockIds[lock.Id] = true
+	}
+
+	// The accumulators must track exactly the bonded shares of each pool and lock duration
+	type accumulatorKey struct {
+		poolId   uint64
+		duration time.Duration
+	}
+	bondedShares := make(map[accumulatorKey]sdk.Coin)
+	for _, lock := range gs.Locks {
+		if lock.IsUnbonding() {
+			continue
+		}
+		key := accumulatorKey{lock.PoolId(), lock.Duration}
+		if shares, ok := bondedShares[key]; ok {
+			bondedShares[key] = shares.Add(lock.Coin)
+		} else {
+			bondedShares[key] = lock.Coin
+		}
+	}
+	for i, acc := range gs.Accumulators {
+		if err := acc.Validate(); err != nil {
+			return fmt.Errorf("invalid accumulators[%d]: %w", i, err)
+		}
+		key := accumulatorKey{acc.PoolId, acc.Duration}
+		shares, ok := bondedShares[key]
+		if !ok {
+			return fmt.Errorf("invalid accumulators[%d]: duplicate or without bonded locks", i)
+		}
+		if !shares.Amount.Equal(acc.TotalShares) {
+			return fmt.Errorf("invalid accumulators[%d]: total_shares %s do not match the bonded locks %s", i, acc.TotalShares, shares.Amount)
+		}
+		delete(bondedShares, key)
+	}
+	if len(bondedShares) > 0 {
+		return fmt.Errorf("%d pool and lock duration pairs with bonded locks have no accumulator", len(bondedShares))
+	}
+
+	gaugeIds := make(map[uint64]bool, len(gs.Gauges))
+	for i, gauge := range gs.Gauges {
+		if err := gauge.Validate(); err != nil {
+			return fmt.Errorf("invalid gauges[%d]: %w", i, err)
+		}
+		if gauge.Id >= gs.NextGaugeId {
+			return fmt.Errorf("invalid gauges[%d]: id %d must be less than next_gauge_id %d", i, gauge.Id, gs.NextGaugeId)
+		}
+		if gaugeIds[gauge.Id] {
+			return fmt.Errorf("duplicate gauge id: %d", gauge.Id)
+		}
+		gaugeIds[gauge.Id] = true
+	}
+
+	addresses := make(map[string]bool, len(gs.Rewards))
+	for i, rewards := range gs.Rewards {
+		if _, err := sdk.AccAddressFromBech32(rewards.Address); err != nil {
+			return fmt.Errorf("invalid rewards[%d]: invalid address: %w", i, err)
+		}
+		if err := rewards.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards[%d]: %w", i, err)
+		}
+		if addresses[rewards.Address] {
+			return fmt.Errorf("duplicate rewards address: %s", rewards.Address)
+		}
+		addresses[rewards.Address] = true
+	}
+
+	return nil
+}
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return Params{
+		EpochDuration:     DefaultEpochDuration,
+		MaxLockDuration:   DefaultMaxLockDuration,
+		LockableDurations: DefaultLockableDurations,
+		MinLockAmount:     DefaultMinLockAmount,
+		GaugeCreationFee:  DefaultGaugeCreationFee,
+		MaxActiveGauges:   DefaultMaxActiveGauges,
+	}
+}
+
+// Validate validates params
+func (p Params) Validate() error {
+	if p.EpochDuration <= 0 {
+		return fmt.Errorf("epoch_duration must be positive, got %s", p.EpochDuration)
+	}
+	if p.MaxLockDuration <= 0 {
+		return fmt.Errorf("max_lock_duration must be positive, got %s", p.MaxLockDuration)
+	}
+	if len(p.LockableDurations) == 0 || len(p.LockableDurations) > MaxLockableDurations {
+		return fmt.Errorf("number of lockable_durations must be in [1, %d], got %d", MaxLockableDurations, len(p.LockableDurations))
+	}
+	seen := make(map[time.Duration]bool, len(p.LockableDurations))
+	for _, duration := range p.LockableDurations {
+		if duration <= 0 || duration > p.MaxLockDuration {
+			return fmt.Errorf("lockable_durations must be in (0, %s], got %s", p.MaxLockDuration, duration)
+		}
+		if seen[duration] {
+			return fmt.Errorf("duplicate lockable duration: %s", duration)
+		}
+		seen[duration] = true
+	}
+	if p.MinLockAmount.IsNil() || !p.MinLockAmount.IsPositive() {
+		return fmt.Errorf("min_lock_amount must be positive, got %s", p.MinLockAmount)
+	}
+	if err := p.GaugeCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid gauge_creation_fee: %w", err)
+	}
+	if p.MaxActiveGauges == 0 {
+		return fmt.Errorf("max_active_gauges must be positive")
+	}
+	return nil
+}
+
+// IsLockableDuration returns whether shares can be locked for duration.
+func (p Params) IsLockableDuration(duration time.Duration) bool {
+	for _, lockableDuration := range p.LockableDurations {
+		if duration == lockableDuration {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxLockableDuration returns the longest duration shares can be locked for.
+func (p Params) MaxLockableDuration() time.Duration {
+	maxDuration := time.Duration(0)
+	for _, lockableDuration := range p.LockableDurations {
+		if lockableDuration > maxDuration {
+			maxDuration = lockableDuration
+		}
+	}
+	return maxDuration
+}
+
+// PoolIdFromShareDenom returns the pool id of a gamm/pool/{pool_id} share denom.
+func PoolIdFromShareDenom(denom string) (uint64, error) {
+	poolIdStr, found := strings.CutPrefix(denom, gammtypes.GAMMTokenPrefix)
+	if !found {
+		return 0, fmt.Errorf("denom %s is not a pool share denom", denom)
+	}
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil || gammtypes.GetPoolShareDenom(poolId) != denom {
+		return 0, fmt.Errorf("denom %s is not a pool share denom", denom)
+	}
+	return poolId, nil
+}
+
+// ValidateLockCoin checks that coin is a positive amount of pool shares, and returns the pool id.
+func ValidateLockCoin(coin sdk.Coin) (uint64, error) {
+	if err := coin.Validate(); err != nil {
+		return 0, err
+	}
+	if !coin.IsPositive() {
+		return 0, fmt.Errorf("locked amount must be positive, got %s", coin)
+	}
+	return PoolIdFromShareDenom(coin.Denom)
+}
+
+// Validate performs a stateless validation of a lock.
+func (lock PeriodLock) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(lock.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	if lock.Duration <= 0 {
+		return fmt.Errorf("duration must be positive, got %s", lock.Duration)
+	}
+	_, err := ValidateLockCoin(lock.Coin)
+	return err
+}
+
+// IsUnbonding returns whether the owner has begun unlocking the lock.
+func (lock PeriodLock) IsUnbonding() bool {
+	return !lock.EndTime.IsZero()
+}
+
+// PoolId returns the id of the pool whose shares are locked.
+func (lock PeriodLock) PoolId() uint64 {
+	// The coin is validated when the lock is created
+	poolId, _ := PoolIdFromShareDenom(lock.Coin.Denom)
+	return poolId
+}
+
+// Validate performs a stateless validation of a gauge.
+func (gauge Gauge) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(gauge.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	if err := validateGaugeParams(gauge.PoolId, gauge.MinLockDuration, gauge.NumEpochsPaidOver); err != nil {
+		return err
+	}
+	if err := gauge.Coins.Validate(); err != nil {
+		return fmt.Errorf("invalid coins: %w", err)
+	}
+	if err := gauge.DistributedCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid distributed_coins: %w", err)
+	}
+	if !gauge.Coins.IsAllGTE(gauge.DistributedCoins) {
+		return fmt.Errorf("distributed_coins %s exceed coins %s", gauge.DistributedCoins, gauge.Coins)
+	}
+	if gauge.FilledEpochs > gauge.NumEpochsPaidOver {
+		return fmt.Errorf("filled_epochs %d exceed num_epochs_paid_over %d", gauge.FilledEpochs, gauge.NumEpochsPaidOver)
+	}
+	return nil
+}
+
+// IsFinished returns whether the gauge has been active for all of its epochs.
+func (gauge Gauge) IsFinished() bool {
+	return gauge.FilledEpochs >= gauge.NumEpochsPaidOver
+}
+
+// IsActive returns whether the gauge distributes rewards at the given time.
+func (gauge Gauge) IsActive(blockTime time.Time) bool {
+	return !gauge.IsFinished() && !blockTime.Before(gauge.StartTime)
+}
+
+// RemainingCoins returns the rewards the gauge has not distributed yet.
+func (gauge Gauge) RemainingCoins() sdk.Coins {
+	return gauge.Coins.Sub(gauge.DistributedCoins...)
+}
+
+func validateGaugeParams(poolId uint64, minLockDuration time.Duration, numEpochsPaidOver uint64) error {
+	if poolId == 0 {
+		return fmt.Errorf("pool_id must be specified")
+	}
+	if minLockDuration < 0 {
+		return fmt.Errorf("min_lock_duration cannot be negative, got %s", minLockDuration)
+	}
+	if numEpochsPaidOver == 0 || numEpochsPaidOver > MaxNumEpochsPaidOver {
+		return fmt.Errorf("num_epochs_paid_over must be in [1, %d], got %d", MaxNumEpochsPaidOver, numEpochsPaidOver)
+	}
+	return nil
+}
@@ -23,6 +23,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryManagerSplitter())
 	cmd.AddCommand(CmdQueryAllManagerSplitters())
+	cmd.AddCommand(CmdQueryQueuedExecution())
+	cmd.AddCommand(CmdQueryQueuedExecutions())
+	cmd.AddCommand(CmdQueryManagerSplittersByAdmin())
+	cmd.AddCommand(CmdQueryManagerSplittersByExecutor())
+	cmd.AddCommand(CmdQueryManagerSplitterByCollection())
+	cmd.AddCommand(CmdQueryExecutionLogs())
 
 	return cmd
 }
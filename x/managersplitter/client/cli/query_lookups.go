@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+)
+
+func CmdQueryManagerSplittersByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manager-splitters-by-admin [admin]",
+		Short: "Query all manager splitters administered by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ManagerSplittersByAdmin(cmd.Context(), &types.QueryManagerSplittersByAdminRequest{Admin: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "manager-splitters-by-admin")
+
+	return cmd
+}
+
+func CmdQueryManagerSplittersByExecutor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manager-splitters-by-executor [executor]",
+		Short: "Query all manager splitters where an address is an approved executor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ManagerSplittersByExecutor(cmd.Context(), &types.QueryManagerSplittersByExecutorRequest{Executor: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "manager-splitters-by-executor")
+
+	return cmd
+}
+
+func CmdQueryManagerSplitterByCollection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manager-splitter-by-collection [collection-id]",
+		Short: "Query the manager splitter managing a collection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ManagerSplitterByCollection(cmd.Context(), &types.QueryManagerSplitterByCollectionRequest{CollectionId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryExecutionLogs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution-logs [manager-splitter-address]",
+		Short: "Query the execution log of a manager splitter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExecutionLogs(cmd.Context(), &types.QueryExecutionLogsRequest{ManagerSplitterAddress: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "execution-logs")
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+)
+
+func CmdQueryQueuedExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-execution [id]",
+		Short: "Query a queued (timelocked) execution by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueuedExecution(cmd.Context(), &types.QueryGetQueuedExecutionRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryQueuedExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-executions [manager-splitter-address]",
+		Short: "Query all queued (timelocked) executions for a manager splitter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueuedExecutions(cmd.Context(), &types.QueryQueuedExecutionsRequest{
+				ManagerSplitterAddress: args[0],
+				Pagination:             pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued-executions")
+
+	return cmd
+}
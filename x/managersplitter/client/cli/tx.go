@@ -23,6 +23,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateManagerSplitter())
 	cmd.AddCommand(CmdDeleteManagerSplitter())
 	cmd.AddCommand(CmdExecuteUniversalUpdateCollection())
+	cmd.AddCommand(CmdExecuteQueuedExecution())
+	cmd.AddCommand(CmdCancelQueuedExecution())
+	cmd.AddCommand(CmdExecute())
 
 	return cmd
 }
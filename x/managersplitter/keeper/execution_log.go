@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordExecution appends an entry to the execution log of a manager splitter
+func (k Keeper) recordExecution(ctx sdk.Context, managerSplitter *types.ManagerSplitter, executor string, permissionNames []string, collectionId sdkmath.Uint, queuedExecutionId sdkmath.Uint) error {
+	nextId := k.GetNextExecutionLogId(ctx)
+	executionLog := &types.ExecutionLogEntry{
+		Id:                     nextId,
+		ManagerSplitterAddress: managerSplitter.Address,
+		Executor:               executor,
+		BlockHeight:            ctx.BlockHeight(),
+		PermissionsExercised:   permissionNames,
+		CollectionId:           collectionId,
+		QueuedExecutionId:      queuedExecutionId,
+		ExecutedAt:             sdkmath.NewUint(uint64(ctx.BlockTime().UnixMilli())),
+	}
+
+	if err := k.SetExecutionLogInStore(ctx, executionLog); err != nil {
+		return sdkerrors.Wrap(err, "failed to store execution log")
+	}
+	k.SetNextExecutionLogId(ctx, nextId.Add(sdkmath.NewUint(1)))
+
+	return nil
+}
+
+// recordExecutableMsgs appends one execution log entry per executable message, with the ID of the
+// collection the message acted on (as returned by executeExecutableMsgs)
+func (k Keeper) recordExecutableMsgs(ctx sdk.Context, managerSplitter *types.ManagerSplitter, executor string, msgs []*types.ExecutableMsg, collectionIds []sdkmath.Uint, queuedExecutionId sdkmath.Uint) error {
+	for i, msg := range msgs {
+		permissionName, err := getExecutableMsgPermission(msg)
+		if err != nil {
+			return err
+		}
+
+		if err := k.recordExecution(ctx, managerSplitter, executor, []string{permissionName}, collectionIds[i], queuedExecutionId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
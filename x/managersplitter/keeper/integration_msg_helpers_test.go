@@ -58,3 +58,13 @@ func ExecuteUniversalUpdateCollection(suite *TestSuite, ctx context.Context, msg
 	res, err := suite.msgServer.ExecuteUniversalUpdateCollection(ctx, msg)
 	return res, err
 }
+
+func Execute(suite *TestSuite, ctx context.Context, msg *types.MsgExecute) (*types.MsgExecuteResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := suite.msgServer.Execute(ctx, msg)
+	return res, err
+}
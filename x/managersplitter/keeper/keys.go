@@ -1,10 +1,30 @@
 package keeper
 
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+)
+
 var (
 	// ManagerSplitterKey is the prefix for manager splitter storage
 	ManagerSplitterKey = []byte{0x01}
 	// ManagerSplitterCountKey is the prefix for the next manager splitter ID
 	ManagerSplitterCountKey = []byte{0x02}
+	// QueuedExecutionKey is the prefix for queued (timelocked) execution storage
+	QueuedExecutionKey = []byte{0x03}
+	// QueuedExecutionCountKey is the prefix for the next queued execution ID
+	QueuedExecutionCountKey = []byte{0x04}
+	// ManagerSplitterByAdminKey is the prefix for the admin -> manager splitter index
+	ManagerSplitterByAdminKey = []byte{0x05}
+	// ManagerSplitterByExecutorKey is the prefix for the approved executor -> manager splitter index
+	ManagerSplitterByExecutorKey = []byte{0x06}
+	// ExecutionLogKey is the prefix for execution log storage
+	ExecutionLogKey = []byte{0x07}
+	// ExecutionLogCountKey is the prefix for the next execution log ID
+	ExecutionLogCountKey = []byte{0x08}
+	// QueuedExecutionByManagerSplitterKey is the prefix for the manager splitter -> queued execution index
+	QueuedExecutionByManagerSplitterKey = []byte{0x09}
 )
 
 // managerSplitterStoreKey returns the key for a manager splitter by address
@@ -15,3 +35,41 @@ func managerSplitterStoreKey(addr string) []byte {
 	return key
 }
 
+
+// queuedExecutionStoreKey returns the key for a queued execution by ID
+func queuedExecutionStoreKey(id sdkmath.Uint) []byte {
+	key := make([]byte, len(QueuedExecutionKey)+8)
+	copy(key, QueuedExecutionKey)
+	binary.BigEndian.PutUint64(key[len(QueuedExecutionKey):], id.Uint64())
+	return key
+}
+
+// queuedExecutionIndexStoreKey returns the index key mapping a manager splitter address to one of its queued executions
+func queuedExecutionIndexStoreKey(managerSplitterAddress string, id sdkmath.Uint) []byte {
+	key := addressIndexPrefix(QueuedExecutionByManagerSplitterKey, managerSplitterAddress)
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id.Uint64())
+	return append(key, idBytes...)
+}
+
+// addressIndexPrefix returns the prefix for all index entries of an address (length-prefixed so addresses cannot collide)
+func addressIndexPrefix(indexKey []byte, addr string) []byte {
+	key := make([]byte, len(indexKey)+1+len(addr))
+	copy(key, indexKey)
+	key[len(indexKey)] = byte(len(addr))
+	copy(key[len(indexKey)+1:], []byte(addr))
+	return key
+}
+
+// addressIndexStoreKey returns the index key mapping an address to a manager splitter address
+func addressIndexStoreKey(indexKey []byte, addr string, managerSplitterAddress string) []byte {
+	return append(addressIndexPrefix(indexKey, addr), []byte(managerSplitterAddress)...)
+}
+
+// executionLogStoreKey returns the key for an execution log entry by manager splitter address and ID
+func executionLogStoreKey(managerSplitterAddress string, id sdkmath.Uint) []byte {
+	key := addressIndexPrefix(ExecutionLogKey, managerSplitterAddress)
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id.Uint64())
+	return append(key, idBytes...)
+}
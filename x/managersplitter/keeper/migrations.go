@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateManagerSplitterIndexes backfills the admin, approved executor and queued execution
+// indexes for state written before the indexes were added (v1 -> v2)
+func (k Keeper) MigrateManagerSplitterIndexes(ctx sdk.Context) error {
+	for _, managerSplitter := range k.GetAllManagerSplittersFromStore(ctx) {
+		// Re-setting the manager splitter (re)writes its index entries
+		if err := k.SetManagerSplitterInStore(ctx, managerSplitter); err != nil {
+			return err
+		}
+	}
+
+	for _, queuedExecution := range k.GetAllQueuedExecutionsFromStore(ctx) {
+		if err := k.SetQueuedExecutionInStore(ctx, queuedExecution); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
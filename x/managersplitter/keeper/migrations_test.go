@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/keeper"
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *TestSuite) TestMigrateManagerSplitterIndexes() {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanUpdateCustomData.ApprovedAddresses = []string{alice}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	// Simulate v1 state, where manager splitters were stored without indexes
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, indexKey := range [][]byte{keeper.ManagerSplitterByAdminKey, keeper.ManagerSplitterByExecutorKey} {
+		iterator := storetypes.KVStorePrefixIterator(store, indexKey)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		suite.Require().NotEmpty(keys)
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	byAdmin, err := suite.queryClient.ManagerSplittersByAdmin(wctx, &types.QueryManagerSplittersByAdminRequest{Admin: bob})
+	suite.Require().Nil(err)
+	suite.Require().Empty(byAdmin.ManagerSplitters)
+
+	suite.Require().Nil(suite.app.ManagerSplitterKeeper.MigrateManagerSplitterIndexes(suite.ctx))
+
+	byAdmin, err = suite.queryClient.ManagerSplittersByAdmin(wctx, &types.QueryManagerSplittersByAdminRequest{Admin: bob})
+	suite.Require().Nil(err)
+	suite.Require().Len(byAdmin.ManagerSplitters, 1)
+	suite.Require().Equal(res.Address, byAdmin.ManagerSplitters[0].Address)
+
+	byExecutor, err := suite.queryClient.ManagerSplittersByExecutor(wctx, &types.QueryManagerSplittersByExecutorRequest{Executor: alice})
+	suite.Require().Nil(err)
+	suite.Require().Len(byExecutor.ManagerSplitters, 1)
+	suite.Require().Equal(res.Address, byExecutor.ManagerSplitters[0].Address)
+}
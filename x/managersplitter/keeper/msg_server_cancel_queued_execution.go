@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelQueuedExecution(goCtx context.Context, msg *types.MsgCancelQueuedExecution) (*types.MsgCancelQueuedExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate admin address
+	_, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAdmin, err.Error())
+	}
+
+	// Get manager splitter
+	managerSplitter, found := k.GetManagerSplitterFromStore(ctx, msg.ManagerSplitterAddress)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrManagerSplitterNotFound, msg.ManagerSplitterAddress)
+	}
+
+	// Check authorization - only admin can cancel
+	if managerSplitter.Admin != msg.Admin {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "only admin can cancel queued executions")
+	}
+
+	// Get queued execution
+	queuedExecution, found := k.GetQueuedExecutionFromStore(ctx, msg.QueuedExecutionId)
+	if !found || queuedExecution.ManagerSplitterAddress != managerSplitter.Address {
+		return nil, sdkerrors.Wrap(types.ErrQueuedExecutionNotFound, msg.QueuedExecutionId.String())
+	}
+
+	k.DeleteQueuedExecutionFromStore(ctx, queuedExecution.Id)
+
+	return &types.MsgCancelQueuedExecutionResponse{}, nil
+}
@@ -29,8 +29,11 @@ func (k msgServer) DeleteManagerSplitter(goCtx context.Context, msg *types.MsgDe
 		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "only admin can delete manager splitter")
 	}
 
-	// Delete manager splitter
+	// Delete manager splitter and any executions still queued through it
 	k.DeleteManagerSplitterFromStore(ctx, msg.Address)
+	for _, queuedExecution := range k.GetQueuedExecutionsForManagerSplitter(ctx, msg.Address) {
+		k.DeleteQueuedExecutionFromStore(ctx, queuedExecution.Id)
+	}
 
 	return &types.MsgDeleteManagerSplitterResponse{}, nil
 }
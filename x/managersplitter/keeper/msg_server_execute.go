@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+	tokenizationkeeper "github.com/bitbadges/bitbadgeschain/x/tokenization/keeper"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// getExecutableMsgPermission returns the name of the permission required to execute the wrapped message
+func getExecutableMsgPermission(msg *types.ExecutableMsg) (string, error) {
+	switch {
+	case msg.TransferTokensMsg != nil:
+		return "canTransferTokens", nil
+	case msg.CreateAddressListsMsg != nil:
+		return "canCreateAddressLists", nil
+	case msg.CreateDynamicStoreMsg != nil:
+		return "canCreateDynamicStore", nil
+	case msg.UpdateDynamicStoreMsg != nil:
+		return "canUpdateDynamicStore", nil
+	case msg.DeleteDynamicStoreMsg != nil:
+		return "canDeleteDynamicStore", nil
+	case msg.SetDynamicStoreValueMsg != nil:
+		return "canSetDynamicStoreValue", nil
+	}
+
+	return "", sdkerrors.Wrap(types.ErrInvalidRequest, "executable message must set exactly one message")
+}
+
+// getExecutableMsgsPermissions returns the names of all permissions used by the executable messages
+func getExecutableMsgsPermissions(msgs []*types.ExecutableMsg) ([]string, error) {
+	permissionNames := []string{}
+	for _, msg := range msgs {
+		permissionName, err := getExecutableMsgPermission(msg)
+		if err != nil {
+			return nil, err
+		}
+		permissionNames = append(permissionNames, permissionName)
+	}
+
+	return permissionNames, nil
+}
+
+// checkExecutableMsgPermissions checks all permissions that would be used by the executable messages
+func (k Keeper) checkExecutableMsgPermissions(ctx sdk.Context, executor string, managerSplitter *types.ManagerSplitter, msgs []*types.ExecutableMsg) error {
+	permissionNames, err := getExecutableMsgsPermissions(msgs)
+	if err != nil {
+		return err
+	}
+
+	for _, permissionName := range permissionNames {
+		if err := k.checkPermission(ctx, executor, managerSplitter, permissionName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeExecutableMsgs executes the wrapped tokenization messages in order with the manager splitter as the creator.
+// Returns the ID of the collection each message acted on (zero for messages not scoped to a collection).
+func (k Keeper) executeExecutableMsgs(goCtx context.Context, managerSplitter *types.ManagerSplitter, msgs []*types.ExecutableMsg) ([]sdkmath.Uint, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tokenizationMsgServer := tokenizationkeeper.NewMsgServerImpl(k.tokenizationKeeper)
+
+	collectionIds := make([]sdkmath.Uint, len(msgs))
+	for i, msg := range msgs {
+		collectionIds[i] = sdkmath.ZeroUint()
+
+		var err error
+		switch {
+		case msg.TransferTokensMsg != nil:
+			tokenizationMsg := *msg.TransferTokensMsg
+			tokenizationMsg.Creator = managerSplitter.Address
+			// A zero collection ID refers to the latest collection, as resolved by the tokenization module
+			collectionIds[i] = tokenizationMsg.CollectionId
+			if collectionIds[i].IsZero() && !k.tokenizationKeeper.GetNextCollectionId(ctx).IsZero() {
+				collectionIds[i] = k.tokenizationKeeper.GetNextCollectionId(ctx).Sub(sdkmath.NewUint(1))
+			}
+			_, err = tokenizationMsgServer.TransferTokens(goCtx, &tokenizationMsg)
+		case msg.CreateAddressListsMsg != nil:
+			tokenizationMsg := *msg.CreateAddressListsMsg
+			tokenizationMsg.Creator = managerSplitter.Address
+			_, err = tokenizationMsgServer.CreateAddressLists(goCtx, &tokenizationMsg)
+		case msg.CreateDynamicStoreMsg != nil:
+			tokenizationMsg := *msg.CreateDynamicStoreMsg
+			tokenizationMsg.Creator = managerSplitter.Address
+			_, err = tokenizationMsgServer.CreateDynamicStore(goCtx, &tokenizationMsg)
+		case msg.UpdateDynamicStoreMsg != nil:
+			tokenizationMsg := *msg.UpdateDynamicStoreMsg
+			tokenizationMsg.Creator = managerSplitter.Address
+			_, err = tokenizationMsgServer.UpdateDynamicStore(goCtx, &tokenizationMsg)
+		case msg.DeleteDynamicStoreMsg != nil:
+			tokenizationMsg := *msg.DeleteDynamicStoreMsg
+			tokenizationMsg.Creator = managerSplitter.Address
+			_, err = tokenizationMsgServer.DeleteDynamicStore(goCtx, &tokenizationMsg)
+		case msg.SetDynamicStoreValueMsg != nil:
+			tokenizationMsg := *msg.SetDynamicStoreValueMsg
+			tokenizationMsg.Creator = managerSplitter.Address
+			_, err = tokenizationMsgServer.SetDynamicStoreValue(goCtx, &tokenizationMsg)
+		default:
+			err = sdkerrors.Wrap(types.ErrInvalidRequest, "executable message must set exactly one message")
+		}
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message at index %d", i)
+		}
+	}
+
+	return collectionIds, nil
+}
+
+func (k msgServer) Execute(goCtx context.Context, msg *types.MsgExecute) (*types.MsgExecuteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate executor address
+	_, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAddress, "invalid executor address")
+	}
+
+	// Get manager splitter
+	managerSplitter, found := k.GetManagerSplitterFromStore(ctx, msg.ManagerSplitterAddress)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrManagerSplitterNotFound, msg.ManagerSplitterAddress)
+	}
+
+	// Check all permissions before executing
+	if err := k.checkExecutableMsgPermissions(ctx, msg.Executor, managerSplitter, msg.Msgs); err != nil {
+		return nil, err
+	}
+
+	// If any used permission is timelocked, queue the execution instead of applying it
+	permissionNames, err := getExecutableMsgsPermissions(msg.Msgs)
+	if err != nil {
+		return nil, err
+	}
+	timelockDuration := getTimelockDuration(managerSplitter, permissionNames)
+	if !timelockDuration.IsZero() {
+		now := sdkmath.NewUint(uint64(ctx.BlockTime().UnixMilli()))
+
+		nextId := k.GetNextQueuedExecutionId(ctx)
+		queuedExecution := &types.QueuedExecution{
+			Id:                     nextId,
+			ManagerSplitterAddress: managerSplitter.Address,
+			Executor:               msg.Executor,
+			Msgs:                   msg.Msgs,
+			QueuedAt:               now,
+			ExecutableAt:           now.Add(timelockDuration),
+		}
+
+		if err := k.SetQueuedExecutionInStore(ctx, queuedExecution); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to store queued execution")
+		}
+		k.SetNextQueuedExecutionId(ctx, nextId.Add(sdkmath.NewUint(1)))
+
+		return &types.MsgExecuteResponse{
+			Queued:            true,
+			QueuedExecutionId: nextId,
+			ExecutableAt:      queuedExecution.ExecutableAt,
+		}, nil
+	}
+
+	collectionIds, err := k.executeExecutableMsgs(goCtx, managerSplitter, msg.Msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.recordExecutableMsgs(ctx, managerSplitter, msg.Executor, msg.Msgs, collectionIds, sdkmath.ZeroUint()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteResponse{
+		QueuedExecutionId: sdkmath.ZeroUint(),
+		ExecutableAt:      sdkmath.ZeroUint(),
+	}, nil
+}
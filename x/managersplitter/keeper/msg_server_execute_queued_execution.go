@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ExecuteQueuedExecution(goCtx context.Context, msg *types.MsgExecuteQueuedExecution) (*types.MsgExecuteQueuedExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate executor address
+	_, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAddress, "invalid executor address")
+	}
+
+	// Get manager splitter
+	managerSplitter, found := k.GetManagerSplitterFromStore(ctx, msg.ManagerSplitterAddress)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrManagerSplitterNotFound, msg.ManagerSplitterAddress)
+	}
+
+	// Get queued execution
+	queuedExecution, found := k.GetQueuedExecutionFromStore(ctx, msg.QueuedExecutionId)
+	if !found || queuedExecution.ManagerSplitterAddress != managerSplitter.Address {
+		return nil, sdkerrors.Wrap(types.ErrQueuedExecutionNotFound, msg.QueuedExecutionId.String())
+	}
+
+	// Only the original executor or the admin can apply a queued execution
+	if msg.Executor != queuedExecution.Executor && msg.Executor != managerSplitter.Admin {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "only the original executor or admin can execute a queued execution")
+	}
+
+	// Check that the timelock has passed
+	now := sdkmath.NewUint(uint64(ctx.BlockTime().UnixMilli()))
+	if now.LT(queuedExecution.ExecutableAt) {
+		return nil, sdkerrors.Wrapf(types.ErrTimelockNotExpired, "executable at %s, current time %s", queuedExecution.ExecutableAt, now)
+	}
+
+	// Queued via MsgUpdateManagerSplitter
+	if queuedExecution.Permissions != nil {
+		// Only the admin can update permissions
+		if queuedExecution.Executor != managerSplitter.Admin {
+			return nil, sdkerrors.Wrap(types.ErrUnauthorized, "only admin can update manager splitter")
+		}
+
+		// The queued permissions are a full snapshot, so applying them on top of permissions that changed
+		// after queueing (e.g. a non-timelocked update or another queued update) would silently revert that change
+		if !k.permissionsEqual(managerSplitter.Permissions, queuedExecution.PreviousPermissions) {
+			return nil, sdkerrors.Wrap(types.ErrStaleQueuedExecution, "queue the update again against the current permissions")
+		}
+
+		k.DeleteQueuedExecutionFromStore(ctx, queuedExecution.Id)
+
+		managerSplitter.Permissions = queuedExecution.Permissions
+		if err := k.SetManagerSplitterInStore(ctx, managerSplitter); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to update manager splitter")
+		}
+
+		return &types.MsgExecuteQueuedExecutionResponse{
+			CollectionId: sdkmath.ZeroUint(),
+		}, nil
+	}
+
+	// Queued via MsgExecute
+	if queuedExecution.UniversalUpdateCollectionMsg == nil {
+		// Re-check permissions of the original executor in case they were revoked while queued
+		if err := k.checkExecutableMsgPermissions(ctx, queuedExecution.Executor, managerSplitter, queuedExecution.Msgs); err != nil {
+			return nil, err
+		}
+
+		k.DeleteQueuedExecutionFromStore(ctx, queuedExecution.Id)
+
+		collectionIds, err := k.executeExecutableMsgs(goCtx, managerSplitter, queuedExecution.Msgs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.recordExecutableMsgs(ctx, managerSplitter, queuedExecution.Executor, queuedExecution.Msgs, collectionIds, queuedExecution.Id); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgExecuteQueuedExecutionResponse{
+			CollectionId: sdkmath.ZeroUint(),
+		}, nil
+	}
+
+	// Re-check permissions of the original executor in case they were revoked while queued
+	if err := k.checkAllPermissions(ctx, queuedExecution.Executor, managerSplitter, queuedExecution.UniversalUpdateCollectionMsg); err != nil {
+		return nil, err
+	}
+
+	k.DeleteQueuedExecutionFromStore(ctx, queuedExecution.Id)
+
+	response, err := k.executeUniversalUpdateCollection(goCtx, managerSplitter, queuedExecution.UniversalUpdateCollectionMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.recordExecution(ctx, managerSplitter, queuedExecution.Executor, getExercisedPermissions(queuedExecution.UniversalUpdateCollectionMsg), response.CollectionId, queuedExecution.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteQueuedExecutionResponse{
+		CollectionId: response.CollectionId,
+	}, nil
+}
+
+// permissionsEqual returns whether two sets of manager splitter permissions are equal, treating unset permissions as empty
+func (k Keeper) permissionsEqual(a *types.ManagerSplitterPermissions, b *types.ManagerSplitterPermissions) bool {
+	if a == nil {
+		a = &types.ManagerSplitterPermissions{}
+	}
+	if b == nil {
+		b = &types.ManagerSplitterPermissions{}
+	}
+	return bytes.Equal(k.cdc.MustMarshal(a), k.cdc.MustMarshal(b))
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"math"
+	"time"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *TestSuite) TestExecute_ApprovedAddressCanCreateDynamicStore() {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanCreateDynamicStore = &types.PermissionCriteria{ApprovedAddresses: []string{alice}}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	storeId := suite.app.TokenizationKeeper.GetNextDynamicStoreId(suite.ctx)
+	_, err = Execute(suite, wctx, &types.MsgExecute{
+		Executor:               alice,
+		ManagerSplitterAddress: res.Address,
+		Msgs: []*types.ExecutableMsg{
+			{CreateDynamicStoreMsg: &tokenizationtypes.MsgCreateDynamicStore{Creator: alice}},
+		},
+	})
+	suite.Require().Nil(err, "Approved address should be able to create a dynamic store")
+
+	dynamicStore, found := suite.app.TokenizationKeeper.GetDynamicStoreFromStore(suite.ctx, storeId)
+	suite.Require().True(found)
+	suite.Require().Equal(res.Address, dynamicStore.CreatedBy, "Dynamic store should be created by the manager splitter")
+}
+
+func (suite *TestSuite) TestExecute_UnapprovedAddressDenied() {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanCreateDynamicStore = &types.PermissionCriteria{ApprovedAddresses: []string{alice}}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	// Alice is approved for dynamic stores but not for transfers
+	_, err = Execute(suite, wctx, &types.MsgExecute{
+		Executor:               alice,
+		ManagerSplitterAddress: res.Address,
+		Msgs: []*types.ExecutableMsg{
+			{CreateDynamicStoreMsg: &tokenizationtypes.MsgCreateDynamicStore{Creator: alice}},
+			{CreateAddressListsMsg: &tokenizationtypes.MsgCreateAddressLists{Creator: alice}},
+		},
+	})
+	suite.Require().ErrorIs(err, types.ErrPermissionDenied)
+	suite.Require().Contains(err.Error(), "canCreateAddressLists")
+
+	// Charlie is not approved for anything
+	_, err = Execute(suite, wctx, &types.MsgExecute{
+		Executor:               charlie,
+		ManagerSplitterAddress: res.Address,
+		Msgs: []*types.ExecutableMsg{
+			{CreateDynamicStoreMsg: &tokenizationtypes.MsgCreateDynamicStore{Creator: charlie}},
+		},
+	})
+	suite.Require().ErrorIs(err, types.ErrPermissionDenied)
+}
+
+func (suite *TestSuite) TestExecute_RejectsMultipleMsgsInOneWrapper() {
+	msg := &types.MsgExecute{
+		Executor:               alice,
+		ManagerSplitterAddress: bob,
+		Msgs: []*types.ExecutableMsg{
+			{
+				CreateDynamicStoreMsg: &tokenizationtypes.MsgCreateDynamicStore{Creator: alice},
+				DeleteDynamicStoreMsg: &tokenizationtypes.MsgDeleteDynamicStore{Creator: alice, StoreId: sdkmath.NewUint(1)},
+			},
+		},
+	}
+	suite.Require().Error(msg.ValidateBasic())
+}
+
+func (suite *TestSuite) TestExecute_TimelockedPermissionIsQueued() {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanCreateDynamicStore = &types.PermissionCriteria{
+		ApprovedAddresses: []string{alice},
+		TimelockDuration:  sdkmath.NewUint(60000),
+	}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	storeId := suite.app.TokenizationKeeper.GetNextDynamicStoreId(suite.ctx)
+	execRes, err := Execute(suite, wctx, &types.MsgExecute{
+		Executor:               alice,
+		ManagerSplitterAddress: res.Address,
+		Msgs: []*types.ExecutableMsg{
+			{CreateDynamicStoreMsg: &tokenizationtypes.MsgCreateDynamicStore{Creator: alice}},
+		},
+	})
+	suite.Require().Nil(err, "Error queuing execution")
+	suite.Require().True(execRes.Queued)
+
+	_, found := suite.app.TokenizationKeeper.GetDynamicStoreFromStore(suite.ctx, storeId)
+	suite.Require().False(found, "Queued execution should not be applied yet")
+
+	laterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Second))
+	_, err = suite.msgServer.ExecuteQueuedExecution(sdk.WrapSDKContext(laterCtx), &types.MsgExecuteQueuedExecution{
+		Executor:               alice,
+		ManagerSplitterAddress: res.Address,
+		QueuedExecutionId:      execRes.QueuedExecutionId,
+	})
+	suite.Require().Nil(err, "Should execute after timelock passes")
+
+	_, found = suite.app.TokenizationKeeper.GetDynamicStoreFromStore(laterCtx, storeId)
+	suite.Require().True(found)
+}
+
+func (suite *TestSuite) TestExecute_LogsOneEntryPerMsgWithCollectionId() {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanTransferTokens = &types.PermissionCriteria{ApprovedAddresses: []string{alice}}
+	perms.CanCreateDynamicStore = &types.PermissionCriteria{ApprovedAddresses: []string{alice}}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	fullRanges := []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(math.MaxUint64)}}
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               bob,
+		ManagerSplitterAddress: res.Address,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:                   bob,
+			CollectionId:              sdkmath.NewUint(0),
+			UpdateValidTokenIds:       true,
+			ValidTokenIds:             []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(1)}},
+			UpdateCollectionApprovals: true,
+			CollectionApprovals: []*tokenizationtypes.CollectionApproval{
+				{
+					ApprovalId:        "mint",
+					FromListId:        "Mint",
+					ToListId:          "All",
+					InitiatedByListId: "All",
+					TransferTimes:     fullRanges,
+					TokenIds:          fullRanges,
+					OwnershipTimes:    fullRanges,
+					ApprovalCriteria: &tokenizationtypes.ApprovalCriteria{
+						OverridesFromOutgoingApprovals: true,
+						OverridesToIncomingApprovals:   true,
+					},
+				},
+			},
+		},
+	})
+	suite.Require().Nil(err, "Error creating collection through manager splitter")
+
+	// A zero collection ID refers to the latest collection
+	_, err = Execute(suite, wctx, &types.MsgExecute{
+		Executor:               alice,
+		ManagerSplitterAddress: res.Address,
+		Msgs: []*types.ExecutableMsg{
+			{CreateDynamicStoreMsg: &tokenizationtypes.MsgCreateDynamicStore{Creator: alice}},
+			{TransferTokensMsg: &tokenizationtypes.MsgTransferTokens{
+				Creator:      alice,
+				CollectionId: sdkmath.NewUint(0),
+				Transfers: []*tokenizationtypes.Transfer{
+					{
+						From:        "Mint",
+						ToAddresses: []string{alice},
+						Balances: []*tokenizationtypes.Balance{
+							{Amount: sdkmath.NewUint(1), TokenIds: []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(1)}}, OwnershipTimes: fullRanges},
+						},
+					},
+				},
+			}},
+		},
+	})
+	suite.Require().Nil(err, "Approved address should be able to execute both messages")
+
+	logs, err := suite.queryClient.ExecutionLogs(wctx, &types.QueryExecutionLogsRequest{ManagerSplitterAddress: res.Address})
+	suite.Require().Nil(err)
+	suite.Require().Len(logs.ExecutionLogs, 3)
+	suite.Require().Equal([]string{"canCreateDynamicStore"}, logs.ExecutionLogs[1].PermissionsExercised)
+	suite.Require().True(logs.ExecutionLogs[1].CollectionId.IsZero())
+	suite.Require().Equal([]string{"canTransferTokens"}, logs.ExecutionLogs[2].PermissionsExercised)
+	suite.Require().Equal(execRes.CollectionId, logs.ExecutionLogs[2].CollectionId)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,62 +18,107 @@ func isAddressApproved(address string, approvedAddresses []string) bool {
 	return slices.Contains(approvedAddresses, address)
 }
 
-// checkPermission checks if the executor has permission to execute a specific action
-func (k Keeper) checkPermission(ctx sdk.Context, executor string, managerSplitter *types.ManagerSplitter, permissionName string) error {
-	// Admin always has full permissions
-	if executor == managerSplitter.Admin {
+// allPermissionNames lists the names of all manager splitter permissions
+var allPermissionNames = []string{
+	"canDeleteCollection",
+	"canArchiveCollection",
+	"canUpdateStandards",
+	"canUpdateCustomData",
+	"canUpdateManager",
+	"canUpdateCollectionMetadata",
+	"canUpdateValidTokenIds",
+	"canUpdateTokenMetadata",
+	"canUpdateCollectionApprovals",
+	"canAddMoreAliasPaths",
+	"canAddMoreCosmosCoinWrapperPaths",
+	"canTransferTokens",
+	"canCreateAddressLists",
+	"canCreateDynamicStore",
+	"canUpdateDynamicStore",
+	"canDeleteDynamicStore",
+	"canSetDynamicStoreValue",
+}
+
+// getApprovedExecutors returns the unique addresses approved for at least one permission
+func getApprovedExecutors(managerSplitter *types.ManagerSplitter) []string {
+	executors := []string{}
+	for _, permissionName := range allPermissionNames {
+		criteria := getPermissionCriteria(managerSplitter, permissionName)
+		if criteria == nil {
+			continue
+		}
+
+		for _, addr := range criteria.ApprovedAddresses {
+			if !slices.Contains(executors, addr) {
+				executors = append(executors, addr)
+			}
+		}
+	}
+
+	return executors
+}
+
+// getPermissionCriteria returns the permission criteria for a specific permission (nil if not set)
+func getPermissionCriteria(managerSplitter *types.ManagerSplitter, permissionName string) *types.PermissionCriteria {
+	return getCriteriaFromPermissions(managerSplitter.Permissions, permissionName)
+}
+
+// getCriteriaFromPermissions returns the criteria for a specific permission from a permissions set (nil if not set)
+func getCriteriaFromPermissions(perms *types.ManagerSplitterPermissions, permissionName string) *types.PermissionCriteria {
+	if perms == nil {
 		return nil
 	}
 
-	// Get the permission criteria for this permission
-	var criteria *types.PermissionCriteria
 	switch permissionName {
 	case "canDeleteCollection":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanDeleteCollection != nil {
-			criteria = managerSplitter.Permissions.CanDeleteCollection
-		}
+		return perms.CanDeleteCollection
 	case "canArchiveCollection":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanArchiveCollection != nil {
-			criteria = managerSplitter.Permissions.CanArchiveCollection
-		}
+		return perms.CanArchiveCollection
 	case "canUpdateStandards":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanUpdateStandards != nil {
-			criteria = managerSplitter.Permissions.CanUpdateStandards
-		}
+		return perms.CanUpdateStandards
 	case "canUpdateCustomData":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanUpdateCustomData != nil {
-			criteria = managerSplitter.Permissions.CanUpdateCustomData
-		}
+		return perms.CanUpdateCustomData
 	case "canUpdateManager":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanUpdateManager != nil {
-			criteria = managerSplitter.Permissions.CanUpdateManager
-		}
+		return perms.CanUpdateManager
 	case "canUpdateCollectionMetadata":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanUpdateCollectionMetadata != nil {
-			criteria = managerSplitter.Permissions.CanUpdateCollectionMetadata
-		}
+		return perms.CanUpdateCollectionMetadata
 	case "canUpdateValidTokenIds":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanUpdateValidTokenIds != nil {
-			criteria = managerSplitter.Permissions.CanUpdateValidTokenIds
-		}
+		return perms.CanUpdateValidTokenIds
 	case "canUpdateTokenMetadata":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanUpdateTokenMetadata != nil {
-			criteria = managerSplitter.Permissions.CanUpdateTokenMetadata
-		}
+		return perms.CanUpdateTokenMetadata
 	case "canUpdateCollectionApprovals":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanUpdateCollectionApprovals != nil {
-			criteria = managerSplitter.Permissions.CanUpdateCollectionApprovals
-		}
+		return perms.CanUpdateCollectionApprovals
 	case "canAddMoreAliasPaths":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanAddMoreAliasPaths != nil {
-			criteria = managerSplitter.Permissions.CanAddMoreAliasPaths
-		}
+		return perms.CanAddMoreAliasPaths
 	case "canAddMoreCosmosCoinWrapperPaths":
-		if managerSplitter.Permissions != nil && managerSplitter.Permissions.CanAddMoreCosmosCoinWrapperPaths != nil {
-			criteria = managerSplitter.Permissions.CanAddMoreCosmosCoinWrapperPaths
-		}
+		return perms.CanAddMoreCosmosCoinWrapperPaths
+	case "canTransferTokens":
+		return perms.CanTransferTokens
+	case "canCreateAddressLists":
+		return perms.CanCreateAddressLists
+	case "canCreateDynamicStore":
+		return perms.CanCreateDynamicStore
+	case "canUpdateDynamicStore":
+		return perms.CanUpdateDynamicStore
+	case "canDeleteDynamicStore":
+		return perms.CanDeleteDynamicStore
+	case "canSetDynamicStoreValue":
+		return perms.CanSetDynamicStoreValue
 	}
 
+	return nil
+}
+
+// checkPermission checks if the executor has permission to execute a specific action
+func (k Keeper) checkPermission(ctx sdk.Context, executor string, managerSplitter *types.ManagerSplitter, permissionName string) error {
+	// Admin always has full permissions
+	if executor == managerSplitter.Admin {
+		return nil
+	}
+
+	// Get the permission criteria for this permission
+	criteria := getPermissionCriteria(managerSplitter, permissionName)
+
 	// If no criteria set, deny by default (except admin)
 	if criteria == nil {
 		return sdkerrors.Wrap(types.ErrPermissionDenied, "no permission criteria set for "+permissionName)
@@ -86,81 +132,150 @@ func (k Keeper) checkPermission(ctx sdk.Context, executor string, managerSplitte
 	return nil
 }
 
-// checkAllPermissions checks all permissions that would be used by the UniversalUpdateCollection message
-func (k Keeper) checkAllPermissions(ctx sdk.Context, executor string, managerSplitter *types.ManagerSplitter, msg *tokenizationtypes.MsgUniversalUpdateCollection) error {
-	// Check permissions based on which fields are being updated
-	if msg.UpdateValidTokenIds {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canUpdateValidTokenIds"); err != nil {
-			return err
-		}
-	}
+// getExercisedPermissions returns the names of all permissions that would be used by the UniversalUpdateCollection message.
+// Note: UpdateCollectionPermissions is admin-only and has no corresponding permission criteria.
+func getExercisedPermissions(msg *tokenizationtypes.MsgUniversalUpdateCollection) []string {
+	permissionNames := []string{}
 
-	if msg.UpdateCollectionPermissions {
-		if executor != managerSplitter.Admin {
-			return sdkerrors.Wrap(types.ErrPermissionDenied, "only admin can update collection permissions")
-		}
+	if msg.UpdateValidTokenIds {
+		permissionNames = append(permissionNames, "canUpdateValidTokenIds")
 	}
 
 	if msg.UpdateManager {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canUpdateManager"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canUpdateManager")
 	}
 
 	if msg.UpdateCollectionMetadata {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canUpdateCollectionMetadata"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canUpdateCollectionMetadata")
 	}
 
 	if msg.UpdateTokenMetadata {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canUpdateTokenMetadata"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canUpdateTokenMetadata")
 	}
 
 	if msg.UpdateCustomData {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canUpdateCustomData"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canUpdateCustomData")
 	}
 
 	if msg.UpdateCollectionApprovals {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canUpdateCollectionApprovals"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canUpdateCollectionApprovals")
 	}
 
 	if msg.UpdateStandards {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canUpdateStandards"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canUpdateStandards")
 	}
 
 	if msg.UpdateIsArchived {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canArchiveCollection"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canArchiveCollection")
 	}
 
 	if len(msg.CosmosCoinWrapperPathsToAdd) > 0 {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canAddMoreCosmosCoinWrapperPaths"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canAddMoreCosmosCoinWrapperPaths")
 	}
 
 	if len(msg.AliasPathsToAdd) > 0 {
-		if err := k.checkPermission(ctx, executor, managerSplitter, "canAddMoreAliasPaths"); err != nil {
-			return err
-		}
+		permissionNames = append(permissionNames, "canAddMoreAliasPaths")
 	}
 
 	// Note: canDeleteCollection is checked separately if needed
 	// For now, we don't have a delete flag in UniversalUpdateCollection
 
+	return permissionNames
+}
+
+// checkAllPermissions checks all permissions that would be used by the UniversalUpdateCollection message
+func (k Keeper) checkAllPermissions(ctx sdk.Context, executor string, managerSplitter *types.ManagerSplitter, msg *tokenizationtypes.MsgUniversalUpdateCollection) error {
+	if msg.UpdateCollectionPermissions {
+		if executor != managerSplitter.Admin {
+			return sdkerrors.Wrap(types.ErrPermissionDenied, "only admin can update collection permissions")
+		}
+	}
+
+	for _, permissionName := range getExercisedPermissions(msg) {
+		if err := k.checkPermission(ctx, executor, managerSplitter, permissionName); err != nil {
+			return err
+		}
+	}
+
+	// Check token ID and approval ID scopes against the diff of the update
+	if err := k.checkScopedPermissions(ctx, executor, managerSplitter, msg); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// getTimelockDuration returns the longest timelock (in milliseconds) across the given permissions.
+// Zero means the execution can be applied immediately.
+func getTimelockDuration(managerSplitter *types.ManagerSplitter, permissionNames []string) sdkmath.Uint {
+	timelockDuration := sdkmath.ZeroUint()
+	for _, permissionName := range permissionNames {
+		criteriaTimelock := getCriteriaTimelockDuration(getPermissionCriteria(managerSplitter, permissionName))
+		if criteriaTimelock.GT(timelockDuration) {
+			timelockDuration = criteriaTimelock
+		}
+	}
+
+	return timelockDuration
+}
+
+// getUniversalUpdateTimelockDuration returns the timelock (in milliseconds) of a UniversalUpdateCollection message.
+// Updating the collection permissions can unlock any other action, so it is gated by the longest timelock of any permission.
+func getUniversalUpdateTimelockDuration(managerSplitter *types.ManagerSplitter, msg *tokenizationtypes.MsgUniversalUpdateCollection) sdkmath.Uint {
+	if msg.UpdateCollectionPermissions {
+		return getTimelockDuration(managerSplitter, allPermissionNames)
+	}
+
+	return getTimelockDuration(managerSplitter, getExercisedPermissions(msg))
+}
+
+// getPermissionsUpdateTimelockDuration returns the timelock (in milliseconds) of replacing the manager splitter's permissions.
+// Changing a permission's timelock is gated by its current timelock, so the admin cannot skip the delay by removing it first.
+// Other changes (e.g. approving or revoking executors) can be applied immediately.
+func getPermissionsUpdateTimelockDuration(managerSplitter *types.ManagerSplitter, newPermissions *types.ManagerSplitterPermissions) sdkmath.Uint {
+	timelockDuration := sdkmath.ZeroUint()
+	for _, permissionName := range allPermissionNames {
+		currentTimelock := getCriteriaTimelockDuration(getPermissionCriteria(managerSplitter, permissionName))
+		newTimelock := getCriteriaTimelockDuration(getCriteriaFromPermissions(newPermissions, permissionName))
+		if currentTimelock.Equal(newTimelock) {
+			continue
+		}
+
+		if currentTimelock.GT(timelockDuration) {
+			timelockDuration = currentTimelock
+		}
+	}
+
+	return timelockDuration
+}
+
+// getCriteriaTimelockDuration returns the timelock (in milliseconds) of the permission criteria (zero if not set)
+func getCriteriaTimelockDuration(criteria *types.PermissionCriteria) sdkmath.Uint {
+	if criteria == nil || criteria.TimelockDuration.IsNil() {
+		return sdkmath.ZeroUint()
+	}
+
+	return criteria.TimelockDuration
+}
+
+// executeUniversalUpdateCollection executes the UniversalUpdateCollection message with the manager splitter as the creator
+func (k Keeper) executeUniversalUpdateCollection(goCtx context.Context, managerSplitter *types.ManagerSplitter, msg *tokenizationtypes.MsgUniversalUpdateCollection) (*tokenizationtypes.MsgUniversalUpdateCollectionResponse, error) {
+	// Create a new message with the manager splitter address as the creator
+	// This ensures the tokenization module sees the manager splitter as the manager
+	tokenizationMsg := *msg
+	tokenizationMsg.Creator = managerSplitter.Address
+
+	// Call the tokenization module's UniversalUpdateCollection through msgServer
+	// We need to create a msgServer instance to call the method
+	tokenizationMsgServer := tokenizationkeeper.NewMsgServerImpl(k.tokenizationKeeper)
+	response, err := tokenizationMsgServer.UniversalUpdateCollection(goCtx, &tokenizationMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to execute UniversalUpdateCollection")
+	}
+
+	return response, nil
+}
+
 func (k msgServer) ExecuteUniversalUpdateCollection(goCtx context.Context, msg *types.MsgExecuteUniversalUpdateCollection) (*types.MsgExecuteUniversalUpdateCollectionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -181,20 +296,48 @@ func (k msgServer) ExecuteUniversalUpdateCollection(goCtx context.Context, msg *
 		return nil, err
 	}
 
-	// Create a new message with the manager splitter address as the creator
-	// This ensures the tokenization module sees the manager splitter as the manager
-	tokenizationMsg := *msg.UniversalUpdateCollectionMsg
-	tokenizationMsg.Creator = managerSplitter.Address
+	// If any used permission is timelocked, queue the execution instead of applying it
+	timelockDuration := getUniversalUpdateTimelockDuration(managerSplitter, msg.UniversalUpdateCollectionMsg)
+	if !timelockDuration.IsZero() {
+		now := sdkmath.NewUint(uint64(ctx.BlockTime().UnixMilli()))
+		queuedMsg := *msg.UniversalUpdateCollectionMsg
+		queuedMsg.Creator = managerSplitter.Address
 
-	// Call the tokenization module's UniversalUpdateCollection through msgServer
-	// We need to create a msgServer instance to call the method
-	tokenizationMsgServer := tokenizationkeeper.NewMsgServerImpl(k.tokenizationKeeper)
-	response, err := tokenizationMsgServer.UniversalUpdateCollection(goCtx, &tokenizationMsg)
+		nextId := k.GetNextQueuedExecutionId(ctx)
+		queuedExecution := &types.QueuedExecution{
+			Id:                           nextId,
+			ManagerSplitterAddress:       managerSplitter.Address,
+			Executor:                     msg.Executor,
+			UniversalUpdateCollectionMsg: &queuedMsg,
+			QueuedAt:                     now,
+			ExecutableAt:                 now.Add(timelockDuration),
+		}
+
+		if err := k.SetQueuedExecutionInStore(ctx, queuedExecution); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to store queued execution")
+		}
+		k.SetNextQueuedExecutionId(ctx, nextId.Add(sdkmath.NewUint(1)))
+
+		return &types.MsgExecuteUniversalUpdateCollectionResponse{
+			CollectionId:      msg.UniversalUpdateCollectionMsg.CollectionId,
+			Queued:            true,
+			QueuedExecutionId: nextId,
+			ExecutableAt:      queuedExecution.ExecutableAt,
+		}, nil
+	}
+
+	response, err := k.executeUniversalUpdateCollection(goCtx, managerSplitter, msg.UniversalUpdateCollectionMsg)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to execute UniversalUpdateCollection")
+		return nil, err
+	}
+
+	if err := k.recordExecution(ctx, managerSplitter, msg.Executor, getExercisedPermissions(msg.UniversalUpdateCollectionMsg), response.CollectionId, sdkmath.ZeroUint()); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgExecuteUniversalUpdateCollectionResponse{
-		CollectionId: response.CollectionId,
+		CollectionId:      response.CollectionId,
+		QueuedExecutionId: sdkmath.ZeroUint(),
+		ExecutableAt:      sdkmath.ZeroUint(),
 	}, nil
 }
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func scopedTestApproval(approvalId string) *tokenizationtypes.CollectionApproval {
+	return &tokenizationtypes.CollectionApproval{
+		ApprovalId:        approvalId,
+		FromListId:        "!Mint",
+		ToListId:          "All",
+		InitiatedByListId: "All",
+		TransferTimes:     []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(1000)}},
+		TokenIds:          []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(1000)}},
+		OwnershipTimes:    []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(1000)}},
+		ApprovalCriteria:  &tokenizationtypes.ApprovalCriteria{},
+	}
+}
+
+func scopedTestTokenMetadata(start uint64, end uint64, uri string) *tokenizationtypes.TokenMetadata {
+	return &tokenizationtypes.TokenMetadata{
+		Uri:      uri,
+		TokenIds: []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(start), End: sdkmath.NewUint(end)}},
+	}
+}
+
+// createScopedSplitterAndCollection creates a manager splitter where alice is scoped to token IDs 1000-1999
+// for token metadata and to approval IDs prefixed with "alice-" for collection approvals.
+func (suite *TestSuite) createScopedSplitterAndCollection() (string, sdkmath.Uint) {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanUpdateTokenMetadata = &types.PermissionCriteria{
+		ApprovedAddresses: []string{alice},
+		TokenIds:          []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1000), End: sdkmath.NewUint(1999)}},
+	}
+	perms.CanUpdateCollectionApprovals = &types.PermissionCriteria{
+		ApprovedAddresses:  []string{alice},
+		ApprovalIdPrefixes: []string{"alice-"},
+	}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	// Admin is never scoped
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               bob,
+		ManagerSplitterAddress: res.Address,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:             bob,
+			CollectionId:        sdkmath.NewUint(0),
+			UpdateTokenMetadata: true,
+			TokenMetadata: []*tokenizationtypes.TokenMetadata{
+				scopedTestTokenMetadata(1, 999, "https://example.com/a"),
+				scopedTestTokenMetadata(1000, 1999, "https://example.com/b"),
+			},
+			UpdateCollectionApprovals: true,
+			CollectionApprovals: []*tokenizationtypes.CollectionApproval{
+				scopedTestApproval("alice-1"),
+				scopedTestApproval("other"),
+			},
+		},
+	})
+	suite.Require().Nil(err, "Error creating collection through manager splitter")
+
+	return res.Address, execRes.CollectionId
+}
+
+func (suite *TestSuite) TestScopedPermissions_TokenMetadata() {
+	splitterAddress, collectionId := suite.createScopedSplitterAndCollection()
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	// Changing metadata within token IDs 1000-1999 is allowed
+	_, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:             alice,
+			CollectionId:        collectionId,
+			UpdateTokenMetadata: true,
+			TokenMetadata: []*tokenizationtypes.TokenMetadata{
+				scopedTestTokenMetadata(1, 999, "https://example.com/a"),
+				scopedTestTokenMetadata(1000, 1999, "https://example.com/c"),
+			},
+		},
+	})
+	suite.Require().Nil(err, "In-scope token metadata update should succeed")
+
+	// Changing metadata outside the scope is denied
+	_, err = ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:             alice,
+			CollectionId:        collectionId,
+			UpdateTokenMetadata: true,
+			TokenMetadata: []*tokenizationtypes.TokenMetadata{
+				scopedTestTokenMetadata(1, 999, "https://example.com/d"),
+				scopedTestTokenMetadata(1000, 1999, "https://example.com/c"),
+			},
+		},
+	})
+	suite.Require().ErrorIs(err, types.ErrPermissionDenied, "Out-of-scope token metadata update should be denied")
+}
+
+func (suite *TestSuite) TestScopedPermissions_CollectionApprovals() {
+	splitterAddress, collectionId := suite.createScopedSplitterAndCollection()
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	// Editing an approval matching the prefix is allowed
+	editedApproval := scopedTestApproval("alice-1")
+	editedApproval.TokenIds = []*tokenizationtypes.UintRange{{Start: sdkmath.NewUint(1), End: sdkmath.NewUint(500)}}
+	_, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:                   alice,
+			CollectionId:              collectionId,
+			UpdateCollectionApprovals: true,
+			CollectionApprovals:       []*tokenizationtypes.CollectionApproval{editedApproval, scopedTestApproval("other")},
+		},
+	})
+	suite.Require().Nil(err, "In-scope approval update should succeed")
+
+	// Removing an approval outside the scope is denied
+	_, err = ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:                   alice,
+			CollectionId:              collectionId,
+			UpdateCollectionApprovals: true,
+			CollectionApprovals:       []*tokenizationtypes.CollectionApproval{editedApproval},
+		},
+	})
+	suite.Require().ErrorIs(err, types.ErrPermissionDenied, "Out-of-scope approval removal should be denied")
+}
+
+func (suite *TestSuite) TestScopedPermissions_InvalidScopeRejected() {
+	perms := GetDefaultPermissions()
+	perms.CanUpdateCustomData = &types.PermissionCriteria{
+		ApprovedAddresses: []string{alice},
+		ApprovalIds:       []string{"alice-1"},
+	}
+
+	msg := &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	}
+	suite.Require().Error(msg.ValidateBasic(), "Approval ID scopes should only be allowed on canUpdateCollectionApprovals")
+}
@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// createTimelockedSplitterAndCollection creates a manager splitter where alice can update custom data
+// behind a timelock, and a collection managed by that splitter.
+func (suite *TestSuite) createTimelockedSplitterAndCollection(timelockDuration sdkmath.Uint) (string, sdkmath.Uint) {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanUpdateCustomData.ApprovedAddresses = []string{alice}
+	perms.CanUpdateCustomData.TimelockDuration = timelockDuration
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	// Admin creates a collection through the splitter (standards are not timelocked)
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               bob,
+		ManagerSplitterAddress: res.Address,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:         bob,
+			CollectionId:    sdkmath.NewUint(0),
+			UpdateStandards: true,
+			Standards:       []string{"test"},
+		},
+	})
+	suite.Require().Nil(err, "Error creating collection through manager splitter")
+	suite.Require().False(execRes.Queued, "Untimelocked execution should not be queued")
+
+	return res.Address, execRes.CollectionId
+}
+
+func (suite *TestSuite) TestTimelock_ExecutionIsQueuedUntilDelayPasses() {
+	splitterAddress, collectionId := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:          alice,
+			CollectionId:     collectionId,
+			UpdateCustomData: true,
+			CustomData:       "timelocked",
+		},
+	})
+	suite.Require().Nil(err, "Error queuing execution")
+	suite.Require().True(execRes.Queued, "Timelocked execution should be queued")
+
+	collection, found := suite.app.TokenizationKeeper.GetCollectionFromStore(suite.ctx, collectionId)
+	suite.Require().True(found)
+	suite.Require().Empty(collection.CustomData, "Queued execution should not be applied yet")
+
+	queuedRes, err := suite.queryClient.QueuedExecutions(wctx, &types.QueryQueuedExecutionsRequest{ManagerSplitterAddress: splitterAddress})
+	suite.Require().Nil(err)
+	suite.Require().Len(queuedRes.QueuedExecutions, 1, "Queued execution should be visible via query")
+
+	executeMsg := &types.MsgExecuteQueuedExecution{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      execRes.QueuedExecutionId,
+	}
+	suite.Require().Nil(executeMsg.ValidateBasic())
+
+	_, err = suite.msgServer.ExecuteQueuedExecution(wctx, executeMsg)
+	suite.Require().ErrorIs(err, types.ErrTimelockNotExpired, "Should not execute before timelock passes")
+
+	laterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Second))
+	_, err = suite.msgServer.ExecuteQueuedExecution(sdk.WrapSDKContext(laterCtx), executeMsg)
+	suite.Require().Nil(err, "Should execute after timelock passes")
+
+	collection, found = suite.app.TokenizationKeeper.GetCollectionFromStore(laterCtx, collectionId)
+	suite.Require().True(found)
+	suite.Require().Equal("timelocked", collection.CustomData)
+
+	_, found = suite.app.ManagerSplitterKeeper.GetQueuedExecutionFromStore(laterCtx, execRes.QueuedExecutionId)
+	suite.Require().False(found, "Queued execution should be removed after execution")
+}
+
+func (suite *TestSuite) TestTimelock_AdminCanCancelQueuedExecution() {
+	splitterAddress, collectionId := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:          alice,
+			CollectionId:     collectionId,
+			UpdateCustomData: true,
+			CustomData:       "timelocked",
+		},
+	})
+	suite.Require().Nil(err, "Error queuing execution")
+
+	// Non-admin cannot cancel
+	_, err = suite.msgServer.CancelQueuedExecution(wctx, &types.MsgCancelQueuedExecution{
+		Admin:                  alice,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      execRes.QueuedExecutionId,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.CancelQueuedExecution(wctx, &types.MsgCancelQueuedExecution{
+		Admin:                  bob,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      execRes.QueuedExecutionId,
+	})
+	suite.Require().Nil(err, "Admin should be able to cancel")
+
+	laterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Second))
+	_, err = suite.msgServer.ExecuteQueuedExecution(sdk.WrapSDKContext(laterCtx), &types.MsgExecuteQueuedExecution{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      execRes.QueuedExecutionId,
+	})
+	suite.Require().ErrorIs(err, types.ErrQueuedExecutionNotFound, "Cancelled execution should not be executable")
+}
+
+func (suite *TestSuite) TestTimelock_RevokedExecutorCannotApplyQueuedExecution() {
+	splitterAddress, collectionId := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:          alice,
+			CollectionId:     collectionId,
+			UpdateCustomData: true,
+			CustomData:       "timelocked",
+		},
+	})
+	suite.Require().Nil(err, "Error queuing execution")
+
+	// Admin revokes alice while the execution is queued (keeping the timelock, so the revocation applies immediately)
+	revokedPerms := GetDefaultPermissions()
+	revokedPerms.CanUpdateCustomData.TimelockDuration = sdkmath.NewUint(60000)
+	err = UpdateManagerSplitter(suite, wctx, &types.MsgUpdateManagerSplitter{
+		Admin:       bob,
+		Address:     splitterAddress,
+		Permissions: revokedPerms,
+	})
+	suite.Require().Nil(err)
+
+	laterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Second))
+	_, err = suite.msgServer.ExecuteQueuedExecution(sdk.WrapSDKContext(laterCtx), &types.MsgExecuteQueuedExecution{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      execRes.QueuedExecutionId,
+	})
+	suite.Require().ErrorIs(err, types.ErrPermissionDenied)
+}
+
+func (suite *TestSuite) TestTimelock_CollectionPermissionsUpdateIsQueued() {
+	splitterAddress, collectionId := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	// Updating the collection permissions is gated by the longest timelock of any permission
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               bob,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:                     bob,
+			CollectionId:                collectionId,
+			UpdateCollectionPermissions: true,
+			CollectionPermissions:       &tokenizationtypes.CollectionPermissions{},
+		},
+	})
+	suite.Require().Nil(err, "Error queuing execution")
+	suite.Require().True(execRes.Queued, "Collection permissions update should be queued")
+	suite.Require().Equal(sdkmath.NewUint(uint64(suite.ctx.BlockTime().UnixMilli())+60000), execRes.ExecutableAt)
+
+	executeMsg := &types.MsgExecuteQueuedExecution{
+		Executor:               bob,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      execRes.QueuedExecutionId,
+	}
+	_, err = suite.msgServer.ExecuteQueuedExecution(wctx, executeMsg)
+	suite.Require().ErrorIs(err, types.ErrTimelockNotExpired, "Should not execute before timelock passes")
+
+	laterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Second))
+	_, err = suite.msgServer.ExecuteQueuedExecution(sdk.WrapSDKContext(laterCtx), executeMsg)
+	suite.Require().Nil(err, "Should execute after timelock passes")
+}
+
+func (suite *TestSuite) TestTimelock_RemovingTimelockIsQueued() {
+	splitterAddress, collectionId := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	// Admin tries to remove the timelock to skip the delay
+	perms := GetDefaultPermissions()
+	perms.CanUpdateCustomData.ApprovedAddresses = []string{alice}
+	updateRes, err := suite.msgServer.UpdateManagerSplitter(wctx, &types.MsgUpdateManagerSplitter{
+		Admin:       bob,
+		Address:     splitterAddress,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error queuing permissions update")
+	suite.Require().True(updateRes.Queued, "Timelock removal should be queued")
+
+	managerSplitter, found := suite.app.ManagerSplitterKeeper.GetManagerSplitterFromStore(suite.ctx, splitterAddress)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewUint(60000), managerSplitter.Permissions.CanUpdateCustomData.TimelockDuration, "Timelock should not be removed yet")
+
+	// Executions are still timelocked while the removal is queued
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: splitterAddress,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:          alice,
+			CollectionId:     collectionId,
+			UpdateCustomData: true,
+			CustomData:       "timelocked",
+		},
+	})
+	suite.Require().Nil(err)
+	suite.Require().True(execRes.Queued, "Execution should still be queued")
+
+	executeMsg := &types.MsgExecuteQueuedExecution{
+		Executor:               bob,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      updateRes.QueuedExecutionId,
+	}
+	_, err = suite.msgServer.ExecuteQueuedExecution(wctx, executeMsg)
+	suite.Require().ErrorIs(err, types.ErrTimelockNotExpired, "Should not remove timelock before it passes")
+
+	laterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Second))
+	_, err = suite.msgServer.ExecuteQueuedExecution(sdk.WrapSDKContext(laterCtx), executeMsg)
+	suite.Require().Nil(err, "Should remove timelock after it passes")
+
+	managerSplitter, found = suite.app.ManagerSplitterKeeper.GetManagerSplitterFromStore(laterCtx, splitterAddress)
+	suite.Require().True(found)
+	suite.Require().True(managerSplitter.Permissions.CanUpdateCustomData.TimelockDuration.IsNil() || managerSplitter.Permissions.CanUpdateCustomData.TimelockDuration.IsZero())
+}
+
+func (suite *TestSuite) TestTimelock_StalePermissionsUpdateIsRejected() {
+	splitterAddress, _ := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	// Admin queues removing the timelock
+	perms := GetDefaultPermissions()
+	perms.CanUpdateCustomData.ApprovedAddresses = []string{alice}
+	updateRes, err := suite.msgServer.UpdateManagerSplitter(wctx, &types.MsgUpdateManagerSplitter{
+		Admin:       bob,
+		Address:     splitterAddress,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error queuing permissions update")
+	suite.Require().True(updateRes.Queued, "Timelock removal should be queued")
+
+	// Meanwhile, alice's approval is revoked without changing the timelock, which applies immediately
+	revokedPerms := GetDefaultPermissions()
+	revokedPerms.CanUpdateCustomData.TimelockDuration = sdkmath.NewUint(60000)
+	revokeRes, err := suite.msgServer.UpdateManagerSplitter(wctx, &types.MsgUpdateManagerSplitter{
+		Admin:       bob,
+		Address:     splitterAddress,
+		Permissions: revokedPerms,
+	})
+	suite.Require().Nil(err, "Error revoking approval")
+	suite.Require().False(revokeRes.Queued, "Revocation should not be queued")
+
+	// Applying the queued snapshot would re-approve alice, so it is rejected
+	laterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(61 * time.Second))
+	_, err = suite.msgServer.ExecuteQueuedExecution(sdk.WrapSDKContext(laterCtx), &types.MsgExecuteQueuedExecution{
+		Executor:               bob,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      updateRes.QueuedExecutionId,
+	})
+	suite.Require().ErrorIs(err, types.ErrStaleQueuedExecution, "Stale permissions update should be rejected")
+
+	managerSplitter, found := suite.app.ManagerSplitterKeeper.GetManagerSplitterFromStore(laterCtx, splitterAddress)
+	suite.Require().True(found)
+	suite.Require().Empty(managerSplitter.Permissions.CanUpdateCustomData.ApprovedAddresses, "Revocation should not be reverted")
+	suite.Require().Equal(sdkmath.NewUint(60000), managerSplitter.Permissions.CanUpdateCustomData.TimelockDuration)
+}
+
+func (suite *TestSuite) TestTimelock_QueuedExecutionsAreIndexedPerSplitter() {
+	splitterAddress, collectionId := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	otherSplitterAddress, otherCollectionId := suite.createTimelockedSplitterAndCollection(sdkmath.NewUint(60000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	queue := func(splitter string, collectionId sdkmath.Uint) sdkmath.Uint {
+		execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+			Executor:               alice,
+			ManagerSplitterAddress: splitter,
+			UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+				Creator:          alice,
+				CollectionId:     collectionId,
+				UpdateCustomData: true,
+				CustomData:       "timelocked",
+			},
+		})
+		suite.Require().Nil(err, "Error queuing execution")
+		suite.Require().True(execRes.Queued)
+		return execRes.QueuedExecutionId
+	}
+
+	firstId := queue(splitterAddress, collectionId)
+	queue(otherSplitterAddress, otherCollectionId)
+	queue(splitterAddress, collectionId)
+
+	pageRes, err := suite.queryClient.QueuedExecutions(wctx, &types.QueryQueuedExecutionsRequest{
+		ManagerSplitterAddress: splitterAddress,
+		Pagination:             &query.PageRequest{Limit: 1},
+	})
+	suite.Require().Nil(err)
+	suite.Require().Len(pageRes.QueuedExecutions, 1)
+	suite.Require().Equal(firstId, pageRes.QueuedExecutions[0].Id)
+	suite.Require().NotEmpty(pageRes.Pagination.NextKey)
+
+	pageRes, err = suite.queryClient.QueuedExecutions(wctx, &types.QueryQueuedExecutionsRequest{
+		ManagerSplitterAddress: splitterAddress,
+		Pagination:             &query.PageRequest{Key: pageRes.Pagination.NextKey},
+	})
+	suite.Require().Nil(err)
+	suite.Require().Len(pageRes.QueuedExecutions, 1)
+	suite.Require().Equal(splitterAddress, pageRes.QueuedExecutions[0].ManagerSplitterAddress)
+	suite.Require().Empty(pageRes.Pagination.NextKey)
+
+	otherRes, err := suite.queryClient.QueuedExecutions(wctx, &types.QueryQueuedExecutionsRequest{ManagerSplitterAddress: otherSplitterAddress})
+	suite.Require().Nil(err)
+	suite.Require().Len(otherRes.QueuedExecutions, 1)
+
+	// Cancelling removes the execution from the index
+	_, err = suite.msgServer.CancelQueuedExecution(wctx, &types.MsgCancelQueuedExecution{
+		Admin:                  bob,
+		ManagerSplitterAddress: splitterAddress,
+		QueuedExecutionId:      firstId,
+	})
+	suite.Require().Nil(err)
+	suite.Require().Len(suite.app.ManagerSplitterKeeper.GetQueuedExecutionsForManagerSplitter(suite.ctx, splitterAddress), 1)
+}
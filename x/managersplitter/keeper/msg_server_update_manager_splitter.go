@@ -6,6 +6,7 @@ import (
 	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -29,17 +30,53 @@ func (k msgServer) UpdateManagerSplitter(goCtx context.Context, msg *types.MsgUp
 		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "only admin can update manager splitter")
 	}
 
-	// Update permissions
-	if msg.Permissions != nil {
-		managerSplitter.Permissions = msg.Permissions
+	if msg.Permissions == nil {
+		return &types.MsgUpdateManagerSplitterResponse{
+			QueuedExecutionId: sdkmath.ZeroUint(),
+			ExecutableAt:      sdkmath.ZeroUint(),
+		}, nil
+	}
+
+	// If the update changes a timelock, queue it behind the current timelock instead of applying it
+	timelockDuration := getPermissionsUpdateTimelockDuration(managerSplitter, msg.Permissions)
+	if !timelockDuration.IsZero() {
+		now := sdkmath.NewUint(uint64(ctx.BlockTime().UnixMilli()))
+
+		nextId := k.GetNextQueuedExecutionId(ctx)
+		queuedExecution := &types.QueuedExecution{
+			Id:                     nextId,
+			ManagerSplitterAddress: managerSplitter.Address,
+			Executor:               msg.Admin,
+			Permissions:            msg.Permissions,
+			PreviousPermissions:    managerSplitter.Permissions,
+			QueuedAt:               now,
+			ExecutableAt:           now.Add(timelockDuration),
+		}
+
+		if err := k.SetQueuedExecutionInStore(ctx, queuedExecution); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to store queued execution")
+		}
+		k.SetNextQueuedExecutionId(ctx, nextId.Add(sdkmath.NewUint(1)))
+
+		return &types.MsgUpdateManagerSplitterResponse{
+			Queued:            true,
+			QueuedExecutionId: nextId,
+			ExecutableAt:      queuedExecution.ExecutableAt,
+		}, nil
 	}
 
+	// Update permissions
+	managerSplitter.Permissions = msg.Permissions
+
 	// Store updated manager splitter
 	err = k.SetManagerSplitterInStore(ctx, managerSplitter)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to update manager splitter")
 	}
 
-	return &types.MsgUpdateManagerSplitterResponse{}, nil
+	return &types.MsgUpdateManagerSplitterResponse{
+		QueuedExecutionId: sdkmath.ZeroUint(),
+		ExecutableAt:      sdkmath.ZeroUint(),
+	}, nil
 }
 
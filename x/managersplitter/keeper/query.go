@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
 
@@ -9,6 +10,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -65,3 +69,156 @@ func (k Keeper) AllManagerSplitters(c context.Context, req *types.QueryAllManage
 	}, nil
 }
 
+
+func (k Keeper) QueuedExecution(c context.Context, req *types.QueryGetQueuedExecutionRequest) (*types.QueryGetQueuedExecutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	id, err := sdkmath.ParseUint(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid queued execution ID")
+	}
+
+	queuedExecution, found := k.GetQueuedExecutionFromStore(ctx, id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "queued execution not found")
+	}
+
+	return &types.QueryGetQueuedExecutionResponse{QueuedExecution: queuedExecution}, nil
+}
+
+func (k Keeper) QueuedExecutions(c context.Context, req *types.QueryQueuedExecutionsRequest) (*types.QueryQueuedExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, addressIndexPrefix(QueuedExecutionByManagerSplitterKey, req.ManagerSplitterAddress))
+
+	queuedExecutions := []*types.QueuedExecution{}
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		queuedExecution, found := k.GetQueuedExecutionFromStore(ctx, sdkmath.NewUint(binary.BigEndian.Uint64(key)))
+		if found {
+			queuedExecutions = append(queuedExecutions, queuedExecution)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedExecutionsResponse{
+		QueuedExecutions: queuedExecutions,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// getManagerSplittersFromIndex pages through an address index and resolves the manager splitters it points to
+func (k Keeper) getManagerSplittersFromIndex(ctx sdk.Context, indexKey []byte, addr string, pageReq *query.PageRequest) ([]*types.ManagerSplitter, *query.PageResponse, error) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, addressIndexPrefix(indexKey, addr))
+
+	managerSplitters := []*types.ManagerSplitter{}
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, value []byte) error {
+		managerSplitter, found := k.GetManagerSplitterFromStore(ctx, string(key))
+		if found {
+			managerSplitters = append(managerSplitters, managerSplitter)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return managerSplitters, pageRes, nil
+}
+
+func (k Keeper) ManagerSplittersByAdmin(c context.Context, req *types.QueryManagerSplittersByAdminRequest) (*types.QueryManagerSplittersByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	managerSplitters, pageRes, err := k.getManagerSplittersFromIndex(ctx, ManagerSplitterByAdminKey, req.Admin, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryManagerSplittersByAdminResponse{
+		ManagerSplitters: managerSplitters,
+		Pagination:       pageRes,
+	}, nil
+}
+
+func (k Keeper) ManagerSplittersByExecutor(c context.Context, req *types.QueryManagerSplittersByExecutorRequest) (*types.QueryManagerSplittersByExecutorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	managerSplitters, pageRes, err := k.getManagerSplittersFromIndex(ctx, ManagerSplitterByExecutorKey, req.Executor, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryManagerSplittersByExecutorResponse{
+		ManagerSplitters: managerSplitters,
+		Pagination:       pageRes,
+	}, nil
+}
+
+func (k Keeper) ManagerSplitterByCollection(c context.Context, req *types.QueryManagerSplitterByCollectionRequest) (*types.QueryManagerSplitterByCollectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	collectionId, err := sdkmath.ParseUint(req.CollectionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid collection ID")
+	}
+
+	collection, found := k.tokenizationKeeper.GetCollectionFromStore(ctx, collectionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "collection not found")
+	}
+
+	// A splitter controls a collection by being its manager
+	managerSplitter, found := k.GetManagerSplitterFromStore(ctx, collection.Manager)
+	if !found {
+		return nil, status.Error(codes.NotFound, "collection is not managed by a manager splitter")
+	}
+
+	return &types.QueryManagerSplitterByCollectionResponse{ManagerSplitter: managerSplitter}, nil
+}
+
+func (k Keeper) ExecutionLogs(c context.Context, req *types.QueryExecutionLogsRequest) (*types.QueryExecutionLogsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	logStore := prefix.NewStore(storeAdapter, addressIndexPrefix(ExecutionLogKey, req.ManagerSplitterAddress))
+
+	executionLogs := []*types.ExecutionLogEntry{}
+	pageRes, err := query.Paginate(logStore, req.Pagination, func(key []byte, value []byte) error {
+		var executionLog types.ExecutionLogEntry
+		if err := k.cdc.Unmarshal(value, &executionLog); err != nil {
+			return err
+		}
+		executionLogs = append(executionLogs, &executionLog)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutionLogsResponse{
+		ExecutionLogs: executionLogs,
+		Pagination:    pageRes,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *TestSuite) TestQuery_ManagerSplittersByAdminAndExecutor() {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanUpdateCustomData.ApprovedAddresses = []string{alice}
+	perms.CanUpdateStandards.ApprovedAddresses = []string{alice, charlie}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	_, err = CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       charlie,
+		Permissions: GetDefaultPermissions(),
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	byAdmin, err := suite.queryClient.ManagerSplittersByAdmin(wctx, &types.QueryManagerSplittersByAdminRequest{Admin: bob})
+	suite.Require().Nil(err)
+	suite.Require().Len(byAdmin.ManagerSplitters, 1)
+	suite.Require().Equal(res.Address, byAdmin.ManagerSplitters[0].Address)
+
+	byExecutor, err := suite.queryClient.ManagerSplittersByExecutor(wctx, &types.QueryManagerSplittersByExecutorRequest{Executor: charlie})
+	suite.Require().Nil(err)
+	suite.Require().Len(byExecutor.ManagerSplitters, 1)
+	suite.Require().Equal(res.Address, byExecutor.ManagerSplitters[0].Address)
+
+	// Revoking charlie removes the splitter from the executor lookup
+	perms.CanUpdateStandards.ApprovedAddresses = []string{alice}
+	err = UpdateManagerSplitter(suite, wctx, &types.MsgUpdateManagerSplitter{
+		Admin:       bob,
+		Address:     res.Address,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err)
+
+	byExecutor, err = suite.queryClient.ManagerSplittersByExecutor(wctx, &types.QueryManagerSplittersByExecutorRequest{Executor: charlie})
+	suite.Require().Nil(err)
+	suite.Require().Empty(byExecutor.ManagerSplitters)
+
+	// Deleting the splitter removes it from the admin lookup
+	err = DeleteManagerSplitter(suite, wctx, &types.MsgDeleteManagerSplitter{
+		Admin:   bob,
+		Address: res.Address,
+	})
+	suite.Require().Nil(err)
+
+	byAdmin, err = suite.queryClient.ManagerSplittersByAdmin(wctx, &types.QueryManagerSplittersByAdminRequest{Admin: bob})
+	suite.Require().Nil(err)
+	suite.Require().Empty(byAdmin.ManagerSplitters)
+}
+
+func (suite *TestSuite) TestQuery_ManagerSplitterByCollectionAndExecutionLogs() {
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	perms := GetDefaultPermissions()
+	perms.CanUpdateCustomData.ApprovedAddresses = []string{alice}
+
+	res, err := CreateManagerSplitter(suite, wctx, &types.MsgCreateManagerSplitter{
+		Admin:       bob,
+		Permissions: perms,
+	})
+	suite.Require().Nil(err, "Error creating manager splitter")
+
+	execRes, err := ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               bob,
+		ManagerSplitterAddress: res.Address,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:         bob,
+			CollectionId:    sdkmath.NewUint(0),
+			UpdateStandards: true,
+			Standards:       []string{"test"},
+		},
+	})
+	suite.Require().Nil(err, "Error creating collection through manager splitter")
+
+	_, err = ExecuteUniversalUpdateCollection(suite, wctx, &types.MsgExecuteUniversalUpdateCollection{
+		Executor:               alice,
+		ManagerSplitterAddress: res.Address,
+		UniversalUpdateCollectionMsg: &tokenizationtypes.MsgUniversalUpdateCollection{
+			Creator:          alice,
+			CollectionId:     execRes.CollectionId,
+			UpdateCustomData: true,
+			CustomData:       "updated",
+		},
+	})
+	suite.Require().Nil(err, "Approved executor should be able to update custom data")
+
+	byCollection, err := suite.queryClient.ManagerSplitterByCollection(wctx, &types.QueryManagerSplitterByCollectionRequest{CollectionId: execRes.CollectionId.String()})
+	suite.Require().Nil(err)
+	suite.Require().Equal(res.Address, byCollection.ManagerSplitter.Address)
+
+	logs, err := suite.queryClient.ExecutionLogs(wctx, &types.QueryExecutionLogsRequest{ManagerSplitterAddress: res.Address})
+	suite.Require().Nil(err)
+	suite.Require().Len(logs.ExecutionLogs, 2)
+	suite.Require().Equal(bob, logs.ExecutionLogs[0].Executor)
+	suite.Require().Equal(alice, logs.ExecutionLogs[1].Executor)
+	suite.Require().Equal([]string{"canUpdateCustomData"}, logs.ExecutionLogs[1].PermissionsExercised)
+	suite.Require().Equal(execRes.CollectionId, logs.ExecutionLogs[1].CollectionId)
+	suite.Require().Equal(suite.ctx.BlockHeight(), logs.ExecutionLogs[1].BlockHeight)
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	"github.com/bitbadges/bitbadgeschain/x/managersplitter/types"
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkScopedPermissions checks the token ID and approval ID scopes of the permissions used by the message.
+// Scopes are enforced against the diff between the current collection state and the submitted update.
+func (k Keeper) checkScopedPermissions(ctx sdk.Context, executor string, managerSplitter *types.ManagerSplitter, msg *tokenizationtypes.MsgUniversalUpdateCollection) error {
+	// Admin is never scoped
+	if executor == managerSplitter.Admin {
+		return nil
+	}
+
+	tokenMetadataCriteria := getPermissionCriteria(managerSplitter, "canUpdateTokenMetadata")
+	approvalsCriteria := getPermissionCriteria(managerSplitter, "canUpdateCollectionApprovals")
+
+	checkTokenIds := msg.UpdateTokenMetadata && tokenMetadataCriteria != nil && len(tokenMetadataCriteria.TokenIds) > 0
+	checkApprovalIds := msg.UpdateCollectionApprovals && approvalsCriteria != nil &&
+		(len(approvalsCriteria.ApprovalIds) > 0 || len(approvalsCriteria.ApprovalIdPrefixes) > 0)
+	if !checkTokenIds && !checkApprovalIds {
+		return nil
+	}
+
+	// New collections (ID 0) have no previous state
+	previousCollection := &tokenizationtypes.TokenCollection{}
+	if !msg.CollectionId.IsZero() {
+		collection, found := k.tokenizationKeeper.GetCollectionFromStore(ctx, msg.CollectionId)
+		if found {
+			previousCollection = collection
+		}
+	}
+
+	if checkTokenIds {
+		changedTokenIds := k.getChangedTokenMetadataIds(previousCollection.TokenMetadata, msg.TokenMetadata)
+		outOfScope, _ := tokenizationtypes.RemoveUintRangesFromUintRanges(tokenMetadataCriteria.TokenIds, changedTokenIds)
+		if len(outOfScope) > 0 {
+			return sdkerrors.Wrapf(types.ErrPermissionDenied, "executor not approved to update token metadata for token IDs %s-%s (canUpdateTokenMetadata)", outOfScope[0].Start, outOfScope[0].End)
+		}
+	}
+
+	if checkApprovalIds {
+		// Approvals are stored with their defaults filled in, so normalize a copy of the update the same way before diffing
+		updatedApprovals := make([]*tokenizationtypes.CollectionApproval, 0, len(msg.CollectionApprovals))
+		for _, approval := range msg.CollectionApprovals {
+			approvalCopy := &tokenizationtypes.CollectionApproval{}
+			k.cdc.MustUnmarshal(k.cdc.MustMarshal(approval), approvalCopy)
+			updatedApprovals = append(updatedApprovals, approvalCopy)
+		}
+		if err := tokenizationtypes.ValidateCollectionApprovals(ctx, updatedApprovals, true); err != nil {
+			return err
+		}
+
+		for _, approvalId := range k.getChangedCollectionApprovalIds(previousCollection.CollectionApprovals, updatedApprovals) {
+			if !isApprovalIdInScope(approvalId, approvalsCriteria) {
+				return sdkerrors.Wrapf(types.ErrPermissionDenied, "executor not approved to update collection approval %s (canUpdateCollectionApprovals)", approvalId)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isApprovalIdInScope checks if an approval ID matches the approval ID filters of the criteria
+func isApprovalIdInScope(approvalId string, criteria *types.PermissionCriteria) bool {
+	if slices.Contains(criteria.ApprovalIds, approvalId) {
+		return true
+	}
+
+	for _, prefix := range criteria.ApprovalIdPrefixes {
+		if strings.HasPrefix(approvalId, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getChangedTokenMetadataIds returns the token IDs covered by token metadata entries that were added or removed.
+// Entries are compared as a whole, so reorganizing entries counts as changing all of their token IDs.
+func (k Keeper) getChangedTokenMetadataIds(previous []*tokenizationtypes.TokenMetadata, updated []*tokenizationtypes.TokenMetadata) []*tokenizationtypes.UintRange {
+	previousBytes := make([][]byte, 0, len(previous))
+	for _, metadata := range previous {
+		previousBytes = append(previousBytes, k.cdc.MustMarshal(metadata))
+	}
+
+	updatedBytes := make([][]byte, 0, len(updated))
+	for _, metadata := range updated {
+		updatedBytes = append(updatedBytes, k.cdc.MustMarshal(metadata))
+	}
+
+	changedTokenIds := []*tokenizationtypes.UintRange{}
+	for i, metadata := range previous {
+		if !containsBytes(updatedBytes, previousBytes[i]) {
+			changedTokenIds = append(changedTokenIds, tokenizationtypes.DeepCopyRanges(metadata.TokenIds)...)
+		}
+	}
+	for i, metadata := range updated {
+		if !containsBytes(previousBytes, updatedBytes[i]) {
+			changedTokenIds = append(changedTokenIds, tokenizationtypes.DeepCopyRanges(metadata.TokenIds)...)
+		}
+	}
+
+	return changedTokenIds
+}
+
+// getChangedCollectionApprovalIds returns the IDs of collection approvals that were added, edited, or removed.
+// The approval version is ignored since it is managed by the tokenization module.
+func (k Keeper) getChangedCollectionApprovalIds(previous []*tokenizationtypes.CollectionApproval, updated []*tokenizationtypes.CollectionApproval) []string {
+	previousById := make(map[string][]byte, len(previous))
+	for _, approval := range previous {
+		previousById[approval.ApprovalId] = k.marshalApprovalWithoutVersion(approval)
+	}
+
+	changedApprovalIds := []string{}
+	updatedIds := make(map[string]bool, len(updated))
+	for _, approval := range updated {
+		updatedIds[approval.ApprovalId] = true
+		previousBytes, exists := previousById[approval.ApprovalId]
+		if !exists || !bytes.Equal(previousBytes, k.marshalApprovalWithoutVersion(approval)) {
+			changedApprovalIds = append(changedApprovalIds, approval.ApprovalId)
+		}
+	}
+
+	for _, approval := range previous {
+		if !updatedIds[approval.ApprovalId] {
+			changedApprovalIds = append(changedApprovalIds, approval.ApprovalId)
+		}
+	}
+
+	return changedApprovalIds
+}
+
+// marshalApprovalWithoutVersion marshals a copy of the approval with the version field cleared
+func (k Keeper) marshalApprovalWithoutVersion(approval *tokenizationtypes.CollectionApproval) []byte {
+	approvalCopy := *approval
+	approvalCopy.Version = sdkmath.ZeroUint()
+	return k.cdc.MustMarshal(&approvalCopy)
+}
+
+// containsBytes checks if a byte slice is in the list
+func containsBytes(list [][]byte, target []byte) bool {
+	for _, item := range list {
+		if bytes.Equal(item, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -19,12 +19,32 @@ func (k Keeper) SetManagerSplitterInStore(ctx sdk.Context, ms *types.ManagerSpli
 	if err != nil {
 		return sdkerrors.Wrap(err, "Marshal types.ManagerSplitter failed")
 	}
+	// Clear index entries of the previous version before writing the new ones
+	if previous, found := k.GetManagerSplitterFromStore(ctx, ms.Address); found {
+		k.deleteManagerSplitterIndexes(ctx, previous)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, []byte{})
 	store.Set(managerSplitterStoreKey(ms.Address), marshaled)
+
+	store.Set(addressIndexStoreKey(ManagerSplitterByAdminKey, ms.Admin, ms.Address), []byte{0x01})
+	for _, executor := range getApprovedExecutors(ms) {
+		store.Set(addressIndexStoreKey(ManagerSplitterByExecutorKey, executor, ms.Address), []byte{0x01})
+	}
 	return nil
 }
 
+// deleteManagerSplitterIndexes deletes the admin and approved executor index entries of a manager splitter
+func (k Keeper) deleteManagerSplitterIndexes(ctx sdk.Context, ms *types.ManagerSplitter) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Delete(addressIndexStoreKey(ManagerSplitterByAdminKey, ms.Admin, ms.Address))
+	for _, executor := range getApprovedExecutors(ms) {
+		store.Delete(addressIndexStoreKey(ManagerSplitterByExecutorKey, executor, ms.Address))
+	}
+}
+
 // GetManagerSplitterFromStore gets a manager splitter from the store by address
 func (k Keeper) GetManagerSplitterFromStore(ctx sdk.Context, addr string) (*types.ManagerSplitter, bool) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -55,6 +75,10 @@ func (k Keeper) GetAllManagerSplittersFromStore(ctx sdk.Context) (managerSplitte
 
 // DeleteManagerSplitterFromStore deletes a manager splitter from the store
 func (k Keeper) DeleteManagerSplitterFromStore(ctx sdk.Context, addr string) {
+	if ms, found := k.GetManagerSplitterFromStore(ctx, addr); found {
+		k.deleteManagerSplitterIndexes(ctx, ms)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, []byte{})
 	store.Delete(managerSplitterStoreKey(addr))
@@ -88,3 +112,135 @@ func (k Keeper) SetNextManagerSplitterId(ctx sdk.Context, id sdkmath.Uint) {
 	binary.BigEndian.PutUint64(bz, idUint64)
 	store.Set(ManagerSplitterCountKey, bz)
 }
+
+// SetQueuedExecutionInStore sets a queued execution in the store
+func (k Keeper) SetQueuedExecutionInStore(ctx sdk.Context, queuedExecution *types.QueuedExecution) error {
+	marshaled, err := k.cdc.Marshal(queuedExecution)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal types.QueuedExecution failed")
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Set(queuedExecutionStoreKey(queuedExecution.Id), marshaled)
+	store.Set(queuedExecutionIndexStoreKey(queuedExecution.ManagerSplitterAddress, queuedExecution.Id), []byte{0x01})
+	return nil
+}
+
+// GetQueuedExecutionFromStore gets a queued execution from the store by ID
+func (k Keeper) GetQueuedExecutionFromStore(ctx sdk.Context, id sdkmath.Uint) (*types.QueuedExecution, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	marshaled := store.Get(queuedExecutionStoreKey(id))
+
+	var queuedExecution types.QueuedExecution
+	if len(marshaled) == 0 {
+		return &queuedExecution, false
+	}
+	k.cdc.MustUnmarshal(marshaled, &queuedExecution)
+	return &queuedExecution, true
+}
+
+// GetAllQueuedExecutionsFromStore gets all queued executions from the store
+func (k Keeper) GetAllQueuedExecutionsFromStore(ctx sdk.Context) (queuedExecutions []*types.QueuedExecution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	iterator := storetypes.KVStorePrefixIterator(store, QueuedExecutionKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedExecution types.QueuedExecution
+		k.cdc.MustUnmarshal(iterator.Value(), &queuedExecution)
+		queuedExecutions = append(queuedExecutions, &queuedExecution)
+	}
+	return
+}
+
+// GetQueuedExecutionsForManagerSplitter gets all queued executions for a manager splitter
+func (k Keeper) GetQueuedExecutionsForManagerSplitter(ctx sdk.Context, managerSplitterAddress string) (queuedExecutions []*types.QueuedExecution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	iterator := storetypes.KVStorePrefixIterator(store, addressIndexPrefix(QueuedExecutionByManagerSplitterKey, managerSplitterAddress))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdkmath.NewUint(binary.BigEndian.Uint64(iterator.Key()[len(iterator.Key())-8:]))
+		if queuedExecution, found := k.GetQueuedExecutionFromStore(ctx, id); found {
+			queuedExecutions = append(queuedExecutions, queuedExecution)
+		}
+	}
+	return
+}
+
+// DeleteQueuedExecutionFromStore deletes a queued execution from the store
+func (k Keeper) DeleteQueuedExecutionFromStore(ctx sdk.Context, id sdkmath.Uint) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	if queuedExecution, found := k.GetQueuedExecutionFromStore(ctx, id); found {
+		store.Delete(queuedExecutionIndexStoreKey(queuedExecution.ManagerSplitterAddress, id))
+	}
+	store.Delete(queuedExecutionStoreKey(id))
+}
+
+// GetNextQueuedExecutionId gets the next queued execution ID
+func (k Keeper) GetNextQueuedExecutionId(ctx sdk.Context) sdkmath.Uint {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	bz := store.Get(QueuedExecutionCountKey)
+	if len(bz) < 8 {
+		return sdkmath.NewUint(1)
+	}
+	return sdkmath.NewUint(binary.BigEndian.Uint64(bz))
+}
+
+// SetNextQueuedExecutionId sets the next queued execution ID
+func (k Keeper) SetNextQueuedExecutionId(ctx sdk.Context, id sdkmath.Uint) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id.Uint64())
+	store.Set(QueuedExecutionCountKey, bz)
+}
+
+// SetExecutionLogInStore sets an execution log entry in the store
+func (k Keeper) SetExecutionLogInStore(ctx sdk.Context, executionLog *types.ExecutionLogEntry) error {
+	marshaled, err := k.cdc.Marshal(executionLog)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal types.ExecutionLogEntry failed")
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Set(executionLogStoreKey(executionLog.ManagerSplitterAddress, executionLog.Id), marshaled)
+	return nil
+}
+
+// GetAllExecutionLogsFromStore gets all execution log entries from the store
+func (k Keeper) GetAllExecutionLogsFromStore(ctx sdk.Context) (executionLogs []*types.ExecutionLogEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	iterator := storetypes.KVStorePrefixIterator(store, ExecutionLogKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var executionLog types.ExecutionLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &executionLog)
+		executionLogs = append(executionLogs, &executionLog)
+	}
+	return
+}
+
+// GetNextExecutionLogId gets the next execution log ID
+func (k Keeper) GetNextExecutionLogId(ctx sdk.Context) sdkmath.Uint {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	bz := store.Get(ExecutionLogCountKey)
+	if len(bz) < 8 {
+		return sdkmath.NewUint(1)
+	}
+	return sdkmath.NewUint(binary.BigEndian.Uint64(bz))
+}
+
+// SetNextExecutionLogId sets the next execution log ID
+func (k Keeper) SetNextExecutionLogId(ctx sdk.Context, id sdkmath.Uint) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id.Uint64())
+	store.Set(ExecutionLogCountKey, bz)
+}
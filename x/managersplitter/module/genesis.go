@@ -25,6 +25,32 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+
+	// Set next queued execution ID if defined; default to 1
+	if genState.NextQueuedExecutionId.IsNil() || genState.NextQueuedExecutionId.IsZero() {
+		genState.NextQueuedExecutionId = sdkmath.NewUint(1)
+	}
+	k.SetNextQueuedExecutionId(ctx, genState.NextQueuedExecutionId)
+
+	// Initialize queued executions
+	for _, queuedExecution := range genState.QueuedExecutions {
+		if err := k.SetQueuedExecutionInStore(ctx, queuedExecution); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set next execution log ID if defined; default to 1
+	if genState.NextExecutionLogId.IsNil() || genState.NextExecutionLogId.IsZero() {
+		genState.NextExecutionLogId = sdkmath.NewUint(1)
+	}
+	k.SetNextExecutionLogId(ctx, genState.NextExecutionLogId)
+
+	// Initialize execution logs
+	for _, executionLog := range genState.ExecutionLogs {
+		if err := k.SetExecutionLogInStore(ctx, executionLog); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -33,6 +59,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.NextManagerSplitterId = k.GetNextManagerSplitterId(ctx)
 	genesis.ManagerSplitters = k.GetAllManagerSplittersFromStore(ctx)
+	genesis.NextQueuedExecutionId = k.GetNextQueuedExecutionId(ctx)
+	genesis.QueuedExecutions = k.GetAllQueuedExecutionsFromStore(ctx)
+	genesis.NextExecutionLogId = k.GetNextExecutionLogId(ctx)
+	genesis.ExecutionLogs = k.GetAllExecutionLogsFromStore(ctx)
 	return genesis
 }
 
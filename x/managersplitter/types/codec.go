@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateManagerSplitter{}, "managersplitter/UpdateManagerSplitter", nil)
 	cdc.RegisterConcrete(&MsgDeleteManagerSplitter{}, "managersplitter/DeleteManagerSplitter", nil)
 	cdc.RegisterConcrete(&MsgExecuteUniversalUpdateCollection{}, "managersplitter/ExecuteUniversalUpdateCollection", nil)
+	cdc.RegisterConcrete(&MsgExecuteQueuedExecution{}, "managersplitter/ExecuteQueuedExecution", nil)
+	cdc.RegisterConcrete(&MsgCancelQueuedExecution{}, "managersplitter/CancelQueuedExecution", nil)
+	cdc.RegisterConcrete(&MsgExecute{}, "managersplitter/Execute", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -27,6 +30,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgExecuteUniversalUpdateCollection{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgExecuteQueuedExecution{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelQueuedExecution{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgExecute{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -17,5 +17,8 @@ var (
 	ErrPermissionDenied           = sdkerrors.Register(ModuleName, 1106, "permission denied: address not approved for this action")
 	ErrInvalidAdmin               = sdkerrors.Register(ModuleName, 1107, "invalid admin address")
 	ErrManagerSplitterExists      = sdkerrors.Register(ModuleName, 1108, "manager splitter already exists")
+	ErrQueuedExecutionNotFound    = sdkerrors.Register(ModuleName, 1109, "queued execution not found")
+	ErrTimelockNotExpired         = sdkerrors.Register(ModuleName, 1110, "timelock has not expired")
+	ErrStaleQueuedExecution       = sdkerrors.Register(ModuleName, 1111, "manager splitter permissions changed since the execution was queued")
 )
 
@@ -10,6 +10,10 @@ func DefaultGenesis() *GenesisState {
 		Params:                DefaultParams(),
 		ManagerSplitters:      []*ManagerSplitter{},
 		NextManagerSplitterId: sdkmath.NewUint(1),
+		QueuedExecutions:      []*QueuedExecution{},
+		NextQueuedExecutionId: sdkmath.NewUint(1),
+		ExecutionLogs:         []*ExecutionLogEntry{},
+		NextExecutionLogId:    sdkmath.NewUint(1),
 	}
 }
 
@@ -30,6 +34,45 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate all queued executions
+	for _, queuedExecution := range gs.QueuedExecutions {
+		if queuedExecution.Id.IsNil() || queuedExecution.Id.IsZero() {
+			return ErrInvalidRequest
+		}
+		if !gs.NextQueuedExecutionId.IsNil() && queuedExecution.Id.GTE(gs.NextQueuedExecutionId) {
+			return ErrInvalidRequest
+		}
+		if queuedExecution.ManagerSplitterAddress == "" || queuedExecution.Executor == "" {
+			return ErrInvalidAddress
+		}
+		numSet := 0
+		if queuedExecution.UniversalUpdateCollectionMsg != nil {
+			numSet++
+		}
+		if len(queuedExecution.Msgs) > 0 {
+			numSet++
+		}
+		if queuedExecution.Permissions != nil {
+			numSet++
+		}
+		if numSet != 1 {
+			return ErrInvalidRequest
+		}
+	}
+
+	// Validate all execution logs
+	for _, executionLog := range gs.ExecutionLogs {
+		if executionLog.Id.IsNil() || executionLog.Id.IsZero() {
+			return ErrInvalidRequest
+		}
+		if !gs.NextExecutionLogId.IsNil() && executionLog.Id.GTE(gs.NextExecutionLogId) {
+			return ErrInvalidRequest
+		}
+		if executionLog.ManagerSplitterAddress == "" || executionLog.Executor == "" {
+			return ErrInvalidAddress
+		}
+	}
+
 	return nil
 }
 
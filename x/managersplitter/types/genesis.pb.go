@@ -25,9 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the managersplitter module's genesis state.
 type GenesisState struct {
-	Params                Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ManagerSplitters      []*ManagerSplitter `protobuf:"bytes,2,rep,name=managerSplitters,proto3" json:"managerSplitters,omitempty"`
-	NextManagerSplitterId Uint               `protobuf:"bytes,3,opt,name=nextManagerSplitterId,proto3,customtype=Uint" json:"nextManagerSplitterId"`
+	Params                Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ManagerSplitters      []*ManagerSplitter   `protobuf:"bytes,2,rep,name=managerSplitters,proto3" json:"managerSplitters,omitempty"`
+	NextManagerSplitterId Uint                 `protobuf:"bytes,3,opt,name=nextManagerSplitterId,proto3,customtype=Uint" json:"nextManagerSplitterId"`
+	QueuedExecutions      []*QueuedExecution   `protobuf:"bytes,4,rep,name=queuedExecutions,proto3" json:"queuedExecutions,omitempty"`
+	NextQueuedExecutionId Uint                 `protobuf:"bytes,5,opt,name=nextQueuedExecutionId,proto3,customtype=Uint" json:"nextQueuedExecutionId"`
+	ExecutionLogs         []*ExecutionLogEntry `protobuf:"bytes,6,rep,name=executionLogs,proto3" json:"executionLogs,omitempty"`
+	NextExecutionLogId    Uint                 `protobuf:"bytes,7,opt,name=nextExecutionLogId,proto3,customtype=Uint" json:"nextExecutionLogId"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +81,20 @@ func (m *GenesisState) GetManagerSplitters() []*ManagerSplitter {
 	return nil
 }
 
+func (m *GenesisState) GetQueuedExecutions() []*QueuedExecution {
+	if m != nil {
+		return m.QueuedExecutions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionLogs() []*ExecutionLogEntry {
+	if m != nil {
+		return m.ExecutionLogs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "managersplitter.GenesisState")
 }
@@ -84,24 +102,29 @@ func init() {
 func init() { proto.RegisterFile("managersplitter/genesis.proto", fileDescriptor_19166fd962c7dc8c) }
 
 var fileDescriptor_19166fd962c7dc8c = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0x2d, 0x2a, 0x2e, 0xc8, 0xc9, 0x2c, 0x29, 0x49, 0x2d, 0xd2, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x47, 0x93, 0x96, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xe9, 0x83, 0x58, 0x10, 0x65, 0x52, 0x32, 0xe8, 0xa6, 0x14, 0x24,
-	0x16, 0x25, 0xe6, 0x42, 0x0d, 0x91, 0x92, 0x40, 0x97, 0x2d, 0xa9, 0x80, 0xc8, 0x28, 0x5d, 0x67,
-	0xe4, 0xe2, 0x71, 0x87, 0x58, 0x18, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xca, 0xc5, 0x06, 0xd1,
-	0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xae, 0x87, 0xa6, 0x57, 0x2f, 0x00, 0x2c, 0xed,
-	0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xb1, 0x90, 0x0f, 0x97, 0x00, 0x54, 0x5d, 0x30,
-	0x54, 0x5d, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x02, 0x86, 0x01, 0xbe, 0xa8, 0x0a,
-	0x83, 0x30, 0x74, 0x0a, 0x39, 0x71, 0x89, 0xe6, 0xa5, 0x56, 0x94, 0xa0, 0x29, 0xf4, 0x4c, 0x91,
-	0x60, 0x56, 0x60, 0xd4, 0xe0, 0x74, 0xe2, 0x01, 0x59, 0x7d, 0xeb, 0x9e, 0x3c, 0x4b, 0x68, 0x66,
-	0x5e, 0x49, 0x10, 0x76, 0xa5, 0x4e, 0xa1, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x9d, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x94, 0x59,
-	0x92, 0x94, 0x98, 0x92, 0x9e, 0x5a, 0x8c, 0x60, 0x25, 0x67, 0x24, 0x66, 0xe6, 0xe9, 0x57, 0xe8,
-	0x63, 0x84, 0x59, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xdc, 0x8c, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xf9, 0x5b, 0x29, 0x05, 0xb7, 0x01, 0x00, 0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x93, 0xaf, 0xfd, 0x2a, 0x4e, 0x2b, 0xca, 0xa0, 0x38, 0x14, 0x4d, 0x43, 0x57, 0x5d,
+	0x25, 0x50, 0x71, 0xa5, 0xab, 0x40, 0xd1, 0x42, 0x05, 0x4d, 0xe9, 0xc6, 0xdd, 0xa4, 0x19, 0xa6,
+	0x03, 0x76, 0x26, 0x66, 0x26, 0x90, 0xde, 0x85, 0x97, 0xd5, 0x65, 0x97, 0xe2, 0xa2, 0x48, 0x7b,
+	0x05, 0xde, 0x81, 0xe4, 0xa7, 0xd8, 0x26, 0xed, 0x6e, 0x86, 0xf7, 0x79, 0xcf, 0x79, 0x16, 0x07,
+	0x5c, 0x4f, 0x31, 0xc7, 0x94, 0x84, 0x32, 0x78, 0x63, 0x4a, 0x91, 0xd0, 0xa6, 0x84, 0x13, 0xc9,
+	0xa4, 0x15, 0x84, 0x42, 0x09, 0x78, 0x5a, 0x88, 0x9b, 0xe7, 0x54, 0x50, 0x91, 0x66, 0x76, 0xf2,
+	0xca, 0xb0, 0xe6, 0x55, 0x71, 0x4a, 0x80, 0x43, 0x3c, 0xcd, 0x87, 0x34, 0x51, 0x31, 0x55, 0x71,
+	0x96, 0xb4, 0x7f, 0x2a, 0xa0, 0xf1, 0x90, 0x2d, 0x1c, 0x2a, 0xac, 0x08, 0xbc, 0x05, 0xb5, 0xac,
+	0x8a, 0x74, 0x53, 0xef, 0xd4, 0xbb, 0x97, 0x56, 0xa1, 0x6b, 0x3d, 0xa7, 0xb1, 0x53, 0x9d, 0x2f,
+	0x5b, 0x9a, 0x9b, 0xc3, 0x70, 0x00, 0xce, 0x72, 0x6e, 0x98, 0x73, 0x12, 0xfd, 0x33, 0x2b, 0x9d,
+	0x7a, 0xd7, 0x2c, 0x0d, 0x78, 0xda, 0x05, 0xdd, 0x52, 0x13, 0x3a, 0xe0, 0x82, 0x93, 0x58, 0x15,
+	0xc0, 0xbe, 0x8f, 0x2a, 0xa6, 0xde, 0x39, 0x76, 0x1a, 0xc9, 0xea, 0xaf, 0x65, 0xab, 0x3a, 0x62,
+	0x5c, 0xb9, 0xfb, 0xd1, 0xc4, 0xe8, 0x3d, 0x22, 0x11, 0xf1, 0x7b, 0x31, 0x19, 0x47, 0x8a, 0x09,
+	0x2e, 0x51, 0xf5, 0x80, 0xd1, 0xcb, 0x2e, 0xe8, 0x96, 0x9a, 0x1b, 0xa3, 0x02, 0xd8, 0xf7, 0xd1,
+	0xff, 0x43, 0x46, 0x25, 0x14, 0x3e, 0x82, 0x13, 0xb2, 0xf9, 0x0e, 0x04, 0x95, 0xa8, 0x96, 0xea,
+	0xb4, 0x4b, 0x3a, 0xbd, 0x2d, 0xaa, 0xc7, 0x55, 0x38, 0x73, 0x77, 0x8b, 0xf0, 0x1e, 0xc0, 0x64,
+	0xc5, 0x36, 0xd7, 0xf7, 0xd1, 0xd1, 0x1e, 0x95, 0x3d, 0x9c, 0x33, 0x9a, 0xaf, 0x0c, 0x7d, 0xb1,
+	0x32, 0xf4, 0xef, 0x95, 0xa1, 0x7f, 0xac, 0x0d, 0x6d, 0xb1, 0x36, 0xb4, 0xcf, 0xb5, 0xa1, 0xbd,
+	0xde, 0x51, 0xa6, 0x26, 0x91, 0x67, 0x8d, 0xc5, 0xd4, 0xf6, 0x98, 0xf2, 0xb0, 0x4f, 0x89, 0xfc,
+	0x7b, 0x8d, 0x27, 0x98, 0x71, 0x3b, 0xb6, 0x4b, 0xd7, 0x34, 0x0b, 0x88, 0xf4, 0x6a, 0xe9, 0x45,
+	0xdd, 0xfc, 0x0e, 0x00, 0x0b, 0x4d, 0x02, 0xc3, 0xd1, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +147,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.NextExecutionLogId.Size()
+		i -= size
+		if _, err := m.NextExecutionLogId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ExecutionLogs) > 0 {
+		for iNdEx := len(m.ExecutionLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.NextQueuedExecutionId.Size()
+		i -= size
+		if _, err := m.NextQueuedExecutionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.QueuedExecutions) > 0 {
+		for iNdEx := len(m.QueuedExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.NextManagerSplitterId.Size()
 		i -= size
@@ -188,6 +259,22 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.NextManagerSplitterId.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.QueuedExecutions) > 0 {
+		for _, e := range m.QueuedExecutions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.NextQueuedExecutionId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ExecutionLogs) > 0 {
+		for _, e := range m.ExecutionLogs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.NextExecutionLogId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -327,6 +414,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedExecutions = append(m.QueuedExecutions, &QueuedExecution{})
+			if err := m.QueuedExecutions[len(m.QueuedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextQueuedExecutionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextQueuedExecutionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionLogs = append(m.ExecutionLogs, &ExecutionLogEntry{})
+			if err := m.ExecutionLogs[len(m.ExecutionLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionLogId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextExecutionLogId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
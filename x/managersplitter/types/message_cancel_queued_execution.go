@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (msg *MsgCancelQueuedExecution) ValidateBasic() error {
+	// Validate admin address format
+	adminAddr, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAdmin, "invalid admin address (%s)", err)
+	}
+	if adminAddr.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAdmin, "admin address cannot be empty")
+	}
+
+	// Validate manager splitter address format
+	if msg.ManagerSplitterAddress == "" {
+		return sdkerrors.Wrap(ErrInvalidAddress, "manager splitter address cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ManagerSplitterAddress); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid manager splitter address format (%s)", err)
+	}
+
+	// Validate queued execution ID
+	if msg.QueuedExecutionId.IsNil() || msg.QueuedExecutionId.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidRequest, "queued execution ID cannot be zero")
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	tokenizationtypes "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -56,13 +58,71 @@ func validatePermissions(perms *ManagerSplitterPermissions) error {
 			return sdkerrors.Wrapf(ErrInvalidRequest, "permission criteria for %s cannot be nil", field.name)
 		}
 
-		// Validate approved addresses format if they exist
-		for i, addr := range field.criteria.ApprovedAddresses {
-			if addr == "" {
-				return sdkerrors.Wrapf(ErrInvalidAddress, "approved address at index %d for %s cannot be empty", i, field.name)
+		if err := validatePermissionCriteria(field.name, field.criteria); err != nil {
+			return err
+		}
+	}
+
+	// Permissions for MsgExecute are optional (nil means only the admin can execute)
+	optionalPermissionFields := []struct {
+		name     string
+		criteria *PermissionCriteria
+	}{
+		{"canTransferTokens", perms.CanTransferTokens},
+		{"canCreateAddressLists", perms.CanCreateAddressLists},
+		{"canCreateDynamicStore", perms.CanCreateDynamicStore},
+		{"canUpdateDynamicStore", perms.CanUpdateDynamicStore},
+		{"canDeleteDynamicStore", perms.CanDeleteDynamicStore},
+		{"canSetDynamicStoreValue", perms.CanSetDynamicStoreValue},
+	}
+
+	for _, field := range optionalPermissionFields {
+		if field.criteria == nil {
+			continue
+		}
+
+		if err := validatePermissionCriteria(field.name, field.criteria); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePermissionCriteria validates the approved addresses format and scopes of a single permission criteria
+func validatePermissionCriteria(name string, criteria *PermissionCriteria) error {
+	for i, addr := range criteria.ApprovedAddresses {
+		if addr == "" {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "approved address at index %d for %s cannot be empty", i, name)
+		}
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "invalid approved address at index %d for %s (%s)", i, name, err)
+		}
+	}
+
+	// Token ID scopes are only supported for token metadata updates
+	if len(criteria.TokenIds) > 0 {
+		if name != "canUpdateTokenMetadata" {
+			return sdkerrors.Wrapf(ErrInvalidRequest, "token ID scopes are not supported for %s", name)
+		}
+		if err := tokenizationtypes.ValidateRangesAreValid(criteria.TokenIds, false, false); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRequest, "invalid token ID scope for %s (%s)", name, err)
+		}
+	}
+
+	// Approval ID scopes are only supported for collection approval updates
+	if len(criteria.ApprovalIds) > 0 || len(criteria.ApprovalIdPrefixes) > 0 {
+		if name != "canUpdateCollectionApprovals" {
+			return sdkerrors.Wrapf(ErrInvalidRequest, "approval ID scopes are not supported for %s", name)
+		}
+		for i, approvalId := range criteria.ApprovalIds {
+			if approvalId == "" {
+				return sdkerrors.Wrapf(ErrInvalidRequest, "approval ID at index %d for %s cannot be empty", i, name)
 			}
-			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
-				return sdkerrors.Wrapf(ErrInvalidAddress, "invalid approved address at index %d for %s (%s)", i, field.name, err)
+		}
+		for i, prefix := range criteria.ApprovalIdPrefixes {
+			if prefix == "" {
+				return sdkerrors.Wrapf(ErrInvalidRequest, "approval ID prefix at index %d for %s cannot be empty", i, name)
 			}
 		}
 	}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (msg *MsgExecute) ValidateBasic() error {
+	// Validate executor address format
+	executorAddr, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid executor address (%s)", err)
+	}
+	if executorAddr.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAddress, "executor address cannot be empty")
+	}
+
+	// Validate manager splitter address format
+	if msg.ManagerSplitterAddress == "" {
+		return sdkerrors.Wrap(ErrInvalidAddress, "manager splitter address cannot be empty")
+	}
+	managerSplitterAddr, err := sdk.AccAddressFromBech32(msg.ManagerSplitterAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid manager splitter address format (%s)", err)
+	}
+	if managerSplitterAddr.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAddress, "manager splitter address cannot be empty")
+	}
+
+	// Validate that executor and manager splitter are different addresses
+	if msg.Executor == msg.ManagerSplitterAddress {
+		return sdkerrors.Wrap(ErrInvalidRequest, "executor and manager splitter address cannot be the same")
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRequest, "at least one message must be provided")
+	}
+
+	// Validate each nested message as if it were signed by the manager splitter
+	for i, executableMsg := range msg.Msgs {
+		if err := validateExecutableMsg(executableMsg, msg.ManagerSplitterAddress); err != nil {
+			return sdkerrors.Wrapf(err, "invalid message at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// validateExecutableMsg ensures exactly one whitelisted message is set and that it is valid
+// when executed with the manager splitter address as the creator.
+func validateExecutableMsg(executableMsg *ExecutableMsg, managerSplitterAddress string) error {
+	if executableMsg == nil {
+		return sdkerrors.Wrap(ErrInvalidRequest, "executable message cannot be nil")
+	}
+
+	numSet := 0
+	var err error
+	if executableMsg.TransferTokensMsg != nil {
+		numSet++
+		tokenizationMsg := *executableMsg.TransferTokensMsg
+		tokenizationMsg.Creator = managerSplitterAddress
+		err = tokenizationMsg.ValidateBasic()
+	}
+	if executableMsg.CreateAddressListsMsg != nil {
+		numSet++
+		tokenizationMsg := *executableMsg.CreateAddressListsMsg
+		tokenizationMsg.Creator = managerSplitterAddress
+		err = tokenizationMsg.ValidateBasic()
+	}
+	if executableMsg.CreateDynamicStoreMsg != nil {
+		numSet++
+		tokenizationMsg := *executableMsg.CreateDynamicStoreMsg
+		tokenizationMsg.Creator = managerSplitterAddress
+		err = tokenizationMsg.ValidateBasic()
+	}
+	if executableMsg.UpdateDynamicStoreMsg != nil {
+		numSet++
+		tokenizationMsg := *executableMsg.UpdateDynamicStoreMsg
+		tokenizationMsg.Creator = managerSplitterAddress
+		err = tokenizationMsg.ValidateBasic()
+	}
+	if executableMsg.DeleteDynamicStoreMsg != nil {
+		numSet++
+		tokenizationMsg := *executableMsg.DeleteDynamicStoreMsg
+		tokenizationMsg.Creator = managerSplitterAddress
+		err = tokenizationMsg.ValidateBasic()
+	}
+	if executableMsg.SetDynamicStoreValueMsg != nil {
+		numSet++
+		tokenizationMsg := *executableMsg.SetDynamicStoreValueMsg
+		tokenizationMsg.Creator = managerSplitterAddress
+		err = tokenizationMsg.ValidateBasic()
+	}
+
+	if numSet != 1 {
+		return sdkerrors.Wrap(ErrInvalidRequest, "executable message must set exactly one message")
+	}
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "%s", err)
+	}
+
+	return nil
+}
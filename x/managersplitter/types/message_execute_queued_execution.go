@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (msg *MsgExecuteQueuedExecution) ValidateBasic() error {
+	// Validate executor address format
+	executorAddr, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid executor address (%s)", err)
+	}
+	if executorAddr.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAddress, "executor address cannot be empty")
+	}
+
+	// Validate manager splitter address format
+	if msg.ManagerSplitterAddress == "" {
+		return sdkerrors.Wrap(ErrInvalidAddress, "manager splitter address cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ManagerSplitterAddress); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid manager splitter address format (%s)", err)
+	}
+
+	// Validate queued execution ID
+	if msg.QueuedExecutionId.IsNil() || msg.QueuedExecutionId.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidRequest, "queued execution ID cannot be zero")
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/bitbadges/bitbadgeschain/x/tokenization/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,10 +25,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // PermissionCriteria defines the criteria for executing a permission.
-// Currently supports approved addresses (whitelist).
+// Currently supports approved addresses (whitelist), an optional timelock, and optional
+// token ID / approval ID scopes.
 type PermissionCriteria struct {
 	// List of approved addresses that can execute this permission.
 	ApprovedAddresses []string `protobuf:"bytes,1,rep,name=approvedAddresses,proto3" json:"approvedAddresses,omitempty"`
+	// Delay (in milliseconds) before an execution exercising this permission can be applied.
+	// If non-zero, executions are queued and only become executable once the delay has passed.
+	// Applies to all executors, including the admin.
+	TimelockDuration Uint `protobuf:"bytes,2,opt,name=timelockDuration,proto3,customtype=Uint" json:"timelockDuration"`
+	// If non-empty, approved addresses may only change token metadata for these token IDs.
+	// Only applicable to canUpdateTokenMetadata. Does not apply to the admin.
+	TokenIds []*types.UintRange `protobuf:"bytes,3,rep,name=tokenIds,proto3" json:"tokenIds,omitempty"`
+	// If non-empty, approved addresses may only add, edit, or remove collection approvals with these IDs.
+	// Only applicable to canUpdateCollectionApprovals. Does not apply to the admin.
+	ApprovalIds []string `protobuf:"bytes,4,rep,name=approvalIds,proto3" json:"approvalIds,omitempty"`
+	// If non-empty, approved addresses may only add, edit, or remove collection approvals whose ID starts
+	// with one of these prefixes. Only applicable to canUpdateCollectionApprovals. Does not apply to the admin.
+	ApprovalIdPrefixes []string `protobuf:"bytes,5,rep,name=approvalIdPrefixes,proto3" json:"approvalIdPrefixes,omitempty"`
 }
 
 func (m *PermissionCriteria) Reset()         { *m = PermissionCriteria{} }
@@ -70,6 +85,27 @@ func (m *PermissionCriteria) GetApprovedAddresses() []string {
 	return nil
 }
 
+func (m *PermissionCriteria) GetTokenIds() []*types.UintRange {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+func (m *PermissionCriteria) GetApprovalIds() []string {
+	if m != nil {
+		return m.ApprovalIds
+	}
+	return nil
+}
+
+func (m *PermissionCriteria) GetApprovalIdPrefixes() []string {
+	if m != nil {
+		return m.ApprovalIdPrefixes
+	}
+	return nil
+}
+
 // ManagerSplitterPermissions mirrors the CollectionPermissions structure
 // but maps each permission to criteria for execution.
 type ManagerSplitterPermissions struct {
@@ -95,6 +131,18 @@ type ManagerSplitterPermissions struct {
 	CanAddMoreAliasPaths *PermissionCriteria `protobuf:"bytes,10,opt,name=canAddMoreAliasPaths,proto3" json:"canAddMoreAliasPaths,omitempty"`
 	// Permissions related to adding more cosmos coin wrapper paths to the collection.
 	CanAddMoreCosmosCoinWrapperPaths *PermissionCriteria `protobuf:"bytes,11,opt,name=canAddMoreCosmosCoinWrapperPaths,proto3" json:"canAddMoreCosmosCoinWrapperPaths,omitempty"`
+	// Permissions related to executing MsgTransferTokens as the manager splitter (e.g. minting).
+	CanTransferTokens *PermissionCriteria `protobuf:"bytes,12,opt,name=canTransferTokens,proto3" json:"canTransferTokens,omitempty"`
+	// Permissions related to executing MsgCreateAddressLists as the manager splitter.
+	CanCreateAddressLists *PermissionCriteria `protobuf:"bytes,13,opt,name=canCreateAddressLists,proto3" json:"canCreateAddressLists,omitempty"`
+	// Permissions related to executing MsgCreateDynamicStore as the manager splitter.
+	CanCreateDynamicStore *PermissionCriteria `protobuf:"bytes,14,opt,name=canCreateDynamicStore,proto3" json:"canCreateDynamicStore,omitempty"`
+	// Permissions related to executing MsgUpdateDynamicStore as the manager splitter.
+	CanUpdateDynamicStore *PermissionCriteria `protobuf:"bytes,15,opt,name=canUpdateDynamicStore,proto3" json:"canUpdateDynamicStore,omitempty"`
+	// Permissions related to executing MsgDeleteDynamicStore as the manager splitter.
+	CanDeleteDynamicStore *PermissionCriteria `protobuf:"bytes,16,opt,name=canDeleteDynamicStore,proto3" json:"canDeleteDynamicStore,omitempty"`
+	// Permissions related to executing MsgSetDynamicStoreValue as the manager splitter.
+	CanSetDynamicStoreValue *PermissionCriteria `protobuf:"bytes,17,opt,name=canSetDynamicStoreValue,proto3" json:"canSetDynamicStoreValue,omitempty"`
 }
 
 func (m *ManagerSplitterPermissions) Reset()         { *m = ManagerSplitterPermissions{} }
@@ -207,6 +255,48 @@ func (m *ManagerSplitterPermissions) GetCanAddMoreCosmosCoinWrapperPaths() *Perm
 	return nil
 }
 
+func (m *ManagerSplitterPermissions) GetCanTransferTokens() *PermissionCriteria {
+	if m != nil {
+		return m.CanTransferTokens
+	}
+	return nil
+}
+
+func (m *ManagerSplitterPermissions) GetCanCreateAddressLists() *PermissionCriteria {
+	if m != nil {
+		return m.CanCreateAddressLists
+	}
+	return nil
+}
+
+func (m *ManagerSplitterPermissions) GetCanCreateDynamicStore() *PermissionCriteria {
+	if m != nil {
+		return m.CanCreateDynamicStore
+	}
+	return nil
+}
+
+func (m *ManagerSplitterPermissions) GetCanUpdateDynamicStore() *PermissionCriteria {
+	if m != nil {
+		return m.CanUpdateDynamicStore
+	}
+	return nil
+}
+
+func (m *ManagerSplitterPermissions) GetCanDeleteDynamicStore() *PermissionCriteria {
+	if m != nil {
+		return m.CanDeleteDynamicStore
+	}
+	return nil
+}
+
+func (m *ManagerSplitterPermissions) GetCanSetDynamicStoreValue() *PermissionCriteria {
+	if m != nil {
+		return m.CanSetDynamicStoreValue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PermissionCriteria)(nil), "managersplitter.PermissionCriteria")
 	proto.RegisterType((*ManagerSplitterPermissions)(nil), "managersplitter.ManagerSplitterPermissions")
@@ -215,35 +305,48 @@ func init() {
 func init() { proto.RegisterFile("managersplitter/permissions.proto", fileDescriptor_216a29cba87bf9f4) }
 
 var fileDescriptor_216a29cba87bf9f4 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x0a, 0xa5, 0xdd, 0x1e, 0x80, 0xa5, 0x42, 0xab, 0x82, 0xac, 0x50, 0x2e, 0x3d,
-	0xa0, 0x58, 0x82, 0x23, 0xa7, 0xd4, 0xbd, 0x70, 0x88, 0xa8, 0x1a, 0x42, 0x25, 0x38, 0x4d, 0xbc,
-	0x23, 0x67, 0x85, 0xbd, 0xb3, 0xda, 0xd9, 0x56, 0xf0, 0x16, 0xbc, 0x00, 0xef, 0xc3, 0xb1, 0x47,
-	0x8e, 0x28, 0x79, 0x11, 0xd4, 0x24, 0x38, 0xd4, 0x31, 0xc5, 0xed, 0x6d, 0x35, 0xb3, 0xff, 0xf7,
-	0xff, 0x1e, 0xef, 0xae, 0x78, 0x5e, 0x82, 0x85, 0x1c, 0x3d, 0xbb, 0xc2, 0x84, 0x80, 0x3e, 0x71,
-	0xe8, 0x4b, 0xc3, 0x6c, 0xc8, 0x72, 0xcf, 0x79, 0x0a, 0x24, 0x1f, 0xd4, 0xb6, 0xec, 0xed, 0xe6,
-	0x94, 0xd3, 0xbc, 0x97, 0x5c, 0xae, 0x16, 0xdb, 0xf6, 0x0f, 0x85, 0x3c, 0xae, 0xb4, 0xa9, 0x37,
-	0x01, 0xbd, 0x01, 0xf9, 0x52, 0x3c, 0x02, 0xe7, 0x3c, 0x9d, 0xa3, 0xee, 0x6b, 0xed, 0x91, 0x19,
-	0x59, 0x45, 0xdd, 0x8d, 0x83, 0xed, 0x93, 0xf5, 0xc6, 0xfe, 0xf7, 0x2d, 0xb1, 0x37, 0x58, 0xb8,
-	0x0d, 0x97, 0x6e, 0x2b, 0x26, 0xcb, 0x91, 0x78, 0x9c, 0x81, 0x3d, 0xc2, 0x02, 0x03, 0xa6, 0x54,
-	0x14, 0x98, 0x05, 0x43, 0x56, 0x45, 0xdd, 0xe8, 0x60, 0xe7, 0xd5, 0x8b, 0x5e, 0x2d, 0x67, 0x6f,
-	0x3d, 0xce, 0x49, 0x93, 0x5e, 0x9e, 0x8a, 0xdd, 0x0c, 0x6c, 0xdf, 0x67, 0x13, 0x73, 0xfe, 0x37,
-	0xf7, 0x4e, 0x7b, 0x6e, 0x23, 0x40, 0x0e, 0x85, 0xcc, 0xc0, 0x8e, 0x9c, 0x86, 0x80, 0xc3, 0x00,
-	0x56, 0x83, 0xd7, 0xac, 0x36, 0xda, 0x63, 0x1b, 0xe4, 0xcb, 0x21, 0x2c, 0xaa, 0xe9, 0x19, 0x07,
-	0x2a, 0x8f, 0x20, 0x80, 0xba, 0x7b, 0xb3, 0x21, 0xd4, 0xf5, 0xf2, 0x9d, 0x78, 0x58, 0x95, 0x97,
-	0xbf, 0x40, 0xdd, 0x6b, 0xcf, 0x5c, 0x13, 0x4b, 0x14, 0x4f, 0x57, 0x3e, 0xd5, 0x4c, 0x06, 0x18,
-	0x40, 0x5f, 0xe6, 0xdd, 0x6c, 0xcf, 0xbe, 0x8e, 0x23, 0x3f, 0x89, 0x27, 0x55, 0xfb, 0x03, 0x14,
-	0x46, 0xbf, 0xa7, 0xcf, 0x68, 0xdf, 0x6a, 0x56, 0xf7, 0xdb, 0x3b, 0xfc, 0x03, 0x71, 0x05, 0x3e,
-	0x2f, 0x56, 0xf1, 0xb7, 0x6e, 0x03, 0xbf, 0x82, 0x90, 0xb9, 0x78, 0xd6, 0xf0, 0x61, 0xfd, 0xf9,
-	0xa5, 0x80, 0x82, 0xd5, 0x76, 0x7b, 0x8b, 0x6b, 0x41, 0x7f, 0xce, 0xb7, 0xd6, 0x03, 0xf2, 0xd8,
-	0x2f, 0x0c, 0xf0, 0x31, 0x84, 0x09, 0x2b, 0x71, 0xc3, 0xf3, 0x5d, 0x07, 0x48, 0x12, 0xdd, 0x55,
-	0x3d, 0x25, 0x2e, 0x89, 0x53, 0x32, 0xf6, 0xd4, 0x83, 0x73, 0xe8, 0x17, 0x26, 0x3b, 0xed, 0x4d,
-	0xfe, 0x0b, 0x3b, 0x1c, 0xfd, 0x98, 0xc6, 0xd1, 0xc5, 0x34, 0x8e, 0x7e, 0x4d, 0xe3, 0xe8, 0xdb,
-	0x2c, 0xee, 0x5c, 0xcc, 0xe2, 0xce, 0xcf, 0x59, 0xdc, 0xf9, 0xf8, 0x26, 0x37, 0x61, 0x72, 0x36,
-	0xee, 0x65, 0x54, 0x26, 0x63, 0x13, 0xc6, 0xa0, 0x73, 0xe4, 0xd5, 0x2a, 0x9b, 0x80, 0xb1, 0xc9,
-	0x97, 0xa4, 0xfe, 0xda, 0x85, 0xaf, 0x0e, 0x79, 0xbc, 0x39, 0x7f, 0xc1, 0x5e, 0xff, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0xa2, 0xed, 0xd7, 0xe9, 0x0d, 0x05, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x14, 0xc7, 0x63, 0x6e, 0x1f, 0x4c, 0xf8, 0x0a, 0x4c, 0x69, 0xb1, 0xa0, 0x0a, 0x29, 0xdd, 0x64,
+	0x51, 0x39, 0x12, 0x6c, 0x2a, 0x75, 0x15, 0x92, 0x4d, 0xa5, 0xa2, 0xd2, 0x84, 0x80, 0xda, 0xaa,
+	0x8b, 0x93, 0xf1, 0xc1, 0x19, 0x61, 0xcf, 0x58, 0x33, 0x13, 0x04, 0xdd, 0xf6, 0x05, 0xfa, 0x3e,
+	0x7d, 0x01, 0x96, 0x2c, 0xab, 0x2e, 0x50, 0x05, 0x2f, 0x52, 0x61, 0x9b, 0xdc, 0x6c, 0xa8, 0xc3,
+	0xce, 0x39, 0x97, 0xdf, 0xff, 0xcc, 0x7f, 0x26, 0x33, 0xe4, 0x65, 0x00, 0x02, 0x3c, 0x54, 0x3a,
+	0xf4, 0xb9, 0x31, 0xa8, 0xaa, 0x21, 0xaa, 0x80, 0x6b, 0xcd, 0xa5, 0xd0, 0x4e, 0xa8, 0xa4, 0x91,
+	0x74, 0x69, 0xac, 0x64, 0x7d, 0xd5, 0x93, 0x9e, 0x8c, 0x72, 0xd5, 0xdb, 0xaf, 0xb8, 0x6c, 0x7d,
+	0xc3, 0xc8, 0x13, 0x14, 0xfc, 0x1b, 0x18, 0x2e, 0x45, 0xb5, 0x03, 0x3e, 0x08, 0x86, 0x09, 0x63,
+	0xeb, 0xfb, 0x14, 0xa1, 0xfb, 0x7d, 0x72, 0x5d, 0x71, 0x83, 0x8a, 0x03, 0x7d, 0x4d, 0x56, 0x20,
+	0x0c, 0x95, 0x3c, 0x45, 0xb7, 0xe6, 0xba, 0x0a, 0xb5, 0x46, 0x6d, 0x5b, 0xe5, 0xe9, 0xca, 0x42,
+	0x33, 0x9d, 0xa0, 0x6f, 0xc8, 0xb2, 0xe1, 0x01, 0xfa, 0x92, 0x9d, 0x34, 0x7a, 0x2a, 0xd2, 0xb1,
+	0xa7, 0xca, 0x56, 0x65, 0x61, 0x77, 0xf1, 0xe2, 0x6a, 0xb3, 0xf0, 0xfb, 0x6a, 0x73, 0xa6, 0xcd,
+	0x85, 0x69, 0xa6, 0xaa, 0xe8, 0x0e, 0x99, 0x8f, 0xa6, 0x7b, 0xe7, 0x6a, 0x7b, 0xba, 0x3c, 0x5d,
+	0x29, 0x6e, 0xaf, 0x39, 0xc3, 0xe3, 0x3a, 0x51, 0x1f, 0x08, 0x0f, 0x9b, 0xfd, 0x42, 0x5a, 0x26,
+	0xc5, 0x78, 0x06, 0xf0, 0x6f, 0xfb, 0x66, 0xa2, 0xb1, 0x86, 0x43, 0xd4, 0x21, 0x74, 0xf0, 0x73,
+	0x5f, 0xe1, 0x31, 0x3f, 0x43, 0x6d, 0xcf, 0x46, 0x85, 0x19, 0x99, 0xad, 0x9f, 0x8b, 0x64, 0x7d,
+	0x2f, 0x36, 0xb3, 0x95, 0x98, 0x39, 0x30, 0x45, 0xd3, 0x36, 0x79, 0xca, 0x40, 0x34, 0xd0, 0x47,
+	0x83, 0x75, 0xe9, 0xfb, 0xc8, 0xa2, 0x25, 0x5a, 0x65, 0xab, 0x52, 0xdc, 0x7e, 0xe5, 0x8c, 0x6d,
+	0x83, 0x93, 0xf6, 0xb3, 0x99, 0xd5, 0x4f, 0x8f, 0xc8, 0x2a, 0x03, 0x51, 0x53, 0xac, 0xcb, 0x4f,
+	0x87, 0xb9, 0x53, 0xf9, 0xb9, 0x99, 0x00, 0xda, 0x22, 0x94, 0x81, 0x68, 0x87, 0x2e, 0x18, 0x6c,
+	0x19, 0x10, 0x2e, 0xa8, 0xc8, 0xdf, 0xdc, 0xd8, 0x8c, 0xf6, 0xc4, 0x84, 0x38, 0x5a, 0xef, 0x69,
+	0x23, 0x83, 0x06, 0x18, 0xb0, 0x67, 0x26, 0x33, 0x61, 0xbc, 0x9f, 0x7e, 0x20, 0xcb, 0xfd, 0x70,
+	0xb2, 0x05, 0xf6, 0x6c, 0x7e, 0x66, 0xaa, 0x99, 0x22, 0xd9, 0x18, 0xe8, 0xf4, 0x3d, 0xd9, 0x43,
+	0x03, 0xee, 0xed, 0xbc, 0x73, 0xf9, 0xd9, 0x0f, 0x71, 0xe8, 0x17, 0xf2, 0xbc, 0x9f, 0x3e, 0x04,
+	0x9f, 0xbb, 0x07, 0x77, 0xe7, 0xf8, 0xbf, 0xfc, 0x0a, 0xf7, 0x20, 0x46, 0xe0, 0x51, 0xb0, 0x3f,
+	0xfe, 0xfc, 0x63, 0xe0, 0x23, 0x08, 0xea, 0x91, 0x17, 0x19, 0x0b, 0xab, 0x25, 0xff, 0x0a, 0x6d,
+	0x2f, 0xe4, 0x97, 0x78, 0x10, 0x74, 0x77, 0xbe, 0x5d, 0x77, 0x4f, 0x2a, 0xac, 0xf9, 0x1c, 0xf4,
+	0x3e, 0x98, 0xae, 0xb6, 0xc9, 0x84, 0xe7, 0x7b, 0x1c, 0x40, 0x25, 0x29, 0x0f, 0xe2, 0x75, 0xa9,
+	0x03, 0xa9, 0xeb, 0x92, 0x8b, 0x23, 0x05, 0x61, 0x88, 0x2a, 0x16, 0x29, 0xe6, 0x17, 0xf9, 0x27,
+	0x8c, 0x7e, 0x24, 0x2b, 0x0c, 0xc4, 0x81, 0x02, 0xa1, 0x8f, 0x51, 0x45, 0x76, 0x6a, 0x7b, 0x31,
+	0xbf, 0x42, 0xba, 0x9b, 0x7e, 0x22, 0xcf, 0x18, 0x88, 0xba, 0x42, 0x30, 0x98, 0xdc, 0xa4, 0xef,
+	0xb9, 0x36, 0xda, 0xfe, 0x3f, 0x3f, 0x36, 0x9b, 0x30, 0x82, 0x6e, 0x9c, 0x0b, 0x08, 0x38, 0x6b,
+	0x19, 0xa9, 0xd0, 0x7e, 0xf2, 0x18, 0xf4, 0x30, 0x21, 0x41, 0xc7, 0x5b, 0x3e, 0x82, 0x5e, 0x9a,
+	0x0c, 0x9d, 0x26, 0x24, 0xe8, 0xf8, 0x92, 0x1c, 0x41, 0x2f, 0x4f, 0x86, 0x4e, 0x13, 0xe8, 0x57,
+	0xb2, 0xc6, 0x40, 0xb4, 0xd0, 0x0c, 0x47, 0x0f, 0xc1, 0xef, 0xa1, 0xbd, 0x92, 0x1f, 0x7e, 0x1f,
+	0x63, 0xb7, 0x7d, 0x71, 0x5d, 0xb2, 0x2e, 0xaf, 0x4b, 0xd6, 0x9f, 0xeb, 0x92, 0xf5, 0xe3, 0xa6,
+	0x54, 0xb8, 0xbc, 0x29, 0x15, 0x7e, 0xdd, 0x94, 0x0a, 0x9f, 0xdf, 0x7a, 0xdc, 0x74, 0x7b, 0x1d,
+	0x87, 0xc9, 0xa0, 0xda, 0xe1, 0xa6, 0x03, 0xae, 0x87, 0x7a, 0xf0, 0xc5, 0xba, 0xc0, 0x45, 0xf5,
+	0xac, 0x3a, 0xfe, 0xd4, 0x9b, 0xf3, 0x10, 0x75, 0x67, 0x2e, 0x7a, 0xa1, 0x77, 0xfe, 0x0e, 0x00,
+	0x53, 0x80, 0x44, 0x55, 0x0a, 0x08, 0x00, 0x00,
 }
 
 func (m *PermissionCriteria) Marshal() (dAtA []byte, err error) {
@@ -266,6 +369,48 @@ func (m *PermissionCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ApprovalIdPrefixes) > 0 {
+		for iNdEx := len(m.ApprovalIdPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovalIdPrefixes[iNdEx])
+			copy(dAtA[i:], m.ApprovalIdPrefixes[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.ApprovalIdPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ApprovalIds) > 0 {
+		for iNdEx := len(m.ApprovalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovalIds[iNdEx])
+			copy(dAtA[i:], m.ApprovalIds[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.ApprovalIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TimelockDuration.Size()
+		i -= size
+		if _, err := m.TimelockDuration.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.ApprovedAddresses) > 0 {
 		for iNdEx := len(m.ApprovedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ApprovedAddresses[iNdEx])
@@ -298,6 +443,82 @@ func (m *ManagerSplitterPermissions) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.CanSetDynamicStoreValue != nil {
+		{
+			size, err := m.CanSetDynamicStoreValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPermissions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.CanDeleteDynamicStore != nil {
+		{
+			size, err := m.CanDeleteDynamicStore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPermissions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.CanUpdateDynamicStore != nil {
+		{
+			size, err := m.CanUpdateDynamicStore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPermissions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.CanCreateDynamicStore != nil {
+		{
+			size, err := m.CanCreateDynamicStore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPermissions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.CanCreateAddressLists != nil {
+		{
+			size, err := m.CanCreateAddressLists.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPermissions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CanTransferTokens != nil {
+		{
+			size, err := m.CanTransferTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPermissions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.CanAddMoreCosmosCoinWrapperPaths != nil {
 		{
 			size, err := m.CanAddMoreCosmosCoinWrapperPaths.MarshalToSizedBuffer(dAtA[:i])
@@ -456,6 +677,26 @@ func (m *PermissionCriteria) Size() (n int) {
 			n += 1 + l + sovPermissions(uint64(l))
 		}
 	}
+	l = m.TimelockDuration.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	if len(m.TokenIds) > 0 {
+		for _, e := range m.TokenIds {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.ApprovalIds) > 0 {
+		for _, s := range m.ApprovalIds {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.ApprovalIdPrefixes) > 0 {
+		for _, s := range m.ApprovalIdPrefixes {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -509,6 +750,30 @@ func (m *ManagerSplitterPermissions) Size() (n int) {
 		l = m.CanAddMoreCosmosCoinWrapperPaths.Size()
 		n += 1 + l + sovPermissions(uint64(l))
 	}
+	if m.CanTransferTokens != nil {
+		l = m.CanTransferTokens.Size()
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.CanCreateAddressLists != nil {
+		l = m.CanCreateAddressLists.Size()
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.CanCreateDynamicStore != nil {
+		l = m.CanCreateDynamicStore.Size()
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.CanUpdateDynamicStore != nil {
+		l = m.CanUpdateDynamicStore.Size()
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.CanDeleteDynamicStore != nil {
+		l = m.CanDeleteDynamicStore.Size()
+		n += 2 + l + sovPermissions(uint64(l))
+	}
+	if m.CanSetDynamicStoreValue != nil {
+		l = m.CanSetDynamicStoreValue.Size()
+		n += 2 + l + sovPermissions(uint64(l))
+	}
 	return n
 }
 
@@ -579,6 +844,138 @@ func (m *PermissionCriteria) Unmarshal(dAtA []byte) error {
 			}
 			m.ApprovedAddresses = append(m.ApprovedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimelockDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, &types.UintRange{})
+			if err := m.TokenIds[len(m.TokenIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalIds = append(m.ApprovalIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalIdPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalIdPrefixes = append(m.ApprovalIdPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1025,6 +1422,222 @@ func (m *ManagerSplitterPermissions) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanTransferTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanTransferTokens == nil {
+				m.CanTransferTokens = &PermissionCriteria{}
+			}
+			if err := m.CanTransferTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanCreateAddressLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanCreateAddressLists == nil {
+				m.CanCreateAddressLists = &PermissionCriteria{}
+			}
+			if err := m.CanCreateAddressLists.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanCreateDynamicStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanCreateDynamicStore == nil {
+				m.CanCreateDynamicStore = &PermissionCriteria{}
+			}
+			if err := m.CanCreateDynamicStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanUpdateDynamicStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanUpdateDynamicStore == nil {
+				m.CanUpdateDynamicStore = &PermissionCriteria{}
+			}
+			if err := m.CanUpdateDynamicStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanDeleteDynamicStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanDeleteDynamicStore == nil {
+				m.CanDeleteDynamicStore = &PermissionCriteria{}
+			}
+			if err := m.CanDeleteDynamicStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSetDynamicStoreValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanSetDynamicStoreValue == nil {
+				m.CanSetDynamicStoreValue = &PermissionCriteria{}
+			}
+			if err := m.CanSetDynamicStoreValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -301,490 +301,1999 @@ func (m *QueryAllManagerSplittersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "managersplitter.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "managersplitter.QueryParamsResponse")
-	proto.RegisterType((*QueryGetManagerSplitterRequest)(nil), "managersplitter.QueryGetManagerSplitterRequest")
-	proto.RegisterType((*QueryGetManagerSplitterResponse)(nil), "managersplitter.QueryGetManagerSplitterResponse")
-	proto.RegisterType((*QueryAllManagerSplittersRequest)(nil), "managersplitter.QueryAllManagerSplittersRequest")
-	proto.RegisterType((*QueryAllManagerSplittersResponse)(nil), "managersplitter.QueryAllManagerSplittersResponse")
+// QueryGetQueuedExecutionRequest is request type for the Query/QueuedExecution RPC method.
+type QueryGetQueuedExecutionRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("managersplitter/query.proto", fileDescriptor_7f6db4f131e6ce70) }
-
-var fileDescriptor_7f6db4f131e6ce70 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x46, 0x7c, 0x1e, 0x2a, 0xd3, 0x82, 0x61, 0x95, 0x6d, 0x58, 0xc5, 0x9f,
-	0xb0, 0xd3, 0xa4, 0x14, 0xc4, 0x9e, 0xec, 0xc1, 0x82, 0x54, 0xa8, 0x11, 0x2f, 0xde, 0x66, 0x93,
-	0x61, 0x3a, 0x90, 0xdd, 0xd9, 0xee, 0x4c, 0xa4, 0x45, 0xbc, 0x78, 0x17, 0x04, 0xff, 0x0e, 0x0f,
-	0x1e, 0xfd, 0x0f, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x5f, 0x78, 0x91, 0xcc, 0xcc, 0xd2,
-	0x66, 0x36, 0xab, 0xe9, 0x6d, 0x93, 0xf7, 0xfd, 0x7e, 0xdf, 0x67, 0xdf, 0x7b, 0x2c, 0xdc, 0x4c,
-	0x69, 0x46, 0x39, 0x2b, 0x54, 0x3e, 0x14, 0x5a, 0xb3, 0x82, 0x1c, 0x8e, 0x58, 0x71, 0x1c, 0xe7,
-	0x85, 0xd4, 0x12, 0xaf, 0x78, 0xc5, 0x60, 0x8d, 0x4b, 0x2e, 0x4d, 0x8d, 0x4c, 0x9f, 0xac, 0x2c,
-	0xb8, 0xc5, 0xa5, 0xe4, 0x43, 0x46, 0x68, 0x2e, 0x08, 0xcd, 0x32, 0xa9, 0xa9, 0x16, 0x32, 0x53,
-	0xae, 0xfa, 0xb0, 0x2f, 0x55, 0x2a, 0x15, 0x49, 0xa8, 0x62, 0x36, 0x9d, 0xbc, 0xed, 0x24, 0x4c,
-	0xd3, 0x0e, 0xc9, 0x29, 0x17, 0x99, 0x11, 0x97, 0x49, 0x3e, 0x4d, 0x4e, 0x0b, 0x9a, 0x96, 0x49,
-	0x2d, 0xbf, 0xaa, 0x8f, 0x6c, 0x25, 0x5a, 0x03, 0xfc, 0x72, 0x9a, 0xbc, 0x6f, 0xe4, 0x3d, 0x76,
-	0x38, 0x62, 0x4a, 0x47, 0x7b, 0xb0, 0x3a, 0xf3, 0xaf, 0xca, 0x65, 0xa6, 0x18, 0xde, 0x82, 0xa6,
-	0x8d, 0x6d, 0xa1, 0x36, 0xba, 0x7f, 0xad, 0x7b, 0x23, 0xf6, 0x72, 0x63, 0x6b, 0xd8, 0xb9, 0x74,
-	0xf2, 0x73, 0xbd, 0xd1, 0x73, 0xe2, 0xe8, 0x09, 0x84, 0x26, 0x6d, 0x97, 0xe9, 0x17, 0x56, 0xff,
-	0xca, 0xe9, 0x5d, 0x3f, 0xdc, 0x82, 0x2b, 0x74, 0x30, 0x28, 0x98, 0xb2, 0xc9, 0x57, 0x7b, 0xe5,
-	0xcf, 0x28, 0x85, 0xf5, 0x5a, 0xaf, 0xa3, 0x7a, 0x0e, 0xe5, 0xb4, 0xcb, 0x92, 0xc3, 0x6b, 0x57,
-	0xf0, 0xfc, 0x08, 0xdf, 0x18, 0x09, 0xd7, 0xee, 0xe9, 0x70, 0xe8, 0x69, 0xcb, 0xd9, 0xe0, 0x67,
-	0x00, 0x67, 0xd3, 0x77, 0x9d, 0xee, 0xc6, 0x76, 0x55, 0xf1, 0x74, 0x55, 0xb1, 0x3d, 0x04, 0xb7,
-	0xaa, 0x78, 0x9f, 0x72, 0xe6, 0xbc, 0xbd, 0x73, 0xce, 0xe8, 0x1b, 0x82, 0x76, 0x7d, 0x2f, 0xf7,
-	0x6e, 0x7b, 0x70, 0xdd, 0x43, 0x9c, 0x4e, 0x68, 0x79, 0xa1, 0x97, 0xab, 0x38, 0xf1, 0xee, 0x0c,
-	0xfa, 0x92, 0x41, 0xbf, 0xf7, 0x5f, 0x74, 0x8b, 0x72, 0x9e, 0xbd, 0xfb, 0x67, 0x19, 0x2e, 0x1b,
-	0x76, 0xfc, 0x11, 0x41, 0xd3, 0x2e, 0x1d, 0xdf, 0xae, 0x10, 0x55, 0x2f, 0x2b, 0xb8, 0xf3, 0x6f,
-	0x91, 0xed, 0x15, 0x3d, 0xfe, 0xf0, 0xfd, 0xf7, 0xe7, 0xa5, 0x2e, 0xde, 0x20, 0x89, 0xd0, 0x09,
-	0x1d, 0x70, 0xa6, 0xce, 0x9e, 0xfa, 0x07, 0x54, 0x64, 0x64, 0xfe, 0xbd, 0xe3, 0xaf, 0x08, 0x56,
-	0xbc, 0x41, 0x60, 0x32, 0xbf, 0x67, 0xed, 0x39, 0x06, 0x1b, 0x8b, 0x1b, 0x1c, 0xf0, 0xb6, 0x01,
-	0xde, 0xc2, 0x9b, 0x8b, 0x03, 0xbf, 0x73, 0x27, 0xfe, 0x1e, 0x7f, 0x41, 0xb0, 0x3a, 0xe7, 0x08,
-	0x70, 0x0d, 0x46, 0xfd, 0x6d, 0x06, 0x9d, 0x0b, 0x38, 0x1c, 0x79, 0xc7, 0x90, 0x3f, 0xc2, 0x0f,
-	0x16, 0x26, 0xdf, 0x79, 0x7d, 0x32, 0x0e, 0xd1, 0xe9, 0x38, 0x44, 0xbf, 0xc6, 0x21, 0xfa, 0x34,
-	0x09, 0x1b, 0xa7, 0x93, 0xb0, 0xf1, 0x63, 0x12, 0x36, 0xde, 0x6c, 0x73, 0xa1, 0x0f, 0x46, 0x49,
-	0xdc, 0x97, 0x69, 0x7d, 0xdc, 0x51, 0x65, 0x14, 0xfa, 0x38, 0x67, 0x2a, 0x69, 0x9a, 0x2f, 0xd2,
-	0xe6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xda, 0x7c, 0x90, 0x59, 0x05, 0x00, 0x00,
+func (m *QueryGetQueuedExecutionRequest) Reset()         { *m = QueryGetQueuedExecutionRequest{} }
+func (m *QueryGetQueuedExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQueuedExecutionRequest) ProtoMessage()    {}
+func (*QueryGetQueuedExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{6}
+}
+func (m *QueryGetQueuedExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQueuedExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQueuedExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetQueuedExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQueuedExecutionRequest.Merge(m, src)
+}
+func (m *QueryGetQueuedExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQueuedExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQueuedExecutionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetQueuedExecutionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ManagerSplitter queries a manager splitter by address.
-	ManagerSplitter(ctx context.Context, in *QueryGetManagerSplitterRequest, opts ...grpc.CallOption) (*QueryGetManagerSplitterResponse, error)
-	// AllManagerSplitters queries all manager splitters.
-	AllManagerSplitters(ctx context.Context, in *QueryAllManagerSplittersRequest, opts ...grpc.CallOption) (*QueryAllManagerSplittersResponse, error)
+func (m *QueryGetQueuedExecutionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetQueuedExecutionResponse is response type for the Query/QueuedExecution RPC method.
+type QueryGetQueuedExecutionResponse struct {
+	QueuedExecution *QueuedExecution `protobuf:"bytes,1,opt,name=queuedExecution,proto3" json:"queuedExecution,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetQueuedExecutionResponse) Reset()         { *m = QueryGetQueuedExecutionResponse{} }
+func (m *QueryGetQueuedExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQueuedExecutionResponse) ProtoMessage()    {}
+func (*QueryGetQueuedExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetQueuedExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ManagerSplitter(ctx context.Context, in *QueryGetManagerSplitterRequest, opts ...grpc.CallOption) (*QueryGetManagerSplitterResponse, error) {
-	out := new(QueryGetManagerSplitterResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Query/ManagerSplitter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetQueuedExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQueuedExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetQueuedExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQueuedExecutionResponse.Merge(m, src)
+}
+func (m *QueryGetQueuedExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQueuedExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQueuedExecutionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllManagerSplitters(ctx context.Context, in *QueryAllManagerSplittersRequest, opts ...grpc.CallOption) (*QueryAllManagerSplittersResponse, error) {
-	out := new(QueryAllManagerSplittersResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Query/AllManagerSplitters", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetQueuedExecutionResponse proto.InternalMessageInfo
+
+func (m *QueryGetQueuedExecutionResponse) GetQueuedExecution() *QueuedExecution {
+	if m != nil {
+		return m.QueuedExecution
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ManagerSplitter queries a manager splitter by address.
-	ManagerSplitter(context.Context, *QueryGetManagerSplitterRequest) (*QueryGetManagerSplitterResponse, error)
-	// AllManagerSplitters queries all manager splitters.
-	AllManagerSplitters(context.Context, *QueryAllManagerSplittersRequest) (*QueryAllManagerSplittersResponse, error)
+// QueryQueuedExecutionsRequest is request type for the Query/QueuedExecutions RPC method.
+type QueryQueuedExecutionsRequest struct {
+	ManagerSplitterAddress string             `protobuf:"bytes,1,opt,name=managerSplitterAddress,proto3" json:"managerSplitterAddress,omitempty"`
+	Pagination             *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryQueuedExecutionsRequest) Reset()         { *m = QueryQueuedExecutionsRequest{} }
+func (m *QueryQueuedExecutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedExecutionsRequest) ProtoMessage()    {}
+func (*QueryQueuedExecutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryQueuedExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ManagerSplitter(ctx context.Context, req *QueryGetManagerSplitterRequest) (*QueryGetManagerSplitterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManagerSplitter not implemented")
+func (m *QueryQueuedExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedExecutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllManagerSplitters(ctx context.Context, req *QueryAllManagerSplittersRequest) (*QueryAllManagerSplittersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllManagerSplitters not implemented")
+func (m *QueryQueuedExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedExecutionsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryQueuedExecutionsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryQueuedExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedExecutionsRequest.DiscardUnknown(m)
 }
 
-func _Query_ManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetManagerSplitterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ManagerSplitter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Query/ManagerSplitter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ManagerSplitter(ctx, req.(*QueryGetManagerSplitterRequest))
+var xxx_messageInfo_QueryQueuedExecutionsRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedExecutionsRequest) GetManagerSplitterAddress() string {
+	if m != nil {
+		return m.ManagerSplitterAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllManagerSplitters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllManagerSplittersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllManagerSplitters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Query/AllManagerSplitters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllManagerSplitters(ctx, req.(*QueryAllManagerSplittersRequest))
+func (m *QueryQueuedExecutionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "managersplitter.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ManagerSplitter",
-			Handler:    _Query_ManagerSplitter_Handler,
-		},
-		{
-			MethodName: "AllManagerSplitters",
-			Handler:    _Query_AllManagerSplitters_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "managersplitter/query.proto",
+// QueryQueuedExecutionsResponse is response type for the Query/QueuedExecutions RPC method.
+type QueryQueuedExecutionsResponse struct {
+	QueuedExecutions []*QueuedExecution  `protobuf:"bytes,1,rep,name=queuedExecutions,proto3" json:"queuedExecutions,omitempty"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQueuedExecutionsResponse) Reset()         { *m = QueryQueuedExecutionsResponse{} }
+func (m *QueryQueuedExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedExecutionsResponse) ProtoMessage()    {}
+func (*QueryQueuedExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{9}
+}
+func (m *QueryQueuedExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedExecutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryQueuedExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedExecutionsResponse.Merge(m, src)
+}
+func (m *QueryQueuedExecutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedExecutionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryQueuedExecutionsResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedExecutionsResponse) GetQueuedExecutions() []*QueuedExecution {
+	if m != nil {
+		return m.QueuedExecutions
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQueuedExecutionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryManagerSplittersByAdminRequest is request type for the Query/ManagerSplittersByAdmin RPC method.
+type QueryManagerSplittersByAdminRequest struct {
+	Admin      string             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryManagerSplittersByAdminRequest) Reset()         { *m = QueryManagerSplittersByAdminRequest{} }
+func (m *QueryManagerSplittersByAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryManagerSplittersByAdminRequest) ProtoMessage()    {}
+func (*QueryManagerSplittersByAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{10}
+}
+func (m *QueryManagerSplittersByAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagerSplittersByAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagerSplittersByAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetManagerSplitterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryManagerSplittersByAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagerSplittersByAdminRequest.Merge(m, src)
 }
-
-func (m *QueryGetManagerSplitterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryManagerSplittersByAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagerSplittersByAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagerSplittersByAdminRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetManagerSplitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryManagerSplittersByAdminRequest proto.InternalMessageInfo
+
+func (m *QueryManagerSplittersByAdminRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryManagerSplittersByAdminRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryManagerSplittersByAdminResponse is response type for the Query/ManagerSplittersByAdmin RPC method.
+type QueryManagerSplittersByAdminResponse struct {
+	ManagerSplitters []*ManagerSplitter  `protobuf:"bytes,1,rep,name=managerSplitters,proto3" json:"managerSplitters,omitempty"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ManagerSplitter != nil {
-		{
-			size, err := m.ManagerSplitter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryManagerSplittersByAdminResponse) Reset()         { *m = QueryManagerSplittersByAdminResponse{} }
+func (m *QueryManagerSplittersByAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryManagerSplittersByAdminResponse) ProtoMessage()    {}
+func (*QueryManagerSplittersByAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{11}
+}
+func (m *QueryManagerSplittersByAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagerSplittersByAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagerSplittersByAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllManagerSplittersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryManagerSplittersByAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagerSplittersByAdminResponse.Merge(m, src)
 }
-
-func (m *QueryAllManagerSplittersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryManagerSplittersByAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagerSplittersByAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagerSplittersByAdminResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllManagerSplittersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryManagerSplittersByAdminResponse proto.InternalMessageInfo
+
+func (m *QueryManagerSplittersByAdminResponse) GetManagerSplitters() []*ManagerSplitter {
+	if m != nil {
+		return m.ManagerSplitters
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllManagerSplittersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryManagerSplittersByAdminResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllManagerSplittersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryManagerSplittersByExecutorRequest is request type for the Query/ManagerSplittersByExecutor RPC method.
+type QueryManagerSplittersByExecutorRequest struct {
+	Executor   string             `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllManagerSplittersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ManagerSplitters) > 0 {
-		for iNdEx := len(m.ManagerSplitters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ManagerSplitters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryManagerSplittersByExecutorRequest) Reset() {
+	*m = QueryManagerSplittersByExecutorRequest{}
+}
+func (m *QueryManagerSplittersByExecutorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryManagerSplittersByExecutorRequest) ProtoMessage()    {}
+func (*QueryManagerSplittersByExecutorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{12}
+}
+func (m *QueryManagerSplittersByExecutorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagerSplittersByExecutorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagerSplittersByExecutorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryManagerSplittersByExecutorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagerSplittersByExecutorRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryManagerSplittersByExecutorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagerSplittersByExecutorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagerSplittersByExecutorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryManagerSplittersByExecutorRequest proto.InternalMessageInfo
+
+func (m *QueryManagerSplittersByExecutorRequest) GetExecutor() string {
+	if m != nil {
+		return m.Executor
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetManagerSplitterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryManagerSplittersByExecutorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetManagerSplitterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ManagerSplitter != nil {
-		l = m.ManagerSplitter.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryManagerSplittersByExecutorResponse is response type for the Query/ManagerSplittersByExecutor RPC method.
+type QueryManagerSplittersByExecutorResponse struct {
+	ManagerSplitters []*ManagerSplitter  `protobuf:"bytes,1,rep,name=managerSplitters,proto3" json:"managerSplitters,omitempty"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllManagerSplittersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryManagerSplittersByExecutorResponse) Reset() {
+	*m = QueryManagerSplittersByExecutorResponse{}
+}
+func (m *QueryManagerSplittersByExecutorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryManagerSplittersByExecutorResponse) ProtoMessage()    {}
+func (*QueryManagerSplittersByExecutorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{13}
+}
+func (m *QueryManagerSplittersByExecutorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagerSplittersByExecutorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagerSplittersByExecutorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryManagerSplittersByExecutorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagerSplittersByExecutorResponse.Merge(m, src)
+}
+func (m *QueryManagerSplittersByExecutorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagerSplittersByExecutorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagerSplittersByExecutorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllManagerSplittersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryManagerSplittersByExecutorResponse proto.InternalMessageInfo
+
+func (m *QueryManagerSplittersByExecutorResponse) GetManagerSplitters() []*ManagerSplitter {
+	if m != nil {
+		return m.ManagerSplitters
+	}
+	return nil
+}
+
+func (m *QueryManagerSplittersByExecutorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryManagerSplitterByCollectionRequest is request type for the Query/ManagerSplitterByCollection RPC method.
+type QueryManagerSplitterByCollectionRequest struct {
+	CollectionId string `protobuf:"bytes,1,opt,name=collectionId,proto3" json:"collectionId,omitempty"`
+}
+
+func (m *QueryManagerSplitterByCollectionRequest) Reset() {
+	*m = QueryManagerSplitterByCollectionRequest{}
+}
+func (m *QueryManagerSplitterByCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryManagerSplitterByCollectionRequest) ProtoMessage()    {}
+func (*QueryManagerSplitterByCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{14}
+}
+func (m *QueryManagerSplitterByCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagerSplitterByCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagerSplitterByCollectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryManagerSplitterByCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagerSplitterByCollectionRequest.Merge(m, src)
+}
+func (m *QueryManagerSplitterByCollectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagerSplitterByCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagerSplitterByCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryManagerSplitterByCollectionRequest proto.InternalMessageInfo
+
+func (m *QueryManagerSplitterByCollectionRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+// QueryManagerSplitterByCollectionResponse is response type for the Query/ManagerSplitterByCollection RPC method.
+type QueryManagerSplitterByCollectionResponse struct {
+	ManagerSplitter *ManagerSplitter `protobuf:"bytes,1,opt,name=managerSplitter,proto3" json:"managerSplitter,omitempty"`
+}
+
+func (m *QueryManagerSplitterByCollectionResponse) Reset() {
+	*m = QueryManagerSplitterByCollectionResponse{}
+}
+func (m *QueryManagerSplitterByCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryManagerSplitterByCollectionResponse) ProtoMessage()    {}
+func (*QueryManagerSplitterByCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{15}
+}
+func (m *QueryManagerSplitterByCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryManagerSplitterByCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryManagerSplitterByCollectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryManagerSplitterByCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryManagerSplitterByCollectionResponse.Merge(m, src)
+}
+func (m *QueryManagerSplitterByCollectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryManagerSplitterByCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryManagerSplitterByCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryManagerSplitterByCollectionResponse proto.InternalMessageInfo
+
+func (m *QueryManagerSplitterByCollectionResponse) GetManagerSplitter() *ManagerSplitter {
+	if m != nil {
+		return m.ManagerSplitter
+	}
+	return nil
+}
+
+// QueryExecutionLogsRequest is request type for the Query/ExecutionLogs RPC method.
+type QueryExecutionLogsRequest struct {
+	ManagerSplitterAddress string             `protobuf:"bytes,1,opt,name=managerSplitterAddress,proto3" json:"managerSplitterAddress,omitempty"`
+	Pagination             *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionLogsRequest) Reset()         { *m = QueryExecutionLogsRequest{} }
+func (m *QueryExecutionLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionLogsRequest) ProtoMessage()    {}
+func (*QueryExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{16}
+}
+func (m *QueryExecutionLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionLogsRequest.Merge(m, src)
+}
+func (m *QueryExecutionLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionLogsRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionLogsRequest) GetManagerSplitterAddress() string {
+	if m != nil {
+		return m.ManagerSplitterAddress
+	}
+	return ""
+}
+
+func (m *QueryExecutionLogsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExecutionLogsResponse is response type for the Query/ExecutionLogs RPC method.
+type QueryExecutionLogsResponse struct {
+	ExecutionLogs []*ExecutionLogEntry `protobuf:"bytes,1,rep,name=executionLogs,proto3" json:"executionLogs,omitempty"`
+	Pagination    *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionLogsResponse) Reset()         { *m = QueryExecutionLogsResponse{} }
+func (m *QueryExecutionLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionLogsResponse) ProtoMessage()    {}
+func (*QueryExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f6db4f131e6ce70, []int{17}
+}
+func (m *QueryExecutionLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionLogsResponse.Merge(m, src)
+}
+func (m *QueryExecutionLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionLogsResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionLogsResponse) GetExecutionLogs() []*ExecutionLogEntry {
+	if m != nil {
+		return m.ExecutionLogs
+	}
+	return nil
+}
+
+func (m *QueryExecutionLogsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "managersplitter.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "managersplitter.QueryParamsResponse")
+	proto.RegisterType((*QueryGetManagerSplitterRequest)(nil), "managersplitter.QueryGetManagerSplitterRequest")
+	proto.RegisterType((*QueryGetManagerSplitterResponse)(nil), "managersplitter.QueryGetManagerSplitterResponse")
+	proto.RegisterType((*QueryAllManagerSplittersRequest)(nil), "managersplitter.QueryAllManagerSplittersRequest")
+	proto.RegisterType((*QueryAllManagerSplittersResponse)(nil), "managersplitter.QueryAllManagerSplittersResponse")
+	proto.RegisterType((*QueryGetQueuedExecutionRequest)(nil), "managersplitter.QueryGetQueuedExecutionRequest")
+	proto.RegisterType((*QueryGetQueuedExecutionResponse)(nil), "managersplitter.QueryGetQueuedExecutionResponse")
+	proto.RegisterType((*QueryQueuedExecutionsRequest)(nil), "managersplitter.QueryQueuedExecutionsRequest")
+	proto.RegisterType((*QueryQueuedExecutionsResponse)(nil), "managersplitter.QueryQueuedExecutionsResponse")
+	proto.RegisterType((*QueryManagerSplittersByAdminRequest)(nil), "managersplitter.QueryManagerSplittersByAdminRequest")
+	proto.RegisterType((*QueryManagerSplittersByAdminResponse)(nil), "managersplitter.QueryManagerSplittersByAdminResponse")
+	proto.RegisterType((*QueryManagerSplittersByExecutorRequest)(nil), "managersplitter.QueryManagerSplittersByExecutorRequest")
+	proto.RegisterType((*QueryManagerSplittersByExecutorResponse)(nil), "managersplitter.QueryManagerSplittersByExecutorResponse")
+	proto.RegisterType((*QueryManagerSplitterByCollectionRequest)(nil), "managersplitter.QueryManagerSplitterByCollectionRequest")
+	proto.RegisterType((*QueryManagerSplitterByCollectionResponse)(nil), "managersplitter.QueryManagerSplitterByCollectionResponse")
+	proto.RegisterType((*QueryExecutionLogsRequest)(nil), "managersplitter.QueryExecutionLogsRequest")
+	proto.RegisterType((*QueryExecutionLogsResponse)(nil), "managersplitter.QueryExecutionLogsResponse")
+}
+
+func init() { proto.RegisterFile("managersplitter/query.proto", fileDescriptor_7f6db4f131e6ce70) }
+
+var fileDescriptor_7f6db4f131e6ce70 = []byte{
+	// 968 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x5f, 0x8b, 0x1b, 0x55,
+	0x14, 0xc0, 0x73, 0xa3, 0x5d, 0xf5, 0xe8, 0x9a, 0x72, 0xba, 0xd8, 0x75, 0x5a, 0xd3, 0x65, 0x5a,
+	0xda, 0xda, 0xe2, 0x4c, 0xb2, 0x35, 0xb6, 0xb6, 0x0a, 0x6e, 0xa4, 0xfb, 0x47, 0xb7, 0xba, 0x89,
+	0xf8, 0xe2, 0x8b, 0x4c, 0x32, 0x97, 0xd9, 0x81, 0xcc, 0xdc, 0x6c, 0x66, 0xb2, 0x6c, 0x08, 0x79,
+	0xd1, 0x57, 0x05, 0xc1, 0x47, 0xc1, 0x6f, 0x20, 0x22, 0x82, 0xb0, 0x0f, 0x82, 0x88, 0x0f, 0x8b,
+	0x4f, 0x0b, 0xbe, 0xe8, 0x8b, 0x48, 0xd6, 0x0f, 0x22, 0xb9, 0x73, 0x27, 0xc9, 0xdc, 0xcc, 0x64,
+	0x27, 0x4b, 0x84, 0x7d, 0xcb, 0xcc, 0xf9, 0xf7, 0x3b, 0xe7, 0xdc, 0xb9, 0xe7, 0x10, 0xb8, 0xe2,
+	0x18, 0xae, 0x61, 0xd1, 0x96, 0xd7, 0x6c, 0xd8, 0xbe, 0x4f, 0x5b, 0xfa, 0x5e, 0x9b, 0xb6, 0x3a,
+	0x5a, 0xb3, 0xc5, 0x7c, 0x86, 0x39, 0x49, 0xa8, 0x2c, 0x59, 0xcc, 0x62, 0x5c, 0xa6, 0x0f, 0x7e,
+	0x05, 0x6a, 0xca, 0x55, 0x8b, 0x31, 0xab, 0x41, 0x75, 0xa3, 0x69, 0xeb, 0x86, 0xeb, 0x32, 0xdf,
+	0xf0, 0x6d, 0xe6, 0x7a, 0x42, 0x7a, 0xa7, 0xce, 0x3c, 0x87, 0x79, 0x7a, 0xcd, 0xf0, 0x68, 0xe0,
+	0x5d, 0xdf, 0x2f, 0xd6, 0xa8, 0x6f, 0x14, 0xf5, 0xa6, 0x61, 0xd9, 0x2e, 0x57, 0x0e, 0x3d, 0xc9,
+	0x34, 0x4d, 0xa3, 0x65, 0x38, 0xa1, 0xa7, 0x65, 0x59, 0xea, 0x1f, 0x04, 0x12, 0x75, 0x09, 0xb0,
+	0x32, 0xf0, 0xbc, 0xc3, 0xd5, 0xab, 0x74, 0xaf, 0x4d, 0x3d, 0x5f, 0xdd, 0x86, 0x4b, 0x91, 0xb7,
+	0x5e, 0x93, 0xb9, 0x1e, 0xc5, 0x12, 0x2c, 0x04, 0x6e, 0x97, 0xc9, 0x0a, 0xb9, 0xfd, 0xfc, 0xea,
+	0x65, 0x4d, 0xf2, 0xab, 0x05, 0x06, 0xe5, 0xa7, 0x8f, 0xfe, 0xbe, 0x96, 0xa9, 0x0a, 0x65, 0xf5,
+	0x21, 0xe4, 0xb9, 0xb7, 0x0d, 0xea, 0x3f, 0x09, 0xf4, 0x3f, 0x12, 0xfa, 0x22, 0x1e, 0x2e, 0xc3,
+	0x33, 0x86, 0x69, 0xb6, 0xa8, 0x17, 0x78, 0x7e, 0xae, 0x1a, 0x3e, 0xaa, 0x0e, 0x5c, 0x4b, 0xb4,
+	0x15, 0x54, 0xef, 0x41, 0xce, 0x89, 0x8a, 0x04, 0xde, 0xca, 0x04, 0x9e, 0xec, 0x42, 0x36, 0x54,
+	0x6d, 0x11, 0x6e, 0xad, 0xd1, 0x90, 0x74, 0xc3, 0xda, 0xe0, 0x3a, 0xc0, 0xa8, 0xfa, 0x22, 0xd2,
+	0x4d, 0x2d, 0x68, 0x95, 0x36, 0x68, 0x95, 0x16, 0x1c, 0x04, 0xd1, 0x2a, 0x6d, 0xc7, 0xb0, 0xa8,
+	0xb0, 0xad, 0x8e, 0x59, 0xaa, 0x87, 0x04, 0x56, 0x92, 0x63, 0x89, 0xdc, 0xb6, 0xe1, 0xa2, 0x84,
+	0x38, 0xa8, 0xd0, 0x53, 0xa9, 0x92, 0x9b, 0xb0, 0xc4, 0x8d, 0x08, 0x7a, 0x96, 0xa3, 0xdf, 0x3a,
+	0x15, 0x3d, 0x40, 0x89, 0xb0, 0x17, 0x46, 0x1d, 0xad, 0xb4, 0x69, 0x9b, 0x9a, 0x8f, 0x0f, 0x68,
+	0xbd, 0x3d, 0x10, 0x85, 0x55, 0x7a, 0x11, 0xb2, 0xb6, 0x29, 0x9a, 0x99, 0xb5, 0xcd, 0xf1, 0x3e,
+	0x4e, 0x58, 0x8c, 0xfa, 0xb8, 0x17, 0x15, 0x25, 0xf6, 0x51, 0x76, 0x21, 0x1b, 0xaa, 0xdf, 0x12,
+	0xb8, 0xca, 0xe3, 0x49, 0x9a, 0xc3, 0x2e, 0xbe, 0x01, 0x2f, 0x49, 0xe5, 0x59, 0x8b, 0x1c, 0xc0,
+	0x04, 0x29, 0xae, 0xc7, 0x94, 0xf0, 0x2c, 0xdd, 0xff, 0x89, 0xc0, 0x2b, 0x09, 0x80, 0xa3, 0xd6,
+	0x4b, 0x59, 0x25, 0xb7, 0x5e, 0xae, 0xc7, 0x84, 0xe5, 0xfc, 0x5a, 0xff, 0x39, 0x81, 0xeb, 0x1c,
+	0x5c, 0x3e, 0xb3, 0xe5, 0xce, 0x9a, 0xe9, 0xd8, 0xc3, 0x03, 0xb0, 0x04, 0x17, 0x8c, 0xc1, 0xb3,
+	0xa8, 0x67, 0xf0, 0x30, 0xb7, 0xf2, 0xfd, 0x4c, 0xe0, 0xc6, 0x74, 0x8a, 0xf3, 0xfd, 0x01, 0x7d,
+	0x41, 0xe0, 0x66, 0x02, 0x7f, 0xd0, 0x34, 0x36, 0xbc, 0x1b, 0x15, 0x78, 0x96, 0x8a, 0x57, 0xa2,
+	0x96, 0xc3, 0xe7, 0xb9, 0x95, 0xf3, 0x17, 0x02, 0xb7, 0x4e, 0xc5, 0x39, 0xdf, 0x15, 0x7d, 0x12,
+	0x9f, 0x41, 0xb9, 0xf3, 0x2e, 0x6b, 0x34, 0x68, 0x7d, 0xfc, 0x6e, 0x52, 0xe1, 0x85, 0xfa, 0xf0,
+	0xe5, 0x56, 0x78, 0x4b, 0x45, 0xde, 0xa9, 0xfb, 0x70, 0xfb, 0x74, 0x77, 0xff, 0xc3, 0x00, 0xfa,
+	0x86, 0xc0, 0xcb, 0x3c, 0xf0, 0xf0, 0xdb, 0xdd, 0x66, 0xd6, 0xb9, 0xb9, 0xb5, 0xbe, 0x27, 0xa0,
+	0xc4, 0xd1, 0x89, 0x42, 0x6c, 0xc2, 0x22, 0x1d, 0x17, 0x88, 0x73, 0xa1, 0x4e, 0x94, 0x61, 0xdc,
+	0xfc, 0xb1, 0xeb, 0xb7, 0x3a, 0xd5, 0xa8, 0xe1, 0xdc, 0x8e, 0xc5, 0xea, 0xaf, 0x8b, 0x70, 0x81,
+	0x13, 0xe3, 0x97, 0x04, 0x16, 0x82, 0xf5, 0x04, 0xaf, 0xc7, 0x5d, 0xa0, 0xd2, 0x0e, 0xa4, 0xdc,
+	0x98, 0xae, 0x14, 0xc4, 0x52, 0x1f, 0x7c, 0xf6, 0xc7, 0xbf, 0x5f, 0x67, 0x57, 0xb1, 0xa0, 0xd7,
+	0x6c, 0xbf, 0x66, 0x98, 0x16, 0xf5, 0x46, 0xbf, 0xea, 0xbb, 0x86, 0xed, 0xea, 0xf1, 0x9b, 0x19,
+	0xfe, 0x40, 0x20, 0x27, 0x1d, 0x07, 0xd4, 0xe3, 0x63, 0x26, 0x2e, 0x4e, 0x4a, 0x21, 0xbd, 0x81,
+	0x00, 0x7e, 0xc4, 0x81, 0x4b, 0x78, 0x2f, 0x3d, 0x70, 0x57, 0x2c, 0x63, 0x3d, 0xfc, 0x8e, 0xc0,
+	0xa5, 0x98, 0x75, 0x05, 0x13, 0x30, 0x92, 0xb7, 0x28, 0xa5, 0x38, 0x83, 0x85, 0x20, 0x2f, 0x72,
+	0xf2, 0xbb, 0xf8, 0x6a, 0x6a, 0x72, 0xfc, 0x91, 0x40, 0x4e, 0x9a, 0x8d, 0x53, 0x6a, 0x1c, 0xbf,
+	0xca, 0x28, 0x85, 0xf4, 0x06, 0x82, 0xf4, 0x6d, 0x4e, 0x7a, 0x1f, 0x4b, 0xe9, 0x6b, 0x1c, 0x0c,
+	0x6c, 0xbd, 0x6b, 0x9b, 0x3d, 0xfc, 0x8d, 0xc0, 0xc5, 0x8a, 0x3c, 0xc0, 0x5f, 0x8b, 0xa7, 0x48,
+	0xd8, 0x6f, 0x14, 0x2d, 0xad, 0xba, 0x40, 0xae, 0x70, 0xe4, 0xf7, 0x71, 0x6b, 0x86, 0x63, 0x11,
+	0x7f, 0xd9, 0xf4, 0x44, 0x2e, 0xf8, 0x3b, 0x81, 0xcb, 0x09, 0xe3, 0x19, 0x5f, 0x8f, 0xc7, 0x9b,
+	0xbe, 0x53, 0x28, 0xa5, 0x19, 0xad, 0x44, 0x6e, 0x65, 0x9e, 0xdb, 0x5b, 0xf8, 0x30, 0x7d, 0x6e,
+	0xb5, 0xce, 0xa7, 0x7c, 0x61, 0x19, 0x9c, 0x7d, 0xc7, 0x76, 0x7b, 0xf8, 0x17, 0x01, 0x25, 0x79,
+	0x38, 0xe2, 0xfd, 0xb4, 0x64, 0xd2, 0x74, 0x57, 0x1e, 0xcc, 0x6e, 0x28, 0xb2, 0xda, 0xe4, 0x59,
+	0x95, 0xf1, 0x9d, 0x99, 0xb2, 0x0a, 0x57, 0x07, 0xbd, 0x1b, 0xfe, 0xea, 0x61, 0x9f, 0xc0, 0x95,
+	0x29, 0x73, 0x0e, 0xd3, 0x31, 0xc6, 0x4c, 0x5a, 0xe5, 0xcd, 0x33, 0x58, 0x8a, 0xf4, 0x3e, 0xe0,
+	0xe9, 0x6d, 0xe2, 0xfa, 0x4c, 0xe9, 0x8d, 0x66, 0xb8, 0xde, 0x1d, 0x9f, 0xe7, 0x3d, 0x3c, 0x24,
+	0xb0, 0x18, 0x99, 0x5a, 0x78, 0x27, 0x1e, 0x2e, 0x6e, 0xf0, 0x2a, 0x77, 0x53, 0xe9, 0x0a, 0xf4,
+	0x0f, 0x39, 0xfa, 0x16, 0x6e, 0xcc, 0xe1, 0x5b, 0x6a, 0x30, 0xcb, 0x2b, 0x7f, 0x7c, 0xd4, 0xcf,
+	0x93, 0xe3, 0x7e, 0x9e, 0xfc, 0xd3, 0xcf, 0x93, 0xaf, 0x4e, 0xf2, 0x99, 0xe3, 0x93, 0x7c, 0xe6,
+	0xcf, 0x93, 0x7c, 0xe6, 0x93, 0x47, 0x96, 0xed, 0xef, 0xb6, 0x6b, 0x5a, 0x9d, 0x39, 0xc9, 0xc1,
+	0x0e, 0x26, 0xc2, 0xf9, 0x9d, 0x26, 0xf5, 0x6a, 0x0b, 0xfc, 0x2f, 0x80, 0x7b, 0xff, 0x0d, 0x00,
+	0x33, 0x03, 0x8c, 0x8e, 0xca, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ManagerSplitter queries a manager splitter by address.
+	ManagerSplitter(ctx context.Context, in *QueryGetManagerSplitterRequest, opts ...grpc.CallOption) (*QueryGetManagerSplitterResponse, error)
+	// AllManagerSplitters queries all manager splitters.
+	AllManagerSplitters(ctx context.Context, in *QueryAllManagerSplittersRequest, opts ...grpc.CallOption) (*QueryAllManagerSplittersResponse, error)
+	// QueuedExecution queries a queued (timelocked) execution by ID.
+	QueuedExecution(ctx context.Context, in *QueryGetQueuedExecutionRequest, opts ...grpc.CallOption) (*QueryGetQueuedExecutionResponse, error)
+	// QueuedExecutions queries all queued (timelocked) executions for a manager splitter.
+	QueuedExecutions(ctx context.Context, in *QueryQueuedExecutionsRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsResponse, error)
+	// ManagerSplittersByAdmin queries all manager splitters administered by an address.
+	ManagerSplittersByAdmin(ctx context.Context, in *QueryManagerSplittersByAdminRequest, opts ...grpc.CallOption) (*QueryManagerSplittersByAdminResponse, error)
+	// ManagerSplittersByExecutor queries all manager splitters where an address is an approved executor for any permission.
+	ManagerSplittersByExecutor(ctx context.Context, in *QueryManagerSplittersByExecutorRequest, opts ...grpc.CallOption) (*QueryManagerSplittersByExecutorResponse, error)
+	// ManagerSplitterByCollection queries the manager splitter currently managing a collection.
+	ManagerSplitterByCollection(ctx context.Context, in *QueryManagerSplitterByCollectionRequest, opts ...grpc.CallOption) (*QueryManagerSplitterByCollectionResponse, error)
+	// ExecutionLogs queries the execution log of a manager splitter.
+	ExecutionLogs(ctx context.Context, in *QueryExecutionLogsRequest, opts ...grpc.CallOption) (*QueryExecutionLogsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ManagerSplitter(ctx context.Context, in *QueryGetManagerSplitterRequest, opts ...grpc.CallOption) (*QueryGetManagerSplitterResponse, error) {
+	out := new(QueryGetManagerSplitterResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/ManagerSplitter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllManagerSplitters(ctx context.Context, in *QueryAllManagerSplittersRequest, opts ...grpc.CallOption) (*QueryAllManagerSplittersResponse, error) {
+	out := new(QueryAllManagerSplittersResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/AllManagerSplitters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedExecution(ctx context.Context, in *QueryGetQueuedExecutionRequest, opts ...grpc.CallOption) (*QueryGetQueuedExecutionResponse, error) {
+	out := new(QueryGetQueuedExecutionResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/QueuedExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedExecutions(ctx context.Context, in *QueryQueuedExecutionsRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsResponse, error) {
+	out := new(QueryQueuedExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/QueuedExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ManagerSplittersByAdmin(ctx context.Context, in *QueryManagerSplittersByAdminRequest, opts ...grpc.CallOption) (*QueryManagerSplittersByAdminResponse, error) {
+	out := new(QueryManagerSplittersByAdminResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/ManagerSplittersByAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ManagerSplittersByExecutor(ctx context.Context, in *QueryManagerSplittersByExecutorRequest, opts ...grpc.CallOption) (*QueryManagerSplittersByExecutorResponse, error) {
+	out := new(QueryManagerSplittersByExecutorResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/ManagerSplittersByExecutor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ManagerSplitterByCollection(ctx context.Context, in *QueryManagerSplitterByCollectionRequest, opts ...grpc.CallOption) (*QueryManagerSplitterByCollectionResponse, error) {
+	out := new(QueryManagerSplitterByCollectionResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/ManagerSplitterByCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionLogs(ctx context.Context, in *QueryExecutionLogsRequest, opts ...grpc.CallOption) (*QueryExecutionLogsResponse, error) {
+	out := new(QueryExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Query/ExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ManagerSplitter queries a manager splitter by address.
+	ManagerSplitter(context.Context, *QueryGetManagerSplitterRequest) (*QueryGetManagerSplitterResponse, error)
+	// AllManagerSplitters queries all manager splitters.
+	AllManagerSplitters(context.Context, *QueryAllManagerSplittersRequest) (*QueryAllManagerSplittersResponse, error)
+	// QueuedExecution queries a queued (timelocked) execution by ID.
+	QueuedExecution(context.Context, *QueryGetQueuedExecutionRequest) (*QueryGetQueuedExecutionResponse, error)
+	// QueuedExecutions queries all queued (timelocked) executions for a manager splitter.
+	QueuedExecutions(context.Context, *QueryQueuedExecutionsRequest) (*QueryQueuedExecutionsResponse, error)
+	// ManagerSplittersByAdmin queries all manager splitters administered by an address.
+	ManagerSplittersByAdmin(context.Context, *QueryManagerSplittersByAdminRequest) (*QueryManagerSplittersByAdminResponse, error)
+	// ManagerSplittersByExecutor queries all manager splitters where an address is an approved executor for any permission.
+	ManagerSplittersByExecutor(context.Context, *QueryManagerSplittersByExecutorRequest) (*QueryManagerSplittersByExecutorResponse, error)
+	// ManagerSplitterByCollection queries the manager splitter currently managing a collection.
+	ManagerSplitterByCollection(context.Context, *QueryManagerSplitterByCollectionRequest) (*QueryManagerSplitterByCollectionResponse, error)
+	// ExecutionLogs queries the execution log of a manager splitter.
+	ExecutionLogs(context.Context, *QueryExecutionLogsRequest) (*QueryExecutionLogsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ManagerSplitter(ctx context.Context, req *QueryGetManagerSplitterRequest) (*QueryGetManagerSplitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManagerSplitter not implemented")
+}
+func (*UnimplementedQueryServer) AllManagerSplitters(ctx context.Context, req *QueryAllManagerSplittersRequest) (*QueryAllManagerSplittersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllManagerSplitters not implemented")
+}
+func (*UnimplementedQueryServer) QueuedExecution(ctx context.Context, req *QueryGetQueuedExecutionRequest) (*QueryGetQueuedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedExecution not implemented")
+}
+func (*UnimplementedQueryServer) QueuedExecutions(ctx context.Context, req *QueryQueuedExecutionsRequest) (*QueryQueuedExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedExecutions not implemented")
+}
+func (*UnimplementedQueryServer) ManagerSplittersByAdmin(ctx context.Context, req *QueryManagerSplittersByAdminRequest) (*QueryManagerSplittersByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManagerSplittersByAdmin not implemented")
+}
+func (*UnimplementedQueryServer) ManagerSplittersByExecutor(ctx context.Context, req *QueryManagerSplittersByExecutorRequest) (*QueryManagerSplittersByExecutorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManagerSplittersByExecutor not implemented")
+}
+func (*UnimplementedQueryServer) ManagerSplitterByCollection(ctx context.Context, req *QueryManagerSplitterByCollectionRequest) (*QueryManagerSplitterByCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManagerSplitterByCollection not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionLogs(ctx context.Context, req *QueryExecutionLogsRequest) (*QueryExecutionLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionLogs not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetManagerSplitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ManagerSplitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/ManagerSplitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ManagerSplitter(ctx, req.(*QueryGetManagerSplitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllManagerSplitters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllManagerSplittersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllManagerSplitters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/AllManagerSplitters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllManagerSplitters(ctx, req.(*QueryAllManagerSplittersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetQueuedExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/QueuedExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedExecution(ctx, req.(*QueryGetQueuedExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/QueuedExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedExecutions(ctx, req.(*QueryQueuedExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ManagerSplittersByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryManagerSplittersByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ManagerSplittersByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/ManagerSplittersByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ManagerSplittersByAdmin(ctx, req.(*QueryManagerSplittersByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ManagerSplittersByExecutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryManagerSplittersByExecutorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ManagerSplittersByExecutor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/ManagerSplittersByExecutor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ManagerSplittersByExecutor(ctx, req.(*QueryManagerSplittersByExecutorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ManagerSplitterByCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryManagerSplitterByCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ManagerSplitterByCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/ManagerSplitterByCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ManagerSplitterByCollection(ctx, req.(*QueryManagerSplitterByCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Query/ExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionLogs(ctx, req.(*QueryExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "managersplitter.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ManagerSplitter",
+			Handler:    _Query_ManagerSplitter_Handler,
+		},
+		{
+			MethodName: "AllManagerSplitters",
+			Handler:    _Query_AllManagerSplitters_Handler,
+		},
+		{
+			MethodName: "QueuedExecution",
+			Handler:    _Query_QueuedExecution_Handler,
+		},
+		{
+			MethodName: "QueuedExecutions",
+			Handler:    _Query_QueuedExecutions_Handler,
+		},
+		{
+			MethodName: "ManagerSplittersByAdmin",
+			Handler:    _Query_ManagerSplittersByAdmin_Handler,
+		},
+		{
+			MethodName: "ManagerSplittersByExecutor",
+			Handler:    _Query_ManagerSplittersByExecutor_Handler,
+		},
+		{
+			MethodName: "ManagerSplitterByCollection",
+			Handler:    _Query_ManagerSplitterByCollection_Handler,
+		},
+		{
+			MethodName: "ExecutionLogs",
+			Handler:    _Query_ExecutionLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "managersplitter/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetManagerSplitterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetManagerSplitterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetManagerSplitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ManagerSplitter != nil {
+		{
+			size, err := m.ManagerSplitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllManagerSplittersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllManagerSplittersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllManagerSplittersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllManagerSplittersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllManagerSplittersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllManagerSplittersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ManagerSplitters) > 0 {
+		for iNdEx := len(m.ManagerSplitters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagerSplitters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQueuedExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQueuedExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQueuedExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQueuedExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQueuedExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQueuedExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedExecution != nil {
+		{
+			size, err := m.QueuedExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedExecutions) > 0 {
+		for iNdEx := len(m.QueuedExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagerSplittersByAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagerSplittersByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagerSplittersByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagerSplittersByAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagerSplittersByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagerSplittersByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ManagerSplitters) > 0 {
+		for iNdEx := len(m.ManagerSplitters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagerSplitters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagerSplittersByExecutorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagerSplittersByExecutorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagerSplittersByExecutorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagerSplittersByExecutorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagerSplittersByExecutorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagerSplittersByExecutorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ManagerSplitters) > 0 {
+		for iNdEx := len(m.ManagerSplitters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagerSplitters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagerSplitterByCollectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagerSplitterByCollectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagerSplitterByCollectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryManagerSplitterByCollectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryManagerSplitterByCollectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryManagerSplitterByCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ManagerSplitter != nil {
+		{
+			size, err := m.ManagerSplitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutionLogs) > 0 {
+		for iNdEx := len(m.ExecutionLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetManagerSplitterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetManagerSplitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ManagerSplitter != nil {
+		l = m.ManagerSplitter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllManagerSplittersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllManagerSplittersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ManagerSplitters) > 0 {
+		for _, e := range m.ManagerSplitters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetQueuedExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetQueuedExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueuedExecution != nil {
+		l = m.QueuedExecution.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedExecutions) > 0 {
+		for _, e := range m.QueuedExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagerSplittersByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagerSplittersByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ManagerSplitters) > 0 {
+		for _, e := range m.ManagerSplitters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagerSplittersByExecutorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagerSplittersByExecutorResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -794,20 +2303,1233 @@ func (m *QueryAllManagerSplittersResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagerSplitterByCollectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryManagerSplitterByCollectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ManagerSplitter != nil {
+		l = m.ManagerSplitter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutionLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutionLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExecutionLogs) > 0 {
+		for _, e := range m.ExecutionLogs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetManagerSplitterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetManagerSplitterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetManagerSplitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetManagerSplitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetManagerSplitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagerSplitter == nil {
+				m.ManagerSplitter = &ManagerSplitter{}
+			}
+			if err := m.ManagerSplitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllManagerSplittersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllManagerSplittersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllManagerSplittersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllManagerSplittersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllManagerSplittersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllManagerSplittersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSplitters = append(m.ManagerSplitters, &ManagerSplitter{})
+			if err := m.ManagerSplitters[len(m.ManagerSplitters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetQueuedExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetQueuedExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetQueuedExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetQueuedExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetQueuedExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetQueuedExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedExecution == nil {
+				m.QueuedExecution = &QueuedExecution{}
+			}
+			if err := m.QueuedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedExecutionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSplitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedExecutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedExecutions = append(m.QueuedExecutions, &QueuedExecution{})
+			if err := m.QueuedExecutions[len(m.QueuedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryManagerSplittersByAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryManagerSplittersByAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryManagerSplittersByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryManagerSplittersByAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryManagerSplittersByAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryManagerSplittersByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSplitters = append(m.ManagerSplitters, &ManagerSplitter{})
+			if err := m.ManagerSplitters[len(m.ManagerSplitters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryManagerSplittersByExecutorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,12 +3552,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagerSplittersByExecutorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagerSplittersByExecutorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -857,7 +3647,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryManagerSplittersByExecutorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -880,15 +3670,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagerSplittersByExecutorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagerSplittersByExecutorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -915,7 +3705,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ManagerSplitters = append(m.ManagerSplitters, &ManagerSplitter{})
+			if err := m.ManagerSplitters[len(m.ManagerSplitters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -940,7 +3767,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetManagerSplitterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryManagerSplitterByCollectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,15 +3790,15 @@ func (m *QueryGetManagerSplitterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetManagerSplitterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagerSplitterByCollectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetManagerSplitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagerSplitterByCollectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -999,7 +3826,7 @@ func (m *QueryGetManagerSplitterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1022,7 +3849,7 @@ func (m *QueryGetManagerSplitterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetManagerSplitterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryManagerSplitterByCollectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,10 +3872,10 @@ func (m *QueryGetManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetManagerSplitterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryManagerSplitterByCollectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryManagerSplitterByCollectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1108,7 +3935,7 @@ func (m *QueryGetManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllManagerSplittersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExecutionLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1131,13 +3958,45 @@ func (m *QueryAllManagerSplittersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllManagerSplittersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExecutionLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllManagerSplittersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExecutionLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSplitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1194,7 +4053,7 @@ func (m *QueryAllManagerSplittersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllManagerSplittersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExecutionLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,15 +4076,15 @@ func (m *QueryAllManagerSplittersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllManagerSplittersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExecutionLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllManagerSplittersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExecutionLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionLogs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1252,8 +4111,8 @@ func (m *QueryAllManagerSplittersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagerSplitters = append(m.ManagerSplitters, &ManagerSplitter{})
-			if err := m.ManagerSplitters[len(m.ManagerSplitters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecutionLogs = append(m.ExecutionLogs, &ExecutionLogEntry{})
+			if err := m.ExecutionLogs[len(m.ExecutionLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -358,6 +358,12 @@ func (m *MsgUpdateManagerSplitter) GetPermissions() *ManagerSplitterPermissions
 
 // MsgUpdateManagerSplitterResponse is the response to MsgUpdateManagerSplitter.
 type MsgUpdateManagerSplitterResponse struct {
+	// Whether the update was queued due to a timelock instead of being applied immediately.
+	Queued bool `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// ID of the queued execution. Zero if the update was applied immediately.
+	QueuedExecutionId Uint `protobuf:"bytes,2,opt,name=queuedExecutionId,proto3,customtype=Uint" json:"queuedExecutionId"`
+	// Time (UNIX milliseconds) at which the queued update becomes executable. Zero if not queued.
+	ExecutableAt Uint `protobuf:"bytes,3,opt,name=executableAt,proto3,customtype=Uint" json:"executableAt"`
 }
 
 func (m *MsgUpdateManagerSplitterResponse) Reset()         { *m = MsgUpdateManagerSplitterResponse{} }
@@ -393,6 +399,13 @@ func (m *MsgUpdateManagerSplitterResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateManagerSplitterResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateManagerSplitterResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
 // MsgDeleteManagerSplitter deletes a manager splitter entity.
 type MsgDeleteManagerSplitter struct {
 	// Admin address deleting the entity.
@@ -554,6 +567,12 @@ func (m *MsgExecuteUniversalUpdateCollection) GetUniversalUpdateCollectionMsg()
 type MsgExecuteUniversalUpdateCollectionResponse struct {
 	// ID of the collection that was updated.
 	CollectionId Uint `protobuf:"bytes,1,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+	// Whether the execution was queued due to a timelock instead of being applied immediately.
+	Queued bool `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	// ID of the queued execution. Zero if the execution was applied immediately.
+	QueuedExecutionId Uint `protobuf:"bytes,3,opt,name=queuedExecutionId,proto3,customtype=Uint" json:"queuedExecutionId"`
+	// Time (UNIX milliseconds) at which the queued execution becomes executable. Zero if not queued.
+	ExecutableAt Uint `protobuf:"bytes,4,opt,name=executableAt,proto3,customtype=Uint" json:"executableAt"`
 }
 
 func (m *MsgExecuteUniversalUpdateCollectionResponse) Reset() {
@@ -593,26 +612,49 @@ func (m *MsgExecuteUniversalUpdateCollectionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecuteUniversalUpdateCollectionResponse proto.InternalMessageInfo
 
-// Used for WASM bindings and JSON parsing
-type ManagersplitterCustomMsgType struct {
-	CreateManagerSplitterMsg            *MsgCreateManagerSplitter            `protobuf:"bytes,1,opt,name=createManagerSplitterMsg,proto3" json:"createManagerSplitterMsg,omitempty"`
-	UpdateManagerSplitterMsg            *MsgUpdateManagerSplitter            `protobuf:"bytes,2,opt,name=updateManagerSplitterMsg,proto3" json:"updateManagerSplitterMsg,omitempty"`
-	DeleteManagerSplitterMsg            *MsgDeleteManagerSplitter            `protobuf:"bytes,3,opt,name=deleteManagerSplitterMsg,proto3" json:"deleteManagerSplitterMsg,omitempty"`
-	ExecuteUniversalUpdateCollectionMsg *MsgExecuteUniversalUpdateCollection `protobuf:"bytes,4,opt,name=executeUniversalUpdateCollectionMsg,proto3" json:"executeUniversalUpdateCollectionMsg,omitempty"`
+func (m *MsgExecuteUniversalUpdateCollectionResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
 }
 
-func (m *ManagersplitterCustomMsgType) Reset()         { *m = ManagersplitterCustomMsgType{} }
-func (m *ManagersplitterCustomMsgType) String() string { return proto.CompactTextString(m) }
-func (*ManagersplitterCustomMsgType) ProtoMessage()    {}
-func (*ManagersplitterCustomMsgType) Descriptor() ([]byte, []int) {
+// QueuedExecution is a timelocked execution waiting to be applied.
+type QueuedExecution struct {
+	// Unique ID of the queued execution.
+	Id Uint `protobuf:"bytes,1,opt,name=id,proto3,customtype=Uint" json:"id"`
+	// Address of the manager splitter the execution was submitted through.
+	ManagerSplitterAddress string `protobuf:"bytes,2,opt,name=managerSplitterAddress,proto3" json:"managerSplitterAddress,omitempty"`
+	// Address that submitted the execution.
+	Executor string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	// The UniversalUpdateCollection message to execute once the timelock has passed.
+	UniversalUpdateCollectionMsg *types.MsgUniversalUpdateCollection `protobuf:"bytes,4,opt,name=universalUpdateCollectionMsg,proto3" json:"universalUpdateCollectionMsg,omitempty"`
+	// Time (UNIX milliseconds) at which the execution was queued.
+	QueuedAt Uint `protobuf:"bytes,5,opt,name=queuedAt,proto3,customtype=Uint" json:"queuedAt"`
+	// Time (UNIX milliseconds) at which the execution becomes executable.
+	ExecutableAt Uint `protobuf:"bytes,6,opt,name=executableAt,proto3,customtype=Uint" json:"executableAt"`
+	// The whitelisted messages to execute once the timelock has passed (queued via MsgExecute).
+	// Exactly one of universalUpdateCollectionMsg, msgs or permissions is set.
+	Msgs []*ExecutableMsg `protobuf:"bytes,7,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// The permissions to set on the manager splitter once the timelock has passed (queued via MsgUpdateManagerSplitter).
+	Permissions *ManagerSplitterPermissions `protobuf:"bytes,8,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	// The permissions of the manager splitter when the update was queued (queued via MsgUpdateManagerSplitter).
+	// The update is rejected if the permissions changed in the meantime, so it never reverts a later change.
+	PreviousPermissions *ManagerSplitterPermissions `protobuf:"bytes,9,opt,name=previousPermissions,proto3" json:"previousPermissions,omitempty"`
+}
+
+func (m *QueuedExecution) Reset()         { *m = QueuedExecution{} }
+func (m *QueuedExecution) String() string { return proto.CompactTextString(m) }
+func (*QueuedExecution) ProtoMessage()    {}
+func (*QueuedExecution) Descriptor() ([]byte, []int) {
 	return fileDescriptor_53c122f86b47cda5, []int{11}
 }
-func (m *ManagersplitterCustomMsgType) XXX_Unmarshal(b []byte) error {
+func (m *QueuedExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ManagersplitterCustomMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueuedExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ManagersplitterCustomMsgType.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueuedExecution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -622,1018 +664,3929 @@ func (m *ManagersplitterCustomMsgType) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ManagersplitterCustomMsgType) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ManagersplitterCustomMsgType.Merge(m, src)
+func (m *QueuedExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedExecution.Merge(m, src)
 }
-func (m *ManagersplitterCustomMsgType) XXX_Size() int {
+func (m *QueuedExecution) XXX_Size() int {
 	return m.Size()
 }
-func (m *ManagersplitterCustomMsgType) XXX_DiscardUnknown() {
-	xxx_messageInfo_ManagersplitterCustomMsgType.DiscardUnknown(m)
+func (m *QueuedExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedExecution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ManagersplitterCustomMsgType proto.InternalMessageInfo
+var xxx_messageInfo_QueuedExecution proto.InternalMessageInfo
 
-func (m *ManagersplitterCustomMsgType) GetCreateManagerSplitterMsg() *MsgCreateManagerSplitter {
+func (m *QueuedExecution) GetManagerSplitterAddress() string {
 	if m != nil {
-		return m.CreateManagerSplitterMsg
+		return m.ManagerSplitterAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *ManagersplitterCustomMsgType) GetUpdateManagerSplitterMsg() *MsgUpdateManagerSplitter {
+func (m *QueuedExecution) GetExecutor() string {
 	if m != nil {
-		return m.UpdateManagerSplitterMsg
+		return m.Executor
 	}
-	return nil
+	return ""
 }
 
-func (m *ManagersplitterCustomMsgType) GetDeleteManagerSplitterMsg() *MsgDeleteManagerSplitter {
+func (m *QueuedExecution) GetUniversalUpdateCollectionMsg() *types.MsgUniversalUpdateCollection {
 	if m != nil {
-		return m.DeleteManagerSplitterMsg
+		return m.UniversalUpdateCollectionMsg
 	}
 	return nil
 }
 
-func (m *ManagersplitterCustomMsgType) GetExecuteUniversalUpdateCollectionMsg() *MsgExecuteUniversalUpdateCollection {
+func (m *QueuedExecution) GetMsgs() []*ExecutableMsg {
 	if m != nil {
-		return m.ExecuteUniversalUpdateCollectionMsg
+		return m.Msgs
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "managersplitter.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "managersplitter.MsgUpdateParamsResponse")
-	proto.RegisterType((*ManagerSplitter)(nil), "managersplitter.ManagerSplitter")
-	proto.RegisterType((*MsgCreateManagerSplitter)(nil), "managersplitter.MsgCreateManagerSplitter")
-	proto.RegisterType((*MsgCreateManagerSplitterResponse)(nil), "managersplitter.MsgCreateManagerSplitterResponse")
-	proto.RegisterType((*MsgUpdateManagerSplitter)(nil), "managersplitter.MsgUpdateManagerSplitter")
-	proto.RegisterType((*MsgUpdateManagerSplitterResponse)(nil), "managersplitter.MsgUpdateManagerSplitterResponse")
-	proto.RegisterType((*MsgDeleteManagerSplitter)(nil), "managersplitter.MsgDeleteManagerSplitter")
-	proto.RegisterType((*MsgDeleteManagerSplitterResponse)(nil), "managersplitter.MsgDeleteManagerSplitterResponse")
-	proto.RegisterType((*MsgExecuteUniversalUpdateCollection)(nil), "managersplitter.MsgExecuteUniversalUpdateCollection")
-	proto.RegisterType((*MsgExecuteUniversalUpdateCollectionResponse)(nil), "managersplitter.MsgExecuteUniversalUpdateCollectionResponse")
-	proto.RegisterType((*ManagersplitterCustomMsgType)(nil), "managersplitter.ManagersplitterCustomMsgType")
+func (m *QueuedExecution) GetPermissions() *ManagerSplitterPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("managersplitter/tx.proto", fileDescriptor_53c122f86b47cda5) }
-
-var fileDescriptor_53c122f86b47cda5 = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3f, 0x4f, 0xdb, 0x40,
-	0x14, 0x8f, 0xc3, 0x9f, 0x36, 0x07, 0x12, 0xaa, 0x05, 0xc5, 0x58, 0x28, 0xa4, 0x46, 0x95, 0x68,
-	0x50, 0x63, 0xa0, 0x88, 0x21, 0x65, 0x21, 0xb4, 0x43, 0x87, 0x48, 0xc8, 0x34, 0x0b, 0x0b, 0xba,
-	0x24, 0x27, 0x73, 0x6a, 0xec, 0x8b, 0x7c, 0x17, 0x04, 0x9d, 0x50, 0x87, 0x0e, 0x9d, 0x98, 0xfa,
-	0x19, 0xba, 0x54, 0x62, 0x68, 0x87, 0x7e, 0x03, 0xb6, 0xa2, 0x4e, 0x55, 0x55, 0xa1, 0x0a, 0xa4,
-	0xf2, 0x35, 0xaa, 0xf3, 0x39, 0x4e, 0xe2, 0x9c, 0x13, 0x0b, 0xba, 0x24, 0xbe, 0x7b, 0xf7, 0xde,
-	0xef, 0xf7, 0x7e, 0xef, 0xdd, 0xb3, 0x81, 0xe6, 0x40, 0x17, 0xda, 0xc8, 0xa3, 0xcd, 0x06, 0x66,
-	0x0c, 0x79, 0x26, 0x3b, 0x2a, 0x34, 0x3d, 0xc2, 0x88, 0x3a, 0x15, 0xb1, 0xe8, 0x0f, 0xa0, 0x83,
-	0x5d, 0x62, 0xfa, 0xbf, 0xe2, 0x8c, 0x3e, 0x5b, 0x23, 0xd4, 0x21, 0xd4, 0x74, 0xa8, 0x6d, 0x1e,
-	0xae, 0xf2, 0xbf, 0xc0, 0x30, 0x27, 0x0c, 0xfb, 0xfe, 0xca, 0x14, 0x8b, 0xc0, 0x34, 0x6d, 0x13,
-	0x9b, 0x88, 0x7d, 0xfe, 0x14, 0xec, 0x3e, 0x8a, 0xf2, 0x68, 0x22, 0xcf, 0xc1, 0x94, 0x62, 0xe2,
-	0xb6, 0x1d, 0xe7, 0xfb, 0x8e, 0x40, 0x0f, 0x3a, 0x6d, 0xeb, 0x0c, 0x23, 0x6f, 0x90, 0x8b, 0xdf,
-	0x42, 0x86, 0x89, 0x1b, 0x66, 0xa1, 0x67, 0x7b, 0xb6, 0xfb, 0x82, 0x1a, 0xdf, 0x14, 0x30, 0x55,
-	0xa6, 0x76, 0xa5, 0x59, 0x87, 0x0c, 0xed, 0xf8, 0x01, 0xd5, 0x0d, 0x90, 0x81, 0x2d, 0x76, 0x40,
-	0x3c, 0xcc, 0x8e, 0x35, 0x25, 0xa7, 0x2c, 0x65, 0x4a, 0xda, 0x8f, 0x2f, 0x4f, 0xa7, 0x83, 0x34,
-	0xb6, 0xea, 0x75, 0x0f, 0x51, 0xba, 0xcb, 0x3c, 0xec, 0xda, 0x56, 0xe7, 0xa8, 0x5a, 0x04, 0xe3,
-	0x82, 0x92, 0x96, 0xce, 0x29, 0x4b, 0x13, 0x6b, 0xb3, 0x85, 0x08, 0xe3, 0x82, 0x00, 0x28, 0x65,
-	0xce, 0x2f, 0x17, 0x52, 0x9f, 0x6e, 0xce, 0xf2, 0x8a, 0x15, 0x78, 0x14, 0xd7, 0xde, 0xdd, 0x9c,
-	0xe5, 0x3b, 0xb1, 0x3e, 0xdc, 0x9c, 0xe5, 0x17, 0xa2, 0xf9, 0x46, 0x78, 0x1a, 0x73, 0x60, 0x36,
-	0xb2, 0x65, 0x21, 0xda, 0x24, 0x2e, 0x45, 0xc6, 0x29, 0x4f, 0x4b, 0xb8, 0xef, 0x06, 0xee, 0xaa,
-	0x06, 0xee, 0x41, 0x41, 0x5d, 0x24, 0x65, 0xb5, 0x97, 0xea, 0x34, 0x18, 0x83, 0x75, 0x07, 0xbb,
-	0x3e, 0xef, 0x8c, 0x25, 0x16, 0x6a, 0x19, 0x4c, 0x74, 0xe9, 0xa5, 0x8d, 0xf8, 0x39, 0x2d, 0xf7,
-	0xe5, 0x14, 0x81, 0xd9, 0xe9, 0xb8, 0x58, 0xdd, 0xfe, 0xc6, 0x57, 0x05, 0x68, 0x65, 0x6a, 0x6f,
-	0x7b, 0x08, 0x32, 0x14, 0xe5, 0x16, 0x32, 0x50, 0x06, 0x30, 0x48, 0xdf, 0x8d, 0x41, 0x71, 0x9d,
-	0x6b, 0x2c, 0x42, 0x73, 0x7d, 0x1f, 0x47, 0xf5, 0x95, 0x52, 0x33, 0x36, 0x41, 0x2e, 0x8e, 0x76,
-	0x5b, 0xee, 0x78, 0x69, 0x8d, 0xef, 0x22, 0x6b, 0x51, 0xa4, 0x64, 0x59, 0x77, 0x05, 0x4b, 0xf7,
-	0xd6, 0xe9, 0xff, 0x56, 0x64, 0xa8, 0x1e, 0x52, 0xd2, 0x86, 0xe1, 0xeb, 0x21, 0xb5, 0x85, 0xed,
-	0x77, 0x22, 0xb2, 0x7e, 0x81, 0x1a, 0xe8, 0xce, 0x59, 0x0f, 0xa5, 0x29, 0x45, 0x09, 0x68, 0x4a,
-	0x6d, 0x21, 0xcd, 0xcf, 0x69, 0xb0, 0x58, 0xa6, 0xf6, 0xcb, 0x23, 0x54, 0x6b, 0x31, 0x54, 0x71,
-	0xf1, 0x21, 0xf2, 0x28, 0x6c, 0x88, 0xdc, 0xb6, 0x49, 0xa3, 0x81, 0x6a, 0x7c, 0x6c, 0xa8, 0x3a,
-	0xb8, 0x8f, 0xfc, 0x33, 0xc4, 0x0b, 0x48, 0x87, 0x6b, 0x75, 0x03, 0x3c, 0x74, 0x7a, 0xc3, 0x6f,
-	0xf5, 0xa4, 0x11, 0x63, 0x55, 0x5d, 0x30, 0xdf, 0x8a, 0x03, 0x2c, 0x53, 0x3b, 0x28, 0x6e, 0xbe,
-	0xd0, 0x3d, 0xbf, 0x0a, 0x5c, 0xf8, 0x38, 0x27, 0x6b, 0x60, 0xbc, 0x62, 0x89, 0xab, 0x18, 0xd2,
-	0xe6, 0x42, 0xae, 0x44, 0x85, 0x1c, 0xa6, 0x83, 0xb1, 0x0f, 0x96, 0x13, 0xc8, 0x15, 0xde, 0x8a,
-	0x15, 0x30, 0x59, 0x0b, 0x77, 0x5f, 0xd5, 0x83, 0x51, 0x3a, 0xc9, 0x87, 0xdf, 0xaf, 0xcb, 0x85,
-	0xd1, 0x0a, 0x76, 0x99, 0xd5, 0x73, 0xc2, 0xf8, 0x3d, 0x02, 0xe6, 0xcb, 0xbd, 0xac, 0xb6, 0x5b,
-	0x94, 0x11, 0xa7, 0x4c, 0xed, 0xd7, 0xc7, 0x4d, 0xa4, 0x22, 0xa0, 0xd5, 0x64, 0x37, 0x91, 0x2b,
-	0xa6, 0xf8, 0x8a, 0x3d, 0xe9, 0xbf, 0x0e, 0x71, 0xb7, 0x37, 0x36, 0x14, 0x87, 0x69, 0xc9, 0x1a,
-	0x9c, 0xc3, 0xa4, 0xe3, 0x61, 0xe4, 0x97, 0x22, 0x36, 0x14, 0x87, 0xa9, 0xcb, 0x1a, 0xb4, 0x53,
-	0x7f, 0x29, 0x8c, 0xbc, 0xa9, 0x63, 0x43, 0xa9, 0xef, 0x15, 0xb0, 0x88, 0x86, 0x14, 0x8d, 0x43,
-	0x8e, 0xfa, 0x90, 0xeb, 0x32, 0xc8, 0xa1, 0x35, 0x4f, 0x02, 0xb0, 0xf6, 0x77, 0x14, 0x8c, 0x70,
-	0x42, 0x7b, 0x60, 0xb2, 0xe7, 0x85, 0x9b, 0x8b, 0x17, 0x53, 0x9c, 0xd0, 0x97, 0x86, 0x9d, 0x08,
-	0x9b, 0xae, 0x05, 0x66, 0xe4, 0xaf, 0x98, 0xe4, 0x8d, 0xa1, 0xaf, 0x26, 0xef, 0xa1, 0x2e, 0x58,
-	0xf9, 0x8c, 0x4f, 0xde, 0x28, 0x72, 0xd8, 0x81, 0x83, 0x96, 0xc3, 0xca, 0x87, 0x6c, 0xf2, 0xc6,
-	0x91, 0xc3, 0x0e, 0x1c, 0x9c, 0xea, 0x47, 0x05, 0xe4, 0x86, 0x4e, 0xcd, 0x5b, 0x35, 0x92, 0xbe,
-	0x79, 0xab, 0xf6, 0x0b, 0x88, 0xe9, 0x63, 0x27, 0xfc, 0xab, 0xaa, 0x54, 0x39, 0xbf, 0xca, 0x2a,
-	0x17, 0x57, 0x59, 0xe5, 0xcf, 0x55, 0x56, 0x39, 0xbd, 0xce, 0xa6, 0x2e, 0xae, 0xb3, 0xa9, 0x9f,
-	0xd7, 0xd9, 0xd4, 0xde, 0x73, 0x1b, 0xb3, 0x83, 0x56, 0xb5, 0x50, 0x23, 0x8e, 0x59, 0xc5, 0xac,
-	0x0a, 0xeb, 0x36, 0xa2, 0x9d, 0xa7, 0xda, 0x01, 0xc4, 0xae, 0x79, 0x64, 0xf6, 0x7d, 0x15, 0x1f,
-	0x37, 0x11, 0xad, 0x8e, 0xfb, 0xdf, 0x8c, 0xcf, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x33, 0x86,
-	0x14, 0xf3, 0x35, 0x0b, 0x00, 0x00,
+func (m *QueuedExecution) GetPreviousPermissions() *ManagerSplitterPermissions {
+	if m != nil {
+		return m.PreviousPermissions
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// ExecutionLogEntry records a successful execution through a manager splitter.
+type ExecutionLogEntry struct {
+	// Unique ID of the log entry.
+	Id Uint `protobuf:"bytes,1,opt,name=id,proto3,customtype=Uint" json:"id"`
+	// Address of the manager splitter the execution went through.
+	ManagerSplitterAddress string `protobuf:"bytes,2,opt,name=managerSplitterAddress,proto3" json:"managerSplitterAddress,omitempty"`
+	// Address whose permissions were exercised (the original executor for queued executions).
+	Executor string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	// Block height at which the execution was applied.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	// Names of the permissions exercised by the execution (e.g. canUpdateCustomData).
+	PermissionsExercised []string `protobuf:"bytes,5,rep,name=permissionsExercised,proto3" json:"permissionsExercised,omitempty"`
+	// ID of the collection that was updated or transferred from (zero if not applicable).
+	// Executions of multiple executable messages log one entry per message.
+	CollectionId Uint `protobuf:"bytes,6,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+	// ID of the queued execution that was applied (zero if executed immediately).
+	QueuedExecutionId Uint `protobuf:"bytes,7,opt,name=queuedExecutionId,proto3,customtype=Uint" json:"queuedExecutionId"`
+	// Time (UNIX milliseconds) at which the execution was applied.
+	ExecutedAt Uint `protobuf:"bytes,8,opt,name=executedAt,proto3,customtype=Uint" json:"executedAt"`
+}
+
+func (m *ExecutionLogEntry) Reset()         { *m = ExecutionLogEntry{} }
+func (m *ExecutionLogEntry) String() string { return proto.CompactTextString(m) }
+func (*ExecutionLogEntry) ProtoMessage()    {}
+func (*ExecutionLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{12}
+}
+func (m *ExecutionLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionLogEntry.Merge(m, src)
+}
+func (m *ExecutionLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionLogEntry.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ExecutionLogEntry proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreateManagerSplitter(ctx context.Context, in *MsgCreateManagerSplitter, opts ...grpc.CallOption) (*MsgCreateManagerSplitterResponse, error)
-	UpdateManagerSplitter(ctx context.Context, in *MsgUpdateManagerSplitter, opts ...grpc.CallOption) (*MsgUpdateManagerSplitterResponse, error)
-	DeleteManagerSplitter(ctx context.Context, in *MsgDeleteManagerSplitter, opts ...grpc.CallOption) (*MsgDeleteManagerSplitterResponse, error)
-	ExecuteUniversalUpdateCollection(ctx context.Context, in *MsgExecuteUniversalUpdateCollection, opts ...grpc.CallOption) (*MsgExecuteUniversalUpdateCollectionResponse, error)
+func (m *ExecutionLogEntry) GetManagerSplitterAddress() string {
+	if m != nil {
+		return m.ManagerSplitterAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *ExecutionLogEntry) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *ExecutionLogEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExecutionLogEntry) GetPermissionsExercised() []string {
+	if m != nil {
+		return m.PermissionsExercised
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CreateManagerSplitter(ctx context.Context, in *MsgCreateManagerSplitter, opts ...grpc.CallOption) (*MsgCreateManagerSplitterResponse, error) {
-	out := new(MsgCreateManagerSplitterResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Msg/CreateManagerSplitter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgExecuteQueuedExecution applies a queued execution whose timelock has passed.
+type MsgExecuteQueuedExecution struct {
+	// Address executing the queued execution (must be the original executor or admin).
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// Address of the manager splitter the execution was queued through.
+	ManagerSplitterAddress string `protobuf:"bytes,2,opt,name=managerSplitterAddress,proto3" json:"managerSplitterAddress,omitempty"`
+	// ID of the queued execution.
+	QueuedExecutionId Uint `protobuf:"bytes,3,opt,name=queuedExecutionId,proto3,customtype=Uint" json:"queuedExecutionId"`
 }
 
-func (c *msgClient) UpdateManagerSplitter(ctx context.Context, in *MsgUpdateManagerSplitter, opts ...grpc.CallOption) (*MsgUpdateManagerSplitterResponse, error) {
-	out := new(MsgUpdateManagerSplitterResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Msg/UpdateManagerSplitter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgExecuteQueuedExecution) Reset()         { *m = MsgExecuteQueuedExecution{} }
+func (m *MsgExecuteQueuedExecution) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteQueuedExecution) ProtoMessage()    {}
+func (*MsgExecuteQueuedExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{13}
+}
+func (m *MsgExecuteQueuedExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteQueuedExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteQueuedExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgExecuteQueuedExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteQueuedExecution.Merge(m, src)
+}
+func (m *MsgExecuteQueuedExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteQueuedExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteQueuedExecution.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteManagerSplitter(ctx context.Context, in *MsgDeleteManagerSplitter, opts ...grpc.CallOption) (*MsgDeleteManagerSplitterResponse, error) {
-	out := new(MsgDeleteManagerSplitterResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Msg/DeleteManagerSplitter", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExecuteQueuedExecution proto.InternalMessageInfo
+
+func (m *MsgExecuteQueuedExecution) GetExecutor() string {
+	if m != nil {
+		return m.Executor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ExecuteUniversalUpdateCollection(ctx context.Context, in *MsgExecuteUniversalUpdateCollection, opts ...grpc.CallOption) (*MsgExecuteUniversalUpdateCollectionResponse, error) {
-	out := new(MsgExecuteUniversalUpdateCollectionResponse)
-	err := c.cc.Invoke(ctx, "/managersplitter.Msg/ExecuteUniversalUpdateCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgExecuteQueuedExecution) GetManagerSplitterAddress() string {
+	if m != nil {
+		return m.ManagerSplitterAddress
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreateManagerSplitter(context.Context, *MsgCreateManagerSplitter) (*MsgCreateManagerSplitterResponse, error)
-	UpdateManagerSplitter(context.Context, *MsgUpdateManagerSplitter) (*MsgUpdateManagerSplitterResponse, error)
-	DeleteManagerSplitter(context.Context, *MsgDeleteManagerSplitter) (*MsgDeleteManagerSplitterResponse, error)
-	ExecuteUniversalUpdateCollection(context.Context, *MsgExecuteUniversalUpdateCollection) (*MsgExecuteUniversalUpdateCollectionResponse, error)
+// MsgExecuteQueuedExecutionResponse is the response to MsgExecuteQueuedExecution.
+type MsgExecuteQueuedExecutionResponse struct {
+	// ID of the collection that was updated.
+	CollectionId Uint `protobuf:"bytes,1,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgExecuteQueuedExecutionResponse) Reset()         { *m = MsgExecuteQueuedExecutionResponse{} }
+func (m *MsgExecuteQueuedExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteQueuedExecutionResponse) ProtoMessage()    {}
+func (*MsgExecuteQueuedExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{14}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgExecuteQueuedExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateManagerSplitter(ctx context.Context, req *MsgCreateManagerSplitter) (*MsgCreateManagerSplitterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateManagerSplitter not implemented")
+func (m *MsgExecuteQueuedExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteQueuedExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateManagerSplitter(ctx context.Context, req *MsgUpdateManagerSplitter) (*MsgUpdateManagerSplitterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateManagerSplitter not implemented")
+func (m *MsgExecuteQueuedExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteQueuedExecutionResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteManagerSplitter(ctx context.Context, req *MsgDeleteManagerSplitter) (*MsgDeleteManagerSplitterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteManagerSplitter not implemented")
+func (m *MsgExecuteQueuedExecutionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ExecuteUniversalUpdateCollection(ctx context.Context, req *MsgExecuteUniversalUpdateCollection) (*MsgExecuteUniversalUpdateCollectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteUniversalUpdateCollection not implemented")
+func (m *MsgExecuteQueuedExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteQueuedExecutionResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgExecuteQueuedExecutionResponse proto.InternalMessageInfo
+
+// MsgCancelQueuedExecution cancels a queued execution. Only the admin can cancel.
+type MsgCancelQueuedExecution struct {
+	// Admin address of the manager splitter.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Address of the manager splitter the execution was queued through.
+	ManagerSplitterAddress string `protobuf:"bytes,2,opt,name=managerSplitterAddress,proto3" json:"managerSplitterAddress,omitempty"`
+	// ID of the queued execution to cancel.
+	QueuedExecutionId Uint `protobuf:"bytes,3,opt,name=queuedExecutionId,proto3,customtype=Uint" json:"queuedExecutionId"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgCancelQueuedExecution) Reset()         { *m = MsgCancelQueuedExecution{} }
+func (m *MsgCancelQueuedExecution) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedExecution) ProtoMessage()    {}
+func (*MsgCancelQueuedExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{15}
+}
+func (m *MsgCancelQueuedExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelQueuedExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedExecution.Merge(m, src)
+}
+func (m *MsgCancelQueuedExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedExecution.DiscardUnknown(m)
 }
 
-func _Msg_CreateManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateManagerSplitter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateManagerSplitter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Msg/CreateManagerSplitter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateManagerSplitter(ctx, req.(*MsgCreateManagerSplitter))
+var xxx_messageInfo_MsgCancelQueuedExecution proto.InternalMessageInfo
+
+func (m *MsgCancelQueuedExecution) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateManagerSplitter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateManagerSplitter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Msg/UpdateManagerSplitter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateManagerSplitter(ctx, req.(*MsgUpdateManagerSplitter))
+func (m *MsgCancelQueuedExecution) GetManagerSplitterAddress() string {
+	if m != nil {
+		return m.ManagerSplitterAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteManagerSplitter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteManagerSplitter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Msg/DeleteManagerSplitter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteManagerSplitter(ctx, req.(*MsgDeleteManagerSplitter))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelQueuedExecutionResponse is the response to MsgCancelQueuedExecution.
+type MsgCancelQueuedExecutionResponse struct {
 }
 
-func _Msg_ExecuteUniversalUpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteUniversalUpdateCollection)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteUniversalUpdateCollection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/managersplitter.Msg/ExecuteUniversalUpdateCollection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteUniversalUpdateCollection(ctx, req.(*MsgExecuteUniversalUpdateCollection))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCancelQueuedExecutionResponse) Reset()         { *m = MsgCancelQueuedExecutionResponse{} }
+func (m *MsgCancelQueuedExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedExecutionResponse) ProtoMessage()    {}
+func (*MsgCancelQueuedExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{16}
 }
-
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "managersplitter.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateManagerSplitter",
-			Handler:    _Msg_CreateManagerSplitter_Handler,
-		},
-		{
-			MethodName: "UpdateManagerSplitter",
-			Handler:    _Msg_UpdateManagerSplitter_Handler,
-		},
-		{
-			MethodName: "DeleteManagerSplitter",
-			Handler:    _Msg_DeleteManagerSplitter_Handler,
-		},
-		{
-			MethodName: "ExecuteUniversalUpdateCollection",
-			Handler:    _Msg_ExecuteUniversalUpdateCollection_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "managersplitter/tx.proto",
+func (m *MsgCancelQueuedExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelQueuedExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedExecutionResponse.Merge(m, src)
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedExecutionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelQueuedExecutionResponse proto.InternalMessageInfo
+
+// ExecutableMsg wraps a single whitelisted tokenization message that can be executed
+// as the manager splitter address. Exactly one field must be set.
+type ExecutableMsg struct {
+	// Requires canTransferTokens.
+	TransferTokensMsg *types.MsgTransferTokens `protobuf:"bytes,1,opt,name=transferTokensMsg,proto3" json:"transferTokensMsg,omitempty"`
+	// Requires canCreateAddressLists.
+	CreateAddressListsMsg *types.MsgCreateAddressLists `protobuf:"bytes,2,opt,name=createAddressListsMsg,proto3" json:"createAddressListsMsg,omitempty"`
+	// Requires canCreateDynamicStore.
+	CreateDynamicStoreMsg *types.MsgCreateDynamicStore `protobuf:"bytes,3,opt,name=createDynamicStoreMsg,proto3" json:"createDynamicStoreMsg,omitempty"`
+	// Requires canUpdateDynamicStore.
+	UpdateDynamicStoreMsg *types.MsgUpdateDynamicStore `protobuf:"bytes,4,opt,name=updateDynamicStoreMsg,proto3" json:"updateDynamicStoreMsg,omitempty"`
+	// Requires canDeleteDynamicStore.
+	DeleteDynamicStoreMsg *types.MsgDeleteDynamicStore `protobuf:"bytes,5,opt,name=deleteDynamicStoreMsg,proto3" json:"deleteDynamicStoreMsg,omitempty"`
+	// Requires canSetDynamicStoreValue.
+	SetDynamicStoreValueMsg *types.MsgSetDynamicStoreValue `protobuf:"bytes,6,opt,name=setDynamicStoreValueMsg,proto3" json:"setDynamicStoreValueMsg,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ExecutableMsg) Reset()         { *m = ExecutableMsg{} }
+func (m *ExecutableMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecutableMsg) ProtoMessage()    {}
+func (*ExecutableMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{17}
+}
+func (m *ExecutableMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutableMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutableMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ExecutableMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutableMsg.Merge(m, src)
+}
+func (m *ExecutableMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutableMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutableMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutableMsg proto.InternalMessageInfo
+
+func (m *ExecutableMsg) GetTransferTokensMsg() *types.MsgTransferTokens {
+	if m != nil {
+		return m.TransferTokensMsg
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExecutableMsg) GetCreateAddressListsMsg() *types.MsgCreateAddressLists {
+	if m != nil {
+		return m.CreateAddressListsMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecutableMsg) GetCreateDynamicStoreMsg() *types.MsgCreateDynamicStore {
+	if m != nil {
+		return m.CreateDynamicStoreMsg
+	}
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ExecutableMsg) GetUpdateDynamicStoreMsg() *types.MsgUpdateDynamicStore {
+	if m != nil {
+		return m.UpdateDynamicStoreMsg
+	}
+	return nil
 }
 
-func (m *ManagerSplitter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExecutableMsg) GetDeleteDynamicStoreMsg() *types.MsgDeleteDynamicStore {
+	if m != nil {
+		return m.DeleteDynamicStoreMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecutableMsg) GetSetDynamicStoreValueMsg() *types.MsgSetDynamicStoreValue {
+	if m != nil {
+		return m.SetDynamicStoreValueMsg
+	}
+	return nil
 }
 
-func (m *ManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permissions != nil {
-		{
-			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+// MsgExecute executes whitelisted tokenization messages through the manager splitter,
+// checking permissions before execution. Messages are signed as the manager splitter address.
+type MsgExecute struct {
+	// Address executing the messages (must be approved or admin).
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// Address of the manager splitter to execute through.
+	ManagerSplitterAddress string `protobuf:"bytes,2,opt,name=managerSplitterAddress,proto3" json:"managerSplitterAddress,omitempty"`
+	// The messages to execute, in order.
+	Msgs []*ExecutableMsg `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgExecute) Reset()         { *m = MsgExecute{} }
+func (m *MsgExecute) String() string { return proto.CompactTextString(m) }
+func (*MsgExecute) ProtoMessage()    {}
+func (*MsgExecute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{18}
+}
+func (m *MsgExecute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgExecute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecute.Merge(m, src)
+}
+func (m *MsgExecute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecute proto.InternalMessageInfo
+
+func (m *MsgExecute) GetExecutor() string {
+	if m != nil {
+		return m.Executor
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgExecute) GetManagerSplitterAddress() string {
+	if m != nil {
+		return m.ManagerSplitterAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateManagerSplitter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExecute) GetMsgs() []*ExecutableMsg {
+	if m != nil {
+		return m.Msgs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgExecuteResponse is the response to MsgExecute.
+type MsgExecuteResponse struct {
+	// Whether the execution was queued due to a timelock instead of being applied immediately.
+	Queued bool `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// ID of the queued execution. Zero if the execution was applied immediately.
+	QueuedExecutionId Uint `protobuf:"bytes,2,opt,name=queuedExecutionId,proto3,customtype=Uint" json:"queuedExecutionId"`
+	// Time (UNIX milliseconds) at which the queued execution becomes executable. Zero if not queued.
+	ExecutableAt Uint `protobuf:"bytes,3,opt,name=executableAt,proto3,customtype=Uint" json:"executableAt"`
 }
 
-func (m *MsgCreateManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permissions != nil {
-		{
-			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgExecuteResponse) Reset()         { *m = MsgExecuteResponse{} }
+func (m *MsgExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteResponse) ProtoMessage()    {}
+func (*MsgExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{19}
+}
+func (m *MsgExecuteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgExecuteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteResponse.Merge(m, src)
 }
-
-func (m *MsgCreateManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExecuteResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgExecuteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateManagerSplitter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExecuteResponse proto.InternalMessageInfo
+
+func (m *MsgExecuteResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Used for WASM bindings and JSON parsing
+type ManagersplitterCustomMsgType struct {
+	CreateManagerSplitterMsg            *MsgCreateManagerSplitter            `protobuf:"bytes,1,opt,name=createManagerSplitterMsg,proto3" json:"createManagerSplitterMsg,omitempty"`
+	UpdateManagerSplitterMsg            *MsgUpdateManagerSplitter            `protobuf:"bytes,2,opt,name=updateManagerSplitterMsg,proto3" json:"updateManagerSplitterMsg,omitempty"`
+	DeleteManagerSplitterMsg            *MsgDeleteManagerSplitter            `protobuf:"bytes,3,opt,name=deleteManagerSplitterMsg,proto3" json:"deleteManagerSplitterMsg,omitempty"`
+	ExecuteUniversalUpdateCollectionMsg *MsgExecuteUniversalUpdateCollection `protobuf:"bytes,4,opt,name=executeUniversalUpdateCollectionMsg,proto3" json:"executeUniversalUpdateCollectionMsg,omitempty"`
+	ExecuteQueuedExecutionMsg           *MsgExecuteQueuedExecution           `protobuf:"bytes,5,opt,name=executeQueuedExecutionMsg,proto3" json:"executeQueuedExecutionMsg,omitempty"`
+	CancelQueuedExecutionMsg            *MsgCancelQueuedExecution            `protobuf:"bytes,6,opt,name=cancelQueuedExecutionMsg,proto3" json:"cancelQueuedExecutionMsg,omitempty"`
+	ExecuteMsg                          *MsgExecute                          `protobuf:"bytes,7,opt,name=executeMsg,proto3" json:"executeMsg,omitempty"`
 }
 
-func (m *MsgUpdateManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permissions != nil {
-		{
-			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *ManagersplitterCustomMsgType) Reset()         { *m = ManagersplitterCustomMsgType{} }
+func (m *ManagersplitterCustomMsgType) String() string { return proto.CompactTextString(m) }
+func (*ManagersplitterCustomMsgType) ProtoMessage()    {}
+func (*ManagersplitterCustomMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53c122f86b47cda5, []int{20}
+}
+func (m *ManagersplitterCustomMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagersplitterCustomMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManagersplitterCustomMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ManagersplitterCustomMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagersplitterCustomMsgType.Merge(m, src)
+}
+func (m *ManagersplitterCustomMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagersplitterCustomMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagersplitterCustomMsgType.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ManagersplitterCustomMsgType proto.InternalMessageInfo
+
+func (m *ManagersplitterCustomMsgType) GetCreateManagerSplitterMsg() *MsgCreateManagerSplitter {
+	if m != nil {
+		return m.CreateManagerSplitterMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ManagersplitterCustomMsgType) GetUpdateManagerSplitterMsg() *MsgUpdateManagerSplitter {
+	if m != nil {
+		return m.UpdateManagerSplitterMsg
+	}
+	return nil
 }
 
-func (m *MsgUpdateManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ManagersplitterCustomMsgType) GetDeleteManagerSplitterMsg() *MsgDeleteManagerSplitter {
+	if m != nil {
+		return m.DeleteManagerSplitterMsg
+	}
+	return nil
 }
 
-func (m *MsgDeleteManagerSplitter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManagersplitterCustomMsgType) GetExecuteUniversalUpdateCollectionMsg() *MsgExecuteUniversalUpdateCollection {
+	if m != nil {
+		return m.ExecuteUniversalUpdateCollectionMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ManagersplitterCustomMsgType) GetExecuteQueuedExecutionMsg() *MsgExecuteQueuedExecution {
+	if m != nil {
+		return m.ExecuteQueuedExecutionMsg
+	}
+	return nil
 }
 
-func (m *MsgDeleteManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *ManagersplitterCustomMsgType) GetCancelQueuedExecutionMsg() *MsgCancelQueuedExecution {
+	if m != nil {
+		return m.CancelQueuedExecutionMsg
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDeleteManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManagersplitterCustomMsgType) GetExecuteMsg() *MsgExecute {
+	if m != nil {
+		return m.ExecuteMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "managersplitter.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "managersplitter.MsgUpdateParamsResponse")
+	proto.RegisterType((*ManagerSplitter)(nil), "managersplitter.ManagerSplitter")
+	proto.RegisterType((*MsgCreateManagerSplitter)(nil), "managersplitter.MsgCreateManagerSplitter")
+	proto.RegisterType((*MsgCreateManagerSplitterResponse)(nil), "managersplitter.MsgCreateManagerSplitterResponse")
+	proto.RegisterType((*MsgUpdateManagerSplitter)(nil), "managersplitter.MsgUpdateManagerSplitter")
+	proto.RegisterType((*MsgUpdateManagerSplitterResponse)(nil), "managersplitter.MsgUpdateManagerSplitterResponse")
+	proto.RegisterType((*MsgDeleteManagerSplitter)(nil), "managersplitter.MsgDeleteManagerSplitter")
+	proto.RegisterType((*MsgDeleteManagerSplitterResponse)(nil), "managersplitter.MsgDeleteManagerSplitterResponse")
+	proto.RegisterType((*MsgExecuteUniversalUpdateCollection)(nil), "managersplitter.MsgExecuteUniversalUpdateCollection")
+	proto.RegisterType((*MsgExecuteUniversalUpdateCollectionResponse)(nil), "managersplitter.MsgExecuteUniversalUpdateCollectionResponse")
+	proto.RegisterType((*QueuedExecution)(nil), "managersplitter.QueuedExecution")
+	proto.RegisterType((*ExecutionLogEntry)(nil), "managersplitter.ExecutionLogEntry")
+	proto.RegisterType((*MsgExecuteQueuedExecution)(nil), "managersplitter.MsgExecuteQueuedExecution")
+	proto.RegisterType((*MsgExecuteQueuedExecutionResponse)(nil), "managersplitter.MsgExecuteQueuedExecutionResponse")
+	proto.RegisterType((*MsgCancelQueuedExecution)(nil), "managersplitter.MsgCancelQueuedExecution")
+	proto.RegisterType((*MsgCancelQueuedExecutionResponse)(nil), "managersplitter.MsgCancelQueuedExecutionResponse")
+	proto.RegisterType((*ExecutableMsg)(nil), "managersplitter.ExecutableMsg")
+	proto.RegisterType((*MsgExecute)(nil), "managersplitter.MsgExecute")
+	proto.RegisterType((*MsgExecuteResponse)(nil), "managersplitter.MsgExecuteResponse")
+	proto.RegisterType((*ManagersplitterCustomMsgType)(nil), "managersplitter.ManagersplitterCustomMsgType")
 }
 
-func (m *MsgDeleteManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+func init() { proto.RegisterFile("managersplitter/tx.proto", fileDescriptor_53c122f86b47cda5) }
 
-func (m *MsgExecuteUniversalUpdateCollection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_53c122f86b47cda5 = []byte{
+	// 1374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6b, 0xdc, 0xc6,
+	0x17, 0xb7, 0xf6, 0xa7, 0x77, 0xec, 0x2f, 0xc6, 0xf3, 0xf5, 0x0f, 0x59, 0x35, 0xeb, 0x8d, 0x4c,
+	0xca, 0xc6, 0x69, 0x77, 0xed, 0x6d, 0x08, 0x65, 0x93, 0x8b, 0x7f, 0x41, 0x43, 0xb3, 0x90, 0xca,
+	0xd9, 0x42, 0x03, 0x25, 0x68, 0xa5, 0xa9, 0x2c, 0xb2, 0x92, 0xb6, 0x1a, 0xc9, 0xd8, 0x3d, 0x85,
+	0x1e, 0x7a, 0xe8, 0x29, 0xa7, 0x1e, 0x7a, 0xee, 0x21, 0x50, 0x0a, 0x2e, 0xb4, 0x87, 0x9e, 0x7a,
+	0xcd, 0xad, 0xa1, 0xa7, 0x92, 0x43, 0x28, 0x36, 0xc5, 0xd0, 0x7f, 0xa0, 0xd7, 0x22, 0x8d, 0x56,
+	0xd2, 0x4a, 0xa3, 0x95, 0x6c, 0x27, 0xa4, 0x17, 0x7b, 0x35, 0xf3, 0xe6, 0xf3, 0xde, 0xfb, 0xe8,
+	0x7d, 0xde, 0x8c, 0x06, 0xb0, 0x9a, 0xa8, 0x8b, 0x0a, 0x32, 0xf1, 0xa0, 0xaf, 0x5a, 0x16, 0x32,
+	0x9b, 0xd6, 0x61, 0x63, 0x60, 0x1a, 0x96, 0x01, 0x67, 0x22, 0x33, 0xdc, 0xac, 0xa8, 0xa9, 0xba,
+	0xd1, 0x74, 0xff, 0x12, 0x1b, 0x6e, 0x51, 0x32, 0xb0, 0x66, 0xe0, 0xa6, 0x86, 0x95, 0xe6, 0xc1,
+	0x86, 0xf3, 0xcf, 0x9b, 0x58, 0x22, 0x13, 0x0f, 0xdd, 0xa7, 0x26, 0x79, 0xf0, 0xa6, 0xe6, 0x14,
+	0x43, 0x31, 0xc8, 0xb8, 0xf3, 0xcb, 0x1b, 0xbd, 0x12, 0x8d, 0x63, 0x80, 0x4c, 0x4d, 0xc5, 0x58,
+	0x35, 0xf4, 0xe1, 0xc2, 0xe5, 0x98, 0x89, 0x68, 0x8a, 0xda, 0x70, 0x76, 0xde, 0x32, 0x1e, 0x21,
+	0x5d, 0xfd, 0x42, 0xb4, 0x54, 0x43, 0xf7, 0xb3, 0xe0, 0xaa, 0x23, 0xc3, 0x31, 0x50, 0xfe, 0x17,
+	0x06, 0xcc, 0x74, 0xb0, 0xd2, 0x1d, 0xc8, 0xa2, 0x85, 0xee, 0xb9, 0x80, 0xf0, 0x26, 0xa8, 0x88,
+	0xb6, 0xb5, 0x6f, 0x98, 0xaa, 0x75, 0xc4, 0x32, 0x35, 0xa6, 0x5e, 0xd9, 0x62, 0x7f, 0xff, 0xe9,
+	0xdd, 0x39, 0x2f, 0x8d, 0x4d, 0x59, 0x36, 0x11, 0xc6, 0x7b, 0x96, 0xa9, 0xea, 0x8a, 0x10, 0x98,
+	0xc2, 0x36, 0x28, 0x91, 0x90, 0xd8, 0x5c, 0x8d, 0xa9, 0x4f, 0xb5, 0x16, 0x1b, 0x91, 0x88, 0x1b,
+	0xc4, 0xc1, 0x56, 0xe5, 0xd9, 0xcb, 0x95, 0x89, 0xa7, 0x67, 0xc7, 0x6b, 0x8c, 0xe0, 0xad, 0x68,
+	0xb7, 0xbe, 0x3c, 0x3b, 0x5e, 0x0b, 0xb0, 0xbe, 0x3e, 0x3b, 0x5e, 0x5b, 0x89, 0xe6, 0x1b, 0x89,
+	0x93, 0x5f, 0x02, 0x8b, 0x91, 0x21, 0x01, 0xe1, 0x81, 0xa1, 0x63, 0xc4, 0x3f, 0x71, 0xd2, 0x22,
+	0xcb, 0xf7, 0xbc, 0xe5, 0x90, 0x05, 0x65, 0x91, 0x84, 0x4e, 0x92, 0x12, 0x86, 0x8f, 0x70, 0x0e,
+	0x14, 0x45, 0x59, 0x53, 0x75, 0x37, 0xee, 0x8a, 0x40, 0x1e, 0x60, 0x07, 0x4c, 0x85, 0xf8, 0x62,
+	0xf3, 0x6e, 0x4e, 0xd7, 0x63, 0x39, 0x45, 0xdc, 0xdc, 0x0b, 0x96, 0x08, 0xe1, 0xf5, 0xfc, 0xcf,
+	0x0c, 0x60, 0x3b, 0x58, 0xd9, 0x36, 0x91, 0x68, 0xa1, 0x68, 0x6c, 0x7e, 0x04, 0xcc, 0x98, 0x08,
+	0x72, 0x97, 0x8b, 0xa0, 0x7d, 0xc3, 0xe1, 0x98, 0x40, 0x3b, 0xfc, 0x5e, 0x8d, 0xf2, 0x4b, 0x0d,
+	0x8d, 0xbf, 0x0d, 0x6a, 0x49, 0x61, 0x0f, 0xe9, 0x4e, 0xa6, 0x96, 0xff, 0x8d, 0x64, 0x4d, 0x5e,
+	0x52, 0xb6, 0xac, 0x43, 0x60, 0xb9, 0xd1, 0xf7, 0xf4, 0x6a, 0xdf, 0x48, 0x2a, 0x1f, 0xd4, 0xa0,
+	0xf9, 0xa7, 0x8c, 0x4b, 0x08, 0x75, 0xd2, 0x27, 0x64, 0x01, 0x94, 0x3e, 0xb7, 0x91, 0x8d, 0x64,
+	0x37, 0xb5, 0x49, 0xc1, 0x7b, 0x82, 0x6d, 0x30, 0x4b, 0x7e, 0xed, 0x1e, 0x22, 0xc9, 0x76, 0x34,
+	0x79, 0x47, 0x26, 0x59, 0x6e, 0x4d, 0x3b, 0xa2, 0x78, 0xf1, 0x72, 0xa5, 0xd0, 0x55, 0x75, 0x4b,
+	0x88, 0x9b, 0xc1, 0x75, 0x30, 0x8d, 0xdc, 0x47, 0xb1, 0xd7, 0x47, 0x9b, 0x16, 0x9b, 0xa7, 0x2c,
+	0x1b, 0xb1, 0xe0, 0x1f, 0x13, 0xf2, 0x77, 0x50, 0x1f, 0x5d, 0x9a, 0xfc, 0x54, 0xb6, 0xa8, 0x5e,
+	0x78, 0xde, 0x25, 0x8b, 0x3a, 0xe7, 0x8b, 0xf5, 0x87, 0x1c, 0x58, 0xed, 0x60, 0x85, 0xe4, 0x8a,
+	0xba, 0xba, 0x7a, 0x80, 0x4c, 0x2c, 0xf6, 0x09, 0xc3, 0xdb, 0x46, 0xbf, 0x8f, 0x24, 0x87, 0x02,
+	0xc8, 0x81, 0x49, 0x92, 0x9e, 0x61, 0x7a, 0x41, 0xfb, 0xcf, 0xf0, 0x26, 0x58, 0xd0, 0x46, 0xe1,
+	0x37, 0x47, 0xd2, 0x48, 0x98, 0x85, 0x3a, 0x58, 0xb6, 0x93, 0x1c, 0x76, 0xb0, 0xe2, 0xd5, 0xd8,
+	0x5a, 0x23, 0xdc, 0x46, 0x1b, 0xce, 0xeb, 0x4f, 0x5a, 0x24, 0x8c, 0xc5, 0x6b, 0x6f, 0x39, 0x2c,
+	0xfa, 0x61, 0x3b, 0x44, 0xae, 0x47, 0x89, 0x4c, 0xe3, 0x81, 0xff, 0x8b, 0x01, 0xd7, 0x33, 0xf0,
+	0xe5, 0x17, 0xe3, 0x3a, 0x98, 0x96, 0xfc, 0xd1, 0x3b, 0x32, 0xcb, 0xd0, 0x0a, 0x27, 0x6c, 0x11,
+	0x2a, 0xdf, 0x5c, 0x7a, 0xf9, 0xe6, 0x2f, 0x56, 0xbe, 0x85, 0xd4, 0xf2, 0xfd, 0xb1, 0x00, 0x66,
+	0x3e, 0x1a, 0xc5, 0x81, 0xcb, 0x20, 0xa7, 0xd2, 0x33, 0xc8, 0xa9, 0xf2, 0x85, 0xab, 0x20, 0x5c,
+	0x59, 0xf9, 0x48, 0x65, 0xa5, 0x55, 0x48, 0xe1, 0xd5, 0x56, 0x08, 0xac, 0x83, 0x49, 0x42, 0xde,
+	0xa6, 0xc5, 0x16, 0x29, 0x79, 0xfa, 0xb3, 0x31, 0x46, 0x4b, 0x69, 0x8c, 0xc2, 0x16, 0x28, 0x68,
+	0x58, 0xc1, 0x6c, 0xb9, 0x96, 0xaf, 0x4f, 0xb5, 0xaa, 0xb1, 0xce, 0xb9, 0xeb, 0x1b, 0x77, 0xb0,
+	0x22, 0xb8, 0xb6, 0xd1, 0xa6, 0x3b, 0x79, 0xb9, 0xa6, 0x0b, 0x3f, 0x05, 0xff, 0x1f, 0x98, 0xe8,
+	0x40, 0x35, 0x6c, 0x1c, 0xb2, 0x61, 0x2b, 0xe7, 0x87, 0xa5, 0xe1, 0xf0, 0xff, 0xe4, 0xc0, 0xac,
+	0x5f, 0x2d, 0x77, 0x0d, 0x65, 0x57, 0xb7, 0xcc, 0xa3, 0x37, 0x50, 0x35, 0x35, 0x30, 0xd5, 0xeb,
+	0x1b, 0xd2, 0xa3, 0x0f, 0x90, 0xaa, 0xec, 0x93, 0x62, 0xcf, 0x0b, 0xe1, 0x21, 0xd8, 0x02, 0x73,
+	0x21, 0x5e, 0x76, 0x0f, 0x91, 0x29, 0xa9, 0x18, 0xc9, 0x6c, 0xb1, 0x96, 0xaf, 0x57, 0x04, 0xea,
+	0x5c, 0x4c, 0xc9, 0xa5, 0x54, 0x25, 0x53, 0x15, 0x5b, 0xce, 0xa6, 0xd8, 0x77, 0x00, 0x20, 0xf9,
+	0xb8, 0xb5, 0x38, 0x49, 0x59, 0x14, 0x9a, 0x77, 0xba, 0xd2, 0x52, 0xd0, 0x95, 0xa2, 0xba, 0x7d,
+	0x1d, 0xbd, 0xfb, 0x12, 0xdd, 0xa8, 0xfd, 0x7e, 0xac, 0x0f, 0xbf, 0x9d, 0xd0, 0x87, 0x23, 0x99,
+	0xf0, 0x5d, 0x70, 0x25, 0x31, 0xcd, 0x8b, 0xb7, 0x5c, 0xfe, 0x85, 0x77, 0x3c, 0x14, 0x75, 0x09,
+	0xf5, 0xa3, 0xec, 0xd1, 0xf7, 0xea, 0x37, 0xc1, 0x5b, 0xea, 0x19, 0x92, 0x16, 0xbf, 0x77, 0x0a,
+	0xa0, 0xce, 0xf9, 0xa7, 0x80, 0xef, 0x0b, 0xe0, 0x7f, 0x23, 0xfd, 0x07, 0x76, 0xc0, 0xac, 0x65,
+	0x8a, 0x3a, 0xfe, 0x0c, 0x99, 0xf7, 0x9d, 0xe6, 0x8a, 0x9d, 0x76, 0xcb, 0xb8, 0x8d, 0x62, 0x25,
+	0xd6, 0x6e, 0xef, 0x8f, 0x58, 0x0a, 0xf1, 0x95, 0xf0, 0x13, 0x30, 0x2f, 0xb9, 0xa7, 0x58, 0x8f,
+	0x87, 0xbb, 0x2a, 0xb6, 0x5c, 0x48, 0x72, 0xae, 0x5e, 0x8d, 0x41, 0x6e, 0xc7, 0xac, 0x05, 0x3a,
+	0x42, 0x00, 0xbd, 0x73, 0xa4, 0x8b, 0x9a, 0x2a, 0xed, 0x59, 0x86, 0x89, 0x82, 0xe3, 0x43, 0x12,
+	0x74, 0xd8, 0x5a, 0xa0, 0x23, 0x38, 0xd0, 0xf6, 0x40, 0x8e, 0x4f, 0xb0, 0x85, 0x04, 0xe8, 0x6e,
+	0xcc, 0x5a, 0xa0, 0x23, 0x38, 0xd0, 0xb2, 0x7b, 0x30, 0x8b, 0x42, 0x17, 0x13, 0xa0, 0x77, 0x62,
+	0xd6, 0x02, 0x1d, 0x01, 0x3e, 0x04, 0x8b, 0x18, 0x59, 0xe1, 0xd1, 0x8f, 0xc5, 0xbe, 0xed, 0x82,
+	0x97, 0x5c, 0xf0, 0xab, 0x31, 0xf0, 0x3d, 0x8a, 0xbd, 0x90, 0x84, 0xc2, 0xff, 0xca, 0x00, 0x10,
+	0xc8, 0xf0, 0xb5, 0xb4, 0x97, 0xe1, 0x66, 0x99, 0xcf, 0xbe, 0x59, 0xb6, 0xaf, 0xc5, 0xda, 0xca,
+	0x62, 0x42, 0x5b, 0xe1, 0xbf, 0x65, 0x00, 0x0c, 0x32, 0xf8, 0x8f, 0x7d, 0x39, 0xfc, 0x5d, 0x04,
+	0xcb, 0x9d, 0xd1, 0xc0, 0xb7, 0x6d, 0x6c, 0x19, 0x9a, 0xa3, 0xb4, 0xa3, 0x01, 0x82, 0x08, 0xb0,
+	0x12, 0xed, 0x93, 0x30, 0x90, 0xe8, 0xb5, 0xf8, 0x5e, 0x9e, 0xf4, 0x19, 0x99, 0x08, 0xe5, 0xb8,
+	0xb1, 0x07, 0x32, 0xdd, 0x4d, 0x2e, 0xd9, 0x0d, 0xfd, 0xe3, 0x2c, 0x11, 0xca, 0x71, 0x23, 0xd3,
+	0x3e, 0x51, 0x02, 0x09, 0x53, 0xdd, 0xd0, 0x3f, 0x6b, 0x12, 0xa1, 0xe0, 0x57, 0x0c, 0x58, 0x45,
+	0x29, 0xa7, 0xf6, 0x40, 0xda, 0x37, 0x68, 0x2e, 0x53, 0x0f, 0xfd, 0x59, 0x1c, 0xc0, 0x7d, 0xb0,
+	0x84, 0xa8, 0x1b, 0x58, 0xa0, 0xfe, 0xb5, 0x31, 0xde, 0xa3, 0x2d, 0x3c, 0x19, 0xcc, 0xad, 0x13,
+	0x5a, 0xdb, 0x0f, 0x3a, 0x01, 0xbd, 0x4e, 0xa8, 0x5b, 0x45, 0x22, 0x14, 0xbc, 0xe5, 0x1f, 0x55,
+	0x1c, 0xe0, 0xb2, 0x0b, 0xfc, 0xd6, 0x98, 0x0c, 0x84, 0x90, 0x79, 0xeb, 0xbb, 0x32, 0xc8, 0x3b,
+	0x20, 0x0f, 0xc0, 0xf4, 0xc8, 0x3d, 0x58, 0x2d, 0xb9, 0xb4, 0x88, 0x05, 0x57, 0x4f, 0xb3, 0xf0,
+	0x65, 0x6d, 0x83, 0x79, 0xfa, 0xcd, 0x4f, 0x76, 0x99, 0x70, 0x1b, 0xd9, 0x15, 0x15, 0x72, 0x4b,
+	0xbf, 0x7a, 0xc9, 0x2e, 0x1b, 0x6e, 0x23, 0xb3, 0x69, 0xd8, 0x2d, 0xfd, 0xd2, 0x21, 0xbb, 0x8c,
+	0xb8, 0x8d, 0xcc, 0xa6, 0xbe, 0xdb, 0x6f, 0x18, 0x50, 0x4b, 0xbd, 0x45, 0xb8, 0x90, 0xac, 0xb8,
+	0xdb, 0x17, 0x12, 0xe3, 0x30, 0xb0, 0x43, 0xb0, 0x90, 0x70, 0x2e, 0x3e, 0x87, 0xcc, 0xb8, 0xd6,
+	0x39, 0x24, 0x19, 0xae, 0x3b, 0xea, 0x91, 0x32, 0xbb, 0xec, 0xb8, 0x8d, 0xcc, 0xa6, 0xbe, 0xdb,
+	0x0f, 0x41, 0x79, 0xb8, 0x35, 0x8f, 0x93, 0x21, 0xb7, 0x3a, 0x4e, 0xa3, 0x1e, 0x18, 0x57, 0x7c,
+	0xec, 0x5c, 0x15, 0x6f, 0x75, 0x9f, 0x9d, 0x54, 0x99, 0xe7, 0x27, 0x55, 0xe6, 0xcf, 0x93, 0x2a,
+	0xf3, 0xe4, 0xb4, 0x3a, 0xf1, 0xfc, 0xb4, 0x3a, 0xf1, 0xc7, 0x69, 0x75, 0xe2, 0xc1, 0x2d, 0x45,
+	0xb5, 0xf6, 0xed, 0x5e, 0x43, 0x32, 0xb4, 0x66, 0x4f, 0xb5, 0x7a, 0xa2, 0xac, 0x20, 0x1c, 0xfc,
+	0x92, 0xf6, 0x45, 0x55, 0x6f, 0x1e, 0x36, 0x63, 0x57, 0xfd, 0x47, 0x03, 0x84, 0x7b, 0x25, 0xf7,
+	0x22, 0xfc, 0xbd, 0x7f, 0x07, 0x00, 0x50, 0x5b, 0xfb, 0x39, 0x0a, 0x18, 0x00, 0x00,
 }
 
-func (m *MsgExecuteUniversalUpdateCollection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *MsgExecuteUniversalUpdateCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UniversalUpdateCollectionMsg != nil {
-		{
-			size, err := m.UniversalUpdateCollectionMsg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ManagerSplitterAddress) > 0 {
-		i -= len(m.ManagerSplitterAddress)
-		copy(dAtA[i:], m.ManagerSplitterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Executor) > 0 {
-		i -= len(m.Executor)
-		copy(dAtA[i:], m.Executor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	CreateManagerSplitter(ctx context.Context, in *MsgCreateManagerSplitter, opts ...grpc.CallOption) (*MsgCreateManagerSplitterResponse, error)
+	UpdateManagerSplitter(ctx context.Context, in *MsgUpdateManagerSplitter, opts ...grpc.CallOption) (*MsgUpdateManagerSplitterResponse, error)
+	DeleteManagerSplitter(ctx context.Context, in *MsgDeleteManagerSplitter, opts ...grpc.CallOption) (*MsgDeleteManagerSplitterResponse, error)
+	ExecuteUniversalUpdateCollection(ctx context.Context, in *MsgExecuteUniversalUpdateCollection, opts ...grpc.CallOption) (*MsgExecuteUniversalUpdateCollectionResponse, error)
+	ExecuteQueuedExecution(ctx context.Context, in *MsgExecuteQueuedExecution, opts ...grpc.CallOption) (*MsgExecuteQueuedExecutionResponse, error)
+	CancelQueuedExecution(ctx context.Context, in *MsgCancelQueuedExecution, opts ...grpc.CallOption) (*MsgCancelQueuedExecutionResponse, error)
+	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
 }
 
-func (m *MsgExecuteUniversalUpdateCollectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgExecuteUniversalUpdateCollectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateManagerSplitter(ctx context.Context, in *MsgCreateManagerSplitter, opts ...grpc.CallOption) (*MsgCreateManagerSplitterResponse, error) {
+	out := new(MsgCreateManagerSplitterResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/CreateManagerSplitter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgExecuteUniversalUpdateCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CollectionId.Size()
-		i -= size
-		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) UpdateManagerSplitter(ctx context.Context, in *MsgUpdateManagerSplitter, opts ...grpc.CallOption) (*MsgUpdateManagerSplitterResponse, error) {
+	out := new(MsgUpdateManagerSplitterResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/UpdateManagerSplitter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ManagersplitterCustomMsgType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteManagerSplitter(ctx context.Context, in *MsgDeleteManagerSplitter, opts ...grpc.CallOption) (*MsgDeleteManagerSplitterResponse, error) {
+	out := new(MsgDeleteManagerSplitterResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/DeleteManagerSplitter", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ManagersplitterCustomMsgType) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExecuteUniversalUpdateCollection(ctx context.Context, in *MsgExecuteUniversalUpdateCollection, opts ...grpc.CallOption) (*MsgExecuteUniversalUpdateCollectionResponse, error) {
+	out := new(MsgExecuteUniversalUpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/ExecuteUniversalUpdateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ManagersplitterCustomMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecuteUniversalUpdateCollectionMsg != nil {
-		{
-			size, err := m.ExecuteUniversalUpdateCollectionMsg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DeleteManagerSplitterMsg != nil {
-		{
-			size, err := m.DeleteManagerSplitterMsg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.UpdateManagerSplitterMsg != nil {
-		{
-			size, err := m.UpdateManagerSplitterMsg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CreateManagerSplitterMsg != nil {
-		{
-			size, err := m.CreateManagerSplitterMsg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ExecuteQueuedExecution(ctx context.Context, in *MsgExecuteQueuedExecution, opts ...grpc.CallOption) (*MsgExecuteQueuedExecutionResponse, error) {
+	out := new(MsgExecuteQueuedExecutionResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/ExecuteQueuedExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) CancelQueuedExecution(ctx context.Context, in *MsgCancelQueuedExecution, opts ...grpc.CallOption) (*MsgCancelQueuedExecutionResponse, error) {
+	out := new(MsgCancelQueuedExecutionResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/CancelQueuedExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error) {
+	out := new(MsgExecuteResponse)
+	err := c.cc.Invoke(ctx, "/managersplitter.Msg/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CreateManagerSplitter(context.Context, *MsgCreateManagerSplitter) (*MsgCreateManagerSplitterResponse, error)
+	UpdateManagerSplitter(context.Context, *MsgUpdateManagerSplitter) (*MsgUpdateManagerSplitterResponse, error)
+	DeleteManagerSplitter(context.Context, *MsgDeleteManagerSplitter) (*MsgDeleteManagerSplitterResponse, error)
+	ExecuteUniversalUpdateCollection(context.Context, *MsgExecuteUniversalUpdateCollection) (*MsgExecuteUniversalUpdateCollectionResponse, error)
+	ExecuteQueuedExecution(context.Context, *MsgExecuteQueuedExecution) (*MsgExecuteQueuedExecutionResponse, error)
+	CancelQueuedExecution(context.Context, *MsgCancelQueuedExecution) (*MsgCancelQueuedExecutionResponse, error)
+	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
 }
 
-func (m *ManagerSplitter) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateManagerSplitter(ctx context.Context, req *MsgCreateManagerSplitter) (*MsgCreateManagerSplitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateManagerSplitter not implemented")
+}
+func (*UnimplementedMsgServer) UpdateManagerSplitter(ctx context.Context, req *MsgUpdateManagerSplitter) (*MsgUpdateManagerSplitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateManagerSplitter not implemented")
+}
+func (*UnimplementedMsgServer) DeleteManagerSplitter(ctx context.Context, req *MsgDeleteManagerSplitter) (*MsgDeleteManagerSplitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteManagerSplitter not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteUniversalUpdateCollection(ctx context.Context, req *MsgExecuteUniversalUpdateCollection) (*MsgExecuteUniversalUpdateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteUniversalUpdateCollection not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteQueuedExecution(ctx context.Context, req *MsgExecuteQueuedExecution) (*MsgExecuteQueuedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteQueuedExecution not implemented")
+}
+func (*UnimplementedMsgServer) CancelQueuedExecution(ctx context.Context, req *MsgCancelQueuedExecution) (*MsgCancelQueuedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedExecution not implemented")
+}
+func (*UnimplementedMsgServer) Execute(ctx context.Context, req *MsgExecute) (*MsgExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/UpdateParams",
 	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateManagerSplitter) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateManagerSplitter)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateManagerSplitter(ctx, in)
 	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/CreateManagerSplitter",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateManagerSplitter(ctx, req.(*MsgCreateManagerSplitter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateManagerSplitterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateManagerSplitter)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateManagerSplitter(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/UpdateManagerSplitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateManagerSplitter(ctx, req.(*MsgUpdateManagerSplitter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateManagerSplitter) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteManagerSplitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteManagerSplitter)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteManagerSplitter(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/DeleteManagerSplitter",
 	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteManagerSplitter(ctx, req.(*MsgDeleteManagerSplitter))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateManagerSplitterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExecuteUniversalUpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteUniversalUpdateCollection)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteUniversalUpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/ExecuteUniversalUpdateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteUniversalUpdateCollection(ctx, req.(*MsgExecuteUniversalUpdateCollection))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteManagerSplitter) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExecuteQueuedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteQueuedExecution)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteQueuedExecution(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/ExecuteQueuedExecution",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteQueuedExecution(ctx, req.(*MsgExecuteQueuedExecution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteManagerSplitterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelQueuedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedExecution)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/CancelQueuedExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedExecution(ctx, req.(*MsgCancelQueuedExecution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExecuteUniversalUpdateCollection) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Executor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Execute(ctx, in)
 	}
-	l = len(m.ManagerSplitterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/managersplitter.Msg/Execute",
 	}
-	if m.UniversalUpdateCollectionMsg != nil {
-		l = m.UniversalUpdateCollectionMsg.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Execute(ctx, req.(*MsgExecute))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExecuteUniversalUpdateCollectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CollectionId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "managersplitter.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateManagerSplitter",
+			Handler:    _Msg_CreateManagerSplitter_Handler,
+		},
+		{
+			MethodName: "UpdateManagerSplitter",
+			Handler:    _Msg_UpdateManagerSplitter_Handler,
+		},
+		{
+			MethodName: "DeleteManagerSplitter",
+			Handler:    _Msg_DeleteManagerSplitter_Handler,
+		},
+		{
+			MethodName: "ExecuteUniversalUpdateCollection",
+			Handler:    _Msg_ExecuteUniversalUpdateCollection_Handler,
+		},
+		{
+			MethodName: "ExecuteQueuedExecution",
+			Handler:    _Msg_ExecuteQueuedExecution_Handler,
+		},
+		{
+			MethodName: "CancelQueuedExecution",
+			Handler:    _Msg_CancelQueuedExecution_Handler,
+		},
+		{
+			MethodName: "Execute",
+			Handler:    _Msg_Execute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "managersplitter/tx.proto",
 }
 
-func (m *ManagersplitterCustomMsgType) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreateManagerSplitterMsg != nil {
-		l = m.CreateManagerSplitterMsg.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UpdateManagerSplitterMsg != nil {
-		l = m.UpdateManagerSplitterMsg.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DeleteManagerSplitterMsg != nil {
-		l = m.DeleteManagerSplitterMsg.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ExecuteUniversalUpdateCollectionMsg != nil {
-		l = m.ExecuteUniversalUpdateCollectionMsg.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagerSplitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateManagerSplitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateManagerSplitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExecutableAt.Size()
+		i -= size
+		if _, err := m.ExecutableAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.QueuedExecutionId.Size()
+		i -= size
+		if _, err := m.QueuedExecutionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteManagerSplitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteManagerSplitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteManagerSplitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteManagerSplitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteManagerSplitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteManagerSplitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteUniversalUpdateCollection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteUniversalUpdateCollection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteUniversalUpdateCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UniversalUpdateCollectionMsg != nil {
+		{
+			size, err := m.UniversalUpdateCollectionMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteUniversalUpdateCollectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteUniversalUpdateCollectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteUniversalUpdateCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExecutableAt.Size()
+		i -= size
+		if _, err := m.ExecutableAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.QueuedExecutionId.Size()
+		i -= size
+		if _, err := m.QueuedExecutionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousPermissions != nil {
+		{
+			size, err := m.PreviousPermissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.ExecutableAt.Size()
+		i -= size
+		if _, err := m.ExecutableAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.QueuedAt.Size()
+		i -= size
+		if _, err := m.QueuedAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UniversalUpdateCollectionMsg != nil {
+		{
+			size, err := m.UniversalUpdateCollectionMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExecutedAt.Size()
+		i -= size
+		if _, err := m.ExecutedAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.QueuedExecutionId.Size()
+		i -= size
+		if _, err := m.QueuedExecutionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PermissionsExercised) > 0 {
+		for iNdEx := len(m.PermissionsExercised) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PermissionsExercised[iNdEx])
+			copy(dAtA[i:], m.PermissionsExercised[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PermissionsExercised[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteQueuedExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteQueuedExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteQueuedExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QueuedExecutionId.Size()
+		i -= size
+		if _, err := m.QueuedExecutionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteQueuedExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteQueuedExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteQueuedExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QueuedExecutionId.Size()
+		i -= size
+		if _, err := m.QueuedExecutionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutableMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutableMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutableMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SetDynamicStoreValueMsg != nil {
+		{
+			size, err := m.SetDynamicStoreValueMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DeleteDynamicStoreMsg != nil {
+		{
+			size, err := m.DeleteDynamicStoreMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpdateDynamicStoreMsg != nil {
+		{
+			size, err := m.UpdateDynamicStoreMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreateDynamicStoreMsg != nil {
+		{
+			size, err := m.CreateDynamicStoreMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreateAddressListsMsg != nil {
+		{
+			size, err := m.CreateAddressListsMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TransferTokensMsg != nil {
+		{
+			size, err := m.TransferTokensMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ManagerSplitterAddress) > 0 {
+		i -= len(m.ManagerSplitterAddress)
+		copy(dAtA[i:], m.ManagerSplitterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerSplitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExecutableAt.Size()
+		i -= size
+		if _, err := m.ExecutableAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.QueuedExecutionId.Size()
+		i -= size
+		if _, err := m.QueuedExecutionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagersplitterCustomMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagersplitterCustomMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagersplitterCustomMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteMsg != nil {
+		{
+			size, err := m.ExecuteMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CancelQueuedExecutionMsg != nil {
+		{
+			size, err := m.CancelQueuedExecutionMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExecuteQueuedExecutionMsg != nil {
+		{
+			size, err := m.ExecuteQueuedExecutionMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExecuteUniversalUpdateCollectionMsg != nil {
+		{
+			size, err := m.ExecuteUniversalUpdateCollectionMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DeleteManagerSplitterMsg != nil {
+		{
+			size, err := m.DeleteManagerSplitterMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpdateManagerSplitterMsg != nil {
+		{
+			size, err := m.UpdateManagerSplitterMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CreateManagerSplitterMsg != nil {
+		{
+			size, err := m.CreateManagerSplitterMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ManagerSplitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateManagerSplitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateManagerSplitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateManagerSplitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateManagerSplitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued {
+		n += 2
+	}
+	l = m.QueuedExecutionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExecutableAt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteManagerSplitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteManagerSplitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteUniversalUpdateCollection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UniversalUpdateCollectionMsg != nil {
+		l = m.UniversalUpdateCollectionMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteUniversalUpdateCollectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Queued {
+		n += 2
+	}
+	l = m.QueuedExecutionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExecutableAt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *QueuedExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UniversalUpdateCollectionMsg != nil {
+		l = m.UniversalUpdateCollectionMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.QueuedAt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExecutableAt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PreviousPermissions != nil {
+		l = m.PreviousPermissions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if len(m.PermissionsExercised) > 0 {
+		for _, s := range m.PermissionsExercised {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QueuedExecutionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExecutedAt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExecuteQueuedExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.QueuedExecutionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExecuteQueuedExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelQueuedExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.QueuedExecutionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelQueuedExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExecutableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferTokensMsg != nil {
+		l = m.TransferTokensMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CreateAddressListsMsg != nil {
+		l = m.CreateAddressListsMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CreateDynamicStoreMsg != nil {
+		l = m.CreateDynamicStoreMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpdateDynamicStoreMsg != nil {
+		l = m.UpdateDynamicStoreMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeleteDynamicStoreMsg != nil {
+		l = m.DeleteDynamicStoreMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SetDynamicStoreValueMsg != nil {
+		l = m.SetDynamicStoreValueMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ManagerSplitterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued {
+		n += 2
+	}
+	l = m.QueuedExecutionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExecutableAt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ManagersplitterCustomMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateManagerSplitterMsg != nil {
+		l = m.CreateManagerSplitterMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpdateManagerSplitterMsg != nil {
+		l = m.UpdateManagerSplitterMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeleteManagerSplitterMsg != nil {
+		l = m.DeleteManagerSplitterMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecuteUniversalUpdateCollectionMsg != nil {
+		l = m.ExecuteUniversalUpdateCollectionMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecuteQueuedExecutionMsg != nil {
+		l = m.ExecuteQueuedExecutionMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CancelQueuedExecutionMsg != nil {
+		l = m.CancelQueuedExecutionMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecuteMsg != nil {
+		l = m.ExecuteMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagerSplitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagerSplitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &ManagerSplitterPermissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateManagerSplitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateManagerSplitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &ManagerSplitterPermissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateManagerSplitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateManagerSplitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateManagerSplitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &ManagerSplitterPermissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateManagerSplitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedExecutionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteManagerSplitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteManagerSplitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteManagerSplitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteManagerSplitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteUniversalUpdateCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSplitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniversalUpdateCollectionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UniversalUpdateCollectionMsg == nil {
+				m.UniversalUpdateCollectionMsg = &types.MsgUniversalUpdateCollection{}
+			}
+			if err := m.UniversalUpdateCollectionMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteUniversalUpdateCollectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedExecutionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1643,28 +4596,196 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSplitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniversalUpdateCollectionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UniversalUpdateCollectionMsg == nil {
+				m.UniversalUpdateCollectionMsg = &types.MsgUniversalUpdateCollection{}
+			}
+			if err := m.UniversalUpdateCollectionMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.QueuedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1692,11 +4813,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.ExecutableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1723,7 +4846,80 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &ExecutableMsg{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &ManagerSplitterPermissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousPermissions == nil {
+				m.PreviousPermissions = &ManagerSplitterPermissions{}
+			}
+			if err := m.PreviousPermissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1748,7 +4944,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecutionLogEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1771,65 +4967,164 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionLogEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSplitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionsExercised", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManagerSplitter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManagerSplitter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PermissionsExercised = append(m.PermissionsExercised, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,11 +5152,13 @@ func (m *ManagerSplitter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,13 +5186,15 @@ func (m *ManagerSplitter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.QueuedExecutionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1905,25 +5204,23 @@ func (m *ManagerSplitter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = &ManagerSplitterPermissions{}
-			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1948,7 +5245,7 @@ func (m *ManagerSplitter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateManagerSplitter) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteQueuedExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1971,15 +5268,15 @@ func (m *MsgCreateManagerSplitter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateManagerSplitter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteQueuedExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteQueuedExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2007,13 +5304,13 @@ func (m *MsgCreateManagerSplitter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2023,25 +5320,55 @@ func (m *MsgCreateManagerSplitter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = &ManagerSplitterPermissions{}
+			m.ManagerSplitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutionId", wireType)
 			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedExecutionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2066,7 +5393,7 @@ func (m *MsgCreateManagerSplitter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteQueuedExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,15 +5416,15 @@ func (m *MsgCreateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateManagerSplitterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteQueuedExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteQueuedExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2125,7 +5452,9 @@ func (m *MsgCreateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2148,7 +5477,7 @@ func (m *MsgCreateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateManagerSplitter) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelQueuedExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2171,10 +5500,10 @@ func (m *MsgUpdateManagerSplitter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateManagerSplitter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelQueuedExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelQueuedExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2211,7 +5540,7 @@ func (m *MsgUpdateManagerSplitter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSplitterAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2239,13 +5568,13 @@ func (m *MsgUpdateManagerSplitter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ManagerSplitterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2255,25 +5584,23 @@ func (m *MsgUpdateManagerSplitter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = &ManagerSplitterPermissions{}
-			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueuedExecutionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2298,7 +5625,7 @@ func (m *MsgUpdateManagerSplitter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelQueuedExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,10 +5648,10 @@ func (m *MsgUpdateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateManagerSplitterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelQueuedExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelQueuedExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2348,7 +5675,7 @@ func (m *MsgUpdateManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteManagerSplitter) Unmarshal(dAtA []byte) error {
+func (m *ExecutableMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2371,17 +5698,125 @@ func (m *MsgDeleteManagerSplitter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteManagerSplitter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutableMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteManagerSplitter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutableMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTokensMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferTokensMsg == nil {
+				m.TransferTokensMsg = &types.MsgTransferTokens{}
+			}
+			if err := m.TransferTokensMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAddressListsMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateAddressListsMsg == nil {
+				m.CreateAddressListsMsg = &types.MsgCreateAddressLists{}
+			}
+			if err := m.CreateAddressListsMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDynamicStoreMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateDynamicStoreMsg == nil {
+				m.CreateDynamicStoreMsg = &types.MsgCreateDynamicStore{}
+			}
+			if err := m.CreateDynamicStoreMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDynamicStoreMsg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2391,29 +5826,33 @@ func (m *MsgDeleteManagerSplitter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if m.UpdateDynamicStoreMsg == nil {
+				m.UpdateDynamicStoreMsg = &types.MsgUpdateDynamicStore{}
+			}
+			if err := m.UpdateDynamicStoreMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDynamicStoreMsg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2423,74 +5862,64 @@ func (m *MsgDeleteManagerSplitter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if m.DeleteDynamicStoreMsg == nil {
+				m.DeleteDynamicStoreMsg = &types.MsgDeleteDynamicStore{}
+			}
+			if err := m.DeleteDynamicStoreMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetDynamicStoreValueMsg", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteManagerSplitterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SetDynamicStoreValueMsg == nil {
+				m.SetDynamicStoreValueMsg = &types.MsgSetDynamicStoreValue{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteManagerSplitterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteManagerSplitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.SetDynamicStoreValueMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2512,7 +5941,7 @@ func (m *MsgDeleteManagerSplitterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteUniversalUpdateCollection) Unmarshal(dAtA []byte) error {
+func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,10 +5964,10 @@ func (m *MsgExecuteUniversalUpdateCollection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollection: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2607,7 +6036,7 @@ func (m *MsgExecuteUniversalUpdateCollection) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniversalUpdateCollectionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2634,10 +6063,8 @@ func (m *MsgExecuteUniversalUpdateCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UniversalUpdateCollectionMsg == nil {
-				m.UniversalUpdateCollectionMsg = &types.MsgUniversalUpdateCollection{}
-			}
-			if err := m.UniversalUpdateCollectionMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &ExecutableMsg{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2662,7 +6089,7 @@ func (m *MsgExecuteUniversalUpdateCollection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteUniversalUpdateCollectionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2685,15 +6112,35 @@ func (m *MsgExecuteUniversalUpdateCollectionResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteUniversalUpdateCollectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2721,7 +6168,41 @@ func (m *MsgExecuteUniversalUpdateCollectionResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueuedExecutionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2919,6 +6400,114 @@ func (m *ManagersplitterCustomMsgType) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteQueuedExecutionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteQueuedExecutionMsg == nil {
+				m.ExecuteQueuedExecutionMsg = &MsgExecuteQueuedExecution{}
+			}
+			if err := m.ExecuteQueuedExecutionMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelQueuedExecutionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CancelQueuedExecutionMsg == nil {
+				m.CancelQueuedExecutionMsg = &MsgCancelQueuedExecution{}
+			}
+			if err := m.CancelQueuedExecutionMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteMsg == nil {
+				m.ExecuteMsg = &MsgExecute{}
+			}
+			if err := m.ExecuteMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
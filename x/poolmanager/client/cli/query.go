@@ -30,9 +30,12 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPool)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalVolumeForPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolVolumeHistory)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolFeeApr)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdFindBestRoute)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllTakerFeeShareAgreements)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTakerFeeShareAgreementFromDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTakerFeeShareDenomsToAccruedValue)
@@ -123,6 +126,16 @@ func GetCmdListPoolsByDenom() (*osmocli.QueryDescriptor, *queryproto.ListPoolsBy
 	}, &queryproto.ListPoolsByDenomRequest{}
 }
 
+// GetCmdFindBestRoute returns the best route (single or split) to swap an exact amount in to a token out denom.
+func GetCmdFindBestRoute() (*osmocli.QueryDescriptor, *queryproto.FindBestRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "find-best-route",
+		Short: "Query find-best-route",
+		Long: `Query the best route to swap token in to token out denom, with max hops and max splits (0 for defaults).{{.ExampleHeader}}
+{{.CommandPrefix}} find-best-route 1000ubadge uatom 3 2`,
+	}, &queryproto.FindBestRouteRequest{}
+}
+
 func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
@@ -199,6 +212,26 @@ func GetCmdTotalVolumeForPool() (*osmocli.QueryDescriptor, *queryproto.TotalVolu
 	}, &queryproto.TotalVolumeForPoolRequest{}
 }
 
+// GetCmdPoolVolumeHistory returns the daily volume and swap fees of a pool over a time range.
+func GetCmdPoolVolumeHistory() (*osmocli.QueryDescriptor, *queryproto.PoolVolumeHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-volume-history",
+		Short: "Query pool-volume-history",
+		Long: `Query the daily volume and swap fees of a pool between a start and end time (unix seconds, 0 end time for now).{{.ExampleHeader}}
+{{.CommandPrefix}} pool-volume-history 1 1735689600 0`,
+	}, &queryproto.PoolVolumeHistoryRequest{}
+}
+
+// GetCmdPoolFeeApr returns an APR estimate of the swap fees earned by the LPs of a pool.
+func GetCmdPoolFeeApr() (*osmocli.QueryDescriptor, *queryproto.PoolFeeAprRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-fee-apr",
+		Short: "Query pool-fee-apr",
+		Long: `Query the swap fee APR of a pool, valued in a quote denom of the pool and averaged over a number of completed days (0 for 7).{{.ExampleHeader}}
+{{.CommandPrefix}} pool-fee-apr 1 ubadge 30`,
+	}, &queryproto.PoolFeeAprRequest{}
+}
+
 func GetCmdTradingPairTakerFee() (*osmocli.QueryDescriptor, *queryproto.TradingPairTakerFeeRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "trading-pair-taker-fee",
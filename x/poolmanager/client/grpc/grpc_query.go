@@ -110,6 +110,26 @@ func (q Querier) RegisteredAlloyedPoolFromDenom(grpcCtx context.Context,
 	return q.Q.RegisteredAlloyedPoolFromDenom(ctx, *req)
 }
 
+func (q Querier) PoolVolumeHistory(grpcCtx context.Context,
+	req *queryproto.PoolVolumeHistoryRequest,
+) (*queryproto.PoolVolumeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolVolumeHistory(ctx, *req)
+}
+
+func (q Querier) PoolFeeApr(grpcCtx context.Context,
+	req *queryproto.PoolFeeAprRequest,
+) (*queryproto.PoolFeeAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolFeeApr(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -220,6 +240,16 @@ func (q Querier) EstimateSinglePoolSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSinglePoolSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) FindBestRoute(grpcCtx context.Context,
+	req *queryproto.FindBestRouteRequest,
+) (*queryproto.FindBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.FindBestRoute(ctx, *req)
+}
+
 func (q Querier) AllTakerFeeShareAgreements(grpcCtx context.Context,
 	req *queryproto.AllTakerFeeShareAgreementsRequest,
 ) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
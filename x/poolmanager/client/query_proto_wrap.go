@@ -1,6 +1,8 @@
 package client
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -321,6 +323,48 @@ func (q Querier) TotalVolumeForPool(ctx sdk.Context, req queryproto.TotalVolumeF
 	}, nil
 }
 
+// PoolVolumeHistory returns a page of the daily volume and swap fees of the pool over the given time range.
+func (q Querier) PoolVolumeHistory(ctx sdk.Context, req queryproto.PoolVolumeHistoryRequest) (*queryproto.PoolVolumeHistoryResponse, error) {
+	endTime := req.EndTime
+	if endTime.Unix() <= 0 {
+		endTime = ctx.BlockTime()
+	}
+	if endTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time must not be before start time")
+	}
+
+	dailyVolumes, pageRes, err := q.K.GetPoolVolumeHistory(ctx, req.PoolId, req.StartTime, endTime, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	volume := sdk.NewCoins()
+	swapFees := sdk.NewCoins()
+	for _, dailyVolume := range dailyVolumes {
+		volume = volume.Add(dailyVolume.Volume...)
+		swapFees = swapFees.Add(dailyVolume.SwapFees...)
+	}
+
+	return &queryproto.PoolVolumeHistoryResponse{
+		DailyVolumes: dailyVolumes,
+		Volume:       volume,
+		SwapFees:     swapFees,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// PoolFeeApr returns an APR estimate of the swap fees earned by the LPs of the pool.
+func (q Querier) PoolFeeApr(ctx sdk.Context, req queryproto.PoolFeeAprRequest) (*queryproto.PoolFeeAprResponse, error) {
+	res, err := q.K.EstimatePoolFeeApr(ctx, req)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidPoolFeeAprParams) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
+
 // TradingPairTakerFee returns the taker fee for the given trading pair
 func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPairTakerFeeRequest) (*queryproto.TradingPairTakerFeeResponse, error) {
 	tradingPairTakerFee, err := q.K.GetTradingPairTakerFee(ctx, req.Denom_0, req.Denom_1)
@@ -405,6 +449,39 @@ func (q Querier) EstimateTradeBasedOnPriceImpact(
 	}
 }
 
+// FindBestRoute finds the route (single or split) that maximizes the estimated amount out of a swap.
+func (q Querier) FindBestRoute(ctx sdk.Context, req queryproto.FindBestRouteRequest) (*queryproto.FindBestRouteResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	routes, tokenOutAmount, err := q.K.FindBestRoute(ctx, tokenIn, req.TokenOutDenom, req.MaxHops, req.MaxSplits)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidFindBestRouteParams) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		var noRouteFoundErr types.NoRouteFoundError
+		if errors.As(err, &noRouteFoundErr) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.FindBestRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 func (q Querier) AllTakerFeeShareAgreements(ctx sdk.Context, req queryproto.AllTakerFeeShareAgreementsRequest) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
 	takerFeeShareAgreements, err := q.K.GetAllTakerFeesShareAgreements(ctx)
 	if err != nil {
@@ -57,6 +57,7 @@ func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, gam
 	routesMap := map[types.PoolType]types.PoolModuleI{
 		types.Balancer:   gammKeeper,
 		types.Stableswap: gammKeeper,
+		types.Orderbook:  gammKeeper,
 	}
 
 	routesList := []types.PoolModuleI{
@@ -144,6 +145,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.TokenInDenom, denomPairTakerFee.TokenOutDenom, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the pool daily volumes KVStore.
+	for _, dailyVolume := range genState.PoolDailyVolumes {
+		k.SetPoolDailyVolume(ctx, dailyVolume)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -169,6 +175,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	poolDailyVolumes, err := k.GetAllPoolDailyVolumes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -182,6 +193,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolDailyVolumes:       poolDailyVolumes,
 	}
 }
 
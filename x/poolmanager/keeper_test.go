@@ -2,6 +2,7 @@ package poolmanager_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -62,6 +63,21 @@ var (
 		// },
 	}
 
+	testPoolDailyVolumes = []types.PoolDailyVolume{
+		{
+			PoolId:       1,
+			DayStartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Volume:       sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(10000000))),
+			SwapFees:     sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(100000))),
+		},
+		{
+			PoolId:       1,
+			DayStartTime: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			Volume:       sdk.NewCoins(sdk.NewCoin("uusdc", osmomath.NewInt(20000000))),
+			SwapFees:     sdk.NewCoins(sdk.NewCoin("uusdc", osmomath.NewInt(200000))),
+		},
+	}
+
 	testDenomPairTakerFees = []types.DenomPairTakerFee{
 		{
 			TokenInDenom:  "uion",
@@ -136,6 +152,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolDailyVolumes:       testPoolDailyVolumes,
 	})
 
 	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -160,6 +177,10 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	takerFee, err = s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, testDenomPairTakerFees[1].TokenInDenom, testDenomPairTakerFees[1].TokenOutDenom)
 	s.Require().NoError(err)
 	s.Require().Equal(testDenomPairTakerFees[1].TakerFee, takerFee)
+
+	dailyVolumes, err := s.App.PoolManagerKeeper.GetAllPoolDailyVolumes(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(testPoolDailyVolumes, dailyVolumes)
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -181,6 +202,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolDailyVolumes:       testPoolDailyVolumes,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
@@ -199,6 +221,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	// s.Require().Equal(testPoolVolumes[0].PoolVolume, genesis.PoolVolumes[0].PoolVolume)
 	// s.Require().Equal(testPoolVolumes[1].PoolVolume, genesis.PoolVolumes[1].PoolVolume)
 	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Equal(testPoolDailyVolumes, genesis.PoolDailyVolumes)
 }
 
 // // TestBeginBlock tests that, if any one of the cache trackers is empty, all cache trackers are updated.
@@ -0,0 +1,315 @@
+package poolmanager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+const (
+	// DefaultFindBestRouteMaxHops is the max number of pools in a path when not specified
+	DefaultFindBestRouteMaxHops = uint64(3)
+	// MaxFindBestRouteMaxHops caps the max number of pools in a path
+	MaxFindBestRouteMaxHops = uint64(4)
+	// MaxFindBestRouteMaxSplits caps the max number of paths a swap can be split across
+	MaxFindBestRouteMaxSplits = uint64(5)
+
+	// maxRouteCandidates bounds the number of candidate paths that are estimated
+	maxRouteCandidates = 50
+	// maxRouteSearchSteps bounds the number of pool hops the path search visits
+	maxRouteSearchSteps = 1000
+	// splitRouteChunks is the number of chunks token in is divided into when searching for a split route
+	splitRouteChunks = 10
+)
+
+// routeCandidate is a candidate multihop path with its estimated amount out for the full token in
+type routeCandidate struct {
+	pools     []types.SwapAmountInRoute
+	amountOut osmomath.Int
+}
+
+// FindBestRoute searches for the route that maximizes the estimated amount out of swapping tokenIn
+// into tokenOutDenom. Candidate paths of up to maxHops pools are built from ListPoolsByDenom, and each
+// is estimated with MultihopEstimateOutGivenExactAmountIn (which applies the spread factor and the
+// trading pair taker fee of every hop). If maxSplits > 1, tokenIn is additionally split in chunks across
+// up to maxSplits pool-disjoint paths, and the split is returned if it beats the best single path.
+//
+// Returns the routes (a single route if not split) and the total estimated amount out.
+func (k Keeper) FindBestRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+	maxSplits uint64,
+) ([]types.SwapAmountInSplitRoute, osmomath.Int, error) {
+	if err := tokenIn.Validate(); err != nil || !tokenIn.IsPositive() {
+		return nil, osmomath.Int{}, fmt.Errorf("%w: token in must be a positive coin, got (%s)", types.ErrInvalidFindBestRouteParams, tokenIn)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, osmomath.Int{}, fmt.Errorf("%w: %s", types.ErrInvalidFindBestRouteParams, err)
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, fmt.Errorf("%w: token in denom and token out denom must differ, got (%s)", types.ErrInvalidFindBestRouteParams, tokenOutDenom)
+	}
+
+	if maxHops == 0 {
+		maxHops = DefaultFindBestRouteMaxHops
+	}
+	if maxHops > MaxFindBestRouteMaxHops {
+		return nil, osmomath.Int{}, fmt.Errorf("%w: max hops (%d) exceeds the maximum (%d)", types.ErrInvalidFindBestRouteParams, maxHops, MaxFindBestRouteMaxHops)
+	}
+	if maxSplits == 0 {
+		maxSplits = 1
+	}
+	if maxSplits > MaxFindBestRouteMaxSplits {
+		return nil, osmomath.Int{}, fmt.Errorf("%w: max splits (%d) exceeds the maximum (%d)", types.ErrInvalidFindBestRouteParams, maxSplits, MaxFindBestRouteMaxSplits)
+	}
+
+	paths, err := k.findRoutePaths(ctx, tokenIn.Denom, tokenOutDenom, maxHops)
+	if err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	candidates := make([]routeCandidate, 0, len(paths))
+	for _, path := range paths {
+		amountOut, ok := k.estimateRouteOut(ctx, path, tokenIn)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, routeCandidate{pools: path, amountOut: amountOut})
+	}
+	if len(candidates) == 0 {
+		return nil, osmomath.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom, MaxHops: maxHops}
+	}
+
+	// Best candidates first; the stable sort keeps discovery order (by pool ID) for ties
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].amountOut.GT(candidates[j].amountOut)
+	})
+
+	best := candidates[0]
+	bestRoutes := []types.SwapAmountInSplitRoute{{Pools: best.pools, TokenInAmount: tokenIn.Amount}}
+	if maxSplits == 1 || len(candidates) == 1 {
+		return bestRoutes, best.amountOut, nil
+	}
+
+	splitRoutes, splitAmountOut := k.findBestSplit(ctx, candidates, tokenIn, int(maxSplits))
+	if len(splitRoutes) > 1 && splitAmountOut.GT(best.amountOut) {
+		return splitRoutes, splitAmountOut, nil
+	}
+	return bestRoutes, best.amountOut, nil
+}
+
+// findRoutePaths returns paths of active pools from denomIn to denomOut with at most maxHops pools,
+// without revisiting a denom. The search explores the pools holding the most of each denom first and
+// stops after visiting maxRouteSearchSteps pool hops. If more than maxRouteCandidates paths are found,
+// the paths whose last pool holds the most of denomOut are returned.
+func (k Keeper) findRoutePaths(ctx sdk.Context, denomIn string, denomOut string, maxHops uint64) ([][]types.SwapAmountInRoute, error) {
+	liquidityByPool := map[uint64]sdk.Coins{}
+	poolLiquidity := func(poolId uint64, denom string) osmomath.Int {
+		liquidity, ok := liquidityByPool[poolId]
+		if !ok {
+			// Pools whose liquidity cannot be read rank last
+			liquidity, _ = k.GetTotalPoolLiquidity(ctx, poolId)
+			liquidityByPool[poolId] = liquidity
+		}
+		return liquidity.AmountOf(denom)
+	}
+
+	poolsByDenom := map[string][]types.PoolI{}
+	listPools := func(denom string) ([]types.PoolI, error) {
+		if pools, ok := poolsByDenom[denom]; ok {
+			return pools, nil
+		}
+		pools, err := k.ListPoolsByDenom(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		activePools := make([]types.PoolI, 0, len(pools))
+		for _, pool := range pools {
+			if pool.IsActive(ctx) {
+				activePools = append(activePools, pool)
+			}
+		}
+		// Deepest pools first; the stable sort keeps pool ID order for ties
+		sort.SliceStable(activePools, func(i, j int) bool {
+			return poolLiquidity(activePools[i].GetId(), denom).GT(poolLiquidity(activePools[j].GetId(), denom))
+		})
+		poolsByDenom[denom] = activePools
+		return activePools, nil
+	}
+
+	var (
+		paths   [][]types.SwapAmountInRoute
+		current []types.SwapAmountInRoute
+		visited = map[string]bool{denomIn: true}
+		steps   = 0
+	)
+
+	var search func(denom string) error
+	search = func(denom string) error {
+		if steps >= maxRouteSearchSteps || uint64(len(current)) >= maxHops {
+			return nil
+		}
+
+		pools, err := listPools(denom)
+		if err != nil {
+			return err
+		}
+
+		for _, pool := range pools {
+			poolDenoms, err := k.RouteGetPoolDenoms(ctx, pool.GetId())
+			if err != nil {
+				continue
+			}
+			poolDenoms = append([]string{}, poolDenoms...)
+			sort.Strings(poolDenoms)
+
+			for _, nextDenom := range poolDenoms {
+				if visited[nextDenom] {
+					continue
+				}
+				if steps >= maxRouteSearchSteps {
+					return nil
+				}
+				steps++
+
+				current = append(current, types.SwapAmountInRoute{PoolId: pool.GetId(), TokenOutDenom: nextDenom})
+				if nextDenom == denomOut {
+					paths = append(paths, append([]types.SwapAmountInRoute{}, current...))
+				} else {
+					visited[nextDenom] = true
+					if err := search(nextDenom); err != nil {
+						return err
+					}
+					visited[nextDenom] = false
+				}
+				current = current[:len(current)-1]
+			}
+		}
+		return nil
+	}
+
+	if err := search(denomIn); err != nil {
+		return nil, err
+	}
+
+	if len(paths) > maxRouteCandidates {
+		// Rank by how much of denomOut the last pool holds, then by fewer hops; the stable sort keeps search order for ties
+		sort.SliceStable(paths, func(i, j int) bool {
+			liquidityI := poolLiquidity(paths[i][len(paths[i])-1].PoolId, denomOut)
+			liquidityJ := poolLiquidity(paths[j][len(paths[j])-1].PoolId, denomOut)
+			if !liquidityI.Equal(liquidityJ) {
+				return liquidityI.GT(liquidityJ)
+			}
+			return len(paths[i]) < len(paths[j])
+		})
+		paths = paths[:maxRouteCandidates]
+	}
+	return paths, nil
+}
+
+// estimateRouteOut estimates the amount out of swapping tokenIn along the route, including taker fees.
+// Returns false if the route cannot be estimated (e.g., not enough liquidity).
+func (k Keeper) estimateRouteOut(ctx sdk.Context, route []types.SwapAmountInRoute, tokenIn sdk.Coin) (osmomath.Int, bool) {
+	if !tokenIn.Amount.IsPositive() {
+		return osmomath.ZeroInt(), true
+	}
+
+	// Estimate in a cache context so that no estimate can leave state behind
+	cacheCtx, _ := ctx.CacheContext()
+	amountOut, err := k.MultihopEstimateOutGivenExactAmountIn(cacheCtx, route, tokenIn)
+	if err != nil || !amountOut.IsPositive() {
+		return osmomath.Int{}, false
+	}
+	return amountOut, true
+}
+
+// findBestSplit greedily allocates tokenIn in chunks to the candidate path with the highest marginal
+// amount out, using at most maxSplits paths. Paths in a split never share a pool, since the estimate
+// of each path assumes the other paths do not move its pools' prices.
+func (k Keeper) findBestSplit(ctx sdk.Context, candidates []routeCandidate, tokenIn sdk.Coin, maxSplits int) ([]types.SwapAmountInSplitRoute, osmomath.Int) {
+	// Only the best candidates are considered to bound the number of estimates
+	if len(candidates) > 2*maxSplits {
+		candidates = candidates[:2*maxSplits]
+	}
+
+	chunk := tokenIn.Amount.QuoRaw(splitRouteChunks)
+	if !chunk.IsPositive() {
+		return nil, osmomath.ZeroInt()
+	}
+
+	allocations := make([]osmomath.Int, len(candidates))
+	amountsOut := make([]osmomath.Int, len(candidates))
+	for i := range candidates {
+		allocations[i] = osmomath.ZeroInt()
+		amountsOut[i] = osmomath.ZeroInt()
+	}
+	usedPools := map[uint64]bool{}
+	numUsed := 0
+
+	for i := 0; i < splitRouteChunks; i++ {
+		chunkAmount := chunk
+		if i == splitRouteChunks-1 {
+			// The last chunk includes the remainder of the division
+			chunkAmount = tokenIn.Amount.Sub(chunk.MulRaw(splitRouteChunks - 1))
+		}
+
+		bestIndex := -1
+		bestGain := osmomath.ZeroInt()
+		bestAmountOut := osmomath.ZeroInt()
+		for j, candidate := range candidates {
+			if allocations[j].IsZero() && (numUsed >= maxSplits || routeUsesAnyPool(candidate.pools, usedPools)) {
+				continue
+			}
+
+			amountOut, ok := k.estimateRouteOut(ctx, candidate.pools, sdk.NewCoin(tokenIn.Denom, allocations[j].Add(chunkAmount)))
+			if !ok {
+				continue
+			}
+
+			gain := amountOut.Sub(amountsOut[j])
+			if gain.GT(bestGain) {
+				bestIndex, bestGain, bestAmountOut = j, gain, amountOut
+			}
+		}
+
+		if bestIndex == -1 {
+			// No path can absorb the chunk, so the split is not viable
+			return nil, osmomath.ZeroInt()
+		}
+
+		if allocations[bestIndex].IsZero() {
+			numUsed++
+			for _, pool := range candidates[bestIndex].pools {
+				usedPools[pool.PoolId] = true
+			}
+		}
+		allocations[bestIndex] = allocations[bestIndex].Add(chunkAmount)
+		amountsOut[bestIndex] = bestAmountOut
+	}
+
+	routes := []types.SwapAmountInSplitRoute{}
+	totalAmountOut := osmomath.ZeroInt()
+	for i, candidate := range candidates {
+		if allocations[i].IsZero() {
+			continue
+		}
+		routes = append(routes, types.SwapAmountInSplitRoute{Pools: candidate.pools, TokenInAmount: allocations[i]})
+		totalAmountOut = totalAmountOut.Add(amountsOut[i])
+	}
+	return routes, totalAmountOut
+}
+
+func routeUsesAnyPool(route []types.SwapAmountInRoute, pools map[uint64]bool) bool {
+	for _, step := range route {
+		if pools[step.PoolId] {
+			return true
+		}
+	}
+	return false
+}
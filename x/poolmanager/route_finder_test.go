@@ -0,0 +1,191 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/balancer"
+	"github.com/bitbadges/bitbadgeschain/x/poolmanager"
+	"github.com/bitbadges/bitbadgeschain/x/poolmanager/client"
+	"github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+// TestFindBestRoute tests that FindBestRoute returns the route with the highest estimated amount out.
+func (s *KeeperTestSuite) TestFindBestRoute() {
+	smallAmount := osmomath.NewInt(1_000_000)
+	largeAmount := osmomath.NewInt(1_000_000_000)
+
+	// More shallow direct pools than route candidates, followed by the deepest pool
+	manyShallowPoolsThenDeep := []sdk.Coins{}
+	for i := 0; i < 51; i++ {
+		manyShallowPoolsThenDeep = append(manyShallowPoolsThenDeep, sdk.NewCoins(sdk.NewCoin(FOO, smallAmount), sdk.NewCoin(BAR, smallAmount)))
+	}
+	manyShallowPoolsThenDeep = append(manyShallowPoolsThenDeep, sdk.NewCoins(sdk.NewCoin(FOO, largeAmount), sdk.NewCoin(BAR, largeAmount)))
+
+	tests := []struct {
+		name          string
+		poolCoins     []sdk.Coins
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       uint64
+		maxSplits     uint64
+
+		expectedRoutes [][]types.SwapAmountInRoute
+		expectedError  error
+	}{
+		{
+			name: "single hop - picks the deeper pool",
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, smallAmount), sdk.NewCoin(BAR, smallAmount)),
+				sdk.NewCoins(sdk.NewCoin(FOO, largeAmount), sdk.NewCoin(BAR, largeAmount)),
+			},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(100_000)),
+			tokenOutDenom: BAR,
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 2, TokenOutDenom: BAR}},
+			},
+		},
+		{
+			name: "multihop - beats a shallow direct pool",
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, smallAmount), sdk.NewCoin(BAZ, smallAmount)),
+				sdk.NewCoins(sdk.NewCoin(FOO, largeAmount), sdk.NewCoin(BAR, largeAmount)),
+				sdk.NewCoins(sdk.NewCoin(BAR, largeAmount), sdk.NewCoin(BAZ, largeAmount)),
+			},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(100_000)),
+			tokenOutDenom: BAZ,
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 2, TokenOutDenom: BAR}, {PoolId: 3, TokenOutDenom: BAZ}},
+			},
+		},
+		{
+			name: "multihop - max hops of one only considers the direct pool",
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, smallAmount), sdk.NewCoin(BAZ, smallAmount)),
+				sdk.NewCoins(sdk.NewCoin(FOO, largeAmount), sdk.NewCoin(BAR, largeAmount)),
+				sdk.NewCoins(sdk.NewCoin(BAR, largeAmount), sdk.NewCoin(BAZ, largeAmount)),
+			},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(100_000)),
+			tokenOutDenom: BAZ,
+			maxHops:       1,
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 1, TokenOutDenom: BAZ}},
+			},
+		},
+		{
+			name: "split - large swap is split across two equal pools",
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, smallAmount), sdk.NewCoin(BAR, smallAmount)),
+				sdk.NewCoins(sdk.NewCoin(FOO, smallAmount), sdk.NewCoin(BAR, smallAmount)),
+			},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(500_000)),
+			tokenOutDenom: BAR,
+			maxSplits:     2,
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 1, TokenOutDenom: BAR}},
+				{{PoolId: 2, TokenOutDenom: BAR}},
+			},
+		},
+		{
+			name: "split - small swap is not split",
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, smallAmount), sdk.NewCoin(BAR, smallAmount)),
+				sdk.NewCoins(sdk.NewCoin(FOO, largeAmount), sdk.NewCoin(BAR, largeAmount)),
+			},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(10_000)),
+			tokenOutDenom: BAR,
+			maxSplits:     2,
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 2, TokenOutDenom: BAR}},
+			},
+		},
+		{
+			name:          "candidates - deepest pool is considered regardless of pool ID order",
+			poolCoins:     manyShallowPoolsThenDeep,
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(100_000)),
+			tokenOutDenom: BAR,
+			maxHops:       1,
+			expectedRoutes: [][]types.SwapAmountInRoute{
+				{{PoolId: 52, TokenOutDenom: BAR}},
+			},
+		},
+		{
+			name: "error: no route",
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, largeAmount), sdk.NewCoin(BAR, largeAmount)),
+			},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(100_000)),
+			tokenOutDenom: BAZ,
+			expectedError: types.NoRouteFoundError{TokenInDenom: FOO, TokenOutDenom: BAZ, MaxHops: poolmanager.DefaultFindBestRouteMaxHops},
+		},
+		{
+			name: "error: max hops too large",
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, largeAmount), sdk.NewCoin(BAR, largeAmount)),
+			},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(100_000)),
+			tokenOutDenom: BAR,
+			maxHops:       poolmanager.MaxFindBestRouteMaxHops + 1,
+			expectedError: types.ErrInvalidFindBestRouteParams,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			k := s.App.PoolManagerKeeper
+
+			for _, poolCoins := range tc.poolCoins {
+				s.FundAcc(s.TestAccs[0], poolCoins)
+				s.PrepareCustomBalancerPoolFromCoins(poolCoins, balancer.PoolParams{
+					SwapFee: defaultPoolSpreadFactor,
+					ExitFee: osmomath.ZeroDec(),
+				})
+			}
+
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tc.tokenIn))
+
+			routes, tokenOutAmount, err := k.FindBestRoute(s.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxSplits)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(routes, len(tc.expectedRoutes))
+
+			totalTokenIn := osmomath.ZeroInt()
+			for i, route := range routes {
+				s.Require().Equal(tc.expectedRoutes[i], route.Pools)
+				totalTokenIn = totalTokenIn.Add(route.TokenInAmount)
+			}
+			s.Require().Equal(tc.tokenIn.Amount, totalTokenIn)
+
+			// The estimate matches the amount out of executing the returned routes
+			actualTokenOutAmount, err := k.SplitRouteExactAmountIn(s.Ctx, s.TestAccs[0], routes, tc.tokenIn.Denom, osmomath.OneInt())
+			s.Require().NoError(err)
+			s.Require().Equal(tokenOutAmount, actualTokenOutAmount)
+		})
+	}
+}
+
+// TestFindBestRouteQueryErrorCodes tests that the FindBestRoute query maps client errors to gRPC codes.
+func (s *KeeperTestSuite) TestFindBestRouteQueryErrorCodes() {
+	poolCoins := sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(BAR, osmomath.NewInt(1_000_000_000)))
+	s.FundAcc(s.TestAccs[0], poolCoins)
+	s.PrepareCustomBalancerPoolFromCoins(poolCoins, balancer.PoolParams{
+		SwapFee: defaultPoolSpreadFactor,
+		ExitFee: osmomath.ZeroDec(),
+	})
+	querier := client.NewQuerier(&s.App.PoolManagerKeeper)
+
+	_, err := querier.FindBestRoute(s.Ctx, types.FindBestRouteRequest{TokenIn: "100000" + FOO, TokenOutDenom: BAR, MaxHops: poolmanager.MaxFindBestRouteMaxHops + 1})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = querier.FindBestRoute(s.Ctx, types.FindBestRouteRequest{TokenIn: "100000" + FOO, TokenOutDenom: BAZ})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = querier.FindBestRoute(s.Ctx, types.FindBestRouteRequest{TokenIn: "100000" + FOO, TokenOutDenom: BAR})
+	s.Require().NoError(err)
+}
@@ -209,13 +209,16 @@ func (k Keeper) SwapExactAmountIn(
 
 	// routeStep to the pool-specific SwapExactAmountIn implementation.
 	// Pass affiliates through to the pool module (gamm handles them)
-	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenInAfterSubTakerFee, tokenOutDenom, tokenOutMinAmount, pool.GetSpreadFactor(ctx), affiliates)
+	spreadFactor := pool.GetSpreadFactor(ctx)
+	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenInAfterSubTakerFee, tokenOutDenom, tokenOutMinAmount, spreadFactor, affiliates)
 	if err != nil {
 		return osmomath.Int{}, sdk.Coin{}, err
 	}
 
 	// Track volume for volume-splitting incentives
 	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	// Track daily volume and swap fees for analytics. The spread factor is charged on the amount entering the pool.
+	k.trackDailyVolume(ctx, pool.GetId(), tokenIn, swapFeeForTokenIn(tokenInAfterSubTakerFee, spreadFactor))
 
 	return tokenOutAmount, takerFeeCharged, nil
 }
@@ -246,13 +249,16 @@ func (k Keeper) SwapExactAmountInNoTakerFee(
 
 	// routeStep to the pool-specific SwapExactAmountIn implementation.
 	// Pass nil for affiliates as poolmanager doesn't handle affiliates (only gamm does)
-	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, pool.GetSpreadFactor(ctx), affiliates)
+	spreadFactor := pool.GetSpreadFactor(ctx)
+	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, spreadFactor, affiliates)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
 
 	// Track volume for volume-splitting incentives
 	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	// Track daily volume and swap fees for analytics
+	k.trackDailyVolume(ctx, pool.GetId(), tokenIn, swapFeeForTokenIn(tokenIn, spreadFactor))
 
 	return tokenOutAmount, nil
 }
@@ -417,6 +423,8 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 
 		// Track volume for volume-splitting incentives
 		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
+		// Track daily volume and swap fees for analytics. The volume includes the taker fee, as for exact amount in swaps.
+		k.trackDailyVolume(ctx, pool.GetId(), tokenInAfterAddTakerFee, swapFeeForTokenIn(tokenIn, spreadFactor))
 
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
@@ -24,6 +24,8 @@ var (
 	ErrSetRegisteredAlloyedPool                  = errors.New("error setting registered alloyed pool")
 	ErrInvalidKeyFormat                          = errors.New("invalid key format")
 	ErrTotalAlloyedLiquidityIsZero               = errors.New("totalAlloyedLiquidity is zero")
+	ErrInvalidFindBestRouteParams                = errors.New("invalid find best route params")
+	ErrInvalidPoolFeeAprParams                   = errors.New("invalid pool fee apr params")
 )
 
 type nonPositiveAmountError struct {
@@ -42,6 +44,16 @@ func (e FailedToFindRouteError) Error() string {
 	return fmt.Sprintf("failed to find route for pool id (%d)", e.PoolId)
 }
 
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+	MaxHops       uint64
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s) within (%d) hops", e.TokenInDenom, e.TokenOutDenom, e.MaxHops)
+}
+
 type UndefinedRouteError struct {
 	PoolType PoolType
 	PoolId   uint64
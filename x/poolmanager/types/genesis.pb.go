@@ -87,6 +87,7 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	PoolDailyVolumes       []PoolDailyVolume   `protobuf:"bytes,7,rep,name=pool_daily_volumes,json=poolDailyVolumes,proto3" json:"pool_daily_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -164,6 +165,13 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetPoolDailyVolumes() []PoolDailyVolume {
+	if m != nil {
+		return m.PoolDailyVolumes
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -452,71 +460,73 @@ func init() {
 func init() { proto.RegisterFile("poolmanager/v1beta1/genesis.proto", fileDescriptor_91ab649b91e93e7f) }
 
 var fileDescriptor_91ab649b91e93e7f = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x17, 0x35, 0x23, 0x47, 0x81, 0xc7, 0xfe, 0x6c, 0x7f, 0x93, 0x3a, 0x61, 0x24, 0x57, 0x54, 0x99,
-	0x26, 0x50, 0x17, 0x15, 0x9b, 0x04, 0x48, 0xd1, 0xa2, 0x59, 0x58, 0x36, 0x1c, 0x14, 0x68, 0x53,
-	0x97, 0x12, 0x5a, 0xa0, 0x9b, 0xc1, 0x88, 0x1c, 0x51, 0x84, 0xc8, 0x19, 0x81, 0x33, 0x8c, 0xad,
-	0xb7, 0x28, 0xd0, 0xae, 0xfb, 0x00, 0xdd, 0x15, 0x28, 0xd0, 0x57, 0xc8, 0x32, 0xcb, 0xa2, 0x0b,
-	0xb6, 0x90, 0x97, 0x45, 0x37, 0x7a, 0x82, 0x62, 0x66, 0xa8, 0xdf, 0xd0, 0xaa, 0x91, 0x95, 0xc4,
-	0xfb, 0x73, 0xee, 0x39, 0x97, 0x73, 0xe7, 0x12, 0xbc, 0x37, 0x64, 0x2c, 0x8a, 0x31, 0xc5, 0x01,
-	0x49, 0x9c, 0x97, 0x8f, 0xba, 0x44, 0xe0, 0x47, 0x4e, 0x40, 0x28, 0xe1, 0x21, 0x6f, 0x0e, 0x13,
-	0x26, 0x18, 0xbc, 0xbd, 0x10, 0xd2, 0xcc, 0x43, 0x2a, 0xef, 0x04, 0x2c, 0x60, 0xca, 0xef, 0xc8,
-	0x7f, 0x3a, 0xb4, 0x72, 0x2f, 0x60, 0x2c, 0x88, 0x88, 0xa3, 0x9e, 0xba, 0x69, 0xcf, 0xc1, 0x74,
-	0x34, 0x75, 0x79, 0x8c, 0xc7, 0x8c, 0x23, 0x9d, 0xa3, 0x1f, 0x72, 0x57, 0x6d, 0x35, 0xcb, 0x4f,
-	0x13, 0x2c, 0x42, 0x46, 0xa7, 0x7e, 0x1d, 0xed, 0x74, 0x31, 0x27, 0x33, 0x8e, 0x1e, 0x0b, 0xa7,
-	0xfe, 0x87, 0x45, 0x1a, 0x62, 0xe6, 0xa7, 0x11, 0x41, 0x09, 0x4b, 0x05, 0xc9, 0xe3, 0x0e, 0x8b,
-	0xe2, 0xc4, 0x85, 0xf6, 0xda, 0x17, 0xa0, 0x7c, 0x86, 0x13, 0x1c, 0x73, 0x48, 0xc1, 0xbe, 0xc0,
-	0x03, 0x92, 0xa0, 0x1e, 0x21, 0x68, 0xa8, 0x6c, 0xa6, 0x51, 0x37, 0x1a, 0xdb, 0x8f, 0xef, 0x37,
-	0x0b, 0x7a, 0xd1, 0xec, 0xc8, 0xe0, 0x53, 0x42, 0x74, 0x7a, 0xcb, 0x7a, 0x95, 0x59, 0x1b, 0x93,
-	0xcc, 0xba, 0x3b, 0xc2, 0x71, 0xf4, 0xa9, 0xbd, 0x0a, 0x65, 0xbb, 0xbb, 0x62, 0x29, 0xc1, 0xfe,
-	0xad, 0x04, 0x76, 0x9e, 0xeb, 0x96, 0xb7, 0x05, 0x16, 0x04, 0xd6, 0xc1, 0x0e, 0x25, 0x17, 0x02,
-	0xc9, 0x62, 0x28, 0xf4, 0x55, 0xf1, 0x4d, 0x17, 0x48, 0xdb, 0x19, 0x63, 0xd1, 0xe7, 0x3e, 0xfc,
-	0x04, 0x94, 0x73, 0x62, 0x37, 0x14, 0xb1, 0x6a, 0x21, 0xb1, 0x9c, 0xd0, 0xa6, 0x24, 0xe4, 0xe6,
-	0x09, 0xf0, 0x39, 0xd8, 0x56, 0xb8, 0xaa, 0x33, 0xdc, 0x2c, 0xd5, 0x4b, 0x8d, 0xed, 0xc7, 0xf5,
-	0xc2, 0xfc, 0x2f, 0x55, 0x0f, 0x5d, 0x19, 0x98, 0x83, 0x00, 0x19, 0xa6, 0x0c, 0x1c, 0xb6, 0x01,
-	0x9c, 0x69, 0xe3, 0x48, 0x24, 0xd8, 0x1b, 0x90, 0xc4, 0xdc, 0x54, 0x7c, 0x1e, 0xac, 0x6d, 0x14,
-	0xef, 0xe8, 0x60, 0x77, 0x5f, 0xac, 0x58, 0x60, 0x0b, 0xec, 0x28, 0x76, 0x2f, 0x59, 0x94, 0xc6,
-	0x84, 0x9b, 0x37, 0x15, 0x3d, 0xab, 0x58, 0x1e, 0x63, 0xd1, 0x37, 0x2a, 0xce, 0x55, 0x92, 0xf4,
-	0x7f, 0x0e, 0xfb, 0xa0, 0xe2, 0x13, 0xca, 0x62, 0x34, 0xc4, 0x61, 0x82, 0xe6, 0xfd, 0xe7, 0x82,
-	0x25, 0xc4, 0x2c, 0x2b, 0xc4, 0x87, 0x85, 0x88, 0x27, 0x32, 0xed, 0x0c, 0x87, 0xc9, 0x94, 0x69,
-	0x2e, 0xfb, 0x8e, 0xbf, 0xea, 0x68, 0x4b, 0x2c, 0xfb, 0xef, 0x32, 0xd8, 0x5d, 0x7e, 0xfb, 0xb0,
-	0x0b, 0xfe, 0xef, 0x93, 0x1e, 0x4e, 0x23, 0x31, 0xaf, 0xac, 0x5e, 0xe0, 0x56, 0xeb, 0xa9, 0xc4,
-	0xfa, 0x23, 0xb3, 0xaa, 0xfa, 0x3c, 0x73, 0x7f, 0xd0, 0x0c, 0x99, 0x13, 0x63, 0xd1, 0x6f, 0x7e,
-	0x41, 0x02, 0xec, 0x8d, 0x4e, 0x88, 0x37, 0xce, 0xac, 0xbd, 0x13, 0x9d, 0x3f, 0x05, 0x76, 0xf7,
-	0xfc, 0x65, 0x03, 0xfc, 0xd1, 0x00, 0x55, 0x09, 0xb1, 0xa0, 0xcd, 0x0f, 0xb9, 0x48, 0xc2, 0x6e,
-	0x2a, 0xc7, 0x26, 0x3f, 0x13, 0x4f, 0xd6, 0xbe, 0x83, 0x93, 0x85, 0x84, 0x33, 0x92, 0x78, 0x84,
-	0x0a, 0x1c, 0x90, 0x56, 0x5d, 0x72, 0x1c, 0x67, 0x96, 0xf9, 0x15, 0x8f, 0x59, 0x51, 0xac, 0x6b,
-	0xb2, 0x2b, 0x3c, 0xf0, 0x27, 0x03, 0x58, 0x94, 0x51, 0xb4, 0x8e, 0x5a, 0xe9, 0xed, 0xa9, 0xdd,
-	0xcf, 0xa9, 0x55, 0x5f, 0x30, 0x7a, 0x25, 0xbb, 0x2a, 0xbd, 0xda, 0x09, 0x8f, 0xc1, 0x1e, 0xf6,
-	0xe3, 0x90, 0x22, 0xec, 0xfb, 0x09, 0xe1, 0x9c, 0x70, 0x73, 0xb3, 0x5e, 0x6a, 0x6c, 0xb5, 0x2a,
-	0x93, 0xcc, 0xba, 0xa3, 0xc7, 0x75, 0x25, 0xc0, 0x76, 0x77, 0x95, 0xe5, 0x68, 0x6a, 0x80, 0xbf,
-	0x18, 0xe0, 0xa9, 0xc7, 0xe2, 0x38, 0xa5, 0xa1, 0x18, 0xe9, 0x11, 0xd5, 0xa7, 0x4d, 0x30, 0xc4,
-	0xcf, 0xf1, 0x10, 0xc9, 0x16, 0x9c, 0xf7, 0x43, 0x41, 0xa2, 0x90, 0x0b, 0xe2, 0x23, 0xcc, 0x39,
-	0x11, 0x1c, 0x09, 0x66, 0xde, 0x54, 0xc7, 0xe0, 0x68, 0x92, 0x59, 0xcf, 0x74, 0xb1, 0xb7, 0xc3,
-	0xb1, 0xdd, 0xe6, 0x2c, 0x51, 0xce, 0x80, 0x3a, 0xb5, 0x1d, 0xd6, 0x3e, 0xc7, 0xc3, 0x17, 0x8c,
-	0x7e, 0x3b, 0x4f, 0x39, 0x52, 0x19, 0x1d, 0x06, 0x3b, 0xe0, 0x20, 0x21, 0x7e, 0xea, 0x11, 0x5f,
-	0xbd, 0x91, 0x19, 0xaa, 0x1a, 0x86, 0xad, 0x56, 0x7d, 0x92, 0x59, 0x87, 0x9a, 0x51, 0x61, 0x98,
-	0xed, 0xde, 0xce, 0xed, 0xa7, 0x84, 0xcc, 0xf0, 0x21, 0x05, 0xb5, 0x42, 0x01, 0x73, 0xf8, 0x5b,
-	0x0a, 0xfe, 0x83, 0x49, 0x66, 0x3d, 0x58, 0x23, 0x78, 0xa1, 0x4e, 0xf5, 0x4d, 0x61, 0xb3, 0x7a,
-	0xf6, 0x3f, 0x06, 0xa8, 0xad, 0x3f, 0x23, 0xb0, 0x07, 0xf6, 0xb8, 0xc0, 0x83, 0x90, 0x06, 0x28,
-	0x21, 0xe7, 0x38, 0xf1, 0x79, 0x3e, 0x7b, 0xcf, 0xae, 0x31, 0x7b, 0xf3, 0x43, 0xb0, 0x82, 0x61,
-	0xbb, 0xbb, 0xb9, 0xc5, 0xd5, 0x06, 0xe8, 0x81, 0xdd, 0x65, 0x29, 0x6a, 0xe6, 0xb6, 0x5a, 0x9f,
-	0x5d, 0xaf, 0xcc, 0x41, 0x51, 0x37, 0x6c, 0xf7, 0x7f, 0x4b, 0xea, 0xed, 0x5f, 0x6f, 0x80, 0xfd,
-	0xd5, 0x2b, 0x13, 0xba, 0xe0, 0x60, 0xf1, 0xd6, 0x65, 0x88, 0xab, 0x47, 0xa9, 0x53, 0xde, 0x6b,
-	0xf7, 0x9a, 0xf9, 0x6a, 0x95, 0xcb, 0x72, 0x36, 0x59, 0xc7, 0x2c, 0xa4, 0xf9, 0x55, 0x06, 0xe7,
-	0x57, 0x2e, 0x6b, 0xeb, 0x54, 0x88, 0xc0, 0xe1, 0x32, 0xe6, 0x1b, 0xda, 0xae, 0x05, 0x6d, 0x2e,
-	0x40, 0x1f, 0x2f, 0x2a, 0x81, 0x03, 0xf0, 0x6e, 0x9f, 0x84, 0x41, 0x5f, 0x20, 0xec, 0x79, 0x2c,
-	0xa5, 0x42, 0x36, 0x97, 0x0b, 0x9c, 0x08, 0x8e, 0x7a, 0x09, 0x8b, 0xd5, 0xb5, 0x50, 0x6a, 0x35,
-	0x26, 0x99, 0xf5, 0xbe, 0x6e, 0xcd, 0xda, 0x70, 0xdb, 0xad, 0x68, 0xff, 0xd1, 0xcc, 0xdd, 0x56,
-	0xde, 0x53, 0xe9, 0xfc, 0xc1, 0x00, 0x60, 0xbe, 0x1a, 0xe0, 0x5d, 0x70, 0x6b, 0x79, 0x8f, 0x96,
-	0x87, 0x7a, 0x87, 0x46, 0xf9, 0x22, 0xd4, 0xab, 0xe6, 0xbf, 0x45, 0x7e, 0x24, 0x45, 0xfe, 0xfc,
-	0xa7, 0xd5, 0x08, 0x42, 0xd1, 0x4f, 0xbb, 0x72, 0x06, 0xf3, 0xef, 0x98, 0xfc, 0xe7, 0x43, 0xee,
-	0x0f, 0x1c, 0x31, 0x1a, 0x12, 0xae, 0x12, 0xb8, 0xde, 0x96, 0x9a, 0x46, 0xeb, 0xeb, 0x57, 0xe3,
-	0x9a, 0xf1, 0x7a, 0x5c, 0x33, 0xfe, 0x1a, 0xd7, 0x8c, 0xef, 0x2f, 0x6b, 0x1b, 0xaf, 0x2f, 0x6b,
-	0x1b, 0xbf, 0x5f, 0xd6, 0x36, 0xbe, 0xfb, 0x78, 0x01, 0xaf, 0x1b, 0x8a, 0x2e, 0xf6, 0x03, 0xc2,
-	0xe7, 0xff, 0xbc, 0x3e, 0x0e, 0xa9, 0x73, 0xe1, 0x2c, 0x7e, 0xbc, 0xa8, 0x22, 0xdd, 0xb2, 0xfa,
-	0x70, 0x79, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8b, 0xbd, 0xc1, 0xc8, 0xc4, 0x09, 0x00,
+	// 1057 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
+	0x17, 0x8e, 0x9b, 0xd4, 0x51, 0x26, 0x79, 0x93, 0xbc, 0x53, 0xd2, 0xba, 0x71, 0xf0, 0x9a, 0xed,
+	0x87, 0xcc, 0x05, 0x36, 0x6d, 0xa5, 0x22, 0x10, 0xbd, 0x88, 0x13, 0xa5, 0x42, 0x82, 0x12, 0xd6,
+	0x11, 0x20, 0x6e, 0x46, 0xe3, 0x9d, 0xc9, 0x7a, 0xe4, 0xdd, 0x19, 0x6b, 0x67, 0xb6, 0x89, 0xff,
+	0x05, 0x08, 0xae, 0xf9, 0x01, 0xdc, 0x21, 0xf1, 0x23, 0x7a, 0xd9, 0x4b, 0xc4, 0x85, 0x41, 0xc9,
+	0x25, 0xe2, 0xc6, 0xbf, 0x00, 0xcd, 0x87, 0x3f, 0xbb, 0x31, 0x51, 0xaf, 0xec, 0x3d, 0xe7, 0x39,
+	0xcf, 0x3c, 0xe7, 0xec, 0x39, 0x73, 0x16, 0xbc, 0xd7, 0x13, 0x22, 0x4e, 0x30, 0xc7, 0x11, 0x4d,
+	0x1b, 0x2f, 0x1f, 0xb5, 0xa9, 0xc2, 0x8f, 0x1a, 0x11, 0xe5, 0x54, 0x32, 0x59, 0xef, 0xa5, 0x42,
+	0x09, 0x78, 0x6b, 0x0a, 0x52, 0x77, 0x90, 0xdd, 0x77, 0x22, 0x11, 0x09, 0xe3, 0x6f, 0xe8, 0x7f,
+	0x16, 0xba, 0x7b, 0x37, 0x12, 0x22, 0x8a, 0x69, 0xc3, 0x3c, 0xb5, 0xb3, 0xd3, 0x06, 0xe6, 0xfd,
+	0x91, 0x2b, 0x14, 0x32, 0x11, 0x12, 0xd9, 0x18, 0xfb, 0xe0, 0x5c, 0x95, 0xf9, 0x28, 0x92, 0xa5,
+	0x58, 0x31, 0xc1, 0x47, 0x7e, 0x8b, 0x6e, 0xb4, 0xb1, 0xa4, 0x63, 0x8d, 0xa1, 0x60, 0x23, 0xff,
+	0xc3, 0xbc, 0x1c, 0x12, 0x41, 0xb2, 0x98, 0xa2, 0x54, 0x64, 0x8a, 0x3a, 0xdc, 0x5e, 0x1e, 0x4e,
+	0x9d, 0x3b, 0x6f, 0x2d, 0xd7, 0x9b, 0xe2, 0xb0, 0x4b, 0x09, 0x7a, 0x29, 0xe2, 0x2c, 0x71, 0x3c,
+	0xfe, 0x39, 0x28, 0x1e, 0xe3, 0x14, 0x27, 0x12, 0x72, 0xb0, 0xad, 0x70, 0x97, 0xa6, 0xe8, 0x94,
+	0x52, 0xd4, 0x33, 0xb6, 0x52, 0xa1, 0x5a, 0xa8, 0xad, 0x3f, 0xbe, 0x57, 0xcf, 0xa9, 0x5a, 0xfd,
+	0x44, 0x83, 0x8f, 0x28, 0xb5, 0xe1, 0x4d, 0xef, 0xd5, 0xc0, 0x5b, 0x1a, 0x0e, 0xbc, 0x3b, 0x7d,
+	0x9c, 0xc4, 0x9f, 0xf8, 0xf3, 0x54, 0x7e, 0xb0, 0xa9, 0x66, 0x02, 0xfc, 0x1f, 0x56, 0xc0, 0xc6,
+	0x73, 0xfb, 0x72, 0x5a, 0x0a, 0x2b, 0x0a, 0xab, 0x60, 0x83, 0xd3, 0x73, 0x85, 0xf4, 0x61, 0x88,
+	0x11, 0x73, 0xf8, 0x4a, 0x00, 0xb4, 0xed, 0x58, 0x88, 0xf8, 0x33, 0x02, 0x3f, 0x06, 0x45, 0x27,
+	0xec, 0x86, 0x11, 0x56, 0xce, 0x15, 0xe6, 0x04, 0xad, 0x68, 0x41, 0x81, 0x0b, 0x80, 0xcf, 0xc1,
+	0xba, 0xe1, 0x35, 0x35, 0x94, 0xa5, 0xe5, 0xea, 0x72, 0x6d, 0xfd, 0x71, 0x35, 0x37, 0xfe, 0x0b,
+	0x53, 0xed, 0x40, 0x03, 0x1d, 0x09, 0xd0, 0x30, 0x63, 0x90, 0xb0, 0x05, 0xe0, 0x38, 0x37, 0x89,
+	0x6c, 0x4d, 0xd3, 0xd2, 0x8a, 0xd1, 0xf3, 0x60, 0x61, 0xa1, 0xe4, 0x89, 0x05, 0x07, 0xdb, 0x6a,
+	0xce, 0x02, 0x9b, 0x60, 0xc3, 0xa8, 0xb3, 0xaf, 0x46, 0x96, 0x6e, 0x1a, 0x79, 0x5e, 0x7e, 0x7a,
+	0x42, 0xc4, 0x5f, 0x1b, 0x5c, 0xb0, 0xde, 0x1b, 0xff, 0x97, 0xb0, 0x03, 0x76, 0x09, 0xe5, 0x22,
+	0x41, 0x3d, 0xcc, 0x52, 0x34, 0xa9, 0xbf, 0x54, 0x22, 0xa5, 0xa5, 0xa2, 0x61, 0x7c, 0x98, 0xcb,
+	0x78, 0xa8, 0xc3, 0x8e, 0x31, 0x4b, 0x47, 0x4a, 0x5d, 0xda, 0xb7, 0xc9, 0xbc, 0xa3, 0xa5, 0xb9,
+	0xe0, 0xb7, 0x00, 0x1a, 0xb5, 0x04, 0xb3, 0xb8, 0x3f, 0xd6, 0xbc, 0x6a, 0x4e, 0xb8, 0x7f, 0xa5,
+	0xe6, 0x43, 0x8d, 0xb6, 0x62, 0x1d, 0xff, 0x76, 0x6f, 0xd6, 0x2c, 0xfd, 0xbf, 0x8b, 0x60, 0x73,
+	0xb6, 0xaf, 0x60, 0x1b, 0xfc, 0x9f, 0xd0, 0x53, 0x9c, 0xc5, 0x6a, 0x92, 0x93, 0x69, 0x8d, 0xb5,
+	0xe6, 0x53, 0xcd, 0xf2, 0xc7, 0xc0, 0x2b, 0xdb, 0x99, 0x92, 0xa4, 0x5b, 0x67, 0xa2, 0x91, 0x60,
+	0xd5, 0xa9, 0x7f, 0x4e, 0x23, 0x1c, 0xf6, 0x0f, 0x69, 0x78, 0x31, 0xf0, 0xb6, 0x0e, 0x6d, 0xfc,
+	0x88, 0x38, 0xd8, 0x22, 0xb3, 0x06, 0xf8, 0x53, 0x01, 0x94, 0x35, 0xc5, 0x54, 0xd5, 0x08, 0x93,
+	0x2a, 0x65, 0xed, 0x4c, 0x8f, 0xae, 0xeb, 0xb6, 0x27, 0x0b, 0xdf, 0xee, 0xe1, 0x54, 0xc0, 0x31,
+	0x4d, 0x43, 0xca, 0x15, 0x8e, 0x68, 0xb3, 0xaa, 0x35, 0x5e, 0x0c, 0xbc, 0xd2, 0x97, 0x32, 0x11,
+	0x79, 0xd8, 0xa0, 0x24, 0xae, 0xf0, 0xc0, 0x9f, 0x0b, 0xc0, 0xe3, 0x82, 0xa3, 0x45, 0xd2, 0x96,
+	0xdf, 0x5e, 0xda, 0x3d, 0x27, 0xad, 0xfc, 0x42, 0xf0, 0x2b, 0xd5, 0x95, 0xf9, 0xd5, 0x4e, 0x78,
+	0x00, 0xb6, 0x30, 0x49, 0x18, 0x47, 0x98, 0x90, 0x94, 0x4a, 0x49, 0x65, 0x69, 0xa5, 0xba, 0x5c,
+	0x5b, 0x6b, 0xee, 0x0e, 0x07, 0xde, 0x6d, 0x7b, 0x11, 0xcc, 0x01, 0xfc, 0x60, 0xd3, 0x58, 0xf6,
+	0x47, 0x06, 0xf8, 0x6b, 0x01, 0x3c, 0x0d, 0x45, 0x92, 0x64, 0x9c, 0xa9, 0xbe, 0x1d, 0x7e, 0xdb,
+	0xc7, 0x4a, 0x20, 0x79, 0x86, 0x7b, 0x48, 0x97, 0xe0, 0xac, 0xc3, 0x14, 0x8d, 0x99, 0x54, 0x94,
+	0x20, 0x2c, 0x25, 0x55, 0x12, 0x29, 0x51, 0xba, 0x69, 0xda, 0x60, 0x7f, 0x38, 0xf0, 0x9e, 0xd9,
+	0xc3, 0xde, 0x8e, 0xc7, 0x0f, 0xea, 0xe3, 0x40, 0xd3, 0xa9, 0x3a, 0xec, 0x44, 0xb4, 0xce, 0x70,
+	0xef, 0x85, 0xe0, 0xdf, 0x4c, 0x42, 0xf6, 0x4d, 0xc4, 0x89, 0x80, 0x27, 0x60, 0x27, 0xa5, 0x24,
+	0x0b, 0x29, 0x31, 0x6f, 0x64, 0xcc, 0x6a, 0xc6, 0x6c, 0xad, 0x59, 0x1d, 0x0e, 0xbc, 0x3d, 0xab,
+	0x28, 0x17, 0xe6, 0x07, 0xb7, 0x9c, 0xfd, 0x88, 0xd2, 0x31, 0x3f, 0xe4, 0xa0, 0x92, 0x9b, 0xc0,
+	0x84, 0x7e, 0xd5, 0xd0, 0xbf, 0x3f, 0x1c, 0x78, 0x0f, 0x16, 0x24, 0x3c, 0x75, 0x4e, 0xf9, 0xcd,
+	0xc4, 0xc6, 0xe7, 0xf9, 0xff, 0x14, 0x40, 0x65, 0x71, 0x8f, 0xc0, 0x53, 0xb0, 0x25, 0x15, 0xee,
+	0x32, 0x1e, 0xa1, 0x94, 0x9e, 0xe1, 0x94, 0x48, 0x37, 0x7b, 0xcf, 0xae, 0x31, 0x7b, 0x93, 0x26,
+	0x98, 0xe3, 0xf0, 0x83, 0x4d, 0x67, 0x09, 0xac, 0x01, 0x86, 0x60, 0x73, 0x36, 0x15, 0x33, 0x73,
+	0x6b, 0xcd, 0x4f, 0xaf, 0x77, 0xcc, 0x4e, 0x5e, 0x35, 0xfc, 0xe0, 0x7f, 0x33, 0xd9, 0xfb, 0xbf,
+	0xdd, 0x00, 0xdb, 0xf3, 0x97, 0x31, 0x0c, 0xc0, 0xce, 0xf4, 0x7d, 0x2e, 0x90, 0x34, 0x8f, 0x3a,
+	0x4f, 0x7d, 0x9f, 0xdd, 0xad, 0xbb, 0xf5, 0xae, 0x17, 0xf6, 0x78, 0xb2, 0x0e, 0x04, 0xe3, 0xee,
+	0x12, 0x83, 0x93, 0xcb, 0x5c, 0xb4, 0x6c, 0x28, 0x44, 0x60, 0x6f, 0x96, 0xf3, 0x8d, 0xdc, 0xae,
+	0x45, 0x5d, 0x9a, 0xa2, 0x3e, 0x98, 0xce, 0x04, 0x76, 0xc1, 0xbb, 0x1d, 0xca, 0xa2, 0x8e, 0x42,
+	0x38, 0x0c, 0x45, 0xc6, 0x95, 0x2e, 0xae, 0x54, 0x38, 0x55, 0x12, 0x9d, 0xa6, 0x22, 0x31, 0xd7,
+	0xc2, 0x72, 0xb3, 0x36, 0x1c, 0x78, 0xf7, 0x6d, 0x69, 0x16, 0xc2, 0xfd, 0x60, 0xd7, 0xfa, 0xf7,
+	0xc7, 0xee, 0x96, 0xf1, 0x1e, 0x69, 0xe7, 0x8f, 0x05, 0x00, 0x26, 0x4b, 0x07, 0xde, 0x01, 0xab,
+	0xb3, 0x1b, 0xba, 0xd8, 0xb3, 0xdb, 0x39, 0x76, 0x2b, 0xd6, 0x2e, 0x84, 0xff, 0x4e, 0xf2, 0x43,
+	0x9d, 0xe4, 0x2f, 0x7f, 0x7a, 0xb5, 0x88, 0xa9, 0x4e, 0xd6, 0xd6, 0x33, 0xe8, 0xbe, 0xa5, 0xdc,
+	0xcf, 0x07, 0x92, 0x74, 0x1b, 0xaa, 0xdf, 0xa3, 0xd2, 0x04, 0x48, 0xbb, 0x87, 0xdd, 0x0a, 0xf9,
+	0xea, 0xd5, 0x45, 0xa5, 0xf0, 0xfa, 0xa2, 0x52, 0xf8, 0xeb, 0xa2, 0x52, 0xf8, 0xfe, 0xb2, 0xb2,
+	0xf4, 0xfa, 0xb2, 0xb2, 0xf4, 0xfb, 0x65, 0x65, 0xe9, 0xbb, 0x8f, 0xa6, 0xf8, 0xda, 0x4c, 0xb5,
+	0x31, 0x89, 0xa8, 0x9c, 0xfc, 0x0b, 0x3b, 0x98, 0xf1, 0xc6, 0x79, 0x63, 0xfa, 0x13, 0xc9, 0x1c,
+	0xd2, 0x2e, 0x9a, 0x4f, 0xa2, 0x27, 0xff, 0x0e, 0x00, 0x53, 0xd4, 0x52, 0x3f, 0x48, 0x0a, 0x00,
 	0x00,
 }
 
@@ -573,6 +583,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolDailyVolumes) > 0 {
+		for iNdEx := len(m.PoolDailyVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDailyVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -928,6 +952,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolDailyVolumes) > 0 {
+		for _, e := range m.PoolDailyVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1331,6 +1361,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDailyVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDailyVolumes = append(m.PoolDailyVolumes, PoolDailyVolume{})
+			if err := m.PoolDailyVolumes[len(m.PoolDailyVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
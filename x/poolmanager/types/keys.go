@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -60,6 +61,9 @@ var (
 
 	// KeyTakerFeeBurnProtoRevArray defines key to store the taker fee for burn tracker coin array.
 	KeyTakerFeeBurnProtoRevArray = []byte{0x0D}
+
+	// KeyPoolDailyVolumePrefix defines prefix to store pool volume and swap fees per day.
+	KeyPoolDailyVolumePrefix = []byte{0x0E}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -100,6 +104,19 @@ func KeyPoolVolume(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumePrefix, KeySeparator, poolId, KeySeparator))
 }
 
+// KeyPoolDailyVolumes returns the key prefix for the daily volumes corresponding to the given poolId.
+func KeyPoolDailyVolumes(poolId uint64) []byte {
+	key := make([]byte, 0, len(KeyPoolDailyVolumePrefix)+8)
+	key = append(key, KeyPoolDailyVolumePrefix...)
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyPoolDailyVolume returns the key for the volume corresponding to the given poolId on the day starting at dayStartTime.
+// Keys are ordered by day within a pool.
+func KeyPoolDailyVolume(poolId uint64, dayStartTime time.Time) []byte {
+	return append(KeyPoolDailyVolumes(poolId), sdk.FormatTimeBytes(dayStartTime)...)
+}
+
 // ParseDenomTradePairKey parses the raw bytes of the DenomTradePairKey into a denom trade pair.
 func ParseDenomTradePairKey(key []byte) (tokenInDenom, tokenOutDenom string, err error) {
 	keyStr := string(key)
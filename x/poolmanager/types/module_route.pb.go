@@ -32,16 +32,27 @@ const (
 	// Stableswap is the Solidly cfmm stable swap curve. Its pool model is defined
 	// in x/gamm.
 	Stableswap PoolType = 1
+	// // Concentrated is the pool model specific to concentrated liquidity. It is
+	// // defined in x/concentrated-liquidity.
+	// Concentrated = 2;
+	// // CosmWasm is the pool model specific to CosmWasm. It is defined in
+	// // x/cosmwasmpool.
+	// CosmWasm = 3;
+	// Orderbook is the limit order book pool model. Its pool model is defined in
+	// x/gamm.
+	Orderbook PoolType = 4
 )
 
 var PoolType_name = map[int32]string{
 	0: "Balancer",
 	1: "Stableswap",
+	4: "Orderbook",
 }
 
 var PoolType_value = map[string]int32{
 	"Balancer":   0,
 	"Stableswap": 1,
+	"Orderbook":  4,
 }
 
 func (x PoolType) String() string {
@@ -119,25 +130,26 @@ func init() {
 }
 
 var fileDescriptor_a2cddfa56b9cfb2b = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0xc8, 0xcf, 0xcf,
-	0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x8d, 0x2f, 0xca, 0x2f, 0x2d, 0x49, 0xd5, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x46, 0x52, 0xa7, 0x07, 0x55, 0x27, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x96, 0xd7, 0x07, 0xb1, 0x20, 0x4a, 0x95, 0xaa, 0xb8, 0xb8, 0x7d, 0xc1, 0x06, 0x04, 0x81, 0xf4,
-	0x0b, 0x59, 0x71, 0x71, 0x82, 0xf4, 0xc6, 0x97, 0x54, 0x16, 0xa4, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0xf0, 0x19, 0xc9, 0xea, 0x61, 0x31, 0x4d, 0x2f, 0x20, 0x3f, 0x3f, 0x27, 0xa4, 0xb2, 0x20, 0x35,
-	0x88, 0xa3, 0x00, 0xca, 0x12, 0xd2, 0xe7, 0x62, 0x07, 0xeb, 0xcd, 0x4c, 0x91, 0x60, 0x52, 0x60,
-	0xd4, 0x60, 0x71, 0x12, 0x3b, 0x71, 0x4f, 0x9e, 0xf1, 0xd3, 0x3d, 0x79, 0xbe, 0xca, 0xc4, 0xdc,
-	0x1c, 0x2b, 0x25, 0xa8, 0xa4, 0x52, 0x10, 0x1b, 0x88, 0xe5, 0x99, 0xa2, 0xa5, 0xc7, 0xc5, 0x01,
-	0x33, 0x46, 0x88, 0x87, 0x8b, 0xc3, 0x29, 0x31, 0x27, 0x31, 0x2f, 0x39, 0xb5, 0x48, 0x80, 0x41,
-	0x88, 0x8f, 0x8b, 0x2b, 0xb8, 0x24, 0x31, 0x29, 0x27, 0xb5, 0xb8, 0x3c, 0xb1, 0x40, 0x80, 0x51,
-	0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83, 0x53, 0xe0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0x99, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0x65,
-	0x96, 0x24, 0x25, 0xa6, 0xa4, 0xa7, 0x16, 0x23, 0x58, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x15,
-	0xfa, 0xc8, 0xc1, 0x07, 0xf2, 0x61, 0x71, 0x12, 0x1b, 0x38, 0x14, 0x8c, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x2c, 0x13, 0x32, 0xb0, 0x5a, 0x01, 0x00, 0x00,
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x33, 0x3f, 0xa5, 0x7f, 0x3b, 0x6a, 0x29, 0xa3, 0x48, 0x29, 0x38, 0x2d, 0x5d, 0x48,
+	0x71, 0x91, 0xa1, 0xba, 0x10, 0x0a, 0x6e, 0xba, 0x73, 0x21, 0x6a, 0x75, 0xe5, 0xa6, 0xcc, 0x34,
+	0x43, 0x1a, 0x9c, 0xe4, 0x0e, 0x93, 0x89, 0x1a, 0x9f, 0xc0, 0xa5, 0xef, 0xe0, 0xcb, 0x74, 0xd9,
+	0xa5, 0xab, 0x22, 0xc9, 0x1b, 0xf8, 0x04, 0x92, 0x18, 0xb1, 0x0b, 0x77, 0x1f, 0xdc, 0x73, 0x3e,
+	0xb8, 0x07, 0x1f, 0x6a, 0x00, 0x15, 0xf2, 0x88, 0xfb, 0xd2, 0xb0, 0x87, 0x91, 0x90, 0x96, 0x8f,
+	0x58, 0x08, 0x5e, 0xa2, 0xe4, 0xcc, 0x40, 0x62, 0xa5, 0xab, 0x0d, 0x58, 0x20, 0xbb, 0x1b, 0x39,
+	0xb7, 0xca, 0x75, 0xf7, 0x7c, 0xf0, 0xa1, 0xbc, 0xb3, 0x82, 0xbe, 0xa3, 0x83, 0x67, 0xbc, 0x75,
+	0x51, 0x0a, 0xa6, 0x45, 0x9f, 0x8c, 0x71, 0xb3, 0xe8, 0xce, 0x6c, 0xaa, 0x65, 0x07, 0xf5, 0xd1,
+	0xb0, 0x75, 0x7c, 0xe0, 0xfe, 0x61, 0x73, 0xaf, 0x00, 0xd4, 0x6d, 0xaa, 0xe5, 0xb4, 0xa1, 0x2b,
+	0x22, 0x0c, 0xff, 0x2f, 0xbb, 0x81, 0xd7, 0xf9, 0xd7, 0x47, 0xc3, 0xda, 0x64, 0x7f, 0xb9, 0xee,
+	0xa1, 0xcf, 0x75, 0xaf, 0x95, 0xf2, 0x50, 0x8d, 0x07, 0xd5, 0x71, 0x30, 0xad, 0x17, 0x74, 0xee,
+	0x1d, 0x9d, 0xe1, 0xc6, 0x8f, 0x86, 0x6c, 0xe3, 0xc6, 0x84, 0x2b, 0x1e, 0xcd, 0xa5, 0x69, 0x3b,
+	0xa4, 0x85, 0xf1, 0x8d, 0xe5, 0x42, 0xc9, 0xf8, 0x91, 0xeb, 0x36, 0x22, 0x3b, 0xb8, 0x79, 0x69,
+	0x3c, 0x69, 0x04, 0xc0, 0x7d, 0xbb, 0xd6, 0xad, 0xbd, 0xbc, 0x51, 0x67, 0x72, 0xbd, 0xcc, 0x28,
+	0x5a, 0x65, 0x14, 0x7d, 0x64, 0x14, 0xbd, 0xe6, 0xd4, 0x59, 0xe5, 0xd4, 0x79, 0xcf, 0xa9, 0x73,
+	0x77, 0xea, 0x07, 0x76, 0x91, 0x08, 0x77, 0x0e, 0x21, 0x13, 0x81, 0x15, 0xdc, 0xf3, 0x65, 0xfc,
+	0x4b, 0xf3, 0x05, 0x0f, 0x22, 0xf6, 0xc4, 0x36, 0xd7, 0x2c, 0x1e, 0x8e, 0x45, 0xbd, 0x1c, 0xe5,
+	0xe4, 0x6b, 0x00, 0x5e, 0x16, 0x1f, 0x55, 0x69, 0x01, 0x00, 0x00,
 }
 
 func (m *ModuleRoute) Marshal() (dAtA []byte, err error) {
@@ -11,10 +11,11 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -23,12 +24,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1319,6 +1322,278 @@ func (m *TotalVolumeForPoolResponse) GetVolume() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+// =============================== PoolVolumeHistory
+type PoolVolumeHistoryRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// start_time is the start of the range. The day containing it is included.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time is the end of the range. The day containing it is included.
+	// Defaults to the current block time if unset.
+	EndTime    time.Time          `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PoolVolumeHistoryRequest) Reset()         { *m = PoolVolumeHistoryRequest{} }
+func (m *PoolVolumeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeHistoryRequest) ProtoMessage()    {}
+func (*PoolVolumeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d807e08b63097e, []int{26}
+}
+func (m *PoolVolumeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeHistoryRequest.Merge(m, src)
+}
+func (m *PoolVolumeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeHistoryRequest proto.InternalMessageInfo
+
+func (m *PoolVolumeHistoryRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolumeHistoryRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *PoolVolumeHistoryRequest) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *PoolVolumeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type PoolVolumeHistoryResponse struct {
+	// daily_volumes are the days in the range with swaps, ordered by day.
+	// Only the last 365 completed days and the current day are kept.
+	DailyVolumes []PoolDailyVolume `protobuf:"bytes,1,rep,name=daily_volumes,json=dailyVolumes,proto3" json:"daily_volumes" yaml:"daily_volumes"`
+	// volume is the total volume over the returned days.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	// swap_fees is the total swap fees over the returned days.
+	SwapFees   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+	Pagination *query.PageResponse                      `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PoolVolumeHistoryResponse) Reset()         { *m = PoolVolumeHistoryResponse{} }
+func (m *PoolVolumeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeHistoryResponse) ProtoMessage()    {}
+func (*PoolVolumeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d807e08b63097e, []int{27}
+}
+func (m *PoolVolumeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeHistoryResponse.Merge(m, src)
+}
+func (m *PoolVolumeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeHistoryResponse proto.InternalMessageInfo
+
+func (m *PoolVolumeHistoryResponse) GetDailyVolumes() []PoolDailyVolume {
+	if m != nil {
+		return m.DailyVolumes
+	}
+	return nil
+}
+
+func (m *PoolVolumeHistoryResponse) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PoolVolumeHistoryResponse) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
+func (m *PoolVolumeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PoolFeeApr
+type PoolFeeAprRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// quote_denom is the pool denom the swap fees and liquidity are valued in.
+	// Defaults to the first denom of the pool's liquidity if empty.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// lookback_days is the number of completed days the swap fees are averaged
+	// over. Defaults to 7 if zero.
+	LookbackDays uint64 `protobuf:"varint,3,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty" yaml:"lookback_days"`
+}
+
+func (m *PoolFeeAprRequest) Reset()         { *m = PoolFeeAprRequest{} }
+func (m *PoolFeeAprRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolFeeAprRequest) ProtoMessage()    {}
+func (*PoolFeeAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d807e08b63097e, []int{28}
+}
+func (m *PoolFeeAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolFeeAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeAprRequest.Merge(m, src)
+}
+func (m *PoolFeeAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeAprRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolFeeAprRequest proto.InternalMessageInfo
+
+func (m *PoolFeeAprRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolFeeAprRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *PoolFeeAprRequest) GetLookbackDays() uint64 {
+	if m != nil {
+		return m.LookbackDays
+	}
+	return 0
+}
+
+type PoolFeeAprResponse struct {
+	// apr is the swap fees over the lookback days, annualized and divided by the
+	// current pool liquidity. 0.05 is 5%.
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr" yaml:"apr"`
+	// swap_fees is the total swap fees over the lookback days.
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+	// swap_fees_value is the value of swap_fees in quote_denom at the current
+	// spot prices.
+	SwapFeesValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=swap_fees_value,json=swapFeesValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"swap_fees_value" yaml:"swap_fees_value"`
+	// liquidity_value is the value of the pool liquidity in quote_denom at the
+	// current spot prices.
+	LiquidityValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=liquidity_value,json=liquidityValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_value" yaml:"liquidity_value"`
+	// quote_denom is the denom the values are in.
+	QuoteDenom string `protobuf:"bytes,5,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+}
+
+func (m *PoolFeeAprResponse) Reset()         { *m = PoolFeeAprResponse{} }
+func (m *PoolFeeAprResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolFeeAprResponse) ProtoMessage()    {}
+func (*PoolFeeAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d807e08b63097e, []int{29}
+}
+func (m *PoolFeeAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolFeeAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeAprResponse.Merge(m, src)
+}
+func (m *PoolFeeAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeAprResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolFeeAprResponse proto.InternalMessageInfo
+
+func (m *PoolFeeAprResponse) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
+func (m *PoolFeeAprResponse) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
 // =============================== TradingPairTakerFee
 type TradingPairTakerFeeRequest struct {
 	Denom_0 string `protobuf:"bytes,1,opt,name=denom_0,json=denom0,proto3" json:"denom_0,omitempty" yaml:"denom_0"`
@@ -1329,7 +1604,7 @@ func (m *TradingPairTakerFeeRequest) Reset()         { *m = TradingPairTakerFeeR
 func (m *TradingPairTakerFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeRequest) ProtoMessage()    {}
 func (*TradingPairTakerFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{26}
+	return fileDescriptor_55d807e08b63097e, []int{30}
 }
 func (m *TradingPairTakerFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1655,7 @@ func (m *TradingPairTakerFeeResponse) Reset()         { *m = TradingPairTakerFee
 func (m *TradingPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeResponse) ProtoMessage()    {}
 func (*TradingPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{27}
+	return fileDescriptor_55d807e08b63097e, []int{31}
 }
 func (m *TradingPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1711,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactRequest) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{28}
+	return fileDescriptor_55d807e08b63097e, []int{32}
 }
 func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +1780,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactResponse) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{29}
+	return fileDescriptor_55d807e08b63097e, []int{33}
 }
 func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,6 +1823,131 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) GetOutputCoin() types1.Coin {
 	return types1.Coin{}
 }
 
+// FindBestRouteRequest represents a request to find the best route for swapping
+// token_in into token_out_denom.
+type FindBestRouteRequest struct {
+	// token_in is the coin to swap in, e.g. 1000ubadge.
+	TokenIn string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	// token_out_denom is the denom to swap into.
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools in a multihop path.
+	// Defaults to 3 if zero.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// max_splits is the maximum number of multihop paths token_in can be split
+	// across. Defaults to 1 (single route) if zero.
+	MaxSplits uint64 `protobuf:"varint,4,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty" yaml:"max_splits"`
+}
+
+func (m *FindBestRouteRequest) Reset()         { *m = FindBestRouteRequest{} }
+func (m *FindBestRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*FindBestRouteRequest) ProtoMessage()    {}
+func (*FindBestRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d807e08b63097e, []int{34}
+}
+func (m *FindBestRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindBestRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindBestRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindBestRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindBestRouteRequest.Merge(m, src)
+}
+func (m *FindBestRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindBestRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindBestRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindBestRouteRequest proto.InternalMessageInfo
+
+func (m *FindBestRouteRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *FindBestRouteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *FindBestRouteRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *FindBestRouteRequest) GetMaxSplits() uint64 {
+	if m != nil {
+		return m.MaxSplits
+	}
+	return 0
+}
+
+// FindBestRouteResponse represents the best route found. The routes can be used
+// directly with MsgSplitRouteSwapExactAmountIn, or with MsgSwapExactAmountIn if
+// there is a single route.
+type FindBestRouteResponse struct {
+	Routes []SwapAmountInSplitRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// token_out_amount is the total estimated amount out across all routes.
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *FindBestRouteResponse) Reset()         { *m = FindBestRouteResponse{} }
+func (m *FindBestRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*FindBestRouteResponse) ProtoMessage()    {}
+func (*FindBestRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55d807e08b63097e, []int{35}
+}
+func (m *FindBestRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindBestRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindBestRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindBestRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindBestRouteResponse.Merge(m, src)
+}
+func (m *FindBestRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindBestRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindBestRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindBestRouteResponse proto.InternalMessageInfo
+
+func (m *FindBestRouteResponse) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 type AllTakerFeeShareAgreementsRequest struct {
 }
 
@@ -1555,7 +1955,7 @@ func (m *AllTakerFeeShareAgreementsRequest) Reset()         { *m = AllTakerFeeSh
 func (m *AllTakerFeeShareAgreementsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAgreementsRequest) ProtoMessage()    {}
 func (*AllTakerFeeShareAgreementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{30}
+	return fileDescriptor_55d807e08b63097e, []int{36}
 }
 func (m *AllTakerFeeShareAgreementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1992,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Reset()         { *m = AllTakerFeeS
 func (m *AllTakerFeeShareAgreementsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAgreementsResponse) ProtoMessage()    {}
 func (*AllTakerFeeShareAgreementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{31}
+	return fileDescriptor_55d807e08b63097e, []int{37}
 }
 func (m *AllTakerFeeShareAgreementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1638,7 +2038,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Reset() {
 func (m *TakerFeeShareAgreementFromDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareAgreementFromDenomRequest) ProtoMessage()    {}
 func (*TakerFeeShareAgreementFromDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{32}
+	return fileDescriptor_55d807e08b63097e, []int{38}
 }
 func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1684,7 +2084,7 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Reset() {
 func (m *TakerFeeShareAgreementFromDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareAgreementFromDenomResponse) ProtoMessage()    {}
 func (*TakerFeeShareAgreementFromDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{33}
+	return fileDescriptor_55d807e08b63097e, []int{39}
 }
 func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +2131,7 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Reset() {
 func (m *TakerFeeShareDenomsToAccruedValueRequest) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareDenomsToAccruedValueRequest) ProtoMessage()    {}
 func (*TakerFeeShareDenomsToAccruedValueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{34}
+	return fileDescriptor_55d807e08b63097e, []int{40}
 }
 func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,7 +2186,7 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) String() string {
 }
 func (*TakerFeeShareDenomsToAccruedValueResponse) ProtoMessage() {}
 func (*TakerFeeShareDenomsToAccruedValueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{35}
+	return fileDescriptor_55d807e08b63097e, []int{41}
 }
 func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1822,7 +2222,7 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Reset()         { *m = AllTakerFee
 func (m *AllTakerFeeShareAccumulatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAccumulatorsRequest) ProtoMessage()    {}
 func (*AllTakerFeeShareAccumulatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{36}
+	return fileDescriptor_55d807e08b63097e, []int{42}
 }
 func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2259,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Reset()         { *m = AllTakerFe
 func (m *AllTakerFeeShareAccumulatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAccumulatorsResponse) ProtoMessage()    {}
 func (*AllTakerFeeShareAccumulatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{37}
+	return fileDescriptor_55d807e08b63097e, []int{43}
 }
 func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1903,7 +2303,7 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Reset()         { *m = Registere
 func (m *RegisteredAlloyedPoolFromDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromDenomRequest) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{38}
+	return fileDescriptor_55d807e08b63097e, []int{44}
 }
 func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,7 +2349,7 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Reset() {
 func (m *RegisteredAlloyedPoolFromDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromDenomResponse) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{39}
+	return fileDescriptor_55d807e08b63097e, []int{45}
 }
 func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1995,7 +2395,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Reset() {
 func (m *RegisteredAlloyedPoolFromPoolIdRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromPoolIdRequest) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{40}
+	return fileDescriptor_55d807e08b63097e, []int{46}
 }
 func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2041,7 +2441,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Reset() {
 func (m *RegisteredAlloyedPoolFromPoolIdResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromPoolIdResponse) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{41}
+	return fileDescriptor_55d807e08b63097e, []int{47}
 }
 func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2084,7 +2484,7 @@ func (m *AllRegisteredAlloyedPoolsRequest) Reset()         { *m = AllRegisteredA
 func (m *AllRegisteredAlloyedPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllRegisteredAlloyedPoolsRequest) ProtoMessage()    {}
 func (*AllRegisteredAlloyedPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{42}
+	return fileDescriptor_55d807e08b63097e, []int{48}
 }
 func (m *AllRegisteredAlloyedPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2121,7 +2521,7 @@ func (m *AllRegisteredAlloyedPoolsResponse) Reset()         { *m = AllRegistered
 func (m *AllRegisteredAlloyedPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllRegisteredAlloyedPoolsResponse) ProtoMessage()    {}
 func (*AllRegisteredAlloyedPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55d807e08b63097e, []int{43}
+	return fileDescriptor_55d807e08b63097e, []int{49}
 }
 func (m *AllRegisteredAlloyedPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2184,10 +2584,16 @@ func init() {
 	proto.RegisterType((*TotalLiquidityResponse)(nil), "poolmanager.v1beta1.TotalLiquidityResponse")
 	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "poolmanager.v1beta1.TotalVolumeForPoolRequest")
 	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "poolmanager.v1beta1.TotalVolumeForPoolResponse")
+	proto.RegisterType((*PoolVolumeHistoryRequest)(nil), "poolmanager.v1beta1.PoolVolumeHistoryRequest")
+	proto.RegisterType((*PoolVolumeHistoryResponse)(nil), "poolmanager.v1beta1.PoolVolumeHistoryResponse")
+	proto.RegisterType((*PoolFeeAprRequest)(nil), "poolmanager.v1beta1.PoolFeeAprRequest")
+	proto.RegisterType((*PoolFeeAprResponse)(nil), "poolmanager.v1beta1.PoolFeeAprResponse")
 	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "poolmanager.v1beta1.TradingPairTakerFeeRequest")
 	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "poolmanager.v1beta1.TradingPairTakerFeeResponse")
 	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
 	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
+	proto.RegisterType((*FindBestRouteRequest)(nil), "poolmanager.v1beta1.FindBestRouteRequest")
+	proto.RegisterType((*FindBestRouteResponse)(nil), "poolmanager.v1beta1.FindBestRouteResponse")
 	proto.RegisterType((*AllTakerFeeShareAgreementsRequest)(nil), "poolmanager.v1beta1.AllTakerFeeShareAgreementsRequest")
 	proto.RegisterType((*AllTakerFeeShareAgreementsResponse)(nil), "poolmanager.v1beta1.AllTakerFeeShareAgreementsResponse")
 	proto.RegisterType((*TakerFeeShareAgreementFromDenomRequest)(nil), "poolmanager.v1beta1.TakerFeeShareAgreementFromDenomRequest")
@@ -2207,172 +2613,206 @@ func init() {
 func init() { proto.RegisterFile("poolmanager/v1beta1/query.proto", fileDescriptor_55d807e08b63097e) }
 
 var fileDescriptor_55d807e08b63097e = []byte{
-	// 2634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5d, 0x6c, 0x5c, 0x47,
-	0x15, 0xce, 0x5d, 0x3b, 0xae, 0x7d, 0x12, 0xff, 0x64, 0x12, 0xc7, 0xf6, 0x26, 0x78, 0xed, 0x71,
-	0xe2, 0xb8, 0x75, 0xbc, 0x1b, 0x3b, 0x69, 0xd3, 0x26, 0x75, 0xd3, 0xdd, 0xc4, 0x69, 0x4c, 0x12,
-	0xe2, 0xac, 0xdd, 0x14, 0x4a, 0xe1, 0x6a, 0xbc, 0x3b, 0x59, 0x5f, 0x79, 0xef, 0xbd, 0x9b, 0x7b,
-	0x67, 0x13, 0x5b, 0x28, 0x08, 0x90, 0x50, 0x2b, 0x81, 0xc4, 0xbf, 0x2a, 0xc4, 0x03, 0xcf, 0x05,
-	0x89, 0xa7, 0x3e, 0xf0, 0x04, 0x3c, 0x56, 0x48, 0x45, 0x11, 0x48, 0xa8, 0x2a, 0x68, 0x41, 0x09,
-	0x4f, 0xbc, 0x20, 0xf6, 0x89, 0x47, 0x74, 0x67, 0xe6, 0xde, 0xfd, 0xf1, 0xfd, 0xdb, 0x4d, 0x40,
-	0x7d, 0xca, 0x7a, 0xe6, 0x9c, 0x33, 0xdf, 0x77, 0xe6, 0x9c, 0x99, 0xb9, 0x5f, 0x20, 0x55, 0x31,
-	0xcd, 0xb2, 0x4e, 0x0c, 0x52, 0xa2, 0x56, 0xe6, 0xfe, 0xe2, 0x26, 0x65, 0x64, 0x31, 0x73, 0xaf,
-	0x4a, 0xad, 0xdd, 0x74, 0xc5, 0x32, 0x99, 0x89, 0x0e, 0x37, 0x19, 0xa4, 0xa5, 0x41, 0xf2, 0x48,
-	0xc9, 0x2c, 0x99, 0x7c, 0x3e, 0xe3, 0xfc, 0x12, 0xa6, 0xc9, 0x69, 0xbf, 0x58, 0x25, 0x6a, 0x50,
-	0x5b, 0xb3, 0xa5, 0xc9, 0x71, 0x3f, 0x13, 0xb6, 0x23, 0x67, 0x4f, 0xf8, 0xcd, 0xda, 0x0f, 0x48,
-	0x45, 0xb5, 0xcc, 0x2a, 0xa3, 0xd2, 0xea, 0x79, 0xdf, 0x18, 0x64, 0x9b, 0x5a, 0xea, 0x5d, 0x4a,
-	0x55, 0x7b, 0x8b, 0x58, 0xae, 0xe9, 0x64, 0xc1, 0xb4, 0x75, 0xd3, 0xce, 0x6c, 0x12, 0x9b, 0x7a,
-	0xa6, 0x05, 0x53, 0x33, 0xe4, 0xfc, 0x0b, 0xcd, 0xf3, 0x9c, 0xb5, 0x67, 0x55, 0x21, 0x25, 0xcd,
-	0x20, 0x4c, 0x33, 0x5d, 0xdb, 0xe3, 0x25, 0xd3, 0x2c, 0x95, 0x69, 0x86, 0x54, 0xb4, 0x0c, 0x31,
-	0x0c, 0x93, 0xf1, 0x49, 0x97, 0xd8, 0x84, 0x9c, 0xe5, 0x7f, 0x6d, 0x56, 0xef, 0x66, 0x88, 0xb1,
-	0xeb, 0x4e, 0x89, 0x45, 0x54, 0x91, 0x2f, 0xf1, 0x87, 0x9c, 0x4a, 0xb5, 0x7b, 0x31, 0x4d, 0xa7,
-	0x36, 0x23, 0x7a, 0x45, 0x18, 0xe0, 0x61, 0x18, 0x5c, 0x23, 0x16, 0xd1, 0xed, 0x3c, 0xbd, 0x57,
-	0xa5, 0x36, 0xc3, 0xd7, 0x61, 0xc8, 0x1d, 0xb0, 0x2b, 0xa6, 0x61, 0x53, 0xf4, 0x0a, 0xf4, 0x55,
-	0xf8, 0xc8, 0xb8, 0x32, 0xa5, 0xcc, 0x1d, 0x58, 0x3a, 0x96, 0xf6, 0xd9, 0xb1, 0xb4, 0x70, 0xca,
-	0xf5, 0x7e, 0x54, 0x4b, 0xed, 0xcb, 0x4b, 0x07, 0xfc, 0x41, 0x02, 0xa6, 0x56, 0x6c, 0xa6, 0xe9,
-	0x84, 0xd1, 0xf5, 0x07, 0xa4, 0xb2, 0xb2, 0x43, 0x0a, 0x2c, 0xab, 0x9b, 0x55, 0x83, 0xad, 0x1a,
-	0x72, 0x45, 0xb4, 0x0c, 0x7d, 0x36, 0x35, 0x8a, 0xd4, 0xe2, 0xf1, 0x07, 0x72, 0x27, 0xeb, 0xb5,
-	0x54, 0x6a, 0x97, 0xe8, 0xe5, 0x0b, 0x58, 0x8c, 0xe3, 0xd3, 0x45, 0x5a, 0xb1, 0x68, 0x81, 0x30,
-	0x5a, 0xbc, 0x80, 0x99, 0x55, 0xa5, 0x78, 0x5c, 0xc9, 0x4b, 0x27, 0x74, 0x09, 0x9e, 0x73, 0xf0,
-	0xa8, 0x5a, 0x71, 0x3c, 0x31, 0xa5, 0xcc, 0xf5, 0xe6, 0x66, 0xeb, 0xb5, 0xd4, 0x94, 0xf0, 0x97,
-	0x13, 0x01, 0x01, 0x9c, 0xd9, 0xd5, 0x22, 0x4a, 0x43, 0x3f, 0x33, 0xb7, 0xa9, 0xa1, 0x6a, 0xc6,
-	0x78, 0x0f, 0x47, 0x70, 0xb8, 0x5e, 0x4b, 0x0d, 0x8b, 0x08, 0xee, 0x0c, 0xce, 0x3f, 0xc7, 0x7f,
-	0xae, 0x1a, 0xe8, 0x4d, 0xe8, 0xe3, 0xd5, 0x62, 0x8f, 0xf7, 0x4e, 0xf5, 0xcc, 0x1d, 0x58, 0x9a,
-	0xf5, 0xcd, 0x87, 0x43, 0xd7, 0x63, 0xea, 0x98, 0xe7, 0x46, 0x9d, 0xd4, 0xd4, 0x6b, 0xa9, 0x41,
-	0x11, 0x59, 0xc4, 0xc0, 0x79, 0x19, 0x0c, 0xff, 0x26, 0x01, 0x4b, 0x81, 0xb9, 0x7a, 0x4b, 0x63,
-	0x5b, 0x6b, 0x96, 0xa6, 0x6b, 0x4c, 0xbb, 0x4f, 0x37, 0x76, 0x2b, 0xd4, 0xdd, 0xaf, 0x66, 0xfa,
-	0xca, 0x53, 0xd3, 0x4f, 0xc4, 0xa0, 0x7f, 0x09, 0x86, 0x04, 0x62, 0xd5, 0x5d, 0xb7, 0x67, 0xaa,
-	0x67, 0xae, 0x37, 0x37, 0x51, 0xaf, 0xa5, 0x46, 0x9b, 0xa9, 0xb9, 0xf3, 0x38, 0x7f, 0x50, 0x0c,
-	0xac, 0x89, 0x05, 0xef, 0xc0, 0x51, 0x69, 0x20, 0xa2, 0x9b, 0x55, 0xa6, 0x16, 0xa9, 0x61, 0xea,
-	0x3c, 0x9f, 0x03, 0xb9, 0xe9, 0x7a, 0x2d, 0xf5, 0xb9, 0x96, 0x40, 0x6d, 0x76, 0x38, 0x7f, 0x58,
-	0x4c, 0x6c, 0x38, 0xe3, 0xb7, 0xaa, 0xec, 0x0a, 0x1f, 0xfd, 0x58, 0x81, 0x17, 0xbc, 0x04, 0x6a,
-	0x46, 0xa9, 0x4c, 0x9d, 0x05, 0x03, 0xcb, 0x6e, 0xbe, 0x3d, 0x71, 0xa8, 0x5e, 0x4b, 0x0d, 0xb5,
-	0x26, 0xae, 0xeb, 0x24, 0xe5, 0x60, 0xb8, 0x9d, 0x9c, 0x28, 0xad, 0x64, 0xbd, 0x96, 0x3a, 0xda,
-	0xec, 0xd6, 0xc4, 0x6a, 0x90, 0xb5, 0xf0, 0x79, 0x57, 0x81, 0xe9, 0x90, 0xe6, 0x91, 0xdd, 0xb9,
-	0x09, 0x23, 0x8d, 0x40, 0x84, 0xcf, 0xca, 0x3e, 0x7a, 0xd9, 0xa9, 0xb7, 0x4f, 0x6b, 0xa9, 0x51,
-	0x71, 0x22, 0xd8, 0xc5, 0xed, 0xb4, 0x66, 0x66, 0x74, 0xc2, 0xb6, 0xd2, 0xab, 0x06, 0xab, 0xd7,
-	0x52, 0x63, 0xed, 0x38, 0x84, 0x3b, 0xce, 0x0f, 0xb9, 0x40, 0xc4, 0x6a, 0xf8, 0x57, 0x89, 0x40,
-	0x24, 0xb7, 0xaa, 0xec, 0xb3, 0xd2, 0xc7, 0x77, 0xbc, 0xbe, 0xec, 0xe1, 0x7d, 0x79, 0x2a, 0xa2,
-	0x2f, 0x1d, 0xe8, 0x31, 0x1a, 0x13, 0x2d, 0xc2, 0x80, 0x97, 0xa2, 0xf1, 0x5e, 0x4e, 0xed, 0x48,
-	0xbd, 0x96, 0x1a, 0x69, 0xcb, 0x1e, 0xce, 0xf7, 0xbb, 0x69, 0xc3, 0xbf, 0x4d, 0xc0, 0xd9, 0xe0,
-	0x84, 0xfd, 0x0f, 0x9b, 0x79, 0x6f, 0x73, 0x26, 0x3a, 0x6b, 0xce, 0x75, 0x18, 0x6d, 0x69, 0x3a,
-	0xcd, 0xf0, 0xca, 0xd7, 0xe9, 0xcd, 0xa9, 0x7a, 0x2d, 0x75, 0xdc, 0xa7, 0x37, 0x5d, 0x33, 0x9c,
-	0x47, 0x4d, 0xad, 0xb9, 0x6a, 0xf0, 0x4a, 0xee, 0x26, 0x83, 0x7f, 0x50, 0x60, 0x3e, 0xb2, 0x99,
-	0x9b, 0x8a, 0xaf, 0xa3, 0x6e, 0xbe, 0x04, 0x43, 0x6d, 0xec, 0x44, 0x4f, 0x37, 0x65, 0xa9, 0x9d,
-	0xd6, 0x41, 0x16, 0x48, 0xa8, 0x27, 0x16, 0xa1, 0x6f, 0x2b, 0x80, 0xc3, 0x7a, 0x48, 0xb6, 0xb3,
-	0xea, 0x1e, 0x1c, 0x9a, 0xd1, 0xda, 0xcd, 0xe7, 0xa3, 0xba, 0xf9, 0x68, 0x1b, 0x70, 0xb7, 0x99,
-	0x07, 0x25, 0x72, 0xd9, 0xcb, 0x87, 0x60, 0xf8, 0x0b, 0x55, 0xdd, 0x49, 0xa6, 0x77, 0xe5, 0xaf,
-	0xc0, 0x48, 0x63, 0x48, 0xe2, 0x58, 0x84, 0x01, 0xa3, 0xaa, 0xf3, 0x2a, 0xb1, 0x65, 0x46, 0x9b,
-	0x18, 0x7a, 0x53, 0x38, 0xdf, 0x6f, 0x48, 0x57, 0x7c, 0x01, 0x0e, 0x38, 0x3f, 0xba, 0xd9, 0x11,
-	0x7c, 0x19, 0x0e, 0x0a, 0x5f, 0xb9, 0xfc, 0x59, 0xe8, 0x75, 0x66, 0xe4, 0x8b, 0xe3, 0x48, 0x5a,
-	0x3c, 0x63, 0xd2, 0xee, 0x33, 0x26, 0x9d, 0x35, 0x76, 0x73, 0x03, 0xbf, 0xff, 0x70, 0x61, 0x3f,
-	0x2f, 0xdb, 0x3c, 0x37, 0x76, 0xa8, 0x65, 0xcb, 0xe5, 0x16, 0x6a, 0xab, 0x30, 0xd2, 0x18, 0x92,
-	0xb1, 0x5f, 0x84, 0xfd, 0x2e, 0xad, 0x9e, 0x38, 0xc1, 0x85, 0x35, 0xce, 0xc2, 0xd8, 0x0d, 0xcd,
-	0x66, 0x3c, 0x56, 0x6e, 0x97, 0xd7, 0x81, 0x4b, 0x75, 0x16, 0xf6, 0x8b, 0x32, 0x12, 0x5b, 0x35,
-	0x52, 0xaf, 0xa5, 0x0e, 0x0a, 0xa2, 0xb2, 0x7a, 0xc4, 0x34, 0xbe, 0x0d, 0xe3, 0x7b, 0x43, 0x3c,
-	0x1d, 0xaa, 0x47, 0x0a, 0x8c, 0xac, 0x57, 0x4c, 0xb6, 0x66, 0x69, 0x05, 0xda, 0x55, 0x33, 0xac,
-	0xc0, 0x88, 0xf3, 0x3a, 0x55, 0x89, 0x6d, 0x53, 0xd6, 0xd2, 0x0e, 0xc7, 0x1a, 0x77, 0x44, 0xbb,
-	0x05, 0xce, 0x0f, 0x39, 0x43, 0x59, 0x67, 0x44, 0xb4, 0xc4, 0x35, 0x38, 0x74, 0xaf, 0x6a, 0xb2,
-	0xd6, 0x38, 0xa2, 0x35, 0x8e, 0xd7, 0x6b, 0xa9, 0x71, 0x11, 0x67, 0x8f, 0x09, 0xce, 0x0f, 0xf3,
-	0xb1, 0x46, 0x24, 0xbc, 0x0a, 0x87, 0x9a, 0x18, 0xc9, 0xf4, 0x9c, 0x03, 0xb0, 0x2b, 0x26, 0x53,
-	0x2b, 0xce, 0xa8, 0xcc, 0xf3, 0x68, 0xbd, 0x96, 0x3a, 0x24, 0x2f, 0x18, 0x6f, 0x0e, 0xe7, 0x07,
-	0x6c, 0xd7, 0x1b, 0x5f, 0x83, 0x89, 0x0d, 0x93, 0x11, 0x5e, 0x00, 0x37, 0xb4, 0x7b, 0x55, 0xad,
-	0xa8, 0xb1, 0xdd, 0xae, 0x0a, 0xf4, 0x67, 0x0a, 0x24, 0xfd, 0x42, 0x49, 0x78, 0x0f, 0x61, 0xa0,
-	0xec, 0x0e, 0xca, 0x1d, 0x9c, 0x48, 0xcb, 0x97, 0xb8, 0x93, 0x28, 0xef, 0xfa, 0xb9, 0x6c, 0x6a,
-	0x46, 0xee, 0x8a, 0xbc, 0x70, 0x64, 0x37, 0x79, 0x9e, 0xf8, 0x17, 0x7f, 0x4b, 0xcd, 0x95, 0x34,
-	0xb6, 0x55, 0xdd, 0x4c, 0x17, 0x4c, 0x5d, 0x3e, 0xe5, 0xe5, 0x3f, 0x0b, 0x76, 0x71, 0x3b, 0xc3,
-	0x9c, 0xdb, 0x82, 0x07, 0xb1, 0xf3, 0x8d, 0x15, 0xf1, 0x18, 0x8c, 0x72, 0x70, 0xed, 0x1c, 0xf1,
-	0xfb, 0x0a, 0x1c, 0x6d, 0x9f, 0xf9, 0x6c, 0x40, 0x76, 0xb7, 0xe6, 0x8e, 0x59, 0xae, 0xea, 0xf4,
-	0xaa, 0x69, 0x75, 0x7d, 0x76, 0xfc, 0xd0, 0xdd, 0x9a, 0xb6, 0x50, 0x92, 0x27, 0x83, 0xbe, 0xfb,
-	0x7c, 0x22, 0x9a, 0x64, 0xb6, 0xf5, 0x21, 0x20, 0xdc, 0x3a, 0x63, 0x28, 0xd7, 0xc2, 0xf7, 0x21,
-	0xb9, 0x61, 0x91, 0xa2, 0x66, 0x94, 0xd6, 0x88, 0x66, 0x6d, 0x38, 0x1f, 0x8f, 0x57, 0x69, 0x73,
-	0x83, 0xf2, 0xea, 0x57, 0xcf, 0xc8, 0x52, 0x6e, 0xe2, 0x27, 0x27, 0x70, 0xbe, 0x8f, 0xff, 0x3a,
-	0xd3, 0x30, 0x5e, 0x94, 0x7d, 0xb9, 0xc7, 0x78, 0xd1, 0x35, 0x5e, 0xc4, 0x2a, 0x1c, 0xf3, 0x5d,
-	0x57, 0x26, 0xe3, 0x75, 0x18, 0xf0, 0x3e, 0x64, 0xe5, 0xd2, 0x33, 0xf2, 0x62, 0x39, 0xb6, 0xf7,
-	0x62, 0xb9, 0x41, 0x4b, 0xa4, 0xb0, 0x7b, 0x85, 0x16, 0xf2, 0xfd, 0x4c, 0x46, 0x72, 0x3e, 0x53,
-	0x66, 0xdd, 0x7b, 0xcc, 0x59, 0x89, 0xe6, 0x88, 0x4d, 0x8b, 0xb7, 0x0c, 0xde, 0x70, 0xab, 0x7a,
-	0x85, 0x14, 0xbc, 0x3b, 0xf9, 0x55, 0x18, 0xb8, 0x6b, 0x99, 0xba, 0xea, 0x7c, 0x0f, 0xcb, 0x93,
-	0x3c, 0x24, 0xf9, 0xe2, 0xcb, 0xb1, 0xdf, 0xf1, 0x70, 0xfe, 0x46, 0x18, 0x06, 0x99, 0xc9, 0x7d,
-	0x9b, 0x0f, 0xa5, 0xfc, 0x01, 0x66, 0x3a, 0xd3, 0xe2, 0xd0, 0x19, 0x6b, 0xd4, 0x89, 0x73, 0xd4,
-	0xf4, 0x7a, 0x87, 0xda, 0x4d, 0x18, 0xd1, 0xc9, 0x8e, 0x38, 0x11, 0x54, 0x8d, 0xa3, 0x92, 0x0f,
-	0x8f, 0x58, 0x74, 0x87, 0x74, 0xb2, 0xd3, 0x44, 0x08, 0x7d, 0x1e, 0x86, 0xe8, 0x0e, 0xa3, 0x96,
-	0x41, 0xca, 0xf2, 0x04, 0xda, 0x1f, 0x3f, 0xd8, 0xa0, 0xeb, 0x2a, 0xce, 0xa4, 0x5f, 0x2a, 0x70,
-	0x2a, 0x32, 0x81, 0x72, 0xbb, 0x5e, 0x03, 0xd0, 0x8c, 0x4a, 0x95, 0x75, 0x94, 0xc2, 0x01, 0xee,
-	0xc2, 0x73, 0xf8, 0x3a, 0x1c, 0x30, 0xab, 0xcc, 0x0b, 0x90, 0x88, 0x17, 0x00, 0x84, 0x8f, 0x33,
-	0x82, 0x67, 0x60, 0x3a, 0x5b, 0x2e, 0xbb, 0x75, 0xb4, 0xbe, 0x45, 0x2c, 0x9a, 0x2d, 0x59, 0x94,
-	0xea, 0xd4, 0x60, 0xde, 0x2d, 0xfb, 0x63, 0x05, 0x70, 0x98, 0x95, 0x64, 0x63, 0x40, 0xb2, 0x4d,
-	0x45, 0x51, 0x89, 0x67, 0x25, 0xbb, 0x73, 0xde, 0xf7, 0xd1, 0xee, 0x1f, 0x59, 0xc2, 0x1d, 0x63,
-	0xfe, 0xeb, 0xe2, 0xd7, 0x60, 0xd6, 0xdf, 0xf1, 0xaa, 0x65, 0xea, 0x2d, 0x17, 0xf8, 0x91, 0x96,
-	0x0b, 0xdc, 0xbd, 0xae, 0xdf, 0x57, 0xe0, 0x54, 0x64, 0x00, 0xc9, 0xad, 0x0c, 0x13, 0x81, 0xdc,
-	0xe4, 0xc6, 0x75, 0x41, 0xed, 0xa8, 0x3f, 0x35, 0x7c, 0x17, 0xe6, 0x5a, 0xfc, 0x38, 0x16, 0x7b,
-	0xc3, 0xcc, 0x16, 0x0a, 0x56, 0x95, 0x16, 0xef, 0x90, 0x72, 0x95, 0x86, 0x72, 0x43, 0x27, 0x60,
-	0xd0, 0x8d, 0x7d, 0xa5, 0xa9, 0xbb, 0x5a, 0x07, 0xb1, 0x0d, 0xcf, 0xc7, 0x58, 0x47, 0xa6, 0xe0,
-	0x2a, 0xf4, 0xb5, 0xbc, 0x58, 0xd3, 0x51, 0x2f, 0x56, 0x79, 0xcc, 0xba, 0x0f, 0x55, 0xe9, 0x8d,
-	0x4f, 0xc2, 0xcc, 0x9e, 0x62, 0x2a, 0x14, 0xaa, 0x7a, 0xb5, 0x4c, 0x98, 0x69, 0x79, 0x45, 0xf7,
-	0x53, 0x05, 0x4e, 0x84, 0xdb, 0x49, 0x5c, 0xf7, 0xe0, 0x58, 0xd3, 0xd6, 0x6c, 0x6b, 0xba, 0x4a,
-	0x9a, 0xcc, 0x64, 0xdd, 0x9d, 0x0e, 0xdf, 0x9c, 0x6d, 0x4d, 0x6f, 0x8a, 0x2d, 0x77, 0x67, 0x9c,
-	0xf9, 0x4f, 0xdb, 0x78, 0x19, 0x4e, 0xe6, 0x69, 0x49, 0xb3, 0x19, 0xb5, 0x68, 0x31, 0x5b, 0x2e,
-	0x9b, 0xbb, 0xb4, 0xe8, 0x5c, 0x4a, 0x31, 0x0b, 0xef, 0x3b, 0x0a, 0xcc, 0x46, 0xf9, 0x4b, 0x72,
-	0x04, 0x86, 0x0a, 0xa6, 0xc1, 0x2c, 0x52, 0x60, 0xaa, 0xcd, 0x08, 0xa3, 0xb2, 0xd8, 0xce, 0xf9,
-	0xf2, 0xe1, 0xa1, 0x2e, 0x4b, 0xfb, 0x96, 0xcc, 0xad, 0x3b, 0xbe, 0x92, 0xd7, 0xa0, 0x1b, 0x91,
-	0x0f, 0xe2, 0x6c, 0x08, 0x18, 0xf1, 0xd5, 0xe8, 0xb2, 0x19, 0x6b, 0xbb, 0xb6, 0xbd, 0x2b, 0xfa,
-	0xbb, 0x0a, 0x9c, 0x8a, 0x8c, 0xf1, 0xff, 0x63, 0x84, 0x61, 0x2a, 0x5b, 0x2e, 0xfb, 0x02, 0xf2,
-	0xca, 0xeb, 0x3d, 0x85, 0x9f, 0x7c, 0x41, 0x46, 0x12, 0x6c, 0x01, 0x86, 0x5b, 0xc1, 0xba, 0xf5,
-	0xf4, 0x34, 0x68, 0x87, 0x5a, 0xd0, 0xda, 0x4b, 0x7f, 0x9e, 0x85, 0xfd, 0xb7, 0xab, 0xd4, 0xda,
-	0x45, 0x5f, 0x87, 0x3e, 0xa1, 0xb3, 0x22, 0x1c, 0x22, 0xc2, 0x4a, 0x0a, 0xc9, 0x99, 0x50, 0x1b,
-	0xc1, 0x00, 0xcf, 0x7f, 0xeb, 0x4f, 0xff, 0xf8, 0x51, 0xe2, 0x24, 0x9a, 0xc9, 0xf0, 0x2e, 0xd5,
-	0xec, 0x8c, 0x9f, 0xfa, 0x2d, 0x57, 0xfd, 0x44, 0x81, 0x89, 0x40, 0x49, 0x0a, 0xbd, 0xe8, 0xbb,
-	0x5e, 0x94, 0xfe, 0x9b, 0x7c, 0xa9, 0x53, 0x37, 0x89, 0xfc, 0x06, 0x47, 0x7e, 0x15, 0x5d, 0x09,
-	0x45, 0xfe, 0x35, 0x59, 0x91, 0x0f, 0x33, 0x54, 0x46, 0x14, 0x82, 0x3f, 0x75, 0x62, 0xca, 0x8f,
-	0x64, 0x55, 0x33, 0xd0, 0x0f, 0x12, 0x4d, 0x82, 0x43, 0xb4, 0xfc, 0x8a, 0xde, 0xe8, 0x0c, 0x75,
-	0xa0, 0xe6, 0xd3, 0x35, 0x7d, 0xc2, 0xe9, 0x7f, 0x19, 0x7d, 0xe9, 0x59, 0xd0, 0x57, 0x1f, 0x68,
-	0x6c, 0xcb, 0x79, 0xda, 0x08, 0x80, 0x2a, 0x7f, 0xd2, 0xa2, 0xff, 0x28, 0x30, 0x13, 0x43, 0x51,
-	0x45, 0x97, 0xc2, 0x29, 0x44, 0x6a, 0xb1, 0x5d, 0xe7, 0xe0, 0x8b, 0x3c, 0x07, 0x79, 0xb4, 0xd6,
-	0x71, 0x0e, 0x38, 0x26, 0x21, 0x82, 0xf9, 0x96, 0xc3, 0x5f, 0x15, 0x48, 0x06, 0xcb, 0x35, 0xa8,
-	0x23, 0xc0, 0x0d, 0x99, 0x2a, 0x79, 0xbe, 0x63, 0x3f, 0xc9, 0xf4, 0x26, 0x67, 0xfa, 0x06, 0x5a,
-	0x79, 0xfa, 0xdd, 0x36, 0xab, 0x0c, 0xfd, 0x24, 0x01, 0xa7, 0x3b, 0x11, 0x28, 0xd1, 0xb5, 0x0e,
-	0x81, 0x07, 0xd7, 0x7b, 0xd7, 0x29, 0xd8, 0xe4, 0x29, 0x78, 0x07, 0xbd, 0xfd, 0x4c, 0x52, 0xe0,
-	0x5f, 0xf1, 0xdf, 0x48, 0xc0, 0x89, 0x38, 0xb2, 0x23, 0x7a, 0xbd, 0xbb, 0x92, 0x7f, 0x16, 0xa5,
-	0xf0, 0x15, 0x9e, 0x87, 0xb7, 0xd0, 0x9b, 0x1d, 0xe6, 0xc1, 0x61, 0x1d, 0x51, 0xf8, 0x4e, 0x69,
-	0xbc, 0xab, 0x40, 0xbf, 0x2b, 0x07, 0xa2, 0x13, 0xbe, 0x20, 0xdb, 0x04, 0xc4, 0xe4, 0xc9, 0x08,
-	0x2b, 0x09, 0x3c, 0xcd, 0x81, 0xcf, 0xa1, 0xd9, 0x50, 0xe0, 0x9e, 0xb6, 0x88, 0xbe, 0xa9, 0x40,
-	0xaf, 0x13, 0x01, 0x4d, 0xf9, 0x5f, 0x64, 0x0d, 0xc1, 0x20, 0x39, 0x1d, 0x62, 0x21, 0x57, 0x3f,
-	0xc7, 0x57, 0x4f, 0xa3, 0xd3, 0xa1, 0xab, 0xf3, 0x95, 0x1b, 0xc9, 0xe3, 0xd9, 0x70, 0x15, 0xc4,
-	0x80, 0x6c, 0xb4, 0x69, 0x8e, 0x01, 0xd9, 0x68, 0x97, 0x21, 0x63, 0x66, 0x83, 0x94, 0xcb, 0x0b,
-	0x22, 0x1b, 0x1f, 0x28, 0x30, 0xd2, 0xae, 0x1e, 0x22, 0xff, 0x67, 0x6b, 0x80, 0x4e, 0x99, 0x5c,
-	0x88, 0x69, 0x2d, 0x11, 0xbe, 0xcc, 0x11, 0x2e, 0xa1, 0x33, 0xa1, 0x08, 0xcb, 0x9a, 0xcd, 0x04,
-	0xc4, 0x85, 0xcd, 0xdd, 0x05, 0xf1, 0x75, 0xf1, 0x3d, 0x05, 0x06, 0x3c, 0x0d, 0x0f, 0xf9, 0x27,
-	0xa4, 0x5d, 0xb5, 0x4c, 0xce, 0x46, 0x99, 0x49, 0x58, 0x67, 0x39, 0xac, 0x05, 0x34, 0xef, 0x0b,
-	0xab, 0x6d, 0x03, 0x33, 0xfc, 0x73, 0xdd, 0x46, 0xbf, 0x53, 0x00, 0xed, 0xd5, 0xef, 0x50, 0xda,
-	0xff, 0xd9, 0x1f, 0xa4, 0x19, 0x26, 0x33, 0xb1, 0xed, 0x25, 0xd8, 0x55, 0x0e, 0xf6, 0x32, 0xca,
-	0x76, 0x52, 0x75, 0x19, 0xe6, 0x04, 0x14, 0x4d, 0xea, 0x29, 0x66, 0xe8, 0xe7, 0x0a, 0x0c, 0xb5,
-	0x6a, 0x79, 0xe8, 0x85, 0x60, 0x38, 0x7b, 0xa0, 0xcf, 0xc7, 0xb2, 0xed, 0xa8, 0x59, 0x04, 0xcc,
-	0x06, 0xc2, 0x5f, 0xbb, 0x49, 0x6e, 0x51, 0xe2, 0xc2, 0x92, 0xec, 0xa7, 0xfe, 0x85, 0x25, 0xd9,
-	0x57, 0xe2, 0xc3, 0x59, 0x8e, 0xf6, 0x22, 0x7a, 0xa5, 0x8b, 0x24, 0x0b, 0xbd, 0x0e, 0x7d, 0xa8,
-	0xc0, 0x61, 0x1f, 0xe1, 0x0c, 0x05, 0x60, 0x09, 0x94, 0xf6, 0x92, 0x67, 0xe2, 0x3b, 0x48, 0xf4,
-	0x17, 0x38, 0xfa, 0x73, 0x68, 0x29, 0x3c, 0xd7, 0x22, 0x82, 0x5a, 0x21, 0x9a, 0xa5, 0xf2, 0x0f,
-	0xcf, 0xbb, 0x94, 0xa2, 0xbf, 0x28, 0x90, 0x8a, 0x10, 0x93, 0xd0, 0xc5, 0xd0, 0x8b, 0x26, 0x5c,
-	0xc3, 0x4b, 0xbe, 0xda, 0x9d, 0xb3, 0xa4, 0xb6, 0xcc, 0xa9, 0x9d, 0x47, 0x2f, 0x76, 0x7a, 0x55,
-	0x39, 0x6c, 0x29, 0xfa, 0xa3, 0x02, 0xc9, 0x60, 0x5d, 0x29, 0xe0, 0x11, 0x16, 0x29, 0x57, 0x05,
-	0xdc, 0xbc, 0xd1, 0x02, 0x16, 0xbe, 0xcc, 0xe9, 0x2c, 0xa3, 0x8b, 0x51, 0x47, 0xb6, 0x1a, 0xac,
-	0x73, 0xa1, 0x7f, 0x29, 0x90, 0x8a, 0x50, 0x95, 0x02, 0xb6, 0x2c, 0x9e, 0x98, 0x15, 0xb0, 0x65,
-	0x31, 0x85, 0x2c, 0x7c, 0x9b, 0x73, 0xbc, 0x8e, 0x56, 0xc3, 0xb7, 0x8c, 0x9f, 0xf3, 0x0f, 0x33,
-	0x81, 0x3c, 0x55, 0xae, 0xfc, 0x8a, 0xdb, 0xe0, 0xbd, 0x04, 0x4c, 0x47, 0xca, 0x48, 0x68, 0x39,
-	0x1a, 0x76, 0x88, 0xcc, 0x95, 0x7c, 0xad, 0x5b, 0x77, 0xc9, 0xbb, 0xc8, 0x79, 0x7f, 0x15, 0xbd,
-	0x13, 0xce, 0xbb, 0x45, 0x1f, 0x7b, 0x18, 0x98, 0x07, 0x3e, 0x6c, 0xab, 0xcc, 0x54, 0x89, 0x58,
-	0x4c, 0xbd, 0xcf, 0x49, 0x7e, 0xaa, 0xc0, 0xf1, 0x30, 0xd1, 0x0a, 0xbd, 0x1c, 0xaf, 0x36, 0xf7,
-	0xea, 0x61, 0xc9, 0x57, 0xba, 0xf0, 0x94, 0xdc, 0x57, 0x38, 0xf7, 0x4b, 0x68, 0xb9, 0xf3, 0xba,
-	0x6e, 0xc6, 0xfe, 0x4f, 0x05, 0x26, 0xc3, 0x65, 0x2b, 0x74, 0xc1, 0x17, 0x64, 0x2c, 0xad, 0x2c,
-	0x79, 0xb1, 0x2b, 0x5f, 0x49, 0xf1, 0x16, 0xa7, 0xb8, 0x8a, 0xde, 0x88, 0x55, 0xd6, 0x96, 0x17,
-	0x54, 0x25, 0x22, 0xaa, 0xb8, 0x8c, 0x9b, 0x8a, 0xfa, 0xdf, 0x0a, 0xa4, 0x22, 0x24, 0x2d, 0xd4,
-	0x21, 0xe2, 0x16, 0x31, 0x2d, 0xa0, 0x8d, 0x63, 0xaa, 0x68, 0x78, 0x9d, 0xf3, 0xbd, 0x89, 0xae,
-	0xc7, 0x3c, 0x79, 0x43, 0x19, 0x4b, 0x2b, 0xf4, 0xb1, 0x02, 0x13, 0x81, 0x9a, 0x58, 0x80, 0xfc,
-	0x13, 0x25, 0xb4, 0x25, 0x5f, 0xea, 0xd4, 0xad, 0xa3, 0x4b, 0xdf, 0x29, 0xda, 0x00, 0x6e, 0x76,
-	0xee, 0xf6, 0x47, 0x8f, 0x27, 0x95, 0x47, 0x8f, 0x27, 0x95, 0xbf, 0x3f, 0x9e, 0x54, 0xbe, 0xff,
-	0x64, 0x72, 0xdf, 0xa3, 0x27, 0x93, 0xfb, 0x3e, 0x79, 0x32, 0xb9, 0xef, 0xed, 0xf3, 0x4d, 0xff,
-	0xe1, 0xb7, 0xa9, 0xb1, 0x4d, 0x52, 0x2c, 0x51, 0xbb, 0xf1, 0xab, 0xb0, 0x45, 0x34, 0x23, 0xb3,
-	0xd3, 0xb2, 0x22, 0xff, 0x80, 0xdc, 0xec, 0xe3, 0xff, 0x5f, 0x7f, 0xf6, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x5b, 0x3a, 0xbf, 0xc0, 0xf7, 0x2a, 0x00, 0x00,
+	// 3181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5d, 0x6c, 0x14, 0xd7,
+	0xf5, 0x67, 0xd6, 0xc6, 0x78, 0x0f, 0xf8, 0x83, 0xcb, 0x97, 0x3d, 0x10, 0xaf, 0xb9, 0x80, 0x71,
+	0x00, 0xef, 0x62, 0x20, 0x21, 0x01, 0x1c, 0xb2, 0x0b, 0x18, 0xfc, 0x0f, 0xf9, 0x03, 0x03, 0x21,
+	0x69, 0x9a, 0x76, 0x34, 0xde, 0xbd, 0x5e, 0x8f, 0xbc, 0x3b, 0xb3, 0xcc, 0xdc, 0x25, 0xb6, 0x5a,
+	0xaa, 0xb6, 0x52, 0x95, 0x48, 0xad, 0xd4, 0xf4, 0x4b, 0x51, 0x95, 0x4a, 0x7d, 0x4e, 0x2b, 0xf5,
+	0x29, 0x52, 0xf3, 0xd2, 0xaf, 0xb7, 0xa8, 0x52, 0xaa, 0xa8, 0x7d, 0x68, 0x94, 0x56, 0x9b, 0x2a,
+	0xe9, 0x53, 0x5f, 0xda, 0xee, 0x53, 0x1f, 0xab, 0xfb, 0x31, 0xb3, 0xb3, 0xeb, 0x99, 0xd9, 0xd9,
+	0x85, 0x46, 0x79, 0xc2, 0x7b, 0xef, 0x39, 0xe7, 0xfe, 0x7e, 0xe7, 0x9e, 0x33, 0xf7, 0xde, 0x73,
+	0x80, 0x4c, 0xcd, 0xb6, 0x2b, 0x55, 0xc3, 0x32, 0xca, 0xc4, 0xc9, 0xdd, 0x9f, 0x5f, 0x26, 0xd4,
+	0x98, 0xcf, 0xdd, 0xab, 0x13, 0x67, 0x23, 0x5b, 0x73, 0x6c, 0x6a, 0xa3, 0x5d, 0x01, 0x81, 0xac,
+	0x14, 0x50, 0x77, 0x97, 0xed, 0xb2, 0xcd, 0xe7, 0x73, 0xec, 0x2f, 0x21, 0xaa, 0x1e, 0x0c, 0xb3,
+	0x55, 0x26, 0x16, 0x71, 0x4d, 0x57, 0x8a, 0x1c, 0x08, 0x13, 0xa1, 0xeb, 0x72, 0xf6, 0x70, 0xd8,
+	0xac, 0xfb, 0xaa, 0x51, 0xd3, 0x1d, 0xbb, 0x4e, 0x89, 0x94, 0x7a, 0x3c, 0xd4, 0x86, 0xb1, 0x46,
+	0x1c, 0x7d, 0x85, 0x10, 0xdd, 0x5d, 0x35, 0x1c, 0x4f, 0x74, 0x36, 0x54, 0xd4, 0x31, 0x8a, 0x6b,
+	0xa4, 0xa4, 0xdf, 0xb7, 0x2b, 0xf5, 0xaa, 0x27, 0x39, 0x55, 0xb4, 0xdd, 0xaa, 0xed, 0xe6, 0x96,
+	0x0d, 0x97, 0xf8, 0x92, 0x45, 0xdb, 0xb4, 0xe4, 0xfc, 0xb1, 0xe0, 0x3c, 0xf7, 0x8f, 0x2f, 0x55,
+	0x33, 0xca, 0xa6, 0x65, 0x50, 0xd3, 0xf6, 0x64, 0x0f, 0x94, 0x6d, 0xbb, 0x5c, 0x21, 0x39, 0xa3,
+	0x66, 0xe6, 0x0c, 0xcb, 0xb2, 0x29, 0x9f, 0xf4, 0x5c, 0x30, 0x29, 0x67, 0xf9, 0xaf, 0xe5, 0xfa,
+	0x4a, 0xce, 0xb0, 0x36, 0xbc, 0x29, 0xb1, 0x88, 0x2e, 0x3c, 0x2b, 0x7e, 0xc8, 0xa9, 0x4c, 0xa7,
+	0x16, 0x35, 0xab, 0xc4, 0xa5, 0x46, 0xb5, 0x26, 0x04, 0xf0, 0x18, 0x8c, 0xdc, 0x34, 0x1c, 0xa3,
+	0xea, 0x6a, 0xe4, 0x5e, 0x9d, 0xb8, 0x14, 0x3f, 0x07, 0xa3, 0xde, 0x80, 0x5b, 0xb3, 0x2d, 0x97,
+	0xa0, 0xa7, 0x61, 0xa8, 0xc6, 0x47, 0x26, 0x94, 0x69, 0x65, 0x76, 0xfb, 0xa9, 0xfd, 0xd9, 0x90,
+	0xbd, 0xcd, 0x0a, 0xa5, 0xc2, 0xe0, 0x7b, 0x8d, 0xcc, 0x16, 0x4d, 0x2a, 0xe0, 0xb7, 0x53, 0x30,
+	0x7d, 0xc5, 0xa5, 0x66, 0xd5, 0xa0, 0xe4, 0xf6, 0xab, 0x46, 0xed, 0xca, 0xba, 0x51, 0xa4, 0xf9,
+	0xaa, 0x5d, 0xb7, 0xe8, 0x92, 0x25, 0x57, 0x44, 0x0b, 0x30, 0xe4, 0x12, 0xab, 0x44, 0x1c, 0x6e,
+	0x3f, 0x5d, 0x38, 0xd2, 0x6c, 0x64, 0x32, 0x1b, 0x46, 0xb5, 0x72, 0x0e, 0x8b, 0x71, 0x7c, 0xa2,
+	0x44, 0x6a, 0x0e, 0x29, 0x1a, 0x94, 0x94, 0xce, 0x61, 0xea, 0xd4, 0x09, 0x9e, 0x50, 0x34, 0xa9,
+	0x84, 0x2e, 0xc2, 0x36, 0x86, 0x47, 0x37, 0x4b, 0x13, 0xa9, 0x69, 0x65, 0x76, 0xb0, 0x30, 0xd3,
+	0x6c, 0x64, 0xa6, 0x85, 0xbe, 0x9c, 0x88, 0x30, 0xc0, 0x66, 0x97, 0x4a, 0x28, 0x0b, 0xc3, 0xd4,
+	0x5e, 0x23, 0x96, 0x6e, 0x5a, 0x13, 0x03, 0x1c, 0xc1, 0xae, 0x66, 0x23, 0x33, 0x26, 0x2c, 0x78,
+	0x33, 0x58, 0xdb, 0xc6, 0xff, 0x5c, 0xb2, 0xd0, 0x0b, 0x30, 0xc4, 0xe3, 0xca, 0x9d, 0x18, 0x9c,
+	0x1e, 0x98, 0xdd, 0x7e, 0x6a, 0x26, 0xd4, 0x1f, 0x8c, 0xae, 0xcf, 0x94, 0x89, 0x17, 0xf6, 0x30,
+	0xd7, 0x34, 0x1b, 0x99, 0x11, 0x61, 0x59, 0xd8, 0xc0, 0x9a, 0x34, 0x86, 0x7f, 0x9d, 0x82, 0x53,
+	0x91, 0xbe, 0x7a, 0xd1, 0xa4, 0xab, 0x37, 0x1d, 0xb3, 0x6a, 0x52, 0xf3, 0x3e, 0xb9, 0xb3, 0x51,
+	0x23, 0xde, 0x7e, 0x05, 0xe9, 0x2b, 0x0f, 0x4d, 0x3f, 0x95, 0x80, 0xfe, 0x45, 0x18, 0x15, 0x88,
+	0x75, 0x6f, 0xdd, 0x81, 0xe9, 0x81, 0xd9, 0xc1, 0xc2, 0x64, 0xb3, 0x91, 0xd9, 0x13, 0xa4, 0xe6,
+	0xcd, 0x63, 0x6d, 0x87, 0x18, 0xb8, 0x29, 0x16, 0xbc, 0x0b, 0x7b, 0xa5, 0x80, 0xb0, 0x6e, 0xd7,
+	0xa9, 0x5e, 0x22, 0x96, 0x5d, 0xe5, 0xfe, 0x4c, 0x17, 0x0e, 0x36, 0x1b, 0x99, 0xc7, 0xda, 0x0c,
+	0x75, 0xc8, 0x61, 0x6d, 0x97, 0x98, 0xb8, 0xc3, 0xc6, 0x6f, 0xd4, 0xe9, 0x65, 0x3e, 0xfa, 0xbe,
+	0x02, 0xc7, 0x7c, 0x07, 0x9a, 0x56, 0xb9, 0x42, 0xd8, 0x82, 0x91, 0x61, 0x77, 0xbc, 0xd3, 0x71,
+	0xa8, 0xd9, 0xc8, 0x8c, 0xb6, 0x3b, 0xae, 0x6f, 0x27, 0x15, 0x60, 0xac, 0x93, 0x9c, 0x08, 0x2d,
+	0xb5, 0xd9, 0xc8, 0xec, 0x0d, 0xaa, 0x05, 0x58, 0x8d, 0xd0, 0x36, 0x3e, 0xaf, 0x29, 0x70, 0x30,
+	0x26, 0x79, 0x64, 0x76, 0x2e, 0xc3, 0x78, 0xcb, 0x90, 0xc1, 0x67, 0x65, 0x1e, 0x3d, 0xc5, 0xe2,
+	0xed, 0xa3, 0x46, 0x66, 0x8f, 0xf8, 0x22, 0xb8, 0xa5, 0xb5, 0xac, 0x69, 0xe7, 0xaa, 0x06, 0x5d,
+	0xcd, 0x2e, 0x59, 0xb4, 0xd9, 0xc8, 0xec, 0xeb, 0xc4, 0x21, 0xd4, 0xb1, 0x36, 0xea, 0x01, 0x11,
+	0xab, 0xe1, 0x5f, 0xa4, 0x22, 0x91, 0xdc, 0xa8, 0xd3, 0xcf, 0x4b, 0x1e, 0xdf, 0xf5, 0xf3, 0x72,
+	0x80, 0xe7, 0xe5, 0xd1, 0x2e, 0x79, 0xc9, 0xa0, 0x27, 0x48, 0x4c, 0x34, 0x0f, 0x69, 0xdf, 0x45,
+	0x13, 0x83, 0x9c, 0xda, 0xee, 0x66, 0x23, 0x33, 0xde, 0xe1, 0x3d, 0xac, 0x0d, 0x7b, 0x6e, 0xc3,
+	0xbf, 0x49, 0xc1, 0xe9, 0x68, 0x87, 0xfd, 0x0f, 0x93, 0x79, 0x73, 0x72, 0xa6, 0x7a, 0x4b, 0xce,
+	0xdb, 0xb0, 0xa7, 0x2d, 0xe9, 0x4c, 0xcb, 0x0f, 0x5f, 0x96, 0x9b, 0xd3, 0xcd, 0x46, 0xe6, 0x40,
+	0x48, 0x6e, 0x7a, 0x62, 0x58, 0x43, 0x81, 0xd4, 0x5c, 0xb2, 0x78, 0x24, 0xf7, 0xe3, 0xc1, 0x3f,
+	0x28, 0x70, 0xbc, 0x6b, 0x32, 0x07, 0x82, 0xaf, 0xa7, 0x6c, 0xbe, 0x08, 0xa3, 0x1d, 0xec, 0x44,
+	0x4e, 0x07, 0xbc, 0xd4, 0x49, 0x6b, 0x07, 0x8d, 0x24, 0x34, 0x90, 0x88, 0xd0, 0xb7, 0x14, 0xc0,
+	0x71, 0x39, 0x24, 0xd3, 0x59, 0xf7, 0x3e, 0x1c, 0xa6, 0xd5, 0x9e, 0xcd, 0x67, 0xbb, 0x65, 0xf3,
+	0xde, 0x0e, 0xe0, 0x5e, 0x32, 0x8f, 0x48, 0xe4, 0x32, 0x97, 0x77, 0xc2, 0xd8, 0xff, 0xd7, 0xab,
+	0xcc, 0x99, 0xfe, 0x91, 0x7f, 0x05, 0xc6, 0x5b, 0x43, 0x12, 0xc7, 0x3c, 0xa4, 0xad, 0x7a, 0x95,
+	0x47, 0x89, 0x2b, 0x3d, 0x1a, 0x60, 0xe8, 0x4f, 0x61, 0x6d, 0xd8, 0x92, 0xaa, 0xf8, 0x1c, 0x6c,
+	0x67, 0x7f, 0xf4, 0xb3, 0x23, 0xf8, 0x12, 0xec, 0x10, 0xba, 0x72, 0xf9, 0xd3, 0x30, 0xc8, 0x66,
+	0xe4, 0x8d, 0x63, 0x77, 0x56, 0x5c, 0x63, 0xb2, 0xde, 0x35, 0x26, 0x9b, 0xb7, 0x36, 0x0a, 0xe9,
+	0xdf, 0xbf, 0x33, 0xb7, 0x95, 0x87, 0xad, 0xc6, 0x85, 0x19, 0xb5, 0x7c, 0xa5, 0xd2, 0x46, 0x6d,
+	0x09, 0xc6, 0x5b, 0x43, 0xd2, 0xf6, 0x13, 0xb0, 0xd5, 0xa3, 0x35, 0x90, 0xc4, 0xb8, 0x90, 0xc6,
+	0x79, 0xd8, 0x77, 0xdd, 0x74, 0x29, 0xb7, 0x55, 0xd8, 0xe0, 0x71, 0xe0, 0x51, 0x9d, 0x81, 0xad,
+	0x22, 0x8c, 0xc4, 0x56, 0x8d, 0x37, 0x1b, 0x99, 0x1d, 0x82, 0xa8, 0x8c, 0x1e, 0x31, 0x8d, 0x6f,
+	0xc1, 0xc4, 0x66, 0x13, 0x0f, 0x87, 0xea, 0x03, 0x05, 0xc6, 0x6f, 0xd7, 0x6c, 0x7a, 0xd3, 0x31,
+	0x8b, 0xa4, 0xaf, 0x64, 0xb8, 0x02, 0xe3, 0xec, 0x76, 0xaa, 0x1b, 0xae, 0x4b, 0x68, 0x5b, 0x3a,
+	0xec, 0x6f, 0x9d, 0x11, 0x9d, 0x12, 0x58, 0x1b, 0x65, 0x43, 0x79, 0x36, 0x22, 0x52, 0xe2, 0x1a,
+	0xec, 0xbc, 0x57, 0xb7, 0x69, 0xbb, 0x1d, 0x91, 0x1a, 0x07, 0x9a, 0x8d, 0xcc, 0x84, 0xb0, 0xb3,
+	0x49, 0x04, 0x6b, 0x63, 0x7c, 0xac, 0x65, 0x09, 0x2f, 0xc1, 0xce, 0x00, 0x23, 0xe9, 0x9e, 0x33,
+	0x00, 0x6e, 0xcd, 0xa6, 0x7a, 0x8d, 0x8d, 0x4a, 0x3f, 0xef, 0x69, 0x36, 0x32, 0x3b, 0x85, 0xdd,
+	0xd6, 0x1c, 0xd6, 0xd2, 0xae, 0xa7, 0x8d, 0xaf, 0xc1, 0xe4, 0x1d, 0x9b, 0x1a, 0x3c, 0x00, 0xae,
+	0x9b, 0xf7, 0xea, 0x66, 0xc9, 0xa4, 0x1b, 0x7d, 0x05, 0xe8, 0x5b, 0x0a, 0xa8, 0x61, 0xa6, 0x24,
+	0xbc, 0x07, 0x90, 0xae, 0x78, 0x83, 0x72, 0x07, 0x27, 0xb3, 0xf2, 0x26, 0xce, 0x1c, 0xe5, 0x1f,
+	0x3f, 0x97, 0x6c, 0xd3, 0x2a, 0x5c, 0x96, 0x07, 0x8e, 0xcc, 0x26, 0x5f, 0x13, 0xff, 0xec, 0xe3,
+	0xcc, 0x6c, 0xd9, 0xa4, 0xab, 0xf5, 0xe5, 0x6c, 0xd1, 0xae, 0xca, 0xab, 0xbc, 0xfc, 0x67, 0xce,
+	0x2d, 0xad, 0xe5, 0x28, 0x3b, 0x2d, 0xb8, 0x11, 0x57, 0x6b, 0xad, 0x88, 0xf7, 0xc1, 0x1e, 0x0e,
+	0xae, 0x93, 0x23, 0x7e, 0x53, 0x81, 0xbd, 0x9d, 0x33, 0x9f, 0x0f, 0xc8, 0xde, 0xd6, 0xdc, 0xe5,
+	0xcf, 0xa9, 0x45, 0xdb, 0xe9, 0xfb, 0xdb, 0xf1, 0x7d, 0x6f, 0x6b, 0x3a, 0x4c, 0x49, 0x9e, 0x14,
+	0x86, 0xc4, 0x93, 0xad, 0x3b, 0xc9, 0x7c, 0xfb, 0x45, 0x40, 0xa8, 0xf5, 0xc6, 0x50, 0xae, 0x85,
+	0x7f, 0x95, 0x82, 0x09, 0x06, 0x43, 0x60, 0xba, 0x66, 0xba, 0xd4, 0x76, 0xfa, 0x8a, 0x3c, 0xf4,
+	0x12, 0x80, 0x4b, 0x0d, 0x87, 0xea, 0xec, 0xe9, 0xc6, 0x33, 0x73, 0xfb, 0x29, 0x75, 0xd3, 0xd7,
+	0xe1, 0x8e, 0xf7, 0xae, 0x2b, 0x3c, 0x26, 0x49, 0x78, 0x99, 0xe1, 0xeb, 0xe2, 0x37, 0x3e, 0xce,
+	0x28, 0x5a, 0x9a, 0x0f, 0x30, 0x71, 0xa4, 0xc1, 0x30, 0xb1, 0x4a, 0xc2, 0xee, 0x40, 0x57, 0xbb,
+	0xfb, 0xa5, 0x5d, 0x79, 0xe9, 0xf5, 0x34, 0x85, 0xd5, 0x6d, 0xc4, 0x2a, 0x71, 0x9b, 0x8b, 0x00,
+	0xad, 0x87, 0x2d, 0x3f, 0xeb, 0xd9, 0x03, 0x29, 0xe8, 0x71, 0x51, 0x25, 0x68, 0x3d, 0x1b, 0xcb,
+	0xde, 0x67, 0x4b, 0x0b, 0x68, 0xe2, 0xdf, 0x0d, 0xc0, 0x64, 0x88, 0xff, 0xe4, 0x9e, 0x96, 0x61,
+	0xa4, 0x64, 0x98, 0x95, 0x0d, 0xf9, 0x18, 0xf7, 0x3e, 0x9a, 0x87, 0xc3, 0x5f, 0xa6, 0xb6, 0x5d,
+	0xb9, 0xcc, 0xa4, 0x85, 0xad, 0xc2, 0x01, 0x49, 0x64, 0xb7, 0xfc, 0x44, 0x07, 0x0d, 0x61, 0x6d,
+	0x47, 0xa9, 0x25, 0xea, 0x06, 0x82, 0x27, 0xf5, 0xd9, 0x05, 0x0f, 0xfa, 0x2a, 0xa4, 0x79, 0xf9,
+	0x62, 0x85, 0xf8, 0x97, 0xd9, 0xe4, 0xa9, 0xe9, 0x6b, 0xf6, 0xb6, 0xf6, 0x30, 0xd3, 0x5b, 0x24,
+	0xc4, 0x45, 0x57, 0x43, 0xb6, 0xf0, 0x68, 0xd7, 0x2d, 0x14, 0x3b, 0xd3, 0xb6, 0x87, 0xef, 0x2a,
+	0xb0, 0x93, 0x39, 0x7f, 0x91, 0x90, 0x7c, 0xcd, 0xe9, 0x2b, 0xf8, 0xcf, 0xc2, 0x76, 0x71, 0x64,
+	0x04, 0xcf, 0xa5, 0xbd, 0xcd, 0x46, 0x06, 0x05, 0xcf, 0x13, 0x79, 0x92, 0x00, 0xff, 0x25, 0x8e,
+	0xa3, 0x05, 0x18, 0xa9, 0xd8, 0xf6, 0xda, 0xb2, 0x51, 0x5c, 0xd3, 0x4b, 0xc6, 0x86, 0xcb, 0x03,
+	0x7c, 0xb0, 0x30, 0xd1, 0xda, 0xf7, 0xb6, 0x69, 0xac, 0xed, 0xf0, 0x7e, 0x5f, 0x66, 0x3f, 0x3f,
+	0x1e, 0x00, 0x14, 0x84, 0x2e, 0xe3, 0xee, 0x12, 0x0c, 0x18, 0x35, 0xef, 0x7d, 0x33, 0x2f, 0x6f,
+	0x64, 0xfb, 0x37, 0xdf, 0xc8, 0xae, 0x93, 0xb2, 0x51, 0xdc, 0xb8, 0x4c, 0x8a, 0xcd, 0x46, 0x06,
+	0xc4, 0x72, 0x46, 0xcd, 0xc1, 0x1a, 0xd3, 0x6e, 0xdf, 0xdd, 0xd4, 0x67, 0xbd, 0xbb, 0x04, 0xc6,
+	0x7c, 0x1b, 0xfa, 0x7d, 0xa3, 0x52, 0x27, 0xf2, 0x94, 0x5e, 0x48, 0x46, 0x67, 0x6f, 0x07, 0x0e,
+	0x61, 0x03, 0x6b, 0x23, 0xde, 0x0a, 0x77, 0xd9, 0x6f, 0xb4, 0x02, 0x63, 0xfe, 0xb7, 0x5e, 0x2e,
+	0x33, 0xd8, 0xc7, 0x32, 0x1d, 0x36, 0xb0, 0x36, 0xea, 0x8f, 0x88, 0x75, 0x3a, 0x02, 0x64, 0x6b,
+	0xd2, 0x00, 0xc1, 0xf7, 0x41, 0xbd, 0xe3, 0x18, 0x25, 0xd3, 0x2a, 0xdf, 0x34, 0x4c, 0xe7, 0x0e,
+	0xab, 0x03, 0x2e, 0x92, 0xe0, 0x0d, 0x8a, 0xeb, 0xe8, 0x27, 0xe5, 0x66, 0x07, 0x82, 0x54, 0x4e,
+	0x60, 0x6d, 0x88, 0xff, 0x75, 0xb2, 0x25, 0x3c, 0x3f, 0x91, 0x0a, 0x17, 0x9e, 0xf7, 0x84, 0xe7,
+	0xb1, 0x0e, 0xfb, 0x43, 0xd7, 0x95, 0x11, 0xf6, 0x2c, 0xa4, 0xfd, 0x9a, 0xa4, 0x5c, 0xfa, 0x50,
+	0x02, 0x8f, 0x69, 0xc3, 0x54, 0x5a, 0x62, 0x75, 0xa4, 0x19, 0xef, 0xa1, 0xc1, 0x56, 0x22, 0x05,
+	0xc3, 0x25, 0xa5, 0x1b, 0x16, 0xbf, 0x11, 0x2d, 0x55, 0x6b, 0x46, 0xd1, 0x7f, 0x34, 0x5d, 0x80,
+	0xf4, 0x8a, 0x63, 0x57, 0x75, 0x56, 0xb0, 0x94, 0x57, 0xed, 0x98, 0x48, 0x14, 0xa5, 0xbd, 0x61,
+	0xa6, 0xc1, 0x7e, 0x23, 0x0c, 0x23, 0xd4, 0xe6, 0xba, 0xc1, 0xec, 0xd4, 0xb6, 0x53, 0x9b, 0x4d,
+	0x8b, 0x34, 0xdc, 0xd7, 0x4a, 0x76, 0x9e, 0x80, 0x7e, 0x62, 0x3f, 0x0f, 0xe3, 0x55, 0x63, 0x5d,
+	0x5c, 0xd9, 0x74, 0x93, 0xa3, 0x9a, 0x18, 0x4c, 0x4e, 0x77, 0xb4, 0x6a, 0xac, 0x07, 0x08, 0xa1,
+	0xff, 0x83, 0x51, 0xb2, 0x4e, 0x89, 0x63, 0x19, 0x15, 0x79, 0x45, 0xdc, 0x9a, 0xdc, 0xd8, 0x88,
+	0xa7, 0x2a, 0x2e, 0x8d, 0x3f, 0x57, 0xe0, 0x68, 0x57, 0x07, 0xca, 0xed, 0x7a, 0x06, 0xc0, 0xb4,
+	0x6a, 0x75, 0xda, 0x93, 0x0b, 0xd3, 0x5c, 0x85, 0xfb, 0xf0, 0x59, 0xd8, 0x6e, 0xd7, 0xa9, 0x6f,
+	0x20, 0x95, 0xcc, 0x00, 0x08, 0x1d, 0x36, 0x82, 0xff, 0xa5, 0xc0, 0xee, 0x45, 0xd3, 0x2a, 0x15,
+	0xd8, 0x09, 0x6a, 0xd7, 0xa9, 0x1f, 0xc2, 0xc1, 0x92, 0x95, 0xd2, 0x5f, 0xc9, 0x2a, 0xd5, 0x63,
+	0xc9, 0x8a, 0xad, 0xc9, 0x76, 0x75, 0xd5, 0xae, 0x79, 0x1f, 0xdc, 0xc0, 0x9a, 0xde, 0x0c, 0xd6,
+	0xb6, 0x55, 0x8d, 0xf5, 0x6b, 0x76, 0xcd, 0x65, 0xb7, 0x7a, 0x36, 0xea, 0xd6, 0x2a, 0x26, 0x75,
+	0xf9, 0xfe, 0x0f, 0x06, 0x6f, 0xf5, 0xad, 0x39, 0xac, 0xa5, 0xab, 0xc6, 0xfa, 0x6d, 0xf1, 0xf7,
+	0x9f, 0x15, 0xd8, 0xd3, 0x41, 0x59, 0x6e, 0xc7, 0xcb, 0x7e, 0x09, 0x48, 0x5c, 0x08, 0x8e, 0x77,
+	0x2d, 0xcd, 0x72, 0x93, 0x89, 0xca, 0x40, 0x61, 0x85, 0xb6, 0xd4, 0x23, 0x2e, 0xb4, 0x1d, 0x82,
+	0x83, 0xf9, 0x4a, 0xc5, 0xfb, 0x28, 0xdc, 0x66, 0x2d, 0x89, 0x7c, 0xd9, 0x21, 0xa4, 0x4a, 0x2c,
+	0xea, 0xbf, 0x69, 0x7f, 0xa8, 0x00, 0x8e, 0x93, 0x92, 0xbe, 0xb0, 0x40, 0xed, 0xe8, 0x6e, 0xe8,
+	0x86, 0x2f, 0x15, 0xeb, 0x9f, 0x70, 0xcb, 0x32, 0xf6, 0xf6, 0xd1, 0xf0, 0x75, 0xf1, 0x33, 0x30,
+	0x13, 0xae, 0xb8, 0xe8, 0xd8, 0xd5, 0xb6, 0xe7, 0xf2, 0xee, 0xb6, 0xe7, 0xb2, 0xf7, 0x38, 0x7e,
+	0x53, 0x81, 0xa3, 0x5d, 0x0d, 0x48, 0x6e, 0x15, 0x98, 0x8c, 0xe4, 0x26, 0xb3, 0xb0, 0x0f, 0x6a,
+	0x7b, 0xc3, 0xa9, 0xe1, 0x15, 0x98, 0x6d, 0xd3, 0xe3, 0x58, 0xdc, 0x3b, 0x76, 0xbe, 0x58, 0x74,
+	0xea, 0xa4, 0xc4, 0x0f, 0xa2, 0x58, 0x6e, 0xe8, 0x30, 0x8c, 0x78, 0xb6, 0x2f, 0x07, 0x3e, 0x95,
+	0xed, 0x83, 0xd8, 0x85, 0xc7, 0x13, 0xac, 0x23, 0x5d, 0xb0, 0x08, 0x43, 0x6d, 0xf5, 0xa1, 0x6c,
+	0xb7, 0x20, 0x94, 0x61, 0xed, 0x85, 0x9e, 0xd4, 0xc6, 0x47, 0xe0, 0xd0, 0xa6, 0x60, 0x2a, 0x16,
+	0xeb, 0xd5, 0x7a, 0xc5, 0xa0, 0xb6, 0xe3, 0x07, 0xdd, 0x8f, 0x15, 0x38, 0x1c, 0x2f, 0x27, 0x71,
+	0xdd, 0x83, 0xfd, 0x81, 0xad, 0x59, 0x33, 0xab, 0xba, 0x11, 0x10, 0x93, 0x71, 0x77, 0x22, 0x7e,
+	0x73, 0xd6, 0xcc, 0x6a, 0xc0, 0xb6, 0xdc, 0x9d, 0x09, 0x1a, 0x3e, 0xed, 0xe2, 0x05, 0x38, 0xa2,
+	0x91, 0xb2, 0xe9, 0x52, 0xe2, 0x90, 0x52, 0xbe, 0x52, 0xb1, 0x37, 0x48, 0x89, 0x5f, 0xde, 0x92,
+	0x05, 0xde, 0xb7, 0x15, 0x98, 0xe9, 0xa6, 0x2f, 0xc9, 0x19, 0x30, 0x5a, 0xb4, 0x2d, 0xd6, 0x09,
+	0xa4, 0xba, 0x4b, 0x0d, 0x4a, 0x64, 0xb0, 0x9d, 0x09, 0xe5, 0xc3, 0x4d, 0x5d, 0x92, 0xf2, 0x6d,
+	0x9e, 0xbb, 0xcd, 0x74, 0x25, 0xaf, 0x11, 0xcf, 0x22, 0x1f, 0xc4, 0xf9, 0x18, 0x30, 0xa2, 0x46,
+	0xeb, 0xb1, 0xd9, 0xd7, 0x71, 0x91, 0xf6, 0x1f, 0xc4, 0xdf, 0x51, 0xe0, 0x68, 0x57, 0x1b, 0x9f,
+	0x1d, 0x23, 0x0c, 0xd3, 0xf9, 0x4a, 0x25, 0x14, 0x90, 0x1f, 0x5e, 0xaf, 0x2b, 0x70, 0x30, 0x46,
+	0x48, 0x82, 0x2d, 0xc2, 0x58, 0x3b, 0x58, 0x2f, 0x9e, 0x1e, 0x06, 0xed, 0x68, 0x1b, 0x5a, 0xf7,
+	0xd4, 0xfb, 0xc7, 0x60, 0xeb, 0x2d, 0xf6, 0xc0, 0x41, 0x5f, 0x83, 0x21, 0xd1, 0xd5, 0x44, 0x38,
+	0xa6, 0xe5, 0x29, 0x29, 0xa8, 0x87, 0x62, 0x65, 0x04, 0x03, 0x7c, 0xfc, 0x9b, 0x7f, 0xfa, 0xfb,
+	0x0f, 0x52, 0x47, 0xd0, 0xa1, 0x1c, 0xcf, 0x52, 0xd3, 0xcd, 0x85, 0xb5, 0x9a, 0xe5, 0xaa, 0x1f,
+	0x2a, 0x30, 0x19, 0xd9, 0x00, 0x42, 0x4f, 0x84, 0xae, 0xd7, 0xad, 0xdb, 0xaa, 0x3e, 0xd9, 0xab,
+	0x9a, 0x44, 0x7e, 0x9d, 0x23, 0x5f, 0x44, 0x97, 0x63, 0x91, 0x7f, 0x45, 0x46, 0xe4, 0x83, 0x1c,
+	0x91, 0x16, 0x45, 0x23, 0x9e, 0x30, 0x9b, 0xf2, 0xd8, 0xd3, 0x4d, 0x0b, 0x7d, 0x2f, 0x05, 0xc7,
+	0x23, 0xd7, 0xdc, 0xdc, 0x1f, 0x41, 0x57, 0x7b, 0x43, 0x1d, 0xd9, 0x61, 0xe9, 0x9b, 0xbe, 0xc1,
+	0xe9, 0x7f, 0x11, 0x7d, 0xe1, 0x51, 0xd0, 0xd7, 0x5f, 0x35, 0xe9, 0xaa, 0x5e, 0xf3, 0x00, 0xea,
+	0xfc, 0xa5, 0x86, 0xfe, 0xa3, 0xc0, 0xa1, 0x04, 0xfd, 0x4b, 0x74, 0x31, 0x9e, 0x42, 0xd7, 0xce,
+	0x67, 0xdf, 0x3e, 0x78, 0x89, 0xfb, 0x40, 0x43, 0x37, 0x7b, 0xf6, 0x01, 0xc7, 0x24, 0x5a, 0x4e,
+	0xa1, 0xe1, 0xf0, 0x57, 0x05, 0xd4, 0xe8, 0xe6, 0x08, 0xea, 0x09, 0x70, 0xab, 0x29, 0xa4, 0x9e,
+	0xed, 0x59, 0x4f, 0x32, 0x7d, 0x9e, 0x33, 0xbd, 0x8a, 0xae, 0x3c, 0xfc, 0x6e, 0xdb, 0x75, 0x8a,
+	0x7e, 0x94, 0x82, 0x13, 0xbd, 0xb4, 0x03, 0xd1, 0xb5, 0x1e, 0x81, 0x47, 0xc7, 0x7b, 0xdf, 0x2e,
+	0x58, 0xe6, 0x2e, 0x78, 0x05, 0xbd, 0xfc, 0x48, 0x5c, 0x10, 0x1e, 0xf1, 0x5f, 0x4f, 0xc1, 0xe1,
+	0x24, 0x4d, 0x3e, 0xf4, 0x6c, 0x7f, 0x21, 0xff, 0x28, 0x42, 0xe1, 0x4b, 0xdc, 0x0f, 0x2f, 0xa2,
+	0x17, 0x7a, 0xf4, 0x03, 0x63, 0xdd, 0x25, 0xf0, 0x59, 0x68, 0xbc, 0xa6, 0xc0, 0xb0, 0xd7, 0x7c,
+	0x43, 0xe1, 0xf5, 0xcb, 0x8e, 0x76, 0x9d, 0x7a, 0xa4, 0x8b, 0x94, 0x04, 0x9e, 0xe5, 0xc0, 0x67,
+	0xd1, 0x4c, 0x2c, 0x70, 0xbf, 0x93, 0x87, 0xbe, 0xa1, 0xc0, 0x20, 0xb3, 0x80, 0xa6, 0x23, 0xab,
+	0xa8, 0x1e, 0x82, 0x83, 0x31, 0x12, 0x72, 0xf5, 0x33, 0x7c, 0xf5, 0x2c, 0x3a, 0x11, 0xbb, 0x3a,
+	0x5f, 0xb9, 0xe5, 0x3c, 0xee, 0x0d, 0xaf, 0x5f, 0x17, 0xe1, 0x8d, 0x8e, 0x0e, 0x9f, 0x7a, 0xa4,
+	0x8b, 0x54, 0x4f, 0xde, 0x30, 0x2a, 0x95, 0x39, 0xe1, 0x8d, 0xb7, 0x15, 0x18, 0xef, 0xec, 0xd5,
+	0xa1, 0xf0, 0x6b, 0x6b, 0x44, 0x57, 0x50, 0x9d, 0x4b, 0x28, 0x2d, 0x11, 0x3e, 0xc5, 0x11, 0x9e,
+	0x42, 0x27, 0x63, 0x11, 0x56, 0x4c, 0x97, 0x0a, 0x88, 0x73, 0xcb, 0x1b, 0x73, 0xe2, 0x75, 0xf1,
+	0x5d, 0x05, 0xd2, 0x7e, 0xc7, 0x0c, 0x85, 0x3b, 0xa4, 0xb3, 0x47, 0xa8, 0xce, 0x74, 0x13, 0x93,
+	0xb0, 0x4e, 0x73, 0x58, 0x73, 0xe8, 0x78, 0x28, 0xac, 0x8e, 0x0d, 0xcc, 0xf1, 0xda, 0x8b, 0x8b,
+	0x7e, 0xab, 0x00, 0xda, 0xdc, 0x2d, 0x43, 0xd9, 0xf0, 0x6b, 0x7f, 0x54, 0x87, 0x4e, 0xcd, 0x25,
+	0x96, 0x97, 0x60, 0x97, 0x38, 0xd8, 0x4b, 0x28, 0xdf, 0x4b, 0xd4, 0xe5, 0x28, 0x33, 0x28, 0x92,
+	0xd4, 0xaf, 0x2e, 0xa2, 0x9f, 0x2a, 0x30, 0xda, 0xde, 0x39, 0x43, 0xc7, 0xa2, 0xe1, 0x6c, 0x82,
+	0x7e, 0x3c, 0x91, 0x6c, 0x4f, 0xc9, 0x22, 0x60, 0xb6, 0x10, 0xbe, 0xeb, 0x39, 0xb9, 0xad, 0xef,
+	0x15, 0xe7, 0xe4, 0xb0, 0x5e, 0x9b, 0x9a, 0x4b, 0x2c, 0x2f, 0xd1, 0xe6, 0x39, 0xda, 0xf3, 0xe8,
+	0xe9, 0x3e, 0x9c, 0x2c, 0x1b, 0x1c, 0xbf, 0x94, 0x9d, 0x81, 0xb6, 0xee, 0x0e, 0x9a, 0x8b, 0xfc,
+	0xac, 0x84, 0x75, 0xd1, 0xd4, 0x6c, 0x52, 0x71, 0x89, 0xfb, 0x12, 0xc7, 0xbd, 0x80, 0xce, 0xf7,
+	0x84, 0x5b, 0x20, 0xd6, 0x57, 0x25, 0xc6, 0xb7, 0x14, 0x80, 0x56, 0x63, 0x00, 0xcd, 0x44, 0x62,
+	0x68, 0x6b, 0x7a, 0xa8, 0x47, 0xbb, 0xca, 0x49, 0x90, 0x17, 0x38, 0xc8, 0x27, 0xd1, 0x99, 0x9e,
+	0x40, 0xb2, 0xb7, 0x36, 0x6b, 0x2d, 0xbc, 0xa3, 0xc0, 0xae, 0x90, 0xea, 0x32, 0x8a, 0xd8, 0xe3,
+	0xc8, 0xfa, 0xb7, 0x7a, 0x32, 0xb9, 0x82, 0x04, 0x7e, 0x8e, 0x03, 0x3f, 0x83, 0x4e, 0xc5, 0xc7,
+	0xb0, 0xb0, 0xa0, 0xd7, 0x0c, 0xd3, 0xd1, 0xf9, 0x83, 0x7e, 0x85, 0x10, 0xf4, 0x17, 0x05, 0x32,
+	0x5d, 0x2a, 0xae, 0xe8, 0x7c, 0xec, 0x01, 0x1e, 0x5f, 0xe8, 0x56, 0x2f, 0xf4, 0xa7, 0x2c, 0xa9,
+	0x2d, 0x70, 0x6a, 0x67, 0xd1, 0x13, 0xbd, 0x5e, 0x01, 0x18, 0x5b, 0x82, 0x7e, 0xa2, 0xc0, 0x48,
+	0x5b, 0xb9, 0x12, 0x3d, 0x1e, 0x0a, 0x27, 0xac, 0x8a, 0xab, 0x1e, 0x4b, 0x22, 0xda, 0xd3, 0x67,
+	0x64, 0xc5, 0xb4, 0x4a, 0xfa, 0x32, 0x71, 0xa9, 0xf8, 0xdf, 0xd1, 0xe8, 0x8f, 0x0a, 0xa8, 0xd1,
+	0xe5, 0xc4, 0x88, 0xbb, 0x77, 0xd7, 0x2a, 0xa5, 0x7a, 0xb6, 0x67, 0xbd, 0x9e, 0xd2, 0xd4, 0xa8,
+	0x54, 0xf4, 0xe8, 0xf2, 0x26, 0xfa, 0xa7, 0x02, 0x99, 0x2e, 0xc5, 0xc4, 0x88, 0x88, 0x4a, 0x56,
+	0xc3, 0x54, 0x2f, 0xf4, 0xa7, 0x2c, 0x39, 0xde, 0xe2, 0x1c, 0x9f, 0x43, 0x4b, 0xf1, 0x11, 0xc5,
+	0x8f, 0xf7, 0x07, 0xb9, 0x48, 0x9e, 0x3a, 0xef, 0xde, 0x70, 0x29, 0xf4, 0x7a, 0x0a, 0x0e, 0x76,
+	0xad, 0x1e, 0xa2, 0x85, 0xee, 0xb0, 0x63, 0xaa, 0x9b, 0xea, 0x33, 0xfd, 0xaa, 0x4b, 0xde, 0x25,
+	0xce, 0xfb, 0xcb, 0xe8, 0x95, 0x78, 0xde, 0x6d, 0x65, 0xd1, 0x07, 0x91, 0x7e, 0xe0, 0xc3, 0xae,
+	0x4e, 0x6d, 0xdd, 0x10, 0x8b, 0x89, 0x26, 0x21, 0xfa, 0x48, 0x81, 0x03, 0x71, 0xb5, 0x4a, 0xf4,
+	0x54, 0xb2, 0xd8, 0xdc, 0x5c, 0x06, 0x55, 0x9f, 0xee, 0x43, 0x53, 0x72, 0xbf, 0xc2, 0xb9, 0x5f,
+	0x44, 0x0b, 0xbd, 0xc7, 0x75, 0x10, 0xfb, 0x3f, 0x14, 0x98, 0x8a, 0xaf, 0x56, 0xa2, 0x73, 0xa1,
+	0x20, 0x13, 0x95, 0x48, 0xd5, 0xf3, 0x7d, 0xe9, 0x4a, 0x8a, 0x37, 0x38, 0xc5, 0x25, 0x74, 0x35,
+	0x51, 0x58, 0x3b, 0xbe, 0x51, 0xdd, 0x10, 0x56, 0xc5, 0x1d, 0x2c, 0x10, 0xd4, 0xff, 0x56, 0x20,
+	0xd3, 0xa5, 0x92, 0x89, 0x7a, 0x44, 0xdc, 0x56, 0x43, 0x55, 0x2f, 0xf4, 0xa7, 0x2c, 0xf9, 0xde,
+	0xe6, 0x7c, 0x9f, 0x47, 0xcf, 0x25, 0x3c, 0x18, 0x62, 0x19, 0x4b, 0x29, 0xf4, 0xbe, 0x02, 0x93,
+	0x91, 0xa5, 0xd0, 0x88, 0xaa, 0x5f, 0xb7, 0xfa, 0xaa, 0xfa, 0x64, 0xaf, 0x6a, 0x3d, 0xdd, 0xf5,
+	0x58, 0xd0, 0x46, 0x70, 0x73, 0x0b, 0xb7, 0xde, 0xfb, 0x64, 0x4a, 0xf9, 0xe0, 0x93, 0x29, 0xe5,
+	0x6f, 0x9f, 0x4c, 0x29, 0x6f, 0x7c, 0x3a, 0xb5, 0xe5, 0x83, 0x4f, 0xa7, 0xb6, 0x7c, 0xf8, 0xe9,
+	0xd4, 0x96, 0x97, 0xcf, 0x06, 0xfe, 0xfb, 0xc2, 0xb2, 0x49, 0x97, 0x8d, 0x52, 0x99, 0xb8, 0xad,
+	0xbf, 0x8a, 0xab, 0x86, 0x69, 0xe5, 0xd6, 0xdb, 0x56, 0xe4, 0x75, 0x83, 0xe5, 0x21, 0xfe, 0xdf,
+	0x93, 0x4e, 0xff, 0x77, 0x00, 0xf0, 0xc9, 0xe6, 0x16, 0x86, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2424,12 +2864,22 @@ type QueryClient interface {
 	TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error)
 	// TotalVolumeForPool returns the total volume of the specified pool.
 	TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error)
+	// PoolVolumeHistory returns the daily volume and swap fees of the specified
+	// pool over a time range.
+	PoolVolumeHistory(ctx context.Context, in *PoolVolumeHistoryRequest, opts ...grpc.CallOption) (*PoolVolumeHistoryResponse, error)
+	// PoolFeeApr returns an APR estimate of the swap fees earned by the LPs of
+	// the specified pool over its recent days.
+	PoolFeeApr(ctx context.Context, in *PoolFeeAprRequest, opts ...grpc.CallOption) (*PoolFeeAprResponse, error)
 	// TradingPairTakerFee returns the taker fee for a given set of denoms
 	TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error)
 	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
 	// impact, if a trade cannot be estimated a 0 input and 0 output would be
 	// returned.
 	EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// FindBestRoute returns the route (single or split across pool-disjoint
+	// multihop paths) that maximizes the estimated amount out for swapping
+	// token_in into token_out_denom, accounting for spread and taker fees.
+	FindBestRoute(ctx context.Context, in *FindBestRouteRequest, opts ...grpc.CallOption) (*FindBestRouteResponse, error)
 	// AllTakerFeeShareAgreements returns all taker fee share agreements.
 	// A taker fee share agreement includes the denom of the denom getting the
 	// taker fees, the percent of the taker fees that the denom gets when it is
@@ -2611,18 +3061,45 @@ func (c *queryClient) TotalVolumeForPool(ctx context.Context, in *TotalVolumeFor
 	return out, nil
 }
 
-func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
-	out := new(TradingPairTakerFeeResponse)
-	err := c.cc.Invoke(ctx, "/poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
+func (c *queryClient) PoolVolumeHistory(ctx context.Context, in *PoolVolumeHistoryRequest, opts ...grpc.CallOption) (*PoolVolumeHistoryResponse, error) {
+	out := new(PoolVolumeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/poolmanager.v1beta1.Query/PoolVolumeHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error) {
-	out := new(EstimateTradeBasedOnPriceImpactResponse)
-	err := c.cc.Invoke(ctx, "/poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", in, out, opts...)
+func (c *queryClient) PoolFeeApr(ctx context.Context, in *PoolFeeAprRequest, opts ...grpc.CallOption) (*PoolFeeAprResponse, error) {
+	out := new(PoolFeeAprResponse)
+	err := c.cc.Invoke(ctx, "/poolmanager.v1beta1.Query/PoolFeeApr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
+	out := new(TradingPairTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error) {
+	out := new(EstimateTradeBasedOnPriceImpactResponse)
+	err := c.cc.Invoke(ctx, "/poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FindBestRoute(ctx context.Context, in *FindBestRouteRequest, opts ...grpc.CallOption) (*FindBestRouteResponse, error) {
+	out := new(FindBestRouteResponse)
+	err := c.cc.Invoke(ctx, "/poolmanager.v1beta1.Query/FindBestRoute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2731,12 +3208,22 @@ type QueryServer interface {
 	TotalLiquidity(context.Context, *TotalLiquidityRequest) (*TotalLiquidityResponse, error)
 	// TotalVolumeForPool returns the total volume of the specified pool.
 	TotalVolumeForPool(context.Context, *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error)
+	// PoolVolumeHistory returns the daily volume and swap fees of the specified
+	// pool over a time range.
+	PoolVolumeHistory(context.Context, *PoolVolumeHistoryRequest) (*PoolVolumeHistoryResponse, error)
+	// PoolFeeApr returns an APR estimate of the swap fees earned by the LPs of
+	// the specified pool over its recent days.
+	PoolFeeApr(context.Context, *PoolFeeAprRequest) (*PoolFeeAprResponse, error)
 	// TradingPairTakerFee returns the taker fee for a given set of denoms
 	TradingPairTakerFee(context.Context, *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error)
 	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
 	// impact, if a trade cannot be estimated a 0 input and 0 output would be
 	// returned.
 	EstimateTradeBasedOnPriceImpact(context.Context, *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// FindBestRoute returns the route (single or split across pool-disjoint
+	// multihop paths) that maximizes the estimated amount out for swapping
+	// token_in into token_out_denom, accounting for spread and taker fees.
+	FindBestRoute(context.Context, *FindBestRouteRequest) (*FindBestRouteResponse, error)
 	// AllTakerFeeShareAgreements returns all taker fee share agreements.
 	// A taker fee share agreement includes the denom of the denom getting the
 	// taker fees, the percent of the taker fees that the denom gets when it is
@@ -2824,12 +3311,21 @@ func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *TotalL
 func (*UnimplementedQueryServer) TotalVolumeForPool(ctx context.Context, req *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalVolumeForPool not implemented")
 }
+func (*UnimplementedQueryServer) PoolVolumeHistory(ctx context.Context, req *PoolVolumeHistoryRequest) (*PoolVolumeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVolumeHistory not implemented")
+}
+func (*UnimplementedQueryServer) PoolFeeApr(ctx context.Context, req *PoolFeeAprRequest) (*PoolFeeAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolFeeApr not implemented")
+}
 func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TradingPairTakerFee not implemented")
 }
 func (*UnimplementedQueryServer) EstimateTradeBasedOnPriceImpact(ctx context.Context, req *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateTradeBasedOnPriceImpact not implemented")
 }
+func (*UnimplementedQueryServer) FindBestRoute(ctx context.Context, req *FindBestRouteRequest) (*FindBestRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBestRoute not implemented")
+}
 func (*UnimplementedQueryServer) AllTakerFeeShareAgreements(ctx context.Context, req *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAgreements not implemented")
 }
@@ -3126,6 +3622,42 @@ func _Query_TotalVolumeForPool_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolVolumeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolVolumeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVolumeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolmanager.v1beta1.Query/PoolVolumeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVolumeHistory(ctx, req.(*PoolVolumeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolFeeApr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolFeeAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolFeeApr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolmanager.v1beta1.Query/PoolFeeApr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolFeeApr(ctx, req.(*PoolFeeAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TradingPairTakerFeeRequest)
 	if err := dec(in); err != nil {
@@ -3162,6 +3694,24 @@ func _Query_EstimateTradeBasedOnPriceImpact_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FindBestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBestRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FindBestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolmanager.v1beta1.Query/FindBestRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindBestRoute(ctx, req.(*FindBestRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllTakerFeeShareAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllTakerFeeShareAgreementsRequest)
 	if err := dec(in); err != nil {
@@ -3353,6 +3903,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalVolumeForPool",
 			Handler:    _Query_TotalVolumeForPool_Handler,
 		},
+		{
+			MethodName: "PoolVolumeHistory",
+			Handler:    _Query_PoolVolumeHistory_Handler,
+		},
+		{
+			MethodName: "PoolFeeApr",
+			Handler:    _Query_PoolFeeApr_Handler,
+		},
 		{
 			MethodName: "TradingPairTakerFee",
 			Handler:    _Query_TradingPairTakerFee_Handler,
@@ -3361,6 +3919,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateTradeBasedOnPriceImpact",
 			Handler:    _Query_EstimateTradeBasedOnPriceImpact_Handler,
 		},
+		{
+			MethodName: "FindBestRoute",
+			Handler:    _Query_FindBestRoute_Handler,
+		},
 		{
 			MethodName: "AllTakerFeeShareAgreements",
 			Handler:    _Query_AllTakerFeeShareAgreements_Handler,
@@ -4339,7 +4901,7 @@ func (m *TotalVolumeForPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolVolumeHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4349,34 +4911,53 @@ func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom_1) > 0 {
-		i -= len(m.Denom_1)
-		copy(dAtA[i:], m.Denom_1)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.Denom_0) > 0 {
-		i -= len(m.Denom_0)
-		copy(dAtA[i:], m.Denom_0)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolVolumeHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4386,30 +4967,74 @@ func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TakerFee.Size()
-		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DailyVolumes) > 0 {
+		for iNdEx := len(m.DailyVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolFeeAprRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4419,62 +5044,37 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolFeeAprRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolFeeAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ExternalPrice.Size()
-		i -= size
-		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaxPriceImpact.Size()
-		i -= size
-		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if m.LookbackDays != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackDays))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.ToCoinDenom) > 0 {
-		i -= len(m.ToCoinDenom)
-		copy(dAtA[i:], m.ToCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToCoinDenom)))
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
 		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.FromCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolFeeAprResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4484,32 +5084,63 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolFeeAprResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolFeeAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
-		size, err := m.OutputCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.LiquidityValue.Size()
+		i -= size
+		if _, err := m.LiquidityValue.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
 	{
-		size, err := m.InputCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SwapFeesValue.Size()
+		i -= size
+		if _, err := m.SwapFeesValue.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Apr.Size()
 		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -4517,7 +5148,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4527,20 +5158,34 @@ func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom_1) > 0 {
+		i -= len(m.Denom_1)
+		copy(dAtA[i:], m.Denom_1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom_0) > 0 {
+		i -= len(m.Denom_0)
+		copy(dAtA[i:], m.Denom_0)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4550,19 +5195,277 @@ func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeShareAgreements) > 0 {
-		for iNdEx := len(m.TakerFeeShareAgreements) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExternalPrice.Size()
+		i -= size
+		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToCoinDenom) > 0 {
+		i -= len(m.ToCoinDenom)
+		copy(dAtA[i:], m.ToCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.FromCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OutputCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.InputCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FindBestRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindBestRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindBestRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSplits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSplits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindBestRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindBestRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindBestRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllTakerFeeShareAgreementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TakerFeeShareAgreements) > 0 {
+		for iNdEx := len(m.TakerFeeShareAgreements) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.TakerFeeShareAgreements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -5366,15 +6269,110 @@ func (m *TotalVolumeForPoolResponse) Size() (n int) {
 	return n
 }
 
-func (m *TradingPairTakerFeeRequest) Size() (n int) {
+func (m *PoolVolumeHistoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom_0)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolVolumeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DailyVolumes) > 0 {
+		for _, e := range m.DailyVolumes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolFeeAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LookbackDays != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackDays))
+	}
+	return n
+}
+
+func (m *PoolFeeAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SwapFeesValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidityValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TradingPairTakerFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom_0)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.Denom_1)
 	if l > 0 {
@@ -5429,6 +6427,46 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Size() (n int) {
 	return n
 }
 
+func (m *FindBestRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	if m.MaxSplits != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSplits))
+	}
+	return n
+}
+
+func (m *FindBestRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *AllTakerFeeShareAgreementsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8154,7 +9192,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8177,17 +9215,36 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8197,29 +9254,30 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8229,79 +9287,30 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8311,23 +9320,25 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8352,7 +9363,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8375,15 +9386,15 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8410,15 +9421,16 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DailyVolumes = append(m.DailyVolumes, PoolDailyVolume{})
+			if err := m.DailyVolumes[len(m.DailyVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8428,29 +9440,31 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Volume = append(m.Volume, types1.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8460,18 +9474,773 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types1.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolFeeAprRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolFeeAprRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolFeeAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackDays", wireType)
+			}
+			m.LookbackDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolFeeAprResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolFeeAprResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolFeeAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types1.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeesValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeesValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
@@ -8495,13 +10264,179 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindBestRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindBestRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindBestRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8529,10 +10464,78 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
+			}
+			m.MaxSplits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSplits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8554,7 +10557,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+func (m *FindBestRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8577,15 +10580,15 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FindBestRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FindBestRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8612,15 +10615,16 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8630,22 +10634,23 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
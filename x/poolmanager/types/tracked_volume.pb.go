@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -69,8 +73,84 @@ func (m *TrackedVolume) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// PoolDailyVolume stores the volume and swap fees of a pool over one UTC day,
+// per denom. It is also used in export/import genesis.
+type PoolDailyVolume struct {
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// day_start_time is the start (00:00 UTC) of the day.
+	DayStartTime time.Time `protobuf:"bytes,2,opt,name=day_start_time,json=dayStartTime,proto3,stdtime" json:"day_start_time" yaml:"day_start_time"`
+	// volume is the amount swapped into the pool, including taker fees.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	// swap_fees is the spread factor charged on the amount swapped into the
+	// pool, which accrues to the pool's LPs.
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+}
+
+func (m *PoolDailyVolume) Reset()         { *m = PoolDailyVolume{} }
+func (m *PoolDailyVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolDailyVolume) ProtoMessage()    {}
+func (*PoolDailyVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_342d78e34d76fc2e, []int{1}
+}
+func (m *PoolDailyVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDailyVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDailyVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDailyVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDailyVolume.Merge(m, src)
+}
+func (m *PoolDailyVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDailyVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDailyVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDailyVolume proto.InternalMessageInfo
+
+func (m *PoolDailyVolume) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolDailyVolume) GetDayStartTime() time.Time {
+	if m != nil {
+		return m.DayStartTime
+	}
+	return time.Time{}
+}
+
+func (m *PoolDailyVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PoolDailyVolume) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TrackedVolume)(nil), "poolmanager.v1beta1.TrackedVolume")
+	proto.RegisterType((*PoolDailyVolume)(nil), "poolmanager.v1beta1.PoolDailyVolume")
 }
 
 func init() {
@@ -78,23 +158,35 @@ func init() {
 }
 
 var fileDescriptor_342d78e34d76fc2e = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0xc8, 0xcf, 0xcf,
-	0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x29, 0x4a, 0x4c, 0xce, 0x4e, 0x4d, 0x89, 0x2f, 0xcb, 0xcf, 0x29, 0xcd, 0x4d, 0xd5, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x46, 0x52, 0xa9, 0x07, 0x55, 0x29, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x96, 0xd7, 0x07, 0xb1, 0x20, 0x4a, 0xa5, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b,
-	0xf5, 0x93, 0x12, 0x8b, 0x53, 0xe1, 0x86, 0x26, 0xe7, 0x67, 0xe6, 0x41, 0xe4, 0x95, 0x4a, 0xb8,
-	0x78, 0x43, 0x20, 0x56, 0x84, 0x81, 0x6d, 0x10, 0x4a, 0xe6, 0x62, 0x4b, 0xcc, 0xcd, 0x2f, 0xcd,
-	0x2b, 0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd4, 0x83, 0x98, 0xa0, 0x07, 0x32, 0x01,
-	0x66, 0x99, 0x9e, 0x73, 0x7e, 0x66, 0x9e, 0x93, 0xc1, 0x89, 0x7b, 0xf2, 0x0c, 0xab, 0xee, 0xcb,
-	0x6b, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xad, 0x83, 0x50,
-	0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x60, 0x0d, 0xc5, 0x41, 0x50, 0xa3,
-	0x9d, 0x02, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x1c, 0xc9, 0xac,
-	0xa4, 0xcc, 0x92, 0xa4, 0xc4, 0x94, 0xf4, 0xd4, 0x62, 0x04, 0x2b, 0x39, 0x23, 0x31, 0x33, 0x4f,
-	0xbf, 0x42, 0x1f, 0x39, 0xa8, 0xc0, 0x16, 0x24, 0xb1, 0x81, 0xfd, 0x63, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0xe4, 0xfd, 0x13, 0x6b, 0x46, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6e, 0xd4, 0x30,
+	0x14, 0x86, 0xc7, 0x4c, 0x35, 0x80, 0x4b, 0x0b, 0x0a, 0x20, 0x85, 0x59, 0x24, 0x43, 0x56, 0x91,
+	0x10, 0x36, 0x2d, 0x0b, 0x24, 0x76, 0x0c, 0x15, 0x12, 0x3b, 0x18, 0x2a, 0x16, 0x6c, 0x22, 0x27,
+	0x71, 0x53, 0xab, 0x71, 0x5e, 0x14, 0x3b, 0x85, 0x48, 0x1c, 0xa2, 0x3b, 0xee, 0xc0, 0x49, 0xba,
+	0xec, 0x92, 0xd5, 0x14, 0xcd, 0xdc, 0xa0, 0x27, 0x40, 0x8e, 0x3d, 0x65, 0xba, 0xaa, 0x66, 0x95,
+	0x97, 0xbc, 0xf7, 0xff, 0xdf, 0xf3, 0xef, 0xe0, 0xb8, 0x06, 0x28, 0x25, 0xab, 0x58, 0xc1, 0x1b,
+	0x7a, 0xba, 0x97, 0x72, 0xcd, 0xf6, 0xa8, 0x6e, 0x58, 0x76, 0xc2, 0xf3, 0xe4, 0x14, 0xca, 0x56,
+	0x72, 0x52, 0x37, 0xa0, 0xc1, 0x7b, 0xbc, 0x36, 0x49, 0xdc, 0xe4, 0xf8, 0x49, 0x01, 0x05, 0xf4,
+	0x7d, 0x6a, 0x2a, 0x3b, 0x3a, 0x0e, 0x0b, 0x80, 0xa2, 0xe4, 0xb4, 0x7f, 0x4b, 0xdb, 0x23, 0xaa,
+	0x85, 0xe4, 0x4a, 0x33, 0x59, 0xbb, 0x81, 0x20, 0x03, 0x25, 0x41, 0xd1, 0x94, 0x29, 0x7e, 0x4d,
+	0xcd, 0x40, 0x54, 0xb6, 0x1f, 0x69, 0xbc, 0x73, 0x68, 0x77, 0xf8, 0xda, 0xaf, 0xe0, 0x65, 0x78,
+	0xc4, 0x24, 0xb4, 0x95, 0xf6, 0xd1, 0x64, 0x18, 0x6f, 0xef, 0x3f, 0x23, 0xd6, 0x81, 0x18, 0x87,
+	0xd5, 0x36, 0xe4, 0x3d, 0x88, 0x6a, 0xfa, 0xea, 0x7c, 0x1e, 0x0e, 0x7e, 0x5f, 0x86, 0x71, 0x21,
+	0xf4, 0x71, 0x9b, 0x92, 0x0c, 0x24, 0x75, 0x38, 0xfb, 0x78, 0xa9, 0xf2, 0x13, 0xaa, 0xbb, 0x9a,
+	0xab, 0x5e, 0xa0, 0x66, 0xce, 0x3a, 0xfa, 0x35, 0xc4, 0x0f, 0x3f, 0x01, 0x94, 0x07, 0x4c, 0x94,
+	0x9d, 0x03, 0xbf, 0xc0, 0x77, 0xcd, 0xb9, 0x13, 0x91, 0xfb, 0x68, 0x82, 0xe2, 0xad, 0xa9, 0x77,
+	0x35, 0x0f, 0x77, 0x3b, 0x26, 0xcb, 0xb7, 0x91, 0x6b, 0x44, 0xb3, 0x91, 0xa9, 0x3e, 0xe6, 0x5e,
+	0x86, 0x77, 0x73, 0xd6, 0x25, 0x4a, 0xb3, 0x46, 0x27, 0xe6, 0xcc, 0xfe, 0x9d, 0x09, 0x8a, 0xb7,
+	0xf7, 0xc7, 0xc4, 0x06, 0x42, 0x56, 0x81, 0x90, 0xc3, 0x55, 0x20, 0xd3, 0xe7, 0x66, 0xdd, 0xab,
+	0x79, 0xf8, 0xd4, 0x7a, 0xde, 0xd4, 0x47, 0x67, 0x97, 0x21, 0x9a, 0x3d, 0xc8, 0x59, 0xf7, 0xc5,
+	0x7c, 0x33, 0x2a, 0x4f, 0xe3, 0x91, 0xbd, 0x17, 0x7f, 0x78, 0x5b, 0x14, 0xef, 0x9c, 0xf7, 0x8e,
+	0xf5, 0xb6, 0xb2, 0x68, 0xb3, 0x6c, 0xac, 0xc8, 0xfb, 0x89, 0xef, 0xab, 0xef, 0xac, 0x4e, 0x8e,
+	0x38, 0x57, 0xfe, 0xd6, 0x6d, 0xe0, 0x03, 0x07, 0x7e, 0x64, 0xc1, 0xd7, 0xca, 0xcd, 0xd8, 0xf7,
+	0x8c, 0xee, 0x03, 0xe7, 0x6a, 0xfa, 0xf9, 0x7c, 0x11, 0xa0, 0x8b, 0x45, 0x80, 0xfe, 0x2e, 0x02,
+	0x74, 0xb6, 0x0c, 0x06, 0x17, 0xcb, 0x60, 0xf0, 0x67, 0x19, 0x0c, 0xbe, 0xbd, 0x59, 0x73, 0x4b,
+	0x85, 0x4e, 0x59, 0x5e, 0x70, 0xf5, 0xbf, 0xca, 0x8e, 0x99, 0xa8, 0xe8, 0x0f, 0xba, 0xfe, 0x97,
+	0xf7, 0x88, 0x74, 0xd4, 0xdf, 0xc5, 0xeb, 0x7f, 0x03, 0x00, 0x01, 0x54, 0x4a, 0x13, 0x01, 0x03,
+	0x00, 0x00,
 }
 
 func (m *TrackedVolume) Marshal() (dAtA []byte, err error) {
@@ -134,6 +226,70 @@ func (m *TrackedVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolDailyVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDailyVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDailyVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackedVolume(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackedVolume(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DayStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DayStartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTrackedVolume(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintTrackedVolume(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTrackedVolume(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTrackedVolume(v)
 	base := offset
@@ -160,6 +316,32 @@ func (m *TrackedVolume) Size() (n int) {
 	return n
 }
 
+func (m *PoolDailyVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTrackedVolume(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DayStartTime)
+	n += 1 + l + sovTrackedVolume(uint64(l))
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovTrackedVolume(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovTrackedVolume(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTrackedVolume(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -250,6 +432,176 @@ func (m *TrackedVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolDailyVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackedVolume
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDailyVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDailyVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DayStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackedVolume(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTrackedVolume(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
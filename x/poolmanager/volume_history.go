@@ -0,0 +1,242 @@
+package poolmanager
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/third_party/osmoutils"
+	"github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+	queryproto "github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+const (
+	// DefaultFeeAprLookbackDays is the number of completed days the fee APR is averaged over if unspecified.
+	DefaultFeeAprLookbackDays = 7
+	// MaxFeeAprLookbackDays is the maximum number of days the fee APR can be averaged over.
+	MaxFeeAprLookbackDays = 365
+	// VolumeHistoryRetentionDays is the number of completed days of volume history kept per pool.
+	// It covers the longest fee APR lookback, older days are pruned.
+	VolumeHistoryRetentionDays = MaxFeeAprLookbackDays
+
+	day         = 24 * time.Hour
+	daysPerYear = 365
+)
+
+// dayStartTime returns the start (00:00 UTC) of the day containing t.
+func dayStartTime(t time.Time) time.Time {
+	return t.UTC().Truncate(day)
+}
+
+// trackDailyVolume adds the given volume and swap fee to the pool's volume for the current day.
+// Unlike trackVolume, volume is kept in the swapped denom, so it is tracked for every pool.
+//
+// CONTRACT: `volumeGenerated` and `swapFee` correspond to one of the denoms in the pool
+func (k Keeper) trackDailyVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin, swapFee sdk.Coin) {
+	// The first swap of a day prunes the days that fell out of the retention window,
+	// so each pool keeps at most VolumeHistoryRetentionDays + 1 days of history
+	today := dayStartTime(ctx.BlockTime())
+	if !ctx.KVStore(k.storeKey).Has(types.KeyPoolDailyVolume(poolId, today)) {
+		k.prunePoolDailyVolumes(ctx, poolId, today.Add(-VolumeHistoryRetentionDays*day))
+	}
+
+	dailyVolume := k.GetPoolDailyVolume(ctx, poolId, ctx.BlockTime())
+	if volumeGenerated.IsPositive() {
+		dailyVolume.Volume = dailyVolume.Volume.Add(volumeGenerated)
+	}
+	if swapFee.IsPositive() {
+		dailyVolume.SwapFees = dailyVolume.SwapFees.Add(swapFee)
+	}
+	k.SetPoolDailyVolume(ctx, dailyVolume)
+}
+
+// prunePoolDailyVolumes deletes the volumes of a pool for the days starting before the given time.
+func (k Keeper) prunePoolDailyVolumes(ctx sdk.Context, poolId uint64, before time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPoolDailyVolumes(poolId), types.KeyPoolDailyVolume(poolId, dayStartTime(before)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// swapFeeForTokenIn returns the swap fee charged by a pool with the given spread factor on the amount swapped into it.
+// Rounded down, so that fees are not overcounted.
+func swapFeeForTokenIn(tokenIn sdk.Coin, spreadFactor osmomath.Dec) sdk.Coin {
+	return sdk.NewCoin(tokenIn.Denom, osmomath.NewDecFromInt(tokenIn.Amount).Mul(spreadFactor).TruncateInt())
+}
+
+// SetPoolDailyVolume sets the volume of a pool for the day of its day start time.
+// Note that this function is exported for genesis and testing purposes and should not be
+// called directly from other modules.
+func (k Keeper) SetPoolDailyVolume(ctx sdk.Context, dailyVolume types.PoolDailyVolume) {
+	dailyVolume.DayStartTime = dayStartTime(dailyVolume.DayStartTime)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPoolDailyVolume(dailyVolume.PoolId, dailyVolume.DayStartTime), &dailyVolume)
+}
+
+// GetPoolDailyVolume gets the volume of a pool for the day containing the given time.
+// Days without swaps have no volume.
+func (k Keeper) GetPoolDailyVolume(ctx sdk.Context, poolId uint64, t time.Time) types.PoolDailyVolume {
+	dailyVolume := types.PoolDailyVolume{
+		PoolId:       poolId,
+		DayStartTime: dayStartTime(t),
+		Volume:       sdk.NewCoins(),
+		SwapFees:     sdk.NewCoins(),
+	}
+	_, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPoolDailyVolume(poolId, dailyVolume.DayStartTime), &dailyVolume)
+	if err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+	return dailyVolume
+}
+
+// GetPoolVolumeHistory gets a page of the volumes of a pool for the days with swaps from the day containing startTime
+// up to and including the day containing endTime, ordered by day.
+func (k Keeper) GetPoolVolumeHistory(ctx sdk.Context, poolId uint64, startTime, endTime time.Time, pagination *query.PageRequest) ([]types.PoolDailyVolume, *query.PageResponse, error) {
+	// Keys within a pool are the day start times, which sort chronologically
+	startKey := sdk.FormatTimeBytes(dayStartTime(startTime))
+	endKey := sdk.FormatTimeBytes(dayStartTime(endTime).Add(day))
+
+	dailyVolumes := []types.PoolDailyVolume{}
+	poolStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPoolDailyVolumes(poolId))
+	pageRes, err := query.FilteredPaginate(poolStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if bytes.Compare(key, startKey) < 0 || bytes.Compare(key, endKey) >= 0 {
+			return false, nil
+		}
+		if accumulate {
+			dailyVolume, err := parsePoolDailyVolume(value)
+			if err != nil {
+				return false, err
+			}
+			dailyVolumes = append(dailyVolumes, dailyVolume)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return dailyVolumes, pageRes, nil
+}
+
+// getPoolDailyVolumes gets the volumes of a pool for the days starting in [startTime, endTime).
+func (k Keeper) getPoolDailyVolumes(ctx sdk.Context, poolId uint64, startTime, endTime time.Time) ([]types.PoolDailyVolume, error) {
+	if !startTime.Before(endTime) {
+		return []types.PoolDailyVolume{}, nil
+	}
+	return osmoutils.GatherValuesFromStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPoolDailyVolume(poolId, startTime),
+		types.KeyPoolDailyVolume(poolId, endTime),
+		parsePoolDailyVolume,
+	)
+}
+
+// GetAllPoolDailyVolumes gets the daily volumes of all pools, ordered by pool and day.
+func (k Keeper) GetAllPoolDailyVolumes(ctx sdk.Context) ([]types.PoolDailyVolume, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPoolDailyVolumePrefix, parsePoolDailyVolume)
+}
+
+func parsePoolDailyVolume(bz []byte) (types.PoolDailyVolume, error) {
+	var dailyVolume types.PoolDailyVolume
+	if err := dailyVolume.Unmarshal(bz); err != nil {
+		return types.PoolDailyVolume{}, err
+	}
+	return dailyVolume, nil
+}
+
+// EstimatePoolFeeApr estimates the APR LPs of a pool earn from swap fees: the swap fees over the last lookback days
+// (excluding the current day), annualized and divided by the current pool liquidity.
+// Swap fees and liquidity are valued in the quote denom at the pool's current spot prices.
+//
+// Returns error if:
+//   - the pool does not exist
+//   - the lookback days exceed MaxFeeAprLookbackDays
+//   - the quote denom is not in the pool
+//   - a spot price cannot be calculated
+func (k Keeper) EstimatePoolFeeApr(ctx sdk.Context, req queryproto.PoolFeeAprRequest) (*queryproto.PoolFeeAprResponse, error) {
+	lookbackDays := req.LookbackDays
+	if lookbackDays == 0 {
+		lookbackDays = DefaultFeeAprLookbackDays
+	}
+	if lookbackDays > MaxFeeAprLookbackDays {
+		return nil, fmt.Errorf("%w: lookback days (%d) must be at most (%d)", types.ErrInvalidPoolFeeAprParams, lookbackDays, MaxFeeAprLookbackDays)
+	}
+
+	poolDenoms, err := k.RouteGetPoolDenoms(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	quoteDenom := req.QuoteDenom
+	if quoteDenom == "" && len(poolDenoms) > 0 {
+		quoteDenom = poolDenoms[0]
+	}
+	if !osmoutils.Contains(poolDenoms, quoteDenom) {
+		return nil, fmt.Errorf("%w: quote denom (%s) is not in pool (%d)", types.ErrInvalidPoolFeeAprParams, quoteDenom, req.PoolId)
+	}
+
+	today := dayStartTime(ctx.BlockTime())
+	dailyVolumes, err := k.getPoolDailyVolumes(ctx, req.PoolId, today.Add(-time.Duration(lookbackDays)*day), today)
+	if err != nil {
+		return nil, err
+	}
+	swapFees := sdk.NewCoins()
+	for _, dailyVolume := range dailyVolumes {
+		swapFees = swapFees.Add(dailyVolume.SwapFees...)
+	}
+
+	liquidity, err := k.GetTotalPoolLiquidity(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	swapFeesValue, err := k.valueInQuoteDenom(ctx, req.PoolId, quoteDenom, swapFees)
+	if err != nil {
+		return nil, err
+	}
+	liquidityValue, err := k.valueInQuoteDenom(ctx, req.PoolId, quoteDenom, liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	apr := osmomath.ZeroDec()
+	if liquidityValue.IsPositive() {
+		apr = swapFeesValue.MulInt64(daysPerYear).QuoInt64(int64(lookbackDays)).Quo(liquidityValue)
+	}
+
+	return &queryproto.PoolFeeAprResponse{
+		Apr:            apr,
+		SwapFees:       swapFees,
+		SwapFeesValue:  swapFeesValue,
+		LiquidityValue: liquidityValue,
+		QuoteDenom:     quoteDenom,
+	}, nil
+}
+
+// valueInQuoteDenom values coins of a pool's denoms in the quote denom at the pool's current spot prices.
+func (k Keeper) valueInQuoteDenom(ctx sdk.Context, poolId uint64, quoteDenom string, coins sdk.Coins) (osmomath.Dec, error) {
+	value := osmomath.ZeroBigDec()
+	for _, coin := range coins {
+		if coin.Denom == quoteDenom {
+			value.AddMut(osmomath.BigDecFromSDKInt(coin.Amount))
+			continue
+		}
+
+		quotePerBase, err := k.RouteCalculateSpotPrice(ctx, poolId, quoteDenom, coin.Denom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		value.AddMut(osmomath.BigDecFromSDKInt(coin.Amount).Mul(quotePerBase))
+	}
+	return value.Dec(), nil
+}
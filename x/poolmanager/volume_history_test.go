@@ -0,0 +1,170 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitbadges/bitbadgeschain/third_party/osmomath"
+	"github.com/bitbadges/bitbadgeschain/x/gamm/poolmodels/balancer"
+	"github.com/bitbadges/bitbadgeschain/x/poolmanager"
+	"github.com/bitbadges/bitbadgeschain/x/poolmanager/types"
+)
+
+var volumeHistoryPoolCoins = sdk.NewCoins(sdk.NewInt64Coin(FOO, 1_000_000_000), sdk.NewInt64Coin(BAR, 1_000_000_000))
+
+// TestTrackDailyVolume tests that swaps add their volume and swap fees to the pool's volume for the day they happen on.
+func (s *KeeperTestSuite) TestTrackDailyVolume() {
+	k := s.App.PoolManagerKeeper
+	spreadFactor := osmomath.MustNewDecFromStr("0.01")
+	s.createBalancerPoolsFromCoinsWithSpreadFactor([]sdk.Coins{volumeHistoryPoolCoins}, []osmomath.Dec{spreadFactor})
+
+	dayOne := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dayTwo := dayOne.Add(24 * time.Hour)
+
+	swap := func(blockTime time.Time, tokenIn sdk.Coin, tokenOutDenom string) sdk.Coin {
+		s.Ctx = s.Ctx.WithBlockTime(blockTime)
+		s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+		_, takerFeeCharged, err := k.SwapExactAmountIn(s.Ctx, s.TestAccs[0], 1, tokenIn, tokenOutDenom, osmomath.OneInt(), nil)
+		s.Require().NoError(err)
+
+		// The spread factor is charged on the amount entering the pool
+		return sdk.NewCoin(tokenIn.Denom, osmomath.NewDecFromInt(tokenIn.Amount.Sub(takerFeeCharged.Amount)).Mul(spreadFactor).TruncateInt())
+	}
+
+	fee1 := swap(dayOne.Add(time.Hour), sdk.NewInt64Coin(FOO, 1_000_000), BAR)
+	fee2 := swap(dayOne.Add(23*time.Hour), sdk.NewInt64Coin(BAR, 2_000_000), FOO)
+	fee3 := swap(dayTwo.Add(time.Hour), sdk.NewInt64Coin(FOO, 3_000_000), BAR)
+	s.Require().True(fee1.IsPositive())
+
+	expectedDayOne := types.PoolDailyVolume{
+		PoolId:       1,
+		DayStartTime: dayOne,
+		Volume:       sdk.NewCoins(sdk.NewInt64Coin(FOO, 1_000_000), sdk.NewInt64Coin(BAR, 2_000_000)),
+		SwapFees:     sdk.NewCoins(fee1, fee2),
+	}
+	expectedDayTwo := types.PoolDailyVolume{
+		PoolId:       1,
+		DayStartTime: dayTwo,
+		Volume:       sdk.NewCoins(sdk.NewInt64Coin(FOO, 3_000_000)),
+		SwapFees:     sdk.NewCoins(fee3),
+	}
+	s.Require().Equal(expectedDayOne, k.GetPoolDailyVolume(s.Ctx, 1, dayOne.Add(12*time.Hour)))
+	s.Require().Equal(expectedDayTwo, k.GetPoolDailyVolume(s.Ctx, 1, dayTwo))
+
+	history, _, err := k.GetPoolVolumeHistory(s.Ctx, 1, dayOne.Add(12*time.Hour), dayTwo.Add(12*time.Hour), nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolDailyVolume{expectedDayOne, expectedDayTwo}, history)
+
+	history, _, err = k.GetPoolVolumeHistory(s.Ctx, 1, dayTwo, dayTwo.Add(48*time.Hour), nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolDailyVolume{expectedDayTwo}, history)
+
+	// History is paginated
+	history, pageRes, err := k.GetPoolVolumeHistory(s.Ctx, 1, time.Unix(0, 0), dayTwo, &query.PageRequest{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolDailyVolume{expectedDayOne}, history)
+	s.Require().NotEmpty(pageRes.NextKey)
+
+	history, pageRes, err = k.GetPoolVolumeHistory(s.Ctx, 1, time.Unix(0, 0), dayTwo, &query.PageRequest{Key: pageRes.NextKey, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolDailyVolume{expectedDayTwo}, history)
+	s.Require().Empty(pageRes.NextKey)
+
+	// Other pools have no history
+	history, _, err = k.GetPoolVolumeHistory(s.Ctx, 2, dayOne, dayTwo, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(history)
+
+	// The first swap of a day prunes the days that fell out of the retention window
+	swap(dayOne.Add(poolmanager.VolumeHistoryRetentionDays*24*time.Hour+25*time.Hour), sdk.NewInt64Coin(FOO, 1_000_000), BAR)
+	dailyVolumes, err := k.GetAllPoolDailyVolumes(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(dailyVolumes, 2)
+	s.Require().Equal(expectedDayTwo, dailyVolumes[0])
+}
+
+// TestEstimatePoolFeeApr tests that the fee APR annualizes the swap fees of the completed lookback days over the pool liquidity.
+func (s *KeeperTestSuite) TestEstimatePoolFeeApr() {
+	today := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		req  types.PoolFeeAprRequest
+
+		expectedApr      osmomath.Dec
+		expectedSwapFees sdk.Coins
+		expectedError    error
+	}{
+		{
+			name: "default lookback and quote denom",
+			req:  types.PoolFeeAprRequest{PoolId: 1},
+			// (3_500 + 3_500 bar, priced 1:1) * 365 / 7 / 2_000_000_000
+			expectedApr:      osmomath.MustNewDecFromStr("0.0001825"),
+			expectedSwapFees: sdk.NewCoins(sdk.NewInt64Coin(BAR, 3_500), sdk.NewInt64Coin(FOO, 3_500)),
+		},
+		{
+			name: "single day lookback",
+			req:  types.PoolFeeAprRequest{PoolId: 1, QuoteDenom: FOO, LookbackDays: 1},
+			// 3_500 foo * 365 / 1 / 2_000_000_000
+			expectedApr:      osmomath.MustNewDecFromStr("0.000638750000000000"),
+			expectedSwapFees: sdk.NewCoins(sdk.NewInt64Coin(FOO, 3_500)),
+		},
+		{
+			name:          "error: quote denom not in pool",
+			req:           types.PoolFeeAprRequest{PoolId: 1, QuoteDenom: BAZ},
+			expectedError: types.ErrInvalidPoolFeeAprParams,
+		},
+		{
+			name:          "error: lookback too long",
+			req:           types.PoolFeeAprRequest{PoolId: 1, LookbackDays: poolmanager.MaxFeeAprLookbackDays + 1},
+			expectedError: types.ErrInvalidPoolFeeAprParams,
+		},
+		{
+			name:          "error: pool does not exist",
+			req:           types.PoolFeeAprRequest{PoolId: 2},
+			expectedError: types.FailedToFindRouteError{PoolId: 2},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			k := s.App.PoolManagerKeeper
+			s.FundAcc(s.TestAccs[0], volumeHistoryPoolCoins)
+			s.PrepareCustomBalancerPoolFromCoins(volumeHistoryPoolCoins, balancer.PoolParams{
+				SwapFee: osmomath.MustNewDecFromStr("0.01"),
+				ExitFee: osmomath.ZeroDec(),
+			})
+
+			dailySwapFees := map[time.Time]sdk.Coin{
+				// Outside of the default lookback
+				today.Add(-8 * 24 * time.Hour): sdk.NewInt64Coin(FOO, 1_000_000),
+				today.Add(-7 * 24 * time.Hour): sdk.NewInt64Coin(BAR, 3_500),
+				today.Add(-24 * time.Hour):     sdk.NewInt64Coin(FOO, 3_500),
+				// The current day is not complete
+				today: sdk.NewInt64Coin(FOO, 1_000_000),
+			}
+			for dayStartTime, swapFee := range dailySwapFees {
+				k.SetPoolDailyVolume(s.Ctx, types.PoolDailyVolume{
+					PoolId:       1,
+					DayStartTime: dayStartTime,
+					Volume:       sdk.NewCoins(swapFee.AddAmount(swapFee.Amount.MulRaw(99))),
+					SwapFees:     sdk.NewCoins(swapFee),
+				})
+			}
+			s.Ctx = s.Ctx.WithBlockTime(today.Add(12 * time.Hour))
+
+			res, err := k.EstimatePoolFeeApr(s.Ctx, tc.req)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedApr, res.Apr)
+			s.Require().Equal(tc.expectedSwapFees, res.SwapFees)
+			s.Require().Equal(osmomath.NewDec(2_000_000_000), res.LiquidityValue)
+		})
+	}
+}
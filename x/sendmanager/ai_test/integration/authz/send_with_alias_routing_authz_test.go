@@ -0,0 +1,144 @@
+package authz
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/suite"
+
+	sendmanagerkeeper "github.com/bitbadges/bitbadgeschain/x/sendmanager/keeper"
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/precompile/test/helpers"
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
+)
+
+// SendWithAliasRoutingAuthzTestSuite executes MsgSendWithAliasRouting through authz MsgExec on a full app
+type SendWithAliasRoutingAuthzTestSuite struct {
+	suite.Suite
+	TestSuite  *helpers.TestSuite
+	MockRouter *helpers.MockAliasDenomRouter
+}
+
+func TestSendWithAliasRoutingAuthzTestSuite(t *testing.T) {
+	suite.Run(t, new(SendWithAliasRoutingAuthzTestSuite))
+}
+
+func (suite *SendWithAliasRoutingAuthzTestSuite) SetupTest() {
+	suite.TestSuite = helpers.NewTestSuite(suite.T())
+	suite.MockRouter = helpers.NewMockRouter(sendmanagerkeeper.AliasDenomPrefix)
+	err := suite.TestSuite.App.SendmanagerKeeper.RegisterRouter(sendmanagerkeeper.AliasDenomPrefix, suite.MockRouter)
+	suite.Require().NoError(err)
+}
+
+func (suite *SendWithAliasRoutingAuthzTestSuite) grant(auth authz.Authorization) {
+	ts := suite.TestSuite
+	expiration := ts.Ctx.BlockTime().Add(time.Hour)
+	err := ts.App.AuthzKeeper.SaveGrant(ts.Ctx, ts.Bob, ts.Alice, auth, &expiration)
+	suite.Require().NoError(err)
+}
+
+func (suite *SendWithAliasRoutingAuthzTestSuite) exec(to sdk.AccAddress, amount sdk.Coins) error {
+	ts := suite.TestSuite
+	msgExec := authz.NewMsgExec(ts.Bob, []sdk.Msg{&types.MsgSendWithAliasRouting{
+		FromAddress: ts.Alice.String(),
+		ToAddress:   to.String(),
+		Amount:      amount,
+	}})
+	_, err := ts.App.AuthzKeeper.Exec(ts.Ctx, &msgExec)
+	return err
+}
+
+func (suite *SendWithAliasRoutingAuthzTestSuite) remainingLimit() (sdk.Coins, bool) {
+	ts := suite.TestSuite
+	auth, _ := ts.App.AuthzKeeper.GetAuthorization(ts.Ctx, ts.Bob, ts.Alice, sdk.MsgTypeURL(&types.MsgSendWithAliasRouting{}))
+	if auth == nil {
+		return nil, false
+	}
+	sendAuth, ok := auth.(*types.SendWithAliasRoutingAuthorization)
+	suite.Require().True(ok)
+	return sendAuth.SpendLimit, true
+}
+
+func (suite *SendWithAliasRoutingAuthzTestSuite) TestExec_DecrementsLimitAcrossAliasAndNativeDenoms() {
+	ts := suite.TestSuite
+	suite.grant(types.NewSendWithAliasRoutingAuthorization(sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(1000)),
+		sdk.NewCoin("stake", sdkmath.NewInt(500)),
+	), nil))
+
+	charlieBefore := ts.App.BankKeeper.GetBalance(ts.Ctx, ts.Charlie, "stake")
+
+	// Alias denom send is routed through the alias router
+	err := suite.exec(ts.Charlie, sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(400))))
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.MockRouter.GetSendCalls(), 1)
+	suite.Require().Equal(ts.Alice.String(), suite.MockRouter.GetSendCalls()[0].From)
+
+	limit, found := suite.remainingLimit()
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(600)),
+		sdk.NewCoin("stake", sdkmath.NewInt(500)),
+	), limit)
+
+	// Native denom send goes through x/bank and decrements the same grant
+	err = suite.exec(ts.Charlie, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(200))))
+	suite.Require().NoError(err)
+	charlieAfter := ts.App.BankKeeper.GetBalance(ts.Ctx, ts.Charlie, "stake")
+	suite.Require().Equal(charlieBefore.Amount.Add(sdkmath.NewInt(200)), charlieAfter.Amount)
+
+	limit, found = suite.remainingLimit()
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(600)),
+		sdk.NewCoin("stake", sdkmath.NewInt(300)),
+	), limit)
+
+	// Mixed send exceeding the remaining alias limit is rejected and leaves the grant untouched
+	err = suite.exec(ts.Charlie, sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(601)),
+		sdk.NewCoin("stake", sdkmath.NewInt(1)),
+	))
+	suite.Require().Error(err)
+	limit, found = suite.remainingLimit()
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(600)),
+		sdk.NewCoin("stake", sdkmath.NewInt(300)),
+	), limit)
+
+	// Spending the exact remainder consumes and deletes the grant
+	err = suite.exec(ts.Charlie, sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(600)),
+		sdk.NewCoin("stake", sdkmath.NewInt(300)),
+	))
+	suite.Require().NoError(err)
+	_, found = suite.remainingLimit()
+	suite.Require().False(found)
+
+	err = suite.exec(ts.Charlie, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))))
+	suite.Require().Error(err)
+}
+
+func (suite *SendWithAliasRoutingAuthzTestSuite) TestExec_AllowList() {
+	ts := suite.TestSuite
+	suite.grant(types.NewSendWithAliasRoutingAuthorization(
+		sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(1000))),
+		[]sdk.AccAddress{ts.Charlie},
+	))
+
+	err := suite.exec(ts.Bob, sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(10))))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "cannot send to")
+	suite.Require().Empty(suite.MockRouter.GetSendCalls())
+
+	err = suite.exec(ts.Charlie, sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(10))))
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.MockRouter.GetSendCalls(), 1)
+
+	limit, found := suite.remainingLimit()
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(990))), limit)
+}
@@ -1,8 +1,13 @@
 package testutil
 
 import (
+	"slices"
+	"strings"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
 )
 
 // GenerateMockRouter generates a mock alias denom router for testing
@@ -16,6 +21,8 @@ func GenerateMockRouter(prefix string) *MockAliasDenomRouter {
 type MockAliasDenomRouter struct {
 	prefix string
 	sendCalls []SendCall
+	aliasDenoms []types.AliasDenomMetadata
+	aliasBalances map[string]sdkmath.Int // address|denom -> amount
 }
 
 type SendCall struct {
@@ -56,9 +63,43 @@ func (m *MockAliasDenomRouter) SendFromAccountToModuleViaAliasDenom(ctx sdk.Cont
 }
 
 func (m *MockAliasDenomRouter) GetBalanceWithAliasRouting(ctx sdk.Context, address sdk.AccAddress, denom string) (sdk.Coin, error) {
+	if amount, ok := m.aliasBalances[address.String()+"|"+denom]; ok {
+		return sdk.NewCoin(denom, amount), nil
+	}
 	return sdk.NewCoin(denom, sdkmath.ZeroInt()), nil
 }
 
+func (m *MockAliasDenomRouter) IterateAliasDenomsWithMetadata(ctx sdk.Context, start string, reverse bool, cb func(metadata types.AliasDenomMetadata) bool) {
+	ordered := slices.Clone(m.aliasDenoms)
+	slices.SortFunc(ordered, func(a, b types.AliasDenomMetadata) int {
+		if reverse {
+			return strings.Compare(b.Denom, a.Denom)
+		}
+		return strings.Compare(a.Denom, b.Denom)
+	})
+	for _, metadata := range ordered {
+		if start != "" && ((!reverse && metadata.Denom < start) || (reverse && metadata.Denom > start)) {
+			continue
+		}
+		if cb(metadata) {
+			return
+		}
+	}
+}
+
+// AddAliasDenom registers an alias denom (with display metadata) returned by IterateAliasDenomsWithMetadata
+func (m *MockAliasDenomRouter) AddAliasDenom(metadata types.AliasDenomMetadata) {
+	m.aliasDenoms = append(m.aliasDenoms, metadata)
+}
+
+// SetAliasBalance sets the balance returned by GetBalanceWithAliasRouting for an address and alias denom
+func (m *MockAliasDenomRouter) SetAliasBalance(address sdk.AccAddress, coin sdk.Coin) {
+	if m.aliasBalances == nil {
+		m.aliasBalances = make(map[string]sdkmath.Int)
+	}
+	m.aliasBalances[address.String()+"|"+coin.Denom] = coin.Amount
+}
+
 // GetSendCalls returns all send calls made to this router
 func (m *MockAliasDenomRouter) GetSendCalls() []SendCall {
 	return m.sendCalls
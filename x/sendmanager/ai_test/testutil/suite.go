@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"cosmossdk.io/core/address"
@@ -12,7 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/bitbadges/bitbadgeschain/app/params"
@@ -182,6 +185,36 @@ func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress
 	return sdk.Coins{}
 }
 
+// AllBalances pages through the balances of an address in denom order, resuming from (and including) the page key
+func (m *MockBankKeeper) AllBalances(ctx context.Context, req *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := []sdk.Coin(m.GetAllBalances(ctx, addr))
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Reverse {
+		slices.Reverse(coins)
+	}
+
+	key := string(pageReq.Key)
+	page := []sdk.Coin{}
+	for i, coin := range coins {
+		if key != "" && ((!pageReq.Reverse && coin.Denom < key) || (pageReq.Reverse && coin.Denom > key)) {
+			continue
+		}
+		if uint64(len(page)) == pageReq.Limit {
+			return &banktypes.QueryAllBalancesResponse{Balances: page, Pagination: &query.PageResponse{NextKey: []byte(coins[i].Denom)}}, nil
+		}
+		page = append(page, coin)
+	}
+	return &banktypes.QueryAllBalancesResponse{Balances: page, Pagination: &query.PageResponse{}}, nil
+}
+
 // SetBalance sets a balance for testing
 func (m *MockBankKeeper) SetBalance(addr sdk.AccAddress, coin sdk.Coin) {
 	if m.balances == nil {
@@ -0,0 +1,200 @@
+package keeper_functions
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/ai_test/testutil"
+	sendmanagerkeeper "github.com/bitbadges/bitbadgeschain/x/sendmanager/keeper"
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
+)
+
+type AllBalancesTestSuite struct {
+	testutil.AITestSuite
+	router *testutil.MockAliasDenomRouter
+}
+
+func TestAllBalancesTestSuite(t *testing.T) {
+	suite.Run(t, new(AllBalancesTestSuite))
+}
+
+func (suite *AllBalancesTestSuite) SetupTest() {
+	suite.AITestSuite.SetupTest()
+
+	suite.router = testutil.GenerateMockRouter(sendmanagerkeeper.AliasDenomPrefix)
+	suite.router.AddAliasDenom(types.AliasDenomMetadata{
+		Denom:  "badgeslp:1:utoken",
+		Symbol: "TOKEN",
+		DenomUnits: []types.AliasDenomUnit{
+			{Decimals: 6, Symbol: "TOKEN", IsDefaultDisplay: true},
+		},
+	})
+	suite.router.AddAliasDenom(types.AliasDenomMetadata{
+		Denom:  "badgeslp:2:unft",
+		Symbol: "NFT",
+	})
+	err := suite.Keeper.RegisterRouter(sendmanagerkeeper.AliasDenomPrefix, suite.router)
+	suite.Require().NoError(err)
+}
+
+func (suite *AllBalancesTestSuite) TestGetAliasBalancesPageWithAliasRouting_SkipsZeroBalances() {
+	aliceAddr, err := sdk.AccAddressFromBech32(suite.Alice)
+	suite.Require().NoError(err)
+
+	suite.router.SetAliasBalance(aliceAddr, sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(300)))
+
+	balances, metadata, resume, err := suite.Keeper.GetAliasBalancesPageWithAliasRouting(suite.Ctx, aliceAddr, "", false, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Coin{sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(300))}, balances)
+	suite.Require().Empty(resume)
+
+	// Zero alias balances (badgeslp:2:unft) are omitted along with their metadata
+	suite.Require().Len(metadata, 1)
+	suite.Require().Equal("badgeslp:1:utoken", metadata[0].Denom)
+	suite.Require().Equal("TOKEN", metadata[0].Symbol)
+	suite.Require().Len(metadata[0].DenomUnits, 1)
+	suite.Require().Equal(uint64(6), metadata[0].DenomUnits[0].Decimals)
+}
+
+func (suite *AllBalancesTestSuite) TestGetAliasBalancesPageWithAliasRouting_NoRouter() {
+	suite.AITestSuite.SetupTest()
+
+	aliceAddr, err := sdk.AccAddressFromBech32(suite.Alice)
+	suite.Require().NoError(err)
+
+	balances, metadata, resume, err := suite.Keeper.GetAliasBalancesPageWithAliasRouting(suite.Ctx, aliceAddr, "", false, 10)
+	suite.Require().NoError(err)
+	suite.Require().Empty(balances)
+	suite.Require().Empty(metadata)
+	suite.Require().Empty(resume)
+}
+
+func (suite *AllBalancesTestSuite) TestAllBalancesQuery_BoundsAliasDenomsScanned() {
+	aliceAddr, err := sdk.AccAddressFromBech32(suite.Alice)
+	suite.Require().NoError(err)
+
+	// Many alias denoms the holder has no balance in, followed by one they do
+	for i := 0; i < 2*sendmanagerkeeper.MaxAliasDenomsScannedPerPage; i++ {
+		suite.router.AddAliasDenom(types.AliasDenomMetadata{Denom: fmt.Sprintf("badgeslp:3:u%04d", i)})
+	}
+	suite.router.AddAliasDenom(types.AliasDenomMetadata{Denom: "badgeslp:4:uheld"})
+	suite.router.SetAliasBalance(aliceAddr, sdk.NewCoin("badgeslp:4:uheld", sdkmath.NewInt(7)))
+	suite.MockBank.SetBalance(aliceAddr, sdk.NewCoin("uatom", sdkmath.NewInt(100)))
+
+	queryServer := sendmanagerkeeper.NewQueryServerImpl(suite.Keeper)
+
+	// Each page stops after the scan limit and resumes from the first alias denom not scanned
+	var balances sdk.Coins
+	var key []byte
+	pages := 0
+	for {
+		res, err := queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{
+			Address:    suite.Alice,
+			Pagination: &query.PageRequest{Key: key, Limit: 10},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Balances), 10)
+		balances = balances.Add(res.Balances...)
+		pages++
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		key = res.Pagination.NextKey
+	}
+
+	suite.Require().Greater(pages, 2)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("badgeslp:4:uheld", sdkmath.NewInt(7)),
+		sdk.NewCoin("uatom", sdkmath.NewInt(100)),
+	), balances)
+}
+
+func (suite *AllBalancesTestSuite) TestAllBalancesQuery_Pagination() {
+	aliceAddr, err := sdk.AccAddressFromBech32(suite.Alice)
+	suite.Require().NoError(err)
+
+	suite.MockBank.SetBalance(aliceAddr, sdk.NewCoin("uatom", sdkmath.NewInt(100)))
+	suite.MockBank.SetBalance(aliceAddr, sdk.NewCoin("ubadge", sdkmath.NewInt(200)))
+	suite.router.SetAliasBalance(aliceAddr, sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(300)))
+	suite.router.SetAliasBalance(aliceAddr, sdk.NewCoin("badgeslp:2:unft", sdkmath.NewInt(1)))
+
+	queryServer := sendmanagerkeeper.NewQueryServerImpl(suite.Keeper)
+
+	// First page
+	res, err := queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{
+		Address:    suite.Alice,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(300)),
+		sdk.NewCoin("badgeslp:2:unft", sdkmath.NewInt(1)),
+	), res.Balances)
+	suite.Require().Len(res.DenomMetadata, 2)
+	suite.Require().Equal([]byte("uatom"), res.Pagination.NextKey)
+
+	// Second page resumes from next_key and only carries metadata for alias denoms on the page
+	res, err = queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{
+		Address:    suite.Alice,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("uatom", sdkmath.NewInt(100)),
+		sdk.NewCoin("ubadge", sdkmath.NewInt(200)),
+	), res.Balances)
+	suite.Require().Empty(res.DenomMetadata)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// Offset pagination
+	res, err = queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{
+		Address:    suite.Alice,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewCoin("badgeslp:2:unft", sdkmath.NewInt(1)),
+		sdk.NewCoin("uatom", sdkmath.NewInt(100)),
+	), res.Balances)
+	suite.Require().Len(res.DenomMetadata, 1)
+	suite.Require().Equal("badgeslp:2:unft", res.DenomMetadata[0].Denom)
+	suite.Require().Equal([]byte("ubadge"), res.Pagination.NextKey)
+
+	// Reverse pagination walks both sources from the end
+	res, err = queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{
+		Address:    suite.Alice,
+		Pagination: &query.PageRequest{Limit: 3, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{
+		sdk.NewCoin("ubadge", sdkmath.NewInt(200)),
+		sdk.NewCoin("uatom", sdkmath.NewInt(100)),
+		sdk.NewCoin("badgeslp:2:unft", sdkmath.NewInt(1)),
+	}, res.Balances)
+	suite.Require().Equal([]byte("badgeslp:1:utoken"), res.Pagination.NextKey)
+
+	// Key and offset together are rejected
+	_, err = queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{
+		Address:    suite.Alice,
+		Pagination: &query.PageRequest{Key: []byte("uatom"), Offset: 1},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *AllBalancesTestSuite) TestAllBalancesQuery_InvalidAddress() {
+	queryServer := sendmanagerkeeper.NewQueryServerImpl(suite.Keeper)
+
+	_, err := queryServer.AllBalances(suite.Ctx, nil)
+	suite.Require().Error(err)
+
+	_, err = queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{Address: ""})
+	suite.Require().Error(err)
+
+	_, err = queryServer.AllBalances(suite.Ctx, &types.QueryAllBalancesRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,116 @@
+package msg_handlers
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/ai_test/testutil"
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
+)
+
+type MultiSendWithAliasRoutingTestSuite struct {
+	testutil.AITestSuite
+}
+
+func TestMultiSendWithAliasRoutingTestSuite(t *testing.T) {
+	suite.Run(t, new(MultiSendWithAliasRoutingTestSuite))
+}
+
+func (suite *MultiSendWithAliasRoutingTestSuite) fundAlice(coin sdk.Coin) {
+	alice, err := sdk.AccAddressFromBech32(suite.Alice)
+	suite.Require().NoError(err)
+	suite.MockBank.SetBalance(alice, coin)
+}
+
+func (suite *MultiSendWithAliasRoutingTestSuite) balanceOf(address, denom string) sdkmath.Int {
+	addr, err := sdk.AccAddressFromBech32(address)
+	suite.Require().NoError(err)
+	return suite.MockBank.GetBalance(suite.Ctx, addr, denom).Amount
+}
+
+func (suite *MultiSendWithAliasRoutingTestSuite) TestMultiSend_ValidMessage() {
+	suite.fundAlice(sdk.NewCoin("uatom", sdkmath.NewInt(1000)))
+
+	msg := &types.MsgMultiSendWithAliasRouting{
+		Inputs: []banktypes.Input{
+			{Address: suite.Alice, Coins: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(700)))},
+		},
+		Outputs: []banktypes.Output{
+			{Address: suite.Bob, Coins: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(300)))},
+			{Address: suite.Charlie, Coins: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(400)))},
+		},
+	}
+
+	_, err := suite.MsgServer.MultiSendWithAliasRouting(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdkmath.NewInt(300), suite.balanceOf(suite.Alice, "uatom"))
+	suite.Require().Equal(sdkmath.NewInt(300), suite.balanceOf(suite.Bob, "uatom"))
+	suite.Require().Equal(sdkmath.NewInt(400), suite.balanceOf(suite.Charlie, "uatom"))
+}
+
+func (suite *MultiSendWithAliasRoutingTestSuite) TestMultiSend_InputOutputMismatch() {
+	suite.fundAlice(sdk.NewCoin("uatom", sdkmath.NewInt(1000)))
+
+	msg := &types.MsgMultiSendWithAliasRouting{
+		Inputs: []banktypes.Input{
+			{Address: suite.Alice, Coins: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100)))},
+		},
+		Outputs: []banktypes.Output{
+			{Address: suite.Bob, Coins: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(300)))},
+		},
+	}
+
+	_, err := suite.MsgServer.MultiSendWithAliasRouting(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, banktypes.ErrInputOutputMismatch)
+}
+
+func (suite *MultiSendWithAliasRoutingTestSuite) TestMultiSend_MultipleInputs() {
+	coins := sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100)))
+	msg := &types.MsgMultiSendWithAliasRouting{
+		Inputs: []banktypes.Input{
+			{Address: suite.Alice, Coins: coins},
+			{Address: suite.Bob, Coins: coins},
+		},
+		Outputs: []banktypes.Output{
+			{Address: suite.Charlie, Coins: coins.Add(coins...)},
+		},
+	}
+
+	_, err := suite.MsgServer.MultiSendWithAliasRouting(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, banktypes.ErrMultipleSenders)
+}
+
+func (suite *MultiSendWithAliasRoutingTestSuite) TestMultiSend_NoInputsOrOutputs() {
+	_, err := suite.MsgServer.MultiSendWithAliasRouting(suite.Ctx, &types.MsgMultiSendWithAliasRouting{})
+	suite.Require().ErrorIs(err, banktypes.ErrNoInputs)
+
+	_, err = suite.MsgServer.MultiSendWithAliasRouting(suite.Ctx, &types.MsgMultiSendWithAliasRouting{
+		Inputs: []banktypes.Input{
+			{Address: suite.Alice, Coins: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100)))},
+		},
+	})
+	suite.Require().ErrorIs(err, banktypes.ErrNoOutputs)
+}
+
+func (suite *MultiSendWithAliasRoutingTestSuite) TestMultiSend_InsufficientFunds() {
+	suite.fundAlice(sdk.NewCoin("uatom", sdkmath.NewInt(100)))
+
+	coins := sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(80)))
+	msg := &types.MsgMultiSendWithAliasRouting{
+		Inputs: []banktypes.Input{
+			{Address: suite.Alice, Coins: coins.Add(coins...)},
+		},
+		Outputs: []banktypes.Output{
+			{Address: suite.Bob, Coins: coins},
+			{Address: suite.Charlie, Coins: coins},
+		},
+	}
+
+	_, err := suite.MsgServer.MultiSendWithAliasRouting(suite.Ctx, msg)
+	suite.Require().Error(err)
+}
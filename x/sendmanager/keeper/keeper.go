@@ -21,6 +21,9 @@ import (
 // instead of x/bank. This is a compile-time constant — no dynamic registration needed.
 const AliasDenomPrefix = "badgeslp:"
 
+// MaxAliasDenomsScannedPerPage is the maximum number of alias denoms whose balance is computed for one page of balances
+const MaxAliasDenomsScannedPerPage = 200
+
 type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
@@ -248,6 +251,55 @@ func (k Keeper) GetBalanceWithAliasRouting(ctx sdk.Context, address sdk.AccAddre
 	return k.bankKeeper.GetBalance(ctx, address, denom), nil
 }
 
+// GetAliasBalancesPageWithAliasRouting returns up to limit non-zero alias denom balances of an address in denom order
+// (descending if reverse), starting from (and including) start, along with the display metadata of each returned denom.
+// Balances are only computed for the alias denoms visited, and at most MaxAliasDenomsScannedPerPage alias denoms are visited.
+// The returned resume denom is the first alias denom that was not visited, or empty if every alias denom after start was visited.
+func (k Keeper) GetAliasBalancesPageWithAliasRouting(ctx sdk.Context, address sdk.AccAddress, start string, reverse bool, limit uint64) ([]sdk.Coin, []types.AliasDenomMetadata, string, error) {
+	balances := []sdk.Coin{}
+	metadata := []types.AliasDenomMetadata{}
+
+	if k.aliasRouter == nil || *k.aliasRouter == nil {
+		return balances, metadata, "", nil
+	}
+	router := *k.aliasRouter
+
+	var (
+		scanned uint64
+		resume  string
+		iterErr error
+	)
+	router.IterateAliasDenomsWithMetadata(ctx, start, reverse, func(denomMetadata types.AliasDenomMetadata) bool {
+		if uint64(len(balances)) >= limit || scanned >= MaxAliasDenomsScannedPerPage {
+			resume = denomMetadata.Denom
+			return true
+		}
+		scanned++
+
+		if !strings.HasPrefix(denomMetadata.Denom, AliasDenomPrefix) {
+			return false
+		}
+
+		balance, err := router.GetBalanceWithAliasRouting(ctx, address, denomMetadata.Denom)
+		if err != nil {
+			iterErr = sdkerrors.Wrapf(err, "failed to get balance for alias denom %s", denomMetadata.Denom)
+			return true
+		}
+		if !balance.IsPositive() {
+			return false
+		}
+
+		balances = append(balances, balance)
+		metadata = append(metadata, denomMetadata)
+		return false
+	})
+	if iterErr != nil {
+		return nil, nil, "", iterErr
+	}
+
+	return balances, metadata, resume, nil
+}
+
 // IsICS20Compatible checks if a denom is ICS20 compatible (not an alias denom)
 func (k Keeper) IsICS20Compatible(ctx sdk.Context, denom string) bool {
 	if denom == "" {
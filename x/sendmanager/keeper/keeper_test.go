@@ -11,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/bitbadges/bitbadgeschain/x/sendmanager/keeper"
 	module "github.com/bitbadges/bitbadgeschain/x/sendmanager/module"
@@ -104,6 +106,10 @@ func (m *mockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress
 	return sdk.Coins{}
 }
 
+func (m *mockBankKeeper) AllBalances(ctx context.Context, req *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error) {
+	return &banktypes.QueryAllBalancesResponse{Pagination: &query.PageResponse{}}, nil
+}
+
 // mockDistributionKeeper is a minimal mock implementation of DistributionKeeper for testing
 type mockDistributionKeeper struct{}
 
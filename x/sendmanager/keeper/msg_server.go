@@ -6,6 +6,7 @@ import (
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
 )
@@ -54,3 +55,44 @@ func (k msgServer) SendWithAliasRouting(goCtx context.Context, msg *types.MsgSen
 
 	return &types.MsgSendWithAliasRoutingResponse{}, nil
 }
+
+// MultiSendWithAliasRouting handles MsgMultiSendWithAliasRouting by sending each output through sendmanager
+// to support both standard coins and alias denoms (e.g., badgeslp:). Validation mirrors bank MsgMultiSend.
+func (k msgServer) MultiSendWithAliasRouting(goCtx context.Context, msg *types.MsgMultiSendWithAliasRouting) (*types.MsgMultiSendWithAliasRoutingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(msg.Inputs) == 0 {
+		return nil, banktypes.ErrNoInputs
+	}
+
+	if len(msg.Inputs) != 1 {
+		return nil, banktypes.ErrMultipleSenders
+	}
+
+	if len(msg.Outputs) == 0 {
+		return nil, banktypes.ErrNoOutputs
+	}
+
+	// Checks addresses, that all coins are valid and positive, and that the input total equals the output total
+	if err := banktypes.ValidateInputOutputs(msg.Inputs[0], msg.Outputs); err != nil {
+		return nil, err
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(msg.Inputs[0].Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "invalid input address: %s", err)
+	}
+
+	for _, output := range msg.Outputs {
+		toAddress, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "invalid output address: %s", err)
+		}
+
+		if err := k.SendCoinsWithAliasRouting(ctx, fromAddress, toAddress, output.Coins); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgMultiSendWithAliasRoutingResponse{}, nil
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"math"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
 )
@@ -53,3 +56,150 @@ func (q queryServer) Balance(ctx context.Context, req *types.QueryBalanceRequest
 		Balance: balance,
 	}, nil
 }
+
+// AllBalances queries all balances of an address with alias routing.
+// Bank balances are merged with every non-zero alias denom balance (e.g., badgeslp:),
+// and display metadata is included for the alias denoms on the returned page.
+// Both sources are read from store iterators starting at the page key, so only balances near the page are computed.
+func (q queryServer) AllBalances(ctx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(errortypes.ErrInvalidRequest, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, sdkerrors.Wrap(errortypes.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, sdkerrors.Wrap(errortypes.ErrInvalidRequest, "paginate: invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// One more entry than the page is read from each source so the next key is known
+	start := string(pageReq.Key)
+	need := pageReq.Offset + limit + 1
+	if need <= limit {
+		need = math.MaxUint64
+	}
+
+	bankBalances, bankResume, err := q.getBankBalancesPage(sdkCtx, req.Address, start, pageReq.Reverse, need)
+	if err != nil {
+		return nil, err
+	}
+	aliasBalances, metadata, aliasResume, err := q.k.GetAliasBalancesPageWithAliasRouting(sdkCtx, address, start, pageReq.Reverse, need)
+	if err != nil {
+		return nil, err
+	}
+
+	page, nextKey := mergeBalancePages(bankBalances, bankResume, aliasBalances, aliasResume, pageReq.Offset, limit, pageReq.Reverse)
+
+	onPage := make(map[string]bool, len(page))
+	for _, coin := range page {
+		onPage[coin.Denom] = true
+	}
+	pageMetadata := []types.AliasDenomMetadata{}
+	for _, denomMetadata := range metadata {
+		if onPage[denomMetadata.Denom] {
+			pageMetadata = append(pageMetadata, denomMetadata)
+		}
+	}
+
+	pageRes := &query.PageResponse{}
+	if nextKey != "" {
+		pageRes.NextKey = []byte(nextKey)
+	}
+
+	return &types.QueryAllBalancesResponse{
+		Balances:      page,
+		DenomMetadata: pageMetadata,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// getBankBalancesPage returns up to limit bank balances of an address in denom order (descending if reverse),
+// starting from (and including) start. The returned resume denom is the first denom not returned, or empty if there are none left.
+func (q queryServer) getBankBalancesPage(ctx sdk.Context, address string, start string, reverse bool, limit uint64) ([]sdk.Coin, string, error) {
+	res, err := q.k.bankKeeper.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+		Address:    address,
+		Pagination: &query.PageRequest{Key: []byte(start), Limit: limit, Reverse: reverse},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	balances := make([]sdk.Coin, 0, len(res.Balances))
+	for _, coin := range res.Balances {
+		// In reverse, the bank store also returns denoms that extend the start denom, which sort after it
+		if start != "" && denomBefore(coin.Denom, start, reverse) {
+			continue
+		}
+		balances = append(balances, coin)
+	}
+	return balances, string(res.Pagination.GetNextKey()), nil
+}
+
+// mergeBalancePages merges two denom-ordered balance sources into one page.
+// Each source has returned every balance that comes before its resume denom (or all of its balances if resume is empty),
+// so only merged balances before both resume denoms are known to be complete. The page is taken from those after skipping offset,
+// and the next key is the first complete balance after the page, or otherwise the earliest resume denom.
+func mergeBalancePages(a []sdk.Coin, aResume string, b []sdk.Coin, bResume string, offset, limit uint64, reverse bool) (sdk.Coins, string) {
+	merged := make([]sdk.Coin, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b) || (i < len(a) && denomBefore(a[i].Denom, b[j].Denom, reverse)):
+			merged = append(merged, a[i])
+			i++
+		case i == len(a) || denomBefore(b[j].Denom, a[i].Denom, reverse):
+			merged = append(merged, b[j])
+			j++
+		default:
+			merged = append(merged, a[i].Add(b[j]))
+			i++
+			j++
+		}
+	}
+
+	resume := aResume
+	if bResume != "" && (resume == "" || denomBefore(bResume, resume, reverse)) {
+		resume = bResume
+	}
+	complete := merged
+	if resume != "" {
+		n := 0
+		for n < len(merged) && denomBefore(merged[n].Denom, resume, reverse) {
+			n++
+		}
+		complete = merged[:n]
+	}
+
+	pageStart := min(offset, uint64(len(complete)))
+	pageEnd := min(pageStart+limit, uint64(len(complete)))
+	page := sdk.Coins(complete[pageStart:pageEnd])
+
+	if pageEnd < uint64(len(complete)) {
+		return page, complete[pageEnd].Denom
+	}
+	return page, resume
+}
+
+// denomBefore returns true if denom a comes before denom b in the iteration order
+func denomBefore(a, b string, reverse bool) bool {
+	if reverse {
+		return a > b
+	}
+	return a < b
+}
@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
 	tokenizationkeeper "github.com/bitbadges/bitbadgeschain/x/tokenization/keeper"
 )
 
@@ -64,3 +65,38 @@ func (r *TokenizationAliasDenomRouter) GetBalanceWithAliasRouting(ctx sdk.Contex
 	}
 	return sdk.NewCoin(denom, amount), nil
 }
+
+// IterateAliasDenomsWithMetadata implements AliasDenomRouter interface
+// Iterates the tokenization alias denom index (badgeslp:<collectionId>:<denom>) and only loads the collections
+// of visited denoms, using the alias path symbol and denom units as display metadata
+func (r *TokenizationAliasDenomRouter) IterateAliasDenomsWithMetadata(ctx sdk.Context, start string, reverse bool, cb func(metadata types.AliasDenomMetadata) (stop bool)) {
+	r.tokenizationKeeper.IterateAliasDenoms(ctx, start, reverse, func(aliasDenom string, collectionId sdkmath.Uint) bool {
+		collection, found := r.tokenizationKeeper.GetCollectionFromStore(ctx, collectionId)
+		if !found {
+			return false
+		}
+		path, err := tokenizationkeeper.GetCorrespondingAliasPath(collection, aliasDenom)
+		if err != nil {
+			return false
+		}
+
+		denomUnits := make([]types.AliasDenomUnit, 0, len(path.DenomUnits))
+		for _, unit := range path.DenomUnits {
+			// Decimals is an arbitrary precision Uint, so units that do not fit in a uint64 are skipped
+			if unit == nil || unit.Decimals.IsNil() || !unit.Decimals.BigInt().IsUint64() {
+				continue
+			}
+			denomUnits = append(denomUnits, types.AliasDenomUnit{
+				Decimals:         unit.Decimals.Uint64(),
+				Symbol:           unit.Symbol,
+				IsDefaultDisplay: unit.IsDefaultDisplay,
+			})
+		}
+
+		return cb(types.AliasDenomMetadata{
+			Denom:      aliasDenom,
+			Symbol:     path.Symbol,
+			DenomUnits: denomUnits,
+		})
+	})
+}
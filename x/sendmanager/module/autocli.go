@@ -23,6 +23,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query balance of a specific denom for an address with alias routing",
 					Long:      "Query the balance of a specific denomination for an address. Supports both standard coins and alias denoms (e.g., badgeslp:).",
 				},
+				{
+					RpcMethod:      "AllBalances",
+					Use:            "all-balances [address]",
+					Short:          "Query all balances of an address with alias routing",
+					Long:           "Query all balances of an address. Bank balances are merged with every non-zero alias denom balance (e.g., badgeslp:), including alias denom display metadata.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -41,6 +48,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Send coins from one address to another. Supports both standard coins and alias denoms (e.g., badgeslp:). This mirrors cosmos bank MsgSend but routes through sendmanager.",
 					Example:   "bitbadgeschaind tx sendmanager send-with-alias-routing cosmos1abc... cosmos1def... 1000uatom",
 				},
+				{
+					RpcMethod: "MultiSendWithAliasRouting",
+					Skip:      true, // skipped because the signer is nested in inputs; submit as a JSON tx or via the precompile
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
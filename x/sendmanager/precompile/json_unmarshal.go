@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	sendmanagertypes "github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
 )
@@ -58,6 +59,8 @@ func (p Precompile) unmarshalMsgFromJSON(methodName string, jsonStr string, cont
 	switch methodName {
 	case SendMethod:
 		msg = &sendmanagertypes.MsgSendWithAliasRouting{}
+	case MultiSendMethod:
+		msg = &sendmanagertypes.MsgMultiSendWithAliasRouting{}
 	default:
 		return nil, ErrInvalidInput(fmt.Sprintf("unknown method: %s", methodName))
 	}
@@ -84,6 +87,14 @@ func (p Precompile) unmarshalMsgFromJSON(methodName string, jsonStr string, cont
 		if m.ToAddress != "" {
 			m.ToAddress = convertEVMAddressToBech32(m.ToAddress)
 		}
+	case *sendmanagertypes.MsgMultiSendWithAliasRouting:
+		// The caller is the only input and spends exactly the sum of the outputs
+		total := sdk.NewCoins()
+		for i := range m.Outputs {
+			m.Outputs[i].Address = convertEVMAddressToBech32(m.Outputs[i].Address)
+			total = total.Add(m.Outputs[i].Coins...)
+		}
+		m.Inputs = []banktypes.Input{{Address: senderCosmosAddr, Coins: total}}
 	}
 
 	// Validate message using ValidateBasic
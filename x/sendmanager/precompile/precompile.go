@@ -7,6 +7,7 @@
 //
 // Transaction Methods:
 //   - send: Send native Cosmos coins from the caller to a recipient (supports alias denoms)
+//   - multiSend: Send native Cosmos coins from the caller to multiple recipients (supports alias denoms)
 //
 // All methods use structured error handling with error codes for consistent error reporting.
 // Input validation is performed on all parameters to ensure security and correctness.
@@ -35,7 +36,8 @@ const (
 	// IMPORTANT: These values are DEDUCTED from the transaction gas before the precompile runs.
 	// The actual execution gas comes from the remaining gas (contract.Gas after deduction).
 	// Setting these too high causes "out of gas" errors. Keep these as minimal entry fees.
-	GasSendBase = 30_000
+	GasSendBase      = 30_000
+	GasMultiSendBase = 50_000
 
 	// Gas costs per element for dynamic calculations
 	GasPerCoin = 2_000
@@ -99,6 +101,9 @@ const SendManagerPrecompileAddress = "0x0000000000000000000000000000000000001003
 // SendMethod is the name of the send method in the ABI
 const SendMethod = "send"
 
+// MultiSendMethod is the name of the multiSend method in the ABI
+const MultiSendMethod = "multiSend"
+
 // GetCallerAddress gets the caller address and converts it to Cosmos format
 // This should be used for ALL transaction methods to set the from_address field
 // SECURITY: This ensures the sender is always the actual caller, preventing impersonation
@@ -145,6 +150,8 @@ func (p Precompile) getBaseGas(methodName string) uint64 {
 	switch methodName {
 	case SendMethod:
 		return GasSendBase
+	case MultiSendMethod:
+		return GasMultiSendBase
 	}
 	return 0
 }
@@ -209,7 +216,7 @@ func (p Precompile) ExecuteWithMethodName(ctx sdk.Context, contract *vm.Contract
 // IsTransaction returns true if the method is a transaction (state-changing operation)
 func (p Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case SendMethod:
+	case SendMethod, MultiSendMethod:
 		return true
 	}
 	return false
@@ -232,6 +239,9 @@ func (p Precompile) HandleTransaction(ctx sdk.Context, method *abi.Method, jsonS
 	case *sendmanagertypes.MsgSendWithAliasRouting:
 		_, err = msgServer.SendWithAliasRouting(ctx, m)
 		resp = true // ABI: bool success
+	case *sendmanagertypes.MsgMultiSendWithAliasRouting:
+		_, err = msgServer.MultiSendWithAliasRouting(ctx, m)
+		resp = true // ABI: bool success
 	default:
 		return nil, ErrInvalidInput(fmt.Sprintf("unsupported message type for method: %s", method.Name))
 	}
@@ -242,7 +252,7 @@ func (p Precompile) HandleTransaction(ctx sdk.Context, method *abi.Method, jsonS
 
 	// Pack response based on method output type
 	switch method.Name {
-	case SendMethod:
+	case SendMethod, MultiSendMethod:
 		// Pack bool success
 		return method.Outputs.Pack(resp)
 	default:
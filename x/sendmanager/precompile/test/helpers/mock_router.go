@@ -65,6 +65,9 @@ func (m *MockAliasDenomRouter) GetBalanceWithAliasRouting(ctx sdk.Context, addre
 	return sdk.NewCoin(denom, sdkmath.ZeroInt()), nil
 }
 
+func (m *MockAliasDenomRouter) IterateAliasDenomsWithMetadata(ctx sdk.Context, start string, reverse bool, cb func(metadata types.AliasDenomMetadata) bool) {
+}
+
 // GetSendCalls returns all send calls made to this router
 func (m *MockAliasDenomRouter) GetSendCalls() []SendCall {
 	return m.sendCalls
@@ -162,3 +162,89 @@ func (suite *HandlersTestSuite) TestSend_ZeroAmount() {
 	}
 }
 
+
+func (suite *HandlersTestSuite) TestMultiSend_Success() {
+	method := suite.Precompile.ABI.Methods["multiSend"]
+	require.NotNil(suite.T(), method)
+
+	bobBefore := suite.TestSuite.App.BankKeeper.GetBalance(suite.TestSuite.Ctx, suite.TestSuite.Bob, "stake")
+	charlieBefore := suite.TestSuite.App.BankKeeper.GetBalance(suite.TestSuite.Ctx, suite.TestSuite.Charlie, "uosmo")
+
+	// Outputs accept both bech32 and EVM addresses; inputs are derived from the caller
+	jsonMsg, err := helpers.BuildQueryJSON(map[string]interface{}{
+		"outputs": []map[string]interface{}{
+			{
+				"address": suite.TestSuite.Bob.String(),
+				"coins":   []map[string]interface{}{{"denom": "stake", "amount": "1000"}},
+			},
+			{
+				"address": suite.TestSuite.CharlieEVM.Hex(),
+				"coins":   []map[string]interface{}{{"denom": "uosmo", "amount": "2000"}},
+			},
+		},
+	})
+	suite.NoError(err)
+
+	input, err := helpers.PackMethodCall(&method, jsonMsg)
+	suite.NoError(err)
+
+	contract := suite.TestSuite.CreateMockContract(suite.TestSuite.AliceEVM, input)
+	result, err := suite.Precompile.Execute(suite.TestSuite.Ctx, contract, false)
+	suite.NoError(err)
+
+	unpacked, err := method.Outputs.Unpack(result)
+	suite.NoError(err)
+	suite.True(unpacked[0].(bool), "MultiSend should succeed")
+
+	bobAfter := suite.TestSuite.App.BankKeeper.GetBalance(suite.TestSuite.Ctx, suite.TestSuite.Bob, "stake")
+	charlieAfter := suite.TestSuite.App.BankKeeper.GetBalance(suite.TestSuite.Ctx, suite.TestSuite.Charlie, "uosmo")
+	suite.Equal(int64(1000), bobAfter.Amount.Sub(bobBefore.Amount).Int64())
+	suite.Equal(int64(2000), charlieAfter.Amount.Sub(charlieBefore.Amount).Int64())
+}
+
+func (suite *HandlersTestSuite) TestMultiSend_InputsCannotBeSpoofed() {
+	method := suite.Precompile.ABI.Methods["multiSend"]
+	require.NotNil(suite.T(), method)
+
+	bobBefore := suite.TestSuite.App.BankKeeper.GetBalance(suite.TestSuite.Ctx, suite.TestSuite.Bob, "stake")
+
+	// An input naming Bob is overridden with the caller (Alice)
+	jsonMsg, err := helpers.BuildQueryJSON(map[string]interface{}{
+		"inputs": []map[string]interface{}{
+			{
+				"address": suite.TestSuite.Bob.String(),
+				"coins":   []map[string]interface{}{{"denom": "stake", "amount": "1000"}},
+			},
+		},
+		"outputs": []map[string]interface{}{
+			{
+				"address": suite.TestSuite.Charlie.String(),
+				"coins":   []map[string]interface{}{{"denom": "stake", "amount": "1000"}},
+			},
+		},
+	})
+	suite.NoError(err)
+
+	input, err := helpers.PackMethodCall(&method, jsonMsg)
+	suite.NoError(err)
+
+	contract := suite.TestSuite.CreateMockContract(suite.TestSuite.AliceEVM, input)
+	_, err = suite.Precompile.Execute(suite.TestSuite.Ctx, contract, false)
+	suite.NoError(err)
+
+	bobAfter := suite.TestSuite.App.BankKeeper.GetBalance(suite.TestSuite.Ctx, suite.TestSuite.Bob, "stake")
+	suite.Equal(bobBefore, bobAfter, "Bob's balance should not change")
+}
+
+func (suite *HandlersTestSuite) TestMultiSend_NoOutputs() {
+	method := suite.Precompile.ABI.Methods["multiSend"]
+	require.NotNil(suite.T(), method)
+
+	input, err := helpers.PackMethodCall(&method, `{"outputs":[]}`)
+	suite.NoError(err)
+
+	contract := suite.TestSuite.CreateMockContract(suite.TestSuite.AliceEVM, input)
+	result, err := suite.Precompile.Execute(suite.TestSuite.Ctx, contract, false)
+	suite.Error(err)
+	suite.Nil(result)
+}
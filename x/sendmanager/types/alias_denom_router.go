@@ -36,4 +36,9 @@ type AliasDenomRouter interface {
 	// For alias denoms (e.g., badgeslp:), this may use custom logic (e.g., getMaxWrappableAmount flow)
 	// Returns the coin balance for the given address and denom
 	GetBalanceWithAliasRouting(ctx sdk.Context, address sdk.AccAddress, denom string) (sdk.Coin, error)
+
+	// IterateAliasDenomsWithMetadata iterates over the alias denoms handled by this router in denom order,
+	// starting from (and including) start, passing the display metadata of each. The callback returns true to stop
+	// Used to page through alias denom balances (e.g., for the AllBalances query) without enumerating every alias denom
+	IterateAliasDenomsWithMetadata(ctx sdk.Context, start string, reverse bool, cb func(metadata AliasDenomMetadata) (stop bool))
 }
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is the gas charged per allow list entry checked, matching bank's SendAuthorization
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &SendWithAliasRoutingAuthorization{}
+
+// NewSendWithAliasRoutingAuthorization creates a new SendWithAliasRoutingAuthorization object.
+func NewSendWithAliasRoutingAuthorization(spendLimit sdk.Coins, allowed []sdk.AccAddress) *SendWithAliasRoutingAuthorization {
+	allowList := make([]string, 0, len(allowed))
+	for _, addr := range allowed {
+		allowList = append(allowList, addr.String())
+	}
+	if len(allowList) == 0 {
+		allowList = nil
+	}
+
+	return &SendWithAliasRoutingAuthorization{
+		SpendLimit: spendLimit,
+		AllowList:  allowList,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendWithAliasRoutingAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendWithAliasRouting{})
+}
+
+// Accept implements Authorization.Accept.
+// The spend limit is decremented by the sent amount, regardless of whether each coin is an alias denom or a standard coin.
+func (a SendWithAliasRoutingAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSendWithAliasRouting)
+	if !ok {
+		return authz.AcceptResponse{}, errortypes.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, errortypes.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+
+	isAddrExists := false
+	toAddr := mSend.ToAddress
+	allowedList := a.GetAllowList()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, addr := range allowedList {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "send with alias routing authorization")
+		if addr == toAddr {
+			isAddrExists = true
+			break
+		}
+	}
+
+	if len(allowedList) > 0 && !isAddrExists {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot send to %s address", toAddr)
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendWithAliasRoutingAuthorization{SpendLimit: limitLeft, AllowList: allowedList}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendWithAliasRoutingAuthorization) ValidateBasic() error {
+	if len(a.SpendLimit) == 0 {
+		return errortypes.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsAllPositive() {
+		return errortypes.ErrInvalidCoins.Wrapf("spend limit must be positive")
+	}
+
+	found := make(map[string]bool, len(a.AllowList))
+	for _, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "invalid allow list address %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.Wrapf(ErrInvalidRequest, "duplicate allow list address %s", addr)
+		}
+		found[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sendmanager/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SendWithAliasRoutingAuthorization allows the grantee to spend up to spend_limit coins from
+// the granter's account via MsgSendWithAliasRouting. This mirrors cosmos bank SendAuthorization,
+// but the spend limit may include alias denoms (e.g., badgeslp:) as well as standard coins.
+type SendWithAliasRoutingAuthorization struct {
+	// spend_limit is the maximum amount of coins the grantee may send on behalf of the granter.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// allow_list specifies an optional list of addresses to whom the grantee can send tokens on behalf of the
+	// granter. If omitted, any recipient is allowed.
+	AllowList []string `protobuf:"bytes,2,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+}
+
+func (m *SendWithAliasRoutingAuthorization) Reset()         { *m = SendWithAliasRoutingAuthorization{} }
+func (m *SendWithAliasRoutingAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SendWithAliasRoutingAuthorization) ProtoMessage()    {}
+func (*SendWithAliasRoutingAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4997b3dbca711b5e, []int{0}
+}
+func (m *SendWithAliasRoutingAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendWithAliasRoutingAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendWithAliasRoutingAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendWithAliasRoutingAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendWithAliasRoutingAuthorization.Merge(m, src)
+}
+func (m *SendWithAliasRoutingAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendWithAliasRoutingAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendWithAliasRoutingAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendWithAliasRoutingAuthorization proto.InternalMessageInfo
+
+func (m *SendWithAliasRoutingAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *SendWithAliasRoutingAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SendWithAliasRoutingAuthorization)(nil), "sendmanager.SendWithAliasRoutingAuthorization")
+}
+
+func init() { proto.RegisterFile("sendmanager/v1/authz.proto", fileDescriptor_4997b3dbca711b5e) }
+
+var fileDescriptor_4997b3dbca711b5e = []byte{
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x5b, 0x48, 0x4c, 0x28, 0x93, 0x84, 0x01, 0x18, 0x0a, 0x32, 0x11, 0x12, 0x7a, 0x41,
+	0x63, 0x48, 0x1c, 0x4c, 0xc0, 0x95, 0x45, 0x18, 0x4c, 0x5c, 0xc8, 0xb5, 0xbd, 0xb4, 0x2f, 0xb6,
+	0x77, 0xd8, 0x7b, 0x45, 0xe5, 0x4f, 0x70, 0xf2, 0xcf, 0x30, 0x4e, 0x0c, 0x4c, 0xfe, 0x05, 0xc4,
+	0x89, 0x38, 0x39, 0xa9, 0x81, 0x81, 0x7f, 0xc3, 0xd0, 0x9e, 0x06, 0x17, 0x5d, 0xee, 0xee, 0xbd,
+	0xef, 0xbb, 0xf7, 0x79, 0x3f, 0x8c, 0x8a, 0x64, 0xdc, 0x0d, 0x29, 0xa7, 0x1e, 0x8b, 0xc8, 0xa4,
+	0x4d, 0x68, 0x8c, 0xfe, 0xd4, 0x1a, 0x47, 0x02, 0x45, 0x21, 0xbf, 0xa3, 0x55, 0xf6, 0x69, 0x08,
+	0x5c, 0x90, 0xe4, 0x4c, 0xf5, 0x4a, 0xd9, 0x11, 0x32, 0x14, 0x72, 0x94, 0x58, 0x24, 0x35, 0x94,
+	0x54, 0xf4, 0x84, 0x27, 0x52, 0xff, 0xf6, 0xa5, 0xbc, 0x66, 0x1a, 0x43, 0x6c, 0x2a, 0x19, 0x99,
+	0xb4, 0x6d, 0x86, 0xb4, 0x4d, 0x1c, 0x01, 0x3c, 0xd5, 0xeb, 0xcf, 0x19, 0xe3, 0x60, 0xc8, 0xb8,
+	0x7b, 0x01, 0xe8, 0x77, 0x03, 0xa0, 0x72, 0x20, 0x62, 0x04, 0xee, 0x75, 0x63, 0xf4, 0x45, 0x04,
+	0x53, 0x8a, 0x20, 0x78, 0xe1, 0xda, 0xc8, 0xcb, 0x31, 0xe3, 0xee, 0x28, 0x80, 0x10, 0xb0, 0xa4,
+	0xd7, 0xb2, 0x8d, 0xfc, 0x61, 0xd9, 0x52, 0xfc, 0x6d, 0x6e, 0x4b, 0xe5, 0xb6, 0xce, 0x04, 0xf0,
+	0xde, 0xf1, 0xe2, 0xbd, 0xaa, 0x3d, 0x7d, 0x54, 0x1b, 0x1e, 0xa0, 0x1f, 0xdb, 0x96, 0x23, 0x42,
+	0x55, 0xac, 0xba, 0x5a, 0xd2, 0xbd, 0x22, 0x78, 0x37, 0x66, 0x32, 0xf9, 0x20, 0x1f, 0x37, 0xb3,
+	0xa6, 0x3e, 0x30, 0x12, 0x48, 0x7f, 0xcb, 0x28, 0x74, 0x0c, 0x83, 0x06, 0x81, 0xb8, 0x19, 0x05,
+	0x20, 0xb1, 0x94, 0xa9, 0x65, 0x1b, 0xb9, 0x5e, 0xe9, 0x75, 0xde, 0x2a, 0x2a, 0x68, 0xd7, 0x75,
+	0x23, 0x26, 0xe5, 0x10, 0x23, 0xe0, 0xde, 0x20, 0x97, 0xc4, 0xf6, 0x41, 0xe2, 0x09, 0xbc, 0xcc,
+	0x5b, 0x75, 0x15, 0x94, 0x8e, 0xf6, 0xbb, 0xb4, 0x5f, 0x3d, 0xdd, 0x6f, 0x66, 0xcd, 0x53, 0x1b,
+	0xd0, 0xa6, 0xae, 0xc7, 0xa4, 0xe3, 0x53, 0xe0, 0xe4, 0x96, 0xec, 0xae, 0xe6, 0xdf, 0xb1, 0xf4,
+	0xce, 0x17, 0x2b, 0x53, 0x5f, 0xae, 0x4c, 0xfd, 0x73, 0x65, 0xea, 0x0f, 0x6b, 0x53, 0x5b, 0xae,
+	0x4d, 0xed, 0x6d, 0x6d, 0x6a, 0x97, 0x9d, 0x9d, 0xc6, 0x7f, 0x20, 0xe4, 0x4f, 0x5c, 0x32, 0x0d,
+	0x7b, 0x2f, 0x59, 0xcb, 0xd1, 0xd7, 0x00, 0xa9, 0x20, 0xf2, 0x63, 0x25, 0x02, 0x00, 0x00,
+}
+
+func (m *SendWithAliasRoutingAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendWithAliasRoutingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendWithAliasRoutingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SendWithAliasRoutingAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SendWithAliasRoutingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendWithAliasRoutingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendWithAliasRoutingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitbadges/bitbadgeschain/x/sendmanager/types"
+)
+
+var (
+	authzFromAddr  = sdk.AccAddress("_______from________").String()
+	authzToAddr    = sdk.AccAddress("_______to__________").String()
+	authzOtherAddr = sdk.AccAddress("_______other_______").String()
+)
+
+func TestSendWithAliasRoutingAuthorization_Accept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	spendLimit := sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(100)),
+		sdk.NewCoin("ubadge", sdkmath.NewInt(50)),
+	)
+	auth := types.NewSendWithAliasRoutingAuthorization(spendLimit, nil)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgSendWithAliasRouting{}), auth.MsgTypeURL())
+	require.NoError(t, auth.ValidateBasic())
+
+	// Partial spend across alias and native denoms decrements both
+	resp, err := auth.Accept(ctx, &types.MsgSendWithAliasRouting{
+		FromAddress: authzFromAddr,
+		ToAddress:   authzToAddr,
+		Amount: sdk.NewCoins(
+			sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(40)),
+			sdk.NewCoin("ubadge", sdkmath.NewInt(10)),
+		),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*types.SendWithAliasRoutingAuthorization)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(60)),
+		sdk.NewCoin("ubadge", sdkmath.NewInt(40)),
+	), updated.SpendLimit)
+
+	// Exceeding the remaining limit is rejected
+	_, err = updated.Accept(ctx, &types.MsgSendWithAliasRouting{
+		FromAddress: authzFromAddr,
+		ToAddress:   authzToAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(61))),
+	})
+	require.Error(t, err)
+
+	// Denoms outside the spend limit are rejected
+	_, err = updated.Accept(ctx, &types.MsgSendWithAliasRouting{
+		FromAddress: authzFromAddr,
+		ToAddress:   authzToAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin("badgeslp:2:utoken", sdkmath.NewInt(1))),
+	})
+	require.Error(t, err)
+
+	// Spending the exact remainder deletes the grant
+	resp, err = updated.Accept(ctx, &types.MsgSendWithAliasRouting{
+		FromAddress: authzFromAddr,
+		ToAddress:   authzToAddr,
+		Amount:      updated.SpendLimit,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// Other message types are rejected
+	_, err = auth.Accept(ctx, &types.MsgUpdateParams{})
+	require.Error(t, err)
+}
+
+func TestSendWithAliasRoutingAuthorization_AllowList(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	toAcc, err := sdk.AccAddressFromBech32(authzToAddr)
+	require.NoError(t, err)
+
+	auth := types.NewSendWithAliasRoutingAuthorization(
+		sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(100))),
+		[]sdk.AccAddress{toAcc},
+	)
+	require.NoError(t, auth.ValidateBasic())
+
+	resp, err := auth.Accept(ctx, &types.MsgSendWithAliasRouting{
+		FromAddress: authzFromAddr,
+		ToAddress:   authzToAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(10))),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Equal(t, []string{authzToAddr}, resp.Updated.(*types.SendWithAliasRoutingAuthorization).AllowList)
+
+	_, err = auth.Accept(ctx, &types.MsgSendWithAliasRouting{
+		FromAddress: authzFromAddr,
+		ToAddress:   authzOtherAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin("badgeslp:1:utoken", sdkmath.NewInt(10))),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot send to")
+}
+
+func TestSendWithAliasRoutingAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		desc  string
+		auth  types.SendWithAliasRoutingAuthorization
+		valid bool
+	}{
+		{
+			desc:  "valid",
+			auth:  types.SendWithAliasRoutingAuthorization{SpendLimit: sdk.NewCoins(sdk.NewCoin("ubadge", sdkmath.NewInt(1))), AllowList: []string{authzToAddr}},
+			valid: true,
+		},
+		{
+			desc:  "empty spend limit",
+			auth:  types.SendWithAliasRoutingAuthorization{},
+			valid: false,
+		},
+		{
+			desc:  "non-positive spend limit",
+			auth:  types.SendWithAliasRoutingAuthorization{SpendLimit: sdk.Coins{sdk.Coin{Denom: "ubadge", Amount: sdkmath.ZeroInt()}}},
+			valid: false,
+		},
+		{
+			desc:  "invalid allow list address",
+			auth:  types.SendWithAliasRoutingAuthorization{SpendLimit: sdk.NewCoins(sdk.NewCoin("ubadge", sdkmath.NewInt(1))), AllowList: []string{"invalid"}},
+			valid: false,
+		},
+		{
+			desc:  "duplicate allow list address",
+			auth:  types.SendWithAliasRoutingAuthorization{SpendLimit: sdk.NewCoins(sdk.NewCoin("ubadge", sdkmath.NewInt(1))), AllowList: []string{authzToAddr, authzToAddr}},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
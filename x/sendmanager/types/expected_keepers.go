@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -18,6 +19,7 @@ type AuthKeeper interface {
 type BankKeeper interface {
 	GetBalance(context.Context, sdk.AccAddress, string) sdk.Coin
 	GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins
+	AllBalances(context.Context, *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error)
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
 	SendCoins(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error
 	MintCoins(context.Context, string, sdk.Coins) error
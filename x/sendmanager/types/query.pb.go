@@ -6,8 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -216,46 +217,316 @@ func (m *QueryBalanceResponse) GetBalance() types.Coin {
 	return types.Coin{}
 }
 
+// QueryAllBalancesRequest is request type for the Query/AllBalances RPC method.
+type QueryAllBalancesRequest struct {
+	// address is the address to query balances for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	// Balances are sorted by denom, and next_key is the denom to resume from.
+	// A page may hold fewer balances than the limit (while next_key is still set) when many alias denoms with a zero balance are skipped.
+	// count_total is not supported, since counting would require computing every alias denom balance.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBalancesRequest) Reset()         { *m = QueryAllBalancesRequest{} }
+func (m *QueryAllBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBalancesRequest) ProtoMessage()    {}
+func (*QueryAllBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a97e6e3b64de73, []int{4}
+}
+func (m *QueryAllBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBalancesRequest.Merge(m, src)
+}
+func (m *QueryAllBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryAllBalancesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAllBalancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllBalancesResponse is response type for the Query/AllBalances RPC method.
+type QueryAllBalancesResponse struct {
+	// balances is the page of balances for the address, sorted by denom.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// denom_metadata holds the display metadata for every alias denom in balances.
+	DenomMetadata []AliasDenomMetadata `protobuf:"bytes,2,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBalancesResponse) Reset()         { *m = QueryAllBalancesResponse{} }
+func (m *QueryAllBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBalancesResponse) ProtoMessage()    {}
+func (*QueryAllBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a97e6e3b64de73, []int{5}
+}
+func (m *QueryAllBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBalancesResponse.Merge(m, src)
+}
+func (m *QueryAllBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryAllBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QueryAllBalancesResponse) GetDenomMetadata() []AliasDenomMetadata {
+	if m != nil {
+		return m.DenomMetadata
+	}
+	return nil
+}
+
+func (m *QueryAllBalancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// AliasDenomMetadata holds the display metadata for an alias denom, as defined by the module handling it.
+type AliasDenomMetadata struct {
+	// denom is the full alias denom (e.g., badgeslp:1:utoken).
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// symbol is the display symbol for the alias denom.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// denom_units are the display units for the alias denom.
+	DenomUnits []AliasDenomUnit `protobuf:"bytes,3,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units"`
+}
+
+func (m *AliasDenomMetadata) Reset()         { *m = AliasDenomMetadata{} }
+func (m *AliasDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*AliasDenomMetadata) ProtoMessage()    {}
+func (*AliasDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a97e6e3b64de73, []int{6}
+}
+func (m *AliasDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AliasDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AliasDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AliasDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AliasDenomMetadata.Merge(m, src)
+}
+func (m *AliasDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *AliasDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_AliasDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AliasDenomMetadata proto.InternalMessageInfo
+
+func (m *AliasDenomMetadata) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AliasDenomMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *AliasDenomMetadata) GetDenomUnits() []AliasDenomUnit {
+	if m != nil {
+		return m.DenomUnits
+	}
+	return nil
+}
+
+// AliasDenomUnit is a display unit for an alias denom.
+type AliasDenomUnit struct {
+	// decimals is the number of decimal places for this unit.
+	Decimals uint64 `protobuf:"varint,1,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// symbol is the display symbol for this unit.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// is_default_display is true if this unit should be used for display by default.
+	IsDefaultDisplay bool `protobuf:"varint,3,opt,name=is_default_display,json=isDefaultDisplay,proto3" json:"is_default_display,omitempty"`
+}
+
+func (m *AliasDenomUnit) Reset()         { *m = AliasDenomUnit{} }
+func (m *AliasDenomUnit) String() string { return proto.CompactTextString(m) }
+func (*AliasDenomUnit) ProtoMessage()    {}
+func (*AliasDenomUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a97e6e3b64de73, []int{7}
+}
+func (m *AliasDenomUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AliasDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AliasDenomUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AliasDenomUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AliasDenomUnit.Merge(m, src)
+}
+func (m *AliasDenomUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AliasDenomUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AliasDenomUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AliasDenomUnit proto.InternalMessageInfo
+
+func (m *AliasDenomUnit) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *AliasDenomUnit) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *AliasDenomUnit) GetIsDefaultDisplay() bool {
+	if m != nil {
+		return m.IsDefaultDisplay
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "sendmanager.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "sendmanager.QueryParamsResponse")
 	proto.RegisterType((*QueryBalanceRequest)(nil), "sendmanager.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "sendmanager.QueryBalanceResponse")
+	proto.RegisterType((*QueryAllBalancesRequest)(nil), "sendmanager.QueryAllBalancesRequest")
+	proto.RegisterType((*QueryAllBalancesResponse)(nil), "sendmanager.QueryAllBalancesResponse")
+	proto.RegisterType((*AliasDenomMetadata)(nil), "sendmanager.AliasDenomMetadata")
+	proto.RegisterType((*AliasDenomUnit)(nil), "sendmanager.AliasDenomUnit")
 }
 
 func init() { proto.RegisterFile("sendmanager/v1/query.proto", fileDescriptor_b4a97e6e3b64de73) }
 
 var fileDescriptor_b4a97e6e3b64de73 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x6b, 0x14, 0x31,
-	0x14, 0xdf, 0x2c, 0x76, 0x97, 0xa6, 0x27, 0xd3, 0x3d, 0xac, 0xa3, 0x4c, 0xeb, 0x9c, 0xa4, 0x48,
-	0xc2, 0x56, 0x51, 0x3c, 0x89, 0x2b, 0xe2, 0x49, 0x70, 0xf7, 0xe8, 0xed, 0xcd, 0x4c, 0x48, 0x03,
-	0x9d, 0xbc, 0xe9, 0x24, 0x5b, 0x2c, 0xa5, 0x07, 0xfd, 0x04, 0x82, 0x07, 0x3f, 0x81, 0xe0, 0xd1,
-	0x8f, 0xd1, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0x5d, 0xc1, 0xaf, 0x21, 0x9b, 0x64, 0x71, 0x47, 0xad,
-	0xf4, 0x32, 0xbc, 0xbc, 0xf7, 0xcb, 0xef, 0xcf, 0xcb, 0xd0, 0xc4, 0x4a, 0x53, 0x56, 0x60, 0x40,
-	0xc9, 0x46, 0x1c, 0x8f, 0xc4, 0xd1, 0x4c, 0x36, 0x27, 0xbc, 0x6e, 0xd0, 0x21, 0xdb, 0x5a, 0x9b,
-	0x25, 0xd7, 0xa1, 0xd2, 0x06, 0x85, 0xff, 0x86, 0x79, 0x32, 0x50, 0xa8, 0xd0, 0x97, 0x62, 0x59,
-	0xc5, 0xee, 0x2d, 0x85, 0xa8, 0x0e, 0xa5, 0x80, 0x5a, 0x0b, 0x30, 0x06, 0x1d, 0x38, 0x8d, 0xc6,
-	0xc6, 0xe9, 0x5e, 0x81, 0xb6, 0x42, 0x2b, 0x72, 0xb0, 0x32, 0x88, 0x89, 0xe3, 0x51, 0x2e, 0x1d,
-	0x8c, 0x44, 0x0d, 0x4a, 0x1b, 0x0f, 0x8e, 0xd8, 0x9b, 0x7f, 0x78, 0xab, 0xa1, 0x81, 0x6a, 0x45,
-	0x94, 0xae, 0x13, 0xad, 0x28, 0x0a, 0xd4, 0xf1, 0x72, 0x36, 0xa0, 0x6c, 0xb2, 0xa4, 0x7f, 0xe9,
-	0x2f, 0x4d, 0xe5, 0xd1, 0x4c, 0x5a, 0x97, 0xbd, 0xa0, 0xdb, 0xad, 0xae, 0xad, 0xd1, 0x58, 0xc9,
-	0x1e, 0xd0, 0x5e, 0x20, 0x1f, 0x92, 0x5d, 0x72, 0x67, 0x6b, 0x7f, 0x9b, 0xaf, 0x49, 0xf3, 0x00,
-	0x1e, 0x6f, 0x9e, 0x7f, 0xdb, 0xe9, 0x7c, 0xfa, 0xf9, 0x79, 0x8f, 0x4c, 0x23, 0x3a, 0x7b, 0x16,
-	0xe9, 0xc6, 0x70, 0x08, 0xa6, 0x90, 0x51, 0x85, 0x0d, 0x69, 0x1f, 0xca, 0xb2, 0x91, 0x36, 0xf0,
-	0x6d, 0x4e, 0x57, 0x47, 0x36, 0xa0, 0x1b, 0xa5, 0x34, 0x58, 0x0d, 0xbb, 0xbe, 0x1f, 0x0e, 0xd9,
-	0x84, 0x0e, 0xda, 0x34, 0xd1, 0xd6, 0x23, 0xda, 0xcf, 0x43, 0x2b, 0xfa, 0xba, 0xc1, 0x43, 0x6a,
-	0xbe, 0x4c, 0xcd, 0x63, 0x6a, 0xfe, 0x14, 0xb5, 0x19, 0x5f, 0x5b, 0xba, 0x9b, 0xae, 0xf0, 0xfb,
-	0x1f, 0xbb, 0x74, 0xc3, 0x73, 0xb2, 0x37, 0x84, 0xf6, 0x42, 0x02, 0xb6, 0xd3, 0x8a, 0xf5, 0xf7,
-	0x7a, 0x92, 0xdd, 0xcb, 0x01, 0xc1, 0x52, 0x76, 0xff, 0xed, 0x97, 0x1f, 0xef, 0xbb, 0x9c, 0xdd,
-	0x15, 0xb9, 0x76, 0x39, 0x94, 0x4a, 0xda, 0xdf, 0x55, 0x71, 0x00, 0xda, 0x88, 0xf5, 0x57, 0x0b,
-	0x7b, 0x62, 0x1f, 0x08, 0xed, 0xc7, 0x70, 0xec, 0x1f, 0x1a, 0xed, 0xf5, 0x25, 0xb7, 0xff, 0x83,
-	0x88, 0x36, 0x9e, 0x7b, 0x1b, 0x4f, 0xd8, 0xe3, 0xab, 0xd9, 0x88, 0x5b, 0x11, 0xa7, 0xf1, 0x1d,
-	0xce, 0xc4, 0xa9, 0xdf, 0xfc, 0xd9, 0x78, 0x72, 0x3e, 0x4f, 0xc9, 0xc5, 0x3c, 0x25, 0xdf, 0xe7,
-	0x29, 0x79, 0xb7, 0x48, 0x3b, 0x17, 0x8b, 0xb4, 0xf3, 0x75, 0x91, 0x76, 0x5e, 0x3d, 0x54, 0xda,
-	0x1d, 0xcc, 0x72, 0x5e, 0x60, 0x75, 0xb9, 0xc8, 0xeb, 0x96, 0x8c, 0x3b, 0xa9, 0xa5, 0xcd, 0x7b,
-	0xfe, 0x07, 0xbc, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x79, 0xbd, 0xf9, 0xbb, 0x5b, 0x03, 0x00,
-	0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x3c,
+	0x18, 0x6f, 0xda, 0xad, 0xdb, 0x5c, 0xbd, 0xd3, 0xfb, 0x7a, 0xd5, 0x4b, 0xc9, 0x50, 0x36, 0x22,
+	0xfe, 0x4c, 0xd3, 0x88, 0xd9, 0x40, 0x20, 0x24, 0x04, 0x5a, 0x29, 0xec, 0xc2, 0xa4, 0x2d, 0x12,
+	0x17, 0x2e, 0x95, 0xd3, 0x98, 0xcc, 0x22, 0xb1, 0xb3, 0x3a, 0x9d, 0xa8, 0xa6, 0x1d, 0xe0, 0xc0,
+	0x19, 0x89, 0x03, 0x12, 0x27, 0x8e, 0x88, 0x13, 0x1f, 0x63, 0xc7, 0x49, 0x5c, 0x38, 0x01, 0xda,
+	0x90, 0xf8, 0x1a, 0x28, 0xb6, 0xb3, 0x26, 0x94, 0x6e, 0xbb, 0xb4, 0xf6, 0xe3, 0xc7, 0xbf, 0x3f,
+	0x8f, 0x9f, 0x27, 0xc0, 0x14, 0x84, 0xf9, 0x11, 0x66, 0x38, 0x20, 0x5d, 0xb4, 0xb3, 0x8c, 0xb6,
+	0x7b, 0xa4, 0xdb, 0x77, 0xe2, 0x2e, 0x4f, 0x38, 0xac, 0xe5, 0xce, 0xcc, 0xff, 0x70, 0x44, 0x19,
+	0x47, 0xf2, 0x57, 0x9d, 0x9b, 0xf5, 0x80, 0x07, 0x5c, 0x2e, 0x51, 0xba, 0xd2, 0xd1, 0x0b, 0x01,
+	0xe7, 0x41, 0x48, 0x10, 0x8e, 0x29, 0xc2, 0x8c, 0xf1, 0x04, 0x27, 0x94, 0x33, 0xa1, 0x4f, 0x17,
+	0x3b, 0x5c, 0x44, 0x5c, 0x20, 0x0f, 0x0b, 0xa2, 0xc8, 0xd0, 0xce, 0xb2, 0x47, 0x12, 0xbc, 0x8c,
+	0x62, 0x1c, 0x50, 0x26, 0x93, 0x75, 0xee, 0xec, 0x1f, 0xda, 0x62, 0xdc, 0xc5, 0x51, 0x06, 0x64,
+	0xe5, 0x81, 0x32, 0x88, 0x0e, 0xa7, 0xfa, 0xb2, 0x5d, 0x07, 0x70, 0x33, 0x85, 0xdf, 0x90, 0x97,
+	0x5c, 0xb2, 0xdd, 0x23, 0x22, 0xb1, 0xd7, 0xc1, 0x4c, 0x21, 0x2a, 0x62, 0xce, 0x04, 0x81, 0xb7,
+	0x40, 0x55, 0x81, 0x37, 0x8c, 0x79, 0x63, 0xa1, 0xb6, 0x32, 0xe3, 0xe4, 0xa8, 0x1d, 0x95, 0xdc,
+	0x9c, 0xda, 0xff, 0x36, 0x57, 0xfa, 0xf8, 0xeb, 0xf3, 0xa2, 0xe1, 0xea, 0x6c, 0xfb, 0xa1, 0x86,
+	0x6b, 0xe2, 0x10, 0xb3, 0x0e, 0xd1, 0x2c, 0xb0, 0x01, 0x26, 0xb0, 0xef, 0x77, 0x89, 0x50, 0x78,
+	0x53, 0x6e, 0xb6, 0x85, 0x75, 0x30, 0xee, 0x13, 0xc6, 0xa3, 0x46, 0x59, 0xc6, 0xd5, 0xc6, 0xde,
+	0x04, 0xf5, 0x22, 0x8c, 0x96, 0x75, 0x07, 0x4c, 0x78, 0x2a, 0xa4, 0x75, 0x9d, 0x77, 0x94, 0x6b,
+	0x27, 0x75, 0xed, 0x68, 0xd7, 0xce, 0x03, 0x4e, 0x59, 0x73, 0x2c, 0x55, 0xe7, 0x66, 0xf9, 0xf6,
+	0x2e, 0x38, 0x27, 0x21, 0x57, 0xc3, 0x50, 0xa3, 0x8a, 0xd3, 0xd5, 0x3d, 0x02, 0x60, 0xf0, 0x08,
+	0x52, 0x62, 0x6d, 0xe5, 0x4a, 0x81, 0x52, 0xb5, 0x47, 0x46, 0xbc, 0x81, 0x83, 0xcc, 0xb3, 0x9b,
+	0xbb, 0x69, 0xbf, 0x2f, 0x83, 0xc6, 0x30, 0xbb, 0x36, 0x15, 0x80, 0x49, 0x2d, 0x32, 0xe5, 0xaf,
+	0x9c, 0xec, 0xea, 0x7a, 0xea, 0xea, 0xd3, 0xf7, 0xb9, 0x85, 0x80, 0x26, 0x5b, 0x3d, 0xcf, 0xe9,
+	0xf0, 0x08, 0xe9, 0x87, 0x57, 0x7f, 0xd7, 0x84, 0xff, 0x1c, 0x25, 0xfd, 0x98, 0x08, 0x79, 0x41,
+	0xb8, 0xc7, 0xe0, 0xf0, 0x31, 0x98, 0x96, 0xe5, 0x6d, 0x47, 0x24, 0xc1, 0x3e, 0x4e, 0x70, 0xa3,
+	0x2c, 0xe9, 0xe6, 0x0a, 0x8f, 0xbb, 0x1a, 0x52, 0x2c, 0x5a, 0x69, 0xde, 0xba, 0x4e, 0xd3, 0xa5,
+	0xfc, 0xc7, 0xcf, 0x07, 0xe1, 0x5a, 0xa1, 0x36, 0x15, 0x59, 0x9b, 0xab, 0xa7, 0xd6, 0x46, 0x79,
+	0x2e, 0x14, 0xe7, 0xb5, 0x01, 0xe0, 0x30, 0xe9, 0xa0, 0x33, 0x8c, 0x5c, 0x67, 0xc0, 0xff, 0x41,
+	0x55, 0xf4, 0x23, 0x8f, 0x87, 0xba, 0x61, 0xf4, 0x0e, 0x36, 0x41, 0x4d, 0x79, 0xeb, 0x31, 0x9a,
+	0x88, 0x46, 0x45, 0x1a, 0x9b, 0x1d, 0x61, 0xec, 0x09, 0xa3, 0x89, 0x36, 0x05, 0xfc, 0x2c, 0x20,
+	0xec, 0x2e, 0x98, 0x2e, 0xe6, 0x40, 0x13, 0x4c, 0xfa, 0xa4, 0x43, 0x23, 0x1c, 0xaa, 0xd6, 0x18,
+	0x73, 0x8f, 0xf7, 0x23, 0x95, 0x2c, 0x01, 0x48, 0x45, 0xdb, 0x27, 0xcf, 0x70, 0x2f, 0x4c, 0xda,
+	0x3e, 0x15, 0x71, 0x88, 0xfb, 0xb2, 0x3e, 0x93, 0xee, 0xbf, 0x54, 0xb4, 0xd4, 0x41, 0x4b, 0xc5,
+	0x57, 0xf6, 0x2b, 0x60, 0x5c, 0x76, 0x06, 0x7c, 0x69, 0x80, 0xaa, 0x1a, 0x2c, 0x58, 0x7c, 0x90,
+	0xe1, 0xa9, 0x35, 0xe7, 0x47, 0x27, 0xa8, 0x02, 0xdb, 0x37, 0x5f, 0x7d, 0xf9, 0xf9, 0xb6, 0xec,
+	0xc0, 0x25, 0xe4, 0xd1, 0xc4, 0xc3, 0x7e, 0x40, 0xc4, 0x60, 0xd5, 0xd9, 0xc2, 0x94, 0xa1, 0xfc,
+	0xc7, 0x44, 0x8d, 0x2f, 0x7c, 0x67, 0x80, 0x09, 0xdd, 0x9f, 0xf0, 0x2f, 0x1c, 0xc5, 0xa9, 0x36,
+	0x2f, 0x9e, 0x90, 0xa1, 0x65, 0xac, 0x49, 0x19, 0xab, 0xf0, 0xfe, 0xd9, 0x64, 0xe8, 0x56, 0x45,
+	0xbb, 0x7a, 0x00, 0xf7, 0xd0, 0xae, 0x7c, 0x9f, 0x3d, 0xf8, 0xc1, 0x00, 0xb5, 0xdc, 0xf0, 0xc0,
+	0x4b, 0xc3, 0xdc, 0xc3, 0x93, 0x6d, 0x5e, 0x3e, 0x25, 0x4b, 0xab, 0x6c, 0x49, 0x95, 0xf7, 0xe0,
+	0xdd, 0xb3, 0xa9, 0xc4, 0x61, 0xd8, 0xce, 0x86, 0x6a, 0x20, 0xb5, 0xb9, 0xb9, 0x7f, 0x68, 0x19,
+	0x07, 0x87, 0x96, 0xf1, 0xe3, 0xd0, 0x32, 0xde, 0x1c, 0x59, 0xa5, 0x83, 0x23, 0xab, 0xf4, 0xf5,
+	0xc8, 0x2a, 0x3d, 0xbd, 0x9d, 0x1b, 0xd6, 0x91, 0x0c, 0x2f, 0x0a, 0x1c, 0x72, 0x82, 0xbd, 0xaa,
+	0xfc, 0x74, 0xdf, 0xf8, 0x3d, 0x00, 0x93, 0x38, 0x0b, 0x95, 0x95, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -275,6 +546,9 @@ type QueryClient interface {
 	// Balance queries the balance of a specific denom for an address with alias routing.
 	// This allows querying both standard coins and alias denoms (e.g., badgeslp:).
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
+	// AllBalances queries all balances of an address with alias routing.
+	// Bank balances are merged with every non-zero alias denom balance (e.g., badgeslp:) known to the alias router.
+	AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -303,6 +577,15 @@ func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error) {
+	out := new(QueryAllBalancesResponse)
+	err := c.cc.Invoke(ctx, "/sendmanager.Query/AllBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -310,6 +593,9 @@ type QueryServer interface {
 	// Balance queries the balance of a specific denom for an address with alias routing.
 	// This allows querying both standard coins and alias denoms (e.g., badgeslp:).
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// AllBalances queries all balances of an address with alias routing.
+	// Bank balances are merged with every non-zero alias denom balance (e.g., badgeslp:) known to the alias router.
+	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -322,6 +608,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
 }
+func (*UnimplementedQueryServer) AllBalances(ctx context.Context, req *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBalances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -363,6 +652,24 @@ func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sendmanager.Query/AllBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBalances(ctx, req.(*QueryAllBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sendmanager.Query",
@@ -376,6 +683,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balance",
 			Handler:    _Query_Balance_Handler,
 		},
+		{
+			MethodName: "AllBalances",
+			Handler:    _Query_AllBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sendmanager/v1/query.proto",
@@ -507,72 +818,687 @@ func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryAllBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DenomMetadata) > 0 {
+		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AliasDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AliasDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AliasDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomUnits) > 0 {
+		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AliasDenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AliasDenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AliasDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDefaultDisplay {
+		i--
+		if m.IsDefaultDisplay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DenomMetadata) > 0 {
+		for _, e := range m.DenomMetadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AliasDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.DenomUnits) > 0 {
+		for _, e := range m.DenomUnits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AliasDenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsDefaultDisplay {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -595,12 +1521,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBalancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -622,7 +1616,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -645,15 +1639,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -680,7 +1674,78 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadata = append(m.DenomMetadata, AliasDenomMetadata{})
+			if err := m.DenomMetadata[len(m.DenomMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -705,7 +1770,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *AliasDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -728,15 +1793,15 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AliasDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AliasDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -764,11 +1829,11 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -796,7 +1861,41 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, AliasDenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -819,7 +1918,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *AliasDenomUnit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,17 +1941,36 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AliasDenomUnit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AliasDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -862,25 +1980,44 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefaultDisplay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefaultDisplay = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
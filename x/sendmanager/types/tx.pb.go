@@ -11,6 +11,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -232,50 +233,154 @@ func (m *MsgSendWithAliasRoutingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendWithAliasRoutingResponse proto.InternalMessageInfo
 
+// MsgMultiSendWithAliasRouting defines a message for sending coins to multiple recipients with alias denom routing.
+// This message mirrors cosmos bank MsgMultiSend: there must be exactly one input, and the sum of the input
+// coins must equal the sum of the output coins. Each output is sent via SendCoinsWithAliasRouting.
+type MsgMultiSendWithAliasRouting struct {
+	// inputs, despite being `repeated`, only allows one sender input. This is
+	// checked in the message handler.
+	Inputs []types1.Input `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs"`
+	// outputs is the list of recipients and the coins each one receives.
+	Outputs []types1.Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
+}
+
+func (m *MsgMultiSendWithAliasRouting) Reset()         { *m = MsgMultiSendWithAliasRouting{} }
+func (m *MsgMultiSendWithAliasRouting) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendWithAliasRouting) ProtoMessage()    {}
+func (*MsgMultiSendWithAliasRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ed155cd3d916268, []int{4}
+}
+func (m *MsgMultiSendWithAliasRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendWithAliasRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendWithAliasRouting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendWithAliasRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendWithAliasRouting.Merge(m, src)
+}
+func (m *MsgMultiSendWithAliasRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendWithAliasRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendWithAliasRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendWithAliasRouting proto.InternalMessageInfo
+
+func (m *MsgMultiSendWithAliasRouting) GetInputs() []types1.Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *MsgMultiSendWithAliasRouting) GetOutputs() []types1.Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+// MsgMultiSendWithAliasRoutingResponse defines the response structure for executing a
+// MsgMultiSendWithAliasRouting message.
+type MsgMultiSendWithAliasRoutingResponse struct {
+}
+
+func (m *MsgMultiSendWithAliasRoutingResponse) Reset()         { *m = MsgMultiSendWithAliasRoutingResponse{} }
+func (m *MsgMultiSendWithAliasRoutingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendWithAliasRoutingResponse) ProtoMessage()    {}
+func (*MsgMultiSendWithAliasRoutingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ed155cd3d916268, []int{5}
+}
+func (m *MsgMultiSendWithAliasRoutingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendWithAliasRoutingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendWithAliasRoutingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendWithAliasRoutingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendWithAliasRoutingResponse.Merge(m, src)
+}
+func (m *MsgMultiSendWithAliasRoutingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendWithAliasRoutingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendWithAliasRoutingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendWithAliasRoutingResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "sendmanager.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sendmanager.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSendWithAliasRouting)(nil), "sendmanager.MsgSendWithAliasRouting")
 	proto.RegisterType((*MsgSendWithAliasRoutingResponse)(nil), "sendmanager.MsgSendWithAliasRoutingResponse")
+	proto.RegisterType((*MsgMultiSendWithAliasRouting)(nil), "sendmanager.MsgMultiSendWithAliasRouting")
+	proto.RegisterType((*MsgMultiSendWithAliasRoutingResponse)(nil), "sendmanager.MsgMultiSendWithAliasRoutingResponse")
 }
 
 func init() { proto.RegisterFile("sendmanager/v1/tx.proto", fileDescriptor_5ed155cd3d916268) }
 
 var fileDescriptor_5ed155cd3d916268 = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x24, 0x18, 0xc8, 0xa4, 0x20, 0xae, 0x81, 0xfc, 0x50, 0x36, 0x31, 0x78, 0x08, 0xa1,
-	0xdd, 0x31, 0x51, 0x5a, 0xa8, 0x07, 0x69, 0xbc, 0x09, 0x05, 0xdd, 0x22, 0x82, 0x97, 0x32, 0xbb,
-	0x3b, 0x4e, 0x46, 0x9d, 0x99, 0x65, 0x67, 0x52, 0xda, 0x9b, 0x78, 0xf4, 0xe4, 0x9f, 0x21, 0x9e,
-	0x72, 0xf0, 0x0f, 0x10, 0x4f, 0x3d, 0x78, 0x28, 0x9e, 0x3c, 0xa9, 0x24, 0x87, 0xfc, 0x1b, 0xb2,
-	0xbb, 0xb3, 0x6d, 0x12, 0x63, 0xd3, 0xcb, 0xee, 0xce, 0xfb, 0xde, 0xf7, 0xe6, 0xbd, 0xef, 0x7b,
-	0x0b, 0xab, 0x8a, 0x88, 0x80, 0x63, 0x81, 0x29, 0x89, 0xd0, 0x51, 0x0f, 0xe9, 0x63, 0x27, 0x8c,
-	0xa4, 0x96, 0x56, 0x79, 0x0e, 0x68, 0xdc, 0xc0, 0x9c, 0x09, 0x89, 0x92, 0x67, 0x8a, 0x37, 0xaa,
-	0xbe, 0x54, 0x5c, 0x2a, 0xc4, 0x15, 0x8d, 0x79, 0x5c, 0x51, 0x03, 0xd4, 0x53, 0xe0, 0x30, 0x39,
-	0xa1, 0xf4, 0x60, 0xa0, 0x0a, 0x95, 0x54, 0xa6, 0xf1, 0xf8, 0xcb, 0x44, 0x6f, 0x2d, 0xb5, 0x10,
-	0xe2, 0x08, 0xf3, 0x8c, 0x62, 0x9b, 0x6b, 0x3c, 0xac, 0x08, 0x3a, 0xea, 0x79, 0x44, 0xe3, 0x1e,
-	0xf2, 0x25, 0x13, 0x29, 0xde, 0xfe, 0x06, 0xe0, 0xf5, 0x7d, 0x45, 0x9f, 0x87, 0x01, 0xd6, 0xe4,
-	0x69, 0xc2, 0xb4, 0xb6, 0x61, 0x09, 0x8f, 0xf4, 0x50, 0x46, 0x4c, 0x9f, 0xd4, 0x40, 0x0b, 0x74,
-	0x4a, 0x83, 0xda, 0x8f, 0x2f, 0x5b, 0x15, 0xd3, 0xcb, 0x5e, 0x10, 0x44, 0x44, 0xa9, 0x03, 0x1d,
-	0x31, 0x41, 0xdd, 0x8b, 0x54, 0x6b, 0x1b, 0x16, 0xd3, 0xbb, 0x6b, 0xf9, 0x16, 0xe8, 0x94, 0xfb,
-	0x37, 0x9d, 0xb9, 0xce, 0x9c, 0xb4, 0xf8, 0xa0, 0x74, 0xfa, 0xab, 0x99, 0xfb, 0x34, 0x1b, 0x77,
-	0x81, 0x6b, 0xb2, 0x77, 0x1f, 0xbd, 0x9f, 0x8d, 0xbb, 0x17, 0x75, 0x3e, 0xcc, 0xc6, 0xdd, 0x4d,
-	0x8f, 0x69, 0x0f, 0x07, 0x94, 0x28, 0x7f, 0x88, 0x99, 0x40, 0xc7, 0x68, 0x7e, 0xc8, 0xa5, 0x86,
-	0xdb, 0x75, 0x58, 0x5d, 0x0a, 0xb9, 0x44, 0x85, 0x52, 0x28, 0xd2, 0xfe, 0x9a, 0x4f, 0xb0, 0x03,
-	0x22, 0x82, 0x17, 0x4c, 0x0f, 0xf7, 0xde, 0x32, 0xac, 0x5c, 0x39, 0xd2, 0x4c, 0x50, 0xeb, 0x21,
-	0xdc, 0x78, 0x15, 0x49, 0x7e, 0x88, 0xd3, 0x81, 0xd6, 0x8e, 0x5a, 0x8e, 0xb3, 0x4d, 0xc8, 0xda,
-	0x81, 0x50, 0xcb, 0x73, 0x6a, 0x7e, 0x9d, 0x4a, 0x5a, 0x66, 0x44, 0x1f, 0x16, 0x31, 0x97, 0x23,
-	0xa1, 0x6b, 0x85, 0x56, 0xa1, 0x53, 0xee, 0xd7, 0x1d, 0xc3, 0x88, 0x2d, 0x72, 0x8c, 0x45, 0xce,
-	0x63, 0xc9, 0xc4, 0xe0, 0x5e, 0xac, 0xd5, 0xe7, 0xdf, 0xcd, 0x0e, 0x65, 0x7a, 0x38, 0xf2, 0x1c,
-	0x5f, 0x72, 0xb3, 0x10, 0xe6, 0xb5, 0xa5, 0x82, 0x37, 0x48, 0x9f, 0x84, 0x44, 0x25, 0x04, 0xe5,
-	0x9a, 0xd2, 0xbb, 0x4f, 0x62, 0x49, 0x17, 0xa6, 0x8b, 0x55, 0x7d, 0xb0, 0x4e, 0xd5, 0x55, 0x32,
-	0xb5, 0xef, 0xc0, 0xe6, 0x7f, 0xa0, 0x4c, 0xe5, 0xfe, 0x77, 0x00, 0x0b, 0xfb, 0x8a, 0x5a, 0x2e,
-	0xdc, 0x58, 0xd8, 0xa4, 0xdb, 0x0b, 0x1b, 0xb0, 0xe4, 0x51, 0xe3, 0xee, 0x65, 0x68, 0x56, 0xdb,
-	0x7a, 0x0d, 0x2b, 0x2b, 0xdd, 0xfb, 0x87, 0xbd, 0x2a, 0xab, 0xb1, 0x79, 0x95, 0xac, 0xec, 0xae,
-	0xc6, 0xb5, 0x77, 0xf1, 0x62, 0x0e, 0x9e, 0x9d, 0x4e, 0x6c, 0x70, 0x36, 0xb1, 0xc1, 0x9f, 0x89,
-	0x0d, 0x3e, 0x4e, 0xed, 0xdc, 0xd9, 0xd4, 0xce, 0xfd, 0x9c, 0xda, 0xb9, 0x97, 0x3b, 0x73, 0x4e,
-	0x9c, 0x8b, 0x89, 0x2e, 0x95, 0x35, 0xb1, 0xc7, 0x2b, 0x26, 0xbf, 0xdb, 0xfd, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x3e, 0xf5, 0x57, 0x21, 0x30, 0x04, 0x00, 0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6b, 0x13, 0x4f,
+	0x18, 0xcf, 0xa6, 0xfc, 0xf3, 0xa7, 0x93, 0x82, 0xb8, 0x16, 0x9a, 0x6c, 0xcb, 0xb6, 0x86, 0x22,
+	0xb1, 0xb4, 0xbb, 0xa6, 0x4a, 0x8b, 0x15, 0xd1, 0xc6, 0x53, 0x85, 0xa0, 0x6e, 0x11, 0xc1, 0x4b,
+	0x99, 0xcd, 0xae, 0x93, 0xb1, 0xdd, 0x99, 0x65, 0x67, 0xb6, 0x34, 0x37, 0xf1, 0xe8, 0xc9, 0x8f,
+	0x21, 0x9e, 0x72, 0xf0, 0x03, 0x88, 0x17, 0x7b, 0x2c, 0x9e, 0x3c, 0xa9, 0x24, 0x87, 0x80, 0x9f,
+	0x42, 0x66, 0x77, 0x76, 0x93, 0x6c, 0xf3, 0xd2, 0x4b, 0x92, 0x99, 0xdf, 0xcb, 0x3c, 0xcf, 0xef,
+	0x99, 0x09, 0x58, 0x62, 0x2e, 0x71, 0x3c, 0x48, 0x20, 0x72, 0x03, 0xf3, 0xb4, 0x66, 0xf2, 0x33,
+	0xc3, 0x0f, 0x28, 0xa7, 0x6a, 0x71, 0x08, 0xd0, 0xae, 0x43, 0x0f, 0x13, 0x6a, 0x46, 0x9f, 0x31,
+	0xae, 0x2d, 0x35, 0x29, 0xf3, 0x28, 0x33, 0x3d, 0x86, 0x84, 0xce, 0x63, 0x48, 0x02, 0xe5, 0x18,
+	0x38, 0x8a, 0x56, 0x66, 0xbc, 0x90, 0xd0, 0x22, 0xa2, 0x88, 0xc6, 0xfb, 0xe2, 0x97, 0xdc, 0x5d,
+	0xce, 0x94, 0xe0, 0xc3, 0x00, 0x7a, 0x89, 0x44, 0x97, 0xc7, 0xd8, 0x90, 0xb9, 0xe6, 0x69, 0xcd,
+	0x76, 0x39, 0xac, 0x99, 0x4d, 0x8a, 0xc9, 0x25, 0x9c, 0x1c, 0xa7, 0xb8, 0x58, 0xc4, 0x78, 0xe5,
+	0x9b, 0x02, 0xae, 0x35, 0x18, 0x7a, 0xe9, 0x3b, 0x90, 0xbb, 0xcf, 0x23, 0x67, 0x75, 0x07, 0xcc,
+	0xc3, 0x90, 0xb7, 0x68, 0x80, 0x79, 0xbb, 0xa4, 0xac, 0x29, 0xd5, 0xf9, 0x7a, 0xe9, 0xc7, 0x97,
+	0xad, 0x45, 0x59, 0xeb, 0xbe, 0xe3, 0x04, 0x2e, 0x63, 0x87, 0x3c, 0xc0, 0x04, 0x59, 0x03, 0xaa,
+	0xba, 0x03, 0x0a, 0x71, 0x6d, 0xa5, 0xfc, 0x9a, 0x52, 0x2d, 0x6e, 0xdf, 0x30, 0x86, 0x2a, 0x37,
+	0x62, 0xf3, 0xfa, 0xfc, 0xf9, 0xaf, 0xd5, 0xdc, 0xa7, 0x7e, 0x67, 0x43, 0xb1, 0x24, 0x7b, 0xef,
+	0xd1, 0xfb, 0x7e, 0x67, 0x63, 0xe0, 0xf3, 0xa1, 0xdf, 0xd9, 0xd8, 0xb4, 0x31, 0xb7, 0xa1, 0x83,
+	0x5c, 0xd6, 0x6c, 0x41, 0x4c, 0xcc, 0x33, 0x73, 0x38, 0x84, 0x4c, 0xc1, 0x95, 0x32, 0x58, 0xca,
+	0x6c, 0x59, 0x2e, 0xf3, 0x29, 0x61, 0x6e, 0xe5, 0x6b, 0x3e, 0xc2, 0x0e, 0x5d, 0xe2, 0xbc, 0xc2,
+	0xbc, 0xb5, 0x7f, 0x82, 0x21, 0xb3, 0x68, 0xc8, 0x31, 0x41, 0xea, 0x03, 0xb0, 0xf0, 0x26, 0xa0,
+	0xde, 0x11, 0x8c, 0x1b, 0x9a, 0xd9, 0x6a, 0x51, 0xb0, 0xe5, 0x96, 0xba, 0x0b, 0x00, 0xa7, 0xa9,
+	0x34, 0x3f, 0x2b, 0x25, 0x4e, 0x13, 0x61, 0x13, 0x14, 0xa0, 0x47, 0x43, 0xc2, 0x4b, 0x73, 0x6b,
+	0x73, 0xd5, 0xe2, 0x76, 0xd9, 0x90, 0x0a, 0x31, 0x42, 0x43, 0x8e, 0xc8, 0x78, 0x42, 0x31, 0xa9,
+	0xdf, 0x11, 0x59, 0x7d, 0xfe, 0xbd, 0x5a, 0x45, 0x98, 0xb7, 0x42, 0xdb, 0x68, 0x52, 0x4f, 0x5e,
+	0x18, 0xf9, 0xb5, 0xc5, 0x9c, 0x63, 0x93, 0xb7, 0x7d, 0x97, 0x45, 0x02, 0x66, 0x49, 0xeb, 0xbd,
+	0xa7, 0x22, 0xd2, 0x91, 0xee, 0x44, 0xaa, 0xf7, 0x66, 0xa5, 0x3a, 0x2e, 0xa6, 0xca, 0x4d, 0xb0,
+	0x3a, 0x01, 0x4a, 0x53, 0xfe, 0xab, 0x80, 0x95, 0x06, 0x43, 0x8d, 0xf0, 0x84, 0xe3, 0xb1, 0x51,
+	0x3f, 0x04, 0x05, 0x4c, 0xfc, 0x90, 0x8b, 0x90, 0x45, 0xd3, 0xda, 0xa0, 0x69, 0x72, 0x9c, 0x36,
+	0x7d, 0x20, 0x28, 0x23, 0x37, 0x24, 0x16, 0xa9, 0x8f, 0xc1, 0xff, 0x34, 0xe4, 0x91, 0x3e, 0x1f,
+	0xe9, 0x97, 0xc7, 0xea, 0x9f, 0x85, 0x3c, 0x63, 0x90, 0xc8, 0xf6, 0x0e, 0x44, 0x20, 0xd2, 0x4e,
+	0x44, 0x71, 0x7f, 0x56, 0x14, 0x13, 0x7b, 0xa9, 0xdc, 0x02, 0xeb, 0xd3, 0xf0, 0x24, 0x94, 0xed,
+	0xef, 0x79, 0x30, 0xd7, 0x60, 0x48, 0xb5, 0xc0, 0xc2, 0xc8, 0xf3, 0x5a, 0x19, 0x79, 0x16, 0x99,
+	0x8b, 0xab, 0xad, 0x4f, 0x43, 0x13, 0x6f, 0xf5, 0x2d, 0x58, 0x1c, 0x9b, 0xf3, 0x25, 0xf5, 0x38,
+	0x96, 0xb6, 0x79, 0x15, 0x56, 0x7a, 0x56, 0x1b, 0x94, 0x27, 0x0f, 0xf6, 0x76, 0xd6, 0x6a, 0x22,
+	0x55, 0xab, 0x5d, 0x99, 0x9a, 0x1c, 0xad, 0xfd, 0xf7, 0x4e, 0x4c, 0xb1, 0xfe, 0xe2, 0xbc, 0xab,
+	0x2b, 0x17, 0x5d, 0x5d, 0xf9, 0xd3, 0xd5, 0x95, 0x8f, 0x3d, 0x3d, 0x77, 0xd1, 0xd3, 0x73, 0x3f,
+	0x7b, 0x7a, 0xee, 0xf5, 0xee, 0xd0, 0xcb, 0x48, 0x27, 0x6a, 0x4e, 0x9d, 0x6d, 0xf4, 0x5c, 0xec,
+	0x42, 0xf4, 0xf7, 0x77, 0xf7, 0xdf, 0x00, 0x90, 0x1d, 0xeb, 0xeb, 0xe0, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +401,9 @@ type MsgClient interface {
 	// SendWithAliasRouting defines a message for sending coins with alias denom routing.
 	// This allows sending both standard coins and alias denoms (e.g., badgeslp:) through the sendmanager.
 	SendWithAliasRouting(ctx context.Context, in *MsgSendWithAliasRouting, opts ...grpc.CallOption) (*MsgSendWithAliasRoutingResponse, error)
+	// MultiSendWithAliasRouting defines a message for sending coins from one sender to many recipients
+	// with alias denom routing. This mirrors cosmos bank MsgMultiSend.
+	MultiSendWithAliasRouting(ctx context.Context, in *MsgMultiSendWithAliasRouting, opts ...grpc.CallOption) (*MsgMultiSendWithAliasRoutingResponse, error)
 }
 
 type msgClient struct {
@@ -324,6 +432,15 @@ func (c *msgClient) SendWithAliasRouting(ctx context.Context, in *MsgSendWithAli
 	return out, nil
 }
 
+func (c *msgClient) MultiSendWithAliasRouting(ctx context.Context, in *MsgMultiSendWithAliasRouting, opts ...grpc.CallOption) (*MsgMultiSendWithAliasRoutingResponse, error) {
+	out := new(MsgMultiSendWithAliasRoutingResponse)
+	err := c.cc.Invoke(ctx, "/sendmanager.Msg/MultiSendWithAliasRouting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -332,6 +449,9 @@ type MsgServer interface {
 	// SendWithAliasRouting defines a message for sending coins with alias denom routing.
 	// This allows sending both standard coins and alias denoms (e.g., badgeslp:) through the sendmanager.
 	SendWithAliasRouting(context.Context, *MsgSendWithAliasRouting) (*MsgSendWithAliasRoutingResponse, error)
+	// MultiSendWithAliasRouting defines a message for sending coins from one sender to many recipients
+	// with alias denom routing. This mirrors cosmos bank MsgMultiSend.
+	MultiSendWithAliasRouting(context.Context, *MsgMultiSendWithAliasRouting) (*MsgMultiSendWithAliasRoutingResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -344,6 +464,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SendWithAliasRouting(ctx context.Context, req *MsgSendWithAliasRouting) (*MsgSendWithAliasRoutingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendWithAliasRouting not implemented")
 }
+func (*UnimplementedMsgServer) MultiSendWithAliasRouting(ctx context.Context, req *MsgMultiSendWithAliasRouting) (*MsgMultiSendWithAliasRoutingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSendWithAliasRouting not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -385,6 +508,24 @@ func _Msg_SendWithAliasRouting_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MultiSendWithAliasRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSendWithAliasRouting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSendWithAliasRouting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sendmanager.Msg/MultiSendWithAliasRouting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSendWithAliasRouting(ctx, req.(*MsgMultiSendWithAliasRouting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sendmanager.Msg",
@@ -398,6 +539,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendWithAliasRouting",
 			Handler:    _Msg_SendWithAliasRouting_Handler,
 		},
+		{
+			MethodName: "MultiSendWithAliasRouting",
+			Handler:    _Msg_MultiSendWithAliasRouting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sendmanager/v1/tx.proto",
@@ -540,6 +685,80 @@ func (m *MsgSendWithAliasRoutingResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMultiSendWithAliasRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendWithAliasRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendWithAliasRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendWithAliasRoutingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendWithAliasRoutingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendWithAliasRoutingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -607,6 +826,36 @@ func (m *MsgSendWithAliasRoutingResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMultiSendWithAliasRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendWithAliasRoutingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -976,6 +1225,174 @@ func (m *MsgSendWithAliasRoutingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMultiSendWithAliasRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendWithAliasRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendWithAliasRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, types1.Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, types1.Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendWithAliasRoutingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendWithAliasRoutingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendWithAliasRoutingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,6 +37,9 @@ var msgDocLinks = map[string]struct {
 	"delete-dynamic-store":     {"x-tokenization/messages/msg-delete-dynamic-store", "tx.proto"},
 	"set-dynamic-store-value":  {"x-tokenization/messages/msg-set-dynamic-store-value", "tx.proto"},
 	"cast-vote":                {"", "tx.proto"},
+	"nominate-manager":         {"", "tx.proto"},
+	"accept-manager":           {"", "tx.proto"},
+	"cancel-manager-nomination": {"", "tx.proto"},
 	"update-user-approved-transfers": {"x-tokenization/messages/msg-update-user-approvals", "tx.proto"},
 	// Aliases for set-set* CLI command names
 	"set-setcollectionapprovals": {"x-tokenization/messages/msg-set-collection-approvals", "tx.proto"},
@@ -54,6 +57,7 @@ var queryDocLinks = map[string]struct {
 }{
 	"collection":               {"x-tokenization/queries/get-collection", "query.proto"},
 	"collection-stats":         {"x-tokenization/queries/get-collection", "query.proto"},
+	"pending-manager":          {"", "query.proto"},
 	"balance":                  {"x-tokenization/queries/get-balance", "query.proto"},
 	"balance-for-token":        {"x-tokenization/queries/get-balance", "query.proto"},
 	"address-list":             {"x-tokenization/queries/get-address-list", "query.proto"},
@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetBalance())
 	cmd.AddCommand(CmdGetCollection())
 	cmd.AddCommand(CmdGetCollectionStats())
+	cmd.AddCommand(CmdGetPendingManager())
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdGetAddressList())
 	cmd.AddCommand(CmdGetApprovalTrackers())
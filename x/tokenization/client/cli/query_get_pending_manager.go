@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetPendingManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-manager [collection-id]",
+		Short: "Query the pending manager nomination for a collection",
+		Long:  QueryHelpLinks("pending-manager"),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetPendingManagerRequest{
+				CollectionId: args[0],
+			}
+
+			res, err := queryClient.GetPendingManager(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -48,6 +48,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdSetCollectionApprovals())
 	cmd.AddCommand(CmdSetIsArchived())
 	cmd.AddCommand(CmdCastVote())
+	cmd.AddCommand(CmdNominateManager())
+	cmd.AddCommand(CmdAcceptManager())
+	cmd.AddCommand(CmdCancelManagerNomination())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdNominateManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nominate-manager [collection-id] [nominee] [expires-at]",
+		Short: "Broadcast message nominateManager (nominee must accept before expires-at, in UNIX milliseconds)",
+		Long:  MsgHelpLinks("nominate-manager"),
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCollectionId := types.NewUintFromString(args[0])
+			argNominee := args[1]
+			argExpiresAt := types.NewUintFromString(args[2])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgNominateManager(
+				clientCtx.GetFromAddress().String(),
+				argCollectionId,
+				argNominee,
+				argExpiresAt,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAcceptManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-manager [collection-id]",
+		Short: "Broadcast message acceptManager",
+		Long:  MsgHelpLinks("accept-manager"),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCollectionId := types.NewUintFromString(args[0])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptManager(
+				clientCtx.GetFromAddress().String(),
+				argCollectionId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelManagerNomination() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-manager-nomination [collection-id]",
+		Short: "Broadcast message cancelManagerNomination",
+		Long:  MsgHelpLinks("cancel-manager-nomination"),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCollectionId := types.NewUintFromString(args[0])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelManagerNomination(
+				clientCtx.GetFromAddress().String(),
+				argCollectionId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/keeper"
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_AliasDenomIndex(t *testing.T) {
+	suite := new(TestSuite)
+	suite.SetT(t)
+	suite.SetupTest()
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+
+	aliasPath := func(denom string) *types.AliasPathAddObject {
+		return &types.AliasPathAddObject{
+			Denom: denom,
+			Conversion: &types.ConversionWithoutDenom{
+				SideA: &types.ConversionSideA{Amount: sdkmath.NewUint(1)},
+				SideB: []*types.Balance{
+					{Amount: sdkmath.NewUint(1), OwnershipTimes: GetFullUintRanges(), TokenIds: GetOneUintRange()},
+				},
+			},
+			Symbol:     strings.ToUpper(denom),
+			DenomUnits: []*types.DenomUnit{{Decimals: sdkmath.NewUint(6), Symbol: denom, IsDefaultDisplay: true}},
+		}
+	}
+
+	collectionsToCreate := GetTransferableCollectionToCreateAllMintedToCreator(bob)
+	collectionsToCreate[0].AliasPathsToAdd = []*types.AliasPathAddObject{aliasPath("bcoin"), aliasPath("acoin")}
+	err := CreateCollections(suite, wctx, collectionsToCreate)
+	require.NoError(t, err)
+
+	collectionsToCreate = GetTransferableCollectionToCreateAllMintedToCreator(bob)
+	collectionsToCreate[0].AliasPathsToAdd = []*types.AliasPathAddObject{aliasPath("ccoin")}
+	err = CreateCollections(suite, wctx, collectionsToCreate)
+	require.NoError(t, err)
+
+	collect := func(start string, reverse bool) []string {
+		denoms := []string{}
+		suite.app.TokenizationKeeper.IterateAliasDenoms(ctx, start, reverse, func(aliasDenom string, collectionId sdkmath.Uint) bool {
+			collection, found := suite.app.TokenizationKeeper.GetCollectionFromStore(ctx, collectionId)
+			require.True(t, found)
+			_, err := keeper.GetCorrespondingAliasPath(collection, aliasDenom)
+			require.NoError(t, err)
+			denoms = append(denoms, aliasDenom)
+			return false
+		})
+		return denoms
+	}
+
+	// Alias denoms are iterated in denom order across collections, starting from (and including) start
+	require.Equal(t, []string{"badgeslp:1:acoin", "badgeslp:1:bcoin", "badgeslp:2:ccoin"}, collect("", false))
+	require.Equal(t, []string{"badgeslp:1:bcoin", "badgeslp:2:ccoin"}, collect("badgeslp:1:bcoin", false))
+	require.Equal(t, []string{"badgeslp:2:ccoin", "badgeslp:1:bcoin", "badgeslp:1:acoin"}, collect("", true))
+	require.Equal(t, []string{"badgeslp:1:bcoin", "badgeslp:1:acoin"}, collect("badgeslp:1:bcoin", true))
+
+	// Deleting a collection removes its alias denoms from the index
+	suite.app.TokenizationKeeper.DeleteCollectionFromStore(ctx, sdkmath.NewUint(1))
+	require.Equal(t, []string{"badgeslp:2:ccoin"}, collect("", false))
+}
@@ -94,6 +94,9 @@ func (k Keeper) PurgeCollectionState(ctx sdk.Context, collectionId sdkmath.Uint)
 		k.logger.Error("failed to close eth iterator", "error", err)
 	}
 
+	// 6. Purge any pending manager nomination for this collection
+	k.DeletePendingManagerFromStore(ctx, collectionId)
+
 	// Log the cleanup for monitoring
 	k.logger.Info("purged collection state",
 		"collection_id", collectionIdStr,
@@ -59,4 +59,7 @@ var (
 	ErrInvalidDenomFormat                       = sdkerrors.Register(types.ModuleName, 110, "invalid denom format")
 	ErrAliasPathNotFound                        = sdkerrors.Register(types.ModuleName, 111, "alias path not found for denom")
 	ErrInvariantsImmutable                      = sdkerrors.Register(types.ModuleName, 112, "invariants are immutable after collection creation")
+	ErrPendingManagerNotFound                   = sdkerrors.Register(types.ModuleName, 113, "no pending manager nomination for collection")
+	ErrPendingManagerExpired                    = sdkerrors.Register(types.ModuleName, 114, "pending manager nomination has expired")
+	ErrSenderIsNotPendingManager                = sdkerrors.Register(types.ModuleName, 115, "sender of tx is not the nominated manager of the collection")
 )
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+)
+
+// GetPendingManager returns the pending manager nomination for a collection.
+func (k Keeper) GetPendingManager(goCtx context.Context, req *types.QueryGetPendingManagerRequest) (*types.QueryGetPendingManagerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	collectionId, err := sdkmath.ParseUint(req.CollectionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid collection ID")
+	}
+
+	pendingManager, found := k.GetPendingManagerFromStore(ctx, collectionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no pending manager nomination")
+	}
+
+	return &types.QueryGetPendingManagerResponse{
+		PendingManager: pendingManager,
+	}, nil
+}
@@ -33,6 +33,8 @@ var (
 	VotingTrackerKey           = []byte{0x14}
 	CollectionStatsKey         = []byte{0x15}
 	VotingChallengeTrackerKey  = []byte{0x16}
+	PendingManagerKey          = []byte{0x17}
+	AliasDenomIndexKey         = []byte{0x18}
 
 	WrapperPathGenerationPrefix = []byte{0x0C}
 	BackedPathGenerationPrefix  = []byte{0x12}
@@ -210,6 +212,11 @@ func userBalanceStoreKey(balanceKey string) []byte {
 	return storeKey(UserBalanceKey, balanceKey)
 }
 
+// aliasDenomIndexStoreKey returns the byte representation of the alias denom index key ([]byte{0x18} + alias denom)
+func aliasDenomIndexStoreKey(aliasDenom string) []byte {
+	return storeKey(AliasDenomIndexKey, aliasDenom)
+}
+
 func usedClaimChallengeStoreKey(usedClaimChallengeKey string) []byte {
 	return storeKey(UsedClaimChallengeKey, usedClaimChallengeKey)
 }
@@ -285,3 +292,11 @@ func collectionStatsStoreKey(collectionId sdkmath.Uint) []byte {
 	binary.BigEndian.PutUint64(key[len(CollectionStatsKey):], collectionId.Uint64())
 	return key
 }
+
+// pendingManagerStoreKey returns the byte representation of the pending manager key ([]byte{0x17} + collectionId as 8-byte big-endian)
+func pendingManagerStoreKey(collectionId sdkmath.Uint) []byte {
+	key := make([]byte, len(PendingManagerKey)+IDLength)
+	copy(key, PendingManagerKey)
+	binary.BigEndian.PutUint64(key[len(PendingManagerKey):], collectionId.Uint64())
+	return key
+}
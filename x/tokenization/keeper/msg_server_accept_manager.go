@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AcceptManager completes a two-step manager handoff by making the nominee the manager.
+func (k msgServer) AcceptManager(goCtx context.Context, msg *types.MsgAcceptManager) (*types.MsgAcceptManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	collection, found := k.GetCollectionFromStore(ctx, msg.CollectionId)
+	if !found {
+		return nil, ErrCollectionNotExists
+	}
+
+	pendingManager, found := k.GetPendingManagerFromStore(ctx, msg.CollectionId)
+	if !found {
+		return nil, errorsmod.Wrapf(ErrPendingManagerNotFound, "collection ID %s", msg.CollectionId.String())
+	}
+
+	if pendingManager.Nominee != msg.Creator {
+		return nil, errorsmod.Wrapf(ErrSenderIsNotPendingManager, "nominee is %s but got %s", pendingManager.Nominee, msg.Creator)
+	}
+
+	now := sdkmath.NewUint(uint64(ctx.BlockTime().UnixMilli()))
+	if now.GT(pendingManager.ExpiresAt) {
+		return nil, errorsmod.Wrapf(ErrPendingManagerExpired, "expired at %s (current time %s)", pendingManager.ExpiresAt, now)
+	}
+
+	if types.GetIsArchived(ctx, collection) {
+		return nil, errorsmod.Wrapf(ErrCollectionIsArchived, "collection ID %s is currently archived (read-only)", msg.CollectionId.String())
+	}
+
+	// The nomination is only valid while the nominating manager is still the manager
+	if types.GetCurrentManager(ctx, collection) != pendingManager.NominatedBy {
+		return nil, errorsmod.Wrapf(ErrPendingManagerNotFound, "manager changed since nomination for collection ID %s", msg.CollectionId.String())
+	}
+
+	collection.Manager = pendingManager.Nominee
+	if err := k.SetCollectionInStore(ctx, collection, false); err != nil {
+		return nil, err
+	}
+	k.DeletePendingManagerFromStore(ctx, msg.CollectionId)
+
+	msgStr, err := MarshalMessageForEvent(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	EmitMessageAndIndexerEvents(ctx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, "tokenization"),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute("msg_type", "accept_manager"),
+		sdk.NewAttribute("msg", msgStr),
+	)
+
+	return &types.MsgAcceptManagerResponse{
+		CollectionId: msg.CollectionId,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelManagerNomination cancels a pending manager nomination. Either the current manager or the nominee (declining) can cancel.
+func (k msgServer) CancelManagerNomination(goCtx context.Context, msg *types.MsgCancelManagerNomination) (*types.MsgCancelManagerNominationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	collection, found := k.GetCollectionFromStore(ctx, msg.CollectionId)
+	if !found {
+		return nil, ErrCollectionNotExists
+	}
+
+	pendingManager, found := k.GetPendingManagerFromStore(ctx, msg.CollectionId)
+	if !found {
+		return nil, errorsmod.Wrapf(ErrPendingManagerNotFound, "collection ID %s", msg.CollectionId.String())
+	}
+
+	if msg.Creator != pendingManager.Nominee {
+		if err := k.UniversalValidate(ctx, collection, UniversalValidationParams{
+			Creator:       msg.Creator,
+			MustBeManager: true,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeletePendingManagerFromStore(ctx, msg.CollectionId)
+
+	msgStr, err := MarshalMessageForEvent(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	EmitMessageAndIndexerEvents(ctx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, "tokenization"),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute("msg_type", "cancel_manager_nomination"),
+		sdk.NewAttribute("msg", msgStr),
+	)
+
+	return &types.MsgCancelManagerNominationResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NominateManager starts a two-step manager handoff. The manager only changes once the nominee accepts.
+func (k msgServer) NominateManager(goCtx context.Context, msg *types.MsgNominateManager) (*types.MsgNominateManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	collection, found := k.GetCollectionFromStore(ctx, msg.CollectionId)
+	if !found {
+		return nil, ErrCollectionNotExists
+	}
+
+	if err := k.UniversalValidate(ctx, collection, UniversalValidationParams{
+		Creator:       msg.Creator,
+		MustBeManager: true,
+	}); err != nil {
+		return nil, err
+	}
+
+	if types.GetIsArchived(ctx, collection) {
+		return nil, errorsmod.Wrapf(ErrCollectionIsArchived, "collection ID %s is currently archived (read-only)", msg.CollectionId.String())
+	}
+
+	currManager := types.GetCurrentManager(ctx, collection)
+	if msg.Nominee == currManager {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "nominee %s is already the manager", msg.Nominee)
+	}
+
+	// canUpdateManager is enforced at nomination time
+	if err := k.ValidateManagerUpdate(ctx, currManager, msg.Nominee, collection.CollectionPermissions.CanUpdateManager); err != nil {
+		return nil, err
+	}
+
+	now := sdkmath.NewUint(uint64(ctx.BlockTime().UnixMilli()))
+	if msg.ExpiresAt.LTE(now) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "expiresAt %s must be in the future (current time %s)", msg.ExpiresAt, now)
+	}
+
+	// A new nomination replaces any existing one
+	if err := k.SetPendingManagerInStore(ctx, &types.PendingManager{
+		CollectionId: msg.CollectionId,
+		Nominee:      msg.Nominee,
+		NominatedBy:  currManager,
+		ExpiresAt:    msg.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	msgStr, err := MarshalMessageForEvent(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	EmitMessageAndIndexerEvents(ctx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, "tokenization"),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute("msg_type", "nominate_manager"),
+		sdk.NewAttribute("msg", msgStr),
+	)
+
+	return &types.MsgNominateManagerResponse{
+		CollectionId: msg.CollectionId,
+	}, nil
+}
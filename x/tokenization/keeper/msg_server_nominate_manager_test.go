@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/keeper"
+	"github.com/bitbadges/bitbadgeschain/x/tokenization/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *TestSuite) TestNominateAndAcceptManager() {
+	suite.ctx = suite.ctx.WithBlockTime(time.UnixMilli(1_000_000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	err := CreateCollections(suite, wctx, GetCollectionsToCreate())
+	suite.Require().Nil(err, "Error creating token")
+
+	expiresAt := sdkmath.NewUint(1_000_000 + 60_000)
+	_, err = suite.msgServer.NominateManager(wctx, types.NewMsgNominateManager(bob, sdkmath.NewUint(1), alice, expiresAt))
+	suite.Require().Nil(err, "Error nominating manager")
+
+	// Manager does not change until the nominee accepts
+	collection, _ := GetCollection(suite, wctx, sdkmath.NewUint(1))
+	suite.Require().Equal(bob, types.GetCurrentManager(suite.ctx, collection))
+
+	res, err := suite.app.TokenizationKeeper.GetPendingManager(wctx, &types.QueryGetPendingManagerRequest{CollectionId: "1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(alice, res.PendingManager.Nominee)
+	suite.Require().Equal(bob, res.PendingManager.NominatedBy)
+
+	// Only the nominee can accept
+	_, err = suite.msgServer.AcceptManager(wctx, types.NewMsgAcceptManager(charlie, sdkmath.NewUint(1)))
+	suite.Require().ErrorIs(err, keeper.ErrSenderIsNotPendingManager)
+
+	_, err = suite.msgServer.AcceptManager(wctx, types.NewMsgAcceptManager(alice, sdkmath.NewUint(1)))
+	suite.Require().Nil(err, "Error accepting manager")
+
+	collection, _ = GetCollection(suite, wctx, sdkmath.NewUint(1))
+	suite.Require().Equal(alice, types.GetCurrentManager(suite.ctx, collection))
+
+	_, found := suite.app.TokenizationKeeper.GetPendingManagerFromStore(suite.ctx, sdkmath.NewUint(1))
+	suite.Require().False(found, "Pending manager should be cleared after acceptance")
+}
+
+func (suite *TestSuite) TestAcceptManagerAfterDeadlineFails() {
+	suite.ctx = suite.ctx.WithBlockTime(time.UnixMilli(1_000_000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	err := CreateCollections(suite, wctx, GetCollectionsToCreate())
+	suite.Require().Nil(err, "Error creating token")
+
+	_, err = suite.msgServer.NominateManager(wctx, types.NewMsgNominateManager(bob, sdkmath.NewUint(1), alice, sdkmath.NewUint(1_000_000+60_000)))
+	suite.Require().Nil(err, "Error nominating manager")
+
+	laterCtx := suite.ctx.WithBlockTime(time.UnixMilli(1_000_000 + 60_001))
+	_, err = suite.msgServer.AcceptManager(sdk.WrapSDKContext(laterCtx), types.NewMsgAcceptManager(alice, sdkmath.NewUint(1)))
+	suite.Require().ErrorIs(err, keeper.ErrPendingManagerExpired)
+
+	// Nominations must expire in the future
+	_, err = suite.msgServer.NominateManager(sdk.WrapSDKContext(laterCtx), types.NewMsgNominateManager(bob, sdkmath.NewUint(1), alice, sdkmath.NewUint(1_000_000)))
+	suite.Require().Error(err)
+}
+
+func (suite *TestSuite) TestCancelManagerNomination() {
+	suite.ctx = suite.ctx.WithBlockTime(time.UnixMilli(1_000_000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	err := CreateCollections(suite, wctx, GetCollectionsToCreate())
+	suite.Require().Nil(err, "Error creating token")
+
+	_, err = suite.msgServer.NominateManager(wctx, types.NewMsgNominateManager(bob, sdkmath.NewUint(1), alice, sdkmath.NewUint(1_000_000+60_000)))
+	suite.Require().Nil(err, "Error nominating manager")
+
+	// Unrelated addresses cannot cancel
+	_, err = suite.msgServer.CancelManagerNomination(wctx, types.NewMsgCancelManagerNomination(charlie, sdkmath.NewUint(1)))
+	suite.Require().ErrorIs(err, keeper.ErrSenderIsNotManager)
+
+	_, err = suite.msgServer.CancelManagerNomination(wctx, types.NewMsgCancelManagerNomination(bob, sdkmath.NewUint(1)))
+	suite.Require().Nil(err, "Manager should be able to cancel")
+
+	_, err = suite.msgServer.AcceptManager(wctx, types.NewMsgAcceptManager(alice, sdkmath.NewUint(1)))
+	suite.Require().ErrorIs(err, keeper.ErrPendingManagerNotFound)
+}
+
+func (suite *TestSuite) TestNominateManagerChecksCanUpdateManager() {
+	suite.ctx = suite.ctx.WithBlockTime(time.UnixMilli(1_000_000))
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	collectionsToCreate := GetCollectionsToCreate()
+	collectionsToCreate[0].Permissions = &types.CollectionPermissions{
+		CanUpdateManager: []*types.ActionPermission{
+			{
+				PermanentlyForbiddenTimes: GetFullUintRanges(),
+			},
+		},
+	}
+
+	err := CreateCollections(suite, wctx, collectionsToCreate)
+	suite.Require().Nil(err, "Error creating token")
+
+	_, err = suite.msgServer.NominateManager(wctx, types.NewMsgNominateManager(bob, sdkmath.NewUint(1), alice, sdkmath.NewUint(1_000_000+60_000)))
+	suite.Require().Error(err, "Nomination should fail when canUpdateManager is forbidden")
+
+	// Non-managers cannot nominate
+	_, err = suite.msgServer.NominateManager(wctx, types.NewMsgNominateManager(alice, sdkmath.NewUint(1), alice, sdkmath.NewUint(1_000_000+60_000)))
+	suite.Require().ErrorIs(err, keeper.ErrSenderIsNotManager)
+}
@@ -409,6 +409,10 @@ func (k msgServer) UniversalUpdateCollection(goCtx context.Context, msg *types.M
 		if err := k.ValidateManagerUpdate(ctx, collection.Manager, msg.Manager, collection.CollectionPermissions.CanUpdateManager); err != nil {
 			return nil, err
 		}
+		// Any direct manager change supersedes a pending nomination
+		if collection.Manager != msg.Manager {
+			k.DeletePendingManagerFromStore(ctx, collection.CollectionId)
+		}
 		collection.Manager = msg.Manager
 	}
 
@@ -81,6 +81,7 @@ func (k Keeper) SetCollectionInStore(ctx sdk.Context, collection *types.TokenCol
 
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, []byte{})
+	k.updateAliasDenomIndex(ctx, store, collection)
 	store.Set(collectionStoreKey(collection.CollectionId), marshaled_token)
 	return nil
 }
@@ -128,9 +129,78 @@ func (k Keeper) StoreHasCollectionID(ctx sdk.Context, collectionId sdkmath.Uint)
 func (k Keeper) DeleteCollectionFromStore(ctx sdk.Context, collectionId sdkmath.Uint) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, []byte{})
+	if collection, found := k.GetCollectionFromStore(ctx, collectionId); found {
+		for _, aliasDenom := range aliasDenomsOfCollection(collection) {
+			store.Delete(aliasDenomIndexStoreKey(aliasDenom))
+		}
+	}
 	store.Delete(collectionStoreKey(collectionId))
 }
 
+/****************************************ALIAS DENOM INDEX****************************************/
+
+// aliasDenomsOfCollection returns the alias denom (badgeslp:<collectionId>:<denom>) of every alias path of a collection
+func aliasDenomsOfCollection(collection *types.TokenCollection) []string {
+	aliasDenoms := []string{}
+	for _, path := range collection.AliasPaths {
+		if path == nil || path.Denom == "" {
+			continue
+		}
+		aliasDenoms = append(aliasDenoms, AliasDenomPrefix+collection.CollectionId.String()+":"+path.Denom)
+	}
+	return aliasDenoms
+}
+
+// updateAliasDenomIndex replaces the alias denom index entries of the stored version of a collection with those of the new version.
+// The index is keyed by the full alias denom so alias denoms can be iterated in denom order without loading every collection.
+// Existing collections are indexed when they are rewritten by the collection migration.
+func (k Keeper) updateAliasDenomIndex(ctx sdk.Context, store storetypes.KVStore, collection *types.TokenCollection) {
+	// The stored version may still be in a previous format during migrations, in which case it has no index entries yet
+	var previous types.TokenCollection
+	if bz := store.Get(collectionStoreKey(collection.CollectionId)); len(bz) > 0 && k.cdc.Unmarshal(bz, &previous) == nil {
+		for _, aliasDenom := range aliasDenomsOfCollection(&previous) {
+			store.Delete(aliasDenomIndexStoreKey(aliasDenom))
+		}
+	}
+	for _, aliasDenom := range aliasDenomsOfCollection(collection) {
+		store.Set(aliasDenomIndexStoreKey(aliasDenom), []byte(collection.CollectionId.String()))
+	}
+}
+
+// IterateAliasDenoms iterates over the indexed alias denoms in denom order, starting from (and including) start.
+// An empty start iterates from the first (or, in reverse, the last) alias denom. The callback returns true to stop.
+func (k Keeper) IterateAliasDenoms(ctx sdk.Context, start string, reverse bool, cb func(aliasDenom string, collectionId sdkmath.Uint) (stop bool)) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+
+	var iterator storetypes.Iterator
+	if reverse {
+		end := storetypes.PrefixEndBytes(AliasDenomIndexKey)
+		if start != "" {
+			// The start denom itself is included, but not longer denoms that share it as a prefix
+			end = append(aliasDenomIndexStoreKey(start), 0x00)
+		}
+		iterator = store.ReverseIterator(AliasDenomIndexKey, end)
+	} else {
+		iterator = store.Iterator(aliasDenomIndexStoreKey(start), storetypes.PrefixEndBytes(AliasDenomIndexKey))
+	}
+	defer func() {
+		if err := iterator.Close(); err != nil {
+			k.Logger().Error("failed to close alias denom index iterator", "error", err)
+		}
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		collectionId, err := sdkmath.ParseUint(string(iterator.Value()))
+		if err != nil {
+			continue
+		}
+		if cb(string(iterator.Key()[len(AliasDenomIndexKey):]), collectionId) {
+			return
+		}
+	}
+}
+
 /****************************************USER BALANCES****************************************/
 
 // validateUserBalanceBeforeStore validates a user balance before storing it
@@ -1144,3 +1214,57 @@ func (k Keeper) GetAllCollectionStatsFromStore(ctx sdk.Context) (stats []*types.
 	}
 	return stats, ids
 }
+
+/****************************************PENDING MANAGERS****************************************/
+
+// SetPendingManagerInStore stores a pending manager nomination by collection ID.
+func (k Keeper) SetPendingManagerInStore(ctx sdk.Context, pendingManager *types.PendingManager) error {
+	marshaled, err := k.cdc.Marshal(pendingManager)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal types.PendingManager failed")
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Set(pendingManagerStoreKey(pendingManager.CollectionId), marshaled)
+	return nil
+}
+
+// GetPendingManagerFromStore returns the pending manager nomination for a collection.
+func (k Keeper) GetPendingManagerFromStore(ctx sdk.Context, collectionId sdkmath.Uint) (*types.PendingManager, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	marshaled := store.Get(pendingManagerStoreKey(collectionId))
+
+	var pendingManager types.PendingManager
+	if len(marshaled) == 0 {
+		return &pendingManager, false
+	}
+	k.cdc.MustUnmarshal(marshaled, &pendingManager)
+	return &pendingManager, true
+}
+
+// DeletePendingManagerFromStore deletes the pending manager nomination for a collection.
+func (k Keeper) DeletePendingManagerFromStore(ctx sdk.Context, collectionId sdkmath.Uint) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Delete(pendingManagerStoreKey(collectionId))
+}
+
+// GetAllPendingManagersFromStore returns all pending manager nominations.
+func (k Keeper) GetAllPendingManagersFromStore(ctx sdk.Context) (pendingManagers []*types.PendingManager) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	iterator := storetypes.KVStorePrefixIterator(store, PendingManagerKey)
+	defer func() {
+		if err := iterator.Close(); err != nil {
+			k.Logger().Error("failed to close pending manager iterator", "error", err)
+		}
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingManager types.PendingManager
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingManager)
+		pendingManagers = append(pendingManagers, &pendingManager)
+	}
+	return pendingManagers
+}
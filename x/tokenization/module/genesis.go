@@ -138,6 +138,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+
+	// Initialize pending manager nominations
+	for _, pendingManager := range genState.PendingManagers {
+		if err := k.SetPendingManagerInStore(ctx, pendingManager); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -198,6 +205,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Export reserved protocol addresses
 	genesis.ReservedProtocolAddresses = k.GetAllReservedProtocolAddressesFromStore(ctx)
 
+	// Export pending manager nominations
+	genesis.PendingManagers = k.GetAllPendingManagersFromStore(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
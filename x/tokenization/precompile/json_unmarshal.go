@@ -32,6 +32,9 @@ var messageTypeToMethodMap = map[string]string{
 	"setDynamicStoreValue":      SetDynamicStoreValueMethod,
 	"setValidTokenIds":          SetValidTokenIdsMethod,
 	"setManager":                SetManagerMethod,
+	"nominateManager":           NominateManagerMethod,
+	"acceptManager":             AcceptManagerMethod,
+	"cancelManagerNomination":   CancelManagerNominationMethod,
 	"setCollectionMetadata":     SetCollectionMetadataMethod,
 	"setTokenMetadata":          SetTokenMetadataMethod,
 	"setCustomData":             SetCustomDataMethod,
@@ -127,6 +130,12 @@ func (p Precompile) unmarshalMsgFromJSON(methodName string, jsonStr string, cont
 		msg = &tokenizationtypes.MsgSetValidTokenIds{}
 	case SetManagerMethod:
 		msg = &tokenizationtypes.MsgSetManager{}
+	case NominateManagerMethod:
+		msg = &tokenizationtypes.MsgNominateManager{}
+	case AcceptManagerMethod:
+		msg = &tokenizationtypes.MsgAcceptManager{}
+	case CancelManagerNominationMethod:
+		msg = &tokenizationtypes.MsgCancelManagerNomination{}
 	case SetCollectionMetadataMethod:
 		msg = &tokenizationtypes.MsgSetCollectionMetadata{}
 	case SetTokenMetadataMethod:
@@ -259,6 +268,16 @@ func (p Precompile) unmarshalMsgFromJSON(methodName string, jsonStr string, cont
 		if m.Manager != "" {
 			m.Manager = convertEVMAddressToBech32(m.Manager)
 		}
+	case *tokenizationtypes.MsgNominateManager:
+		m.Creator = creatorCosmosAddr
+		// Convert Nominee from EVM to bech32 format
+		if m.Nominee != "" {
+			m.Nominee = convertEVMAddressToBech32(m.Nominee)
+		}
+	case *tokenizationtypes.MsgAcceptManager:
+		m.Creator = creatorCosmosAddr
+	case *tokenizationtypes.MsgCancelManagerNomination:
+		m.Creator = creatorCosmosAddr
 	case *tokenizationtypes.MsgSetCollectionMetadata:
 		m.Creator = creatorCosmosAddr
 	case *tokenizationtypes.MsgSetTokenMetadata:
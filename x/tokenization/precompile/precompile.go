@@ -68,6 +68,9 @@ const (
 	GasSetDynamicStoreValueBase      = 15_000
 	GasSetValidTokenIdsBase          = 20_000
 	GasSetManagerBase                = 15_000
+	GasNominateManagerBase           = 15_000
+	GasAcceptManagerBase             = 15_000
+	GasCancelManagerNominationBase   = 10_000
 	GasSetCollectionMetadataBase     = 15_000
 	GasSetTokenMetadataBase          = 20_000
 	GasSetCustomDataBase             = 15_000
@@ -259,6 +262,15 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	case SetManagerMethod:
 		baseGas = GasSetManagerBase
 		isTransaction = true
+	case NominateManagerMethod:
+		baseGas = GasNominateManagerBase
+		isTransaction = true
+	case AcceptManagerMethod:
+		baseGas = GasAcceptManagerBase
+		isTransaction = true
+	case CancelManagerNominationMethod:
+		baseGas = GasCancelManagerNominationBase
+		isTransaction = true
 	case SetCollectionMetadataMethod:
 		baseGas = GasSetCollectionMetadataBase
 		isTransaction = true
@@ -680,6 +692,29 @@ func (p Precompile) HandleTransaction(ctx sdk.Context, method *abi.Method, jsonS
 		} else {
 			result = resp.CollectionId.BigInt() // ABI: uint256 collectionId
 		}
+	case *tokenizationtypes.MsgNominateManager:
+		resp, nominateErr := msgServer.NominateManager(ctx, m)
+		err = nominateErr
+		if err != nil {
+			// Error will be handled below
+		} else if resp == nil {
+			return nil, WrapError(fmt.Errorf("response is nil"), ErrorCodeInternalError, "NominateManager returned nil response")
+		} else {
+			result = resp.CollectionId.BigInt() // ABI: uint256 collectionId
+		}
+	case *tokenizationtypes.MsgAcceptManager:
+		resp, acceptErr := msgServer.AcceptManager(ctx, m)
+		err = acceptErr
+		if err != nil {
+			// Error will be handled below
+		} else if resp == nil {
+			return nil, WrapError(fmt.Errorf("response is nil"), ErrorCodeInternalError, "AcceptManager returned nil response")
+		} else {
+			result = resp.CollectionId.BigInt() // ABI: uint256 collectionId
+		}
+	case *tokenizationtypes.MsgCancelManagerNomination:
+		_, err = msgServer.CancelManagerNomination(ctx, m)
+		result = true // ABI: bool success
 	case *tokenizationtypes.MsgSetCollectionMetadata:
 		resp, setErr := msgServer.SetCollectionMetadata(ctx, m)
 		err = setErr
@@ -984,6 +1019,39 @@ func (p Precompile) HandleExecuteMultiple(ctx sdk.Context, method *abi.Method, m
 				}
 				result = resp.CollectionId.BigInt()
 			}
+		case *tokenizationtypes.MsgNominateManager:
+			resp, nominateErr := msgServer.NominateManager(ctx, m)
+			err = nominateErr
+			if err == nil {
+				if resp == nil {
+					return nil, WrapErrorWithContext(
+						fmt.Errorf("response is nil"),
+						ErrorCodeInternalError,
+						"NominateManager returned nil response",
+						fmt.Sprintf("message index %d", i),
+					)
+				}
+				result = resp.CollectionId.BigInt()
+			}
+		case *tokenizationtypes.MsgAcceptManager:
+			resp, acceptErr := msgServer.AcceptManager(ctx, m)
+			err = acceptErr
+			if err == nil {
+				if resp == nil {
+					return nil, WrapErrorWithContext(
+						fmt.Errorf("response is nil"),
+						ErrorCodeInternalError,
+						"AcceptManager returned nil response",
+						fmt.Sprintf("message index %d", i),
+					)
+				}
+				result = resp.CollectionId.BigInt()
+			}
+		case *tokenizationtypes.MsgCancelManagerNomination:
+			_, err = msgServer.CancelManagerNomination(ctx, m)
+			if err == nil {
+				result = true
+			}
 		case *tokenizationtypes.MsgSetCollectionMetadata:
 			resp, setErr := msgServer.SetCollectionMetadata(ctx, m)
 			err = setErr
@@ -2046,6 +2114,9 @@ var transactionMethods = map[string]bool{
 	SetDynamicStoreValueMethod:      true,
 	SetValidTokenIdsMethod:          true,
 	SetManagerMethod:                true,
+	NominateManagerMethod:           true,
+	AcceptManagerMethod:             true,
+	CancelManagerNominationMethod:   true,
 	SetCollectionMetadataMethod:     true,
 	SetTokenMetadataMethod:          true,
 	SetCustomDataMethod:             true,
@@ -2083,6 +2154,9 @@ const (
 	SetDynamicStoreValueMethod      = "setDynamicStoreValue"
 	SetValidTokenIdsMethod          = "setValidTokenIds"
 	SetManagerMethod                = "setManager"
+	NominateManagerMethod           = "nominateManager"
+	AcceptManagerMethod             = "acceptManager"
+	CancelManagerNominationMethod   = "cancelManagerNomination"
 	SetCollectionMetadataMethod     = "setCollectionMetadata"
 	SetTokenMetadataMethod          = "setTokenMetadata"
 	SetCustomDataMethod             = "setCustomData"
@@ -426,6 +426,34 @@ func BuildSetManagerJSON(creator string, collectionId *big.Int, manager string)
 	return string(jsonBytes), nil
 }
 
+// BuildNominateManagerJSON builds a JSON string for nominateManager transaction
+func BuildNominateManagerJSON(creator string, collectionId *big.Int, nominee string, expiresAt *big.Int) (string, error) {
+	msg := map[string]interface{}{
+		"creator":      creator,
+		"collectionId": collectionId.String(),
+		"nominee":      nominee,
+		"expiresAt":    expiresAt.String(),
+	}
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// BuildManagerNominationJSON builds a JSON string for acceptManager and cancelManagerNomination transactions
+func BuildManagerNominationJSON(creator string, collectionId *big.Int) (string, error) {
+	msg := map[string]interface{}{
+		"creator":      creator,
+		"collectionId": collectionId.String(),
+	}
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
 // BuildSetCollectionMetadataJSON builds a JSON string for setCollectionMetadata transaction
 func BuildSetCollectionMetadataJSON(creator string, collectionId *big.Int, metadata map[string]interface{}) (string, error) {
 	msg := map[string]interface{}{
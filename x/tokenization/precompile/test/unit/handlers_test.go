@@ -3,6 +3,7 @@ package tokenization_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -291,6 +292,82 @@ func (suite *HandlersTestSuite) TestSetManager_Valid() {
 	suite.Equal(suite.TestSuite.Bob.String(), resp.Collection.Manager)
 }
 
+func (suite *HandlersTestSuite) TestNominateAndAcceptManager_Valid() {
+	collectionId, err := suite.TestSuite.CreateTestCollection(suite.TestSuite.Alice.String())
+	suite.NoError(err)
+	suite.TestSuite.Ctx = suite.TestSuite.Ctx.WithBlockTime(time.Now())
+
+	// Alice nominates Bob (by EVM address)
+	expiresAt := big.NewInt(suite.TestSuite.Ctx.BlockTime().Add(time.Hour).UnixMilli())
+	jsonMsg, err := helpers.BuildNominateManagerJSON(suite.TestSuite.Alice.String(), collectionId.BigInt(), suite.TestSuite.BobEVM.Hex(), expiresAt)
+	suite.NoError(err)
+
+	method := suite.Precompile.ABI.Methods["nominateManager"]
+	input, err := helpers.PackMethodWithJSON(&method, jsonMsg)
+	suite.NoError(err)
+
+	contract := suite.TestSuite.CreateMockContract(suite.TestSuite.AliceEVM, input)
+	result, err := suite.Precompile.Execute(suite.TestSuite.Ctx, contract, false)
+	suite.NoError(err)
+	suite.NotNil(result)
+
+	pendingManager, found := suite.TestSuite.Keeper.GetPendingManagerFromStore(suite.TestSuite.Ctx, collectionId)
+	suite.True(found)
+	suite.Equal(suite.TestSuite.Bob.String(), pendingManager.Nominee)
+
+	// Bob accepts
+	jsonMsg, err = helpers.BuildManagerNominationJSON(suite.TestSuite.Bob.String(), collectionId.BigInt())
+	suite.NoError(err)
+
+	method = suite.Precompile.ABI.Methods["acceptManager"]
+	input, err = helpers.PackMethodWithJSON(&method, jsonMsg)
+	suite.NoError(err)
+
+	contract = suite.TestSuite.CreateMockContract(suite.TestSuite.BobEVM, input)
+	result, err = suite.Precompile.Execute(suite.TestSuite.Ctx, contract, false)
+	suite.NoError(err)
+	suite.NotNil(result)
+
+	resp, err := suite.TestSuite.Keeper.GetCollection(suite.TestSuite.Ctx, &tokenizationtypes.QueryGetCollectionRequest{
+		CollectionId: collectionId.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(suite.TestSuite.Bob.String(), resp.Collection.Manager)
+}
+
+func (suite *HandlersTestSuite) TestCancelManagerNomination_Valid() {
+	collectionId, err := suite.TestSuite.CreateTestCollection(suite.TestSuite.Alice.String())
+	suite.NoError(err)
+	suite.TestSuite.Ctx = suite.TestSuite.Ctx.WithBlockTime(time.Now())
+
+	expiresAt := big.NewInt(suite.TestSuite.Ctx.BlockTime().Add(time.Hour).UnixMilli())
+	jsonMsg, err := helpers.BuildNominateManagerJSON(suite.TestSuite.Alice.String(), collectionId.BigInt(), suite.TestSuite.Bob.String(), expiresAt)
+	suite.NoError(err)
+
+	method := suite.Precompile.ABI.Methods["nominateManager"]
+	input, err := helpers.PackMethodWithJSON(&method, jsonMsg)
+	suite.NoError(err)
+
+	contract := suite.TestSuite.CreateMockContract(suite.TestSuite.AliceEVM, input)
+	_, err = suite.Precompile.Execute(suite.TestSuite.Ctx, contract, false)
+	suite.NoError(err)
+
+	jsonMsg, err = helpers.BuildManagerNominationJSON(suite.TestSuite.Alice.String(), collectionId.BigInt())
+	suite.NoError(err)
+
+	method = suite.Precompile.ABI.Methods["cancelManagerNomination"]
+	input, err = helpers.PackMethodWithJSON(&method, jsonMsg)
+	suite.NoError(err)
+
+	contract = suite.TestSuite.CreateMockContract(suite.TestSuite.AliceEVM, input)
+	result, err := suite.Precompile.Execute(suite.TestSuite.Ctx, contract, false)
+	suite.NoError(err)
+	suite.NotNil(result)
+
+	_, found := suite.TestSuite.Keeper.GetPendingManagerFromStore(suite.TestSuite.Ctx, collectionId)
+	suite.False(found)
+}
+
 func (suite *HandlersTestSuite) TestSetCollectionMetadata_Valid() {
 	collectionId, err := suite.TestSuite.CreateTestCollection(suite.TestSuite.Alice.String())
 	suite.NoError(err)
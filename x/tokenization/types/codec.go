@@ -40,6 +40,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetIsArchived{}, "tokenization/SetIsArchived", nil)
 	cdc.RegisterConcrete(&MsgSetReservedProtocolAddress{}, "tokenization/SetReservedProtocolAddress", nil)
 	cdc.RegisterConcrete(&MsgCastVote{}, "tokenization/CastVote", nil)
+	cdc.RegisterConcrete(&MsgNominateManager{}, "tokenization/NominateManager", nil)
+	cdc.RegisterConcrete(&MsgAcceptManager{}, "tokenization/AcceptManager", nil)
+	cdc.RegisterConcrete(&MsgCancelManagerNomination{}, "tokenization/CancelManagerNomination", nil)
 
 	encodingcodec.RegisterLegacyAminoCodec(cdc)
 	// this line is used by starport scaffolding # 2
@@ -73,6 +76,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetIsArchived{},
 		&MsgSetReservedProtocolAddress{},
 		&MsgCastVote{},
+		&MsgNominateManager{},
+		&MsgAcceptManager{},
+		&MsgCancelManagerNomination{},
 	)
 	// this line is used by starport scaffolding # 3
 	encodingcodec.RegisterInterfaces(registry)
@@ -850,6 +850,65 @@ func (m *CollectionStats) GetBalances() []*Balance {
 	return nil
 }
 
+// PendingManager is a manager nomination awaiting acceptance by the nominee.
+type PendingManager struct {
+	// ID of the collection.
+	CollectionId Uint `protobuf:"bytes,1,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+	// Address of the nominated manager.
+	Nominee string `protobuf:"bytes,2,opt,name=nominee,proto3" json:"nominee,omitempty"`
+	// Address of the manager that made the nomination.
+	NominatedBy string `protobuf:"bytes,3,opt,name=nominatedBy,proto3" json:"nominatedBy,omitempty"`
+	// Time (UNIX milliseconds) after which the nomination can no longer be accepted.
+	ExpiresAt Uint `protobuf:"bytes,4,opt,name=expiresAt,proto3,customtype=Uint" json:"expiresAt"`
+}
+
+func (m *PendingManager) Reset()         { *m = PendingManager{} }
+func (m *PendingManager) String() string { return proto.CompactTextString(m) }
+func (*PendingManager) ProtoMessage()    {}
+func (*PendingManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecee1d63ef3ca603, []int{11}
+}
+func (m *PendingManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingManager.Merge(m, src)
+}
+func (m *PendingManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingManager proto.InternalMessageInfo
+
+func (m *PendingManager) GetNominee() string {
+	if m != nil {
+		return m.Nominee
+	}
+	return ""
+}
+
+func (m *PendingManager) GetNominatedBy() string {
+	if m != nil {
+		return m.NominatedBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TokenCollection)(nil), "tokenization.TokenCollection")
 	proto.RegisterType((*Conversion)(nil), "tokenization.Conversion")
@@ -862,83 +921,87 @@ func init() {
 	proto.RegisterType((*DenomUnit)(nil), "tokenization.DenomUnit")
 	proto.RegisterType((*CollectionInvariants)(nil), "tokenization.CollectionInvariants")
 	proto.RegisterType((*CollectionStats)(nil), "tokenization.CollectionStats")
+	proto.RegisterType((*PendingManager)(nil), "tokenization.PendingManager")
 }
 
 func init() { proto.RegisterFile("tokenization/collections.proto", fileDescriptor_ecee1d63ef3ca603) }
 
 var fileDescriptor_ecee1d63ef3ca603 = []byte{
-	// 1128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcb, 0x6e, 0x1b, 0x37,
-	0x17, 0xb6, 0x2c, 0xcb, 0x96, 0x8e, 0x9d, 0x38, 0x3f, 0xe3, 0xf8, 0x9f, 0x3a, 0x89, 0x22, 0xa8,
-	0x69, 0x21, 0xb4, 0x85, 0x95, 0x3a, 0x45, 0x92, 0x45, 0x0b, 0x54, 0x97, 0x04, 0xf5, 0xc2, 0xb0,
-	0x4a, 0x5f, 0x82, 0xb6, 0x8b, 0x80, 0x9a, 0xa1, 0x25, 0x36, 0x33, 0xa4, 0x40, 0x52, 0x72, 0x94,
-	0xa7, 0xe8, 0x2b, 0xf4, 0x05, 0x0a, 0xf4, 0x2d, 0xb2, 0xcc, 0xb2, 0xe8, 0xc2, 0x28, 0xec, 0x17,
-	0xe8, 0x23, 0x14, 0xc3, 0xb9, 0xcb, 0x23, 0x27, 0x69, 0x77, 0x43, 0x9e, 0xef, 0x5c, 0xbe, 0x8f,
-	0x67, 0x0e, 0x09, 0x55, 0x2d, 0x5e, 0x52, 0xce, 0x5e, 0x13, 0xcd, 0x04, 0x6f, 0xda, 0xc2, 0x75,
-	0xa9, 0xed, 0x7f, 0xaa, 0xed, 0x91, 0x14, 0x5a, 0xa0, 0xb5, 0xb4, 0x7d, 0xeb, 0xa3, 0x81, 0x10,
-	0x03, 0x97, 0x36, 0x8d, 0xad, 0x3f, 0x3e, 0x69, 0x12, 0x3e, 0x0d, 0x80, 0x5b, 0x77, 0x32, 0x81,
-	0xb4, 0x24, 0x5c, 0x9d, 0x50, 0x19, 0x86, 0xd9, 0xba, 0x9d, 0xb1, 0xf6, 0x89, 0x4b, 0xb8, 0x4d,
-	0x23, 0x63, 0xb6, 0x86, 0x11, 0x95, 0x1e, 0x53, 0x2a, 0xa9, 0x61, 0xc6, 0xd9, 0xa3, 0x9a, 0x38,
-	0x44, 0x93, 0xdc, 0xbc, 0x64, 0x34, 0x92, 0x62, 0x42, 0xdc, 0xc8, 0xf5, 0x93, 0x8c, 0x75, 0xac,
-	0xa8, 0x7c, 0x11, 0x26, 0x7f, 0xa1, 0xb4, 0x90, 0x34, 0x84, 0xdd, 0xcd, 0xaa, 0x30, 0x24, 0xae,
-	0x4b, 0xf9, 0x20, 0x2e, 0x70, 0x63, 0x20, 0x06, 0xc2, 0x7c, 0x36, 0xfd, 0xaf, 0x60, 0xb7, 0xfe,
-	0xdb, 0x0a, 0xac, 0x1f, 0xfa, 0x7e, 0x9d, 0x58, 0x35, 0xf4, 0x00, 0xd6, 0x12, 0x0d, 0x77, 0x1d,
-	0xab, 0x50, 0x2b, 0x34, 0x2a, 0xed, 0xb5, 0x37, 0x67, 0xf7, 0x16, 0xfe, 0x3c, 0xbb, 0xb7, 0x74,
-	0xc4, 0xb8, 0xc6, 0x19, 0x04, 0xea, 0x01, 0x4a, 0xd6, 0x7b, 0x21, 0x37, 0x6b, 0xb1, 0x56, 0x68,
-	0xac, 0xee, 0xd4, 0xb6, 0xd3, 0x75, 0x6d, 0x77, 0x2e, 0xe1, 0x70, 0x8e, 0x2f, 0x6a, 0xc1, 0x35,
-	0xe3, 0x16, 0x07, 0x2b, 0xd6, 0x8a, 0x8d, 0xd5, 0x9d, 0xdb, 0xd9, 0x60, 0x87, 0x69, 0x08, 0xce,
-	0x7a, 0xa0, 0x2a, 0x80, 0x3d, 0x56, 0x5a, 0x78, 0x5d, 0xdf, 0x7f, 0xc9, 0x27, 0x81, 0x53, 0x3b,
-	0xc8, 0x82, 0x15, 0x8f, 0x70, 0x32, 0xa0, 0xd2, 0x2a, 0x19, 0x63, 0xb4, 0x44, 0x3f, 0xc0, 0xad,
-	0xa4, 0xa4, 0x5e, 0x72, 0x94, 0xd6, 0xb2, 0x61, 0xf4, 0xf1, 0x3c, 0x46, 0x29, 0x28, 0xce, 0x8f,
-	0x80, 0x30, 0xdc, 0x4c, 0x0c, 0xad, 0xe8, 0xa0, 0xad, 0x15, 0xc3, 0x6e, 0xae, 0x54, 0x11, 0x10,
-	0xe7, 0x39, 0xa3, 0x3b, 0x50, 0x51, 0x9a, 0x70, 0x87, 0x48, 0x47, 0x59, 0xe5, 0x5a, 0xb1, 0x51,
-	0xc1, 0xc9, 0x86, 0x2f, 0x03, 0x53, 0x2d, 0x69, 0x0f, 0xd9, 0x84, 0x3a, 0x56, 0xa5, 0x56, 0x68,
-	0x94, 0x71, 0x6a, 0x07, 0x7d, 0x07, 0xeb, 0x0e, 0x3d, 0x21, 0x63, 0x57, 0xb7, 0xc3, 0x8e, 0xb6,
-	0xc0, 0xd0, 0xac, 0x66, 0xab, 0x39, 0x52, 0x54, 0x86, 0x88, 0x03, 0xbf, 0xeb, 0xf0, 0xac, 0x9b,
-	0x5f, 0x87, 0x2d, 0x29, 0xd1, 0xd4, 0x69, 0x4f, 0xad, 0x55, 0x23, 0x69, 0xb2, 0x81, 0xbe, 0x81,
-	0x6b, 0x13, 0xe2, 0x32, 0xc7, 0x9c, 0xd9, 0xae, 0xa3, 0xac, 0x35, 0xc3, 0xf9, 0xff, 0x33, 0x59,
-	0xfc, 0xe6, 0x22, 0x7c, 0x40, 0x71, 0x16, 0x8d, 0xbe, 0x80, 0xff, 0x79, 0x8c, 0xeb, 0xa7, 0xca,
-	0x96, 0xe2, 0xb4, 0xe5, 0x38, 0x92, 0x2a, 0x65, 0x5d, 0x33, 0x49, 0x2e, 0x1b, 0xd0, 0x4f, 0xb0,
-	0x69, 0x0b, 0xe5, 0x09, 0xd5, 0x11, 0x8c, 0x3f, 0x97, 0x64, 0x34, 0xa2, 0xb2, 0x47, 0xf4, 0x50,
-	0x59, 0xd7, 0x4d, 0xd6, 0x4b, 0x47, 0x98, 0x83, 0xc5, 0x73, 0x42, 0xa0, 0x36, 0x00, 0xe3, 0x13,
-	0x22, 0x19, 0xe1, 0x5a, 0x59, 0xeb, 0x46, 0xac, 0xfa, 0xbc, 0xa3, 0xdb, 0x8d, 0x91, 0x38, 0xe5,
-	0x85, 0x1e, 0x03, 0x10, 0x97, 0x11, 0x15, 0x14, 0x75, 0x23, 0x4f, 0x8a, 0x56, 0x64, 0xc7, 0x29,
-	0x68, 0xfd, 0x14, 0xa0, 0x23, 0xf8, 0x84, 0x4a, 0xbf, 0x9f, 0xd0, 0xd7, 0x50, 0x52, 0xcc, 0xa1,
-	0x2d, 0xf3, 0x8f, 0xae, 0xee, 0x7c, 0x3a, 0x5b, 0x45, 0x04, 0x3c, 0xf0, 0x41, 0xcf, 0x99, 0x1e,
-	0x76, 0x29, 0x17, 0x1e, 0x0e, 0x9c, 0xd0, 0xe7, 0x81, 0x77, 0xdb, 0x5a, 0x34, 0xf9, 0x6f, 0x65,
-	0xbd, 0xc3, 0x73, 0x0d, 0xc0, 0xed, 0xfa, 0x31, 0x58, 0xf3, 0xe2, 0xa1, 0xfb, 0xb0, 0x4c, 0x3c,
-	0x31, 0xe6, 0x3a, 0x77, 0x56, 0x84, 0x36, 0xb4, 0x01, 0x25, 0xc7, 0x87, 0x9b, 0xc1, 0x50, 0xc1,
-	0xc1, 0xa2, 0xfe, 0x1a, 0x36, 0x93, 0xb8, 0x7e, 0x48, 0x31, 0xd6, 0x41, 0xd4, 0x87, 0x59, 0x72,
-	0x77, 0xaf, 0x24, 0xf7, 0xaf, 0x38, 0x3d, 0x86, 0xf5, 0x99, 0x30, 0xef, 0x47, 0xa5, 0x7e, 0xb6,
-	0x08, 0xb7, 0x72, 0x9b, 0xc6, 0x9f, 0x2a, 0x24, 0xec, 0xce, 0x42, 0x30, 0x55, 0xc2, 0x65, 0x3e,
-	0x7d, 0xd4, 0x05, 0xb0, 0xe3, 0x12, 0xac, 0xa2, 0x61, 0x7a, 0x7f, 0x1e, 0xd3, 0xb4, 0x3c, 0x38,
-	0xe5, 0x87, 0x36, 0x61, 0x59, 0x4d, 0xbd, 0xbe, 0x70, 0xc3, 0x39, 0x17, 0xae, 0xfc, 0x36, 0x33,
-	0x69, 0x8e, 0x38, 0xd3, 0xca, 0x2a, 0xe5, 0xb5, 0x59, 0x37, 0xb2, 0xe3, 0x14, 0x14, 0x3d, 0x83,
-	0x2a, 0x71, 0x5d, 0x71, 0xba, 0x3f, 0xa1, 0x52, 0x32, 0x87, 0xfa, 0x99, 0x5b, 0x7c, 0x7a, 0x1c,
-	0xff, 0x93, 0x66, 0x16, 0x96, 0xf1, 0x3b, 0x50, 0xe8, 0x11, 0x94, 0xa3, 0xbb, 0xce, 0x5a, 0x31,
-	0xe4, 0xb6, 0xb2, 0xe9, 0x7d, 0xd1, 0xe2, 0x09, 0x1e, 0x63, 0xeb, 0x7f, 0x17, 0xa0, 0x12, 0xff,
-	0x00, 0x89, 0x74, 0x85, 0xf9, 0xd2, 0x2d, 0xfe, 0x67, 0xe9, 0x8a, 0x57, 0x48, 0xb7, 0xf4, 0xfe,
-	0xd2, 0xa5, 0x29, 0x97, 0x3e, 0x80, 0xf2, 0xcf, 0xb0, 0x91, 0xb4, 0x54, 0x9b, 0xd8, 0x2f, 0xa9,
-	0xf3, 0x8e, 0x8e, 0x7a, 0x92, 0x23, 0x80, 0x35, 0x4f, 0x80, 0x34, 0xe9, 0xfa, 0xef, 0x05, 0xa8,
-	0xc4, 0xd5, 0xa3, 0x06, 0x94, 0x1d, 0x6a, 0x33, 0xcf, 0xbf, 0x89, 0xf2, 0xba, 0x3e, 0xb6, 0xa6,
-	0xc4, 0x5a, 0xcc, 0x88, 0xf5, 0x19, 0xdc, 0x60, 0xaa, 0x1b, 0xdc, 0x07, 0x5d, 0xa6, 0x46, 0x2e,
-	0x99, 0x1a, 0x39, 0xcb, 0xf8, 0xd2, 0x7e, 0x46, 0x9f, 0xa5, 0x0f, 0xd0, 0xe7, 0xd7, 0xa2, 0x2f,
-	0xd0, 0xe5, 0xb9, 0x8a, 0x1e, 0xc1, 0x26, 0x17, 0x1d, 0x73, 0xb1, 0xef, 0x9f, 0x72, 0x2a, 0xd5,
-	0x90, 0x8d, 0x0e, 0x99, 0x47, 0x03, 0x32, 0x65, 0x3c, 0xc7, 0x8a, 0xbe, 0x82, 0xeb, 0x1e, 0x79,
-	0x75, 0x30, 0x1e, 0x8d, 0xdc, 0x69, 0x8f, 0xca, 0x5d, 0x27, 0x20, 0x35, 0x43, 0x7e, 0x06, 0x83,
-	0x8e, 0x61, 0xc3, 0xce, 0x39, 0xa6, 0xf0, 0xd7, 0xad, 0xcf, 0xbb, 0x58, 0x12, 0x24, 0xce, 0xf5,
-	0x47, 0xdf, 0xc2, 0x6d, 0x2e, 0x9e, 0x09, 0x69, 0xd3, 0x93, 0xb1, 0xdb, 0x13, 0x4a, 0xef, 0x31,
-	0xae, 0x0f, 0xa3, 0x27, 0xa8, 0x51, 0xaa, 0x8c, 0xaf, 0x82, 0xa0, 0x07, 0x70, 0xd3, 0x61, 0x8a,
-	0xf4, 0x5d, 0xda, 0x13, 0xc2, 0xed, 0xf8, 0x37, 0xaf, 0xdf, 0x17, 0x25, 0xe3, 0x99, 0x67, 0x42,
-	0xfb, 0x80, 0xe8, 0xc4, 0xfb, 0x7e, 0x4c, 0xe5, 0xb4, 0x13, 0xbf, 0x17, 0xad, 0x65, 0xd3, 0xeb,
-	0xf7, 0xb2, 0x4c, 0x9e, 0x1e, 0xef, 0x65, 0x71, 0x38, 0xc7, 0xb5, 0xae, 0xfd, 0x81, 0x1a, 0x1d,
-	0xd1, 0x81, 0x26, 0x5a, 0xa1, 0x6d, 0x58, 0x1d, 0x0a, 0xd7, 0xa1, 0xb2, 0x33, 0x77, 0xaa, 0xa6,
-	0x01, 0xe8, 0x4b, 0x28, 0x47, 0x4f, 0xeb, 0xab, 0x67, 0x78, 0x0c, 0x6b, 0xe3, 0x37, 0xe7, 0xd5,
-	0xc2, 0xdb, 0xf3, 0x6a, 0xe1, 0xaf, 0xf3, 0x6a, 0xe1, 0x97, 0x8b, 0xea, 0xc2, 0xdb, 0x8b, 0xea,
-	0xc2, 0x1f, 0x17, 0xd5, 0x85, 0x1f, 0x9f, 0x0c, 0x98, 0x1e, 0x8e, 0xfb, 0xdb, 0xb6, 0xf0, 0x9a,
-	0x7d, 0xa6, 0xfb, 0xc4, 0x19, 0x50, 0x95, 0x7c, 0xd9, 0x43, 0xc2, 0x78, 0xf3, 0x55, 0x33, 0xfb,
-	0xec, 0x9f, 0x8e, 0xa8, 0xea, 0x2f, 0x9b, 0xf7, 0xf1, 0xc3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x6a, 0x0e, 0xc8, 0xea, 0x5c, 0x0c, 0x00, 0x00,
+	// 1178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x6e, 0x1b, 0x37,
+	0x17, 0xb6, 0x2c, 0x5f, 0xa4, 0x63, 0x27, 0xce, 0xcf, 0x38, 0xfe, 0x59, 0x27, 0x51, 0x04, 0x35,
+	0x2d, 0x8c, 0xb4, 0xb0, 0xd2, 0xa4, 0x48, 0xb2, 0x68, 0x81, 0xea, 0x92, 0xa0, 0x5e, 0x18, 0x51,
+	0x69, 0xc7, 0x41, 0xdb, 0x45, 0x40, 0xcd, 0xd0, 0x12, 0x9b, 0x19, 0x52, 0x20, 0x29, 0x39, 0xca,
+	0x53, 0xf4, 0x15, 0xba, 0xed, 0xa2, 0x40, 0xdf, 0x22, 0xcb, 0x2c, 0x8b, 0x2e, 0x82, 0x22, 0x79,
+	0x81, 0x3e, 0x42, 0x31, 0x9c, 0xbb, 0x3c, 0x72, 0xe2, 0x76, 0x37, 0xe4, 0xf9, 0xce, 0xe5, 0xfb,
+	0x78, 0x74, 0x48, 0x41, 0xcd, 0xc8, 0xe7, 0x4c, 0xf0, 0x97, 0xd4, 0x70, 0x29, 0x9a, 0x8e, 0xf4,
+	0x3c, 0xe6, 0x04, 0x9f, 0x7a, 0x77, 0xa4, 0xa4, 0x91, 0x68, 0x3d, 0x6b, 0xdf, 0xfe, 0x68, 0x20,
+	0xe5, 0xc0, 0x63, 0x4d, 0x6b, 0xeb, 0x8f, 0x8f, 0x9b, 0x54, 0x4c, 0x43, 0xe0, 0xf6, 0xb5, 0x5c,
+	0x20, 0xa3, 0xa8, 0xd0, 0xc7, 0x4c, 0x45, 0x61, 0xb6, 0xaf, 0xe6, 0xac, 0x7d, 0xea, 0x51, 0xe1,
+	0xb0, 0xd8, 0x98, 0xaf, 0x61, 0xc4, 0x94, 0xcf, 0xb5, 0x4e, 0x6b, 0x98, 0x71, 0xf6, 0x99, 0xa1,
+	0x2e, 0x35, 0xb4, 0x30, 0x2f, 0x1d, 0x8d, 0x94, 0x9c, 0x50, 0x2f, 0x76, 0xfd, 0x24, 0x67, 0x1d,
+	0x6b, 0xa6, 0x9e, 0x45, 0xc9, 0x9f, 0x69, 0x23, 0x15, 0x8b, 0x60, 0xd7, 0xf3, 0x2a, 0x0c, 0xa9,
+	0xe7, 0x31, 0x31, 0x48, 0x0a, 0xdc, 0x1c, 0xc8, 0x81, 0xb4, 0x9f, 0xcd, 0xe0, 0x2b, 0xdc, 0x6d,
+	0xfc, 0xb6, 0x0a, 0x1b, 0x87, 0x81, 0x5f, 0x27, 0x51, 0x0d, 0xdd, 0x86, 0xf5, 0x54, 0xc3, 0x3d,
+	0x17, 0x97, 0xea, 0xa5, 0x9d, 0x6a, 0x7b, 0xfd, 0xd5, 0x9b, 0x1b, 0x0b, 0x7f, 0xbe, 0xb9, 0xb1,
+	0xf4, 0x84, 0x0b, 0x43, 0x72, 0x08, 0xd4, 0x03, 0x94, 0xae, 0xf7, 0x23, 0x6e, 0x78, 0xb1, 0x5e,
+	0xda, 0x59, 0xbb, 0x53, 0xdf, 0xcd, 0xd6, 0xb5, 0xdb, 0x39, 0x85, 0x23, 0x05, 0xbe, 0xa8, 0x05,
+	0x17, 0xac, 0x5b, 0x12, 0xac, 0x5c, 0x2f, 0xef, 0xac, 0xdd, 0xb9, 0x9a, 0x0f, 0x76, 0x98, 0x85,
+	0x90, 0xbc, 0x07, 0xaa, 0x01, 0x38, 0x63, 0x6d, 0xa4, 0xdf, 0x0d, 0xfc, 0x97, 0x02, 0x12, 0x24,
+	0xb3, 0x83, 0x30, 0xac, 0xfa, 0x54, 0xd0, 0x01, 0x53, 0x78, 0xd9, 0x1a, 0xe3, 0x25, 0xfa, 0x1e,
+	0xae, 0xa4, 0x25, 0xf5, 0xd2, 0xa3, 0xc4, 0x2b, 0x96, 0xd1, 0xc7, 0xf3, 0x18, 0x65, 0xa0, 0xa4,
+	0x38, 0x02, 0x22, 0x70, 0x39, 0x35, 0xb4, 0xe2, 0x83, 0xc6, 0xab, 0xf5, 0xf2, 0x59, 0x52, 0xc5,
+	0x40, 0x52, 0xe4, 0x8c, 0xae, 0x41, 0x55, 0x1b, 0x2a, 0x5c, 0xaa, 0x5c, 0x8d, 0x2b, 0xf5, 0xf2,
+	0x4e, 0x95, 0xa4, 0x1b, 0x81, 0x0c, 0x5c, 0xb7, 0x94, 0x33, 0xe4, 0x13, 0xe6, 0xe2, 0x6a, 0xbd,
+	0xb4, 0x53, 0x21, 0x99, 0x1d, 0xf4, 0x2d, 0x6c, 0xb8, 0xec, 0x98, 0x8e, 0x3d, 0xd3, 0x8e, 0x3a,
+	0x1a, 0x83, 0xa5, 0x59, 0xcb, 0x57, 0xf3, 0x44, 0x33, 0x15, 0x21, 0x0e, 0x82, 0xae, 0x23, 0xb3,
+	0x6e, 0x41, 0x1d, 0x8e, 0x62, 0xd4, 0x30, 0xb7, 0x3d, 0xc5, 0x6b, 0x56, 0xd2, 0x74, 0x03, 0x7d,
+	0x0d, 0x17, 0x26, 0xd4, 0xe3, 0xae, 0x3d, 0xb3, 0x3d, 0x57, 0xe3, 0x75, 0xcb, 0xf9, 0xff, 0x33,
+	0x59, 0x82, 0xe6, 0xa2, 0x62, 0xc0, 0x48, 0x1e, 0x8d, 0x3e, 0x87, 0xff, 0xf9, 0x5c, 0x98, 0x87,
+	0xda, 0x51, 0xf2, 0xa4, 0xe5, 0xba, 0x8a, 0x69, 0x8d, 0x2f, 0xd8, 0x24, 0xa7, 0x0d, 0xe8, 0x47,
+	0xd8, 0x72, 0xa4, 0xf6, 0xa5, 0xee, 0x48, 0x2e, 0x9e, 0x2a, 0x3a, 0x1a, 0x31, 0xd5, 0xa3, 0x66,
+	0xa8, 0xf1, 0xc5, 0x7a, 0xb9, 0xe8, 0x08, 0x0b, 0xb0, 0x64, 0x4e, 0x08, 0xd4, 0x06, 0xe0, 0x62,
+	0x42, 0x15, 0xa7, 0xc2, 0x68, 0xbc, 0x61, 0xc5, 0x6a, 0xcc, 0x3b, 0xba, 0xbd, 0x04, 0x49, 0x32,
+	0x5e, 0xe8, 0x3e, 0x00, 0xf5, 0x38, 0xd5, 0x61, 0x51, 0x97, 0x8a, 0xa4, 0x68, 0xc5, 0x76, 0x92,
+	0x81, 0x36, 0x4e, 0x00, 0x3a, 0x52, 0x4c, 0x98, 0x0a, 0xfa, 0x09, 0x7d, 0x05, 0xcb, 0x9a, 0xbb,
+	0xac, 0x65, 0x7f, 0xa3, 0x6b, 0x77, 0x3e, 0x9d, 0xad, 0x22, 0x06, 0x1e, 0x04, 0xa0, 0xa7, 0xdc,
+	0x0c, 0xbb, 0x4c, 0x48, 0x9f, 0x84, 0x4e, 0xe8, 0xb3, 0xd0, 0xbb, 0x8d, 0x17, 0x6d, 0xfe, 0x2b,
+	0x79, 0xef, 0xe8, 0x5c, 0x43, 0x70, 0xbb, 0x71, 0x04, 0x78, 0x5e, 0x3c, 0x74, 0x13, 0x56, 0xa8,
+	0x2f, 0xc7, 0xc2, 0x14, 0xce, 0x8a, 0xc8, 0x86, 0x36, 0x61, 0xd9, 0x0d, 0xe0, 0x76, 0x30, 0x54,
+	0x49, 0xb8, 0x68, 0xbc, 0x84, 0xad, 0x34, 0x6e, 0x10, 0x52, 0x8e, 0x4d, 0x18, 0xf5, 0x6e, 0x9e,
+	0xdc, 0xf5, 0x33, 0xc9, 0xfd, 0x2b, 0x4e, 0xf7, 0x61, 0x63, 0x26, 0xcc, 0x87, 0x51, 0x69, 0xbc,
+	0x59, 0x84, 0x2b, 0x85, 0x4d, 0x13, 0x4c, 0x15, 0x1a, 0x75, 0x67, 0x29, 0x9c, 0x2a, 0xd1, 0xb2,
+	0x98, 0x3e, 0xea, 0x02, 0x38, 0x49, 0x09, 0xb8, 0x6c, 0x99, 0xde, 0x9c, 0xc7, 0x34, 0x2b, 0x0f,
+	0xc9, 0xf8, 0xa1, 0x2d, 0x58, 0xd1, 0x53, 0xbf, 0x2f, 0xbd, 0x68, 0xce, 0x45, 0xab, 0xa0, 0xcd,
+	0x6c, 0x9a, 0x27, 0x82, 0x1b, 0x8d, 0x97, 0x8b, 0xda, 0xac, 0x1b, 0xdb, 0x49, 0x06, 0x8a, 0x1e,
+	0x41, 0x8d, 0x7a, 0x9e, 0x3c, 0x79, 0x3c, 0x61, 0x4a, 0x71, 0x97, 0x05, 0x99, 0x5b, 0x62, 0x7a,
+	0x94, 0xfc, 0x26, 0xed, 0x2c, 0xac, 0x90, 0xf7, 0xa0, 0xd0, 0x3d, 0xa8, 0xc4, 0x77, 0x1d, 0x5e,
+	0xb5, 0xe4, 0xb6, 0xf3, 0xe9, 0x03, 0xd1, 0x92, 0x09, 0x9e, 0x60, 0x1b, 0x7f, 0x97, 0xa0, 0x9a,
+	0xfc, 0x00, 0x52, 0xe9, 0x4a, 0xf3, 0xa5, 0x5b, 0xfc, 0xcf, 0xd2, 0x95, 0xcf, 0x90, 0x6e, 0xe9,
+	0xc3, 0xa5, 0xcb, 0x52, 0x5e, 0x3e, 0x07, 0xe5, 0x9f, 0x60, 0x33, 0x6d, 0xa9, 0x36, 0x75, 0x9e,
+	0x33, 0xf7, 0x3d, 0x1d, 0xf5, 0xa0, 0x40, 0x00, 0x3c, 0x4f, 0x80, 0x2c, 0xe9, 0xc6, 0xef, 0x25,
+	0xa8, 0x26, 0xd5, 0xa3, 0x1d, 0xa8, 0xb8, 0xcc, 0xe1, 0x3e, 0xf5, 0xa2, 0x14, 0x33, 0x5d, 0x9f,
+	0x58, 0x33, 0x62, 0x2d, 0xe6, 0xc4, 0xba, 0x05, 0x97, 0xb8, 0xee, 0x86, 0xf7, 0x41, 0x97, 0xeb,
+	0x91, 0x47, 0xa7, 0x56, 0xce, 0x0a, 0x39, 0xb5, 0x9f, 0xd3, 0x67, 0xe9, 0x1c, 0xfa, 0xfc, 0x52,
+	0x86, 0xcd, 0xa2, 0xb9, 0x8a, 0xee, 0xc1, 0x96, 0x90, 0x1d, 0x7b, 0xb1, 0x3f, 0x3e, 0x11, 0x4c,
+	0xe9, 0x21, 0x1f, 0x1d, 0x72, 0x9f, 0x85, 0x64, 0x2a, 0x64, 0x8e, 0x15, 0x7d, 0x09, 0x17, 0x7d,
+	0xfa, 0xe2, 0x60, 0x3c, 0x1a, 0x79, 0xd3, 0x1e, 0x53, 0x7b, 0x2e, 0x5e, 0x2c, 0x20, 0x3f, 0x83,
+	0x41, 0x47, 0xb0, 0xe9, 0x14, 0x1c, 0x13, 0x2e, 0x17, 0xdf, 0x03, 0xa7, 0x91, 0xa4, 0xd0, 0x1f,
+	0x7d, 0x03, 0x57, 0x85, 0x7c, 0x24, 0x95, 0xc3, 0x8e, 0xc7, 0x5e, 0x4f, 0x6a, 0xb3, 0xcf, 0x85,
+	0x39, 0x8c, 0x9f, 0xa0, 0x56, 0xa9, 0x0a, 0x39, 0x0b, 0x82, 0x6e, 0xc3, 0x65, 0x97, 0x6b, 0xda,
+	0xf7, 0x58, 0x4f, 0x4a, 0xaf, 0x13, 0xdc, 0xbc, 0x41, 0x5f, 0x2c, 0x5b, 0xcf, 0x22, 0x13, 0x7a,
+	0x0c, 0x88, 0x4d, 0xfc, 0xef, 0xc6, 0x4c, 0x4d, 0x3b, 0xc9, 0x7b, 0x11, 0xaf, 0xd8, 0x5e, 0xbf,
+	0x91, 0x67, 0xf2, 0xf0, 0x68, 0x3f, 0x8f, 0x23, 0x05, 0xae, 0x0d, 0x03, 0x1b, 0xe9, 0x11, 0x1d,
+	0x18, 0x6a, 0x34, 0xda, 0x85, 0xb5, 0xa1, 0xf4, 0x5c, 0xa6, 0x3a, 0x73, 0xa7, 0x6a, 0x16, 0x80,
+	0xbe, 0x80, 0x4a, 0xfc, 0xb4, 0x3e, 0x7b, 0x86, 0x27, 0xb0, 0xc6, 0xaf, 0x25, 0xb8, 0xd8, 0x63,
+	0xc2, 0xe5, 0x62, 0xb0, 0x1f, 0x3d, 0xe1, 0xce, 0xff, 0x86, 0xc5, 0xb0, 0x2a, 0xa4, 0xcf, 0x05,
+	0x63, 0x51, 0x6f, 0xc7, 0x4b, 0x54, 0x87, 0x35, 0xfb, 0x19, 0xbd, 0x6c, 0xc2, 0x31, 0x91, 0xdd,
+	0x42, 0xb7, 0xa0, 0xca, 0x5e, 0x8c, 0xb8, 0x62, 0xba, 0x65, 0xf0, 0x52, 0x41, 0xaa, 0xd4, 0xdc,
+	0x26, 0xaf, 0xde, 0xd6, 0x4a, 0xaf, 0xdf, 0xd6, 0x4a, 0x7f, 0xbd, 0xad, 0x95, 0x7e, 0x7e, 0x57,
+	0x5b, 0x78, 0xfd, 0xae, 0xb6, 0xf0, 0xc7, 0xbb, 0xda, 0xc2, 0x0f, 0x0f, 0x06, 0xdc, 0x0c, 0xc7,
+	0xfd, 0x5d, 0x47, 0xfa, 0xcd, 0x3e, 0x37, 0x7d, 0xea, 0x0e, 0x98, 0x4e, 0xbf, 0x9c, 0x21, 0xe5,
+	0xa2, 0xf9, 0xa2, 0x99, 0xff, 0x8f, 0x32, 0x1d, 0x31, 0xdd, 0x5f, 0xb1, 0x8f, 0xf9, 0xbb, 0xff,
+	0x0c, 0x00, 0xa2, 0xdc, 0x17, 0x10, 0x09, 0x0d, 0x00, 0x00,
 }
 
 func (m *TokenCollection) Marshal() (dAtA []byte, err error) {
@@ -1719,6 +1782,63 @@ func (m *CollectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExpiresAt.Size()
+		i -= size
+		if _, err := m.ExpiresAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCollections(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NominatedBy) > 0 {
+		i -= len(m.NominatedBy)
+		copy(dAtA[i:], m.NominatedBy)
+		i = encodeVarintCollections(dAtA, i, uint64(len(m.NominatedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nominee) > 0 {
+		i -= len(m.Nominee)
+		copy(dAtA[i:], m.Nominee)
+		i = encodeVarintCollections(dAtA, i, uint64(len(m.Nominee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCollections(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCollections(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCollections(v)
 	base := offset
@@ -2031,6 +2151,27 @@ func (m *CollectionStats) Size() (n int) {
 	return n
 }
 
+func (m *PendingManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectionId.Size()
+	n += 1 + l + sovCollections(uint64(l))
+	l = len(m.Nominee)
+	if l > 0 {
+		n += 1 + l + sovCollections(uint64(l))
+	}
+	l = len(m.NominatedBy)
+	if l > 0 {
+		n += 1 + l + sovCollections(uint64(l))
+	}
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovCollections(uint64(l))
+	return n
+}
+
 func sovCollections(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4169,6 +4310,188 @@ func (m *CollectionStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollections
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollections
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollections
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollections
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nominee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollections
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollections
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollections
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nominee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NominatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollections
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollections
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollections
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NominatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollections
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollections
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollections
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollections(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollections
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCollections(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -51,6 +51,7 @@ type GenesisState struct {
 	VotingChallengeTrackerStoreKeys  []string                  `protobuf:"bytes,24,rep,name=votingChallengeTrackerStoreKeys,proto3" json:"votingChallengeTrackerStoreKeys,omitempty"`
 	NextAddressListCounter           Uint                      `protobuf:"bytes,25,opt,name=nextAddressListCounter,proto3,customtype=Uint" json:"nextAddressListCounter"`
 	ReservedProtocolAddresses        []string                  `protobuf:"bytes,26,rep,name=reservedProtocolAddresses,proto3" json:"reservedProtocolAddresses,omitempty"`
+	PendingManagers                  []*PendingManager         `protobuf:"bytes,27,rep,name=pendingManagers,proto3" json:"pendingManagers,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -219,6 +220,13 @@ func (m *GenesisState) GetReservedProtocolAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetPendingManagers() []*PendingManager {
+	if m != nil {
+		return m.PendingManagers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tokenization.GenesisState")
 }
@@ -226,56 +234,57 @@ func init() {
 func init() { proto.RegisterFile("tokenization/genesis.proto", fileDescriptor_4dd2ddc2c7ff28e7) }
 
 var fileDescriptor_4dd2ddc2c7ff28e7 = []byte{
-	// 770 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x93, 0x0b, 0x37, 0xc0, 0x24, 0x40, 0x98, 0x9b, 0x4b, 0x26, 0x29, 0x38, 0x69, 0x45,
-	0xa5, 0xa8, 0x8b, 0x44, 0xa2, 0x52, 0x85, 0x10, 0x15, 0x90, 0xa0, 0xd2, 0xb4, 0x95, 0x8a, 0xcc,
-	0x9f, 0x45, 0x17, 0x8d, 0x26, 0xf6, 0xe0, 0x58, 0x18, 0x4f, 0x34, 0x33, 0x41, 0xd0, 0xa7, 0xe8,
-	0xae, 0xaf, 0xc4, 0x92, 0x65, 0xd5, 0x05, 0xaa, 0xe0, 0x45, 0xaa, 0x99, 0x38, 0xb1, 0x27, 0xb1,
-	0xcb, 0xce, 0x39, 0xdf, 0xef, 0xcc, 0x39, 0xe7, 0xf3, 0xc9, 0x18, 0x94, 0x05, 0xbd, 0x20, 0xbe,
-	0xfb, 0x0d, 0x0b, 0x97, 0xfa, 0x0d, 0x87, 0xf8, 0x84, 0xbb, 0xbc, 0xde, 0x67, 0x54, 0x50, 0x98,
-	0x8b, 0x6a, 0xe5, 0x82, 0x43, 0x1d, 0xaa, 0x84, 0x86, 0x7c, 0x1a, 0x32, 0xe5, 0x92, 0x96, 0xdf,
-	0xc7, 0x0c, 0x5f, 0x06, 0xe9, 0x65, 0x43, 0x93, 0x2c, 0xea, 0x79, 0xc4, 0x92, 0x8f, 0x23, 0xfd,
-	0x99, 0xa6, 0x77, 0xb1, 0x87, 0x7d, 0x8b, 0x8c, 0xc4, 0x35, 0x4d, 0x14, 0x0c, 0xfb, 0xfc, 0x9c,
-	0xb0, 0x91, 0x5a, 0xd5, 0x54, 0x6c, 0xdb, 0x8c, 0x70, 0xde, 0xf1, 0x5c, 0x2e, 0x46, 0xc4, 0x73,
-	0x8d, 0xb0, 0x6f, 0x7c, 0x7c, 0xe9, 0x5a, 0x1d, 0x2e, 0x28, 0x1b, 0x97, 0x78, 0xa9, 0x21, 0x03,
-	0x4e, 0x58, 0x27, 0x68, 0x62, 0xc8, 0x05, 0xd8, 0x86, 0x5e, 0xab, 0xdf, 0x67, 0xf4, 0x0a, 0x7b,
-	0x1d, 0xc1, 0xb0, 0x75, 0xe1, 0xfa, 0x4e, 0x40, 0xad, 0xeb, 0xc3, 0xf6, 0xb0, 0xe7, 0x11, 0xdf,
-	0x19, 0xd5, 0x7a, 0xf1, 0x63, 0x11, 0xe4, 0x0e, 0x87, 0xe6, 0x1e, 0x0b, 0x2c, 0x08, 0xdc, 0x04,
-	0x99, 0xa1, 0x59, 0x28, 0x5d, 0x4d, 0xd7, 0xb2, 0x9b, 0x85, 0x7a, 0xf4, 0x80, 0xfa, 0x91, 0xd2,
-	0x9a, 0xb3, 0xb7, 0xf7, 0x95, 0x94, 0x19, 0x90, 0xb0, 0x08, 0xe6, 0xfa, 0x94, 0x89, 0x8e, 0x6b,
-	0xa3, 0x7f, 0xaa, 0xe9, 0xda, 0x82, 0x99, 0x91, 0x3f, 0xdb, 0x36, 0xdc, 0x05, 0xd9, 0x88, 0xbd,
-	0x68, 0xa6, 0x3a, 0x53, 0xcb, 0x6e, 0xae, 0xeb, 0x27, 0x9e, 0xc8, 0x1f, 0xad, 0x31, 0x65, 0x46,
-	0x33, 0xe0, 0x16, 0xc8, 0xfb, 0xe4, 0x5a, 0x84, 0x72, 0xdb, 0x46, 0xb3, 0xb2, 0x44, 0x33, 0x27,
-	0x3b, 0xf8, 0x75, 0x5f, 0x99, 0x3d, 0x75, 0x7d, 0x61, 0x4e, 0x51, 0x70, 0x1b, 0xcc, 0x8f, 0xde,
-	0x1c, 0xfa, 0x57, 0xd5, 0x35, 0xf4, 0xba, 0xa7, 0x9c, 0xb0, 0xe6, 0x90, 0x38, 0x96, 0xae, 0x9a,
-	0x63, 0x1e, 0xbe, 0x02, 0xf9, 0x6e, 0x44, 0xf9, 0x48, 0x6e, 0x38, 0xca, 0x54, 0x67, 0x6a, 0x0b,
-	0xe6, 0x54, 0x1c, 0x6e, 0x83, 0x95, 0xb1, 0xa9, 0x27, 0xd2, 0x7a, 0xc2, 0x38, 0x9a, 0x93, 0xf0,
-	0x44, 0x8b, 0xd3, 0x18, 0xdc, 0x01, 0xa5, 0xc9, 0x60, 0x58, 0x70, 0x5e, 0x15, 0x4c, 0x06, 0xe0,
-	0x5b, 0x90, 0x0b, 0x16, 0xec, 0x93, 0xdc, 0x2f, 0xb4, 0xa0, 0xa6, 0x2c, 0xe9, 0x53, 0xee, 0x87,
-	0x84, 0xa9, 0xe1, 0xb0, 0x0d, 0xf2, 0xa3, 0x9d, 0x19, 0xf7, 0x0d, 0xe2, 0x5e, 0xd0, 0xbe, 0x4e,
-	0x99, 0x53, 0x69, 0x70, 0x1b, 0xa0, 0x89, 0x58, 0x38, 0x46, 0x56, 0x8d, 0x91, 0xa8, 0xc3, 0x77,
-	0xa0, 0x38, 0xa1, 0x9d, 0x11, 0xc6, 0xd5, 0xba, 0xe4, 0x62, 0x5c, 0x4c, 0x82, 0xe1, 0x07, 0x50,
-	0x4d, 0x90, 0xc2, 0x5e, 0x16, 0x55, 0x2f, 0x4f, 0x72, 0x70, 0x0f, 0x2c, 0x06, 0x7f, 0x4c, 0x15,
-	0xe3, 0x68, 0x49, 0xf9, 0x52, 0xd6, 0x7d, 0x39, 0x88, 0x20, 0xa6, 0x9e, 0x00, 0x77, 0x00, 0x94,
-	0x1b, 0x19, 0x45, 0xda, 0x36, 0x5a, 0x8e, 0xd9, 0xdc, 0x18, 0x0e, 0x7e, 0x06, 0x30, 0x7a, 0xdc,
-	0x19, 0xf6, 0x06, 0x84, 0xa3, 0xbc, 0x6a, 0xa2, 0x92, 0xdc, 0x84, 0xe2, 0xcc, 0x98, 0x54, 0xb8,
-	0x07, 0x0a, 0x44, 0xf4, 0x8e, 0x5d, 0xc7, 0xc7, 0x62, 0xc0, 0xc2, 0x3d, 0x5d, 0x89, 0x71, 0x38,
-	0x96, 0x84, 0x4d, 0xb0, 0x16, 0x13, 0x0f, 0xad, 0x85, 0xca, 0xda, 0xbf, 0x32, 0x70, 0x17, 0x2c,
-	0x5d, 0x51, 0xe1, 0xfa, 0xce, 0xb8, 0xfe, 0x7f, 0x6a, 0xa4, 0xa2, 0x3e, 0xd2, 0x19, 0x15, 0xe4,
-	0x88, 0x51, 0x7a, 0x6e, 0x4e, 0xe0, 0xf0, 0x0d, 0x58, 0xd5, 0x22, 0x61, 0xf9, 0x82, 0x2a, 0x9f,
-	0xa0, 0xc2, 0x43, 0xb0, 0x1c, 0x5e, 0x2a, 0xf2, 0x9a, 0xe3, 0xe8, 0xff, 0xb8, 0x4d, 0x6f, 0xe9,
-	0x90, 0x39, 0x99, 0x25, 0x5f, 0xeb, 0x44, 0xa8, 0x6d, 0x73, 0xb4, 0x1a, 0xe3, 0x62, 0x0c, 0x07,
-	0xbf, 0x82, 0xe2, 0xb0, 0xc1, 0xd6, 0xd4, 0x85, 0x51, 0x54, 0xed, 0x6c, 0x4c, 0x19, 0x11, 0x03,
-	0x9b, 0x49, 0x87, 0xc0, 0xf7, 0xa0, 0x12, 0x2f, 0x85, 0x3e, 0x21, 0xe5, 0xd3, 0x53, 0x18, 0x3c,
-	0x00, 0xab, 0x72, 0x2d, 0x23, 0x97, 0x47, 0x8b, 0x0e, 0x7c, 0x41, 0x18, 0x2a, 0xc5, 0xac, 0x70,
-	0x02, 0x2b, 0xaf, 0x37, 0x46, 0x38, 0x61, 0x57, 0xc4, 0x3e, 0x92, 0x1f, 0x1b, 0x8b, 0x7a, 0x01,
-	0x45, 0x38, 0x2a, 0x0f, 0xaf, 0xb7, 0x44, 0xa0, 0x69, 0xde, 0x3e, 0x18, 0xe9, 0xbb, 0x07, 0x23,
-	0xfd, 0xfb, 0xc1, 0x48, 0x7f, 0x7f, 0x34, 0x52, 0x77, 0x8f, 0x46, 0xea, 0xe7, 0xa3, 0x91, 0xfa,
-	0xb2, 0xe5, 0xb8, 0xa2, 0x37, 0xe8, 0xd6, 0x2d, 0x7a, 0xd9, 0xe8, 0xba, 0xa2, 0x8b, 0x6d, 0x87,
-	0xf0, 0xf0, 0xc9, 0xea, 0x61, 0xd7, 0x6f, 0x5c, 0x37, 0xf4, 0x0f, 0xf5, 0x4d, 0x9f, 0xf0, 0x6e,
-	0x46, 0x7d, 0xf4, 0x5e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xec, 0xb5, 0x25, 0x5d, 0x08,
-	0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xc7, 0x93, 0x86, 0x06, 0x98, 0x04, 0x08, 0xd3, 0x94, 0x4c, 0x02, 0x38, 0x69, 0x45, 0xa5,
+	0xa8, 0x8b, 0x44, 0xa2, 0x52, 0x85, 0x10, 0x15, 0x90, 0x20, 0x68, 0xfa, 0xa1, 0x22, 0xf3, 0xb1,
+	0xe8, 0xa2, 0xd1, 0xc4, 0x1e, 0x1c, 0x0b, 0x33, 0x13, 0xcd, 0x4c, 0x10, 0xf4, 0x29, 0xfa, 0x58,
+	0x2c, 0x59, 0x56, 0x77, 0xc1, 0xbd, 0x82, 0x17, 0xb9, 0xf2, 0xc4, 0x89, 0x3d, 0x8e, 0x7d, 0xd9,
+	0x39, 0xe7, 0xff, 0x3b, 0x73, 0xce, 0xf9, 0xfb, 0x64, 0x0c, 0x6a, 0x92, 0xdd, 0x12, 0xea, 0xfe,
+	0x8b, 0xa5, 0xcb, 0x68, 0xdb, 0x21, 0x94, 0x08, 0x57, 0xb4, 0x46, 0x9c, 0x49, 0x06, 0x8b, 0x51,
+	0xad, 0x56, 0x76, 0x98, 0xc3, 0x94, 0xd0, 0xf6, 0x9f, 0x26, 0x4c, 0xad, 0xaa, 0xe5, 0x8f, 0x30,
+	0xc7, 0x77, 0x41, 0x7a, 0xcd, 0xd0, 0x24, 0x8b, 0x79, 0x1e, 0xb1, 0xfc, 0xc7, 0xa9, 0xbe, 0xa9,
+	0xe9, 0x03, 0xec, 0x61, 0x6a, 0x91, 0xa9, 0xb8, 0xa5, 0x89, 0x92, 0x63, 0x2a, 0x6e, 0x08, 0x9f,
+	0xaa, 0x0d, 0x4d, 0xc5, 0xb6, 0xcd, 0x89, 0x10, 0x7d, 0xcf, 0x15, 0x72, 0x4a, 0x7c, 0xa7, 0x11,
+	0xf6, 0x23, 0xc5, 0x77, 0xae, 0xd5, 0x17, 0x92, 0xf1, 0x59, 0x89, 0x1f, 0x34, 0x64, 0x2c, 0x08,
+	0xef, 0x07, 0x4d, 0x4c, 0xb8, 0x00, 0xdb, 0xd1, 0x6b, 0x8d, 0x46, 0x9c, 0xdd, 0x63, 0xaf, 0x2f,
+	0x39, 0xb6, 0x6e, 0x5d, 0xea, 0x04, 0xd4, 0xb6, 0x3e, 0xec, 0x10, 0x7b, 0x1e, 0xa1, 0xce, 0xb4,
+	0xd6, 0xf7, 0x1f, 0x57, 0x40, 0xf1, 0x6c, 0x62, 0xee, 0x85, 0xc4, 0x92, 0xc0, 0x5d, 0x90, 0x9f,
+	0x98, 0x85, 0xb2, 0x8d, 0x6c, 0xb3, 0xb0, 0x5b, 0x6e, 0x45, 0x0f, 0x68, 0x9d, 0x2b, 0xad, 0xb3,
+	0xf0, 0xf4, 0x52, 0xcf, 0x98, 0x01, 0x09, 0x2b, 0x60, 0x71, 0xc4, 0xb8, 0xec, 0xbb, 0x36, 0xfa,
+	0xaa, 0x91, 0x6d, 0x2e, 0x9b, 0x79, 0xff, 0x67, 0xcf, 0x86, 0x87, 0xa0, 0x10, 0xb1, 0x17, 0xe5,
+	0x1a, 0xb9, 0x66, 0x61, 0x77, 0x5b, 0x3f, 0xf1, 0xd2, 0xff, 0xd1, 0x9d, 0x51, 0x66, 0x34, 0x03,
+	0xee, 0x81, 0x12, 0x25, 0x0f, 0x32, 0x94, 0x7b, 0x36, 0x5a, 0xf0, 0x4b, 0x74, 0x8a, 0x7e, 0x07,
+	0x1f, 0x5e, 0xea, 0x0b, 0x57, 0x2e, 0x95, 0xe6, 0x1c, 0x05, 0xf7, 0xc1, 0xd2, 0xf4, 0xcd, 0xa1,
+	0xaf, 0x55, 0x5d, 0x43, 0xaf, 0x7b, 0x25, 0x08, 0xef, 0x4c, 0x88, 0x0b, 0xdf, 0x55, 0x73, 0xc6,
+	0xc3, 0x1f, 0x41, 0x69, 0x10, 0x51, 0x7e, 0x27, 0x8f, 0x02, 0xe5, 0x1b, 0xb9, 0xe6, 0xb2, 0x39,
+	0x17, 0x87, 0xfb, 0x60, 0x7d, 0x66, 0xea, 0xa5, 0x6f, 0x3d, 0xe1, 0x02, 0x2d, 0x36, 0x72, 0x73,
+	0x2d, 0xce, 0x63, 0xf0, 0x00, 0x54, 0xe3, 0xc1, 0xb0, 0xe0, 0x92, 0x2a, 0x98, 0x0e, 0xc0, 0x5f,
+	0x40, 0x31, 0x58, 0xb0, 0x3f, 0xfc, 0xfd, 0x42, 0xcb, 0x6a, 0xca, 0xaa, 0x3e, 0xe5, 0x71, 0x48,
+	0x98, 0x1a, 0x0e, 0x7b, 0xa0, 0x34, 0xdd, 0x99, 0x59, 0xdf, 0x20, 0xe9, 0x05, 0x1d, 0xeb, 0x94,
+	0x39, 0x97, 0x06, 0xf7, 0x01, 0x8a, 0xc5, 0xc2, 0x31, 0x0a, 0x6a, 0x8c, 0x54, 0x1d, 0x9e, 0x82,
+	0x4a, 0x4c, 0xbb, 0x26, 0x5c, 0xa8, 0x75, 0x29, 0x26, 0xb8, 0x98, 0x06, 0xc3, 0xdf, 0x40, 0x23,
+	0x45, 0x0a, 0x7b, 0x59, 0x51, 0xbd, 0xbc, 0xcb, 0xc1, 0x23, 0xb0, 0x12, 0xfc, 0x31, 0x55, 0x4c,
+	0xa0, 0x55, 0xe5, 0x4b, 0x4d, 0xf7, 0xe5, 0x24, 0x82, 0x98, 0x7a, 0x02, 0x3c, 0x00, 0xd0, 0xdf,
+	0xc8, 0x28, 0xd2, 0xb3, 0xd1, 0x5a, 0xc2, 0xe6, 0x26, 0x70, 0xf0, 0x2f, 0x00, 0xa3, 0xc7, 0x5d,
+	0x63, 0x6f, 0x4c, 0x04, 0x2a, 0xa9, 0x26, 0xea, 0xe9, 0x4d, 0x28, 0xce, 0x4c, 0x48, 0x85, 0x47,
+	0xa0, 0x4c, 0xe4, 0xf0, 0xc2, 0x75, 0x28, 0x96, 0x63, 0x1e, 0xee, 0xe9, 0x7a, 0x82, 0xc3, 0x89,
+	0x24, 0xec, 0x80, 0xad, 0x84, 0x78, 0x68, 0x2d, 0x54, 0xd6, 0x7e, 0x91, 0x81, 0x87, 0x60, 0xf5,
+	0x9e, 0x49, 0x97, 0x3a, 0xb3, 0xfa, 0xdf, 0xa8, 0x91, 0x2a, 0xfa, 0x48, 0xd7, 0x4c, 0x92, 0x73,
+	0xce, 0xd8, 0x8d, 0x19, 0xc3, 0xe1, 0xcf, 0x60, 0x43, 0x8b, 0x84, 0xe5, 0xcb, 0xaa, 0x7c, 0x8a,
+	0x0a, 0xcf, 0xc0, 0x5a, 0x78, 0xa9, 0xf8, 0xd7, 0x9c, 0x40, 0xdf, 0x26, 0x6d, 0x7a, 0x57, 0x87,
+	0xcc, 0x78, 0x96, 0xff, 0x5a, 0x63, 0xa1, 0x9e, 0x2d, 0xd0, 0x46, 0x82, 0x8b, 0x09, 0x1c, 0xfc,
+	0x07, 0x54, 0x26, 0x0d, 0x76, 0xe7, 0x2e, 0x8c, 0x8a, 0x6a, 0x67, 0x67, 0xce, 0x88, 0x04, 0xd8,
+	0x4c, 0x3b, 0x04, 0xfe, 0x0a, 0xea, 0xc9, 0x52, 0xe8, 0x13, 0x52, 0x3e, 0xbd, 0x87, 0xc1, 0x13,
+	0xb0, 0xe1, 0xaf, 0x65, 0xe4, 0xf2, 0xe8, 0xb2, 0x31, 0x95, 0x84, 0xa3, 0x6a, 0xc2, 0x0a, 0xa7,
+	0xb0, 0xfe, 0xf5, 0xc6, 0x89, 0x20, 0xfc, 0x9e, 0xd8, 0xe7, 0x9c, 0x49, 0x66, 0x31, 0x2f, 0xa0,
+	0x88, 0x40, 0xb5, 0xc9, 0xf5, 0x96, 0x0a, 0xc0, 0x53, 0xb0, 0x36, 0x22, 0xd4, 0x76, 0xa9, 0xf3,
+	0x27, 0xa6, 0xd8, 0xf1, 0x5d, 0xda, 0x54, 0x2e, 0x6d, 0xc5, 0xbe, 0x48, 0x1a, 0x64, 0xc6, 0x93,
+	0x3a, 0xe6, 0xd3, 0xab, 0x91, 0x7d, 0x7e, 0x35, 0xb2, 0x9f, 0x5e, 0x8d, 0xec, 0x7f, 0x6f, 0x46,
+	0xe6, 0xf9, 0xcd, 0xc8, 0xfc, 0xff, 0x66, 0x64, 0xfe, 0xde, 0x73, 0x5c, 0x39, 0x1c, 0x0f, 0x5a,
+	0x16, 0xbb, 0x6b, 0x0f, 0x5c, 0x39, 0xc0, 0xb6, 0x43, 0x44, 0xf8, 0x64, 0x0d, 0xb1, 0x4b, 0xdb,
+	0x0f, 0x6d, 0xfd, 0x83, 0xff, 0x38, 0x22, 0x62, 0x90, 0x57, 0x1f, 0xcf, 0x9f, 0x3e, 0x0f, 0x00,
+	0x7b, 0x91, 0x48, 0xdc, 0xa5, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -298,6 +307,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingManagers) > 0 {
+		for iNdEx := len(m.PendingManagers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingManagers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
 	if len(m.ReservedProtocolAddresses) > 0 {
 		for iNdEx := len(m.ReservedProtocolAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReservedProtocolAddresses[iNdEx])
@@ -779,6 +804,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingManagers) > 0 {
+		for _, e := range m.PendingManagers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1690,6 +1721,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ReservedProtocolAddresses = append(m.ReservedProtocolAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingManagers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingManagers = append(m.PendingManagers, &PendingManager{})
+			if err := m.PendingManagers[len(m.PendingManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
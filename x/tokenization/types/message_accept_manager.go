@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgAcceptManager = "accept_manager"
+
+var _ sdk.Msg = &MsgAcceptManager{}
+
+func NewMsgAcceptManager(creator string, collectionId sdkmath.Uint) *MsgAcceptManager {
+	return &MsgAcceptManager{
+		Creator:      creator,
+		CollectionId: collectionId,
+	}
+}
+
+func (msg *MsgAcceptManager) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptManager) Type() string {
+	return TypeMsgAcceptManager
+}
+
+func (msg *MsgAcceptManager) GetSigners() []sdk.AccAddress {
+	// MustAccAddressFromBech32 panics if address is invalid, which is expected
+	// since ValidateBasic() should have already validated the address
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptManager) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptManager) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.CollectionId.IsNil() || msg.CollectionId.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "collectionId cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCancelManagerNomination = "cancel_manager_nomination"
+
+var _ sdk.Msg = &MsgCancelManagerNomination{}
+
+func NewMsgCancelManagerNomination(creator string, collectionId sdkmath.Uint) *MsgCancelManagerNomination {
+	return &MsgCancelManagerNomination{
+		Creator:      creator,
+		CollectionId: collectionId,
+	}
+}
+
+func (msg *MsgCancelManagerNomination) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelManagerNomination) Type() string {
+	return TypeMsgCancelManagerNomination
+}
+
+func (msg *MsgCancelManagerNomination) GetSigners() []sdk.AccAddress {
+	// MustAccAddressFromBech32 panics if address is invalid, which is expected
+	// since ValidateBasic() should have already validated the address
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelManagerNomination) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelManagerNomination) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.CollectionId.IsNil() || msg.CollectionId.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "collectionId cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgNominateManager = "nominate_manager"
+
+var _ sdk.Msg = &MsgNominateManager{}
+
+func NewMsgNominateManager(creator string, collectionId sdkmath.Uint, nominee string, expiresAt sdkmath.Uint) *MsgNominateManager {
+	return &MsgNominateManager{
+		Creator:      creator,
+		CollectionId: collectionId,
+		Nominee:      nominee,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+func (msg *MsgNominateManager) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgNominateManager) Type() string {
+	return TypeMsgNominateManager
+}
+
+func (msg *MsgNominateManager) GetSigners() []sdk.AccAddress {
+	// MustAccAddressFromBech32 panics if address is invalid, which is expected
+	// since ValidateBasic() should have already validated the address
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgNominateManager) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgNominateManager) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.CollectionId.IsNil() || msg.CollectionId.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "collectionId cannot be zero")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Nominee)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid nominee address (%s)", err)
+	}
+
+	if msg.ExpiresAt.IsNil() || msg.ExpiresAt.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "expiresAt cannot be zero")
+	}
+
+	return nil
+}
@@ -1564,6 +1564,94 @@ func (m *QueryGetCollectionStatsResponse) GetStats() *CollectionStats {
 	return nil
 }
 
+type QueryGetPendingManagerRequest struct {
+	CollectionId string `protobuf:"bytes,1,opt,name=collectionId,proto3" json:"collectionId,omitempty"`
+}
+
+func (m *QueryGetPendingManagerRequest) Reset()         { *m = QueryGetPendingManagerRequest{} }
+func (m *QueryGetPendingManagerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingManagerRequest) ProtoMessage()    {}
+func (*QueryGetPendingManagerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_527f2b136015fc22, []int{30}
+}
+func (m *QueryGetPendingManagerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingManagerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingManagerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingManagerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingManagerRequest.Merge(m, src)
+}
+func (m *QueryGetPendingManagerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingManagerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingManagerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingManagerRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingManagerRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+type QueryGetPendingManagerResponse struct {
+	PendingManager *PendingManager `protobuf:"bytes,1,opt,name=pendingManager,proto3" json:"pendingManager,omitempty"`
+}
+
+func (m *QueryGetPendingManagerResponse) Reset()         { *m = QueryGetPendingManagerResponse{} }
+func (m *QueryGetPendingManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingManagerResponse) ProtoMessage()    {}
+func (*QueryGetPendingManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_527f2b136015fc22, []int{31}
+}
+func (m *QueryGetPendingManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingManagerResponse.Merge(m, src)
+}
+func (m *QueryGetPendingManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingManagerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingManagerResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingManagerResponse) GetPendingManager() *PendingManager {
+	if m != nil {
+		return m.PendingManager
+	}
+	return nil
+}
+
 type QueryGetBalanceForTokenRequest struct {
 	CollectionId string `protobuf:"bytes,1,opt,name=collectionId,proto3" json:"collectionId,omitempty"`
 	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -1575,7 +1663,7 @@ func (m *QueryGetBalanceForTokenRequest) Reset()         { *m = QueryGetBalanceF
 func (m *QueryGetBalanceForTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBalanceForTokenRequest) ProtoMessage()    {}
 func (*QueryGetBalanceForTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_527f2b136015fc22, []int{30}
+	return fileDescriptor_527f2b136015fc22, []int{32}
 }
 func (m *QueryGetBalanceForTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1728,7 @@ func (m *QueryGetBalanceForTokenResponse) Reset()         { *m = QueryGetBalance
 func (m *QueryGetBalanceForTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBalanceForTokenResponse) ProtoMessage()    {}
 func (*QueryGetBalanceForTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_527f2b136015fc22, []int{31}
+	return fileDescriptor_527f2b136015fc22, []int{33}
 }
 func (m *QueryGetBalanceForTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1707,6 +1795,8 @@ func init() {
 	proto.RegisterType((*QueryGetVotesResponse)(nil), "tokenization.QueryGetVotesResponse")
 	proto.RegisterType((*QueryGetCollectionStatsRequest)(nil), "tokenization.QueryGetCollectionStatsRequest")
 	proto.RegisterType((*QueryGetCollectionStatsResponse)(nil), "tokenization.QueryGetCollectionStatsResponse")
+	proto.RegisterType((*QueryGetPendingManagerRequest)(nil), "tokenization.QueryGetPendingManagerRequest")
+	proto.RegisterType((*QueryGetPendingManagerResponse)(nil), "tokenization.QueryGetPendingManagerResponse")
 	proto.RegisterType((*QueryGetBalanceForTokenRequest)(nil), "tokenization.QueryGetBalanceForTokenRequest")
 	proto.RegisterType((*QueryGetBalanceForTokenResponse)(nil), "tokenization.QueryGetBalanceForTokenResponse")
 }
@@ -1714,118 +1804,122 @@ func init() {
 func init() { proto.RegisterFile("tokenization/query.proto", fileDescriptor_527f2b136015fc22) }
 
 var fileDescriptor_527f2b136015fc22 = []byte{
-	// 1764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6f, 0x14, 0x57,
-	0x16, 0x76, 0x35, 0x7e, 0xe0, 0x03, 0x0c, 0xd2, 0xc5, 0x03, 0xed, 0xc2, 0xb4, 0xed, 0x8b, 0x99,
-	0x61, 0x00, 0x77, 0xf1, 0x14, 0xcc, 0x4b, 0x0c, 0xc6, 0x03, 0x34, 0x63, 0x84, 0x69, 0x3f, 0x18,
-	0x79, 0x16, 0xad, 0xea, 0xee, 0xeb, 0x76, 0x89, 0xea, 0xaa, 0xa6, 0xaa, 0xda, 0x83, 0xa7, 0xd5,
-	0x9b, 0x21, 0x8a, 0x14, 0x09, 0xe5, 0x21, 0x36, 0x91, 0xa2, 0x28, 0x52, 0x76, 0x64, 0x91, 0x64,
-	0x91, 0xbf, 0x10, 0x09, 0x65, 0x85, 0xc4, 0x26, 0x41, 0x0a, 0x8a, 0x20, 0xca, 0x2a, 0xf9, 0x0f,
-	0x51, 0xdd, 0x3a, 0xf5, 0xb8, 0xdd, 0x55, 0xfd, 0xc0, 0x2c, 0xc8, 0xce, 0x75, 0xcf, 0xb9, 0xe7,
-	0x7e, 0xdf, 0x39, 0xa7, 0xef, 0x3d, 0x9f, 0x21, 0xed, 0x98, 0x77, 0x98, 0xa1, 0xfd, 0x4f, 0x75,
-	0x34, 0xd3, 0x50, 0xee, 0xd6, 0x99, 0xb5, 0x95, 0xad, 0x59, 0xa6, 0x63, 0x92, 0xdd, 0x51, 0x8b,
-	0x3c, 0x56, 0x31, 0x2b, 0x26, 0x37, 0x28, 0xee, 0x5f, 0x9e, 0x8f, 0x3c, 0x51, 0x31, 0xcd, 0x8a,
-	0xce, 0x14, 0xb5, 0xa6, 0x29, 0xaa, 0x61, 0x98, 0x0e, 0x77, 0xb6, 0xd1, 0x7a, 0xac, 0x64, 0xda,
-	0x55, 0xd3, 0x56, 0x8a, 0xaa, 0xcd, 0xbc, 0xd0, 0xca, 0xe6, 0xa9, 0x22, 0x73, 0xd4, 0x53, 0x4a,
-	0x4d, 0xad, 0x68, 0x06, 0x77, 0x46, 0xdf, 0x71, 0x01, 0x47, 0x4d, 0xb5, 0xd4, 0xaa, 0x1f, 0x26,
-	0x23, 0x98, 0x4a, 0xa6, 0xae, 0xb3, 0x52, 0xf4, 0x98, 0x83, 0x82, 0xbd, 0xa8, 0xea, 0xaa, 0x51,
-	0x62, 0xbe, 0x71, 0x42, 0x30, 0x3a, 0x96, 0x6a, 0xd8, 0xeb, 0xcc, 0xf2, 0xad, 0x53, 0x82, 0x55,
-	0x2d, 0x97, 0x2d, 0x66, 0xdb, 0x05, 0x5d, 0xb3, 0x1d, 0xdf, 0x63, 0x5a, 0xf0, 0x28, 0x6f, 0x19,
-	0x6a, 0x55, 0x2b, 0x15, 0x6c, 0xc7, 0xb4, 0x82, 0x23, 0x8e, 0x08, 0x2e, 0x75, 0x9b, 0x59, 0x05,
-	0x04, 0xe1, 0xf9, 0xa1, 0xdb, 0x8c, 0x78, 0x56, 0xad, 0x66, 0x99, 0x9b, 0xaa, 0x5e, 0x70, 0x2c,
-	0xb5, 0x74, 0x47, 0x33, 0x2a, 0xe8, 0x75, 0x48, 0x24, 0xbb, 0xa1, 0xea, 0x3a, 0x33, 0x2a, 0xfe,
-	0x59, 0x74, 0x0c, 0xc8, 0x2d, 0x37, 0x91, 0x8b, 0x3c, 0x41, 0x79, 0x76, 0xb7, 0xce, 0x6c, 0x87,
-	0xe6, 0x60, 0x9f, 0xb0, 0x6a, 0xd7, 0x4c, 0xc3, 0x66, 0xe4, 0x34, 0x0c, 0x7b, 0x89, 0x4c, 0x4b,
-	0x53, 0xd2, 0xd1, 0x5d, 0xa7, 0xc7, 0xb2, 0xd1, 0xe0, 0x59, 0xcf, 0x7b, 0x6e, 0xf0, 0xf1, 0xf3,
-	0xc9, 0x81, 0x3c, 0x7a, 0xd2, 0x8b, 0x30, 0xce, 0x43, 0x5d, 0x65, 0xce, 0xe5, 0x20, 0xd3, 0x78,
-	0x0e, 0xa1, 0xb0, 0x3b, 0x4c, 0x7f, 0xae, 0xcc, 0xc3, 0x8e, 0xe6, 0x85, 0x35, 0xfa, 0x1f, 0x90,
-	0xe3, 0x02, 0x20, 0xa4, 0xbf, 0x03, 0x84, 0xde, 0x08, 0xeb, 0x90, 0x08, 0x6b, 0xd9, 0xfd, 0x88,
-	0x6c, 0x8d, 0x6c, 0xa0, 0xab, 0xb0, 0xdf, 0x0f, 0x3e, 0xe7, 0xa5, 0xb8, 0x0f, 0x68, 0x24, 0x0d,
-	0x23, 0x58, 0xe2, 0x74, 0x8a, 0x9b, 0xfd, 0x4f, 0xba, 0x04, 0x07, 0xda, 0xe2, 0x22, 0xe2, 0x0b,
-	0x30, 0x82, 0xd5, 0x44, 0xb8, 0x19, 0x11, 0xee, 0x8a, 0xcd, 0x2c, 0xdc, 0xb3, 0xe4, 0x56, 0x3b,
-	0xef, 0xbb, 0xd3, 0xb3, 0x61, 0x26, 0x2e, 0x79, 0xe7, 0x2c, 0x68, 0xb6, 0xe3, 0x03, 0xde, 0x0f,
-	0xc3, 0x6e, 0x9f, 0x05, 0x50, 0xf1, 0x8b, 0x2e, 0xc0, 0xc1, 0xd8, 0x5d, 0x08, 0x67, 0x16, 0x06,
-	0x5d, 0x47, 0xc4, 0x32, 0x2e, 0x62, 0x89, 0x6e, 0xe0, 0x6e, 0xf4, 0xb3, 0x14, 0x64, 0x82, 0x70,
-	0xd8, 0x72, 0xcb, 0x6e, 0xc7, 0x31, 0xcb, 0x07, 0x72, 0x14, 0xf6, 0xaa, 0x55, 0xb3, 0x6e, 0x38,
-	0xb8, 0x1e, 0x20, 0x6a, 0x5d, 0x26, 0x33, 0xb0, 0xc7, 0x6f, 0xdb, 0x05, 0xb6, 0xc9, 0x74, 0xcc,
-	0xa2, 0xb8, 0xc8, 0xe3, 0xf1, 0x05, 0x66, 0x21, 0x9e, 0xf4, 0x0e, 0x8c, 0x27, 0x2e, 0x93, 0x29,
-	0xd8, 0xe5, 0x78, 0xc1, 0x97, 0xb7, 0x6a, 0x2c, 0x3d, 0xc8, 0xbd, 0xa2, 0x4b, 0x6d, 0x55, 0x1d,
-	0x8a, 0xa9, 0x6a, 0x78, 0x5e, 0xd9, 0x3f, 0x6f, 0x58, 0x38, 0xcf, 0x5f, 0x26, 0x19, 0x00, 0x1f,
-	0x6a, 0xae, 0x9c, 0x1e, 0xe1, 0x4e, 0x91, 0x15, 0xba, 0x06, 0x93, 0x89, 0xb9, 0xc2, 0xf4, 0x9f,
-	0x87, 0x11, 0xc4, 0x17, 0xdf, 0xbc, 0xad, 0xfb, 0x7c, 0x6f, 0x7a, 0x3f, 0x15, 0x06, 0xbf, 0xec,
-	0xff, 0xaa, 0x5b, 0x2a, 0xd1, 0x4b, 0x0f, 0xbf, 0xee, 0x1a, 0x64, 0x81, 0x94, 0x5a, 0xe0, 0xe4,
-	0xca, 0x58, 0x8a, 0x18, 0x0b, 0x99, 0x80, 0x51, 0x9d, 0xa9, 0xeb, 0x39, 0xa3, 0xcc, 0xee, 0x61,
-	0x39, 0xc2, 0x85, 0x96, 0x0c, 0x0f, 0xb7, 0x65, 0xf8, 0x6f, 0x30, 0x95, 0x9c, 0x04, 0x4c, 0x71,
-	0x1a, 0x46, 0x8c, 0x7a, 0x75, 0xc5, 0x66, 0x7e, 0x02, 0xfc, 0x4f, 0x7a, 0x3e, 0xfc, 0x69, 0xcc,
-	0x7b, 0x17, 0xb1, 0xf7, 0x8b, 0xc3, 0xf4, 0xa5, 0x61, 0x84, 0xdf, 0xb7, 0x41, 0xe6, 0xfc, 0x4f,
-	0xba, 0x08, 0x13, 0xf1, 0x1b, 0xf1, 0xc8, 0x93, 0x30, 0xc4, 0x5d, 0xb1, 0xa6, 0xb2, 0x58, 0x53,
-	0x61, 0x8b, 0xe7, 0x48, 0x57, 0x43, 0x22, 0x51, 0xf3, 0xaa, 0xaa, 0xd7, 0xbb, 0xe3, 0xe9, 0x70,
-	0x11, 0xad, 0xc1, 0x74, 0x87, 0xb8, 0x08, 0xf7, 0x1c, 0x0c, 0x6d, 0xba, 0x0b, 0x08, 0x77, 0x32,
-	0x19, 0xae, 0xb7, 0xcf, 0xf3, 0xa6, 0x6f, 0xa7, 0x80, 0xfa, 0xc1, 0xff, 0xb9, 0x7c, 0x6d, 0x49,
-	0xab, 0x18, 0xaa, 0x53, 0xb7, 0xde, 0x84, 0x2e, 0x14, 0xfb, 0x66, 0xb0, 0xb5, 0x6f, 0x12, 0xba,
-	0x74, 0xa8, 0x53, 0x97, 0xda, 0x3e, 0x3d, 0x6c, 0xc3, 0x70, 0x81, 0x5e, 0x84, 0xc3, 0x1d, 0xf3,
-	0xd0, 0xb5, 0x11, 0xf3, 0x61, 0xf5, 0x6f, 0x5b, 0x6a, 0xad, 0xa6, 0x16, 0x75, 0x86, 0x6f, 0x80,
-	0xff, 0x26, 0x93, 0x31, 0x18, 0x2a, 0x33, 0xc3, 0xac, 0xe2, 0x5e, 0xef, 0xa3, 0x43, 0xe5, 0x73,
-	0x61, 0xe5, 0x63, 0x62, 0x22, 0xa4, 0x19, 0x18, 0xf6, 0x2e, 0x65, 0x2f, 0xea, 0xdc, 0x6e, 0xf7,
-	0xed, 0x7e, 0xf6, 0x7c, 0x72, 0x70, 0x45, 0x33, 0x9c, 0x3c, 0xda, 0xe8, 0x25, 0x38, 0xc2, 0x43,
-	0xe5, 0x6c, 0xcc, 0x6f, 0x9e, 0xd9, 0xcc, 0xda, 0x64, 0xe5, 0x45, 0x77, 0x86, 0x28, 0x99, 0x7a,
-	0xa4, 0x43, 0x7d, 0x34, 0x92, 0x88, 0xe6, 0xdf, 0xf0, 0x87, 0x6e, 0x21, 0x10, 0x52, 0x16, 0x88,
-	0xd6, 0x66, 0xe5, 0xe1, 0x76, 0xe6, 0x63, 0x2c, 0x74, 0x16, 0x8e, 0x07, 0x97, 0xac, 0xae, 0xb7,
-	0x9a, 0xf1, 0xb4, 0x20, 0x8d, 0x74, 0x01, 0x4e, 0xf4, 0xe6, 0x8e, 0x70, 0x26, 0x60, 0x54, 0xf5,
-	0x17, 0xd3, 0xd2, 0xd4, 0x0e, 0xb7, 0xf2, 0xc1, 0x02, 0xfd, 0x45, 0xc2, 0x49, 0xe9, 0x2a, 0x73,
-	0x56, 0x4d, 0x87, 0xbd, 0xc9, 0x3d, 0x9f, 0x01, 0xa8, 0x59, 0x66, 0xcd, 0xb4, 0xb9, 0xdd, 0xeb,
-	0xf5, 0xc8, 0x8a, 0x8b, 0x79, 0xd3, 0x74, 0xc2, 0x63, 0xbc, 0x36, 0x17, 0xd6, 0xe8, 0x65, 0x18,
-	0x13, 0xe9, 0x62, 0x96, 0x8e, 0xc3, 0xa0, 0xeb, 0x87, 0x17, 0xc8, 0x01, 0xf1, 0x02, 0x71, 0x3d,
-	0x17, 0x2d, 0xd3, 0x5c, 0xcf, 0x73, 0x27, 0xfa, 0x8d, 0x24, 0x46, 0xb1, 0x7f, 0xc3, 0x59, 0xa3,
-	0x57, 0xe0, 0xf7, 0x2d, 0x5c, 0x82, 0xc1, 0x6a, 0xc8, 0x65, 0xeb, 0x35, 0x4d, 0x87, 0x9c, 0x78,
-	0x5e, 0x74, 0x3e, 0x9c, 0xab, 0xc2, 0x59, 0x75, 0xc9, 0x51, 0x9d, 0x7e, 0xb2, 0x43, 0x57, 0x23,
-	0x43, 0x41, 0x6b, 0x14, 0xc4, 0x75, 0xc6, 0x7d, 0x9b, 0x54, 0xc7, 0x8e, 0x9f, 0x37, 0x5a, 0x77,
-	0x79, 0xbe, 0xf4, 0x81, 0x14, 0xc2, 0xc3, 0x4b, 0xe4, 0x8a, 0x69, 0xf1, 0xc9, 0xfa, 0xb5, 0x0c,
-	0xcc, 0xae, 0x85, 0xe3, 0xc8, 0x95, 0xb1, 0x50, 0xfe, 0x27, 0x21, 0x30, 0xe8, 0x68, 0x55, 0x7f,
-	0x9a, 0xe3, 0x7f, 0xd3, 0xbf, 0x86, 0x34, 0xdb, 0xd0, 0x84, 0x97, 0x6d, 0x74, 0xcc, 0x1e, 0x0d,
-	0xc6, 0xe8, 0xd3, 0x1f, 0x1d, 0x84, 0x21, 0xbe, 0x9b, 0xbc, 0x25, 0xc1, 0xb0, 0x27, 0x5a, 0xc8,
-	0x94, 0x98, 0x86, 0x76, 0x4d, 0x24, 0x4f, 0x77, 0xf0, 0xf0, 0xce, 0xa4, 0xe7, 0xfe, 0xff, 0xf4,
-	0xc7, 0x87, 0x29, 0x85, 0xcc, 0x2a, 0x45, 0xcd, 0x29, 0xaa, 0xe5, 0x0a, 0xb3, 0xc3, 0xbf, 0x4a,
-	0x1b, 0xaa, 0x66, 0x28, 0x31, 0xaa, 0x94, 0x7c, 0x29, 0xc1, 0x1e, 0xa1, 0x60, 0xe4, 0x8f, 0x31,
-	0x67, 0xc5, 0x09, 0x28, 0xf9, 0x68, 0x77, 0x47, 0xc4, 0xb6, 0xc0, 0xb1, 0x5d, 0x21, 0xf3, 0x3d,
-	0x62, 0xab, 0x30, 0xa7, 0x10, 0xd6, 0x4e, 0x69, 0x44, 0xeb, 0xd8, 0x24, 0x5f, 0x48, 0xf0, 0x3b,
-	0x51, 0x50, 0x90, 0x04, 0x28, 0xed, 0x4a, 0x45, 0xfe, 0x53, 0x0f, 0x9e, 0x88, 0xfa, 0x1a, 0x47,
-	0x3d, 0x47, 0xfe, 0xd1, 0x07, 0xea, 0xa8, 0xea, 0x56, 0x1a, 0x9e, 0x0a, 0x6a, 0x92, 0x8f, 0x53,
-	0x40, 0xda, 0xe7, 0x70, 0x72, 0x22, 0x01, 0x4b, 0xac, 0xb4, 0x91, 0x67, 0x7b, 0xf4, 0x46, 0xf4,
-	0x8f, 0x24, 0x0e, 0xff, 0x53, 0x89, 0x7c, 0x22, 0xf5, 0x43, 0x00, 0xc3, 0xd9, 0x05, 0x1c, 0xf6,
-	0x5b, 0xb2, 0xaf, 0x34, 0x84, 0xcb, 0x2e, 0xf8, 0x0e, 0x2e, 0x35, 0x77, 0x45, 0x94, 0x5a, 0x4d,
-	0xa5, 0x11, 0x91, 0x41, 0xe1, 0x0e, 0x5f, 0xca, 0x34, 0xc9, 0x7b, 0x29, 0xd8, 0x17, 0x33, 0x45,
-	0x93, 0x04, 0xca, 0x09, 0x92, 0x43, 0xce, 0xf6, 0xea, 0x8e, 0x29, 0xfa, 0xd0, 0x4b, 0xd1, 0x07,
-	0x12, 0x79, 0xb7, 0x9f, 0x14, 0x05, 0x23, 0xda, 0x36, 0x52, 0xd4, 0x3e, 0xe6, 0x35, 0x95, 0x46,
-	0x20, 0x3d, 0x9a, 0xe4, 0x51, 0x0a, 0xf6, 0xc7, 0x4f, 0x74, 0xe4, 0x64, 0x3c, 0xcb, 0xe4, 0x21,
-	0x58, 0x3e, 0xd5, 0xc7, 0x8e, 0xed, 0x75, 0x0f, 0x73, 0x36, 0x0a, 0xc1, 0x68, 0xba, 0x9d, 0x0e,
-	0x0a, 0xde, 0xc0, 0xa4, 0x5c, 0x05, 0xa7, 0x34, 0xc9, 0xe7, 0x12, 0x40, 0x78, 0x19, 0x93, 0x99,
-	0x78, 0xb6, 0xe2, 0xbf, 0x58, 0xe4, 0x23, 0x5d, 0xbc, 0x30, 0x0f, 0x4b, 0x3c, 0x0d, 0x37, 0xc8,
-	0xbf, 0xfa, 0x48, 0x02, 0xde, 0xf5, 0xed, 0xb4, 0xfd, 0x76, 0xff, 0x4a, 0x82, 0xbd, 0x2d, 0x9a,
-	0x88, 0x24, 0xdc, 0x4b, 0x31, 0xd2, 0x50, 0x3e, 0xd6, 0x8b, 0x2b, 0xe2, 0xbf, 0xce, 0xf1, 0xcf,
-	0x93, 0xb9, 0x3e, 0xf0, 0x0b, 0xff, 0x17, 0x54, 0x1a, 0xa8, 0xf3, 0x9a, 0xe4, 0xa9, 0x04, 0x63,
-	0x71, 0x52, 0x8e, 0x64, 0xbb, 0x03, 0x8a, 0x6a, 0x49, 0x59, 0xe9, 0xd9, 0x1f, 0x59, 0xac, 0x71,
-	0x16, 0xcb, 0x24, 0xff, 0xaa, 0x2c, 0x0a, 0x5c, 0x34, 0x86, 0x5c, 0x22, 0xc5, 0x78, 0xe2, 0xb1,
-	0x6a, 0x93, 0x29, 0x49, 0xac, 0x92, 0x34, 0x52, 0x12, 0xab, 0x44, 0xfd, 0x43, 0x6f, 0x73, 0x56,
-	0xb7, 0xc8, 0xcd, 0x3e, 0x58, 0xfd, 0xd7, 0x8f, 0xe6, 0x77, 0x99, 0xad, 0x34, 0xb8, 0x18, 0x8b,
-	0x52, 0xfa, 0x5e, 0x82, 0xf1, 0x44, 0xad, 0x43, 0xce, 0xc4, 0xe0, 0xec, 0x26, 0xae, 0xe4, 0xb3,
-	0xfd, 0x6d, 0x42, 0x86, 0x2b, 0x9c, 0xe1, 0x4d, 0x72, 0xa3, 0x47, 0x86, 0x9a, 0x1d, 0x3c, 0xa0,
-	0x16, 0xc6, 0x2c, 0xd4, 0x30, 0x68, 0x84, 0xdf, 0xcf, 0x12, 0x4c, 0x76, 0x91, 0x50, 0xe4, 0xcf,
-	0x09, 0xaf, 0x65, 0x77, 0x95, 0x26, 0xff, 0xe5, 0x55, 0xb6, 0x22, 0xe3, 0x3c, 0x67, 0xbc, 0x40,
-	0xae, 0xf7, 0xf3, 0xe4, 0xea, 0x7a, 0x3b, 0xdd, 0x42, 0xa0, 0xf3, 0xc8, 0x4f, 0x12, 0x8c, 0xe0,
-	0x84, 0x4f, 0xa6, 0xe3, 0xb1, 0x45, 0xe4, 0x9f, 0x4c, 0x3b, 0xb9, 0x20, 0xcc, 0x87, 0xde, 0xf5,
-	0xfe, 0x40, 0x22, 0xef, 0xf4, 0x73, 0xbd, 0xbb, 0x6a, 0x61, 0xbb, 0xb7, 0x79, 0x28, 0x5f, 0x9a,
-	0x4a, 0x23, 0xaa, 0xef, 0x9a, 0xe4, 0x3b, 0x09, 0x76, 0xfa, 0x52, 0x86, 0x74, 0xa0, 0x11, 0x54,
-	0xea, 0x70, 0x47, 0x1f, 0xe4, 0x7a, 0xdf, 0xe3, 0xda, 0x24, 0x8d, 0x3e, 0x99, 0xda, 0xaf, 0x91,
-	0x2a, 0xf9, 0x5a, 0xe2, 0x23, 0x60, 0x8b, 0xc4, 0x49, 0x1a, 0x01, 0xe3, 0x55, 0x58, 0xd2, 0x08,
-	0x98, 0xa0, 0xb6, 0x5e, 0xa9, 0x19, 0x43, 0xc6, 0x05, 0xae, 0xbe, 0x5a, 0x87, 0xef, 0x67, 0x1e,
-	0x8f, 0x16, 0xe5, 0x93, 0xc4, 0x23, 0x5e, 0xae, 0x25, 0xf1, 0x48, 0x90, 0x53, 0xb4, 0xc2, 0x79,
-	0xa8, 0xa4, 0xd0, 0xff, 0x23, 0x5c, 0x58, 0x37, 0xad, 0x02, 0x37, 0x26, 0x3e, 0xc7, 0x4a, 0x03,
-	0xd5, 0x5e, 0x73, 0x2e, 0xff, 0xf8, 0x45, 0x46, 0x7a, 0xf2, 0x22, 0x23, 0xfd, 0xf0, 0x22, 0x23,
-	0xbd, 0xff, 0x32, 0x33, 0xf0, 0xe4, 0x65, 0x66, 0xe0, 0xdb, 0x97, 0x99, 0x81, 0xb5, 0x0b, 0x15,
-	0xcd, 0xd9, 0xa8, 0x17, 0xb3, 0x25, 0xb3, 0x9a, 0x0c, 0xe2, 0x9e, 0x08, 0xc3, 0xd9, 0xaa, 0x31,
-	0xbb, 0x38, 0xcc, 0x7f, 0xd1, 0x67, 0x7e, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x6e, 0x7a, 0x9a,
-	0x9c, 0x1c, 0x00, 0x00,
+	// 1837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6f, 0x14, 0xcb,
+	0x15, 0x76, 0xcf, 0xf5, 0xe3, 0xfa, 0x5c, 0xee, 0xbd, 0x4a, 0x5d, 0x87, 0x3b, 0x6e, 0xcc, 0xd8,
+	0x2e, 0x4c, 0x42, 0x00, 0x4f, 0xf3, 0x14, 0xe4, 0x25, 0x82, 0xed, 0x00, 0x03, 0x46, 0x98, 0xf1,
+	0x83, 0xc8, 0x59, 0x8c, 0x7a, 0x66, 0xca, 0xe3, 0x16, 0x3d, 0xdd, 0x43, 0x77, 0x8f, 0x83, 0x33,
+	0x9a, 0x4d, 0x88, 0x22, 0x45, 0x42, 0x79, 0x88, 0x4d, 0x36, 0x51, 0xa4, 0xec, 0xc8, 0x22, 0xc9,
+	0x22, 0x7f, 0x20, 0x8b, 0x48, 0x28, 0x2b, 0x24, 0x36, 0x09, 0x52, 0x48, 0x04, 0x51, 0x56, 0xc9,
+	0x7f, 0x88, 0xba, 0xfa, 0xf4, 0xa3, 0x7a, 0xba, 0x67, 0xa6, 0x31, 0x0b, 0xee, 0xce, 0x5d, 0xf5,
+	0xd5, 0xa9, 0xef, 0x3b, 0x75, 0x5c, 0x75, 0xbe, 0x81, 0xbc, 0x63, 0xde, 0x67, 0x86, 0xf6, 0x43,
+	0xd5, 0xd1, 0x4c, 0x43, 0x79, 0xd0, 0x66, 0xd6, 0x7e, 0xb1, 0x65, 0x99, 0x8e, 0x49, 0x0e, 0x45,
+	0x67, 0xe4, 0xa9, 0x86, 0xd9, 0x30, 0xf9, 0x84, 0xe2, 0xfe, 0xe5, 0x61, 0xe4, 0x99, 0x86, 0x69,
+	0x36, 0x74, 0xa6, 0xa8, 0x2d, 0x4d, 0x51, 0x0d, 0xc3, 0x74, 0x38, 0xd8, 0xc6, 0xd9, 0x93, 0x35,
+	0xd3, 0x6e, 0x9a, 0xb6, 0x52, 0x55, 0x6d, 0xe6, 0x85, 0x56, 0xf6, 0xce, 0x56, 0x99, 0xa3, 0x9e,
+	0x55, 0x5a, 0x6a, 0x43, 0x33, 0x38, 0x18, 0xb1, 0xd3, 0x02, 0x8f, 0x96, 0x6a, 0xa9, 0x4d, 0x3f,
+	0x4c, 0x41, 0x98, 0xaa, 0x99, 0xba, 0xce, 0x6a, 0xd1, 0x6d, 0x8e, 0x08, 0xf3, 0x55, 0x55, 0x57,
+	0x8d, 0x1a, 0xf3, 0x27, 0x67, 0x84, 0x49, 0xc7, 0x52, 0x0d, 0x7b, 0x87, 0x59, 0xfe, 0xec, 0x9c,
+	0x30, 0xab, 0xd6, 0xeb, 0x16, 0xb3, 0xed, 0x8a, 0xae, 0xd9, 0x8e, 0x8f, 0x98, 0x17, 0x10, 0xf5,
+	0x7d, 0x43, 0x6d, 0x6a, 0xb5, 0x8a, 0xed, 0x98, 0x56, 0xb0, 0xc5, 0x71, 0x01, 0xd2, 0xb6, 0x99,
+	0x55, 0x41, 0x12, 0x1e, 0x0e, 0x61, 0x0b, 0xe2, 0x5e, 0xad, 0x96, 0x65, 0xee, 0xa9, 0x7a, 0xc5,
+	0xb1, 0xd4, 0xda, 0x7d, 0xcd, 0x68, 0x20, 0xea, 0xa8, 0x28, 0x76, 0x57, 0xd5, 0x75, 0x66, 0x34,
+	0xfc, 0xbd, 0xe8, 0x14, 0x90, 0xbb, 0x6e, 0x22, 0xd7, 0x78, 0x82, 0xca, 0xec, 0x41, 0x9b, 0xd9,
+	0x0e, 0x2d, 0xc1, 0x67, 0xc2, 0xa8, 0xdd, 0x32, 0x0d, 0x9b, 0x91, 0x73, 0x30, 0xee, 0x25, 0x32,
+	0x2f, 0xcd, 0x49, 0x27, 0x3e, 0x3a, 0x37, 0x55, 0x8c, 0x06, 0x2f, 0x7a, 0xe8, 0xa5, 0xd1, 0x67,
+	0xaf, 0x66, 0x47, 0xca, 0x88, 0xa4, 0x57, 0x60, 0x9a, 0x87, 0xba, 0xce, 0x9c, 0xe5, 0x20, 0xd3,
+	0xb8, 0x0f, 0xa1, 0x70, 0x28, 0x4c, 0x7f, 0xa9, 0xce, 0xc3, 0x4e, 0x96, 0x85, 0x31, 0xfa, 0x7d,
+	0x90, 0x93, 0x02, 0x20, 0xa5, 0x6f, 0x03, 0x84, 0x68, 0xa4, 0x75, 0x54, 0xa4, 0xb5, 0xe1, 0x7e,
+	0x44, 0x96, 0x46, 0x16, 0xd0, 0x2d, 0x38, 0xec, 0x07, 0x5f, 0xf2, 0x52, 0x9c, 0x81, 0x1a, 0xc9,
+	0xc3, 0x04, 0x1e, 0x71, 0x3e, 0xc7, 0xa7, 0xfd, 0x4f, 0xba, 0x0e, 0x9f, 0xf7, 0xc4, 0x45, 0xc6,
+	0x97, 0x61, 0x02, 0x4f, 0x13, 0xe9, 0x16, 0x44, 0xba, 0x9b, 0x36, 0xb3, 0x70, 0xcd, 0xba, 0x7b,
+	0xda, 0x65, 0x1f, 0x4e, 0x2f, 0x84, 0x99, 0xb8, 0xea, 0xed, 0xb3, 0xaa, 0xd9, 0x8e, 0x4f, 0xf8,
+	0x30, 0x8c, 0xbb, 0x75, 0x16, 0x50, 0xc5, 0x2f, 0xba, 0x0a, 0x47, 0x12, 0x57, 0x21, 0x9d, 0x45,
+	0x18, 0x75, 0x81, 0xc8, 0x65, 0x5a, 0xe4, 0x12, 0x5d, 0xc0, 0x61, 0xf4, 0x77, 0x39, 0x28, 0x04,
+	0xe1, 0xb0, 0xe4, 0x36, 0xdc, 0x8a, 0x63, 0x96, 0x4f, 0xe4, 0x04, 0x7c, 0xaa, 0x36, 0xcd, 0xb6,
+	0xe1, 0xe0, 0x78, 0xc0, 0x28, 0x3e, 0x4c, 0x16, 0xe0, 0x63, 0xbf, 0x6c, 0x57, 0xd9, 0x1e, 0xd3,
+	0x31, 0x8b, 0xe2, 0x20, 0x8f, 0xc7, 0x07, 0x98, 0x85, 0x7c, 0xf2, 0x1f, 0x60, 0x3c, 0x71, 0x98,
+	0xcc, 0xc1, 0x47, 0x8e, 0x17, 0x7c, 0x63, 0xbf, 0xc5, 0xf2, 0xa3, 0x1c, 0x15, 0x1d, 0xea, 0x39,
+	0xd5, 0xb1, 0x84, 0x53, 0x0d, 0xf7, 0xab, 0xfb, 0xfb, 0x8d, 0x0b, 0xfb, 0xf9, 0xc3, 0xa4, 0x00,
+	0xe0, 0x53, 0x2d, 0xd5, 0xf3, 0x13, 0x1c, 0x14, 0x19, 0xa1, 0xdb, 0x30, 0x9b, 0x9a, 0x2b, 0x4c,
+	0xff, 0x25, 0x98, 0x40, 0x7e, 0xc9, 0xc5, 0x1b, 0x5f, 0xe7, 0xa3, 0xe9, 0xa3, 0x5c, 0x18, 0x7c,
+	0xd9, 0xff, 0xaf, 0x8e, 0x9d, 0xc4, 0x30, 0x35, 0xfc, 0xae, 0xcf, 0xa0, 0x08, 0xa4, 0x16, 0xa3,
+	0x53, 0xaa, 0xe3, 0x51, 0x24, 0xcc, 0x90, 0x19, 0x98, 0xd4, 0x99, 0xba, 0x53, 0x32, 0xea, 0xec,
+	0x21, 0x1e, 0x47, 0x38, 0x10, 0xcb, 0xf0, 0x78, 0x4f, 0x86, 0xbf, 0x05, 0x73, 0xe9, 0x49, 0xc0,
+	0x14, 0xe7, 0x61, 0xc2, 0x68, 0x37, 0x37, 0x6d, 0xe6, 0x27, 0xc0, 0xff, 0xa4, 0x97, 0xc2, 0x7f,
+	0x8d, 0x15, 0xef, 0x22, 0xf6, 0xfe, 0xe3, 0x30, 0x7d, 0x79, 0x98, 0xe0, 0xf7, 0x6d, 0x90, 0x39,
+	0xff, 0x93, 0xae, 0xc1, 0x4c, 0xf2, 0x42, 0xdc, 0xf2, 0x0c, 0x8c, 0x71, 0x28, 0x9e, 0xa9, 0x2c,
+	0x9e, 0xa9, 0xb0, 0xc4, 0x03, 0xd2, 0xad, 0x50, 0x48, 0x74, 0x7a, 0x4b, 0xd5, 0xdb, 0x83, 0xf9,
+	0xf4, 0xb9, 0x88, 0xb6, 0x61, 0xbe, 0x4f, 0x5c, 0xa4, 0x7b, 0x11, 0xc6, 0xf6, 0xdc, 0x01, 0xa4,
+	0x3b, 0x9b, 0x4e, 0xd7, 0x5b, 0xe7, 0xa1, 0xe9, 0x4f, 0x72, 0x40, 0xfd, 0xe0, 0xdf, 0xdd, 0xb8,
+	0xb1, 0xae, 0x35, 0x0c, 0xd5, 0x69, 0x5b, 0xef, 0x43, 0x15, 0x8a, 0x75, 0x33, 0x1a, 0xaf, 0x9b,
+	0x94, 0x2a, 0x1d, 0xeb, 0x57, 0xa5, 0xb6, 0x2f, 0x0f, 0xcb, 0x30, 0x1c, 0xa0, 0x57, 0xe0, 0x58,
+	0xdf, 0x3c, 0x0c, 0x2c, 0xc4, 0x72, 0x78, 0xfa, 0xf7, 0x2c, 0xb5, 0xd5, 0x52, 0xab, 0x3a, 0xc3,
+	0x37, 0xc0, 0x7f, 0x93, 0xc9, 0x14, 0x8c, 0xd5, 0x99, 0x61, 0x36, 0x71, 0xad, 0xf7, 0xd1, 0xe7,
+	0xe4, 0x4b, 0x30, 0xdf, 0x27, 0x26, 0x52, 0x5a, 0x80, 0x71, 0xef, 0x52, 0xf6, 0xa2, 0x2e, 0x1d,
+	0x72, 0xdf, 0xee, 0x97, 0xaf, 0x66, 0x47, 0x37, 0x35, 0xc3, 0x29, 0xe3, 0x1c, 0xbd, 0x0a, 0xc7,
+	0x79, 0xa8, 0x92, 0x8d, 0xf9, 0x2d, 0x33, 0x9b, 0x59, 0x7b, 0xac, 0xbe, 0x66, 0x99, 0x8e, 0x59,
+	0x33, 0xf5, 0x48, 0x85, 0xfa, 0x6c, 0x24, 0x91, 0xcd, 0xf7, 0xe0, 0x2b, 0x83, 0x42, 0x20, 0xa5,
+	0x22, 0x10, 0xad, 0x67, 0x96, 0x87, 0xfb, 0xb0, 0x9c, 0x30, 0x43, 0x17, 0xe1, 0x54, 0x70, 0xc9,
+	0xea, 0x7a, 0x7c, 0x1a, 0x77, 0x0b, 0xd2, 0x48, 0x57, 0xe1, 0xf4, 0x70, 0x70, 0xa4, 0x33, 0x03,
+	0x93, 0xaa, 0x3f, 0x98, 0x97, 0xe6, 0x3e, 0x70, 0x4f, 0x3e, 0x18, 0xa0, 0xff, 0x93, 0xb0, 0x53,
+	0xba, 0xce, 0x9c, 0x2d, 0xd3, 0x61, 0xef, 0x73, 0xcd, 0x17, 0x00, 0x5a, 0x96, 0xd9, 0x32, 0x6d,
+	0x55, 0x0f, 0x6a, 0x3d, 0x32, 0xe2, 0x72, 0xde, 0x33, 0x9d, 0x70, 0x1b, 0xaf, 0xcc, 0x85, 0x31,
+	0xba, 0x0c, 0x53, 0xa2, 0x5c, 0xcc, 0xd2, 0x29, 0x18, 0x75, 0x71, 0x78, 0x81, 0x7c, 0x2e, 0x5e,
+	0x20, 0x2e, 0x72, 0xcd, 0x32, 0xcd, 0x9d, 0x32, 0x07, 0xd1, 0xbf, 0x4a, 0x62, 0x14, 0xfb, 0x0b,
+	0x9c, 0x35, 0x7a, 0x0d, 0xbe, 0x1c, 0xd3, 0x12, 0x34, 0x56, 0x63, 0xae, 0x5a, 0xaf, 0x68, 0xfa,
+	0xe4, 0xc4, 0x43, 0xd1, 0x95, 0xb0, 0xaf, 0x0a, 0x7b, 0xd5, 0x75, 0x47, 0x75, 0xb2, 0x64, 0x87,
+	0x6e, 0x45, 0x9a, 0x82, 0x78, 0x14, 0xe4, 0x75, 0xde, 0x7d, 0x9b, 0x54, 0xc7, 0x4e, 0xee, 0x37,
+	0xe2, 0xab, 0x3c, 0x2c, 0x5d, 0x86, 0xa3, 0x7e, 0xdc, 0x35, 0x66, 0xd4, 0x35, 0xa3, 0x71, 0x5b,
+	0x35, 0xd4, 0x46, 0xa6, 0x4b, 0x9e, 0xee, 0x40, 0x21, 0x2d, 0x08, 0x72, 0x5b, 0x81, 0x4f, 0x5a,
+	0xc2, 0x0c, 0x92, 0x9c, 0x89, 0x19, 0x0d, 0x71, 0x75, 0x6c, 0x0d, 0x7d, 0x2c, 0x85, 0x1b, 0xe1,
+	0x8d, 0x77, 0xcd, 0xb4, 0xb8, 0x0d, 0x78, 0x27, 0xdd, 0xbd, 0x3b, 0xc3, 0xf9, 0x94, 0xea, 0x58,
+	0x55, 0xfe, 0x27, 0x21, 0x30, 0xea, 0x68, 0x4d, 0xbf, 0xf5, 0xe4, 0x7f, 0xd3, 0x6f, 0xc2, 0x6c,
+	0x2a, 0x9b, 0xf0, 0x65, 0x88, 0x7a, 0x82, 0xc9, 0xa0, 0xe7, 0x3f, 0xf7, 0xcf, 0x19, 0x18, 0xe3,
+	0xab, 0xc9, 0x8f, 0x25, 0x18, 0xf7, 0x1c, 0x16, 0x99, 0x13, 0xd3, 0xd1, 0x6b, 0xe0, 0xe4, 0xf9,
+	0x3e, 0x08, 0x6f, 0x4f, 0x7a, 0xf1, 0x47, 0x2f, 0xfe, 0xfd, 0x24, 0xa7, 0x90, 0x45, 0xa5, 0xaa,
+	0x39, 0x55, 0xb5, 0xde, 0x60, 0x76, 0xf8, 0x57, 0x6d, 0x57, 0xd5, 0x0c, 0x25, 0xc1, 0x42, 0x93,
+	0x3f, 0x4a, 0xf0, 0xb1, 0x50, 0x5d, 0xe4, 0xab, 0x09, 0x7b, 0x25, 0xb9, 0x3d, 0xf9, 0xc4, 0x60,
+	0x20, 0x72, 0x5b, 0xe5, 0xdc, 0xae, 0x91, 0x95, 0x21, 0xb9, 0x35, 0x98, 0x53, 0x09, 0xcf, 0x4e,
+	0xe9, 0x44, 0xcf, 0xb1, 0x4b, 0xfe, 0x20, 0xc1, 0x27, 0xa2, 0xfb, 0x21, 0x29, 0x54, 0x7a, 0x6d,
+	0x95, 0xfc, 0xb5, 0x21, 0x90, 0xc8, 0xfa, 0x06, 0x67, 0xbd, 0x44, 0xbe, 0x93, 0x81, 0x75, 0xf4,
+	0x27, 0x02, 0xa5, 0xe3, 0x59, 0xb6, 0x2e, 0xf9, 0x75, 0x0e, 0x48, 0xaf, 0x69, 0x20, 0xa7, 0x53,
+	0xb8, 0x24, 0xfa, 0x30, 0x79, 0x71, 0x48, 0x34, 0xb2, 0x7f, 0x2a, 0x71, 0xfa, 0xbf, 0x95, 0xc8,
+	0x6f, 0xa4, 0x2c, 0x02, 0x30, 0x9c, 0x5d, 0x41, 0x67, 0x12, 0xcb, 0xbe, 0xd2, 0x11, 0x6e, 0xe6,
+	0xe0, 0x3b, 0xb8, 0x81, 0xdd, 0x11, 0xd1, 0x17, 0x76, 0x95, 0x4e, 0xc4, 0xb3, 0x85, 0x2b, 0x7c,
+	0xdf, 0xd5, 0x25, 0x3f, 0xcf, 0xc1, 0x67, 0x09, 0x2d, 0x3f, 0x49, 0x91, 0x9c, 0xe2, 0x8f, 0xe4,
+	0xe2, 0xb0, 0x70, 0x4c, 0xd1, 0xaf, 0xbc, 0x14, 0xfd, 0x52, 0x22, 0x3f, 0xcb, 0x92, 0xa2, 0xa0,
+	0x9f, 0x3c, 0x40, 0x8a, 0x7a, 0x7b, 0xd2, 0xae, 0xd2, 0x09, 0x7c, 0x52, 0x97, 0x3c, 0xcd, 0xc1,
+	0xe1, 0xe4, 0xf6, 0x93, 0x9c, 0x49, 0x56, 0x99, 0xde, 0xb1, 0xcb, 0x67, 0x33, 0xac, 0x38, 0x58,
+	0xf5, 0x30, 0x67, 0xb7, 0x12, 0xf4, 0xd1, 0x07, 0xa9, 0xa0, 0xe0, 0xc1, 0x4e, 0xcb, 0x55, 0xb0,
+	0x4b, 0x97, 0xfc, 0x5e, 0x02, 0x08, 0x2f, 0x63, 0xb2, 0x90, 0xac, 0x56, 0xfc, 0x3d, 0x48, 0x3e,
+	0x3e, 0x00, 0x85, 0x79, 0x58, 0xe7, 0x69, 0xb8, 0x4d, 0x6e, 0x65, 0x48, 0x02, 0xde, 0xf5, 0xbd,
+	0xb2, 0xfd, 0x72, 0xff, 0x93, 0x04, 0x9f, 0xc6, 0x0c, 0x1c, 0x49, 0xb9, 0x97, 0x12, 0x7c, 0xac,
+	0x7c, 0x72, 0x18, 0x28, 0xf2, 0xbf, 0xc9, 0xf9, 0xaf, 0x90, 0xa5, 0x0c, 0xfc, 0x85, 0x1f, 0x31,
+	0x95, 0x0e, 0x9a, 0xd2, 0x2e, 0x79, 0x21, 0xc1, 0x54, 0x92, 0xef, 0x24, 0xc5, 0xc1, 0x84, 0xa2,
+	0xc6, 0x57, 0x56, 0x86, 0xc6, 0xa3, 0x8a, 0x6d, 0xae, 0x62, 0x83, 0x94, 0xdf, 0x56, 0x45, 0x85,
+	0x3b, 0xdc, 0x50, 0x4b, 0xe4, 0x30, 0x9e, 0x7b, 0xaa, 0x7a, 0x3c, 0x55, 0x9a, 0xaa, 0x34, 0x43,
+	0x27, 0x2b, 0x43, 0xe3, 0x51, 0xd5, 0x3d, 0xae, 0xea, 0x2e, 0xb9, 0x93, 0x41, 0xd5, 0x0f, 0xfc,
+	0x68, 0x7e, 0x95, 0xd9, 0x4a, 0x87, 0x3b, 0xc7, 0xa8, 0xa4, 0x7f, 0x48, 0x30, 0x9d, 0x6a, 0xcc,
+	0xc8, 0xf9, 0x04, 0x9e, 0x83, 0x9c, 0xa0, 0x7c, 0x21, 0xdb, 0x22, 0x54, 0xb8, 0xc9, 0x15, 0xde,
+	0x21, 0xb7, 0x87, 0x54, 0xa8, 0xd9, 0xc1, 0x03, 0x6a, 0x61, 0xcc, 0x4a, 0x0b, 0x83, 0x46, 0xf4,
+	0xfd, 0x57, 0x82, 0xd9, 0x01, 0x7e, 0x8f, 0x7c, 0x3d, 0xe5, 0xb5, 0x1c, 0x6c, 0x29, 0xe5, 0x6f,
+	0xbc, 0xcd, 0x52, 0x54, 0x5c, 0xe6, 0x8a, 0x57, 0xc9, 0xcd, 0x2c, 0x4f, 0xae, 0xae, 0xf7, 0xca,
+	0xad, 0x04, 0xa6, 0x94, 0xfc, 0x47, 0x82, 0x09, 0xb4, 0x23, 0x64, 0x3e, 0x99, 0x5b, 0xc4, 0xab,
+	0xca, 0xb4, 0x1f, 0x04, 0x69, 0x3e, 0xf1, 0xae, 0xf7, 0xc7, 0x12, 0xf9, 0x69, 0x96, 0xeb, 0x7d,
+	0xcf, 0x74, 0xd8, 0x41, 0x6f, 0xf3, 0xd0, 0x6b, 0x75, 0x95, 0x4e, 0xd4, 0x8c, 0x76, 0xc9, 0xdf,
+	0x25, 0xf8, 0x10, 0x99, 0xda, 0xa4, 0x8f, 0x8c, 0xe0, 0xa4, 0x8e, 0xf5, 0xc5, 0xa0, 0xd6, 0x47,
+	0x9e, 0xd6, 0x2e, 0xe9, 0x64, 0x54, 0x6a, 0xbf, 0x43, 0xa9, 0xe4, 0x2f, 0x12, 0x10, 0xa1, 0x39,
+	0xe6, 0x7e, 0x2c, 0xad, 0x05, 0x4c, 0xb6, 0x8c, 0xf2, 0xe2, 0x90, 0xe8, 0x03, 0x14, 0x63, 0xa8,
+	0xb8, 0xc2, 0xad, 0x62, 0xbc, 0xf9, 0xfe, 0xb3, 0x04, 0x5f, 0xea, 0x31, 0x7c, 0xe4, 0x54, 0x32,
+	0xb1, 0x44, 0x6f, 0x29, 0x9f, 0x1e, 0x0e, 0x8c, 0x22, 0xee, 0x72, 0x11, 0xb7, 0x48, 0x29, 0x83,
+	0x08, 0x34, 0x90, 0x95, 0xa6, 0x17, 0x2b, 0xae, 0xe1, 0xa5, 0x77, 0x16, 0x31, 0xf7, 0x96, 0x76,
+	0x16, 0xc9, 0x96, 0x53, 0x5e, 0x1c, 0x12, 0x8d, 0x32, 0x1a, 0x5c, 0x86, 0x4a, 0x2a, 0xd9, 0x1b,
+	0x89, 0xca, 0x8e, 0x69, 0x55, 0xf8, 0x64, 0x6a, 0x4b, 0xa1, 0x74, 0xd0, 0xb1, 0x76, 0x97, 0xca,
+	0xcf, 0x5e, 0x17, 0xa4, 0xe7, 0xaf, 0x0b, 0xd2, 0xbf, 0x5e, 0x17, 0xa4, 0x5f, 0xbc, 0x29, 0x8c,
+	0x3c, 0x7f, 0x53, 0x18, 0xf9, 0xdb, 0x9b, 0xc2, 0xc8, 0xf6, 0xe5, 0x86, 0xe6, 0xec, 0xb6, 0xab,
+	0xc5, 0x9a, 0xd9, 0x4c, 0x27, 0xf1, 0x50, 0xa4, 0xe1, 0xec, 0xb7, 0x98, 0x5d, 0x1d, 0xe7, 0xb7,
+	0xd2, 0xf9, 0xff, 0x0f, 0x00, 0xfb, 0x77, 0xf6, 0x6a, 0x0d, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1870,6 +1964,8 @@ type QueryClient interface {
 	GetVotes(ctx context.Context, in *QueryGetVotesRequest, opts ...grpc.CallOption) (*QueryGetVotesResponse, error)
 	// Queries collection stats (holder count and circulating supply) by collection ID.
 	GetCollectionStats(ctx context.Context, in *QueryGetCollectionStatsRequest, opts ...grpc.CallOption) (*QueryGetCollectionStatsResponse, error)
+	// Queries the pending manager nomination for a collection.
+	GetPendingManager(ctx context.Context, in *QueryGetPendingManagerRequest, opts ...grpc.CallOption) (*QueryGetPendingManagerResponse, error)
 	// Queries the balance amount for a specific token ID at a specific time.
 	GetBalanceForToken(ctx context.Context, in *QueryGetBalanceForTokenRequest, opts ...grpc.CallOption) (*QueryGetBalanceForTokenResponse, error)
 }
@@ -2017,6 +2113,15 @@ func (c *queryClient) GetCollectionStats(ctx context.Context, in *QueryGetCollec
 	return out, nil
 }
 
+func (c *queryClient) GetPendingManager(ctx context.Context, in *QueryGetPendingManagerRequest, opts ...grpc.CallOption) (*QueryGetPendingManagerResponse, error) {
+	out := new(QueryGetPendingManagerResponse)
+	err := c.cc.Invoke(ctx, "/tokenization.Query/GetPendingManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetBalanceForToken(ctx context.Context, in *QueryGetBalanceForTokenRequest, opts ...grpc.CallOption) (*QueryGetBalanceForTokenResponse, error) {
 	out := new(QueryGetBalanceForTokenResponse)
 	err := c.cc.Invoke(ctx, "/tokenization.Query/GetBalanceForToken", in, out, opts...)
@@ -2058,6 +2163,8 @@ type QueryServer interface {
 	GetVotes(context.Context, *QueryGetVotesRequest) (*QueryGetVotesResponse, error)
 	// Queries collection stats (holder count and circulating supply) by collection ID.
 	GetCollectionStats(context.Context, *QueryGetCollectionStatsRequest) (*QueryGetCollectionStatsResponse, error)
+	// Queries the pending manager nomination for a collection.
+	GetPendingManager(context.Context, *QueryGetPendingManagerRequest) (*QueryGetPendingManagerResponse, error)
 	// Queries the balance amount for a specific token ID at a specific time.
 	GetBalanceForToken(context.Context, *QueryGetBalanceForTokenRequest) (*QueryGetBalanceForTokenResponse, error)
 }
@@ -2111,6 +2218,9 @@ func (*UnimplementedQueryServer) GetVotes(ctx context.Context, req *QueryGetVote
 func (*UnimplementedQueryServer) GetCollectionStats(ctx context.Context, req *QueryGetCollectionStatsRequest) (*QueryGetCollectionStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStats not implemented")
 }
+func (*UnimplementedQueryServer) GetPendingManager(ctx context.Context, req *QueryGetPendingManagerRequest) (*QueryGetPendingManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingManager not implemented")
+}
 func (*UnimplementedQueryServer) GetBalanceForToken(ctx context.Context, req *QueryGetBalanceForTokenRequest) (*QueryGetBalanceForTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceForToken not implemented")
 }
@@ -2389,6 +2499,24 @@ func _Query_GetCollectionStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPendingManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenization.Query/GetPendingManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingManager(ctx, req.(*QueryGetPendingManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetBalanceForToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetBalanceForTokenRequest)
 	if err := dec(in); err != nil {
@@ -2472,6 +2600,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCollectionStats",
 			Handler:    _Query_GetCollectionStats_Handler,
 		},
+		{
+			MethodName: "GetPendingManager",
+			Handler:    _Query_GetPendingManager_Handler,
+		},
 		{
 			MethodName: "GetBalanceForToken",
 			Handler:    _Query_GetBalanceForToken_Handler,
@@ -3621,6 +3753,71 @@ func (m *QueryGetCollectionStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetPendingManagerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingManagerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingManagerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectionId) > 0 {
+		i -= len(m.CollectionId)
+		copy(dAtA[i:], m.CollectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingManager != nil {
+		{
+			size, err := m.PendingManager.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetBalanceForTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4206,6 +4403,32 @@ func (m *QueryGetCollectionStatsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetPendingManagerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingManager != nil {
+		l = m.PendingManager.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGetBalanceForTokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7567,6 +7790,174 @@ func (m *QueryGetCollectionStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetPendingManagerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingManagerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingManagerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingManager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingManager == nil {
+				m.PendingManager = &PendingManager{}
+			}
+			if err := m.PendingManager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetBalanceForTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
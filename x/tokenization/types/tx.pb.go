@@ -61,6 +61,9 @@ type TokenizationCustomMsgType struct {
 	SetIsArchivedMsg              *MsgSetIsArchived              `protobuf:"bytes,26,opt,name=setIsArchivedMsg,proto3" json:"setIsArchivedMsg,omitempty"`
 	SetReservedProtocolAddressMsg *MsgSetReservedProtocolAddress `protobuf:"bytes,27,opt,name=setReservedProtocolAddressMsg,proto3" json:"setReservedProtocolAddressMsg,omitempty"`
 	CastVoteMsg                   *MsgCastVote                   `protobuf:"bytes,28,opt,name=castVoteMsg,proto3" json:"castVoteMsg,omitempty"`
+	NominateManagerMsg            *MsgNominateManager            `protobuf:"bytes,29,opt,name=nominateManagerMsg,proto3" json:"nominateManagerMsg,omitempty"`
+	AcceptManagerMsg              *MsgAcceptManager              `protobuf:"bytes,30,opt,name=acceptManagerMsg,proto3" json:"acceptManagerMsg,omitempty"`
+	CancelManagerNominationMsg    *MsgCancelManagerNomination    `protobuf:"bytes,31,opt,name=cancelManagerNominationMsg,proto3" json:"cancelManagerNominationMsg,omitempty"`
 }
 
 func (m *TokenizationCustomMsgType) Reset()         { *m = TokenizationCustomMsgType{} }
@@ -278,6 +281,27 @@ func (m *TokenizationCustomMsgType) GetCastVoteMsg() *MsgCastVote {
 	return nil
 }
 
+func (m *TokenizationCustomMsgType) GetNominateManagerMsg() *MsgNominateManager {
+	if m != nil {
+		return m.NominateManagerMsg
+	}
+	return nil
+}
+
+func (m *TokenizationCustomMsgType) GetAcceptManagerMsg() *MsgAcceptManager {
+	if m != nil {
+		return m.AcceptManagerMsg
+	}
+	return nil
+}
+
+func (m *TokenizationCustomMsgType) GetCancelManagerNominationMsg() *MsgCancelManagerNomination {
+	if m != nil {
+		return m.CancelManagerNominationMsg
+	}
+	return nil
+}
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -680,7 +704,7 @@ type MsgUniversalUpdateCollection struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	// ID of the collection (0 for new collection).
 	CollectionId Uint `protobuf:"bytes,2,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
-	// The default balances for the user
+	//The default balances for the user
 	DefaultBalances *UserBalanceStore `protobuf:"bytes,3,opt,name=defaultBalances,proto3" json:"defaultBalances,omitempty"`
 	// Indicates if the valid token IDs should be updated. If true, we set to value in this Msg. If false, we keep existing value.
 	UpdateValidTokenIds bool `protobuf:"varint,4,opt,name=updateValidTokenIds,proto3" json:"updateValidTokenIds,omitempty"`
@@ -1293,7 +1317,7 @@ func (m *MsgUpdateCollectionResponse) GetReviewItems() []string {
 type MsgCreateCollection struct {
 	// Address of the creator.
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// The default balances for the user
+	//The default balances for the user
 	DefaultBalances *UserBalanceStore `protobuf:"bytes,2,opt,name=defaultBalances,proto3" json:"defaultBalances,omitempty"`
 	// New token IDs to add to this collection
 	ValidTokenIds []*UintRange `protobuf:"bytes,3,rep,name=validTokenIds,proto3" json:"validTokenIds,omitempty"`
@@ -4175,6 +4199,277 @@ func (m *MsgCastVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCastVoteResponse proto.InternalMessageInfo
 
+// MsgNominateManager nominates a new manager for a collection. The nominee must accept via MsgAcceptManager
+// before the deadline for the manager to change. canUpdateManager is checked at nomination time.
+type MsgNominateManager struct {
+	// Address of the creator (must be the current manager).
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the collection.
+	CollectionId Uint `protobuf:"bytes,2,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+	// Address of the nominated manager.
+	Nominee string `protobuf:"bytes,3,opt,name=nominee,proto3" json:"nominee,omitempty"`
+	// Time (UNIX milliseconds) after which the nomination can no longer be accepted.
+	ExpiresAt Uint `protobuf:"bytes,4,opt,name=expiresAt,proto3,customtype=Uint" json:"expiresAt"`
+}
+
+func (m *MsgNominateManager) Reset()         { *m = MsgNominateManager{} }
+func (m *MsgNominateManager) String() string { return proto.CompactTextString(m) }
+func (*MsgNominateManager) ProtoMessage()    {}
+func (*MsgNominateManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f9b57e9235d83e7, []int{59}
+}
+func (m *MsgNominateManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNominateManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNominateManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgNominateManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNominateManager.Merge(m, src)
+}
+func (m *MsgNominateManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNominateManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNominateManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgNominateManager proto.InternalMessageInfo
+
+func (m *MsgNominateManager) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgNominateManager) GetNominee() string {
+	if m != nil {
+		return m.Nominee
+	}
+	return ""
+}
+
+// MsgNominateManagerResponse is the response to MsgNominateManager.
+type MsgNominateManagerResponse struct {
+	// ID of the collection.
+	CollectionId Uint `protobuf:"bytes,1,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+}
+
+func (m *MsgNominateManagerResponse) Reset()         { *m = MsgNominateManagerResponse{} }
+func (m *MsgNominateManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgNominateManagerResponse) ProtoMessage()    {}
+func (*MsgNominateManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f9b57e9235d83e7, []int{60}
+}
+func (m *MsgNominateManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNominateManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNominateManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgNominateManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNominateManagerResponse.Merge(m, src)
+}
+func (m *MsgNominateManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNominateManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNominateManagerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgNominateManagerResponse proto.InternalMessageInfo
+
+// MsgAcceptManager accepts a pending manager nomination, making the nominee the manager.
+type MsgAcceptManager struct {
+	// Address of the creator (must be the nominee).
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the collection.
+	CollectionId Uint `protobuf:"bytes,2,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+}
+
+func (m *MsgAcceptManager) Reset()         { *m = MsgAcceptManager{} }
+func (m *MsgAcceptManager) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptManager) ProtoMessage()    {}
+func (*MsgAcceptManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f9b57e9235d83e7, []int{61}
+}
+func (m *MsgAcceptManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptManager.Merge(m, src)
+}
+func (m *MsgAcceptManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptManager proto.InternalMessageInfo
+
+func (m *MsgAcceptManager) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgAcceptManagerResponse is the response to MsgAcceptManager.
+type MsgAcceptManagerResponse struct {
+	// ID of the collection.
+	CollectionId Uint `protobuf:"bytes,1,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+}
+
+func (m *MsgAcceptManagerResponse) Reset()         { *m = MsgAcceptManagerResponse{} }
+func (m *MsgAcceptManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptManagerResponse) ProtoMessage()    {}
+func (*MsgAcceptManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f9b57e9235d83e7, []int{62}
+}
+func (m *MsgAcceptManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptManagerResponse.Merge(m, src)
+}
+func (m *MsgAcceptManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptManagerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptManagerResponse proto.InternalMessageInfo
+
+// MsgCancelManagerNomination cancels a pending manager nomination.
+type MsgCancelManagerNomination struct {
+	// Address of the creator (must be the current manager).
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the collection.
+	CollectionId Uint `protobuf:"bytes,2,opt,name=collectionId,proto3,customtype=Uint" json:"collectionId"`
+}
+
+func (m *MsgCancelManagerNomination) Reset()         { *m = MsgCancelManagerNomination{} }
+func (m *MsgCancelManagerNomination) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelManagerNomination) ProtoMessage()    {}
+func (*MsgCancelManagerNomination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f9b57e9235d83e7, []int{63}
+}
+func (m *MsgCancelManagerNomination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelManagerNomination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelManagerNomination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelManagerNomination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelManagerNomination.Merge(m, src)
+}
+func (m *MsgCancelManagerNomination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelManagerNomination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelManagerNomination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelManagerNomination proto.InternalMessageInfo
+
+func (m *MsgCancelManagerNomination) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgCancelManagerNominationResponse is the response to MsgCancelManagerNomination.
+type MsgCancelManagerNominationResponse struct {
+}
+
+func (m *MsgCancelManagerNominationResponse) Reset()         { *m = MsgCancelManagerNominationResponse{} }
+func (m *MsgCancelManagerNominationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelManagerNominationResponse) ProtoMessage()    {}
+func (*MsgCancelManagerNominationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f9b57e9235d83e7, []int{64}
+}
+func (m *MsgCancelManagerNominationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelManagerNominationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelManagerNominationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelManagerNominationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelManagerNominationResponse.Merge(m, src)
+}
+func (m *MsgCancelManagerNominationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelManagerNominationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelManagerNominationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelManagerNominationResponse proto.InternalMessageInfo
+
 // ApprovalUsed represents an approval that was consumed during a transfer.
 type ApprovalUsed struct {
 	ApprovalId      string `protobuf:"bytes,1,opt,name=approvalId,proto3" json:"approvalId,omitempty"`
@@ -4187,7 +4482,7 @@ func (m *ApprovalUsed) Reset()         { *m = ApprovalUsed{} }
 func (m *ApprovalUsed) String() string { return proto.CompactTextString(m) }
 func (*ApprovalUsed) ProtoMessage()    {}
 func (*ApprovalUsed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f9b57e9235d83e7, []int{59}
+	return fileDescriptor_0f9b57e9235d83e7, []int{65}
 }
 func (m *ApprovalUsed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4257,7 +4552,7 @@ func (m *CoinTransferProto) Reset()         { *m = CoinTransferProto{} }
 func (m *CoinTransferProto) String() string { return proto.CompactTextString(m) }
 func (*CoinTransferProto) ProtoMessage()    {}
 func (*CoinTransferProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f9b57e9235d83e7, []int{60}
+	return fileDescriptor_0f9b57e9235d83e7, []int{66}
 }
 func (m *CoinTransferProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4333,7 +4628,7 @@ func (m *ApprovalChange) Reset()         { *m = ApprovalChange{} }
 func (m *ApprovalChange) String() string { return proto.CompactTextString(m) }
 func (*ApprovalChange) ProtoMessage()    {}
 func (*ApprovalChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f9b57e9235d83e7, []int{61}
+	return fileDescriptor_0f9b57e9235d83e7, []int{67}
 }
 func (m *ApprovalChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4450,6 +4745,12 @@ func init() {
 	proto.RegisterType((*MsgSetReservedProtocolAddressResponse)(nil), "tokenization.MsgSetReservedProtocolAddressResponse")
 	proto.RegisterType((*MsgCastVote)(nil), "tokenization.MsgCastVote")
 	proto.RegisterType((*MsgCastVoteResponse)(nil), "tokenization.MsgCastVoteResponse")
+	proto.RegisterType((*MsgNominateManager)(nil), "tokenization.MsgNominateManager")
+	proto.RegisterType((*MsgNominateManagerResponse)(nil), "tokenization.MsgNominateManagerResponse")
+	proto.RegisterType((*MsgAcceptManager)(nil), "tokenization.MsgAcceptManager")
+	proto.RegisterType((*MsgAcceptManagerResponse)(nil), "tokenization.MsgAcceptManagerResponse")
+	proto.RegisterType((*MsgCancelManagerNomination)(nil), "tokenization.MsgCancelManagerNomination")
+	proto.RegisterType((*MsgCancelManagerNominationResponse)(nil), "tokenization.MsgCancelManagerNominationResponse")
 	proto.RegisterType((*ApprovalUsed)(nil), "tokenization.ApprovalUsed")
 	proto.RegisterType((*CoinTransferProto)(nil), "tokenization.CoinTransferProto")
 	proto.RegisterType((*ApprovalChange)(nil), "tokenization.ApprovalChange")
@@ -4458,257 +4759,266 @@ func init() {
 func init() { proto.RegisterFile("tokenization/tx.proto", fileDescriptor_0f9b57e9235d83e7) }
 
 var fileDescriptor_0f9b57e9235d83e7 = []byte{
-	// 3988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xda, 0x5d, 0x3e, 0x76, 0x8b, 0xef, 0xe6, 0x7b, 0x25, 0x51, 0xd4, 0x5a, 0x2f, 0x53, 0x32,
-	0x69, 0xc9, 0x82, 0x6d, 0xf0, 0xfb, 0x3e, 0xc3, 0x14, 0x29, 0xd9, 0xf4, 0x67, 0x5a, 0x4c, 0x93,
-	0x94, 0x60, 0xc3, 0x88, 0x3c, 0xdc, 0x6d, 0x2e, 0x27, 0xde, 0x9d, 0xd9, 0xcc, 0xcc, 0x52, 0x62,
-	0x02, 0x04, 0x41, 0x2e, 0x31, 0xe2, 0x43, 0x92, 0x53, 0xf2, 0x0b, 0xf2, 0x00, 0x72, 0x10, 0x10,
-	0x5f, 0x7c, 0x08, 0x0c, 0x24, 0x17, 0x5f, 0x82, 0x18, 0xbe, 0x38, 0x08, 0x10, 0x23, 0xb1, 0x0f,
-	0x3a, 0xe4, 0x92, 0x5b, 0x10, 0x20, 0x41, 0x82, 0xe9, 0x99, 0x9e, 0x99, 0x7e, 0xed, 0xce, 0x92,
-	0x2b, 0x03, 0xba, 0x48, 0xdc, 0xee, 0xaa, 0xea, 0xee, 0xea, 0x9a, 0x7a, 0x75, 0x75, 0xc3, 0xa4,
-	0x67, 0xbf, 0x4b, 0x2c, 0xf3, 0x5b, 0x86, 0x67, 0xda, 0xd6, 0x92, 0xf7, 0x60, 0xb1, 0xe1, 0xd8,
-	0x9e, 0x8d, 0x06, 0x93, 0xcd, 0xc5, 0x31, 0xa3, 0x6e, 0x5a, 0xf6, 0x12, 0xfd, 0x37, 0x00, 0x28,
-	0x4e, 0x97, 0x6d, 0xb7, 0x6e, 0xbb, 0x4b, 0x75, 0xb7, 0xba, 0x74, 0x70, 0xd5, 0xff, 0x2f, 0xec,
-	0x98, 0x0d, 0x3a, 0xee, 0xd1, 0x5f, 0x4b, 0xc1, 0x8f, 0xb0, 0x6b, 0xa2, 0x6a, 0x57, 0xed, 0xa0,
-	0xdd, 0xff, 0x8b, 0x21, 0x70, 0x33, 0x68, 0x18, 0x8e, 0x51, 0x67, 0x08, 0xa7, 0xf8, 0xc9, 0x39,
-	0x86, 0xe5, 0xee, 0x11, 0x87, 0xf5, 0x9e, 0xe4, 0x7a, 0x77, 0x8d, 0x9a, 0x61, 0x95, 0x09, 0xeb,
-	0x9c, 0xe3, 0xa9, 0x12, 0xa7, 0x6e, 0xba, 0xae, 0x69, 0x5b, 0x6a, 0xe4, 0x3a, 0xf1, 0x8c, 0x8a,
-	0xe1, 0x19, 0x4a, 0xe4, 0xb2, 0x5d, 0xab, 0x91, 0xb2, 0x97, 0x40, 0x9e, 0xe7, 0xfa, 0x8d, 0x4a,
-	0xc5, 0x21, 0xae, 0x7b, 0xaf, 0x66, 0xba, 0x1e, 0x83, 0x38, 0xcb, 0x41, 0x54, 0x0e, 0x2d, 0xa3,
-	0x6e, 0x96, 0xef, 0xb9, 0x9e, 0xed, 0x10, 0xf5, 0xe2, 0x8c, 0x46, 0xc3, 0xb1, 0x0f, 0x8c, 0x1a,
-	0xeb, 0x3d, 0xcf, 0xf5, 0x36, 0x5d, 0xe2, 0xdc, 0x0b, 0x57, 0x18, 0x50, 0x09, 0xc1, 0x4e, 0xf3,
-	0x33, 0xdd, 0x37, 0x6a, 0x35, 0x62, 0x55, 0x63, 0x2e, 0x84, 0xbb, 0xb4, 0x6b, 0xb8, 0x64, 0xe9,
-	0xe0, 0xea, 0x2e, 0xf1, 0x8c, 0xab, 0x4b, 0x65, 0xdb, 0xb4, 0x82, 0xfe, 0xd2, 0xcf, 0x10, 0xcc,
-	0x6e, 0x27, 0x28, 0xac, 0x36, 0x5d, 0xcf, 0xae, 0x6f, 0xb8, 0xd5, 0xed, 0xc3, 0x06, 0x41, 0x6f,
-	0xc2, 0x64, 0xd9, 0x21, 0x86, 0x47, 0x56, 0x82, 0x15, 0xbe, 0xee, 0x2f, 0x70, 0xc3, 0xad, 0xce,
-	0x64, 0xe6, 0x33, 0x97, 0x06, 0xae, 0x3d, 0xb5, 0x98, 0x1c, 0x7c, 0x71, 0xc3, 0xad, 0xae, 0x4a,
-	0xd0, 0x58, 0x4d, 0x01, 0x59, 0x70, 0xaa, 0x69, 0x99, 0x07, 0xc4, 0x71, 0x8d, 0xda, 0x4e, 0xa3,
-	0x62, 0x78, 0x64, 0x35, 0xe2, 0xb2, 0x3f, 0x42, 0x96, 0x8e, 0xb0, 0x20, 0x8d, 0xb0, 0xa3, 0x43,
-	0xc2, 0x2d, 0xe9, 0xa1, 0x2d, 0x18, 0xaf, 0x90, 0x1a, 0x11, 0x87, 0xc9, 0xd1, 0x61, 0xce, 0x4a,
-	0xc3, 0xac, 0x09, 0xb0, 0x58, 0x85, 0x8d, 0x36, 0x60, 0x8c, 0xc9, 0x24, 0x65, 0x22, 0xe5, 0x4d,
-	0x0f, 0x25, 0x79, 0x46, 0x22, 0xb9, 0xcd, 0x41, 0x62, 0x19, 0x13, 0xbd, 0x0d, 0x53, 0x4d, 0x3a,
-	0xf5, 0x1d, 0x97, 0x38, 0x2b, 0x4c, 0x1e, 0x7c, 0x9a, 0xbd, 0x94, 0xe6, 0x39, 0x99, 0x1b, 0x32,
-	0x38, 0xd6, 0xd0, 0xf0, 0x39, 0xd0, 0x54, 0x30, 0xba, 0x4f, 0xc3, 0x01, 0x89, 0xbf, 0x2a, 0x6c,
-	0x9f, 0x68, 0xb0, 0xbf, 0x3c, 0xd1, 0x7e, 0x0d, 0xd1, 0x55, 0x01, 0x16, 0xab, 0xb0, 0x63, 0xb1,
-	0x5b, 0x0b, 0x3e, 0x9b, 0x2d, 0x5f, 0xde, 0x7d, 0xb2, 0xf9, 0x96, 0x62, 0x97, 0x84, 0xc6, 0x6a,
-	0x0a, 0x3e, 0xe9, 0x60, 0x19, 0x22, 0xe9, 0x82, 0x86, 0xf4, 0x8e, 0x04, 0x8d, 0xd5, 0x14, 0x7c,
-	0xd2, 0x81, 0x8c, 0x88, 0xa4, 0x41, 0x43, 0x7a, 0x4d, 0x82, 0xc6, 0x6a, 0x0a, 0xe8, 0x1e, 0x4c,
-	0xbb, 0xc4, 0x4b, 0xb6, 0xde, 0x31, 0x6a, 0x4d, 0x4a, 0x7c, 0x80, 0x12, 0x3f, 0x2f, 0x11, 0xdf,
-	0x52, 0xc0, 0x63, 0x1d, 0x15, 0x5f, 0xf2, 0x5c, 0xe2, 0xad, 0x5b, 0x65, 0xbb, 0x6e, 0x5a, 0x55,
-	0x26, 0x36, 0x3e, 0xfd, 0x61, 0x8d, 0xe4, 0x6d, 0xc9, 0xe0, 0x58, 0x43, 0x03, 0x11, 0x98, 0x0d,
-	0xd6, 0xa5, 0x1a, 0x60, 0x84, 0x0e, 0x70, 0x51, 0xc3, 0x1d, 0x69, 0x0c, 0x3d, 0xa5, 0x70, 0x11,
-	0xb7, 0x9b, 0x5e, 0xd5, 0x16, 0xc6, 0x18, 0xd5, 0x2f, 0x42, 0x04, 0xc7, 0x1a, 0x1a, 0xf1, 0x22,
-	0x54, 0x03, 0x8c, 0xb5, 0x5c, 0x84, 0x34, 0x86, 0x9e, 0x92, 0xaf, 0x52, 0x1a, 0x4d, 0xa7, 0x4a,
-	0xb8, 0xcf, 0x1f, 0x69, 0x54, 0xca, 0x26, 0x07, 0x89, 0x65, 0x4c, 0xff, 0xfb, 0x74, 0x89, 0x77,
-	0xc7, 0xa8, 0x99, 0x15, 0xaa, 0x67, 0xd6, 0x2b, 0x94, 0xe0, 0xb8, 0xe6, 0xfb, 0xdc, 0x12, 0x60,
-	0xb1, 0x0a, 0x1b, 0xad, 0xc0, 0x90, 0x4b, 0xbc, 0x0d, 0xc3, 0x32, 0xaa, 0xc4, 0xf1, 0xc9, 0x4d,
-	0x50, 0x72, 0x27, 0x55, 0xe4, 0x42, 0x28, 0xcc, 0x63, 0xa0, 0x5d, 0x98, 0x71, 0x89, 0x97, 0xf8,
-	0xec, 0x43, 0xfb, 0xeb, 0x53, 0x9b, 0xa4, 0xd4, 0x2e, 0xa8, 0xa8, 0xc9, 0x08, 0x58, 0x4b, 0x27,
-	0x5c, 0x3b, 0x9d, 0x78, 0x92, 0xfc, 0x94, 0x7e, 0xed, 0x1c, 0x2c, 0x56, 0x61, 0xa3, 0xd7, 0x60,
-	0xd4, 0x1f, 0x90, 0x9a, 0xc9, 0xb5, 0x90, 0xe2, 0x34, 0xa5, 0x38, 0xa7, 0x9c, 0x70, 0x04, 0x88,
-	0x25, 0x3c, 0xf4, 0x0a, 0x8c, 0xb8, 0xc4, 0xdb, 0xf2, 0x0c, 0xab, 0x62, 0x38, 0xc1, 0xc6, 0xcc,
-	0x50, 0x52, 0xa7, 0x55, 0xa4, 0x22, 0x38, 0x2c, 0x62, 0xf9, 0xb2, 0xc9, 0x71, 0x81, 0x13, 0x9e,
-	0x59, 0x8d, 0x6c, 0x6e, 0x29, 0x31, 0xb0, 0x9e, 0x52, 0xb8, 0xf6, 0x75, 0x77, 0xc5, 0x29, 0xef,
-	0x9b, 0x07, 0xa4, 0xe2, 0x53, 0x2f, 0xea, 0xd7, 0x1e, 0x03, 0x62, 0x09, 0x0f, 0x7d, 0x13, 0x4e,
-	0xbb, 0xc4, 0xc3, 0xc4, 0x25, 0xce, 0x01, 0xa9, 0x6c, 0xfa, 0xce, 0x48, 0xd9, 0xae, 0x85, 0x5e,
-	0x82, 0x4f, 0xf8, 0x24, 0x25, 0x7c, 0x59, 0x45, 0x58, 0x83, 0x85, 0x5b, 0x53, 0x44, 0xff, 0x03,
-	0x03, 0x65, 0xc3, 0xf5, 0xee, 0xd8, 0x1e, 0xd5, 0x9c, 0xa7, 0xe8, 0x00, 0xb3, 0xb2, 0x31, 0x09,
-	0x61, 0x70, 0x12, 0xba, 0xf4, 0xeb, 0x0c, 0x8c, 0x44, 0xe6, 0x60, 0x93, 0xfa, 0xa8, 0xe8, 0x79,
-	0x28, 0x18, 0x4d, 0x6f, 0xdf, 0x76, 0x4c, 0xef, 0x90, 0xba, 0x44, 0x85, 0x1b, 0x33, 0x9f, 0x7e,
-	0xf0, 0xcc, 0x44, 0xe8, 0xf3, 0x86, 0x43, 0x6f, 0x79, 0x8e, 0x69, 0x55, 0x71, 0x0c, 0x8a, 0x5e,
-	0x80, 0xbe, 0xc0, 0xcb, 0x0d, 0xbd, 0x9c, 0x09, 0x7e, 0x0e, 0x01, 0xf5, 0x1b, 0x85, 0x8f, 0x3f,
-	0x3f, 0x73, 0xe2, 0x17, 0x8f, 0x1e, 0x2e, 0x64, 0x70, 0x08, 0xbe, 0xbc, 0xf4, 0xbd, 0x47, 0x0f,
-	0x17, 0x62, 0x42, 0x3f, 0x78, 0xf4, 0x70, 0x81, 0x77, 0x22, 0x85, 0x19, 0x96, 0x66, 0x61, 0x5a,
-	0x68, 0xc2, 0xc4, 0x6d, 0xd8, 0x96, 0x4b, 0x4a, 0xbf, 0xcf, 0xc2, 0xdc, 0x2a, 0x9d, 0xe8, 0xaa,
-	0x6d, 0x5a, 0x77, 0x1d, 0xa3, 0xd1, 0x20, 0xce, 0xa6, 0xe1, 0xed, 0xaf, 0x54, 0x2a, 0xb7, 0x77,
-	0xbf, 0x41, 0xca, 0x1e, 0x9a, 0x80, 0xde, 0x0a, 0xb1, 0xec, 0x7a, 0xb0, 0x36, 0x1c, 0xfc, 0x40,
-	0x6b, 0x00, 0x65, 0xdb, 0xf2, 0x3d, 0x2d, 0xd3, 0xb6, 0xc2, 0x15, 0x08, 0xaa, 0x75, 0x35, 0xea,
-	0xbf, 0x6b, 0x7a, 0xfb, 0x76, 0xd3, 0x5b, 0xf3, 0x31, 0x71, 0x02, 0x0f, 0x4d, 0x41, 0x9f, 0x7b,
-	0x58, 0xdf, 0xb5, 0x6b, 0xd4, 0x05, 0x2b, 0xe0, 0xf0, 0x17, 0x7a, 0x01, 0x80, 0x0e, 0xb3, 0x63,
-	0x99, 0x9e, 0x3b, 0xd3, 0x33, 0x9f, 0xbb, 0x34, 0x70, 0x6d, 0x9a, 0xa7, 0xbe, 0xc6, 0xfa, 0x71,
-	0x02, 0x14, 0xdd, 0x82, 0x39, 0xa3, 0x56, 0xb3, 0xef, 0xdf, 0x3e, 0x20, 0x8e, 0x63, 0x56, 0x88,
-	0x3f, 0xf2, 0x8a, 0x75, 0x18, 0x2b, 0x2f, 0xea, 0x44, 0xe5, 0x71, 0x1b, 0x28, 0xf4, 0x3c, 0xe4,
-	0x59, 0x30, 0x10, 0xfa, 0x46, 0x45, 0x71, 0x7b, 0xbc, 0xfd, 0x48, 0x47, 0x44, 0xb0, 0xa5, 0x7f,
-	0x66, 0x00, 0xad, 0xd4, 0x4c, 0xc3, 0x7d, 0x82, 0x79, 0x98, 0x5c, 0x7b, 0x6f, 0x07, 0x6b, 0x7f,
-	0x13, 0x4e, 0xc7, 0xa2, 0x74, 0xc3, 0x28, 0xbf, 0x4b, 0x2a, 0x3c, 0x17, 0x5e, 0xe4, 0xd6, 0x1b,
-	0x44, 0x0f, 0x33, 0xba, 0xf5, 0x26, 0xd7, 0x58, 0xfa, 0x65, 0x0e, 0xc6, 0xd7, 0xad, 0x03, 0xc3,
-	0x31, 0x0d, 0xcb, 0x73, 0x63, 0x8a, 0xcf, 0xc3, 0x94, 0x65, 0x07, 0xea, 0xf4, 0xf6, 0x7d, 0x8b,
-	0x38, 0xee, 0xbe, 0xd9, 0xd8, 0x36, 0xeb, 0xc4, 0xa5, 0xd4, 0xf3, 0x58, 0xd3, 0x8b, 0xae, 0xc3,
-	0x70, 0xdd, 0x78, 0xb0, 0xd5, 0x6c, 0x34, 0x6a, 0x87, 0x9b, 0xc4, 0x59, 0xaf, 0x50, 0xee, 0x17,
-	0x6e, 0x0c, 0xfa, 0x5f, 0xdb, 0x9f, 0x3e, 0x3f, 0xd3, 0xb3, 0x63, 0x5a, 0x1e, 0x16, 0x60, 0xd0,
-	0x3d, 0x08, 0x3f, 0x6a, 0x7e, 0x81, 0x61, 0xf8, 0x70, 0x59, 0x5c, 0x49, 0x0b, 0x56, 0x60, 0x25,
-	0x21, 0xf4, 0x32, 0x9c, 0xb4, 0xec, 0x5b, 0xb6, 0x53, 0x26, 0x7b, 0xcd, 0xda, 0xa6, 0xed, 0x7a,
-	0x1b, 0xa6, 0xe5, 0xb1, 0x88, 0xc1, 0xa5, 0x31, 0x45, 0x1e, 0xb7, 0x02, 0x41, 0xcf, 0xc2, 0x78,
-	0xc5, 0x74, 0x8d, 0xdd, 0x1a, 0xd9, 0xb4, 0xed, 0x1a, 0xf5, 0x88, 0x7d, 0x5e, 0x07, 0x42, 0xaf,
-	0xea, 0x42, 0xb7, 0x01, 0x91, 0x83, 0xfa, 0xd7, 0x9a, 0xc4, 0x39, 0x5c, 0x8d, 0xe2, 0xc6, 0x99,
-	0x3e, 0x2a, 0x2e, 0x82, 0xaf, 0x71, 0xf3, 0xce, 0x06, 0x0f, 0x87, 0x15, 0xa8, 0xa5, 0xf7, 0x06,
-	0xe1, 0x54, 0xab, 0x10, 0x0d, 0xcd, 0x40, 0x3f, 0x75, 0xcb, 0x6d, 0x27, 0xfc, 0x1c, 0xd8, 0x4f,
-	0xf4, 0x2c, 0x0c, 0xc6, 0x51, 0xb6, 0x66, 0x53, 0x38, 0x08, 0xf4, 0x2a, 0x8c, 0x54, 0xc8, 0x9e,
-	0xd1, 0xac, 0x79, 0x37, 0xc2, 0xc0, 0x3f, 0xdc, 0x0d, 0xc1, 0x16, 0xf9, 0x71, 0x50, 0x08, 0x11,
-	0xf8, 0xd8, 0x22, 0x9a, 0xcf, 0xb9, 0xc0, 0xa3, 0xe7, 0x1c, 0x9d, 0x90, 0xe7, 0xaa, 0x2e, 0xf4,
-	0x7f, 0x30, 0x74, 0xc0, 0xc1, 0xf6, 0xaa, 0xbe, 0x31, 0x3a, 0x69, 0xc3, 0x67, 0x16, 0x0f, 0xed,
-	0x6f, 0xb6, 0x18, 0x4b, 0x6d, 0xc6, 0xf9, 0x09, 0xaa, 0x75, 0xf2, 0xb8, 0x15, 0x08, 0x8d, 0x90,
-	0x94, 0xb8, 0xfd, 0xaa, 0x58, 0x43, 0x49, 0x03, 0xab, 0x29, 0xa0, 0x73, 0x30, 0x14, 0x8c, 0x1c,
-	0x7a, 0x6b, 0x34, 0xe8, 0xca, 0x63, 0xbe, 0xd1, 0xdf, 0xc9, 0x7a, 0xd8, 0x5f, 0x08, 0x76, 0x32,
-	0xfc, 0x89, 0x96, 0x61, 0x46, 0x0a, 0x14, 0x99, 0x4e, 0x01, 0x4a, 0x4a, 0xdb, 0x8f, 0x36, 0x01,
-	0x95, 0x65, 0xac, 0x20, 0xc4, 0x99, 0xd7, 0xad, 0x29, 0xd2, 0x47, 0x0a, 0xdc, 0x78, 0x6f, 0x39,
-	0x47, 0x6e, 0x66, 0x30, 0xb9, 0xb7, 0x5c, 0x97, 0xef, 0xdc, 0x7a, 0x1c, 0xec, 0x10, 0xdd, 0x5b,
-	0xc1, 0xb9, 0xe5, 0x3d, 0x45, 0x1e, 0x03, 0x2d, 0xc0, 0x68, 0xb8, 0xc4, 0xc8, 0xdd, 0xa3, 0x71,
-	0x54, 0x1e, 0x4b, 0xed, 0x68, 0x0e, 0xa0, 0x1c, 0x43, 0x8d, 0x50, 0x5e, 0x26, 0x5a, 0xd0, 0xff,
-	0xc2, 0xac, 0xc8, 0xae, 0xc8, 0x27, 0xa3, 0x71, 0x4d, 0x1e, 0xeb, 0x01, 0x10, 0x86, 0xf1, 0xb2,
-	0x02, 0x6f, 0x8c, 0x2e, 0x49, 0xcb, 0xd1, 0x28, 0x4e, 0x51, 0x21, 0xa3, 0x4b, 0x30, 0x12, 0x0c,
-	0x18, 0xb9, 0xa0, 0x34, 0x3e, 0xc9, 0x63, 0xb1, 0x19, 0x9d, 0x82, 0x82, 0x1b, 0xc1, 0x8c, 0xcf,
-	0xe7, 0x2e, 0x15, 0x70, 0xdc, 0x10, 0x73, 0x29, 0x76, 0x0c, 0x69, 0x20, 0x11, 0x71, 0x29, 0x6e,
-	0xf7, 0xb9, 0x64, 0xc6, 0x50, 0x93, 0x14, 0x2a, 0xd1, 0x82, 0xee, 0xc2, 0x6c, 0xdd, 0xb4, 0xbc,
-	0x9b, 0x6e, 0xd9, 0xb1, 0xef, 0xfb, 0xaa, 0xd5, 0xdd, 0xb6, 0x99, 0x6a, 0x9c, 0x99, 0xa2, 0xab,
-	0x9d, 0x5d, 0x0c, 0xdd, 0xb2, 0x5d, 0xc3, 0x25, 0x8b, 0x61, 0x2a, 0x6c, 0xd1, 0x87, 0xc5, 0x7a,
-	0x5c, 0x64, 0xc1, 0xc9, 0xb2, 0xca, 0x49, 0x72, 0xb7, 0xed, 0x95, 0x4a, 0x65, 0x66, 0x9a, 0x92,
-	0xbe, 0xa2, 0xd3, 0xff, 0x2a, 0xaf, 0x0a, 0xb7, 0x22, 0x88, 0x56, 0x00, 0xcc, 0xc8, 0xda, 0x85,
-	0xd1, 0x80, 0x10, 0xaa, 0x28, 0xac, 0x21, 0x4e, 0x20, 0xa1, 0xd7, 0x60, 0xc4, 0x60, 0x7e, 0x48,
-	0x38, 0xcd, 0x59, 0xd5, 0x7e, 0xcb, 0xce, 0x0a, 0x16, 0x11, 0x97, 0x5f, 0xf0, 0x1d, 0x4e, 0xa6,
-	0xa4, 0x7d, 0x77, 0xf3, 0x02, 0xe7, 0x6e, 0x6a, 0x35, 0x7d, 0xe9, 0xb7, 0x19, 0x38, 0xd7, 0x32,
-	0x5b, 0x17, 0xba, 0xa1, 0x92, 0xe2, 0xcf, 0xb4, 0x55, 0xfc, 0xb7, 0x60, 0x84, 0xe5, 0x4a, 0x57,
-	0xf7, 0x0d, 0x6a, 0xb3, 0xb2, 0x74, 0x7d, 0xa7, 0x84, 0xf5, 0x71, 0x40, 0x58, 0x44, 0x42, 0xf3,
-	0x30, 0xe0, 0x90, 0x03, 0x93, 0xdc, 0x5f, 0xf7, 0x48, 0xdd, 0x37, 0x1e, 0xbe, 0x7c, 0x26, 0x9b,
-	0x4a, 0xbf, 0x1a, 0x80, 0x71, 0x45, 0x26, 0xac, 0xab, 0x66, 0x4c, 0x63, 0x7c, 0x72, 0x1d, 0x18,
-	0x9f, 0x9e, 0x6e, 0x1a, 0x9f, 0xde, 0x63, 0x18, 0x9f, 0xbe, 0xee, 0x1b, 0x9f, 0xfe, 0x36, 0xc6,
-	0x27, 0x9f, 0xde, 0xf8, 0x14, 0x8e, 0x64, 0x7c, 0xa0, 0xfb, 0xc6, 0x67, 0xa0, 0x03, 0xe3, 0x33,
-	0xd8, 0x15, 0xe3, 0x33, 0x94, 0xca, 0xf8, 0x0c, 0x77, 0x66, 0x7c, 0x46, 0x8e, 0x68, 0x7c, 0x46,
-	0xbb, 0x6c, 0x7c, 0xc6, 0x52, 0x18, 0x1f, 0x94, 0xc6, 0xf8, 0x8c, 0xa7, 0x32, 0x3e, 0x13, 0x9d,
-	0x19, 0x9f, 0xc9, 0xc7, 0x67, 0x7c, 0xa6, 0x1e, 0xaf, 0xf1, 0x99, 0xee, 0x92, 0xf1, 0x99, 0x39,
-	0xaa, 0xf1, 0x59, 0x14, 0x8d, 0x0f, 0x7f, 0xd6, 0x25, 0xd9, 0x9c, 0x0f, 0x33, 0x70, 0x52, 0x75,
-	0x70, 0xf1, 0x24, 0x98, 0x9a, 0x7f, 0xf5, 0x53, 0x53, 0x23, 0x9e, 0x8f, 0xb4, 0x30, 0x35, 0x8a,
-	0xf8, 0x27, 0x7b, 0xb4, 0xf8, 0x47, 0x32, 0x28, 0xb9, 0x8e, 0x0c, 0x8a, 0xd6, 0x1c, 0xf4, 0x1c,
-	0xdb, 0x1c, 0x24, 0x14, 0x7d, 0x2f, 0xaf, 0xe8, 0xd5, 0xca, 0xba, 0xef, 0x18, 0xca, 0x5a, 0x52,
-	0xbd, 0xfd, 0x1d, 0xab, 0x5e, 0x5e, 0x9d, 0xe6, 0x25, 0x75, 0xaa, 0x51, 0x88, 0x85, 0xe3, 0x28,
-	0x44, 0x4e, 0xcd, 0x81, 0xa8, 0xe6, 0x78, 0xd5, 0x35, 0xd0, 0x99, 0xea, 0x1a, 0x7c, 0x7c, 0xaa,
-	0x6b, 0xe8, 0xf1, 0xaa, 0xae, 0xe1, 0x2e, 0xa9, 0xae, 0x91, 0xc7, 0xa4, 0xba, 0xc4, 0xcf, 0x9c,
-	0xa9, 0x2e, 0xe9, 0x78, 0xf4, 0x49, 0x50, 0x5d, 0x3f, 0xcf, 0xc0, 0xa4, 0xf2, 0xe8, 0xbf, 0x85,
-	0xf2, 0xba, 0x01, 0x83, 0x46, 0x02, 0x32, 0x9c, 0x9a, 0xa0, 0xb9, 0x12, 0xb4, 0xd6, 0xad, 0x46,
-	0xd3, 0xc3, 0x1c, 0xce, 0xf2, 0xb3, 0x22, 0x8f, 0xcf, 0x28, 0x78, 0x9c, 0x9c, 0x4f, 0xe9, 0x0c,
-	0x9c, 0x56, 0xd7, 0x28, 0xb0, 0x9c, 0xf8, 0xef, 0x32, 0x30, 0x26, 0x9d, 0xd4, 0x77, 0xd5, 0xdd,
-	0xbf, 0x0e, 0x85, 0xa8, 0x8a, 0x25, 0xd4, 0xb3, 0x53, 0x82, 0x86, 0x09, 0xbb, 0x71, 0x0c, 0xb8,
-	0x7c, 0x45, 0x5c, 0x2a, 0x5f, 0xbc, 0xc2, 0xcf, 0xb7, 0xf4, 0xc3, 0x2c, 0xcc, 0xca, 0xf5, 0x06,
-	0x4c, 0x94, 0x5e, 0x86, 0xa1, 0xa8, 0xd4, 0x64, 0xc7, 0x25, 0xbe, 0x2c, 0xe5, 0xe4, 0x44, 0x2f,
-	0x13, 0x0b, 0x1f, 0x02, 0xf3, 0x08, 0xe8, 0x26, 0x0c, 0x95, 0x6d, 0xd3, 0x8a, 0xb3, 0x93, 0x59,
-	0x55, 0xca, 0x70, 0x35, 0x01, 0x42, 0x0f, 0x63, 0x30, 0x8f, 0x85, 0x5e, 0x81, 0x71, 0x56, 0xb2,
-	0xc3, 0x1a, 0x1d, 0x52, 0x09, 0x99, 0x32, 0xc9, 0x13, 0x0b, 0x6d, 0x15, 0x56, 0x61, 0x88, 0x22,
-	0xda, 0x23, 0x8b, 0xe8, 0x8f, 0x33, 0xd4, 0xba, 0x8a, 0x45, 0x1d, 0xdd, 0xdc, 0xd9, 0x76, 0x9f,
-	0xbc, 0x38, 0x76, 0xe9, 0x34, 0xfd, 0xe2, 0xa5, 0x3a, 0x13, 0x26, 0x8a, 0x9f, 0xe7, 0x61, 0x4a,
-	0x5d, 0xe0, 0xd1, 0x55, 0x79, 0x7c, 0x11, 0xa6, 0x03, 0x7f, 0x57, 0x3c, 0x8b, 0x66, 0x21, 0xa8,
-	0xae, 0x1b, 0x6d, 0xc2, 0x98, 0x2d, 0xe1, 0x04, 0xa1, 0x68, 0x49, 0xf6, 0x40, 0xa4, 0x23, 0x70,
-	0x19, 0x39, 0x9e, 0x8b, 0x78, 0xb8, 0xcf, 0xa2, 0x52, 0x5d, 0xb7, 0x3f, 0x17, 0x53, 0xc2, 0xe9,
-	0xd3, 0xcd, 0x45, 0xaa, 0x29, 0x90, 0x91, 0xd1, 0x3e, 0x2c, 0x05, 0x83, 0xad, 0x34, 0x3d, 0x3b,
-	0x68, 0x26, 0x5b, 0xa4, 0xb6, 0xb7, 0x6e, 0x99, 0x9e, 0x69, 0x78, 0xa4, 0xc2, 0x16, 0x14, 0x7f,
-	0x05, 0x41, 0xa8, 0xda, 0x29, 0x1a, 0x7a, 0x1b, 0x9e, 0x36, 0x52, 0x8f, 0x11, 0xe4, 0x62, 0xd3,
-	0x23, 0xb4, 0x5f, 0x07, 0x63, 0x46, 0x3c, 0x46, 0x21, 0xcd, 0x3a, 0x24, 0xb4, 0x56, 0xeb, 0x90,
-	0xc7, 0x80, 0xd6, 0xeb, 0x90, 0xa9, 0x6f, 0xc3, 0x79, 0x69, 0x42, 0x2b, 0xb5, 0x9a, 0x4c, 0x39,
-	0xf0, 0x71, 0xd2, 0x01, 0xa3, 0x57, 0xe1, 0x8c, 0xd1, 0x86, 0x5e, 0x90, 0x29, 0x6e, 0x07, 0x86,
-	0xae, 0xb3, 0xba, 0x22, 0x5f, 0xc0, 0x92, 0x4e, 0x70, 0x10, 0x7a, 0xab, 0x3b, 0xd1, 0x2b, 0x30,
-	0xd2, 0x14, 0xe0, 0x87, 0x55, 0x05, 0x00, 0x02, 0x1e, 0x16, 0xb1, 0x96, 0xaf, 0x8a, 0xca, 0x67,
-	0x5e, 0x11, 0x2a, 0x71, 0x5a, 0xa4, 0xf4, 0x69, 0x06, 0xe6, 0x34, 0x15, 0x64, 0xcc, 0x54, 0xdc,
-	0x82, 0x11, 0xf6, 0x65, 0x30, 0x1f, 0x22, 0x93, 0xc6, 0x87, 0x10, 0x90, 0x7c, 0x3a, 0xec, 0x6b,
-	0xef, 0xc8, 0x17, 0x11, 0x90, 0x52, 0xf8, 0x22, 0x9f, 0x65, 0xa8, 0xd6, 0x54, 0x14, 0x27, 0x75,
-	0x55, 0x6b, 0xbe, 0x04, 0x79, 0x66, 0x12, 0xc3, 0x43, 0xa7, 0x34, 0x6a, 0x26, 0xc2, 0x69, 0xb7,
-	0x5d, 0x8a, 0xe9, 0x97, 0x3c, 0xba, 0x5b, 0xaa, 0xaa, 0x2b, 0xb6, 0x5b, 0x53, 0xd0, 0x67, 0xd0,
-	0x09, 0x86, 0xeb, 0x0b, 0x7f, 0xf9, 0x0b, 0x4f, 0x1e, 0x34, 0x17, 0x30, 0xfb, 0x99, 0x82, 0x9f,
-	0xbf, 0xc9, 0x50, 0x57, 0x42, 0x5d, 0x8b, 0xd5, 0x55, 0x96, 0xce, 0x01, 0x30, 0xf6, 0xac, 0x57,
-	0xc2, 0xf3, 0xec, 0x44, 0xcb, 0xf2, 0x75, 0x91, 0x65, 0x4f, 0x29, 0xcc, 0xab, 0xc4, 0xb5, 0x26,
-	0x9c, 0xd5, 0x97, 0x92, 0x31, 0xc6, 0x4d, 0x40, 0xef, 0x9e, 0xdd, 0xb4, 0x2a, 0xe1, 0xc9, 0x71,
-	0xf0, 0xe3, 0x58, 0x6c, 0x8b, 0xc5, 0x50, 0xb4, 0x7b, 0x5f, 0xbd, 0x18, 0x4a, 0x96, 0xb7, 0x13,
-	0x31, 0x14, 0x91, 0x63, 0x31, 0x94, 0xc8, 0x7e, 0x65, 0x62, 0xf8, 0x58, 0x59, 0xda, 0x15, 0x31,
-	0x94, 0xb8, 0x96, 0x14, 0x43, 0x2d, 0xe3, 0xba, 0x2f, 0x86, 0x7f, 0xcf, 0xd2, 0x70, 0x86, 0xaf,
-	0x12, 0xec, 0x2a, 0xbb, 0x4a, 0x30, 0x48, 0x6b, 0x0e, 0x6f, 0x3e, 0x68, 0x98, 0x81, 0xf3, 0xee,
-	0x4f, 0x9d, 0x6b, 0x43, 0x97, 0x58, 0x24, 0x4a, 0x9c, 0x30, 0xe8, 0xa2, 0x99, 0xa1, 0x02, 0x16,
-	0x9b, 0xd1, 0x4b, 0x50, 0xa4, 0x98, 0xab, 0x76, 0xd3, 0xf2, 0x88, 0xd3, 0x30, 0x1c, 0xef, 0x50,
-	0xf4, 0x01, 0x5b, 0x40, 0xa0, 0x2d, 0x18, 0x8d, 0x22, 0x95, 0x6d, 0x9b, 0x2e, 0x3b, 0xf4, 0x02,
-	0x2f, 0xaa, 0x0d, 0xcd, 0x7a, 0x85, 0x58, 0x9e, 0xb9, 0x67, 0x12, 0x67, 0x8d, 0x78, 0x86, 0x59,
-	0x73, 0xb1, 0x44, 0xa0, 0x5d, 0xec, 0xc5, 0x33, 0xb7, 0xf4, 0x93, 0x40, 0x52, 0x85, 0xc2, 0x4c,
-	0xb6, 0xc5, 0x0b, 0x50, 0xb0, 0x9a, 0x75, 0xda, 0xa9, 0x8e, 0xe1, 0xe3, 0x6e, 0x74, 0x25, 0x2c,
-	0x04, 0xad, 0xc4, 0x93, 0x0d, 0xcc, 0x66, 0x01, 0xcb, 0x1d, 0x29, 0x84, 0xe1, 0xc3, 0x64, 0x98,
-	0x9e, 0x2c, 0x02, 0x6e, 0x21, 0x10, 0x25, 0x18, 0x0c, 0x93, 0x85, 0xb4, 0x52, 0x98, 0x0a, 0x44,
-	0x1e, 0x73, 0x6d, 0x68, 0x14, 0x72, 0x4d, 0xc7, 0x0c, 0x3f, 0x15, 0xff, 0x4f, 0x21, 0x0d, 0xd6,
-	0x23, 0xa6, 0xc1, 0xd2, 0x05, 0xee, 0xc9, 0x19, 0x96, 0xcc, 0x44, 0xe0, 0xce, 0xd5, 0x4b, 0x33,
-	0xc6, 0x5e, 0x80, 0x7e, 0x7a, 0x29, 0x42, 0x93, 0x1a, 0x61, 0x9d, 0x22, 0x9b, 0xb2, 0x32, 0x9b,
-	0xbe, 0x9f, 0xa5, 0x6c, 0x92, 0xcb, 0xbe, 0x5b, 0xb0, 0x29, 0x31, 0x7a, 0xb6, 0xd5, 0xe8, 0x22,
-	0x3b, 0x73, 0x0a, 0x76, 0x9e, 0x83, 0xa1, 0x6a, 0xcd, 0xde, 0x35, 0x6a, 0x37, 0x2d, 0x63, 0xb7,
-	0x46, 0x2a, 0x61, 0x19, 0x0a, 0xdf, 0xc8, 0x98, 0xde, 0xab, 0x63, 0x7a, 0x5f, 0xa7, 0x4c, 0x97,
-	0xd7, 0x1b, 0x66, 0x4b, 0x14, 0xf5, 0xef, 0x2c, 0x44, 0x7d, 0x3f, 0x90, 0x28, 0xb9, 0x8c, 0xfd,
-	0xf8, 0xac, 0x6a, 0x37, 0x5d, 0x79, 0xcc, 0x70, 0xba, 0x8a, 0x9a, 0x7a, 0x36, 0xdd, 0x8f, 0x32,
-	0xb4, 0x18, 0x52, 0x55, 0x18, 0xdf, 0x85, 0xbd, 0x9d, 0x81, 0xfe, 0x30, 0x3b, 0x15, 0x7e, 0x0a,
-	0xec, 0xa7, 0xaf, 0xd7, 0x0f, 0xe8, 0x76, 0x07, 0x3b, 0x19, 0xfc, 0x58, 0xbe, 0x26, 0x2e, 0xf0,
-	0xac, 0x68, 0x7b, 0xa5, 0x59, 0x96, 0x4c, 0x38, 0xa3, 0xab, 0xec, 0x67, 0x1f, 0xc2, 0x39, 0x18,
-	0x6a, 0xf8, 0xe2, 0x6c, 0x37, 0xdd, 0x40, 0xc6, 0x82, 0xe5, 0xf0, 0x8d, 0x29, 0x3e, 0x83, 0x87,
-	0x59, 0x9a, 0x31, 0x11, 0xeb, 0xc1, 0xbb, 0x6a, 0x3c, 0x8e, 0x79, 0xee, 0xf0, 0x75, 0x98, 0x2a,
-	0x1b, 0xd6, 0x8e, 0xb2, 0x72, 0x2b, 0x27, 0x17, 0x90, 0xb3, 0xde, 0x15, 0xe1, 0x94, 0x01, 0x6b,
-	0xa8, 0xb4, 0x4b, 0xe8, 0x88, 0xac, 0x29, 0xdd, 0xa6, 0x09, 0x1d, 0xa9, 0x82, 0xfe, 0xc8, 0x29,
-	0xdc, 0xd2, 0xdf, 0x32, 0x30, 0xc4, 0x15, 0xd1, 0x77, 0x95, 0xfb, 0x89, 0xb3, 0x95, 0x1c, 0x7f,
-	0xb6, 0xf2, 0x1a, 0x8c, 0x46, 0x2c, 0x61, 0xe7, 0xf0, 0x3d, 0xca, 0x04, 0xad, 0xc8, 0x4a, 0x09,
-	0x6f, 0xf9, 0x92, 0xc8, 0xc4, 0x69, 0x91, 0x89, 0x21, 0x64, 0x69, 0x9d, 0x2a, 0x93, 0xc4, 0x8d,
-	0x81, 0xa3, 0x33, 0xee, 0x0f, 0x59, 0x98, 0xd1, 0xdd, 0x17, 0xe8, 0x2a, 0x0f, 0xd5, 0xa7, 0x50,
-	0xb9, 0x63, 0x9c, 0x42, 0xbd, 0x03, 0x27, 0x23, 0x1e, 0x2a, 0x6a, 0x18, 0xd2, 0x6d, 0x43, 0x2b,
-	0x12, 0xcb, 0xcf, 0x89, 0x3b, 0x52, 0x12, 0x77, 0x44, 0x46, 0x2a, 0x6d, 0xc3, 0xbc, 0xf6, 0x02,
-	0xc6, 0xd1, 0xf7, 0xe9, 0x83, 0x48, 0xc9, 0xf0, 0x55, 0x10, 0xdd, 0xdc, 0x22, 0xe9, 0x58, 0x2f,
-	0xd7, 0xf1, 0xb1, 0x5e, 0x52, 0xd1, 0xf0, 0x95, 0x1c, 0x47, 0x55, 0x34, 0x1c, 0x95, 0x14, 0x8a,
-	0x86, 0x83, 0x8f, 0x15, 0x0d, 0x3f, 0xeb, 0xa3, 0xef, 0xc3, 0xbf, 0x33, 0x30, 0x2a, 0x5e, 0x57,
-	0xe9, 0x76, 0x54, 0x95, 0x70, 0x4e, 0x72, 0xd2, 0xc1, 0xe8, 0x26, 0x8c, 0xc7, 0x22, 0x9b, 0x74,
-	0x1d, 0xd3, 0x48, 0xbb, 0x0a, 0x75, 0xf9, 0xb2, 0xc8, 0xd3, 0xa2, 0x24, 0xe5, 0x11, 0x70, 0xe9,
-	0xf5, 0x48, 0x5d, 0xc4, 0xb7, 0x75, 0x8e, 0xce, 0xcd, 0x7f, 0x04, 0x37, 0x45, 0x92, 0x37, 0x76,
-	0xba, 0xca, 0x4c, 0xee, 0xc4, 0x37, 0x27, 0x9e, 0xf8, 0xbe, 0x01, 0x28, 0xe2, 0x47, 0x5c, 0x23,
-	0x93, 0x8e, 0x93, 0x0a, 0xcc, 0xe5, 0x05, 0x91, 0x91, 0xb3, 0x22, 0x23, 0x23, 0xd8, 0xd2, 0xff,
-	0x33, 0x07, 0x2b, 0xbe, 0xaa, 0x74, 0x74, 0x36, 0xfe, 0x35, 0x38, 0xc5, 0x52, 0xdf, 0x52, 0xea,
-	0x2a, 0x43, 0x35, 0xc7, 0xf2, 0xb9, 0xe3, 0x1c, 0xcb, 0x5b, 0x70, 0x4a, 0xa1, 0x84, 0xc5, 0x83,
-	0x92, 0x85, 0x76, 0xc4, 0x13, 0x9b, 0xd3, 0x92, 0x5e, 0xbb, 0xbc, 0x84, 0x9a, 0x8b, 0xbe, 0x4b,
-	0x7c, 0x56, 0x7f, 0x13, 0xec, 0x49, 0x38, 0x7c, 0xfe, 0x4f, 0xa4, 0xba, 0x12, 0x05, 0x5b, 0x5d,
-	0x56, 0x5d, 0x89, 0x0a, 0x8a, 0x9c, 0x54, 0x41, 0x81, 0x61, 0xa2, 0x6c, 0x58, 0xe1, 0xcf, 0x98,
-	0x8b, 0x29, 0xbf, 0x38, 0x25, 0x6e, 0x0a, 0xe5, 0x15, 0x2f, 0x36, 0x56, 0x5e, 0x89, 0xeb, 0x76,
-	0x47, 0xff, 0xea, 0xfe, 0x9c, 0xa1, 0x61, 0x94, 0xfe, 0x92, 0xdd, 0x91, 0x2f, 0xbd, 0x25, 0x02,
-	0xa4, 0x2c, 0x1f, 0x20, 0x2d, 0x02, 0x32, 0x5d, 0x71, 0xb8, 0x90, 0xd5, 0x8a, 0x9e, 0xe5, 0x65,
-	0xf9, 0x16, 0xdc, 0x45, 0x91, 0x41, 0x9a, 0xd9, 0x97, 0x2e, 0xc2, 0xf9, 0xd6, 0x77, 0x08, 0x59,
-	0xb4, 0xf8, 0x59, 0x16, 0x06, 0x12, 0x97, 0x01, 0xd1, 0x35, 0x41, 0xa6, 0x5a, 0x2c, 0xfa, 0x18,
-	0xd2, 0x76, 0x2e, 0x3e, 0x9c, 0x7f, 0x9d, 0x1c, 0x10, 0x76, 0xb1, 0x8b, 0x6f, 0xec, 0x20, 0xa3,
-	0xc6, 0xa7, 0x33, 0x7b, 0xc5, 0x74, 0xa6, 0xdf, 0xdf, 0x70, 0xec, 0x86, 0xed, 0xd2, 0xfe, 0x30,
-	0x6b, 0x10, 0xb7, 0xa0, 0x05, 0x28, 0x1c, 0x12, 0xf7, 0x2e, 0x31, 0xab, 0xfb, 0x1e, 0x3d, 0xe0,
-	0x94, 0x12, 0x56, 0x51, 0xf7, 0xf2, 0x05, 0x51, 0x6a, 0xf9, 0x97, 0x45, 0x18, 0x27, 0x4b, 0x93,
-	0x41, 0xa5, 0x1b, 0xbb, 0x65, 0xc9, 0x18, 0xfe, 0xd3, 0x0c, 0x0c, 0x26, 0xab, 0x0e, 0x84, 0xb9,
-	0x67, 0xa4, 0xb9, 0x4b, 0xbc, 0xca, 0xa6, 0xe4, 0x55, 0x4e, 0xcd, 0xab, 0x44, 0xa6, 0xb5, 0x87,
-	0xcb, 0xb4, 0x96, 0xde, 0xcf, 0xc0, 0x98, 0x54, 0xce, 0x80, 0x10, 0xf4, 0xec, 0x39, 0xd1, 0xc5,
-	0x3e, 0xfa, 0x37, 0x1a, 0x86, 0xac, 0x67, 0x87, 0x13, 0xc9, 0x7a, 0x36, 0x4d, 0x86, 0xd7, 0xed,
-	0xa6, 0xe5, 0xb1, 0x1b, 0x7a, 0xc1, 0xaf, 0xf8, 0x56, 0x60, 0x4f, 0xf2, 0x56, 0xe0, 0x39, 0x18,
-	0x32, 0x5d, 0x26, 0x90, 0xb7, 0x08, 0x09, 0x53, 0x9e, 0x7c, 0x63, 0xe9, 0xbd, 0x0c, 0x0c, 0xf3,
-	0x8a, 0xb3, 0x4b, 0xac, 0x8a, 0x33, 0xf7, 0x39, 0x5d, 0xe6, 0x9e, 0x67, 0xcc, 0xb5, 0x8f, 0x26,
-	0x21, 0xb7, 0xe1, 0x56, 0xd1, 0x36, 0x0c, 0x72, 0x17, 0x63, 0x4f, 0x6b, 0x9e, 0x51, 0x08, 0xba,
-	0x8b, 0xe7, 0x5b, 0x76, 0x47, 0xda, 0xeb, 0xdb, 0x30, 0xab, 0xbf, 0x4a, 0xd6, 0xc1, 0xcb, 0x20,
-	0xc5, 0x6b, 0x1d, 0xbc, 0x22, 0xc2, 0x06, 0xdf, 0x03, 0xa4, 0xa8, 0x68, 0x4a, 0xf3, 0xe2, 0x49,
-	0xf1, 0x72, 0x9a, 0x67, 0x51, 0xd8, 0x38, 0x6f, 0xc1, 0xb0, 0x50, 0x6e, 0xd4, 0xee, 0xe5, 0x90,
-	0xe2, 0xc5, 0x76, 0x4f, 0x8b, 0x30, 0xda, 0x26, 0x8c, 0xab, 0xea, 0x47, 0x52, 0x3d, 0x23, 0x52,
-	0xbc, 0x92, 0xea, 0xb1, 0x91, 0xc4, 0x50, 0xaa, 0x43, 0xd7, 0x54, 0xef, 0x46, 0x28, 0x86, 0x6a,
-	0x75, 0xce, 0xe9, 0xc0, 0x94, 0xe6, 0x3c, 0x32, 0xed, 0x23, 0x12, 0xc5, 0xa5, 0xb4, 0xaf, 0x4d,
-	0xf0, 0xcb, 0x93, 0x4e, 0x9e, 0x52, 0xbd, 0x28, 0xa1, 0x5e, 0x9e, 0xf6, 0x18, 0x28, 0x5a, 0x9e,
-	0x34, 0x5a, 0xda, 0xe7, 0x25, 0xb4, 0xcb, 0xd3, 0x8e, 0xf9, 0x16, 0x0c, 0x0b, 0x87, 0x44, 0xed,
-	0xde, 0x9a, 0x50, 0x08, 0xa1, 0xe6, 0xcc, 0xe3, 0x1d, 0x18, 0x95, 0xea, 0xae, 0xda, 0xbf, 0xb7,
-	0x53, 0x7c, 0xba, 0xfd, 0x93, 0x3c, 0x89, 0x11, 0x24, 0xf5, 0xd0, 0xfe, 0x3d, 0x1b, 0xc5, 0x08,
-	0x5a, 0x65, 0xf0, 0x0e, 0x8c, 0x4a, 0x95, 0xd9, 0xed, 0x1f, 0xb7, 0x51, 0x8c, 0xa0, 0x2d, 0xf0,
-	0x8c, 0xd4, 0x0d, 0x97, 0x47, 0x4f, 0xf3, 0xd2, 0x8d, 0x56, 0xdd, 0x28, 0x0f, 0x4a, 0xf6, 0x00,
-	0x29, 0x8e, 0x36, 0xd2, 0x3c, 0x7b, 0xa3, 0x18, 0x47, 0x7f, 0x36, 0xe0, 0x8f, 0xa3, 0x38, 0x17,
-	0x48, 0xf3, 0x06, 0x8e, 0x62, 0x1c, 0x7d, 0x52, 0x1f, 0xd5, 0x60, 0x42, 0x99, 0xd0, 0x4f, 0xf7,
-	0x20, 0x4e, 0xf1, 0x99, 0x74, 0xef, 0xe6, 0x24, 0xe4, 0x40, 0xca, 0x88, 0xb7, 0x7f, 0x44, 0x45,
-	0x21, 0x07, 0xda, 0x2c, 0xf1, 0x1b, 0x00, 0x89, 0x7c, 0x6f, 0xab, 0x17, 0x55, 0x8a, 0x4f, 0xb5,
-	0x7a, 0x6e, 0x85, 0xd1, 0xb3, 0x61, 0x52, 0x9d, 0x06, 0x4d, 0xf9, 0xbc, 0x4a, 0x71, 0x31, 0xe5,
-	0x33, 0x2c, 0x3c, 0x8b, 0xf8, 0x7c, 0x5e, 0xfb, 0xb7, 0x56, 0xd4, 0x2c, 0x52, 0xe7, 0xb7, 0xee,
-	0xc2, 0x10, 0x9f, 0xa9, 0x6a, 0xf3, 0xf0, 0x4a, 0xf1, 0x42, 0x9b, 0x87, 0x59, 0x18, 0xe1, 0x6d,
-	0x18, 0xe4, 0x92, 0x36, 0xad, 0x5f, 0x61, 0x29, 0x9e, 0x6f, 0xfd, 0x48, 0x4b, 0x42, 0x9f, 0x6b,
-	0x72, 0x18, 0x69, 0x9f, 0x64, 0x51, 0xe8, 0xf3, 0x36, 0x11, 0x7b, 0xc0, 0xa2, 0xe4, 0x15, 0xa6,
-	0xd6, 0xef, 0xb3, 0xa8, 0x59, 0xa4, 0x08, 0x28, 0xbf, 0x03, 0xc5, 0x16, 0xa1, 0x61, 0x27, 0x8f,
-	0xb5, 0x14, 0x9f, 0xeb, 0xe4, 0x65, 0x17, 0x36, 0xfe, 0xab, 0x90, 0x8f, 0x22, 0x32, 0xfd, 0xcb,
-	0x2d, 0xc5, 0xb3, 0xfa, 0x47, 0x5d, 0x42, 0x4a, 0xc5, 0xde, 0xef, 0x3e, 0x7a, 0xb8, 0x90, 0xb9,
-	0x81, 0x3f, 0xfe, 0x62, 0x2e, 0xf3, 0xc9, 0x17, 0x73, 0x99, 0xbf, 0x7c, 0x31, 0x97, 0xf9, 0xd1,
-	0x97, 0x73, 0x27, 0x3e, 0xf9, 0x72, 0xee, 0xc4, 0x1f, 0xbf, 0x9c, 0x3b, 0xf1, 0xd6, 0x8b, 0x55,
-	0xd3, 0xdb, 0x6f, 0xee, 0x2e, 0x96, 0xed, 0xfa, 0xd2, 0xae, 0xe9, 0xed, 0x1a, 0x95, 0x2a, 0x71,
-	0xe3, 0xbf, 0xca, 0xfb, 0x86, 0x69, 0x2d, 0x3d, 0x58, 0xe2, 0x1f, 0x28, 0x3c, 0x6c, 0x10, 0x77,
-	0xb7, 0x8f, 0x3e, 0xad, 0xf7, 0xdc, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x18, 0x1b, 0x34, 0xd1,
-	0x5a, 0x51, 0x00, 0x00,
+	// 4136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4b, 0x6c, 0x24, 0x49,
+	0x56, 0x53, 0x55, 0xfe, 0xd5, 0xb3, 0xdd, 0xb6, 0xc3, 0xbf, 0x72, 0x76, 0xdb, 0xed, 0xce, 0x71,
+	0xff, 0x7b, 0xec, 0x99, 0x9e, 0x61, 0x66, 0x64, 0x60, 0xb5, 0x6e, 0x77, 0xf7, 0x8e, 0x87, 0xe9,
+	0x69, 0x13, 0x76, 0x4f, 0x6b, 0x57, 0x0b, 0xbd, 0xe9, 0xaa, 0x68, 0x3b, 0xd9, 0xaa, 0xcc, 0x22,
+	0x33, 0xcb, 0x33, 0x06, 0x09, 0x21, 0x2e, 0xac, 0xd8, 0x03, 0x70, 0x82, 0x1b, 0x47, 0x40, 0x42,
+	0x68, 0x24, 0xf6, 0xb2, 0x07, 0x84, 0x04, 0x97, 0xbd, 0x20, 0x56, 0x2b, 0xa1, 0x45, 0x48, 0x8c,
+	0x60, 0xe6, 0x30, 0x07, 0x24, 0xc4, 0x0d, 0x21, 0x81, 0x40, 0x11, 0x19, 0xf9, 0x89, 0x4f, 0x7e,
+	0xca, 0x55, 0x5e, 0x69, 0x2e, 0x76, 0x65, 0xc4, 0x7b, 0x2f, 0xe2, 0xbd, 0x78, 0xf1, 0x3e, 0xf1,
+	0x83, 0xc5, 0xc0, 0xfd, 0x2e, 0x71, 0xec, 0xdf, 0xb0, 0x02, 0xdb, 0x75, 0xb6, 0x82, 0x4f, 0x36,
+	0xbb, 0x9e, 0x1b, 0xb8, 0x68, 0x2a, 0x5d, 0x6c, 0xcc, 0x59, 0x1d, 0xdb, 0x71, 0xb7, 0xd8, 0xdf,
+	0x10, 0xc0, 0x58, 0x6e, 0xba, 0x7e, 0xc7, 0xf5, 0xb7, 0x3a, 0xfe, 0xf1, 0xd6, 0xe9, 0x1b, 0xf4,
+	0x1f, 0xaf, 0x58, 0x09, 0x2b, 0x5e, 0xb0, 0xaf, 0xad, 0xf0, 0x83, 0x57, 0x2d, 0x1c, 0xbb, 0xc7,
+	0x6e, 0x58, 0x4e, 0x7f, 0x45, 0x08, 0x42, 0x0f, 0xba, 0x96, 0x67, 0x75, 0x22, 0x84, 0x2b, 0x62,
+	0xe7, 0x3c, 0xcb, 0xf1, 0x5f, 0x12, 0x2f, 0xaa, 0xbd, 0x2c, 0xd4, 0x1e, 0x59, 0x6d, 0xcb, 0x69,
+	0x92, 0xa8, 0x72, 0x4d, 0xa4, 0x4a, 0xbc, 0x8e, 0xed, 0xfb, 0xb6, 0xeb, 0xe8, 0x91, 0x3b, 0x24,
+	0xb0, 0x5a, 0x56, 0x60, 0x69, 0x91, 0x9b, 0x6e, 0xbb, 0x4d, 0x9a, 0x41, 0x0a, 0x79, 0x5d, 0xa8,
+	0xb7, 0x5a, 0x2d, 0x8f, 0xf8, 0xfe, 0x8b, 0xb6, 0xed, 0x07, 0x11, 0xc4, 0x35, 0x01, 0xa2, 0x75,
+	0xe6, 0x58, 0x1d, 0xbb, 0xf9, 0xc2, 0x0f, 0x5c, 0x8f, 0xe8, 0x99, 0xb3, 0xba, 0x5d, 0xcf, 0x3d,
+	0xb5, 0xda, 0x51, 0xed, 0x75, 0xa1, 0xb6, 0xe7, 0x13, 0xef, 0x05, 0xe7, 0x30, 0xa4, 0xc2, 0xc1,
+	0x56, 0xc5, 0x9e, 0x9e, 0x58, 0xed, 0x36, 0x71, 0x8e, 0x13, 0x29, 0xf0, 0x51, 0x3a, 0xb2, 0x7c,
+	0xb2, 0x75, 0xfa, 0xc6, 0x11, 0x09, 0xac, 0x37, 0xb6, 0x9a, 0xae, 0xed, 0x84, 0xf5, 0xe6, 0x5f,
+	0x2e, 0xc0, 0xca, 0x61, 0x8a, 0xc2, 0x6e, 0xcf, 0x0f, 0xdc, 0xce, 0x13, 0xff, 0xf8, 0xf0, 0xac,
+	0x4b, 0xd0, 0x37, 0x61, 0xb1, 0xe9, 0x11, 0x2b, 0x20, 0x3b, 0x21, 0x87, 0x1f, 0x50, 0x06, 0x9f,
+	0xf8, 0xc7, 0x8d, 0xca, 0x7a, 0xe5, 0xd6, 0xe4, 0xfd, 0x57, 0x37, 0xd3, 0x8d, 0x6f, 0x3e, 0xf1,
+	0x8f, 0x77, 0x15, 0x68, 0xac, 0xa7, 0x80, 0x1c, 0xb8, 0xd2, 0x73, 0xec, 0x53, 0xe2, 0xf9, 0x56,
+	0xfb, 0x59, 0xb7, 0x65, 0x05, 0x64, 0x37, 0x96, 0x32, 0x6d, 0xa1, 0xca, 0x5a, 0xb8, 0xa3, 0xb4,
+	0xf0, 0x2c, 0x0b, 0x09, 0xe7, 0xd2, 0x43, 0x07, 0x30, 0xdf, 0x22, 0x6d, 0x22, 0x37, 0x53, 0x63,
+	0xcd, 0x5c, 0x53, 0x9a, 0x79, 0x28, 0xc1, 0x62, 0x1d, 0x36, 0x7a, 0x02, 0x73, 0x91, 0x4e, 0x32,
+	0x21, 0x32, 0xd9, 0x8c, 0x30, 0x92, 0x57, 0x15, 0x92, 0x87, 0x02, 0x24, 0x56, 0x31, 0xd1, 0xb7,
+	0x61, 0xa9, 0xc7, 0xba, 0xfe, 0xcc, 0x27, 0xde, 0x4e, 0xa4, 0x0f, 0x94, 0xe6, 0x28, 0xa3, 0xb9,
+	0xa1, 0x4a, 0x43, 0x05, 0xc7, 0x19, 0x34, 0xa8, 0x04, 0x7a, 0x1a, 0x41, 0x8f, 0x65, 0x48, 0x40,
+	0x91, 0xaf, 0x0e, 0x9b, 0x12, 0x0d, 0xc7, 0x57, 0x24, 0x3a, 0x9e, 0x41, 0x74, 0x57, 0x82, 0xc5,
+	0x3a, 0xec, 0x44, 0xed, 0x1e, 0x86, 0xd3, 0xe6, 0x80, 0xea, 0x3b, 0x25, 0x3b, 0x91, 0xab, 0x76,
+	0x69, 0x68, 0xac, 0xa7, 0x40, 0x49, 0x87, 0x6c, 0xc8, 0xa4, 0xeb, 0x19, 0xa4, 0x9f, 0x29, 0xd0,
+	0x58, 0x4f, 0x81, 0x92, 0x0e, 0x75, 0x44, 0x26, 0x0d, 0x19, 0xa4, 0x1f, 0x2a, 0xd0, 0x58, 0x4f,
+	0x01, 0xbd, 0x80, 0x65, 0x9f, 0x04, 0xe9, 0xd2, 0x8f, 0xac, 0x76, 0x8f, 0x11, 0x9f, 0x64, 0xc4,
+	0xaf, 0x2b, 0xc4, 0x0f, 0x34, 0xf0, 0x38, 0x8b, 0x0a, 0xd5, 0x3c, 0x9f, 0x04, 0x7b, 0x4e, 0xd3,
+	0xed, 0xd8, 0xce, 0x71, 0xa4, 0x36, 0x94, 0xfe, 0xa5, 0x0c, 0xcd, 0x3b, 0x50, 0xc1, 0x71, 0x06,
+	0x0d, 0x44, 0x60, 0x25, 0xe4, 0x4b, 0xd7, 0xc0, 0x0c, 0x6b, 0xe0, 0x66, 0x86, 0x74, 0x94, 0x36,
+	0xb2, 0x29, 0x71, 0x26, 0x9e, 0xf6, 0x82, 0x63, 0x57, 0x6a, 0x63, 0x36, 0x9b, 0x09, 0x19, 0x1c,
+	0x67, 0xd0, 0x48, 0x98, 0xd0, 0x35, 0x30, 0x97, 0xcb, 0x84, 0xd2, 0x46, 0x36, 0x25, 0x6a, 0x52,
+	0xba, 0x3d, 0xef, 0x98, 0x08, 0xd3, 0x1f, 0x65, 0x98, 0x94, 0x7d, 0x01, 0x12, 0xab, 0x98, 0x74,
+	0x7e, 0xfa, 0x24, 0xf8, 0xc8, 0x6a, 0xdb, 0x2d, 0x66, 0x67, 0xf6, 0x5a, 0x8c, 0xe0, 0x7c, 0xc6,
+	0xfc, 0x3c, 0x90, 0x60, 0xb1, 0x0e, 0x1b, 0xed, 0xc0, 0xb4, 0x4f, 0x82, 0x27, 0x96, 0x63, 0x1d,
+	0x13, 0x8f, 0x92, 0x5b, 0x60, 0xe4, 0x2e, 0xeb, 0xc8, 0x71, 0x28, 0x2c, 0x62, 0xa0, 0x23, 0x68,
+	0xf8, 0x24, 0x48, 0x4d, 0x7b, 0xee, 0x7f, 0x29, 0xb5, 0x45, 0x46, 0xed, 0x86, 0x8e, 0x9a, 0x8a,
+	0x80, 0x33, 0xe9, 0x70, 0xde, 0x59, 0xc7, 0xd3, 0xe4, 0x97, 0xb2, 0x79, 0x17, 0x60, 0xb1, 0x0e,
+	0x1b, 0xbd, 0x0f, 0xb3, 0xb4, 0x41, 0xe6, 0x26, 0x1f, 0x72, 0x8a, 0xcb, 0x8c, 0xe2, 0x9a, 0xb6,
+	0xc3, 0x31, 0x20, 0x56, 0xf0, 0xd0, 0x37, 0x60, 0xc6, 0x27, 0xc1, 0x41, 0x60, 0x39, 0x2d, 0xcb,
+	0x0b, 0x07, 0xa6, 0xc1, 0x48, 0xad, 0xea, 0x48, 0xc5, 0x70, 0x58, 0xc6, 0xa2, 0xba, 0x29, 0x48,
+	0x41, 0x50, 0x9e, 0x95, 0x0c, 0xdd, 0x3c, 0xd0, 0x62, 0xe0, 0x6c, 0x4a, 0x9c, 0xf7, 0x3d, 0x7f,
+	0xc7, 0x6b, 0x9e, 0xd8, 0xa7, 0xa4, 0x45, 0xa9, 0x1b, 0xd9, 0xbc, 0x27, 0x80, 0x58, 0xc1, 0x43,
+	0xbf, 0x0e, 0xab, 0x3e, 0x09, 0x30, 0xf1, 0x89, 0x77, 0x4a, 0x5a, 0xfb, 0x9e, 0x1b, 0xb8, 0x4d,
+	0xb7, 0xcd, 0xa3, 0x04, 0x4a, 0xf8, 0x32, 0x23, 0x7c, 0x57, 0x47, 0x38, 0x03, 0x0b, 0xe7, 0x53,
+	0x44, 0x3f, 0x0f, 0x93, 0x4d, 0xcb, 0x0f, 0x3e, 0x72, 0x03, 0x66, 0x39, 0xaf, 0xb0, 0x06, 0x56,
+	0x54, 0x67, 0xc2, 0x61, 0x70, 0x1a, 0x1a, 0xed, 0x03, 0x72, 0xa8, 0xc5, 0xb1, 0x02, 0x92, 0x52,
+	0xfc, 0x55, 0x46, 0x63, 0x5d, 0xa1, 0xf1, 0xa1, 0x08, 0x8a, 0x35, 0xb8, 0x54, 0x9a, 0x56, 0xb3,
+	0x49, 0xba, 0xe9, 0x89, 0xb4, 0x96, 0x21, 0xcd, 0x9d, 0x34, 0x20, 0x56, 0xf0, 0xd0, 0x09, 0x18,
+	0x4d, 0xcb, 0x69, 0x92, 0x36, 0x2f, 0xe3, 0xed, 0x73, 0x6f, 0x7c, 0x95, 0x51, 0xbd, 0xa5, 0xe1,
+	0x54, 0x8b, 0x82, 0x73, 0x68, 0x99, 0x7f, 0x55, 0x81, 0x99, 0xd8, 0x2d, 0xee, 0xb3, 0x58, 0x1d,
+	0xbd, 0x0d, 0x75, 0xab, 0x17, 0x9c, 0xb8, 0x9e, 0x1d, 0x9c, 0xb1, 0xd0, 0xb0, 0xfe, 0xa0, 0xf1,
+	0x93, 0x1f, 0xbc, 0xb6, 0xc0, 0x63, 0x7f, 0x3e, 0x04, 0x07, 0x81, 0x67, 0x3b, 0xc7, 0x38, 0x01,
+	0x45, 0xef, 0xc0, 0x58, 0x18, 0xed, 0xf3, 0x68, 0x6f, 0x41, 0xec, 0x61, 0x48, 0xfd, 0x41, 0xfd,
+	0x47, 0x9f, 0x5d, 0x7d, 0xe5, 0xcf, 0xbe, 0xfc, 0xf4, 0x4e, 0x05, 0x73, 0xf0, 0xed, 0xad, 0xdf,
+	0xf9, 0xf2, 0xd3, 0x3b, 0x09, 0xa1, 0xdf, 0xfb, 0xf2, 0xd3, 0x3b, 0x62, 0x30, 0x2d, 0xf5, 0xd0,
+	0x5c, 0x81, 0x65, 0xa9, 0x08, 0x13, 0xbf, 0xeb, 0x3a, 0x3e, 0x31, 0xff, 0xbe, 0x0a, 0x6b, 0xbb,
+	0xac, 0xa3, 0xbb, 0xae, 0xed, 0x3c, 0xf7, 0xac, 0x6e, 0x97, 0x78, 0xfb, 0x56, 0x70, 0xb2, 0xd3,
+	0x6a, 0x3d, 0x3d, 0xfa, 0x35, 0xd2, 0x0c, 0xd0, 0x02, 0x8c, 0xb6, 0x88, 0xe3, 0x76, 0x42, 0xde,
+	0x70, 0xf8, 0x81, 0x1e, 0x02, 0x34, 0x5d, 0x87, 0x46, 0x9c, 0xb6, 0xeb, 0x34, 0xaa, 0x3a, 0x17,
+	0xb3, 0x1b, 0xd7, 0x3f, 0xb7, 0x83, 0x13, 0xb7, 0x17, 0x3c, 0xa4, 0x98, 0x38, 0x85, 0x87, 0x96,
+	0x60, 0xcc, 0x3f, 0xeb, 0x1c, 0xb9, 0x6d, 0x16, 0x8a, 0xd6, 0x31, 0xff, 0x42, 0xef, 0x00, 0xb0,
+	0x66, 0x9e, 0x39, 0x76, 0xe0, 0x37, 0x46, 0xd6, 0x6b, 0xb7, 0x26, 0xef, 0x2f, 0x8b, 0xd4, 0x1f,
+	0x46, 0xf5, 0x38, 0x05, 0x8a, 0x1e, 0xc3, 0x9a, 0xd5, 0x6e, 0xbb, 0x1f, 0x3f, 0x3d, 0x25, 0x9e,
+	0x67, 0xb7, 0x08, 0x6d, 0x79, 0xc7, 0x39, 0x4b, 0x8c, 0x38, 0x0b, 0x26, 0x27, 0x70, 0x01, 0x14,
+	0x7a, 0x1b, 0x26, 0xa2, 0xa4, 0x88, 0xc7, 0x88, 0x86, 0x3c, 0x3c, 0xc1, 0x49, 0x6c, 0x2b, 0x63,
+	0x58, 0xf3, 0xbf, 0x2b, 0x80, 0x76, 0xda, 0xb6, 0xe5, 0x7f, 0x85, 0x65, 0x98, 0xe6, 0x7d, 0xb4,
+	0x0f, 0xde, 0xbf, 0x09, 0xab, 0x89, 0x2a, 0x3d, 0xb0, 0x9a, 0xdf, 0x25, 0x2d, 0x51, 0x0a, 0xef,
+	0x0a, 0xfc, 0x86, 0x59, 0x54, 0x23, 0x8b, 0xdf, 0x34, 0x8f, 0xe6, 0x9f, 0xd7, 0x60, 0x7e, 0xcf,
+	0x39, 0xb5, 0x3c, 0xdb, 0x72, 0x02, 0x3f, 0xa1, 0xf8, 0x36, 0x2c, 0x39, 0x6e, 0xe8, 0x56, 0x9e,
+	0x7e, 0xec, 0x10, 0xcf, 0x3f, 0xb1, 0xbb, 0x87, 0x76, 0x87, 0xf8, 0x8c, 0xfa, 0x04, 0xce, 0xa8,
+	0x45, 0x6f, 0xc1, 0xa5, 0x8e, 0xf5, 0xc9, 0x41, 0xaf, 0xdb, 0x6d, 0x9f, 0xed, 0x13, 0x6f, 0xaf,
+	0xc5, 0xa4, 0x5f, 0x7f, 0x30, 0x45, 0x67, 0xdb, 0x3f, 0x7f, 0x76, 0x75, 0xe4, 0x99, 0xed, 0x04,
+	0x58, 0x82, 0x41, 0x2f, 0x60, 0xa1, 0xa9, 0x61, 0xb0, 0x51, 0xd3, 0x19, 0xeb, 0x5c, 0x51, 0x60,
+	0x2d, 0x21, 0xf4, 0x75, 0xb8, 0xec, 0xb8, 0x8f, 0x5d, 0xaf, 0x49, 0x5e, 0xf6, 0xda, 0xfb, 0xae,
+	0x1f, 0x3c, 0xb1, 0x9d, 0x20, 0xca, 0x9c, 0x7c, 0x96, 0x5b, 0x4d, 0xe0, 0x3c, 0x10, 0xf4, 0x3a,
+	0xcc, 0xb7, 0x6c, 0xdf, 0x3a, 0x6a, 0x93, 0x7d, 0xd7, 0x6d, 0xb3, 0xcc, 0x80, 0xca, 0x3a, 0x54,
+	0x7a, 0x5d, 0x15, 0x7a, 0x0a, 0x88, 0x9c, 0x76, 0x7e, 0xb9, 0x47, 0xbc, 0xb3, 0xdd, 0x38, 0x7f,
+	0x6e, 0x8c, 0xad, 0xd7, 0xd4, 0x98, 0xeb, 0xd1, 0x47, 0x4f, 0x44, 0x38, 0xac, 0x41, 0x35, 0xbf,
+	0x37, 0x05, 0x57, 0xf2, 0x52, 0x55, 0xd4, 0x80, 0x71, 0x96, 0x9e, 0xb8, 0x1e, 0x9f, 0x0e, 0xd1,
+	0x27, 0x7a, 0x1d, 0xa6, 0x92, 0xd5, 0x86, 0x8c, 0x41, 0x11, 0x20, 0xd0, 0x7b, 0x30, 0xd3, 0x22,
+	0x2f, 0xad, 0x5e, 0x3b, 0x78, 0xc0, 0x17, 0x40, 0x1a, 0x35, 0x9d, 0x17, 0xa1, 0xf9, 0x20, 0x87,
+	0x08, 0x73, 0x0d, 0x19, 0x8d, 0x4a, 0x2e, 0xcc, 0x6c, 0x84, 0x80, 0x8f, 0xcb, 0x5c, 0x57, 0x85,
+	0x7e, 0x11, 0xa6, 0x4f, 0x05, 0xd8, 0x51, 0xdd, 0x1c, 0x63, 0x9d, 0xb6, 0xa8, 0xb0, 0x44, 0x68,
+	0x3a, 0xd8, 0x72, 0x4e, 0xb9, 0x9f, 0xac, 0xd3, 0x30, 0xab, 0x33, 0x81, 0xf3, 0x40, 0x58, 0xa6,
+	0xa8, 0xc5, 0x1d, 0xd7, 0xe5, 0x5c, 0x5a, 0x1a, 0x58, 0x4f, 0x01, 0x6d, 0xc0, 0x74, 0xd8, 0x32,
+	0x77, 0x83, 0x2c, 0xf9, 0x9c, 0xc0, 0x62, 0x21, 0x1d, 0xc9, 0x0e, 0xaf, 0xaf, 0x87, 0x23, 0xc9,
+	0x3f, 0xd1, 0x36, 0x34, 0x94, 0x84, 0x39, 0xb2, 0x29, 0xc0, 0x48, 0x65, 0xd6, 0xd3, 0x60, 0xa3,
+	0xa9, 0x62, 0x4d, 0xea, 0x82, 0x0d, 0x15, 0x1b, 0x6b, 0x70, 0x93, 0xb1, 0x15, 0x02, 0xda, 0xc6,
+	0x54, 0x7a, 0x6c, 0x85, 0x2a, 0x1a, 0xe4, 0x07, 0x02, 0xec, 0xf4, 0x7a, 0x4d, 0x0d, 0xf2, 0x05,
+	0x1c, 0x2c, 0x62, 0xa0, 0x3b, 0x30, 0xcb, 0x59, 0x8c, 0xc3, 0x5e, 0x96, 0x4f, 0x4e, 0x60, 0xa5,
+	0x1c, 0xad, 0x01, 0x34, 0x13, 0xa8, 0x19, 0x26, 0xcb, 0x54, 0x09, 0xfa, 0x05, 0x58, 0x91, 0xc5,
+	0x15, 0xc7, 0xa6, 0x2c, 0xbf, 0x9b, 0xc0, 0xd9, 0x00, 0x08, 0xc3, 0x7c, 0x53, 0x83, 0x37, 0xb7,
+	0x5e, 0xcb, 0x93, 0x68, 0x04, 0x88, 0x75, 0xc8, 0xe8, 0x16, 0xcc, 0x84, 0x0d, 0xc6, 0xa1, 0x38,
+	0xcb, 0xd3, 0x26, 0xb0, 0x5c, 0x8c, 0xae, 0x40, 0xdd, 0x8f, 0x61, 0xe6, 0xd7, 0x6b, 0xb7, 0xea,
+	0x38, 0x29, 0x48, 0xa4, 0x94, 0x04, 0xc8, 0x8d, 0x85, 0xb4, 0x94, 0x92, 0x72, 0x2a, 0x25, 0x3b,
+	0x81, 0x5a, 0x64, 0x50, 0xa9, 0x12, 0xf4, 0x1c, 0x56, 0x3a, 0xb6, 0x13, 0x3c, 0xf2, 0x9b, 0x9e,
+	0xfb, 0x31, 0x35, 0xad, 0xfe, 0xa1, 0x1b, 0x99, 0xc6, 0xc6, 0x12, 0xe3, 0x76, 0x65, 0x93, 0x87,
+	0x65, 0x74, 0x49, 0x70, 0x93, 0x2f, 0x09, 0x6e, 0x52, 0x58, 0x9c, 0x8d, 0x8b, 0x1c, 0xb8, 0xdc,
+	0xd4, 0x05, 0x49, 0xfe, 0xa1, 0xbb, 0xd3, 0x6a, 0x35, 0x96, 0x19, 0xe9, 0x7b, 0x59, 0xf6, 0x5f,
+	0x17, 0x55, 0xe1, 0x3c, 0x82, 0x68, 0x07, 0xc0, 0x8e, 0xbd, 0x5d, 0xa3, 0xa1, 0x4b, 0xd9, 0x34,
+	0xde, 0x10, 0xa7, 0x90, 0xd0, 0xfb, 0x30, 0x63, 0x45, 0x71, 0x08, 0xef, 0xe6, 0x8a, 0x6e, 0xbc,
+	0xd5, 0x60, 0x05, 0xcb, 0x88, 0xdb, 0xef, 0xd0, 0x80, 0x33, 0x32, 0xd2, 0x34, 0xdc, 0xbc, 0x21,
+	0x84, 0x9b, 0x99, 0x96, 0xde, 0xfc, 0xdb, 0x0a, 0x6c, 0xe4, 0xae, 0x5a, 0xf2, 0x30, 0x54, 0x31,
+	0xfc, 0x95, 0x42, 0xc3, 0xff, 0x18, 0x66, 0xa2, 0x35, 0xe3, 0xdd, 0x13, 0x8b, 0xf9, 0xac, 0x2a,
+	0xe3, 0xef, 0x8a, 0xc4, 0x9f, 0x00, 0x84, 0x65, 0x24, 0xb4, 0x0e, 0x93, 0x1e, 0x39, 0xb5, 0xc9,
+	0xc7, 0x7b, 0x01, 0xe9, 0x50, 0xe7, 0x41, 0xf5, 0x33, 0x5d, 0x64, 0xfe, 0xc5, 0x24, 0xcc, 0x6b,
+	0x56, 0x04, 0x87, 0xea, 0xc6, 0x32, 0x9c, 0x4f, 0xad, 0x0f, 0xe7, 0x33, 0x32, 0x4c, 0xe7, 0x33,
+	0x3a, 0x80, 0xf3, 0x19, 0x1b, 0xbe, 0xf3, 0x19, 0x2f, 0x70, 0x3e, 0x13, 0xe5, 0x9d, 0x4f, 0xfd,
+	0x5c, 0xce, 0x07, 0x86, 0xef, 0x7c, 0x26, 0xfb, 0x70, 0x3e, 0x53, 0x43, 0x71, 0x3e, 0xd3, 0xa5,
+	0x9c, 0xcf, 0xa5, 0xfe, 0x9c, 0xcf, 0xcc, 0x39, 0x9d, 0xcf, 0xec, 0x90, 0x9d, 0xcf, 0x5c, 0x09,
+	0xe7, 0x83, 0xca, 0x38, 0x9f, 0xf9, 0x52, 0xce, 0x67, 0xa1, 0x3f, 0xe7, 0xb3, 0x78, 0x71, 0xce,
+	0x67, 0xe9, 0x62, 0x9d, 0xcf, 0xf2, 0x90, 0x9c, 0x4f, 0xe3, 0xbc, 0xce, 0x67, 0x53, 0x76, 0x3e,
+	0xe2, 0x9e, 0x9f, 0xe2, 0x73, 0x7e, 0x58, 0x81, 0xcb, 0xba, 0x0d, 0x9c, 0xaf, 0x82, 0xab, 0xf9,
+	0x9f, 0x71, 0xe6, 0x6a, 0xe4, 0x7d, 0xa2, 0x1c, 0x57, 0xa3, 0xc9, 0x7f, 0xaa, 0xe7, 0xcb, 0x7f,
+	0x14, 0x87, 0x52, 0xeb, 0xcb, 0xa1, 0x64, 0xba, 0x83, 0x91, 0x81, 0xdd, 0x41, 0xca, 0xd0, 0x8f,
+	0x8a, 0x86, 0x5e, 0x6f, 0xac, 0xc7, 0x06, 0x30, 0xd6, 0x8a, 0xe9, 0x1d, 0xef, 0xdb, 0xf4, 0x8a,
+	0xe6, 0x74, 0x42, 0x31, 0xa7, 0x19, 0x06, 0xb1, 0x3e, 0x88, 0x41, 0x14, 0xcc, 0x1c, 0xc8, 0x66,
+	0x4e, 0x34, 0x5d, 0x93, 0xfd, 0x99, 0xae, 0xa9, 0x8b, 0x33, 0x5d, 0xd3, 0x17, 0x6b, 0xba, 0x2e,
+	0x0d, 0xc9, 0x74, 0xcd, 0x5c, 0x90, 0xe9, 0x92, 0xa7, 0x79, 0x64, 0xba, 0xe4, 0xf2, 0xaf, 0x84,
+	0xe9, 0xfa, 0xd3, 0x0a, 0x2c, 0x6a, 0x8f, 0x40, 0xe4, 0x18, 0xaf, 0x07, 0x30, 0x65, 0xa5, 0x20,
+	0x79, 0xd7, 0x24, 0xcb, 0x95, 0xa2, 0xb5, 0xe7, 0x74, 0x7b, 0x01, 0x16, 0x70, 0xb6, 0x5f, 0x97,
+	0x65, 0x7c, 0x55, 0x23, 0xe3, 0x74, 0x7f, 0xcc, 0xab, 0xb0, 0xaa, 0xed, 0x68, 0xbc, 0x26, 0xfe,
+	0x77, 0x15, 0x98, 0x53, 0x4e, 0x2c, 0x0c, 0x35, 0xdc, 0x7f, 0x0b, 0xea, 0xf1, 0x69, 0x1e, 0x6e,
+	0x67, 0x97, 0x24, 0x0b, 0xc3, 0xab, 0x71, 0x02, 0xb8, 0x7d, 0x4f, 0x66, 0x55, 0x3c, 0xc4, 0x23,
+	0xf6, 0xd7, 0xfc, 0xfd, 0x2a, 0xac, 0x28, 0x5c, 0xc4, 0xaa, 0xf4, 0x75, 0x98, 0x8e, 0xc6, 0xd8,
+	0x7f, 0xe6, 0x13, 0xaa, 0x4b, 0x35, 0x75, 0xa1, 0x37, 0x52, 0x0b, 0x0a, 0x81, 0x45, 0x04, 0xf4,
+	0x08, 0xa6, 0x9b, 0xae, 0xed, 0x24, 0xab, 0x93, 0x55, 0xdd, 0x92, 0xe1, 0x6e, 0x0a, 0x84, 0x6d,
+	0x4a, 0x61, 0x11, 0x0b, 0x7d, 0x03, 0xe6, 0xa3, 0xa3, 0x4b, 0x51, 0xa1, 0x47, 0x5a, 0x5c, 0x28,
+	0x8b, 0x22, 0x31, 0xee, 0xab, 0xb0, 0x0e, 0x43, 0x56, 0xd1, 0x11, 0x55, 0x45, 0xff, 0xb0, 0x02,
+	0xf3, 0xf1, 0xae, 0xf4, 0xc5, 0x24, 0x72, 0x45, 0x53, 0x5e, 0x6e, 0xdb, 0x5c, 0x65, 0x33, 0x5e,
+	0x2e, 0x8e, 0x55, 0xf1, 0xb3, 0x09, 0x58, 0xd2, 0x1f, 0x74, 0x19, 0xaa, 0x3e, 0xbe, 0x0b, 0xcb,
+	0x61, 0xbc, 0x2b, 0xef, 0xc9, 0x47, 0x29, 0x68, 0x56, 0x35, 0xda, 0x87, 0x39, 0x57, 0xc1, 0x09,
+	0x53, 0x51, 0x53, 0x8d, 0x40, 0x64, 0x7c, 0xac, 0x22, 0x27, 0x7d, 0x91, 0x0f, 0x39, 0x44, 0x59,
+	0x69, 0x56, 0x35, 0xed, 0x8b, 0xad, 0xe0, 0x8c, 0x65, 0xf5, 0x45, 0xc6, 0xc7, 0x2a, 0x32, 0x3a,
+	0x81, 0xad, 0xb0, 0xb1, 0x9d, 0x5e, 0xe0, 0x86, 0xc5, 0xe4, 0x80, 0xb4, 0x5f, 0xee, 0x39, 0x76,
+	0x60, 0x5b, 0x01, 0x69, 0x45, 0x0c, 0x25, 0xb3, 0x20, 0x4c, 0x55, 0xfb, 0x45, 0x43, 0xdf, 0x86,
+	0xdb, 0x56, 0xe9, 0x36, 0xc2, 0xb5, 0xd8, 0xf2, 0x08, 0xc5, 0x7c, 0x44, 0xc2, 0x48, 0xda, 0xa8,
+	0x97, 0xe1, 0x43, 0x41, 0xcb, 0xe3, 0x43, 0x6d, 0x03, 0xf2, 0xf9, 0x50, 0xa9, 0x1f, 0xc2, 0x75,
+	0xa5, 0x43, 0x3b, 0xed, 0xb6, 0x4a, 0x39, 0x8c, 0x71, 0xca, 0x01, 0xa3, 0xf7, 0xe0, 0xaa, 0x55,
+	0x40, 0x2f, 0x5c, 0x29, 0x2e, 0x02, 0x43, 0x6f, 0x45, 0xe7, 0xab, 0xa8, 0x82, 0xa5, 0x83, 0xe0,
+	0x30, 0xf5, 0xd6, 0x57, 0xd2, 0x83, 0x10, 0x3d, 0x09, 0xfe, 0x92, 0xee, 0x20, 0x84, 0x84, 0x87,
+	0x65, 0xac, 0xed, 0x37, 0x64, 0xe3, 0xb3, 0xae, 0x49, 0x95, 0x04, 0x2b, 0x62, 0xfe, 0xa4, 0x02,
+	0x6b, 0x19, 0x27, 0xe9, 0x22, 0x57, 0xf1, 0x18, 0x66, 0xa2, 0x99, 0x11, 0xc5, 0x10, 0x95, 0x32,
+	0x31, 0x84, 0x84, 0x44, 0xe9, 0x44, 0xb3, 0xbd, 0xaf, 0x58, 0x44, 0x42, 0x2a, 0x11, 0x8b, 0xfc,
+	0xb4, 0x02, 0x4b, 0xfc, 0x10, 0x86, 0x34, 0xa5, 0x87, 0x6a, 0x35, 0xbf, 0x06, 0x13, 0x91, 0x4b,
+	0xe4, 0x9b, 0x4e, 0x65, 0xcc, 0x4c, 0x8c, 0x53, 0x34, 0x5c, 0x9a, 0xee, 0x9b, 0x01, 0x1b, 0x2d,
+	0x4d, 0x4d, 0x3c, 0x5a, 0x4b, 0x30, 0x66, 0xb1, 0x0e, 0x72, 0xfe, 0xf8, 0x17, 0x65, 0x3c, 0xbd,
+	0xd1, 0x5c, 0xc7, 0xd1, 0x67, 0x09, 0x79, 0xfe, 0x75, 0x05, 0x56, 0x62, 0x2f, 0x75, 0xa1, 0x22,
+	0x5d, 0x03, 0x88, 0xc4, 0xb3, 0xd7, 0xe2, 0xfb, 0xd9, 0xa9, 0x92, 0xed, 0xb7, 0x64, 0x91, 0xbd,
+	0xaa, 0x71, 0xaf, 0x8a, 0xd4, 0x7a, 0x70, 0x2d, 0xb3, 0xfb, 0xb1, 0xe0, 0x16, 0x60, 0xf4, 0xa5,
+	0xdb, 0x73, 0x5a, 0x7c, 0xe7, 0x38, 0xfc, 0x18, 0x48, 0x6c, 0x89, 0x1a, 0xca, 0x7e, 0xef, 0x67,
+	0xaf, 0x86, 0x8a, 0xe7, 0xed, 0x47, 0x0d, 0x65, 0xe4, 0x44, 0x0d, 0x15, 0xb2, 0x3f, 0x33, 0x35,
+	0xbc, 0x50, 0x91, 0x0e, 0x45, 0x0d, 0x15, 0xa9, 0xa5, 0xd5, 0x30, 0x53, 0x70, 0xc3, 0x57, 0xc3,
+	0xff, 0xac, 0xc2, 0x9c, 0x72, 0x5a, 0x72, 0xa8, 0xe2, 0x32, 0x61, 0x8a, 0x9d, 0xbd, 0x7c, 0xf4,
+	0x49, 0xd7, 0x0e, 0x83, 0x77, 0xda, 0x75, 0xa1, 0x8c, 0x2e, 0xd9, 0x86, 0x02, 0x24, 0x1e, 0x4f,
+	0xba, 0xd8, 0xca, 0x50, 0x1d, 0xcb, 0xc5, 0xe8, 0x6b, 0x60, 0x30, 0xcc, 0x5d, 0xb7, 0xe7, 0x04,
+	0xc4, 0xeb, 0x5a, 0x5e, 0x70, 0x26, 0xc7, 0x80, 0x39, 0x10, 0xe8, 0x00, 0x66, 0xe3, 0x4c, 0xe5,
+	0xd0, 0x65, 0x6c, 0xf3, 0x28, 0xf0, 0xa6, 0xde, 0xd1, 0xec, 0xb5, 0x88, 0x13, 0xd8, 0x2f, 0x6d,
+	0xe2, 0x3d, 0x24, 0x81, 0x65, 0xb7, 0x7d, 0xac, 0x10, 0x28, 0xca, 0xbd, 0x44, 0xe1, 0x9a, 0x7f,
+	0x14, 0x6a, 0xaa, 0x58, 0x1a, 0x0f, 0xf1, 0x1d, 0xa8, 0x3b, 0xbd, 0x0e, 0xab, 0xd4, 0xe7, 0xf0,
+	0x49, 0x35, 0xba, 0xc7, 0x0f, 0xc4, 0xb6, 0x92, 0xce, 0x86, 0x6e, 0xb3, 0x8e, 0xd5, 0x8a, 0x12,
+	0xca, 0xf0, 0xc3, 0x74, 0x9a, 0x9e, 0x3e, 0x0c, 0x9d, 0xa3, 0x10, 0x26, 0x4c, 0xf1, 0xc5, 0x42,
+	0x76, 0x62, 0x9a, 0x29, 0xc4, 0x04, 0x16, 0xca, 0xd0, 0x2c, 0xd4, 0x7a, 0x9e, 0xcd, 0xa7, 0x0a,
+	0xfd, 0x29, 0x2d, 0x83, 0x8d, 0xc8, 0xcb, 0x60, 0xe5, 0x12, 0xf7, 0x74, 0x0f, 0x4d, 0x3b, 0x95,
+	0xb8, 0xa7, 0x2b, 0x62, 0xc1, 0xde, 0x80, 0x71, 0x76, 0x39, 0x24, 0x63, 0x69, 0x24, 0xaa, 0x94,
+	0xc5, 0x54, 0x55, 0xc5, 0xf4, 0xbb, 0x55, 0x58, 0x8c, 0xc3, 0xa2, 0x92, 0x62, 0x4a, 0xb5, 0x5e,
+	0xcd, 0x6b, 0x5d, 0x16, 0x67, 0x4d, 0x23, 0xce, 0x0d, 0x98, 0x3e, 0x6e, 0xbb, 0x47, 0x56, 0xfb,
+	0x91, 0x43, 0x0f, 0xec, 0xb4, 0xf8, 0x31, 0x14, 0xb1, 0x30, 0x12, 0xfa, 0x68, 0x96, 0xd0, 0xc7,
+	0xfa, 0x15, 0xba, 0xca, 0x2f, 0x5f, 0x2d, 0x51, 0x2b, 0xe2, 0x14, 0xf5, 0xfb, 0xa1, 0x46, 0xa9,
+	0xc7, 0xf9, 0x07, 0x17, 0x55, 0x51, 0x77, 0xd5, 0x36, 0x79, 0x77, 0xd5, 0x8a, 0xb8, 0xbb, 0x7f,
+	0x53, 0x81, 0xe5, 0x8c, 0x0b, 0x02, 0x43, 0x18, 0xdb, 0x06, 0x8c, 0xf3, 0xd5, 0x29, 0x3e, 0x15,
+	0xa2, 0x4f, 0x6a, 0xd7, 0x4f, 0xd9, 0x70, 0x87, 0x23, 0x19, 0x7e, 0x6c, 0xdf, 0x97, 0x19, 0xbc,
+	0x26, 0xfb, 0x5e, 0xa5, 0x97, 0xa6, 0x0d, 0x57, 0x33, 0x18, 0x88, 0x27, 0xc2, 0x06, 0x4c, 0x77,
+	0xa9, 0x3a, 0xbb, 0x3d, 0x3f, 0xd4, 0xb1, 0x90, 0x1d, 0xb1, 0xb0, 0xc4, 0x34, 0xf8, 0xb4, 0x0a,
+	0xf3, 0x9a, 0x73, 0xf1, 0x43, 0x75, 0x1e, 0x03, 0xee, 0x3b, 0xfc, 0x2a, 0x2c, 0x35, 0x2d, 0xe7,
+	0x99, 0xf6, 0xe4, 0x56, 0x4d, 0x3d, 0x48, 0x1f, 0xd5, 0xee, 0x48, 0xbb, 0x0c, 0x38, 0x83, 0x4a,
+	0xd1, 0x82, 0x8e, 0x2c, 0x1a, 0xf3, 0x29, 0x5b, 0xd0, 0x91, 0x8b, 0xcf, 0xbf, 0x84, 0x6b, 0xfe,
+	0x7b, 0x05, 0xa6, 0x85, 0xcb, 0x04, 0x43, 0x95, 0x7e, 0x6a, 0x6f, 0xa5, 0x26, 0xee, 0xad, 0xbc,
+	0x0f, 0xb3, 0xb1, 0x48, 0x78, 0xcb, 0x8d, 0x11, 0xed, 0x02, 0xad, 0x2c, 0x4a, 0x05, 0x6f, 0xfb,
+	0x96, 0x2c, 0xc4, 0x65, 0x59, 0x88, 0x1c, 0xd2, 0xdc, 0x63, 0xc6, 0x24, 0x29, 0x18, 0x40, 0x70,
+	0xff, 0x50, 0x85, 0x46, 0xd6, 0xbd, 0x89, 0xa1, 0xca, 0x50, 0xbf, 0x0b, 0x55, 0x1b, 0x60, 0x17,
+	0xea, 0x3b, 0x70, 0x39, 0x96, 0xa1, 0x8a, 0x52, 0x72, 0x18, 0xf2, 0x48, 0x6c, 0xbf, 0x29, 0x8f,
+	0x88, 0x29, 0x8f, 0x88, 0x8a, 0x64, 0x1e, 0xc2, 0x7a, 0xe6, 0x45, 0x94, 0xf3, 0x8f, 0xd3, 0x0f,
+	0x62, 0x23, 0x23, 0x9e, 0x82, 0x18, 0xe6, 0x10, 0x29, 0xdb, 0x7a, 0xb5, 0xbe, 0xb7, 0xf5, 0xd2,
+	0x86, 0x46, 0x00, 0x3c, 0xb7, 0xa1, 0x11, 0xa8, 0x94, 0x30, 0x34, 0x02, 0x7c, 0x62, 0x68, 0xc4,
+	0x5e, 0x9f, 0x7f, 0x1c, 0xfe, 0xb7, 0x02, 0xb3, 0xf2, 0xb5, 0x9d, 0x61, 0x67, 0x55, 0xa9, 0xe0,
+	0xa4, 0xa6, 0x6c, 0x8c, 0xee, 0xc3, 0x7c, 0xa2, 0xb2, 0xe9, 0xd0, 0xb1, 0x8c, 0xb6, 0xeb, 0x50,
+	0xb7, 0xef, 0xca, 0x32, 0x35, 0x14, 0x2d, 0x8f, 0x81, 0xcd, 0x0f, 0x62, 0x73, 0x11, 0x97, 0x0d,
+	0x20, 0xcd, 0xff, 0x0a, 0x6f, 0x8a, 0xa4, 0x6f, 0x2e, 0x0d, 0x55, 0x98, 0xc2, 0x8e, 0x6f, 0x4d,
+	0xde, 0xf1, 0xfd, 0x10, 0x50, 0x2c, 0x8f, 0xb8, 0xfd, 0x92, 0x92, 0xd4, 0x60, 0x6e, 0xdf, 0x91,
+	0x05, 0xb9, 0x22, 0x0b, 0x32, 0x86, 0x35, 0x7f, 0x29, 0x0a, 0xb0, 0xe2, 0xa2, 0x01, 0xc4, 0xf8,
+	0x6f, 0xe1, 0x2e, 0x96, 0xfe, 0xb6, 0xd6, 0x50, 0x05, 0x9a, 0xb1, 0x2d, 0x5f, 0x1b, 0x64, 0x5b,
+	0xde, 0x81, 0x2b, 0x1a, 0x23, 0x2c, 0x6f, 0x94, 0xdc, 0x29, 0x22, 0x9e, 0x1a, 0x9c, 0x5c, 0x7a,
+	0x45, 0xeb, 0x12, 0x7a, 0x29, 0xd2, 0x90, 0xf8, 0x5a, 0xf6, 0x8d, 0xb8, 0xaf, 0xc2, 0xe6, 0xf3,
+	0xff, 0xc5, 0xa6, 0x2b, 0x75, 0x60, 0x6b, 0xc8, 0xa6, 0x2b, 0x75, 0x82, 0xa2, 0xa6, 0x9c, 0xa0,
+	0xc0, 0xb0, 0xd0, 0xb4, 0x1c, 0xfe, 0x99, 0x48, 0xb1, 0xe4, 0x8c, 0xd3, 0xe2, 0x96, 0x30, 0x5e,
+	0x09, 0xb3, 0x89, 0xf1, 0x4a, 0xca, 0x06, 0x98, 0x75, 0xff, 0x52, 0x81, 0xd5, 0xdc, 0xcb, 0x86,
+	0xe7, 0xbe, 0xf4, 0x96, 0x4a, 0x90, 0xaa, 0x62, 0x82, 0xb4, 0x09, 0xc8, 0xf6, 0xe5, 0xe6, 0xb8,
+	0xa8, 0x35, 0x35, 0xdb, 0xdb, 0xea, 0x2d, 0xb8, 0x9b, 0xb2, 0x80, 0x32, 0x7a, 0x6f, 0xde, 0x84,
+	0xeb, 0xb9, 0xec, 0xc5, 0xd9, 0xe2, 0x4f, 0xab, 0x30, 0x99, 0xba, 0x14, 0x89, 0xee, 0x4b, 0x3a,
+	0x95, 0xc3, 0xf4, 0x00, 0xda, 0xb6, 0x91, 0x6c, 0xce, 0x7f, 0x40, 0x4e, 0x49, 0x74, 0xb1, 0x4b,
+	0x2c, 0xec, 0x63, 0x45, 0x4d, 0x5c, 0xce, 0x1c, 0x95, 0x97, 0x33, 0x69, 0x7d, 0xd7, 0x73, 0xbb,
+	0xae, 0xcf, 0xea, 0xf9, 0xaa, 0x41, 0x52, 0x42, 0x17, 0xac, 0xce, 0x88, 0xff, 0x9c, 0xd8, 0xc7,
+	0x27, 0x41, 0x63, 0x5c, 0xd3, 0xfd, 0xa4, 0x7a, 0xfb, 0x86, 0xac, 0xb5, 0xe2, 0x0b, 0x2b, 0x91,
+	0x24, 0xcd, 0xc5, 0xf0, 0xa4, 0x1b, 0xff, 0x8c, 0x05, 0xfe, 0x8f, 0x15, 0x40, 0xea, 0x0d, 0xd2,
+	0x61, 0xa7, 0x3c, 0xec, 0x3e, 0x2a, 0x21, 0x51, 0xca, 0xc3, 0x3f, 0x29, 0x9f, 0x84, 0x2d, 0x57,
+	0xfa, 0x3b, 0x41, 0x63, 0x44, 0x43, 0x28, 0xa9, 0xde, 0x7e, 0x4d, 0xe6, 0x53, 0xbc, 0x82, 0x29,
+	0x31, 0x60, 0x7e, 0x08, 0x86, 0xca, 0xd6, 0x00, 0x33, 0xf4, 0xfb, 0xa1, 0xc5, 0x13, 0x6e, 0xc6,
+	0x0e, 0xf5, 0x20, 0x43, 0x81, 0xf5, 0x11, 0x1a, 0xe6, 0xd6, 0x47, 0x28, 0x1b, 0x80, 0xb7, 0x3f,
+	0xa9, 0x80, 0x91, 0x7d, 0x3f, 0x77, 0xa8, 0x5c, 0xfe, 0x9c, 0xcc, 0xe5, 0x86, 0xa4, 0xad, 0xda,
+	0x2e, 0x98, 0x1b, 0x60, 0x66, 0x77, 0x30, 0xd6, 0xe5, 0x3f, 0xae, 0xc0, 0x54, 0xfa, 0x04, 0x8d,
+	0x34, 0x0f, 0x2b, 0xca, 0x3c, 0x54, 0xe6, 0x7d, 0xb5, 0xe4, 0xbc, 0xaf, 0xe9, 0xe7, 0x7d, 0x6a,
+	0xd7, 0x60, 0x44, 0xd8, 0x35, 0xa0, 0xea, 0x33, 0xa7, 0x1c, 0xcd, 0x41, 0x08, 0x46, 0x5e, 0x7a,
+	0xf1, 0x25, 0x55, 0xf6, 0x1b, 0x5d, 0x82, 0x6a, 0xe0, 0xf2, 0x8e, 0x54, 0x03, 0x97, 0x6d, 0xec,
+	0x74, 0xe8, 0xba, 0x7b, 0x74, 0xdb, 0x34, 0xfc, 0x4a, 0x6e, 0xb8, 0x8e, 0xa4, 0x6f, 0xb8, 0x6e,
+	0xc0, 0xb4, 0xed, 0x47, 0xc6, 0xf5, 0x31, 0x21, 0x7c, 0xf9, 0x5e, 0x2c, 0x34, 0xbf, 0x57, 0x81,
+	0x4b, 0x62, 0x10, 0x30, 0x24, 0x51, 0x25, 0xbb, 0x50, 0xb5, 0xac, 0x5d, 0x28, 0x51, 0x30, 0xf7,
+	0xff, 0x63, 0x19, 0x6a, 0xf4, 0x4a, 0xf9, 0x21, 0x4c, 0x09, 0x97, 0xbc, 0x57, 0x33, 0x9e, 0x46,
+	0x09, 0xab, 0x8d, 0xeb, 0xb9, 0xd5, 0xf1, 0x5c, 0xf8, 0x4d, 0x58, 0xc9, 0xbe, 0x16, 0xd9, 0xc7,
+	0x6b, 0x3f, 0xc6, 0xfd, 0xf2, 0xb0, 0x71, 0xe3, 0x2f, 0x01, 0x69, 0x4e, 0xe7, 0x95, 0x79, 0xc5,
+	0xc8, 0xb8, 0x5b, 0x02, 0x28, 0x6e, 0xe7, 0x5b, 0x70, 0x49, 0x3a, 0x3a, 0x57, 0xf4, 0x1a, 0x90,
+	0x71, 0xb3, 0x00, 0x20, 0xa6, 0x6d, 0xc3, 0xbc, 0xee, 0x2c, 0x54, 0xa9, 0xa7, 0x81, 0x8c, 0x7b,
+	0x65, 0xa0, 0xd2, 0x4d, 0xe9, 0x0e, 0x10, 0x94, 0x7a, 0x0b, 0xc6, 0xb8, 0x57, 0x06, 0x2a, 0x6e,
+	0xca, 0x83, 0xa5, 0x8c, 0xbd, 0xf5, 0xb2, 0x0f, 0xc3, 0x18, 0x5b, 0x25, 0x01, 0x25, 0xf6, 0x94,
+	0x5d, 0xd4, 0x52, 0xaf, 0xc4, 0x18, 0xf7, 0xca, 0x40, 0xa9, 0xec, 0x29, 0xad, 0x95, 0x7d, 0x32,
+	0xc6, 0xd8, 0x2a, 0x09, 0x98, 0x56, 0x42, 0x69, 0xc3, 0xb3, 0xe8, 0xfd, 0x18, 0xe3, 0x66, 0x01,
+	0x40, 0x4c, 0xfb, 0x3b, 0x30, 0xab, 0x9c, 0x21, 0x2c, 0x7e, 0x43, 0xcb, 0xb8, 0x5d, 0x08, 0x92,
+	0x6e, 0x41, 0x31, 0x0f, 0xc5, 0x6f, 0x54, 0x19, 0xb7, 0x0b, 0x41, 0xd2, 0x2d, 0x28, 0xb7, 0x0c,
+	0x8a, 0x1f, 0xac, 0x32, 0x6e, 0x17, 0x82, 0xa8, 0xe6, 0x46, 0xd8, 0x13, 0x2a, 0xf3, 0x7a, 0x95,
+	0x71, 0xb7, 0x04, 0x50, 0xba, 0x1d, 0xcd, 0x36, 0x5d, 0x99, 0xa7, 0xac, 0x8c, 0xbb, 0x25, 0x80,
+	0xd2, 0xed, 0x68, 0xf6, 0xb8, 0xca, 0xbc, 0x6b, 0x65, 0xdc, 0x2d, 0x01, 0x14, 0xb7, 0xd3, 0x86,
+	0x05, 0xed, 0xe6, 0x54, 0xb9, 0x47, 0xae, 0x8c, 0xd7, 0x4a, 0x81, 0xa5, 0xf5, 0x40, 0xd9, 0xdd,
+	0x29, 0x7e, 0x18, 0xc9, 0xb8, 0x5d, 0x08, 0x12, 0xb7, 0xf0, 0x21, 0x40, 0x6a, 0xef, 0x22, 0xef,
+	0x95, 0x24, 0xe3, 0xd5, 0x9c, 0xca, 0x98, 0x9e, 0x0b, 0x8b, 0xfa, 0x25, 0xfd, 0x92, 0x4f, 0x26,
+	0x19, 0x9b, 0xe5, 0xe0, 0x24, 0x11, 0x89, 0x6b, 0xd3, 0xc5, 0xef, 0x27, 0x19, 0xb7, 0x0b, 0x41,
+	0xe2, 0x16, 0x9e, 0xc3, 0xb4, 0xb8, 0xea, 0x5a, 0xf0, 0x98, 0x92, 0x71, 0x23, 0xbf, 0x3e, 0x26,
+	0x7c, 0x08, 0x53, 0xc2, 0x02, 0x64, 0xfe, 0xcb, 0x4a, 0xc6, 0xf5, 0xdc, 0xea, 0xb4, 0x3d, 0xcf,
+	0x58, 0x8f, 0x2b, 0xfb, 0xcc, 0x92, 0xb1, 0x55, 0x12, 0x50, 0x12, 0x51, 0xfa, 0x3a, 0x5e, 0xfe,
+	0x9b, 0x4b, 0xc6, 0x8d, 0xfc, 0xfa, 0x98, 0xf0, 0x6f, 0x81, 0x91, 0xb3, 0xcc, 0xd1, 0xcf, 0x03,
+	0x4c, 0xc6, 0x9b, 0x7d, 0x00, 0xc7, 0xed, 0xbf, 0x07, 0x13, 0xf1, 0xea, 0x42, 0xf6, 0x6b, 0x4c,
+	0xc6, 0xb5, 0xcc, 0xaa, 0x98, 0xd2, 0xaf, 0xc0, 0x8c, 0x9c, 0x36, 0x17, 0x3e, 0xcd, 0x64, 0xdc,
+	0x2a, 0x82, 0x48, 0x8f, 0x80, 0x98, 0x6d, 0x16, 0xbc, 0xd3, 0x64, 0xdc, 0xc8, 0xaf, 0x8f, 0x09,
+	0xf7, 0x60, 0x39, 0x2b, 0xd5, 0x2b, 0xfd, 0x68, 0x93, 0xf1, 0x7a, 0x59, 0xc8, 0xa8, 0x59, 0x63,
+	0xf4, 0xb7, 0xe9, 0xa3, 0x4a, 0x0f, 0xf0, 0x8f, 0x3e, 0x5f, 0xab, 0xfc, 0xf8, 0xf3, 0xb5, 0xca,
+	0xbf, 0x7e, 0xbe, 0x56, 0xf9, 0x83, 0x2f, 0xd6, 0x5e, 0xf9, 0xf1, 0x17, 0x6b, 0xaf, 0xfc, 0xd3,
+	0x17, 0x6b, 0xaf, 0x7c, 0xeb, 0xdd, 0x63, 0x3b, 0x38, 0xe9, 0x1d, 0x6d, 0x36, 0xdd, 0xce, 0xd6,
+	0x91, 0x1d, 0x1c, 0x59, 0xad, 0x63, 0xe2, 0x27, 0xbf, 0x9a, 0x27, 0x96, 0xed, 0x6c, 0x7d, 0xb2,
+	0x25, 0xbe, 0xd1, 0x7a, 0xd6, 0x25, 0xfe, 0xd1, 0x18, 0x7b, 0x5d, 0xf4, 0xcd, 0xff, 0x1f, 0x00,
+	0x48, 0xd2, 0xa0, 0x19, 0x5d, 0x56, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4753,6 +5063,9 @@ type MsgClient interface {
 	SetIsArchived(ctx context.Context, in *MsgSetIsArchived, opts ...grpc.CallOption) (*MsgSetIsArchivedResponse, error)
 	SetReservedProtocolAddress(ctx context.Context, in *MsgSetReservedProtocolAddress, opts ...grpc.CallOption) (*MsgSetReservedProtocolAddressResponse, error)
 	CastVote(ctx context.Context, in *MsgCastVote, opts ...grpc.CallOption) (*MsgCastVoteResponse, error)
+	NominateManager(ctx context.Context, in *MsgNominateManager, opts ...grpc.CallOption) (*MsgNominateManagerResponse, error)
+	AcceptManager(ctx context.Context, in *MsgAcceptManager, opts ...grpc.CallOption) (*MsgAcceptManagerResponse, error)
+	CancelManagerNomination(ctx context.Context, in *MsgCancelManagerNomination, opts ...grpc.CallOption) (*MsgCancelManagerNominationResponse, error)
 }
 
 type msgClient struct {
@@ -5006,6 +5319,33 @@ func (c *msgClient) CastVote(ctx context.Context, in *MsgCastVote, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) NominateManager(ctx context.Context, in *MsgNominateManager, opts ...grpc.CallOption) (*MsgNominateManagerResponse, error) {
+	out := new(MsgNominateManagerResponse)
+	err := c.cc.Invoke(ctx, "/tokenization.Msg/NominateManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptManager(ctx context.Context, in *MsgAcceptManager, opts ...grpc.CallOption) (*MsgAcceptManagerResponse, error) {
+	out := new(MsgAcceptManagerResponse)
+	err := c.cc.Invoke(ctx, "/tokenization.Msg/AcceptManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelManagerNomination(ctx context.Context, in *MsgCancelManagerNomination, opts ...grpc.CallOption) (*MsgCancelManagerNominationResponse, error) {
+	out := new(MsgCancelManagerNominationResponse)
+	err := c.cc.Invoke(ctx, "/tokenization.Msg/CancelManagerNomination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -5038,6 +5378,9 @@ type MsgServer interface {
 	SetIsArchived(context.Context, *MsgSetIsArchived) (*MsgSetIsArchivedResponse, error)
 	SetReservedProtocolAddress(context.Context, *MsgSetReservedProtocolAddress) (*MsgSetReservedProtocolAddressResponse, error)
 	CastVote(context.Context, *MsgCastVote) (*MsgCastVoteResponse, error)
+	NominateManager(context.Context, *MsgNominateManager) (*MsgNominateManagerResponse, error)
+	AcceptManager(context.Context, *MsgAcceptManager) (*MsgAcceptManagerResponse, error)
+	CancelManagerNomination(context.Context, *MsgCancelManagerNomination) (*MsgCancelManagerNominationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -5125,6 +5468,15 @@ func (*UnimplementedMsgServer) SetReservedProtocolAddress(ctx context.Context, r
 func (*UnimplementedMsgServer) CastVote(ctx context.Context, req *MsgCastVote) (*MsgCastVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CastVote not implemented")
 }
+func (*UnimplementedMsgServer) NominateManager(ctx context.Context, req *MsgNominateManager) (*MsgNominateManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NominateManager not implemented")
+}
+func (*UnimplementedMsgServer) AcceptManager(ctx context.Context, req *MsgAcceptManager) (*MsgAcceptManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptManager not implemented")
+}
+func (*UnimplementedMsgServer) CancelManagerNomination(ctx context.Context, req *MsgCancelManagerNomination) (*MsgCancelManagerNominationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelManagerNomination not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -5616,6 +5968,60 @@ func _Msg_CastVote_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_NominateManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNominateManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).NominateManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenization.Msg/NominateManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NominateManager(ctx, req.(*MsgNominateManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenization.Msg/AcceptManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptManager(ctx, req.(*MsgAcceptManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelManagerNomination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelManagerNomination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelManagerNomination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokenization.Msg/CancelManagerNomination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelManagerNomination(ctx, req.(*MsgCancelManagerNomination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tokenization.Msg",
@@ -5729,6 +6135,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CastVote",
 			Handler:    _Msg_CastVote_Handler,
 		},
+		{
+			MethodName: "NominateManager",
+			Handler:    _Msg_NominateManager_Handler,
+		},
+		{
+			MethodName: "AcceptManager",
+			Handler:    _Msg_AcceptManager_Handler,
+		},
+		{
+			MethodName: "CancelManagerNomination",
+			Handler:    _Msg_CancelManagerNomination_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tokenization/tx.proto",
@@ -5754,9 +6172,9 @@ func (m *TokenizationCustomMsgType) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	if m.CastVoteMsg != nil {
+	if m.CancelManagerNominationMsg != nil {
 		{
-			size, err := m.CastVoteMsg.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CancelManagerNominationMsg.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5766,11 +6184,11 @@ func (m *TokenizationCustomMsgType) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xfa
 	}
-	if m.SetReservedProtocolAddressMsg != nil {
+	if m.AcceptManagerMsg != nil {
 		{
-			size, err := m.SetReservedProtocolAddressMsg.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AcceptManagerMsg.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5780,9 +6198,51 @@ func (m *TokenizationCustomMsgType) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xf2
 	}
-	if m.SetIsArchivedMsg != nil {
+	if m.NominateManagerMsg != nil {
+		{
+			size, err := m.NominateManagerMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.CastVoteMsg != nil {
+		{
+			size, err := m.CastVoteMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.SetReservedProtocolAddressMsg != nil {
+		{
+			size, err := m.SetReservedProtocolAddressMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.SetIsArchivedMsg != nil {
 		{
 			size, err := m.SetIsArchivedMsg.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -9758,6 +10218,232 @@ func (m *MsgCastVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgNominateManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNominateManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNominateManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExpiresAt.Size()
+		i -= size
+		if _, err := m.ExpiresAt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Nominee) > 0 {
+		i -= len(m.Nominee)
+		copy(dAtA[i:], m.Nominee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nominee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNominateManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNominateManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNominateManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelManagerNomination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelManagerNomination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelManagerNomination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CollectionId.Size()
+		i -= size
+		if _, err := m.CollectionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelManagerNominationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelManagerNominationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelManagerNominationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *ApprovalUsed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10042,6 +10728,18 @@ func (m *TokenizationCustomMsgType) Size() (n int) {
 		l = m.CastVoteMsg.Size()
 		n += 2 + l + sovTx(uint64(l))
 	}
+	if m.NominateManagerMsg != nil {
+		l = m.NominateManagerMsg.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.AcceptManagerMsg != nil {
+		l = m.AcceptManagerMsg.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.CancelManagerNominationMsg != nil {
+		l = m.CancelManagerNominationMsg.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -11479,23 +12177,105 @@ func (m *MsgCastVoteResponse) Size() (n int) {
 	return n
 }
 
-func (m *ApprovalUsed) Size() (n int) {
+func (m *MsgNominateManager) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ApprovalId)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ApprovalLevel)
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Nominee)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ApproverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgNominateManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelManagerNomination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CollectionId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelManagerNominationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ApprovalUsed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApprovalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApprovalLevel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApproverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Version)
 	if l > 0 {
@@ -12528,6 +13308,114 @@ func (m *TokenizationCustomMsgType) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NominateManagerMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NominateManagerMsg == nil {
+				m.NominateManagerMsg = &MsgNominateManager{}
+			}
+			if err := m.NominateManagerMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptManagerMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AcceptManagerMsg == nil {
+				m.AcceptManagerMsg = &MsgAcceptManager{}
+			}
+			if err := m.AcceptManagerMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelManagerNominationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CancelManagerNominationMsg == nil {
+				m.CancelManagerNominationMsg = &MsgCancelManagerNomination{}
+			}
+			if err := m.CancelManagerNominationMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -22404,6 +23292,638 @@ func (m *MsgCastVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgNominateManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNominateManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNominateManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nominee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nominee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNominateManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNominateManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNominateManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelManagerNomination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelManagerNomination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelManagerNomination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelManagerNominationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelManagerNominationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelManagerNominationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApprovalUsed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0